@@ -47,6 +47,10 @@ type Neo4jBackupSpec struct {
 
 	// Suspend the backup schedule
 	Suspend bool `json:"suspend,omitempty"`
+	// DependsOn lists resources that must report Ready before this one is
+	// reconciled
+	// +optional
+	DependsOn []ResourceDependency `json:"dependsOn,omitempty"`
 }
 
 // BackupTarget defines what to backup
@@ -164,6 +168,10 @@ type Neo4jBackupStatus struct {
 
 	// History of recent backup runs
 	History []BackupRun `json:"history,omitempty"`
+
+	// Benchmark holds the result of the last storage throughput benchmark,
+	// requested with the neo4j.neo4j.com/benchmark annotation
+	Benchmark *BackupBenchmark `json:"benchmark,omitempty"`
 }
 
 // BackupStats provides backup statistics
@@ -199,6 +207,43 @@ type BackupRun struct {
 	Stats *BackupStats `json:"stats,omitempty"`
 }
 
+// BackupBenchmark reports the backup and restore throughput measured against
+// the configured storage location
+type BackupBenchmark struct {
+	// Trigger is the annotation value that requested this benchmark
+	Trigger string `json:"trigger"`
+
+	// Phase of the benchmark (Running, Completed, Failed)
+	Phase string `json:"phase,omitempty"`
+
+	// Message provides additional information about the benchmark
+	Message string `json:"message,omitempty"`
+
+	// Database that was backed up and restored
+	Database string `json:"database,omitempty"`
+
+	// Start time of the benchmark
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Completion time of the benchmark
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Size of the restored store, e.g. "12.4GiB"
+	StoreSize string `json:"storeSize,omitempty"`
+
+	// Time taken to write the backup to the storage location
+	BackupDuration string `json:"backupDuration,omitempty"`
+
+	// Time taken to restore the backup from the storage location
+	RestoreDuration string `json:"restoreDuration,omitempty"`
+
+	// Store bytes backed up per second, e.g. "85.3MiB/s"
+	BackupThroughput string `json:"backupThroughput,omitempty"`
+
+	// Store bytes restored per second
+	RestoreThroughput string `json:"restoreThroughput,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target.kind`
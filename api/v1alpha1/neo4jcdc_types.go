@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Neo4jCDCSpec defines the desired state of Neo4jCDC
+type Neo4jCDCSpec struct {
+	// +kubebuilder:validation:Required
+	// Name of the Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone whose
+	// databases capture changes
+	ClusterRef string `json:"clusterRef"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Databases to enable change data capture on
+	Databases []CDCDatabase `json:"databases"`
+
+	// Kafka Connect worker publishing the changes of the databases that list
+	// topics. Without it the operator only enables change data capture.
+	Connector *CDCConnectorSpec `json:"connector,omitempty"`
+}
+
+// CDCDatabase enables change data capture on one database
+type CDCDatabase struct {
+	// +kubebuilder:validation:Required
+	// Name of the database
+	Name string `json:"name"`
+
+	// Transaction log enrichment mode: FULL records the complete state of
+	// changed entities, DIFF only the changed properties
+	// +kubebuilder:validation:Enum=FULL;DIFF
+	// +kubebuilder:default=FULL
+	EnrichmentMode string `json:"enrichmentMode,omitempty"`
+
+	// Kafka topics the connector publishes the changes of the database to
+	Topics []CDCTopic `json:"topics,omitempty"`
+
+	// Where the connector starts reading changes the first time it runs:
+	// NOW skips earlier changes, EARLIEST replays the retained log
+	// +kubebuilder:validation:Enum=NOW;EARLIEST
+	// +kubebuilder:default=NOW
+	StartFrom string `json:"startFrom,omitempty"`
+}
+
+// CDCTopic maps changes to a Kafka topic
+type CDCTopic struct {
+	// +kubebuilder:validation:Required
+	// Name of the Kafka topic
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// CDC selector patterns of the changes published to the topic, e.g.
+	// "(:Person)" or "()-[:KNOWS]->()"
+	Patterns []string `json:"patterns"`
+}
+
+// CDCConnectorSpec configures the Kafka Connect worker running the Neo4j
+// Connector for Kafka as a source connector
+type CDCConnectorSpec struct {
+	// +kubebuilder:validation:Required
+	// Kafka Connect image with the Neo4j Connector for Kafka on its plugin
+	// path and connect-standalone on its PATH
+	Image ImageSpec `json:"image"`
+
+	// +kubebuilder:validation:Required
+	// Comma-separated Kafka bootstrap servers
+	BootstrapServers string `json:"bootstrapServers"`
+
+	// Kafka security protocol; defaults to SASL_SSL when auth is set and
+	// PLAINTEXT otherwise
+	// +kubebuilder:validation:Enum=PLAINTEXT;SSL;SASL_PLAINTEXT;SASL_SSL
+	SecurityProtocol string `json:"securityProtocol,omitempty"`
+
+	// SASL credentials the worker authenticates to Kafka with
+	Auth *CDCKafkaAuth `json:"auth,omitempty"`
+
+	// Secret with the username and password keys of the Neo4j user the
+	// connector reads changes as. Defaults to the admin secret of the target.
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// Additional properties of every connector, e.g. converters or the
+	// neo4j.cdc.poll-interval
+	Config map[string]string `json:"config,omitempty"`
+
+	// Additional properties of the Kafka Connect worker
+	WorkerConfig map[string]string `json:"workerConfig,omitempty"`
+
+	// Size of the volume holding the connector offsets
+	// +kubebuilder:default="1Gi"
+	OffsetStorageSize string `json:"offsetStorageSize,omitempty"`
+
+	// Resources of the worker container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// CDCKafkaAuth references SASL credentials
+type CDCKafkaAuth struct {
+	// SASL mechanism
+	// +kubebuilder:validation:Enum=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	// +kubebuilder:default=SCRAM-SHA-512
+	Mechanism string `json:"mechanism,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Secret with the username and password keys
+	SecretRef string `json:"secretRef"`
+}
+
+// Neo4jCDCStatus defines the observed state of Neo4jCDC
+type Neo4jCDCStatus struct {
+	// Conditions represent the current state of change data capture
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase
+	// (Pending, Enabling, Ready, Degraded, Failed)
+	Phase string `json:"phase,omitempty"`
+
+	// Message provides additional information about the current state
+	Message string `json:"message,omitempty"`
+
+	// Per-database results, in the order of spec.databases
+	Databases []CDCDatabaseStatus `json:"databases,omitempty"`
+
+	// Whether the Kafka Connect worker is running and ready
+	ConnectorReady bool `json:"connectorReady,omitempty"`
+
+	// Time the lag of the connectors was last measured
+	LastLagCheckTime *metav1.Time `json:"lastLagCheckTime,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed Neo4jCDC
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// CDCDatabaseStatus is the change data capture state of one database
+type CDCDatabaseStatus struct {
+	// Name of the database
+	Name string `json:"name"`
+
+	// Enrichment mode the database runs with
+	EnrichmentMode string `json:"enrichmentMode,omitempty"`
+
+	// Whether the database runs with the requested enrichment mode
+	Enabled bool `json:"enabled"`
+
+	// Age in seconds of the oldest change the connector has not published
+	// yet; 0 when it is caught up
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+
+	// Message describes a failure to enable capture or measure the lag
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.clusterRef`
+// +kubebuilder:printcolumn:name="Connector",type=boolean,JSONPath=`.status.connectorReady`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Neo4jCDC is the Schema for the neo4jcdcs API. It enables change data
+// capture on databases of a cluster or standalone deployment and runs a
+// Kafka Connect worker that publishes the changes to Kafka topics.
+type Neo4jCDC struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Neo4jCDCSpec   `json:"spec,omitempty"`
+	Status Neo4jCDCStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Neo4jCDCList contains a list of Neo4jCDC
+type Neo4jCDCList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Neo4jCDC `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Neo4jCDC{}, &Neo4jCDCList{})
+}
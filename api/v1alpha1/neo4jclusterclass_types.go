@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Neo4jClusterClassSpec defines the desired state of Neo4jClusterClass
+type Neo4jClusterClassSpec struct {
+	// Description of the class shown to the teams choosing it
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Template holds Neo4jEnterpriseCluster spec fields. Clusters of the class
+	// inherit every field set here that they leave empty, and may only set a
+	// field to a different value if it is listed in allowedOverrides.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Template runtime.RawExtension `json:"template,omitempty"`
+
+	// AllowedOverrides lists the spec fields, as dotted paths such as
+	// "topology.servers", "resources" or "config", that clusters may set to
+	// a value other than the template's. A path allows every field below it.
+	// +optional
+	AllowedOverrides []string `json:"allowedOverrides,omitempty"`
+
+	// ImagePolicy restricts the images clusters of the class may run
+	// +optional
+	ImagePolicy *ClusterClassImagePolicy `json:"imagePolicy,omitempty"`
+
+	// RequireTLS rejects clusters of the class that disable TLS
+	// +optional
+	RequireTLS bool `json:"requireTLS,omitempty"`
+
+	// RequireBackups rejects clusters of the class without a default backup
+	// storage location in spec.backups
+	// +optional
+	RequireBackups bool `json:"requireBackups,omitempty"`
+}
+
+// ClusterClassImagePolicy restricts the images of the clusters of a class
+type ClusterClassImagePolicy struct {
+	// AllowedRepositories lists the image repositories clusters may use,
+	// e.g. a mirror of the official image in an internal registry
+	// +optional
+	AllowedRepositories []string `json:"allowedRepositories,omitempty"`
+
+	// MinVersion is the oldest Neo4j version clusters may run, e.g. "5.26.0"
+	// or "2025.01.0"
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Description",type=string,JSONPath=`.spec.description`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Neo4jClusterClass is the Schema for the neo4jclusterclasses API. It is a
+// cluster-scoped template that platform teams publish so that namespaced
+// Neo4jEnterpriseClusters can be created from an approved configuration,
+// overriding only the fields the class allows.
+type Neo4jClusterClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec Neo4jClusterClassSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Neo4jClusterClassList contains a list of Neo4jClusterClass
+type Neo4jClusterClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Neo4jClusterClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Neo4jClusterClass{}, &Neo4jClusterClassList{})
+}
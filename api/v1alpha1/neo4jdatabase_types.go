@@ -33,6 +33,21 @@ type Neo4jDatabaseSpec struct {
 	// Database name
 	Name string `json:"name"`
 
+	// Type of database: a standard database, or a composite database that
+	// queries its constituents
+	// +kubebuilder:validation:Enum=standard;composite
+	// +kubebuilder:default=standard
+	Type string `json:"type,omitempty"`
+
+	// Constituents of a composite database, each an alias of a local or
+	// remote database. Only allowed for composite databases.
+	Constituents []DatabaseAlias `json:"constituents,omitempty"`
+
+	// Aliases of the database. Local aliases point to this database unless
+	// they name another target; remote aliases point to a database of
+	// another DBMS.
+	Aliases []DatabaseAlias `json:"aliases,omitempty"`
+
 	// Database creation options
 	Options map[string]string `json:"options,omitempty"`
 
@@ -96,6 +111,182 @@ type Neo4jDatabaseSpec struct {
 
 	// Seed credentials for URI access when system-wide auth is not available
 	SeedCredentials *SeedCredentials `json:"seedCredentials,omitempty"`
+
+	// Seed creates the database from a backup artifact URI or from the
+	// storage of a Neo4jBackup, instead of empty. seedConfig and
+	// seedCredentials apply to it as to seedURI, which it replaces.
+	Seed *DatabaseSeed `json:"seed,omitempty"`
+
+	// Desired state of the database. Setting it to offline stops the
+	// database with STOP DATABASE; setting it back to online starts it
+	// again. A database stopped outside the operator is started while this
+	// is online. Composite databases are always online.
+	// +kubebuilder:validation:Enum=online;offline
+	// +kubebuilder:default=online
+	DesiredState string `json:"desiredState,omitempty"`
+
+	// DropPolicy controls what happens to the database in Neo4j when this
+	// resource is deleted. Without it the database is dropped right away.
+	DropPolicy *DatabaseDropPolicy `json:"dropPolicy,omitempty"`
+
+	// Schema declares the indexes and constraints of the database. Missing
+	// ones are created; existing ones that differ are reported, not changed.
+	Schema *DatabaseSchema `json:"schema,omitempty"`
+	// DependsOn lists resources that must report Ready before this one is
+	// reconciled
+	// +optional
+	DependsOn []ResourceDependency `json:"dependsOn,omitempty"`
+}
+
+// DatabaseSchema declares the indexes and constraints of a database
+type DatabaseSchema struct {
+	// Indexes of the database
+	Indexes []SchemaIndex `json:"indexes,omitempty"`
+
+	// Constraints of the database
+	Constraints []SchemaConstraint `json:"constraints,omitempty"`
+
+	// Prune drops the indexes and constraints that are not declared.
+	// Token lookup indexes and indexes backing a constraint are kept.
+	Prune bool `json:"prune,omitempty"`
+}
+
+// SchemaIndex declares an index. Exactly one of labels and relationshipTypes
+// must be set.
+type SchemaIndex struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]*$`
+	// Name of the index, unique among the indexes and constraints
+	Name string `json:"name"`
+
+	// Type of the index
+	// +kubebuilder:validation:Enum=RANGE;TEXT;POINT;FULLTEXT;VECTOR
+	// +kubebuilder:default=RANGE
+	Type string `json:"type,omitempty"`
+
+	// Node labels the index covers. Only fulltext indexes take more than one.
+	Labels []string `json:"labels,omitempty"`
+
+	// Relationship types the index covers. Only fulltext indexes take more
+	// than one.
+	RelationshipTypes []string `json:"relationshipTypes,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Indexed properties. Text, point and vector indexes take exactly one.
+	Properties []string `json:"properties"`
+
+	// Index configuration settings, e.g. vector.dimensions: "1536" and
+	// vector.similarity_function: cosine. Numbers and booleans are passed to
+	// Neo4j as such.
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// SchemaConstraint declares a constraint. Exactly one of label and
+// relationshipType must be set.
+type SchemaConstraint struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]*$`
+	// Name of the constraint, unique among the indexes and constraints
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// Type of the constraint: property uniqueness, node or relationship key,
+	// property existence, or property type
+	// +kubebuilder:validation:Enum=UNIQUE;KEY;EXISTS;PROPERTY_TYPE
+	Type string `json:"type"`
+
+	// Node label the constraint applies to
+	Label string `json:"label,omitempty"`
+
+	// Relationship type the constraint applies to
+	RelationshipType string `json:"relationshipType,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Constrained properties. Existence and property type constraints take
+	// exactly one.
+	Properties []string `json:"properties"`
+
+	// Cypher type the property must have, e.g. STRING or LIST<INTEGER NOT
+	// NULL>. Only for PROPERTY_TYPE constraints.
+	PropertyType string `json:"propertyType,omitempty"`
+}
+
+// DatabaseSchemaStatus reports how the indexes and constraints in Neo4j
+// compare to spec.schema
+type DatabaseSchemaStatus struct {
+	// Drifted lists declared indexes and constraints whose definition in
+	// Neo4j differs from the spec. Drop one to have it recreated.
+	Drifted []string `json:"drifted,omitempty"`
+
+	// Unmanaged lists indexes and constraints that are not declared. They
+	// are dropped when spec.schema.prune is set.
+	Unmanaged []string `json:"unmanaged,omitempty"`
+
+	// LastUpdateTime is when indexes or constraints were last created or
+	// dropped, or the report last changed
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// DatabaseDropPolicy guards dropping a database when its Neo4jDatabase is
+// deleted
+type DatabaseDropPolicy struct {
+	// Action taken on deletion: Drop the database, or Retain it in Neo4j and
+	// only delete the resource
+	// +kubebuilder:validation:Enum=Drop;Retain
+	// +kubebuilder:default=Drop
+	Action string `json:"action,omitempty"`
+
+	// RequireConfirmation holds the drop until the neo4j.com/confirm-drop
+	// annotation of the resource is set to the database name
+	RequireConfirmation bool `json:"requireConfirmation,omitempty"`
+
+	// FinalDump backs the database up to object storage before it is
+	// dropped. The drop waits for the backup to complete and does not
+	// happen if it fails.
+	FinalDump *DatabaseFinalDump `json:"finalDump,omitempty"`
+}
+
+// DatabaseFinalDump configures the backup taken before a database is dropped.
+// It is run as a Neo4jBackup named <resource>-final-dump, which is kept
+// after the database is gone.
+type DatabaseFinalDump struct {
+	// +kubebuilder:validation:Required
+	// Storage the backup is written to. PVC storage is not allowed, as the
+	// claim would outlive the dump only by accident.
+	Storage StorageLocation `json:"storage"`
+
+	// Cloud configuration for the storage
+	Cloud *CloudBlock `json:"cloud,omitempty"`
+}
+
+// DatabaseSeed selects the backup a new database is seeded from. Exactly one
+// of uri and backupRef must be set.
+type DatabaseSeed struct {
+	// URI of a backup or dump artifact, in any scheme seedURI accepts
+	URI string `json:"uri,omitempty"`
+
+	// BackupRef names a Neo4jBackup in the same namespace. Its cloud storage
+	// location seeds the database; Neo4j picks the most recent backup of a
+	// database with the same name there. PVC storage cannot be used.
+	BackupRef string `json:"backupRef,omitempty"`
+}
+
+// DatabaseSeedStatus records the progress of seeding a database
+type DatabaseSeedStatus struct {
+	// URI the database was seeded from
+	URI string `json:"uri,omitempty"`
+
+	// Phase of seeding: Seeding, Completed or Failed
+	Phase string `json:"phase,omitempty"`
+
+	// StartTime is when the database was created from the seed
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the seeded database came online
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message describes the last seeding step or failure
+	Message string `json:"message,omitempty"`
 }
 
 // DatabaseTopology defines database distribution in a cluster
@@ -125,6 +316,63 @@ type DatabaseTopology struct {
 	Secondaries int32 `json:"secondaries,omitempty"`
 }
 
+// DatabaseAlias defines a database alias or a constituent of a composite
+// database
+type DatabaseAlias struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	// Name of the alias. Constituents are named <composite>.<name> in Cypher.
+	Name string `json:"name"`
+
+	// Database the alias points to. Required for constituents and remote
+	// aliases; local aliases default to this database.
+	TargetDatabase string `json:"targetDatabase,omitempty"`
+
+	// Remote DBMS hosting the target database; unset for a local alias
+	Remote *RemoteAliasTarget `json:"remote,omitempty"`
+}
+
+// RemoteAliasTarget points an alias at a database of another DBMS
+type RemoteAliasTarget struct {
+	// +kubebuilder:validation:Required
+	// Bolt URL of the remote DBMS, e.g. neo4j+s://remote.example.com:7687
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:Required
+	// Secret with the username and password keys the alias connects with
+	CredentialsSecret string `json:"credentialsSecret"`
+
+	// Driver settings of the connection to the remote DBMS
+	Driver *AliasDriverSettings `json:"driver,omitempty"`
+}
+
+// AliasDriverSettings configures the driver of a remote alias. Durations
+// use Go syntax, e.g. "5s" or "1h".
+type AliasDriverSettings struct {
+	// Require an encrypted connection
+	SSLEnforced *bool `json:"sslEnforced,omitempty"`
+
+	// Timeout for establishing a connection
+	ConnectionTimeout string `json:"connectionTimeout,omitempty"`
+
+	// Maximum lifetime of a pooled connection
+	ConnectionMaxLifetime string `json:"connectionMaxLifetime,omitempty"`
+
+	// Maximum time to wait for a pooled connection
+	ConnectionPoolAcquisitionTimeout string `json:"connectionPoolAcquisitionTimeout,omitempty"`
+
+	// Idle time after which a pooled connection is tested before use
+	ConnectionPoolIdleTest string `json:"connectionPoolIdleTest,omitempty"`
+
+	// Maximum number of pooled connections
+	// +kubebuilder:validation:Minimum=1
+	ConnectionPoolMaxSize *int32 `json:"connectionPoolMaxSize,omitempty"`
+
+	// Driver log level
+	// +kubebuilder:validation:Enum=DEBUG;INFO;WARNING;ERROR;NONE
+	LoggingLevel string `json:"loggingLevel,omitempty"`
+}
+
 // InitialDataSpec defines initial data import configuration
 type InitialDataSpec struct {
 	// Source type for initial data
@@ -236,11 +484,22 @@ type Neo4jDatabaseStatus struct {
 	// This shows the actual distribution of database replicas across
 	// the available cluster server infrastructure.
 	Servers []string `json:"servers,omitempty"`
+
+	// Aliases and constituents created for this database, by their Cypher
+	// name. Entries removed from the spec are dropped.
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Seed reports the progress of creating the database from spec.seed
+	Seed *DatabaseSeedStatus `json:"seed,omitempty"`
+
+	// Schema reports drift of the indexes and constraints from spec.schema
+	Schema *DatabaseSchemaStatus `json:"schema,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.clusterRef`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,priority=1
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,18 +27,33 @@ import (
 
 // Neo4jEnterpriseClusterSpec defines the desired state of Neo4jEnterpriseCluster
 type Neo4jEnterpriseClusterSpec struct {
-	// +kubebuilder:validation:Required
+	// ClusterClassName is the Neo4jClusterClass the cluster is created from.
+	// The cluster inherits the fields of the class template it leaves empty
+	// and may only override the fields the class allows.
+	// +optional
+	ClusterClassName string `json:"clusterClassName,omitempty"`
+
+	// Image of the Neo4j servers. Required unless the cluster class sets it.
+	// +optional
 	Image ImageSpec `json:"image"`
 
-	// +kubebuilder:validation:Required
+	// Topology of the cluster. Required unless the cluster class sets it.
+	// +optional
 	Topology TopologyConfiguration `json:"topology"`
 
-	// +kubebuilder:validation:Required
+	// Storage of the Neo4j servers. Required unless the cluster class sets it.
+	// +optional
 	Storage StorageSpec `json:"storage"`
 
 	// Resource requirements for Neo4j pods
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Probes overrides the timings of the readiness, liveness and startup
+	// probes of the Neo4j container. Timings left empty keep the operator
+	// defaults.
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+
 	// Environment variables for Neo4j pods
 	Env []corev1.EnvVar `json:"env,omitempty"`
 
@@ -56,6 +72,28 @@ type Neo4jEnterpriseClusterSpec struct {
 	// Custom configuration for Neo4j
 	Config map[string]string `json:"config,omitempty"`
 
+	// ConfigOverrides let individual servers or server groups diverge from
+	// spec.config. Later overrides win where they set the same setting.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ConfigOverrides []ConfigOverrideSpec `json:"configOverrides,omitempty"`
+
+	// Lifecycle merges custom shell snippets into the startup and health
+	// scripts of the servers
+	// +optional
+	Lifecycle *LifecycleSpec `json:"lifecycle,omitempty"`
+
+	// Memory controls how the heap, page cache and transaction memory of
+	// the servers are sized
+	// +optional
+	Memory *MemorySpec `json:"memory,omitempty"`
+
+	// VerticalScaling controls how changes of spec.resources reach the
+	// servers and whether resource recommendations are collected
+	// +optional
+	VerticalScaling *VerticalScalingSpec `json:"verticalScaling,omitempty"`
+
 	TLS *TLSSpec `json:"tls,omitempty"`
 
 	Auth *AuthSpec `json:"auth,omitempty"`
@@ -64,6 +102,10 @@ type Neo4jEnterpriseClusterSpec struct {
 
 	Backups *BackupsSpec `json:"backups,omitempty"`
 
+	// BackupPort controls the backup listener (port 6362) of the servers
+	// +optional
+	BackupPort *BackupPortSpec `json:"backupPort,omitempty"`
+
 	UI *UISpec `json:"ui,omitempty"`
 
 	// RestoreFrom specifies backup to restore from during cluster creation
@@ -77,6 +119,16 @@ type Neo4jEnterpriseClusterSpec struct {
 	// Query performance monitoring
 	QueryMonitoring *QueryMonitoringSpec `json:"queryMonitoring,omitempty"`
 
+	// Monitoring configures the scraping of the Neo4j metrics by the
+	// Prometheus Operator
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+
+	// Logging configures the format of the Neo4j logs and a sidecar
+	// shipping them
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
 	// MCP server configuration for this cluster
 	MCP *MCPServerSpec `json:"mcp,omitempty"`
 
@@ -92,6 +144,22 @@ type Neo4jEnterpriseClusterSpec struct {
 	// See: https://neo4j.com/docs/aura/fleet-management/
 	// +optional
 	AuraFleetManagement *AuraFleetManagementSpec `json:"auraFleetManagement,omitempty"`
+
+	// License is the Neo4j license entitlement of the cluster. It takes the
+	// place of the operator-wide entitlement of --license-configmap.
+	// +optional
+	License *LicenseSpec `json:"license,omitempty"`
+
+	// Maintenance pauses reconciliation of the cluster. Single servers are
+	// put into maintenance with the neo4j.com/maintenance pod annotation.
+	// +optional
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
+
+	// Hibernate checkpoints the databases and scales the cluster to zero.
+	// Volumes, configuration and Services are kept, and setting it back to
+	// false starts the servers of spec.topology again.
+	// +optional
+	Hibernate bool `json:"hibernate,omitempty"`
 }
 
 // ImageSpec defines the Neo4j image configuration
@@ -102,9 +170,20 @@ type ImageSpec struct {
 	// +kubebuilder:validation:Required
 	Tag string `json:"tag"`
 
+	// Registry that replaces the registry of repo, e.g. a pull-through
+	// mirror such as "mirror.example.com/dockerhub". Official Docker Hub
+	// images like "neo4j" are pulled from the "library/" path of the mirror.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9.-]*(:[0-9]+)?(/[a-z0-9._-]+)*$`
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
 	// +kubebuilder:default=IfNotPresent
 	PullPolicy string `json:"pullPolicy,omitempty"`
 
+	// Names of Secrets of type kubernetes.io/dockerconfigjson used to pull
+	// the image. They are also used by the backup, restore and init
+	// containers that run the same image.
 	PullSecrets []string `json:"pullSecrets,omitempty"`
 }
 
@@ -133,13 +212,17 @@ type BackupStorageSpec struct {
 
 // TLSSpec defines TLS configuration
 type TLSSpec struct {
-	// +kubebuilder:validation:Enum=cert-manager;disabled
+	// Mode selects where the certificates come from: cert-manager issues
+	// them, secret reads them from certificateSecret, disabled turns TLS off
+	// +kubebuilder:validation:Enum=cert-manager;secret;disabled
 	// +kubebuilder:default=cert-manager
 	Mode string `json:"mode,omitempty"`
 
 	IssuerRef *IssuerRef `json:"issuerRef,omitempty"`
 
-	// Manual certificate configuration
+	// Existing Secret with tls.crt, tls.key and optionally ca.crt, used by
+	// every connector without a certificate of its own. Required in mode
+	// secret. Updating the Secret restarts the servers.
 	CertificateSecret string `json:"certificateSecret,omitempty"`
 
 	// External Secrets configuration for TLS certificates
@@ -156,6 +239,67 @@ type TLSSpec struct {
 
 	// Certificate usage settings
 	Usages []string `json:"usages,omitempty"`
+
+	// Per-connector SSL policies. Connectors without a policy use the
+	// certificate above with client_auth=NONE and TLSv1.3/TLSv1.2.
+	Policies *SSLPolicies `json:"policies,omitempty"`
+
+	// Intra-cluster TLS settings. Ignored by standalone deployments.
+	Cluster *ClusterTLSSpec `json:"cluster,omitempty"`
+}
+
+// ClusterTLSSpec configures the TLS of the traffic between the servers
+type ClusterTLSSpec struct {
+	// MTLS set to required gives every server a certificate of its own,
+	// issued by cert-manager for its pod FQDN, and makes the servers verify
+	// each other's certificates against the CA of the issuer. Renewed
+	// certificates are rolled out one server at a time. Requires mode
+	// cert-manager.
+	// +kubebuilder:validation:Enum=required;disabled
+	MTLS string `json:"mTLS,omitempty"`
+}
+
+// SSLPolicies overrides the dbms.ssl.policy settings of individual connectors
+type SSLPolicies struct {
+	// Bolt connector used by drivers and cypher-shell
+	Bolt *SSLPolicySpec `json:"bolt,omitempty"`
+
+	// HTTPS connector used by the HTTP API and Neo4j Browser
+	HTTPS *SSLPolicySpec `json:"https,omitempty"`
+
+	// Intra-cluster traffic between servers. Ignored by standalone deployments.
+	Cluster *SSLPolicySpec `json:"cluster,omitempty"`
+
+	// Backup port. Only enabled when set, because every backup client then
+	// has to connect with TLS as well.
+	Backup *SSLPolicySpec `json:"backup,omitempty"`
+}
+
+// SSLPolicySpec defines the SSL policy of one connector
+type SSLPolicySpec struct {
+	// Issuer of a separate certificate for this connector, stored in the
+	// <name>-<scope>-tls-secret Secret. Requires mode cert-manager.
+	IssuerRef *IssuerRef `json:"issuerRef,omitempty"`
+
+	// Existing Secret with tls.crt, tls.key and optionally ca.crt for this
+	// connector. Updating the Secret restarts the servers.
+	CertificateSecret string `json:"certificateSecret,omitempty"`
+
+	// Client certificate authentication
+	// +kubebuilder:validation:Enum=NONE;OPTIONAL;REQUIRE
+	ClientAuth string `json:"clientAuth,omitempty"`
+
+	// How peer certificates are verified: TrustAll accepts any certificate,
+	// CA checks them against the ca.crt of the certificate Secret. Defaults
+	// to TrustAll for the cluster scope and CA for the others.
+	// +kubebuilder:validation:Enum=TrustAll;CA
+	Trust string `json:"trust,omitempty"`
+
+	// Allowed TLS protocol versions, e.g. TLSv1.3
+	TLSVersions []string `json:"tlsVersions,omitempty"`
+
+	// Allowed cipher suites. Defaults to the JVM defaults.
+	Ciphers []string `json:"ciphers,omitempty"`
 }
 
 // CertificateSubject defines certificate subject fields
@@ -222,6 +366,27 @@ type SecurityContextSpec struct {
 
 	// Container-level security settings for Neo4j containers
 	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
+
+	// Distro selects node-OS aware defaults for seccompProfile and
+	// appArmorProfile. "cos" and "ubuntu" default to the runtime/default
+	// AppArmor profile; "bottlerocket" and "generic" rely on seccomp only
+	// (Bottlerocket enforces SELinux instead of AppArmor).
+	// +kubebuilder:validation:Enum=generic;bottlerocket;cos;ubuntu
+	// +optional
+	Distro string `json:"distro,omitempty"`
+
+	// SeccompProfile applied to every generated pod. Takes precedence over
+	// podSecurityContext.seccompProfile and the distro default (RuntimeDefault).
+	// +optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+
+	// AppArmorProfile applied to every container of generated pods via the
+	// pod's securityContext.appArmorProfile, or the
+	// container.apparmor.security.beta.kubernetes.io annotations before
+	// Kubernetes 1.30.
+	// One of "runtime/default", "unconfined" or "localhost/<profile>".
+	// +optional
+	AppArmorProfile string `json:"appArmorProfile,omitempty"`
 }
 
 // IssuerRef references a cert-manager-compatible issuer.
@@ -256,9 +421,25 @@ type AuthSpec struct {
 	// Admin secret for initial setup
 	AdminSecret string `json:"adminSecret,omitempty"`
 
+	// OperatorSecret names a Secret holding the username and password of a
+	// dedicated user the operator connects as instead of the admin. The
+	// operator creates the user with the neo4j_operator role, connected as
+	// the admin once the servers are ready, so that audit logs tell its
+	// statements apart from those of the admin. The admin credentials are
+	// still needed to bootstrap the user and to change its password.
+	// +optional
+	OperatorSecret string `json:"operatorSecret,omitempty"`
+
 	// External Secrets configuration for auth secrets
 	ExternalSecrets *ExternalSecretsConfig `json:"externalSecrets,omitempty"`
 
+	// ExternalSecret syncs the admin credentials from an external secret
+	// store through the External Secrets Operator. The operator copies them
+	// into adminSecret, and changes the password inside Neo4j when the store
+	// rotates it. Only clusters support it.
+	// +optional
+	ExternalSecret *AdminExternalSecretSpec `json:"externalSecret,omitempty"`
+
 	// Password policy configuration
 	PasswordPolicy *PasswordPolicySpec `json:"passwordPolicy,omitempty"`
 
@@ -270,6 +451,39 @@ type AuthSpec struct {
 
 	// Kerberos configuration for Kerberos auth provider
 	Kerberos *KerberosAuthSpec `json:"kerberos,omitempty"`
+
+	// OIDC enables single sign-on through an OpenID Connect provider, in
+	// addition to the provider above. Only clusters support it.
+	// +optional
+	OIDC *OIDCAuthSpec `json:"oidc,omitempty"`
+}
+
+// AdminExternalSecretSpec reads the admin credentials from an external
+// secret store
+type AdminExternalSecretSpec struct {
+	// SecretStore or ClusterSecretStore holding the credentials
+	// +kubebuilder:validation:Required
+	SecretStoreRef SecretStoreRef `json:"secretStoreRef"`
+
+	// Key of the credentials in the store
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Property of the key holding the username
+	// +kubebuilder:default=username
+	// +optional
+	UsernameProperty string `json:"usernameProperty,omitempty"`
+
+	// Property of the key holding the password
+	// +kubebuilder:default=password
+	// +optional
+	PasswordProperty string `json:"passwordProperty,omitempty"`
+
+	// How often the External Secrets Operator reads the store
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval string `json:"refreshInterval,omitempty"`
 }
 
 // PasswordPolicySpec defines Neo4j password policy
@@ -317,30 +531,82 @@ type JWTValidationSpec struct {
 	Audience []string `json:"audience,omitempty"`
 }
 
-// LDAPAuthSpec defines LDAP authentication configuration
+// LDAPAuthSpec defines LDAP authentication configuration. The operator
+// renders it as the dbms.security.ldap.* settings and keeps the native
+// provider enabled, so the admin Secret keeps working when LDAP does not.
 type LDAPAuthSpec struct {
 	// LDAP server settings
 	Server *LDAPServerSpec `json:"server,omitempty"`
 
+	// BindCredentials is the system account Neo4j searches the directory
+	// with. Without it, users are authenticated through userDNTemplate and
+	// their groups are read with their own credentials.
+	// +optional
+	BindCredentials *LDAPBindCredentialsSpec `json:"bindCredentials,omitempty"`
+
+	// UserDNTemplate builds the DN users log in as, with {0} replaced by
+	// the username, e.g. uid={0},ou=users,dc=example,dc=com. Without it,
+	// users are searched for by loginAttribute, which needs bindCredentials.
+	// +optional
+	UserDNTemplate string `json:"userDNTemplate,omitempty"`
+
+	// LoginAttribute is the attribute users are searched for by when there is
+	// no userDNTemplate
+	// +kubebuilder:default=samaccountname
+	// +optional
+	LoginAttribute string `json:"loginAttribute,omitempty"`
+
 	// User search settings
 	UserSearch *LDAPSearchSpec `json:"userSearch,omitempty"`
 
-	// Group search settings
+	// Group search settings. The filter resolves nested groups; every group
+	// of groupToRoleMapping must be under the base DN.
 	GroupSearch *LDAPSearchSpec `json:"groupSearch,omitempty"`
+
+	// GroupToRoleMapping maps the DN of an LDAP group to the Neo4j roles its
+	// members get
+	// +optional
+	GroupToRoleMapping map[string][]string `json:"groupToRoleMapping,omitempty"`
 }
 
 // LDAPServerSpec defines LDAP server configuration
 type LDAPServerSpec struct {
-	// LDAP server URLs
+	// LDAP server URLs, ldap:// or ldaps://. Neo4j fails over to the next
+	// URL when a server does not answer.
 	URLs []string `json:"urls,omitempty"`
 
-	// Enable TLS for LDAP connection
+	// Enable TLS for LDAP connection. ldap:// URLs upgrade the connection
+	// with StartTLS.
 	// +kubebuilder:default=true
 	TLS bool `json:"tls,omitempty"`
 
 	// Skip TLS certificate verification
 	// +kubebuilder:default=false
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// CASecret is a Secret holding the CA certificate of the LDAP servers
+	// under ca.crt. The servers trust it in addition to the CAs of the JVM.
+	// +optional
+	CASecret string `json:"caSecret,omitempty"`
+}
+
+// LDAPBindCredentialsSpec references the credentials of an LDAP system
+// account
+type LDAPBindCredentialsSpec struct {
+	// Secret holding the credentials
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SecretRef string `json:"secretRef"`
+
+	// Key in the Secret holding the bind DN
+	// +kubebuilder:default=bindDN
+	// +optional
+	DNKey string `json:"dnKey,omitempty"`
+
+	// Key in the Secret holding the password
+	// +kubebuilder:default=bindPassword
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
 }
 
 // LDAPSearchSpec defines LDAP search configuration
@@ -357,16 +623,112 @@ type LDAPSearchSpec struct {
 	Scope string `json:"scope,omitempty"`
 }
 
-// KerberosAuthSpec defines Kerberos authentication configuration
+// OIDCAuthSpec configures an OpenID Connect identity provider that Neo4j
+// Browser and drivers log in with
+type OIDCAuthSpec struct {
+	// Name of the provider in the Neo4j settings, dbms.security.oidc.<name>
+	// +kubebuilder:default=sso
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9][A-Za-z0-9_-]*$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// DisplayName is shown on the login page of Neo4j Browser
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Issuer URL of the provider. Its discovery document is read from
+	// <issuer>/.well-known/openid-configuration.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer"`
+
+	// ClientID of Neo4j at the provider
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+
+	// ClientSecret is needed by providers that require it in the
+	// authorization code flow
+	// +optional
+	ClientSecret *OIDCClientSecretSpec `json:"clientSecret,omitempty"`
+
+	// Audience the tokens must be issued for, the client ID when empty
+	// +optional
+	Audience []string `json:"audience,omitempty"`
+
+	// AuthFlow clients use to log in
+	// +kubebuilder:validation:Enum=pkce;implicit
+	// +kubebuilder:default=pkce
+	// +optional
+	AuthFlow string `json:"authFlow,omitempty"`
+
+	// Scopes requested from the provider
+	// +kubebuilder:default={openid,profile,email}
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// ClaimsMapping names the token claims holding the username and groups
+	// +optional
+	ClaimsMapping *OIDCClaimsMappingSpec `json:"claimsMapping,omitempty"`
+
+	// GroupToRoleMapping maps the groups of the groups claim to Neo4j roles
+	// +optional
+	GroupToRoleMapping map[string][]string `json:"groupToRoleMapping,omitempty"`
+}
+
+// OIDCClientSecretSpec references the client secret of Neo4j at an OpenID
+// Connect provider
+type OIDCClientSecretSpec struct {
+	// Secret holding the client secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SecretRef string `json:"secretRef"`
+
+	// Key in the Secret holding the client secret
+	// +kubebuilder:default=clientSecret
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// OIDCClaimsMappingSpec names the claims of an OpenID Connect token
+type OIDCClaimsMappingSpec struct {
+	// Claim holding the username
+	// +kubebuilder:default=sub
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// Claim holding the groups of the user
+	// +kubebuilder:default=groups
+	// +optional
+	Groups string `json:"groups,omitempty"`
+}
+
+// KerberosAuthSpec defines Kerberos authentication configuration. The
+// operator generates krb5.conf and the configuration of the Neo4j Kerberos
+// add-on, which has to be installed with a Neo4jPlugin. Users get their roles
+// from native users of the same name, or from LDAP when spec.auth.ldap is
+// set.
 type KerberosAuthSpec struct {
 	// Kerberos realm
 	Realm string `json:"realm,omitempty"`
 
-	// Service principal name
+	// Service principal name, e.g. neo4j/neo4j.example.com@EXAMPLE.COM
 	ServicePrincipal string `json:"servicePrincipal,omitempty"`
 
 	// Keytab configuration
 	Keytab *KerberosKeytabSpec `json:"keytab,omitempty"`
+
+	// KDCs of the realm, as host or host:port
+	// +optional
+	KDCs []string `json:"kdcs,omitempty"`
+
+	// AdminServer of the realm, as host or host:port
+	// +optional
+	AdminServer string `json:"adminServer,omitempty"`
+
+	// Domains whose hosts belong to the realm, e.g. .example.com
+	// +optional
+	Domains []string `json:"domains,omitempty"`
 }
 
 // KerberosKeytabSpec defines Kerberos keytab configuration
@@ -393,15 +755,59 @@ type ServiceSpec struct {
 	LoadBalancerIP           string   `json:"loadBalancerIP,omitempty"`
 	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
 
+	// AllowedCIDRs restricts the client networks that can reach the Bolt and
+	// HTTP(S) ports. LoadBalancer services get them as source ranges; for
+	// other service types the operator generates a NetworkPolicy that admits
+	// these CIDRs and pods inside the Kubernetes cluster.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
 	// External traffic policy: Cluster or Local
 	// +kubebuilder:validation:Enum=Cluster;Local
 	ExternalTrafficPolicy string `json:"externalTrafficPolicy,omitempty"`
 
+	// IPFamilyPolicy of the generated Services: SingleStack, PreferDualStack
+	// or RequireDualStack. Defaults to the cluster's default.
+	// +kubebuilder:validation:Enum=SingleStack;PreferDualStack;RequireDualStack
+	IPFamilyPolicy string `json:"ipFamilyPolicy,omitempty"`
+
+	// IPFamilies of the generated Services in order of preference. When
+	// IPv6 is listed Neo4j listens on the IPv6 wildcard address, and when
+	// it comes first the JVM prefers IPv6 when resolving peers.
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:Enum=IPv4;IPv6
+	IPFamilies []string `json:"ipFamilies,omitempty"`
+
 	// Ingress configuration
 	Ingress *IngressSpec `json:"ingress,omitempty"`
 
 	// Route configuration (OpenShift only)
 	Route *RouteSpec `json:"route,omitempty"`
+
+	// ReadOnly creates the <cluster>-ro Service, which selects only the
+	// secondary servers, for read workloads such as analytics. Standalones
+	// ignore it.
+	// +optional
+	ReadOnly *ReadOnlyServiceSpec `json:"readOnly,omitempty"`
+}
+
+// ReadOnlyServiceSpec configures the Service of the secondary servers
+type ReadOnlyServiceSpec struct {
+	// Enabled creates the Service
+	Enabled bool `json:"enabled"`
+
+	// Service type: ClusterIP, NodePort, LoadBalancer
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Annotations to add to the service
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Ingress exposes the HTTP port of the Service
+	// +optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
 }
 
 // IngressSpec defines ingress configuration
@@ -450,6 +856,115 @@ type RouteTLSSpec struct {
 	SecretName string `json:"secretName,omitempty"`
 }
 
+// BackupPortSpec controls access to the backup protocol of the servers
+type BackupPortSpec struct {
+	// Enabled turns the backup listener on. Neo4jBackup resources cannot
+	// back up a cluster that disables it.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Expose creates the <cluster>-backup-port ClusterIP Service so
+	// neo4j-admin tooling outside the operator can reach the servers
+	// +optional
+	Expose bool `json:"expose,omitempty"`
+
+	// AllowedClients locks the backup port down with a NetworkPolicy. Only
+	// the cluster's own pods, Neo4jBackup jobs and these peers may connect
+	// to it; the other ports are unaffected.
+	// +optional
+	AllowedClients []networkingv1.NetworkPolicyPeer `json:"allowedClients,omitempty"`
+}
+
+// LicenseSpec is a Neo4j license entitlement
+type LicenseSpec struct {
+	// MaxCores is the number of CPU cores the license covers. Every server
+	// counts the CPU limit of its Neo4j container, rounded up to whole
+	// cores, and changes that take the cluster past MaxCores are rejected.
+	// +kubebuilder:validation:Minimum=1
+	MaxCores int32 `json:"maxCores"`
+}
+
+// ProbesSpec holds the timings of the probes of the Neo4j container
+type ProbesSpec struct {
+	// Readiness probe. Defaults to an initial delay of 45s, a period of
+	// 15s, a timeout of 5s and 8 failures, which leaves servers rejoining
+	// the cluster about two minutes.
+	// +optional
+	Readiness *ProbeTimingSpec `json:"readiness,omitempty"`
+
+	// Liveness probe. Defaults to an initial delay of 120s, a period of
+	// 60s, a timeout of 10s and 3 failures.
+	// +optional
+	Liveness *ProbeTimingSpec `json:"liveness,omitempty"`
+
+	// Startup probe. Defaults to an initial delay of 30s, a period of 10s,
+	// a timeout of 5s and 60 failures, which allows ten minutes for the
+	// servers to form the cluster.
+	// +optional
+	Startup *ProbeTimingSpec `json:"startup,omitempty"`
+}
+
+// ProbeTimingSpec holds the timings of a probe
+type ProbeTimingSpec struct {
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// MaintenanceSpec pauses the operator for a cluster
+type MaintenanceSpec struct {
+	// Enabled stops every change to the cluster's resources. Only the
+	// status is kept up to date while it is set.
+	Enabled bool `json:"enabled"`
+
+	// Reason is shown in the status message
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// MemorySpec configures the memory settings of the servers
+type MemorySpec struct {
+	// AutoTune computes server.memory.heap.*, server.memory.pagecache.size
+	// and the transaction memory limits from the memory limit of the pod,
+	// like neo4j-admin server memory-recommendation does. The settings are
+	// re-computed when the resources change. Entries set in spec.config
+	// still take precedence.
+	// +optional
+	AutoTune bool `json:"autoTune,omitempty"`
+}
+
+// VerticalScalingSpec configures CPU and memory changes of the servers
+type VerticalScalingSpec struct {
+	// Strategy rolls out a change of spec.resources. Staged restarts the
+	// server pods one at a time, leaders last, once every server is
+	// available again. InPlace resizes the running pods without restarting
+	// them on Kubernetes 1.33 and later; it falls back to Staged on older
+	// versions or when the pod template changes in other ways too.
+	// +kubebuilder:validation:Enum=Staged;InPlace
+	// +kubebuilder:default=Staged
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// Recommendations creates a VerticalPodAutoscaler for the servers that
+	// only recommends and never evicts, and copies its recommendation into
+	// status.verticalScaling. Needs the VerticalPodAutoscaler CRDs.
+	// +optional
+	Recommendations bool `json:"recommendations,omitempty"`
+}
+
 // BackupsSpec defines default backup configuration
 type BackupsSpec struct {
 	DefaultStorage *StorageLocation `json:"defaultStorage,omitempty"`
@@ -497,6 +1012,18 @@ type StorageLocation struct {
 	Cloud *CloudBlock `json:"cloud,omitempty"`
 }
 
+// ResourceDependency references a resource, in the same namespace, that has
+// to report Ready before the resource naming it is reconciled
+type ResourceDependency struct {
+	// Kind of the resource
+	// +kubebuilder:validation:Enum=Neo4jEnterpriseCluster;Neo4jEnterpriseStandalone;Neo4jDatabase;Neo4jShardedDatabase;Neo4jPlugin;Neo4jUserSync;Neo4jBackup;Neo4jRestore;Neo4jMigration
+	Kind string `json:"kind"`
+
+	// Name of the resource
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
 // PVCSpec defines PVC configuration for backups
 type PVCSpec struct {
 	// Name of the PVC to use (for referencing existing PVCs)
@@ -533,6 +1060,30 @@ type CloudBlock struct {
 	// Required for MinIO and most self-hosted S3-compatible stores.
 	// Only effective when EndpointURL is set.
 	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+
+	// Proxy routes backup and restore job traffic to object storage through
+	// an HTTP(S) egress proxy.
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+
+	// CABundle references a Secret key holding one or more PEM-encoded CA
+	// certificates that backup and restore jobs add to the JVM truststore.
+	// Use this when object storage or a TLS-intercepting proxy presents a
+	// certificate signed by a private CA.
+	CABundle *SecretKeySelector `json:"caBundle,omitempty"`
+}
+
+// ProxySpec defines HTTP(S) proxy settings for jobs that reach object storage
+type ProxySpec struct {
+	// HTTPProxy is the proxy URL used for plain HTTP requests
+	// (e.g. "http://proxy.corp.example:3128").
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the proxy URL used for HTTPS requests.
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts, domains (".svc") or CIDRs
+	// that bypass the proxy.
+	NoProxy string `json:"noProxy,omitempty"`
 }
 
 // CloudIdentity defines cloud identity configuration
@@ -586,6 +1137,48 @@ type Neo4jEnterpriseClusterStatus struct {
 	// UpgradeStatus provides detailed upgrade progress information
 	UpgradeStatus *UpgradeStatus `json:"upgradeStatus,omitempty"`
 
+	// ScaleDown tracks the servers being removed after spec.topology.servers
+	// was reduced. Cleared once every departing server has been dropped.
+	// +optional
+	ScaleDown *ScaleDownStatus `json:"scaleDown,omitempty"`
+
+	// ServerGroupScaleDown tracks the servers being removed after a server
+	// group was reduced or removed. Entries are cleared once dropped.
+	// +optional
+	ServerGroupScaleDown []DepartingServer `json:"serverGroupScaleDown,omitempty"`
+
+	// RollingRestart tracks a restart of the servers for a configuration
+	// change or a staged resize. Cleared once every server pod runs the new
+	// revision.
+	// +optional
+	RollingRestart *RollingRestartStatus `json:"rollingRestart,omitempty"`
+
+	// TLS records the expiry of the certificates the servers mount and the
+	// reload of renewed ones
+	// +optional
+	TLS *TLSStatus `json:"tls,omitempty"`
+
+	// VerticalScaling tracks an in-place resize of the server pods and the
+	// latest resource recommendation for them
+	// +optional
+	VerticalScaling *VerticalScalingStatus `json:"verticalScaling,omitempty"`
+
+	// Hibernation records a cluster scaled to zero by spec.hibernate.
+	// Cleared once the resumed cluster is ready.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
+	// MaintenanceServers lists the server pods in maintenance. Their servers
+	// are cordoned, left out of the client Service and not counted as
+	// missing by health checks.
+	// +optional
+	MaintenanceServers []string `json:"maintenanceServers,omitempty"`
+
+	// Servers lists the members of the cluster as reported by SHOW SERVERS,
+	// refreshed on every reconcile once Neo4j is reachable.
+	// +optional
+	Servers []ServerStatus `json:"servers,omitempty"`
+
 	// PropertyShardingReady indicates whether property sharding is configured and ready
 	//
 	// This field tracks the operational status of property sharding capability
@@ -606,6 +1199,95 @@ type Neo4jEnterpriseClusterStatus struct {
 	// Populated when spec.queryMonitoring.enabled=true and the cluster is Ready.
 	// +optional
 	Diagnostics *ClusterDiagnosticsStatus `json:"diagnostics,omitempty"`
+
+	// OperatorUser records the user of spec.auth.operatorSecret once it is
+	// set up. The operator connects as the admin until then.
+	// +optional
+	OperatorUser *OperatorUserStatus `json:"operatorUser,omitempty"`
+
+	// QueryMonitoring reports the findings of query monitoring
+	// +optional
+	QueryMonitoring *QueryMonitoringStatus `json:"queryMonitoring,omitempty"`
+}
+
+// QueryMonitoringStatus reports the findings of query monitoring
+type QueryMonitoringStatus struct {
+	// Recommendations are the indexes the slow queries sampled by the
+	// operator lack. Populated when spec.queryMonitoring.indexRecommendations
+	// is enabled.
+	// +optional
+	Recommendations []IndexRecommendation `json:"recommendations,omitempty"`
+
+	// LastAnalyzed is when a sampled slow query was last explained
+	// +optional
+	LastAnalyzed *metav1.Time `json:"lastAnalyzed,omitempty"`
+}
+
+// IndexRecommendation is an index a slow query lacks: its plan scans the
+// nodes of a label and filters them on a property
+type IndexRecommendation struct {
+	// Database the query ran against
+	Database string `json:"database"`
+
+	// Label of the scanned nodes
+	Label string `json:"label"`
+
+	// Property the nodes are filtered on
+	Property string `json:"property"`
+
+	// Statement creates the index
+	Statement string `json:"statement"`
+
+	// Query is the latest slow query the index would serve
+	// +optional
+	Query string `json:"query,omitempty"`
+
+	// LastSeen is when a slow query last lacked the index
+	LastSeen metav1.Time `json:"lastSeen"`
+}
+
+// OperatorUserStatus records the user the operator connects as
+type OperatorUserStatus struct {
+	// Username of the operator user
+	Username string `json:"username"`
+
+	// CredentialsHash is the hash of the credentials the user was set up
+	// with, so that a changed password is set again
+	CredentialsHash string `json:"credentialsHash"`
+
+	// UserManagement tells whether the role of the user holds the user
+	// management a Neo4jUserSync of the deployment needs
+	// +optional
+	UserManagement bool `json:"userManagement,omitempty"`
+}
+
+// ServerStatus is the observed state of one server of the cluster
+type ServerStatus struct {
+	// PodName is the pod running the server
+	PodName string `json:"podName"`
+
+	// ServerID is the ID of the server in SHOW SERVERS
+	ServerID string `json:"serverId"`
+
+	// Role is the role of the server for the system database
+	// ("primary" or "secondary")
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// State is the server lifecycle state (e.g. "Enabled", "Cordoned")
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Health is the server health (e.g. "Available", "Unavailable")
+	// +optional
+	Health string `json:"health,omitempty"`
+
+	// HostedDatabases is the number of databases allocated to the server
+	HostedDatabases int32 `json:"hostedDatabases"`
+
+	// Version is the Neo4j version the server runs
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // AuraFleetManagementStatus reports the registration state of the Aura Fleet Management plugin.
@@ -712,6 +1394,205 @@ type UpgradeStatus struct {
 
 	// LastError contains the last error encountered during upgrade
 	LastError string `json:"lastError,omitempty"`
+
+	// Canary tracks the canary server of a Canary upgrade. Cleared once the
+	// other servers are rolled.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// RolledBackTo is the image the servers were reverted to after the
+	// upgrade failed. The upgrade to targetVersion is not retried until
+	// spec.image.tag changes.
+	// +optional
+	RolledBackTo string `json:"rolledBackTo,omitempty"`
+}
+
+// CanaryStatus tracks the canary server of an upgrade
+type CanaryStatus struct {
+	// Pod is the server pod upgraded first
+	Pod string `json:"pod"`
+
+	// PreviousImage is the image the canary is rolled back to if it fails
+	PreviousImage string `json:"previousImage"`
+
+	// SoakStartTime is when the canary became healthy on the new image
+	// +optional
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+
+	// Restarts is the container restart count of the canary pod when the
+	// soak period started
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+}
+
+// ScaleDownStatus tracks the removal of servers from the cluster
+type ScaleDownStatus struct {
+	// TargetServers is the server count the cluster is scaling down to
+	TargetServers int32 `json:"targetServers"`
+
+	// Servers lists the departing servers
+	Servers []DepartingServer `json:"servers,omitempty"`
+
+	// StartTime is when the scale-down started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message describes what the scale-down is waiting for
+	Message string `json:"message,omitempty"`
+}
+
+// TLSStatus records the certificates of the servers
+type TLSStatus struct {
+	// Certificates lists every certificate Secret the servers mount
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+
+	// ReloadedHash is the hash of the cert-manager issued certificates the
+	// servers run with
+	// +optional
+	ReloadedHash string `json:"reloadedHash,omitempty"`
+
+	// PendingHash is the hash of renewed certificates the servers have not
+	// reloaded yet
+	// +optional
+	PendingHash string `json:"pendingHash,omitempty"`
+
+	// PendingSince is when the renewed certificates were first seen
+	// +optional
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+
+	// RestartHash is the hash of the certificates the servers were
+	// restarted for, because they could not reload them
+	// +optional
+	RestartHash string `json:"restartHash,omitempty"`
+}
+
+// CertificateStatus is the expiry of one certificate Secret
+type CertificateStatus struct {
+	// Scope is the SSL policy scope the certificate serves, default for the
+	// shared certificate
+	Scope string `json:"scope"`
+
+	// SecretName is the Secret holding the certificate
+	SecretName string `json:"secretName"`
+
+	// NotAfter is when the certificate expires
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is when the certificate enters its renewal window:
+	// spec.tls.renewBefore ahead of its expiry, or the last third of its
+	// lifetime
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// RollingRestartStatus tracks a leadership-aware restart of the server pods
+type RollingRestartStatus struct {
+	// ConfigHash is the hash of the configuration being rolled out, or of
+	// the resources for a staged resize
+	ConfigHash string `json:"configHash"`
+
+	// Reason is what the restart rolls out: ConfigChange, Resize for a
+	// change of spec.resources, or Certificates for new or renewed server
+	// certificates
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// StartTime is when the restart started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// LastRestartedPod is the server pod restarted most recently
+	// +optional
+	LastRestartedPod string `json:"lastRestartedPod,omitempty"`
+
+	// LeadershipTransferredFrom is the pod whose database leaderships were
+	// handed to other servers ahead of its restart
+	// +optional
+	LeadershipTransferredFrom string `json:"leadershipTransferredFrom,omitempty"`
+
+	// Message describes what the restart is waiting for
+	Message string `json:"message,omitempty"`
+}
+
+// VerticalScalingStatus reports resizes of the servers and resource
+// recommendations for them
+type VerticalScalingStatus struct {
+	// Resize tracks an in-place resize. Cleared once every server pod runs
+	// with the new resources.
+	// +optional
+	Resize *ResizeStatus `json:"resize,omitempty"`
+
+	// Recommendation is the latest VerticalPodAutoscaler recommendation for
+	// the neo4j container
+	// +optional
+	Recommendation *ResourceRecommendation `json:"recommendation,omitempty"`
+}
+
+// HibernationStatus describes a hibernated cluster
+type HibernationStatus struct {
+	// StartTime is when the cluster started hibernating
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Servers is the number of servers that ran before hibernation
+	Servers int32 `json:"servers"`
+
+	// CheckpointedDatabases lists the databases checkpointed before the
+	// servers were stopped
+	// +optional
+	CheckpointedDatabases []string `json:"checkpointedDatabases,omitempty"`
+
+	// Message describes what hibernation or resuming waits for
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResizeStatus is the progress of an in-place resize
+type ResizeStatus struct {
+	// Resources are the resources the pods are resized to
+	Resources corev1.ResourceRequirements `json:"resources"`
+
+	// StartTime is when the resize started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// LastResizedPod is the server pod resized most recently
+	// +optional
+	LastResizedPod string `json:"lastResizedPod,omitempty"`
+
+	// Message describes what the resize is waiting for
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceRecommendation is a VerticalPodAutoscaler recommendation for the
+// requests of a container
+type ResourceRecommendation struct {
+	// Target is the recommended request
+	// +optional
+	Target corev1.ResourceList `json:"target,omitempty"`
+
+	// LowerBound is the smallest request the autoscaler considers safe
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+
+	// UpperBound is the request beyond which more resources are likely wasted
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+
+	// LastUpdated is when the recommendation last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// DepartingServer is a server whose pod is removed by a scale-down
+type DepartingServer struct {
+	// Pod is the name of the server pod
+	Pod string `json:"pod"`
+
+	// ServerID is the id the server has in SHOW SERVERS
+	ServerID string `json:"serverId,omitempty"`
+
+	// State is Deallocating while databases move off the server, Drained
+	// once it only hosts the system database and Dropped when removed.
+	State string `json:"state,omitempty"`
 }
 
 // UpgradeProgress tracks upgrade progress across servers
@@ -801,8 +1682,9 @@ type ConnectionExamples struct {
 
 // UpgradeStrategySpec defines upgrade strategy configuration
 type UpgradeStrategySpec struct {
-	// Strategy specifies the upgrade strategy
-	// +kubebuilder:validation:Enum=RollingUpgrade;Recreate
+	// Strategy specifies the upgrade strategy. Canary upgrades a single
+	// server first and rolls the others once it passed a soak period.
+	// +kubebuilder:validation:Enum=RollingUpgrade;Recreate;Canary
 	// +kubebuilder:default:=RollingUpgrade
 	Strategy string `json:"strategy,omitempty"`
 
@@ -810,7 +1692,9 @@ type UpgradeStrategySpec struct {
 	// +kubebuilder:default=true
 	PreUpgradeHealthCheck bool `json:"preUpgradeHealthCheck,omitempty"`
 
-	// MaxUnavailableDuringUpgrade specifies max unavailable replicas during upgrade
+	// MaxUnavailableDuringUpgrade specifies max unavailable replicas during
+	// upgrade. A failed upgrade that leaves more servers unready is rolled
+	// back to the previous image.
 	// +kubebuilder:default=1
 	MaxUnavailableDuringUpgrade *int32 `json:"maxUnavailableDuringUpgrade,omitempty"`
 
@@ -833,6 +1717,32 @@ type UpgradeStrategySpec struct {
 	// AutoPauseOnFailure pauses upgrade on failure for manual intervention
 	// +kubebuilder:default=true
 	AutoPauseOnFailure bool `json:"autoPauseOnFailure,omitempty"`
+
+	// SkipConfigCheck skips validating the configuration with the target
+	// image before an upgrade. The upgrade path and the databases are
+	// checked either way.
+	// +optional
+	SkipConfigCheck bool `json:"skipConfigCheck,omitempty"`
+
+	// Canary configures the Canary strategy
+	// +optional
+	Canary *CanaryUpgradeSpec `json:"canary,omitempty"`
+}
+
+// CanaryUpgradeSpec configures how long the canary server runs the new image
+// and what it has to pass before the other servers follow
+type CanaryUpgradeSpec struct {
+	// SoakDuration is how long the canary server has to stay healthy on the
+	// new image
+	// +kubebuilder:default="10m"
+	// +optional
+	SoakDuration string `json:"soakDuration,omitempty"`
+
+	// MaxRestarts is the number of container restarts of the canary pod
+	// tolerated during the soak period
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRestarts int32 `json:"maxRestarts,omitempty"`
 }
 
 // TopologySpreadConfig defines how to distribute Neo4j instances across cluster topology
@@ -895,11 +1805,27 @@ type PodAntiAffinityConfig struct {
 // TopologyConfiguration defines cluster topology requirements
 type TopologyConfiguration struct {
 	// Servers specifies the number of Neo4j servers in the cluster
-	// Servers self-organize and can host databases in primary or secondary mode
-	// +kubebuilder:validation:Required
+	// Servers self-organize and can host databases in primary or secondary mode.
+	// Required unless the deprecated primaries and secondaries are set.
 	// +kubebuilder:validation:Minimum=2
 	// +kubebuilder:validation:Maximum=20
-	Servers int32 `json:"servers"`
+	// +optional
+	Servers int32 `json:"servers,omitempty"`
+
+	// Primaries is the number of primary servers of the pre-5.x topology model.
+	// Deprecated: use servers, and the topology of each Neo4jDatabase to place
+	// primaries and secondaries. The operator converts primaries + secondaries
+	// into servers and clears both fields.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Primaries int32 `json:"primaries,omitempty"`
+
+	// Secondaries is the number of secondary servers of the pre-5.x topology
+	// model.
+	// Deprecated: use servers. See primaries.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Secondaries int32 `json:"secondaries,omitempty"`
 
 	// ServerModeConstraint optionally constrains all servers to a specific mode
 	// Valid values: "PRIMARY", "SECONDARY", "NONE" (default: "NONE")
@@ -924,6 +1850,121 @@ type TopologyConfiguration struct {
 	// EnforceDistribution ensures servers are distributed across topology domains
 	// +optional
 	EnforceDistribution bool `json:"enforceDistribution,omitempty"`
+
+	// ZoneSpread spreads the servers evenly across availability zones and
+	// tags every server with the zone it runs in
+	// +optional
+	ZoneSpread *ZoneSpreadSpec `json:"zoneSpread,omitempty"`
+
+	// ServerGroups adds pools of servers that run in their own StatefulSet,
+	// with their own resources, storage class and scheduling, next to the
+	// servers of the cluster. Every server of a group is tagged with the
+	// group name, so databases and workloads such as GDS can be placed on
+	// them with server tags.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ServerGroups []ServerGroupSpec `json:"serverGroups,omitempty"`
+}
+
+// ConfigOverrideSpec overrides spec.config for some servers. Without
+// serverGroup the ordinals select servers of spec.topology.servers; with it
+// they select servers of the group, all of them when servers is empty.
+type ConfigOverrideSpec struct {
+	// Name of the override
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Servers lists the ordinals of the servers the override applies to
+	// +optional
+	Servers []int32 `json:"servers,omitempty"`
+
+	// ServerGroup is the name of the server group the override applies to
+	// +optional
+	ServerGroup string `json:"serverGroup,omitempty"`
+
+	// Config is merged over spec.config on the selected servers
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	Config map[string]string `json:"config"`
+}
+
+// LifecycleSpec references shell snippets the operator merges into the
+// scripts it generates for the servers. Changing a snippet restarts the
+// servers like a change to spec.config.
+type LifecycleSpec struct {
+	// PreStartScript runs in startup.sh once neo4j.conf is generated in
+	// /tmp/neo4j-config, right before Neo4j starts. A failing script keeps
+	// the server from starting.
+	// +optional
+	PreStartScript *ConfigMapKeySelector `json:"preStartScript,omitempty"`
+
+	// PostStartScript runs in health.sh the first time the server passes
+	// the health check after it started. The server is not healthy until
+	// the script succeeds, and a failing script is retried on the next
+	// check.
+	// +optional
+	PostStartScript *ConfigMapKeySelector `json:"postStartScript,omitempty"`
+}
+
+// ServerGroupSpec is a pool of servers with its own StatefulSet
+type ServerGroupSpec struct {
+	// Name of the group. It names the StatefulSet <cluster>-<name> and is the
+	// server tag of its servers. "server" and "backup" are reserved.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+
+	// Servers is the number of servers in the group
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	Servers int32 `json:"servers"`
+
+	// ModeConstraint constrains the servers of the group to a mode, as
+	// serverModeConstraint does for the cluster
+	// +kubebuilder:validation:Enum=NONE;PRIMARY;SECONDARY
+	// +kubebuilder:default=NONE
+	// +optional
+	ModeConstraint string `json:"modeConstraint,omitempty"`
+
+	// Resources of the Neo4j container, spec.resources when unset. The heap
+	// and page cache of the group are sized from them.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StorageClassName of the data volumes, spec.storage.className when unset
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// NodeSelector of the group, spec.nodeSelector when unset
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations of the group, spec.tolerations when unset
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ZoneSpreadSpec places the servers of a cluster across availability zones
+type ZoneSpreadSpec struct {
+	// Enabled turns zone spreading on
+	Enabled bool `json:"enabled"`
+
+	// TopologyKey is the node label holding the zone
+	// +kubebuilder:default="topology.kubernetes.io/zone"
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// MinZones is the number of zones the servers have to span. New
+	// databases get this many primaries by default.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	MinZones int32 `json:"minZones,omitempty"`
 }
 
 // ServerRoleHint specifies a preferred role constraint for a specific server
@@ -971,6 +2012,145 @@ type Neo4jEnterpriseClusterList struct {
 // DEPRECATED: PluginSpec is deprecated. Use Neo4jPlugin CRD instead.
 // This type is kept for backward compatibility but will be removed in future versions.
 
+// MonitoringSpec configures the scraping of the Neo4j metrics
+type MonitoringSpec struct {
+	// ServiceMonitor generates the scrape configuration of the servers
+	// +optional
+	ServiceMonitor *ServiceMonitorSpec `json:"serviceMonitor,omitempty"`
+
+	// Alerts generates a PrometheusRule with the bundled alerts of the
+	// cluster
+	// +optional
+	Alerts *MonitoringAlertsSpec `json:"alerts,omitempty"`
+
+	// Dashboards generates a ConfigMap with the bundled Grafana dashboard
+	// of the cluster
+	// +optional
+	Dashboards *MonitoringDashboardsSpec `json:"dashboards,omitempty"`
+}
+
+// MonitoringAlertsSpec generates the PrometheusRule of a cluster, alerting
+// on replication lag, a low page cache hit ratio, failed backups and an
+// unhealthy cluster
+type MonitoringAlertsSpec struct {
+	// Enabled creates the PrometheusRule. It requires
+	// spec.monitoring.serviceMonitor, whose relabeling the rules select the
+	// servers by.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Labels of the PrometheusRule, for the ruleSelector of the Prometheus
+	// instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// MonitoringDashboardsSpec generates the Grafana dashboard ConfigMap of a
+// cluster
+type MonitoringDashboardsSpec struct {
+	// Enabled creates the ConfigMap, labelled grafana_dashboard=1 for the
+	// Grafana sidecar. It requires spec.monitoring.serviceMonitor.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Labels of the ConfigMap, in addition to grafana_dashboard=1
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ServiceMonitorSpec generates a Prometheus Operator ServiceMonitor or
+// PodMonitor for the Prometheus endpoint of the servers
+type ServiceMonitorSpec struct {
+	// Enabled turns on the Prometheus endpoint of the servers, adds the
+	// metrics port to the headless Service and generates the monitor
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Kind of monitor generated: a ServiceMonitor scraping the headless
+	// Service, or a PodMonitor scraping the server pods
+	// +kubebuilder:validation:Enum=ServiceMonitor;PodMonitor
+	// +kubebuilder:default=ServiceMonitor
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Interval between scrapes
+	// +kubebuilder:default="30s"
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// ScrapeTimeout of a scrape, the default of Prometheus when unset
+	// +optional
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+
+	// Labels of the monitor, for the serviceMonitorSelector or
+	// podMonitorSelector of the Prometheus instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// LoggingSpec configures the Neo4j logs of the servers
+type LoggingSpec struct {
+	// Format of neo4j.log, debug.log, security.log, query.log and
+	// http.log: the plain text layout of Neo4j, or one JSON object per line
+	// +kubebuilder:validation:Enum=text;json
+	// +kubebuilder:default=text
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// Forwarder runs a Fluent Bit sidecar next to each server that ships
+	// its log files, tagged with the cluster, namespace, pod and database
+	// +optional
+	Forwarder *LogForwarderSpec `json:"forwarder,omitempty"`
+}
+
+// LogForwarderSpec configures the Fluent Bit sidecar, whose configuration
+// the operator generates
+type LogForwarderSpec struct {
+	// Enabled adds the sidecar to the server pods
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Image of Fluent Bit, fluent/fluent-bit:3.2 by default
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+
+	// Logs shipped, all of them by default. Their records are tagged
+	// neo4j.<log>, e.g. neo4j.query, for the match of the outputs.
+	// +kubebuilder:validation:items:Enum=neo4j;debug;security;query;http
+	// +optional
+	Logs []string `json:"logs,omitempty"`
+
+	// Outputs the records are sent to. They are written to the standard
+	// output of the sidecar when unset.
+	// +optional
+	Outputs []LogOutputSpec `json:"outputs,omitempty"`
+
+	// CredentialsSecret names a Secret whose keys are set as environment
+	// variables of the sidecar, for ${VARIABLE} references in the output
+	// properties
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// Resources of the sidecar
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// LogOutputSpec is a Fluent Bit output
+type LogOutputSpec struct {
+	// Name of the output plugin, e.g. forward, loki, es, http or stdout
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Match selects the records sent by their tag, neo4j.* by default
+	// +optional
+	Match string `json:"match,omitempty"`
+
+	// Properties of the output, e.g. host and port
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
 // QueryMonitoringSpec defines query performance monitoring
 type QueryMonitoringSpec struct {
 	// +kubebuilder:default=true
@@ -994,6 +2174,42 @@ type QueryMonitoringSpec struct {
 
 	// Metrics export configuration
 	MetricsExport *QueryMetricsExportConfig `json:"metricsExport,omitempty"`
+
+	// Enforcement terminates runaway queries. Applies to clusters only.
+	// +optional
+	Enforcement *QueryEnforcementSpec `json:"enforcement,omitempty"`
+}
+
+// QueryEnforcementSpec defines the limits the operator enforces on running
+// queries, by terminating the transactions of the queries exceeding them
+type QueryEnforcementSpec struct {
+	// MaxQueryDuration terminates queries running longer, such as "10m"
+	// +optional
+	MaxQueryDuration string `json:"maxQueryDuration,omitempty"`
+
+	// MaxAllocatedMemory terminates queries whose transaction uses more
+	// heap, such as "2Gi". Needs the servers to track transaction memory.
+	// +optional
+	MaxAllocatedMemory string `json:"maxAllocatedMemory,omitempty"`
+
+	// Allowlists exempt the queries of a database from the limits
+	// +optional
+	Allowlists []QueryAllowlist `json:"allowlists,omitempty"`
+}
+
+// QueryAllowlist exempts queries of a database from enforcement: all of
+// them unless users or query patterns are listed
+type QueryAllowlist struct {
+	// Database the allowlist applies to
+	Database string `json:"database"`
+
+	// Users whose queries are exempt
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// QueryPatterns are regular expressions exempting the queries they match
+	// +optional
+	QueryPatterns []string `json:"queryPatterns,omitempty"`
 }
 
 // QuerySamplingConfig defines query sampling
@@ -71,6 +71,11 @@ type Neo4jEnterpriseStandaloneSpec struct {
 	// Query performance monitoring
 	QueryMonitoring *QueryMonitoringSpec `json:"queryMonitoring,omitempty"`
 
+	// Logging configures the format of the Neo4j logs and a sidecar
+	// shipping them
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+
 	// MCP server configuration for this standalone deployment
 	MCP *MCPServerSpec `json:"mcp,omitempty"`
 
@@ -82,6 +87,47 @@ type Neo4jEnterpriseStandaloneSpec struct {
 	// See: https://neo4j.com/docs/aura/fleet-management/
 	// +optional
 	AuraFleetManagement *AuraFleetManagementSpec `json:"auraFleetManagement,omitempty"`
+
+	// Schedule limits when the standalone deployment runs. Outside its active
+	// hours the StatefulSet is scaled to zero; data on the PVC is kept.
+	// +optional
+	Schedule *StandaloneScheduleSpec `json:"schedule,omitempty"`
+}
+
+// StandaloneScheduleSpec defines when a standalone deployment runs
+type StandaloneScheduleSpec struct {
+	// ActiveHours is the daily window in which the instance is running
+	// +optional
+	ActiveHours *ActiveHoursSpec `json:"activeHours,omitempty"`
+}
+
+// ActiveHoursSpec defines a daily running window
+type ActiveHoursSpec struct {
+	// Start of the window as HH:MM on a 24-hour clock
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End of the window as HH:MM on a 24-hour clock. An end at or before the
+	// start closes the window on the following day.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// Days on which the window opens; every day when empty
+	// +kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// TimeZone is the IANA time zone of start and end, e.g. "Europe/Berlin"
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// PreWarm starts the instance this long before the window opens, so it
+	// is ready to serve at start (e.g. "15m")
+	// +optional
+	PreWarm string `json:"preWarm,omitempty"`
 }
 
 // PersistenceSpec defines persistence configuration for standalone deployments
@@ -131,6 +177,23 @@ type Neo4jEnterpriseStandaloneStatus struct {
 
 	// AuraFleetManagementStatus reports the current state of the Aura Fleet Management integration.
 	AuraFleetManagement *AuraFleetManagementStatus `json:"auraFleetManagement,omitempty"`
+
+	// Schedule reports the state of the active hours schedule
+	Schedule *StandaloneScheduleStatus `json:"schedule,omitempty"`
+
+	// OperatorUser records the user of spec.auth.operatorSecret once it is
+	// set up. The operator connects as the admin until then.
+	// +optional
+	OperatorUser *OperatorUserStatus `json:"operatorUser,omitempty"`
+}
+
+// StandaloneScheduleStatus reports where a scheduled standalone is in its cycle
+type StandaloneScheduleStatus struct {
+	// Active is true while the instance is scheduled to run, pre-warm included
+	Active bool `json:"active"`
+
+	// NextTransition is when the instance is next started or stopped
+	NextTransition *metav1.Time `json:"nextTransition,omitempty"`
 }
 
 // StandalonePodStatus provides information about the Neo4j pod
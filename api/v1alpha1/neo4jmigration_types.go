@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Neo4jMigrationSpec defines the desired state of Neo4jMigration
+type Neo4jMigrationSpec struct {
+	// +kubebuilder:validation:Required
+	// Name of the Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone the scripts run against
+	ClusterRef string `json:"clusterRef"`
+
+	// Database the scripts run against
+	// +kubebuilder:default=neo4j
+	Database string `json:"database,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Versioned scripts, in ascending version order. Each script is applied
+	// once; scripts added later are applied on the next reconcile.
+	Scripts []MigrationScript `json:"scripts"`
+
+	// Apply scripts whose version is lower than the latest applied one
+	// instead of failing the migration, e.g. after merging branches that
+	// added scripts independently
+	OutOfOrder bool `json:"outOfOrder,omitempty"`
+
+	// Keep migrating when the content of an applied script changed. By
+	// default such a change fails the migration, as it is never re-applied.
+	IgnoreChecksumMismatch bool `json:"ignoreChecksumMismatch,omitempty"`
+}
+
+// MigrationScript is one versioned Cypher script. Exactly one of cypher and
+// configMapRef must be set.
+type MigrationScript struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)*$`
+	// Version of the script, e.g. "1", "1.1" or "2025.10.1"; versions are
+	// compared numerically segment by segment
+	Version string `json:"version"`
+
+	// Description of the change made by the script
+	Description string `json:"description,omitempty"`
+
+	// Inline Cypher; statements are separated by semicolons
+	Cypher string `json:"cypher,omitempty"`
+
+	// ConfigMap key holding the Cypher of the script
+	ConfigMapRef *MigrationScriptRef `json:"configMapRef,omitempty"`
+}
+
+// MigrationScriptRef references a key of a ConfigMap in the resource's
+// namespace
+type MigrationScriptRef struct {
+	// +kubebuilder:validation:Required
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// Key holding the script
+	Key string `json:"key"`
+}
+
+// Neo4jMigrationStatus defines the observed state of Neo4jMigration
+type Neo4jMigrationStatus struct {
+	// Conditions represent the current state of the migration
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the migration
+	// (Pending, Migrating, Migrated, Failed)
+	Phase string `json:"phase,omitempty"`
+
+	// Message provides additional information about the current state
+	Message string `json:"message,omitempty"`
+
+	// Latest version applied to the database
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// Number of scripts not applied yet
+	PendingScripts int32 `json:"pendingScripts,omitempty"`
+
+	// Per-script results, in the order of spec.scripts
+	Scripts []MigrationScriptStatus `json:"scripts,omitempty"`
+
+	// Time scripts were last applied
+	LastMigrationTime *metav1.Time `json:"lastMigrationTime,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed Neo4jMigration
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// MigrationScriptStatus is the outcome of one script
+type MigrationScriptStatus struct {
+	// Version of the script
+	Version string `json:"version"`
+
+	// Description of the script
+	Description string `json:"description,omitempty"`
+
+	// State of the script: Applied, Pending, Failed or ChecksumMismatch
+	State string `json:"state"`
+
+	// SHA-256 checksum of the script's statements
+	Checksum string `json:"checksum,omitempty"`
+
+	// Time the script was applied
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty"`
+
+	// Time it took to apply the script, in milliseconds
+	ExecutionTimeMillis int64 `json:"executionTimeMillis,omitempty"`
+
+	// Message describes the failure of the script
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.clusterRef`
+// +kubebuilder:printcolumn:name="Database",type=string,JSONPath=`.spec.database`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.currentVersion`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pendingScripts`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Neo4jMigration is the Schema for the neo4jmigrations API. It applies
+// versioned Cypher scripts to a database exactly once, recording the applied
+// versions in history nodes of the database.
+type Neo4jMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Neo4jMigrationSpec   `json:"spec,omitempty"`
+	Status Neo4jMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Neo4jMigrationList contains a list of Neo4jMigration
+type Neo4jMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Neo4jMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Neo4jMigration{}, &Neo4jMigrationList{})
+}
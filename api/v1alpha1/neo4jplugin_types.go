@@ -55,11 +55,15 @@ type Neo4jPluginSpec struct {
 
 	// Resource requirements for the plugin
 	Resources *PluginResourceRequirements `json:"resources,omitempty"`
+	// DependsOn lists resources that must report Ready before this one is
+	// reconciled
+	// +optional
+	DependsOn []ResourceDependency `json:"dependsOn,omitempty"`
 }
 
 // PluginSource defines how to obtain the plugin
 type PluginSource struct {
-	// +kubebuilder:validation:Enum=official;community;custom;url
+	// +kubebuilder:validation:Enum=official;community;custom;url;oci;pvc
 	// +kubebuilder:default=official
 	// Source type
 	Type string `json:"type,omitempty"`
@@ -67,6 +71,18 @@ type PluginSource struct {
 	// URL for custom plugins
 	URL string `json:"url,omitempty"`
 
+	// Image reference of an OCI image containing the plugin JAR (type oci).
+	// The image must provide /bin/sh and cp, e.g. an image built FROM busybox.
+	Image string `json:"image,omitempty"`
+
+	// Name of an existing PersistentVolumeClaim in the plugin's namespace
+	// holding a pre-staged plugin JAR (type pvc)
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Path of the JAR inside the image (type oci) or relative to the root of
+	// the claim (type pvc)
+	Path string `json:"path,omitempty"`
+
 	// Checksum for verification
 	Checksum string `json:"checksum,omitempty"`
 
@@ -120,6 +136,11 @@ type PluginLicense struct {
 
 	// Offline license file
 	LicenseFile string `json:"licenseFile,omitempty"`
+
+	// Secret key holding the license file. It is mounted read-only under
+	// /licenses/<plugin>/ on the Neo4j servers, and for GDS and Bloom the
+	// plugin's license file setting is pointed at it.
+	SecretRef *SecretKeySelector `json:"secretRef,omitempty"`
 }
 
 // PluginSecurity defines security settings for plugins
@@ -166,6 +187,10 @@ type Neo4jPluginStatus struct {
 	// Installation time
 	InstallationTime *metav1.Time `json:"installationTime,omitempty"`
 
+	// Servers reports the install state of the plugin on every Neo4j server,
+	// so a rollout that stopped part way is visible per pod
+	Servers []PluginServerStatus `json:"servers,omitempty"`
+
 	// Plugin health status
 	Health *PluginHealth `json:"health,omitempty"`
 
@@ -176,6 +201,24 @@ type Neo4jPluginStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// PluginServerStatus is the install state of the plugin on one server
+type PluginServerStatus struct {
+	// Pod running the server
+	Pod string `json:"pod"`
+
+	// Neo4j server ID as listed by SHOW SERVERS
+	ServerID string `json:"serverId,omitempty"`
+
+	// Version reported by the server, if the plugin exposes one
+	InstalledVersion string `json:"installedVersion,omitempty"`
+
+	// Whether the plugin's procedures or component are registered
+	Loaded bool `json:"loaded"`
+
+	// Why the state could not be determined, e.g. the pod is not ready
+	Message string `json:"message,omitempty"`
+}
+
 // PluginHealth defines plugin health information
 type PluginHealth struct {
 	// Plugin status
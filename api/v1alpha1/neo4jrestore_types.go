@@ -164,6 +164,12 @@ type RestoreOptionsSpec struct {
 
 	// Post-restore hooks
 	PostRestore *RestoreHooks `json:"postRestore,omitempty"`
+
+	// RestoreSecurity replays the users, roles and privileges stored in the
+	// backup into the target cluster once the data is restored, using the
+	// metadata script neo4j-admin writes during restore. Requires an online
+	// restore (stopCluster unset).
+	RestoreSecurity bool `json:"restoreSecurity,omitempty"`
 }
 
 // RestoreHooks defines hooks to run before/after restore
@@ -270,6 +276,10 @@ type Neo4jRestoreStatus struct {
 	// Backup information that was restored
 	BackupInfo *RestoreBackupInfo `json:"backupInfo,omitempty"`
 
+	// SecurityRestored is true once the backup's users, roles and privileges
+	// were replayed into the target cluster
+	SecurityRestored bool `json:"securityRestored,omitempty"`
+
 	// ObservedGeneration reflects the generation of the most recently observed Neo4jRestore
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Neo4jUserSyncSpec defines the desired state of Neo4jUserSync
+type Neo4jUserSyncSpec struct {
+	// +kubebuilder:validation:Required
+	// Name of the Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone whose users are managed
+	ClusterRef string `json:"clusterRef"`
+
+	// +kubebuilder:validation:Required
+	// Source of the user list
+	Source UserSyncSource `json:"source"`
+
+	// Roles granted to the members of each group of a "groups" snapshot,
+	// keyed by group name. Groups without an entry grant no roles.
+	GroupRoles map[string][]string `json:"groupRoles,omitempty"`
+
+	// Roles granted to every synced user in addition to their own
+	DefaultRoles []string `json:"defaultRoles,omitempty"`
+
+	// Secret whose "password" key is the initial password of users listed
+	// without one; they must change it at first login. When unset such users
+	// get a random password and sign in through an external identity provider
+	// or after an administrator resets it.
+	InitialPasswordSecret string `json:"initialPasswordSecret,omitempty"`
+
+	// What happens to users synced by this resource once the source no longer
+	// lists them: Suspend keeps them suspended, Delete drops them and Retain
+	// leaves them untouched and unmanaged
+	// +kubebuilder:validation:Enum=Suspend;Delete;Retain
+	// +kubebuilder:default=Suspend
+	RemovalPolicy string `json:"removalPolicy,omitempty"`
+
+	// Maximum number of users changed per reconcile; the remaining users are
+	// changed in the following reconciles
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:default=50
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// How often the source is compared with the DBMS again (Go duration)
+	// +kubebuilder:default="10m"
+	SyncInterval string `json:"syncInterval,omitempty"`
+	// DependsOn lists resources that must report Ready before this one is
+	// reconciled
+	// +optional
+	DependsOn []ResourceDependency `json:"dependsOn,omitempty"`
+}
+
+// UserSyncSource locates the document listing the users. Exactly one of
+// configMapRef and secretRef must be set.
+type UserSyncSource struct {
+	// Format of the document: "users" is a list of users with their roles,
+	// "groups" maps group names to their members, e.g. an LDAP or OIDC group
+	// membership export
+	// +kubebuilder:validation:Enum=users;groups
+	// +kubebuilder:default=users
+	Format string `json:"format,omitempty"`
+
+	// ConfigMap key holding the document
+	ConfigMapRef *UserSyncSourceRef `json:"configMapRef,omitempty"`
+
+	// Secret key holding the document; required for per-user passwords
+	SecretRef *UserSyncSourceRef `json:"secretRef,omitempty"`
+}
+
+// UserSyncSourceRef references a key of a ConfigMap or Secret in the
+// resource's namespace
+type UserSyncSourceRef struct {
+	// +kubebuilder:validation:Required
+	// Name of the ConfigMap or Secret
+	Name string `json:"name"`
+
+	// Key holding the YAML or JSON document
+	// +kubebuilder:default="users.yaml"
+	Key string `json:"key,omitempty"`
+}
+
+// Neo4jUserSyncStatus defines the observed state of Neo4jUserSync
+type Neo4jUserSyncStatus struct {
+	// Conditions represent the current state of the sync
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the sync
+	// (Pending, Syncing, Synced, Degraded, Failed)
+	Phase string `json:"phase,omitempty"`
+
+	// Message provides additional information about the current state
+	Message string `json:"message,omitempty"`
+
+	// Time the source was last compared with the DBMS
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Number of users listed by the source
+	DesiredUsers int32 `json:"desiredUsers,omitempty"`
+
+	// Number of users with changes left for the following batches
+	PendingUsers int32 `json:"pendingUsers,omitempty"`
+
+	// Number of users whose last change failed
+	FailedUsers int32 `json:"failedUsers,omitempty"`
+
+	// Per-user results, sorted by username. The users listed here are the
+	// ones managed by this resource.
+	Users []UserSyncResult `json:"users,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed Neo4jUserSync
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// UserSyncResult is the outcome of syncing one user
+type UserSyncResult struct {
+	// Username of the Neo4j user
+	Username string `json:"username"`
+
+	// State of the user: Synced, Pending, Removed (suspended after it was
+	// removed from the source) or Failed
+	State string `json:"state"`
+
+	// Roles granted to the user by this resource
+	Roles []string `json:"roles,omitempty"`
+
+	// Message describes the last change or failure
+	Message string `json:"message,omitempty"`
+
+	// Time of the last change made to the user
+	LastChanged *metav1.Time `json:"lastChanged,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.clusterRef`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Users",type=integer,JSONPath=`.status.desiredUsers`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pendingUsers`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failedUsers`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Neo4jUserSync is the Schema for the neo4jusersyncs API. It keeps the native
+// users of a deployment in line with a user list or group membership
+// snapshot kept in a ConfigMap or Secret.
+type Neo4jUserSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Neo4jUserSyncSpec   `json:"spec,omitempty"`
+	Status Neo4jUserSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Neo4jUserSyncList contains a list of Neo4jUserSync
+type Neo4jUserSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Neo4jUserSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Neo4jUserSync{}, &Neo4jUserSyncList{})
+}
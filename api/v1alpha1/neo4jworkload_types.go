@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Neo4jWorkloadSpec defines the desired state of Neo4jWorkload
+type Neo4jWorkloadSpec struct {
+	// +kubebuilder:validation:Required
+	// Name of the Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone to load
+	ClusterRef string `json:"clusterRef"`
+
+	// Database the queries run against
+	// +kubebuilder:default=neo4j
+	Database string `json:"database,omitempty"`
+
+	// How long the load generator runs (Go duration, e.g. "10m")
+	// +kubebuilder:default="5m"
+	Duration string `json:"duration,omitempty"`
+
+	// Number of concurrent client sessions
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	// +kubebuilder:default=4
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// Percentage of client sessions that run ReadQuery; the rest run WriteQuery
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=80
+	ReadPercent *int32 `json:"readPercent,omitempty"`
+
+	// Cypher statement used for reads. Defaults to a lookup of the nodes
+	// created by the default WriteQuery.
+	ReadQuery string `json:"readQuery,omitempty"`
+
+	// Cypher statement used for writes. Defaults to creating a
+	// :Neo4jWorkloadProbe node.
+	WriteQuery string `json:"writeQuery,omitempty"`
+
+	// Load generator image. Defaults to the image of the target deployment,
+	// which provides cypher-shell.
+	Image *ImageSpec `json:"image,omitempty"`
+
+	// Resources of the load generator pod
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// Neo4jWorkloadStatus defines the observed state of Neo4jWorkload
+type Neo4jWorkloadStatus struct {
+	// Conditions represent the current state of the workload
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase represents the current phase of the workload
+	// (Pending, Running, Completed, Failed)
+	Phase string `json:"phase,omitempty"`
+
+	// Message provides additional information about the current state
+	Message string `json:"message,omitempty"`
+
+	// Start time of the load generator
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Completion time of the load generator
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Results reported by the load generator once it finished
+	Results *WorkloadResults `json:"results,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed Neo4jWorkload
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// WorkloadResults summarises a finished load generator run
+type WorkloadResults struct {
+	// Duration the load generator actually ran
+	Duration string `json:"duration,omitempty"`
+
+	// Number of successful transactions
+	Transactions int64 `json:"transactions,omitempty"`
+
+	// Number of successful read transactions
+	Reads int64 `json:"reads,omitempty"`
+
+	// Number of successful write transactions
+	Writes int64 `json:"writes,omitempty"`
+
+	// Number of failed transactions
+	Errors int64 `json:"errors,omitempty"`
+
+	// Successful transactions per second, e.g. "412.3"
+	Throughput string `json:"throughput,omitempty"`
+
+	// Median transaction latency, e.g. "3ms"
+	LatencyP50 string `json:"latencyP50,omitempty"`
+
+	// 95th percentile transaction latency
+	LatencyP95 string `json:"latencyP95,omitempty"`
+
+	// 99th percentile transaction latency
+	LatencyP99 string `json:"latencyP99,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.clusterRef`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Throughput",type=string,JSONPath=`.status.results.throughput`
+// +kubebuilder:printcolumn:name="P99",type=string,JSONPath=`.status.results.latencyP99`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Neo4jWorkload is the Schema for the neo4jworkloads API. It runs a synthetic
+// Cypher load against a deployment, e.g. to soak-test an upgrade or compare
+// storage classes.
+type Neo4jWorkload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Neo4jWorkloadSpec   `json:"spec,omitempty"`
+	Status Neo4jWorkloadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Neo4jWorkloadList contains a list of Neo4jWorkload
+type Neo4jWorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Neo4jWorkload `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Neo4jWorkload{}, &Neo4jWorkloadList{})
+}
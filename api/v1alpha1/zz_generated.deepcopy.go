@@ -22,10 +22,71 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveHoursSpec) DeepCopyInto(out *ActiveHoursSpec) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveHoursSpec.
+func (in *ActiveHoursSpec) DeepCopy() *ActiveHoursSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveHoursSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminExternalSecretSpec) DeepCopyInto(out *AdminExternalSecretSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminExternalSecretSpec.
+func (in *AdminExternalSecretSpec) DeepCopy() *AdminExternalSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminExternalSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasDriverSettings) DeepCopyInto(out *AliasDriverSettings) {
+	*out = *in
+	if in.SSLEnforced != nil {
+		in, out := &in.SSLEnforced, &out.SSLEnforced
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConnectionPoolMaxSize != nil {
+		in, out := &in.ConnectionPoolMaxSize, &out.ConnectionPoolMaxSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasDriverSettings.
+func (in *AliasDriverSettings) DeepCopy() *AliasDriverSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasDriverSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuraFleetManagementSpec) DeepCopyInto(out *AuraFleetManagementSpec) {
 	*out = *in
@@ -88,6 +149,11 @@ func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 		*out = new(ExternalSecretsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalSecret != nil {
+		in, out := &in.ExternalSecret, &out.ExternalSecret
+		*out = new(AdminExternalSecretSpec)
+		**out = **in
+	}
 	if in.PasswordPolicy != nil {
 		in, out := &in.PasswordPolicy, &out.PasswordPolicy
 		*out = new(PasswordPolicySpec)
@@ -108,6 +174,11 @@ func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 		*out = new(KerberosAuthSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCAuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSpec.
@@ -142,6 +213,29 @@ func (in *AutoCreateSpec) DeepCopy() *AutoCreateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBenchmark) DeepCopyInto(out *BackupBenchmark) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBenchmark.
+func (in *BackupBenchmark) DeepCopy() *BackupBenchmark {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBenchmark)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupOptions) DeepCopyInto(out *BackupOptions) {
 	*out = *in
@@ -167,6 +261,33 @@ func (in *BackupOptions) DeepCopy() *BackupOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPortSpec) DeepCopyInto(out *BackupPortSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedClients != nil {
+		in, out := &in.AllowedClients, &out.AllowedClients
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPortSpec.
+func (in *BackupPortSpec) DeepCopy() *BackupPortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRun) DeepCopyInto(out *BackupRun) {
 	*out = *in
@@ -282,6 +403,180 @@ func (in *BaseBackupSource) DeepCopy() *BaseBackupSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCConnectorSpec) DeepCopyInto(out *CDCConnectorSpec) {
+	*out = *in
+	in.Image.DeepCopyInto(&out.Image)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(CDCKafkaAuth)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WorkerConfig != nil {
+		in, out := &in.WorkerConfig, &out.WorkerConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCConnectorSpec.
+func (in *CDCConnectorSpec) DeepCopy() *CDCConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCDatabase) DeepCopyInto(out *CDCDatabase) {
+	*out = *in
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]CDCTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCDatabase.
+func (in *CDCDatabase) DeepCopy() *CDCDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCDatabaseStatus) DeepCopyInto(out *CDCDatabaseStatus) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCDatabaseStatus.
+func (in *CDCDatabaseStatus) DeepCopy() *CDCDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCKafkaAuth) DeepCopyInto(out *CDCKafkaAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCKafkaAuth.
+func (in *CDCKafkaAuth) DeepCopy() *CDCKafkaAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCKafkaAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCTopic) DeepCopyInto(out *CDCTopic) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCTopic.
+func (in *CDCTopic) DeepCopy() *CDCTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	if in.SoakStartTime != nil {
+		in, out := &in.SoakStartTime, &out.SoakStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryUpgradeSpec) DeepCopyInto(out *CanaryUpgradeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryUpgradeSpec.
+func (in *CanaryUpgradeSpec) DeepCopy() *CanaryUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSubject) DeepCopyInto(out *CertificateSubject) {
 	*out = *in
@@ -330,6 +625,16 @@ func (in *CloudBlock) DeepCopyInto(out *CloudBlock) {
 		*out = new(CloudIdentity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBlock.
@@ -362,6 +667,26 @@ func (in *CloudIdentity) DeepCopy() *CloudIdentity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassImagePolicy) DeepCopyInto(out *ClusterClassImagePolicy) {
+	*out = *in
+	if in.AllowedRepositories != nil {
+		in, out := &in.AllowedRepositories, &out.AllowedRepositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassImagePolicy.
+func (in *ClusterClassImagePolicy) DeepCopy() *ClusterClassImagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassImagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterDiagnosticsStatus) DeepCopyInto(out *ClusterDiagnosticsStatus) {
 	*out = *in
@@ -391,6 +716,21 @@ func (in *ClusterDiagnosticsStatus) DeepCopy() *ClusterDiagnosticsStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTLSSpec) DeepCopyInto(out *ClusterTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTLSSpec.
+func (in *ClusterTLSSpec) DeepCopy() *ClusterTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CompressionConfig) DeepCopyInto(out *CompressionConfig) {
 	*out = *in
@@ -422,22 +762,49 @@ func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionExamples) DeepCopyInto(out *ConnectionExamples) {
+func (in *ConfigOverrideSpec) DeepCopyInto(out *ConfigOverrideSpec) {
 	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionExamples.
-func (in *ConnectionExamples) DeepCopy() *ConnectionExamples {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigOverrideSpec.
+func (in *ConfigOverrideSpec) DeepCopy() *ConfigOverrideSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionExamples)
+	out := new(ConfigOverrideSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSpec) DeepCopyInto(out *ContainerSpec) {
+func (in *ConnectionExamples) DeepCopyInto(out *ConnectionExamples) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionExamples.
+func (in *ConnectionExamples) DeepCopy() *ConnectionExamples {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionExamples)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSpec) DeepCopyInto(out *ContainerSpec) {
 	*out = *in
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
@@ -468,6 +835,26 @@ func (in *ContainerSpec) DeepCopy() *ContainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseAlias) DeepCopyInto(out *DatabaseAlias) {
+	*out = *in
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RemoteAliasTarget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseAlias.
+func (in *DatabaseAlias) DeepCopy() *DatabaseAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseDiagnosticInfo) DeepCopyInto(out *DatabaseDiagnosticInfo) {
 	*out = *in
@@ -483,6 +870,143 @@ func (in *DatabaseDiagnosticInfo) DeepCopy() *DatabaseDiagnosticInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseDropPolicy) DeepCopyInto(out *DatabaseDropPolicy) {
+	*out = *in
+	if in.FinalDump != nil {
+		in, out := &in.FinalDump, &out.FinalDump
+		*out = new(DatabaseFinalDump)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseDropPolicy.
+func (in *DatabaseDropPolicy) DeepCopy() *DatabaseDropPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseDropPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseFinalDump) DeepCopyInto(out *DatabaseFinalDump) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Cloud != nil {
+		in, out := &in.Cloud, &out.Cloud
+		*out = new(CloudBlock)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseFinalDump.
+func (in *DatabaseFinalDump) DeepCopy() *DatabaseFinalDump {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseFinalDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSchema) DeepCopyInto(out *DatabaseSchema) {
+	*out = *in
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
+		*out = make([]SchemaIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]SchemaConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSchema.
+func (in *DatabaseSchema) DeepCopy() *DatabaseSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSchemaStatus) DeepCopyInto(out *DatabaseSchemaStatus) {
+	*out = *in
+	if in.Drifted != nil {
+		in, out := &in.Drifted, &out.Drifted
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unmanaged != nil {
+		in, out := &in.Unmanaged, &out.Unmanaged
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSchemaStatus.
+func (in *DatabaseSchemaStatus) DeepCopy() *DatabaseSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSeed) DeepCopyInto(out *DatabaseSeed) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSeed.
+func (in *DatabaseSeed) DeepCopy() *DatabaseSeed {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSeed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSeedStatus) DeepCopyInto(out *DatabaseSeedStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSeedStatus.
+func (in *DatabaseSeedStatus) DeepCopy() *DatabaseSeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseTopology) DeepCopyInto(out *DatabaseTopology) {
 	*out = *in
@@ -498,6 +1022,21 @@ func (in *DatabaseTopology) DeepCopy() *DatabaseTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DepartingServer) DeepCopyInto(out *DepartingServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DepartingServer.
+func (in *DepartingServer) DeepCopy() *DepartingServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DepartingServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -660,6 +1199,30 @@ func (in *ExternalSecretsConfig) DeepCopy() *ExternalSecretsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CheckpointedDatabases != nil {
+		in, out := &in.CheckpointedDatabases, &out.CheckpointedDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 	*out = *in
@@ -680,6 +1243,22 @@ func (in *ImageSpec) DeepCopy() *ImageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexRecommendation) DeepCopyInto(out *IndexRecommendation) {
+	*out = *in
+	in.LastSeen.DeepCopyInto(&out.LastSeen)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexRecommendation.
+func (in *IndexRecommendation) DeepCopy() *IndexRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
 	*out = *in
@@ -833,6 +1412,16 @@ func (in *KerberosAuthSpec) DeepCopyInto(out *KerberosAuthSpec) {
 		*out = new(KerberosKeytabSpec)
 		**out = **in
 	}
+	if in.KDCs != nil {
+		in, out := &in.KDCs, &out.KDCs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KerberosAuthSpec.
@@ -868,6 +1457,11 @@ func (in *LDAPAuthSpec) DeepCopyInto(out *LDAPAuthSpec) {
 		*out = new(LDAPServerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BindCredentials != nil {
+		in, out := &in.BindCredentials, &out.BindCredentials
+		*out = new(LDAPBindCredentialsSpec)
+		**out = **in
+	}
 	if in.UserSearch != nil {
 		in, out := &in.UserSearch, &out.UserSearch
 		*out = new(LDAPSearchSpec)
@@ -878,6 +1472,22 @@ func (in *LDAPAuthSpec) DeepCopyInto(out *LDAPAuthSpec) {
 		*out = new(LDAPSearchSpec)
 		**out = **in
 	}
+	if in.GroupToRoleMapping != nil {
+		in, out := &in.GroupToRoleMapping, &out.GroupToRoleMapping
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAuthSpec.
@@ -890,6 +1500,21 @@ func (in *LDAPAuthSpec) DeepCopy() *LDAPAuthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBindCredentialsSpec) DeepCopyInto(out *LDAPBindCredentialsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBindCredentialsSpec.
+func (in *LDAPBindCredentialsSpec) DeepCopy() *LDAPBindCredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBindCredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LDAPSearchSpec) DeepCopyInto(out *LDAPSearchSpec) {
 	*out = *in
@@ -926,120 +1551,239 @@ func (in *LDAPServerSpec) DeepCopy() *LDAPServerSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPAuthSpec) DeepCopyInto(out *MCPAuthSpec) {
+func (in *LicenseSpec) DeepCopyInto(out *LicenseSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuthSpec.
-func (in *MCPAuthSpec) DeepCopy() *MCPAuthSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseSpec.
+func (in *LicenseSpec) DeepCopy() *LicenseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPAuthSpec)
+	out := new(LicenseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPHTTPConfig) DeepCopyInto(out *MCPHTTPConfig) {
+func (in *LifecycleSpec) DeepCopyInto(out *LifecycleSpec) {
 	*out = *in
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(MCPTLSSpec)
+	if in.PreStartScript != nil {
+		in, out := &in.PreStartScript, &out.PreStartScript
+		*out = new(ConfigMapKeySelector)
 		**out = **in
 	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(MCPServiceSpec)
-		(*in).DeepCopyInto(*out)
+	if in.PostStartScript != nil {
+		in, out := &in.PostStartScript, &out.PostStartScript
+		*out = new(ConfigMapKeySelector)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHTTPConfig.
-func (in *MCPHTTPConfig) DeepCopy() *MCPHTTPConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleSpec.
+func (in *LifecycleSpec) DeepCopy() *LifecycleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPHTTPConfig)
+	out := new(LifecycleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+func (in *LogForwarderSpec) DeepCopyInto(out *LogForwarderSpec) {
 	*out = *in
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(ImageSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SchemaSampleSize != nil {
-		in, out := &in.SchemaSampleSize, &out.SchemaSampleSize
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Telemetry != nil {
-		in, out := &in.Telemetry, &out.Telemetry
-		*out = new(bool)
-		**out = **in
-	}
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(MCPHTTPConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(MCPAuthSpec)
-		**out = **in
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]LogOutputSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SecurityContext != nil {
-		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(SecurityContextSpec)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
-func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarderSpec.
+func (in *LogForwarderSpec) DeepCopy() *LogForwarderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerSpec)
+	out := new(LogForwarderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServiceSpec) DeepCopyInto(out *MCPServiceSpec) {
+func (in *LogOutputSpec) DeepCopyInto(out *LogOutputSpec) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.LoadBalancerSourceRanges != nil {
-		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
-		*out = make([]string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogOutputSpec.
+func (in *LogOutputSpec) DeepCopy() *LogOutputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogOutputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
+	*out = *in
+	if in.Forwarder != nil {
+		in, out := &in.Forwarder, &out.Forwarder
+		*out = new(LogForwarderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpec.
+func (in *LoggingSpec) DeepCopy() *LoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAuthSpec) DeepCopyInto(out *MCPAuthSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuthSpec.
+func (in *MCPAuthSpec) DeepCopy() *MCPAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPHTTPConfig) DeepCopyInto(out *MCPHTTPConfig) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MCPTLSSpec)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(MCPServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHTTPConfig.
+func (in *MCPHTTPConfig) DeepCopy() *MCPHTTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPHTTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaSampleSize != nil {
+		in, out := &in.SchemaSampleSize, &out.SchemaSampleSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(MCPHTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(MCPAuthSpec)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(SecurityContextSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
+func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServiceSpec) DeepCopyInto(out *MCPServiceSpec) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	if in.Ingress != nil {
@@ -1069,37 +1813,460 @@ func (in *MCPTLSSpec) DeepCopyInto(out *MCPTLSSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPTLSSpec.
-func (in *MCPTLSSpec) DeepCopy() *MCPTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPTLSSpec.
+func (in *MCPTLSSpec) DeepCopy() *MCPTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
+func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySpec) DeepCopyInto(out *MemorySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySpec.
+func (in *MemorySpec) DeepCopy() *MemorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationScript) DeepCopyInto(out *MigrationScript) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(MigrationScriptRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationScript.
+func (in *MigrationScript) DeepCopy() *MigrationScript {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationScript)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationScriptRef) DeepCopyInto(out *MigrationScriptRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationScriptRef.
+func (in *MigrationScriptRef) DeepCopy() *MigrationScriptRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationScriptRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationScriptStatus) DeepCopyInto(out *MigrationScriptStatus) {
+	*out = *in
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationScriptStatus.
+func (in *MigrationScriptStatus) DeepCopy() *MigrationScriptStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationScriptStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringAlertsSpec) DeepCopyInto(out *MonitoringAlertsSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringAlertsSpec.
+func (in *MonitoringAlertsSpec) DeepCopy() *MonitoringAlertsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringAlertsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringDashboardsSpec) DeepCopyInto(out *MonitoringDashboardsSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringDashboardsSpec.
+func (in *MonitoringDashboardsSpec) DeepCopy() *MonitoringDashboardsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringDashboardsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+	*out = *in
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(ServiceMonitorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(MonitoringAlertsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(MonitoringDashboardsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
+func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jBackup) DeepCopyInto(out *Neo4jBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackup.
+func (in *Neo4jBackup) DeepCopy() *Neo4jBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jBackupList) DeepCopyInto(out *Neo4jBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Neo4jBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupList.
+func (in *Neo4jBackupList) DeepCopy() *Neo4jBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jBackupSpec) DeepCopyInto(out *Neo4jBackupSpec) {
+	*out = *in
+	out.Target = in.Target
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Cloud != nil {
+		in, out := &in.Cloud, &out.Cloud
+		*out = new(CloudBlock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionPolicy)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(BackupOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ResourceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupSpec.
+func (in *Neo4jBackupSpec) DeepCopy() *Neo4jBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jBackupStatus) DeepCopyInto(out *Neo4jBackupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = new(BackupStats)
+		**out = **in
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]BackupRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Benchmark != nil {
+		in, out := &in.Benchmark, &out.Benchmark
+		*out = new(BackupBenchmark)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupStatus.
+func (in *Neo4jBackupStatus) DeepCopy() *Neo4jBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jCDC) DeepCopyInto(out *Neo4jCDC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jCDC.
+func (in *Neo4jCDC) DeepCopy() *Neo4jCDC {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jCDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jCDC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jCDCList) DeepCopyInto(out *Neo4jCDCList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Neo4jCDC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jCDCList.
+func (in *Neo4jCDCList) DeepCopy() *Neo4jCDCList {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jCDCList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jCDCList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jCDCSpec) DeepCopyInto(out *Neo4jCDCSpec) {
+	*out = *in
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]CDCDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Connector != nil {
+		in, out := &in.Connector, &out.Connector
+		*out = new(CDCConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jCDCSpec.
+func (in *Neo4jCDCSpec) DeepCopy() *Neo4jCDCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jCDCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jCDCStatus) DeepCopyInto(out *Neo4jCDCStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]CDCDatabaseStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastLagCheckTime != nil {
+		in, out := &in.LastLagCheckTime, &out.LastLagCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jCDCStatus.
+func (in *Neo4jCDCStatus) DeepCopy() *Neo4jCDCStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPTLSSpec)
+	out := new(Neo4jCDCStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jBackup) DeepCopyInto(out *Neo4jBackup) {
+func (in *Neo4jClusterClass) DeepCopyInto(out *Neo4jClusterClass) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackup.
-func (in *Neo4jBackup) DeepCopy() *Neo4jBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jClusterClass.
+func (in *Neo4jClusterClass) DeepCopy() *Neo4jClusterClass {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jBackup)
+	out := new(Neo4jClusterClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Neo4jBackup) DeepCopyObject() runtime.Object {
+func (in *Neo4jClusterClass) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1107,31 +2274,31 @@ func (in *Neo4jBackup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jBackupList) DeepCopyInto(out *Neo4jBackupList) {
+func (in *Neo4jClusterClassList) DeepCopyInto(out *Neo4jClusterClassList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Neo4jBackup, len(*in))
+		*out = make([]Neo4jClusterClass, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupList.
-func (in *Neo4jBackupList) DeepCopy() *Neo4jBackupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jClusterClassList.
+func (in *Neo4jClusterClassList) DeepCopy() *Neo4jClusterClassList {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jBackupList)
+	out := new(Neo4jClusterClassList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Neo4jBackupList) DeepCopyObject() runtime.Object {
+func (in *Neo4jClusterClassList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1139,79 +2306,27 @@ func (in *Neo4jBackupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jBackupSpec) DeepCopyInto(out *Neo4jBackupSpec) {
+func (in *Neo4jClusterClassSpec) DeepCopyInto(out *Neo4jClusterClassSpec) {
 	*out = *in
-	out.Target = in.Target
-	in.Storage.DeepCopyInto(&out.Storage)
-	if in.Cloud != nil {
-		in, out := &in.Cloud, &out.Cloud
-		*out = new(CloudBlock)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Retention != nil {
-		in, out := &in.Retention, &out.Retention
-		*out = new(RetentionPolicy)
-		**out = **in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.AllowedOverrides != nil {
+		in, out := &in.AllowedOverrides, &out.AllowedOverrides
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = new(BackupOptions)
+	if in.ImagePolicy != nil {
+		in, out := &in.ImagePolicy, &out.ImagePolicy
+		*out = new(ClusterClassImagePolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupSpec.
-func (in *Neo4jBackupSpec) DeepCopy() *Neo4jBackupSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(Neo4jBackupSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jBackupStatus) DeepCopyInto(out *Neo4jBackupStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastRunTime != nil {
-		in, out := &in.LastRunTime, &out.LastRunTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LastSuccessTime != nil {
-		in, out := &in.LastSuccessTime, &out.LastSuccessTime
-		*out = (*in).DeepCopy()
-	}
-	if in.NextRunTime != nil {
-		in, out := &in.NextRunTime, &out.NextRunTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Stats != nil {
-		in, out := &in.Stats, &out.Stats
-		*out = new(BackupStats)
-		**out = **in
-	}
-	if in.History != nil {
-		in, out := &in.History, &out.History
-		*out = make([]BackupRun, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jBackupStatus.
-func (in *Neo4jBackupStatus) DeepCopy() *Neo4jBackupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jClusterClassSpec.
+func (in *Neo4jClusterClassSpec) DeepCopy() *Neo4jClusterClassSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jBackupStatus)
+	out := new(Neo4jClusterClassSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1278,6 +2393,20 @@ func (in *Neo4jDatabaseList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Neo4jDatabaseSpec) DeepCopyInto(out *Neo4jDatabaseSpec) {
 	*out = *in
+	if in.Constituents != nil {
+		in, out := &in.Constituents, &out.Constituents
+		*out = make([]DatabaseAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]DatabaseAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
@@ -1305,6 +2434,26 @@ func (in *Neo4jDatabaseSpec) DeepCopyInto(out *Neo4jDatabaseSpec) {
 		*out = new(SeedCredentials)
 		**out = **in
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(DatabaseSeed)
+		**out = **in
+	}
+	if in.DropPolicy != nil {
+		in, out := &in.DropPolicy, &out.DropPolicy
+		*out = new(DatabaseDropPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(DatabaseSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ResourceDependency, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jDatabaseSpec.
@@ -1345,6 +2494,21 @@ func (in *Neo4jDatabaseStatus) DeepCopyInto(out *Neo4jDatabaseStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(DatabaseSeedStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(DatabaseSchemaStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jDatabaseStatus.
@@ -1427,6 +2591,11 @@ func (in *Neo4jEnterpriseClusterSpec) DeepCopyInto(out *Neo4jEnterpriseClusterSp
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]v1.EnvVar, len(*in))
@@ -1465,6 +2634,28 @@ func (in *Neo4jEnterpriseClusterSpec) DeepCopyInto(out *Neo4jEnterpriseClusterSp
 			(*out)[key] = val
 		}
 	}
+	if in.ConfigOverrides != nil {
+		in, out := &in.ConfigOverrides, &out.ConfigOverrides
+		*out = make([]ConfigOverrideSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(LifecycleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemorySpec)
+		**out = **in
+	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(VerticalScalingSpec)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSSpec)
@@ -1485,6 +2676,11 @@ func (in *Neo4jEnterpriseClusterSpec) DeepCopyInto(out *Neo4jEnterpriseClusterSp
 		*out = new(BackupsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupPort != nil {
+		in, out := &in.BackupPort, &out.BackupPort
+		*out = new(BackupPortSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UI != nil {
 		in, out := &in.UI, &out.UI
 		*out = new(UISpec)
@@ -1505,6 +2701,16 @@ func (in *Neo4jEnterpriseClusterSpec) DeepCopyInto(out *Neo4jEnterpriseClusterSp
 		*out = new(QueryMonitoringSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MCP != nil {
 		in, out := &in.MCP, &out.MCP
 		*out = new(MCPServerSpec)
@@ -1520,6 +2726,16 @@ func (in *Neo4jEnterpriseClusterSpec) DeepCopyInto(out *Neo4jEnterpriseClusterSp
 		*out = new(AuraFleetManagementSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(LicenseSpec)
+		**out = **in
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jEnterpriseClusterSpec.
@@ -1561,6 +2777,46 @@ func (in *Neo4jEnterpriseClusterStatus) DeepCopyInto(out *Neo4jEnterpriseCluster
 		*out = new(UpgradeStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(ScaleDownStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerGroupScaleDown != nil {
+		in, out := &in.ServerGroupScaleDown, &out.ServerGroupScaleDown
+		*out = make([]DepartingServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.RollingRestart != nil {
+		in, out := &in.RollingRestart, &out.RollingRestart
+		*out = new(RollingRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(VerticalScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceServers != nil {
+		in, out := &in.MaintenanceServers, &out.MaintenanceServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]ServerStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.PropertyShardingReady != nil {
 		in, out := &in.PropertyShardingReady, &out.PropertyShardingReady
 		*out = new(bool)
@@ -1576,6 +2832,16 @@ func (in *Neo4jEnterpriseClusterStatus) DeepCopyInto(out *Neo4jEnterpriseCluster
 		*out = new(ClusterDiagnosticsStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OperatorUser != nil {
+		in, out := &in.OperatorUser, &out.OperatorUser
+		*out = new(OperatorUserStatus)
+		**out = **in
+	}
+	if in.QueryMonitoring != nil {
+		in, out := &in.QueryMonitoring, &out.QueryMonitoring
+		*out = new(QueryMonitoringStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jEnterpriseClusterStatus.
@@ -1735,6 +3001,11 @@ func (in *Neo4jEnterpriseStandaloneSpec) DeepCopyInto(out *Neo4jEnterpriseStanda
 		*out = new(QueryMonitoringSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MCP != nil {
 		in, out := &in.MCP, &out.MCP
 		*out = new(MCPServerSpec)
@@ -1750,6 +3021,11 @@ func (in *Neo4jEnterpriseStandaloneSpec) DeepCopyInto(out *Neo4jEnterpriseStanda
 		*out = new(AuraFleetManagementSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(StandaloneScheduleSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jEnterpriseStandaloneSpec.
@@ -1796,14 +3072,138 @@ func (in *Neo4jEnterpriseStandaloneStatus) DeepCopyInto(out *Neo4jEnterpriseStan
 		*out = new(AuraFleetManagementStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(StandaloneScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperatorUser != nil {
+		in, out := &in.OperatorUser, &out.OperatorUser
+		*out = new(OperatorUserStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jEnterpriseStandaloneStatus.
+func (in *Neo4jEnterpriseStandaloneStatus) DeepCopy() *Neo4jEnterpriseStandaloneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jEnterpriseStandaloneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jMigration) DeepCopyInto(out *Neo4jMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jMigration.
+func (in *Neo4jMigration) DeepCopy() *Neo4jMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jMigrationList) DeepCopyInto(out *Neo4jMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Neo4jMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jMigrationList.
+func (in *Neo4jMigrationList) DeepCopy() *Neo4jMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jMigrationSpec) DeepCopyInto(out *Neo4jMigrationSpec) {
+	*out = *in
+	if in.Scripts != nil {
+		in, out := &in.Scripts, &out.Scripts
+		*out = make([]MigrationScript, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jMigrationSpec.
+func (in *Neo4jMigrationSpec) DeepCopy() *Neo4jMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jMigrationStatus) DeepCopyInto(out *Neo4jMigrationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Scripts != nil {
+		in, out := &in.Scripts, &out.Scripts
+		*out = make([]MigrationScriptStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastMigrationTime != nil {
+		in, out := &in.LastMigrationTime, &out.LastMigrationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jEnterpriseStandaloneStatus.
-func (in *Neo4jEnterpriseStandaloneStatus) DeepCopy() *Neo4jEnterpriseStandaloneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jMigrationStatus.
+func (in *Neo4jMigrationStatus) DeepCopy() *Neo4jMigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jEnterpriseStandaloneStatus)
+	out := new(Neo4jMigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1890,7 +3290,7 @@ func (in *Neo4jPluginSpec) DeepCopyInto(out *Neo4jPluginSpec) {
 	if in.License != nil {
 		in, out := &in.License, &out.License
 		*out = new(PluginLicense)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Security != nil {
 		in, out := &in.Security, &out.Security
@@ -1902,6 +3302,11 @@ func (in *Neo4jPluginSpec) DeepCopyInto(out *Neo4jPluginSpec) {
 		*out = new(PluginResourceRequirements)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ResourceDependency, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jPluginSpec.
@@ -1928,6 +3333,11 @@ func (in *Neo4jPluginStatus) DeepCopyInto(out *Neo4jPluginStatus) {
 		in, out := &in.InstallationTime, &out.InstallationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]PluginServerStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(PluginHealth)
@@ -2060,18 +3470,306 @@ func (in *Neo4jRestoreStatus) DeepCopyInto(out *Neo4jRestoreStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jRestoreStatus.
-func (in *Neo4jRestoreStatus) DeepCopy() *Neo4jRestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jRestoreStatus.
+func (in *Neo4jRestoreStatus) DeepCopy() *Neo4jRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jShardedDatabase) DeepCopyInto(out *Neo4jShardedDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabase.
+func (in *Neo4jShardedDatabase) DeepCopy() *Neo4jShardedDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jShardedDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jShardedDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jShardedDatabaseList) DeepCopyInto(out *Neo4jShardedDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Neo4jShardedDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseList.
+func (in *Neo4jShardedDatabaseList) DeepCopy() *Neo4jShardedDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jShardedDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jShardedDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jShardedDatabaseSpec) DeepCopyInto(out *Neo4jShardedDatabaseSpec) {
+	*out = *in
+	out.PropertySharding = in.PropertySharding
+	if in.SeedURIs != nil {
+		in, out := &in.SeedURIs, &out.SeedURIs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeedConfig != nil {
+		in, out := &in.SeedConfig, &out.SeedConfig
+		*out = new(SeedConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedCredentials != nil {
+		in, out := &in.SeedCredentials, &out.SeedCredentials
+		*out = new(SeedCredentials)
+		**out = **in
+	}
+	if in.BackupConfig != nil {
+		in, out := &in.BackupConfig, &out.BackupConfig
+		*out = new(ShardedDatabaseBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseSpec.
+func (in *Neo4jShardedDatabaseSpec) DeepCopy() *Neo4jShardedDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jShardedDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jShardedDatabaseStatus) DeepCopyInto(out *Neo4jShardedDatabaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShardingReady != nil {
+		in, out := &in.ShardingReady, &out.ShardingReady
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.GraphShard != nil {
+		in, out := &in.GraphShard, &out.GraphShard
+		*out = new(ShardStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PropertyShards != nil {
+		in, out := &in.PropertyShards, &out.PropertyShards
+		*out = make([]ShardStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualDatabase != nil {
+		in, out := &in.VirtualDatabase, &out.VirtualDatabase
+		*out = new(VirtualDatabaseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupStatus != nil {
+		in, out := &in.BackupStatus, &out.BackupStatus
+		*out = new(ShardedBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseStatus.
+func (in *Neo4jShardedDatabaseStatus) DeepCopy() *Neo4jShardedDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jShardedDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jUserSync) DeepCopyInto(out *Neo4jUserSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jUserSync.
+func (in *Neo4jUserSync) DeepCopy() *Neo4jUserSync {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jUserSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jUserSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jUserSyncList) DeepCopyInto(out *Neo4jUserSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Neo4jUserSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jUserSyncList.
+func (in *Neo4jUserSyncList) DeepCopy() *Neo4jUserSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jUserSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Neo4jUserSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jUserSyncSpec) DeepCopyInto(out *Neo4jUserSyncSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.GroupRoles != nil {
+		in, out := &in.GroupRoles, &out.GroupRoles
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.DefaultRoles != nil {
+		in, out := &in.DefaultRoles, &out.DefaultRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ResourceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jUserSyncSpec.
+func (in *Neo4jUserSyncSpec) DeepCopy() *Neo4jUserSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jUserSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jUserSyncStatus) DeepCopyInto(out *Neo4jUserSyncStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserSyncResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jUserSyncStatus.
+func (in *Neo4jUserSyncStatus) DeepCopy() *Neo4jUserSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jRestoreStatus)
+	out := new(Neo4jUserSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jShardedDatabase) DeepCopyInto(out *Neo4jShardedDatabase) {
+func (in *Neo4jWorkload) DeepCopyInto(out *Neo4jWorkload) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2079,18 +3777,18 @@ func (in *Neo4jShardedDatabase) DeepCopyInto(out *Neo4jShardedDatabase) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabase.
-func (in *Neo4jShardedDatabase) DeepCopy() *Neo4jShardedDatabase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jWorkload.
+func (in *Neo4jWorkload) DeepCopy() *Neo4jWorkload {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jShardedDatabase)
+	out := new(Neo4jWorkload)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Neo4jShardedDatabase) DeepCopyObject() runtime.Object {
+func (in *Neo4jWorkload) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2098,31 +3796,31 @@ func (in *Neo4jShardedDatabase) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jShardedDatabaseList) DeepCopyInto(out *Neo4jShardedDatabaseList) {
+func (in *Neo4jWorkloadList) DeepCopyInto(out *Neo4jWorkloadList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Neo4jShardedDatabase, len(*in))
+		*out = make([]Neo4jWorkload, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseList.
-func (in *Neo4jShardedDatabaseList) DeepCopy() *Neo4jShardedDatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jWorkloadList.
+func (in *Neo4jWorkloadList) DeepCopy() *Neo4jWorkloadList {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jShardedDatabaseList)
+	out := new(Neo4jWorkloadList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Neo4jShardedDatabaseList) DeepCopyObject() runtime.Object {
+func (in *Neo4jWorkloadList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2130,45 +3828,37 @@ func (in *Neo4jShardedDatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jShardedDatabaseSpec) DeepCopyInto(out *Neo4jShardedDatabaseSpec) {
+func (in *Neo4jWorkloadSpec) DeepCopyInto(out *Neo4jWorkloadSpec) {
 	*out = *in
-	out.PropertySharding = in.PropertySharding
-	if in.SeedURIs != nil {
-		in, out := &in.SeedURIs, &out.SeedURIs
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.ReadPercent != nil {
+		in, out := &in.ReadPercent, &out.ReadPercent
+		*out = new(int32)
+		**out = **in
 	}
-	if in.SeedConfig != nil {
-		in, out := &in.SeedConfig, &out.SeedConfig
-		*out = new(SeedConfiguration)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SeedCredentials != nil {
-		in, out := &in.SeedCredentials, &out.SeedCredentials
-		*out = new(SeedCredentials)
-		**out = **in
-	}
-	if in.BackupConfig != nil {
-		in, out := &in.BackupConfig, &out.BackupConfig
-		*out = new(ShardedDatabaseBackupConfig)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseSpec.
-func (in *Neo4jShardedDatabaseSpec) DeepCopy() *Neo4jShardedDatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jWorkloadSpec.
+func (in *Neo4jWorkloadSpec) DeepCopy() *Neo4jWorkloadSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jShardedDatabaseSpec)
+	out := new(Neo4jWorkloadSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jShardedDatabaseStatus) DeepCopyInto(out *Neo4jShardedDatabaseStatus) {
+func (in *Neo4jWorkloadStatus) DeepCopyInto(out *Neo4jWorkloadStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2177,49 +3867,27 @@ func (in *Neo4jShardedDatabaseStatus) DeepCopyInto(out *Neo4jShardedDatabaseStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ShardingReady != nil {
-		in, out := &in.ShardingReady, &out.ShardingReady
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
-	if in.GraphShard != nil {
-		in, out := &in.GraphShard, &out.GraphShard
-		*out = new(ShardStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PropertyShards != nil {
-		in, out := &in.PropertyShards, &out.PropertyShards
-		*out = make([]ShardStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VirtualDatabase != nil {
-		in, out := &in.VirtualDatabase, &out.VirtualDatabase
-		*out = new(VirtualDatabaseStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BackupStatus != nil {
-		in, out := &in.BackupStatus, &out.BackupStatus
-		*out = new(ShardedBackupStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LastBackupTime != nil {
-		in, out := &in.LastBackupTime, &out.LastBackupTime
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(WorkloadResults)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jShardedDatabaseStatus.
-func (in *Neo4jShardedDatabaseStatus) DeepCopy() *Neo4jShardedDatabaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jWorkloadStatus.
+func (in *Neo4jWorkloadStatus) DeepCopy() *Neo4jWorkloadStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jShardedDatabaseStatus)
+	out := new(Neo4jWorkloadStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2239,6 +3907,102 @@ func (in *NodeUpgradeProgress) DeepCopy() *NodeUpgradeProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCAuthSpec) DeepCopyInto(out *OIDCAuthSpec) {
+	*out = *in
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(OIDCClientSecretSpec)
+		**out = **in
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClaimsMapping != nil {
+		in, out := &in.ClaimsMapping, &out.ClaimsMapping
+		*out = new(OIDCClaimsMappingSpec)
+		**out = **in
+	}
+	if in.GroupToRoleMapping != nil {
+		in, out := &in.GroupToRoleMapping, &out.GroupToRoleMapping
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCAuthSpec.
+func (in *OIDCAuthSpec) DeepCopy() *OIDCAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCClaimsMappingSpec) DeepCopyInto(out *OIDCClaimsMappingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCClaimsMappingSpec.
+func (in *OIDCClaimsMappingSpec) DeepCopy() *OIDCClaimsMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCClaimsMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCClientSecretSpec) DeepCopyInto(out *OIDCClientSecretSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCClientSecretSpec.
+func (in *OIDCClientSecretSpec) DeepCopy() *OIDCClientSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCClientSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorUserStatus) DeepCopyInto(out *OperatorUserStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorUserStatus.
+func (in *OperatorUserStatus) DeepCopy() *OperatorUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PITRConfig) DeepCopyInto(out *PITRConfig) {
 	*out = *in
@@ -2403,6 +4167,11 @@ func (in *PluginHealth) DeepCopy() *PluginHealth {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginLicense) DeepCopyInto(out *PluginLicense) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginLicense.
@@ -2490,6 +4259,21 @@ func (in *PluginSecurity) DeepCopy() *PluginSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginServerStatus) DeepCopyInto(out *PluginServerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginServerStatus.
+func (in *PluginServerStatus) DeepCopy() *PluginServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginSource) DeepCopyInto(out *PluginSource) {
 	*out = *in
@@ -2541,66 +4325,193 @@ func (in *PodAntiAffinityConfig) DeepCopyInto(out *PodAntiAffinityConfig) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAntiAffinityConfig.
-func (in *PodAntiAffinityConfig) DeepCopy() *PodAntiAffinityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAntiAffinityConfig.
+func (in *PodAntiAffinityConfig) DeepCopy() *PodAntiAffinityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodAntiAffinityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTimingSpec) DeepCopyInto(out *ProbeTimingSpec) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTimingSpec.
+func (in *ProbeTimingSpec) DeepCopy() *ProbeTimingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTimingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbesSpec) DeepCopyInto(out *ProbesSpec) {
+	*out = *in
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ProbeTimingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ProbeTimingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(ProbeTimingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesSpec.
+func (in *ProbesSpec) DeepCopy() *ProbesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyShardTopology) DeepCopyInto(out *PropertyShardTopology) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardTopology.
+func (in *PropertyShardTopology) DeepCopy() *PropertyShardTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyShardTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyShardingConfiguration) DeepCopyInto(out *PropertyShardingConfiguration) {
+	*out = *in
+	out.GraphShard = in.GraphShard
+	out.PropertyShardTopology = in.PropertyShardTopology
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardingConfiguration.
+func (in *PropertyShardingConfiguration) DeepCopy() *PropertyShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyShardingSpec) DeepCopyInto(out *PropertyShardingSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardingSpec.
+func (in *PropertyShardingSpec) DeepCopy() *PropertyShardingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodAntiAffinityConfig)
+	out := new(PropertyShardingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PropertyShardTopology) DeepCopyInto(out *PropertyShardTopology) {
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardTopology.
-func (in *PropertyShardTopology) DeepCopy() *PropertyShardTopology {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PropertyShardTopology)
+	out := new(ProxySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PropertyShardingConfiguration) DeepCopyInto(out *PropertyShardingConfiguration) {
+func (in *QueryAllowlist) DeepCopyInto(out *QueryAllowlist) {
 	*out = *in
-	out.GraphShard = in.GraphShard
-	out.PropertyShardTopology = in.PropertyShardTopology
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryPatterns != nil {
+		in, out := &in.QueryPatterns, &out.QueryPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardingConfiguration.
-func (in *PropertyShardingConfiguration) DeepCopy() *PropertyShardingConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryAllowlist.
+func (in *QueryAllowlist) DeepCopy() *QueryAllowlist {
 	if in == nil {
 		return nil
 	}
-	out := new(PropertyShardingConfiguration)
+	out := new(QueryAllowlist)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PropertyShardingSpec) DeepCopyInto(out *PropertyShardingSpec) {
+func (in *QueryEnforcementSpec) DeepCopyInto(out *QueryEnforcementSpec) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Allowlists != nil {
+		in, out := &in.Allowlists, &out.Allowlists
+		*out = make([]QueryAllowlist, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyShardingSpec.
-func (in *PropertyShardingSpec) DeepCopy() *PropertyShardingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryEnforcementSpec.
+func (in *QueryEnforcementSpec) DeepCopy() *QueryEnforcementSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PropertyShardingSpec)
+	out := new(QueryEnforcementSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2633,6 +4544,11 @@ func (in *QueryMonitoringSpec) DeepCopyInto(out *QueryMonitoringSpec) {
 		*out = new(QueryMetricsExportConfig)
 		**out = **in
 	}
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(QueryEnforcementSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryMonitoringSpec.
@@ -2645,6 +4561,32 @@ func (in *QueryMonitoringSpec) DeepCopy() *QueryMonitoringSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryMonitoringStatus) DeepCopyInto(out *QueryMonitoringStatus) {
+	*out = *in
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make([]IndexRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastAnalyzed != nil {
+		in, out := &in.LastAnalyzed, &out.LastAnalyzed
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryMonitoringStatus.
+func (in *QueryMonitoringStatus) DeepCopy() *QueryMonitoringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryMonitoringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueryPerformanceMetrics) DeepCopyInto(out *QueryPerformanceMetrics) {
 	*out = *in
@@ -2675,6 +4617,33 @@ func (in *QuerySamplingConfig) DeepCopy() *QuerySamplingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadOnlyServiceSpec) DeepCopyInto(out *ReadOnlyServiceSpec) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadOnlyServiceSpec.
+func (in *ReadOnlyServiceSpec) DeepCopy() *ReadOnlyServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadOnlyServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryTLSConfig) DeepCopyInto(out *RegistryTLSConfig) {
 	*out = *in
@@ -2690,6 +4659,26 @@ func (in *RegistryTLSConfig) DeepCopy() *RegistryTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteAliasTarget) DeepCopyInto(out *RemoteAliasTarget) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(AliasDriverSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteAliasTarget.
+func (in *RemoteAliasTarget) DeepCopy() *RemoteAliasTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteAliasTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
 	*out = *in
@@ -2705,6 +4694,81 @@ func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResizeStatus) DeepCopyInto(out *ResizeStatus) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResizeStatus.
+func (in *ResizeStatus) DeepCopy() *ResizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDependency) DeepCopyInto(out *ResourceDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDependency.
+func (in *ResourceDependency) DeepCopy() *ResourceDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendation) DeepCopyInto(out *ResourceRecommendation) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendation.
+func (in *ResourceRecommendation) DeepCopy() *ResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 	*out = *in
@@ -2897,54 +4961,219 @@ func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
-func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingRestartStatus) DeepCopyInto(out *RollingRestartStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingRestartStatus.
+func (in *RollingRestartStatus) DeepCopy() *RollingRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RouteTLSSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTLSSpec) DeepCopyInto(out *RouteTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTLSSpec.
+func (in *RouteTLSSpec) DeepCopy() *RouteTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLPolicies) DeepCopyInto(out *SSLPolicies) {
+	*out = *in
+	if in.Bolt != nil {
+		in, out := &in.Bolt, &out.Bolt
+		*out = new(SSLPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPS != nil {
+		in, out := &in.HTTPS, &out.HTTPS
+		*out = new(SSLPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(SSLPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(SSLPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLPolicies.
+func (in *SSLPolicies) DeepCopy() *SSLPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLPolicySpec) DeepCopyInto(out *SSLPolicySpec) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(IssuerRef)
+		**out = **in
+	}
+	if in.TLSVersions != nil {
+		in, out := &in.TLSVersions, &out.TLSVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLPolicySpec.
+func (in *SSLPolicySpec) DeepCopy() *SSLPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownStatus) DeepCopyInto(out *ScaleDownStatus) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]DepartingServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownStatus.
+func (in *ScaleDownStatus) DeepCopy() *ScaleDownStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RetentionPolicy)
+	out := new(ScaleDownStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+func (in *SchemaConstraint) DeepCopyInto(out *SchemaConstraint) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(RouteTLSSpec)
-		**out = **in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
-func (in *RouteSpec) DeepCopy() *RouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaConstraint.
+func (in *SchemaConstraint) DeepCopy() *SchemaConstraint {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpec)
+	out := new(SchemaConstraint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTLSSpec) DeepCopyInto(out *RouteTLSSpec) {
+func (in *SchemaIndex) DeepCopyInto(out *SchemaIndex) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelationshipTypes != nil {
+		in, out := &in.RelationshipTypes, &out.RelationshipTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTLSSpec.
-func (in *RouteTLSSpec) DeepCopy() *RouteTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaIndex.
+func (in *SchemaIndex) DeepCopy() *SchemaIndex {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTLSSpec)
+	out := new(SchemaIndex)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2992,6 +5221,11 @@ func (in *SecurityContextSpec) DeepCopyInto(out *SecurityContextSpec) {
 		*out = new(v1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(v1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextSpec.
@@ -3056,6 +5290,40 @@ func (in *ServerDiagnosticInfo) DeepCopy() *ServerDiagnosticInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupSpec.
+func (in *ServerGroupSpec) DeepCopy() *ServerGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerRoleHint) DeepCopyInto(out *ServerRoleHint) {
 	*out = *in
@@ -3071,6 +5339,43 @@ func (in *ServerRoleHint) DeepCopy() *ServerRoleHint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerStatus) DeepCopyInto(out *ServerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStatus.
+func (in *ServerStatus) DeepCopy() *ServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMonitorSpec) DeepCopyInto(out *ServiceMonitorSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMonitorSpec.
+func (in *ServiceMonitorSpec) DeepCopy() *ServiceMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 	*out = *in
@@ -3086,6 +5391,16 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(IngressSpec)
@@ -3096,6 +5411,11 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 		*out = new(RouteSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReadOnly != nil {
+		in, out := &in.ReadOnly, &out.ReadOnly
+		*out = new(ReadOnlyServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpec.
@@ -3256,6 +5576,45 @@ func (in *StandalonePodStatus) DeepCopy() *StandalonePodStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandaloneScheduleSpec) DeepCopyInto(out *StandaloneScheduleSpec) {
+	*out = *in
+	if in.ActiveHours != nil {
+		in, out := &in.ActiveHours, &out.ActiveHours
+		*out = new(ActiveHoursSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandaloneScheduleSpec.
+func (in *StandaloneScheduleSpec) DeepCopy() *StandaloneScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StandaloneScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandaloneScheduleStatus) DeepCopyInto(out *StandaloneScheduleStatus) {
+	*out = *in
+	if in.NextTransition != nil {
+		in, out := &in.NextTransition, &out.NextTransition
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandaloneScheduleStatus.
+func (in *StandaloneScheduleStatus) DeepCopy() *StandaloneScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StandaloneScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageLocation) DeepCopyInto(out *StorageLocation) {
 	*out = *in
@@ -3334,6 +5693,16 @@ func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = new(SSLPolicies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(ClusterTLSSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
@@ -3346,6 +5715,32 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSStatus) DeepCopyInto(out *TLSStatus) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingSince != nil {
+		in, out := &in.PendingSince, &out.PendingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSStatus.
+func (in *TLSStatus) DeepCopy() *TLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyConfiguration) DeepCopyInto(out *TopologyConfiguration) {
 	*out = *in
@@ -3364,6 +5759,18 @@ func (in *TopologyConfiguration) DeepCopyInto(out *TopologyConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ZoneSpread != nil {
+		in, out := &in.ZoneSpread, &out.ZoneSpread
+		*out = new(ZoneSpreadSpec)
+		**out = **in
+	}
+	if in.ServerGroups != nil {
+		in, out := &in.ServerGroups, &out.ServerGroups
+		*out = make([]ServerGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyConfiguration.
@@ -3457,6 +5864,11 @@ func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
 		*out = new(UpgradeProgress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
@@ -3477,6 +5889,11 @@ func (in *UpgradeStrategySpec) DeepCopyInto(out *UpgradeStrategySpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryUpgradeSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategySpec.
@@ -3489,6 +5906,110 @@ func (in *UpgradeStrategySpec) DeepCopy() *UpgradeStrategySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncResult) DeepCopyInto(out *UserSyncResult) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastChanged != nil {
+		in, out := &in.LastChanged, &out.LastChanged
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncResult.
+func (in *UserSyncResult) DeepCopy() *UserSyncResult {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncSource) DeepCopyInto(out *UserSyncSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(UserSyncSourceRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(UserSyncSourceRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncSource.
+func (in *UserSyncSource) DeepCopy() *UserSyncSource {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncSourceRef) DeepCopyInto(out *UserSyncSourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncSourceRef.
+func (in *UserSyncSourceRef) DeepCopy() *UserSyncSourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncSourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalScalingSpec) DeepCopyInto(out *VerticalScalingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalScalingSpec.
+func (in *VerticalScalingSpec) DeepCopy() *VerticalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalScalingStatus) DeepCopyInto(out *VerticalScalingStatus) {
+	*out = *in
+	if in.Resize != nil {
+		in, out := &in.Resize, &out.Resize
+		*out = new(ResizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recommendation != nil {
+		in, out := &in.Recommendation, &out.Recommendation
+		*out = new(ResourceRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalScalingStatus.
+func (in *VerticalScalingStatus) DeepCopy() *VerticalScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualDatabaseMetrics) DeepCopyInto(out *VirtualDatabaseMetrics) {
 	*out = *in
@@ -3528,3 +6049,33 @@ func (in *VirtualDatabaseStatus) DeepCopy() *VirtualDatabaseStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadResults) DeepCopyInto(out *WorkloadResults) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadResults.
+func (in *WorkloadResults) DeepCopy() *WorkloadResults {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadResults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSpreadSpec) DeepCopyInto(out *ZoneSpreadSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSpreadSpec.
+func (in *ZoneSpreadSpec) DeepCopy() *ZoneSpreadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSpreadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
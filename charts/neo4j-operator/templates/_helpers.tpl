@@ -132,6 +132,12 @@ Get container args based on configuration
 - --metrics-bind-address=0
 {{- end }}
 - --health-probe-bind-address=:8081
+{{- if .Values.tracing.endpoint }}
+- --otlp-endpoint={{ .Values.tracing.endpoint }}
+- --otlp-protocol={{ .Values.tracing.protocol }}
+- --otlp-insecure={{ .Values.tracing.insecure }}
+- --trace-sampling-ratio={{ .Values.tracing.samplingRatio }}
+{{- end }}
 {{- if .Values.webhook.enabled }}
 - --webhook-port={{ .Values.webhook.port }}
 {{- end }}
@@ -29,22 +29,30 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/controller"
+	operatormetrics "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+	webhookv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/webhook/v1alpha1"
 
 	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -95,6 +103,15 @@ type managerSettings struct {
 	skipCacheWait        bool
 	useDirectClient      bool
 	useCacheManager      bool
+	// slowReconcileThreshold is the cluster reconcile budget
+	slowReconcileThreshold time.Duration
+	// securityAudit configures the sinks of the security audit trail
+	securityAudit controller.SecurityAuditConfig
+	// watchLabelSelector limits the custom resources the operator manages
+	watchLabelSelector labels.Selector
+	leaderElectionID   string
+	// enableWebhooks serves the defaulting and validating admission webhooks
+	enableWebhooks bool
 }
 
 type watchNamespaceConfig struct {
@@ -125,6 +142,10 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == migrateStorageCommand {
+		os.Exit(runStorageMigration(os.Args[2:], os.Stdout))
+	}
+
 	var (
 		mode                 = flag.String("mode", "production", "Operator mode: production or dev")
 		metricsAddr          = flag.String("metrics-bind-address", "", "The address the metric endpoint binds to. (auto-assigned based on mode if empty)")
@@ -133,13 +154,47 @@ func main() {
 		secureMetrics        = flag.Bool("metrics-secure", false, "If set the metrics endpoint is served securely")
 
 		// Development mode specific flags
-		controllersToLoad = flag.String("controllers", "cluster,standalone,database,backup,restore,plugin,shardeddatabase", "Comma-separated list of controllers to load (dev mode only)")
+		controllersToLoad = flag.String("controllers", "cluster,standalone,database,backup,restore,plugin,shardeddatabase,workload,usersync,migration,cdc", "Comma-separated list of controllers to load (dev mode only)")
 
 		// Cache optimization flags
 		cacheStrategy = flag.String("cache-strategy", "", "Cache strategy: standard, lazy, selective, on-demand, none (auto-selected based on mode if empty)")
 		skipCacheWait = flag.Bool("skip-cache-wait", false, "Skip waiting for cache sync before starting controllers")
 		lazyInformers = flag.Bool("lazy-informers", false, "Enable lazy informer creation")
 		ultraFast     = flag.Bool("ultra-fast", false, "Enable ultra-fast mode with no informer caching")
+
+		// Reconcile diagnostics
+		slowReconcileThreshold = flag.Duration("slow-reconcile-threshold", controller.DefaultSlowReconcileThreshold, "Cluster reconciles slower than this emit a SlowReconcile warning event with a per-phase breakdown (negative disables)")
+
+		// Security audit trail flags
+		securityAuditConfigMap  = flag.String("security-audit-configmap", "", "ConfigMap, in the namespace of the resource, that user, role and privilege statements run by the operator are appended to (empty disables)")
+		securityAuditMaxEntries = flag.Int("security-audit-max-entries", controller.DefaultSecurityAuditMaxEntries, "Entries kept in the audit ConfigMap before it is rotated")
+		securityAuditWebhook    = flag.String("security-audit-webhook", "", "URL that user, role and privilege statements run by the operator are posted to as JSON (empty disables)")
+
+		// Administrative Cypher rate limiting
+		adminQueryQPS   = flag.Float64("admin-query-qps", neo4j.DefaultAdminQueryQPS, "Sustained rate of administrative statements the operator runs per cluster (0 disables the limit)")
+		adminQueryBurst = flag.Int("admin-query-burst", neo4j.DefaultAdminQueryBurst, "Administrative statements per cluster that may run back to back before admin-query-qps applies")
+
+		// Neo4j connections
+		driverIdleTimeout = flag.Duration("neo4j-driver-idle-timeout", neo4j.DefaultDriverIdleTimeout, "How long the Neo4j driver of a cluster, shared by the reconcilers, stays open while unused (0 gives every reconcile its own driver)")
+		cypherRetries     = flag.Int("cypher-retries", neo4j.DefaultQueryRetries, "How often a Cypher statement failing with a transient error, such as a leader switch or stale routing table, is retried (0 disables retries)")
+		cypherTimeout     = flag.Duration("cypher-timeout", neo4j.DefaultQueryTimeout, "Timeout of each attempt of a Cypher statement the operator runs without a deadline of its own, except migrations, schema changes and seeds (0 disables the timeout)")
+
+		// Validation
+		oidcDiscoveryCheck = flag.Bool("oidc-discovery-check", false, "Fetch the discovery document of the spec.auth.oidc issuer when validating clusters, so that a misconfigured provider fails validation")
+		licenseConfigMap   = flag.String("license-configmap", "", "ConfigMap, as namespace/name, whose maxCores key is the Neo4j license entitlement of clusters without spec.license (empty disables)")
+		enableWebhooks     = flag.Bool("enable-webhooks", false, "Serve the admission webhooks that default and validate clusters and standalones, and validate backups and restores, on :9443; needs a serving certificate in /tmp/k8s-webhook-server/serving-certs")
+
+		// Opt-in mode
+		watchLabelSelector = flag.String("watch-label-selector", "", "Label selector, e.g. team=payments, limiting the custom resources this operator manages (empty manages all)")
+		leaderElectionID   = flag.String("leader-election-id", "neo4j-operator-leader-election", "Name of the leader election lease; operators running side by side need different ones")
+
+		// Tracing
+		otlpEndpoint       = flag.String("otlp-endpoint", otlpEndpointFromEnv(), "host:port or URL of the OTLP receiver the operator traces are exported to, e.g. tempo.monitoring:4317 (defaults to OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT; empty disables tracing)")
+		otlpProtocol       = flag.String("otlp-protocol", envOrDefault("OTEL_EXPORTER_OTLP_PROTOCOL", operatormetrics.OTLPProtocolGRPC), "OTLP protocol: grpc or http/protobuf (defaults to OTEL_EXPORTER_OTLP_PROTOCOL)")
+		otlpHeaders        = flag.String("otlp-headers", os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"), "Comma-separated key=value headers sent with every export, e.g. for authentication (defaults to OTEL_EXPORTER_OTLP_HEADERS)")
+		otlpInsecure       = flag.Bool("otlp-insecure", false, "Export traces without TLS; endpoints given as http:// URLs are always insecure")
+		traceSamplingRatio = flag.Float64("trace-sampling-ratio", 1.0, "Share of reconciles, between 0.0 and 1.0, whose traces are sampled")
+		traceServiceName   = flag.String("trace-service-name", envOrDefault("OTEL_SERVICE_NAME", operatormetrics.DefaultTraceServiceName), "service.name of the operator traces (defaults to OTEL_SERVICE_NAME)")
 	)
 
 	opts := zap.Options{Development: true}
@@ -177,6 +232,20 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	neo4j.SetAdminRateLimit(*adminQueryQPS, *adminQueryBurst)
+	neo4j.SetDriverIdleTimeout(*driverIdleTimeout)
+	neo4j.SetQueryRetry(*cypherRetries, *cypherTimeout)
+	validation.SetOIDCDiscoveryCheck(*oidcDiscoveryCheck)
+
+	if *licenseConfigMap != "" {
+		namespace, name, ok := strings.Cut(*licenseConfigMap, "/")
+		if !ok || namespace == "" || name == "" {
+			setupLog.Error(nil, "license-configmap must be namespace/name", "value", *licenseConfigMap)
+			os.Exit(1)
+		}
+		validation.SetLicenseConfigMap(types.NamespacedName{Namespace: namespace, Name: name})
+	}
+
 	// Validate flag values
 	if *metricsAddr == "" {
 		setupLog.Error(nil, "metrics-bind-address cannot be empty")
@@ -260,43 +329,134 @@ func main() {
 		os.Exit(1)
 	}
 
+	watchSelector, err := parseWatchLabelSelector(*watchLabelSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid watch-label-selector")
+		os.Exit(1)
+	}
+	if watchSelector != nil {
+		if useDirectClient {
+			// Reads that bypass the cache would see every resource
+			setupLog.Error(nil, "watch-label-selector cannot be combined with the direct API client (cache-strategy none or ultra-fast)")
+			os.Exit(1)
+		}
+		setupLog.Info("managing only labeled custom resources", "selector", watchSelector.String())
+	}
+
 	settings := managerSettings{
-		config:               config,
-		baseCacheOpts:        cacheOpts,
-		metricsAddr:          *metricsAddr,
-		probeAddr:            *probeAddr,
-		secureMetrics:        *secureMetrics,
-		enableLeaderElection: *enableLeaderElection,
-		operatorMode:         operatorMode,
-		controllersToLoad:    *controllersToLoad,
-		skipCacheWait:        *skipCacheWait,
-		useDirectClient:      useDirectClient,
-		useCacheManager:      !useDirectClient && CacheStrategy(*cacheStrategy) == SelectiveCache,
+		config:                 config,
+		baseCacheOpts:          cacheOpts,
+		metricsAddr:            *metricsAddr,
+		probeAddr:              *probeAddr,
+		secureMetrics:          *secureMetrics,
+		enableLeaderElection:   *enableLeaderElection,
+		operatorMode:           operatorMode,
+		controllersToLoad:      *controllersToLoad,
+		skipCacheWait:          *skipCacheWait,
+		useDirectClient:        useDirectClient,
+		useCacheManager:        !useDirectClient && CacheStrategy(*cacheStrategy) == SelectiveCache,
+		slowReconcileThreshold: *slowReconcileThreshold,
+		securityAudit: controller.SecurityAuditConfig{
+			ConfigMapName: *securityAuditConfigMap,
+			MaxEntries:    *securityAuditMaxEntries,
+			WebhookURL:    *securityAuditWebhook,
+		},
+		watchLabelSelector: watchSelector,
+		leaderElectionID:   *leaderElectionID,
+		enableWebhooks:     *enableWebhooks,
+	}
+
+	traceHeaders, err := operatormetrics.ParseTraceHeaders(*otlpHeaders)
+	if err != nil {
+		setupLog.Error(err, "invalid otlp-headers")
+		os.Exit(1)
 	}
 
 	ctx := ctrl.SetupSignalHandler()
-	if err := runManagerWithWatchConfig(ctx, settings, watchConfig); err != nil {
-		setupLog.Error(err, "problem running manager")
+	shutdownTracing, err := operatormetrics.SetupTracing(ctx, operatormetrics.TracingOptions{
+		Endpoint:      *otlpEndpoint,
+		Protocol:      *otlpProtocol,
+		Insecure:      *otlpInsecure,
+		Headers:       traceHeaders,
+		SamplingRatio: *traceSamplingRatio,
+		ServiceName:   *traceServiceName,
+		// Set by the Helm chart
+		ServiceVersion: os.Getenv("OPERATOR_VERSION"),
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	if *otlpEndpoint != "" {
+		setupLog.Info("exporting traces", "endpoint", *otlpEndpoint, "protocol", *otlpProtocol, "samplingRatio", *traceSamplingRatio)
+	}
+
+	runErr := runManagerWithWatchConfig(ctx, settings, watchConfig)
+
+	// Flush the spans of the last reconciles before exiting
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		setupLog.Error(err, "failed to flush traces")
+	}
+	cancel()
+
+	if runErr != nil {
+		setupLog.Error(runErr, "problem running manager")
 		os.Exit(1)
 	}
 }
 
+// tracingShutdownTimeout bounds how long exiting waits for the pending
+// spans to be exported
+const tracingShutdownTimeout = 5 * time.Second
+
+// envOrDefault returns the value of an environment variable, or fallback
+// when it is unset or empty
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// otlpEndpointFromEnv returns the trace endpoint of the standard
+// OpenTelemetry environment variables, preferring the traces specific one
+func otlpEndpointFromEnv() string {
+	return envOrDefault("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+}
+
+// detectCapabilities asks the API server for its version and optional APIs.
+// When that fails, nil is returned and the controllers try every API.
+func detectCapabilities(config *rest.Config) *capabilities.Capabilities {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err == nil {
+		var detected *capabilities.Capabilities
+		if detected, err = capabilities.Detect(discoveryClient); err == nil {
+			setupLog.Info("detected cluster capabilities",
+				"kubernetesVersion", detected.KubernetesVersion, "missingAPIs", detected.Missing())
+			return detected
+		}
+	}
+	setupLog.Error(err, "capability detection failed; optional APIs are tried when used")
+	return nil
+}
+
 // setupControllers sets up controllers based on the operator mode
-func setupControllers(mgr ctrl.Manager, mode OperatorMode, controllersToLoad string) error {
+func setupControllers(mgr ctrl.Manager, mode OperatorMode, controllersToLoad string, slowReconcileThreshold time.Duration, securityAudit controller.SecurityAuditConfig, detected *capabilities.Capabilities) error {
 	switch mode {
 	case ProductionMode:
-		return setupProductionControllers(mgr)
+		return setupProductionControllers(mgr, slowReconcileThreshold, securityAudit, detected)
 	case DevelopmentMode:
 		controllers := parseControllers(controllersToLoad)
 		setupLog.Info("loading controllers", "controllers", controllers)
-		return setupDevelopmentControllers(mgr, controllers)
+		return setupDevelopmentControllers(mgr, controllers, slowReconcileThreshold, securityAudit, detected)
 	default:
 		return fmt.Errorf("unknown mode: %s", mode)
 	}
 }
 
 // setupProductionControllers sets up all controllers for production mode
-func setupProductionControllers(mgr ctrl.Manager) error {
+func setupProductionControllers(mgr ctrl.Manager, slowReconcileThreshold time.Duration, securityAudit controller.SecurityAuditConfig, detected *capabilities.Capabilities) error {
 	controllers := []struct {
 		name       string
 		controller interface{ SetupWithManager(ctrl.Manager) error }
@@ -304,14 +464,16 @@ func setupProductionControllers(mgr ctrl.Manager) error {
 		{
 			name: "Neo4jEnterpriseCluster",
 			controller: &controller.Neo4jEnterpriseClusterReconciler{
-				Client:             mgr.GetClient(),
-				Scheme:             mgr.GetScheme(),
-				Recorder:           mgr.GetEventRecorderFor("neo4j-enterprise-cluster-controller"),
-				RequeueAfter:       controller.GetTestRequeueAfter(),
-				TopologyScheduler:  controller.NewTopologyScheduler(mgr.GetClient()),
-				Validator:          validation.NewClusterValidator(mgr.GetClient()),
-				ConfigMapManager:   controller.NewConfigMapManager(mgr.GetClient()),
-				SplitBrainDetector: controller.NewSplitBrainDetector(mgr.GetClient()),
+				Client:                 mgr.GetClient(),
+				Scheme:                 mgr.GetScheme(),
+				Recorder:               mgr.GetEventRecorderFor("neo4j-enterprise-cluster-controller"),
+				RequeueAfter:           controller.GetTestRequeueAfter(),
+				TopologyScheduler:      controller.NewTopologyScheduler(mgr.GetClient()),
+				Validator:              validation.NewClusterValidator(mgr.GetClient()),
+				ConfigMapManager:       controller.NewConfigMapManager(mgr.GetClient()),
+				SplitBrainDetector:     controller.NewSplitBrainDetector(mgr.GetClient()),
+				SlowReconcileThreshold: slowReconcileThreshold,
+				Capabilities:           detected,
 			},
 		},
 		{
@@ -323,6 +485,7 @@ func setupProductionControllers(mgr ctrl.Manager) error {
 				RequeueAfter:     controller.GetTestRequeueAfter(),
 				Validator:        validation.NewStandaloneValidator(),
 				ConfigMapManager: controller.NewConfigMapManager(mgr.GetClient()),
+				Capabilities:     detected,
 			},
 		},
 		{
@@ -373,6 +536,43 @@ func setupProductionControllers(mgr ctrl.Manager) error {
 				ShardedDatabaseValidator: validation.NewShardedDatabaseValidator(mgr.GetClient()),
 			},
 		},
+		{
+			name: "Neo4jWorkload",
+			controller: &controller.Neo4jWorkloadReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-workload-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			},
+		},
+		{
+			name: "Neo4jUserSync",
+			controller: &controller.Neo4jUserSyncReconciler{
+				Client:        mgr.GetClient(),
+				Scheme:        mgr.GetScheme(),
+				Recorder:      mgr.GetEventRecorderFor("neo4j-usersync-controller"),
+				RequeueAfter:  controller.GetTestRequeueAfter(),
+				SecurityAudit: securityAudit,
+			},
+		},
+		{
+			name: "Neo4jMigration",
+			controller: &controller.Neo4jMigrationReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-migration-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			},
+		},
+		{
+			name: "Neo4jCDC",
+			controller: &controller.Neo4jCDCReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-cdc-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			},
+		},
 	}
 
 	for _, ctrl := range controllers {
@@ -386,18 +586,20 @@ func setupProductionControllers(mgr ctrl.Manager) error {
 }
 
 // setupDevelopmentControllers sets up controllers based on configuration for development mode
-func setupDevelopmentControllers(mgr ctrl.Manager, controllers []string) error {
+func setupDevelopmentControllers(mgr ctrl.Manager, controllers []string, slowReconcileThreshold time.Duration, securityAudit controller.SecurityAuditConfig, detected *capabilities.Capabilities) error {
 	controllerMap := map[string]func() (interface{ SetupWithManager(ctrl.Manager) error }, string){
 		"cluster": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
 			return &controller.Neo4jEnterpriseClusterReconciler{
-				Client:             mgr.GetClient(),
-				Scheme:             mgr.GetScheme(),
-				Recorder:           mgr.GetEventRecorderFor("neo4j-enterprise-cluster-controller"),
-				RequeueAfter:       controller.GetTestRequeueAfter(),
-				TopologyScheduler:  controller.NewTopologyScheduler(mgr.GetClient()),
-				Validator:          validation.NewClusterValidator(mgr.GetClient()),
-				ConfigMapManager:   controller.NewConfigMapManager(mgr.GetClient()),
-				SplitBrainDetector: controller.NewSplitBrainDetector(mgr.GetClient()),
+				Client:                 mgr.GetClient(),
+				Scheme:                 mgr.GetScheme(),
+				Recorder:               mgr.GetEventRecorderFor("neo4j-enterprise-cluster-controller"),
+				RequeueAfter:           controller.GetTestRequeueAfter(),
+				TopologyScheduler:      controller.NewTopologyScheduler(mgr.GetClient()),
+				Validator:              validation.NewClusterValidator(mgr.GetClient()),
+				ConfigMapManager:       controller.NewConfigMapManager(mgr.GetClient()),
+				SplitBrainDetector:     controller.NewSplitBrainDetector(mgr.GetClient()),
+				SlowReconcileThreshold: slowReconcileThreshold,
+				Capabilities:           detected,
 			}, "Neo4jEnterpriseCluster"
 		},
 		"standalone": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
@@ -408,6 +610,7 @@ func setupDevelopmentControllers(mgr ctrl.Manager, controllers []string) error {
 				RequeueAfter:     controller.GetTestRequeueAfter(),
 				Validator:        validation.NewStandaloneValidator(),
 				ConfigMapManager: controller.NewConfigMapManager(mgr.GetClient()),
+				Capabilities:     detected,
 			}, "Neo4jEnterpriseStandalone"
 		},
 		"database": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
@@ -453,6 +656,39 @@ func setupDevelopmentControllers(mgr ctrl.Manager, controllers []string) error {
 				ShardedDatabaseValidator: validation.NewShardedDatabaseValidator(mgr.GetClient()),
 			}, "Neo4jShardedDatabase"
 		},
+		"workload": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
+			return &controller.Neo4jWorkloadReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-workload-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			}, "Neo4jWorkload"
+		},
+		"usersync": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
+			return &controller.Neo4jUserSyncReconciler{
+				Client:        mgr.GetClient(),
+				Scheme:        mgr.GetScheme(),
+				Recorder:      mgr.GetEventRecorderFor("neo4j-usersync-controller"),
+				RequeueAfter:  controller.GetTestRequeueAfter(),
+				SecurityAudit: securityAudit,
+			}, "Neo4jUserSync"
+		},
+		"migration": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
+			return &controller.Neo4jMigrationReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-migration-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			}, "Neo4jMigration"
+		},
+		"cdc": func() (interface{ SetupWithManager(ctrl.Manager) error }, string) {
+			return &controller.Neo4jCDCReconciler{
+				Client:       mgr.GetClient(),
+				Scheme:       mgr.GetScheme(),
+				Recorder:     mgr.GetEventRecorderFor("neo4j-cdc-controller"),
+				RequeueAfter: controller.GetTestRequeueAfter(),
+			}, "Neo4jCDC"
+		},
 	}
 
 	for _, controllerName := range controllers {
@@ -574,6 +810,7 @@ func runManager(ctx context.Context, settings managerSettings, selection watchNa
 	} else {
 		applyWatchNamespaces(&cacheOpts, selection)
 	}
+	applyWatchLabelSelector(&cacheOpts, settings.watchLabelSelector)
 
 	var mgr ctrl.Manager
 	var err error
@@ -589,7 +826,7 @@ func runManager(ctx context.Context, settings managerSettings, selection watchNa
 			},
 			HealthProbeBindAddress: settings.probeAddr,
 			LeaderElection:         settings.enableLeaderElection,
-			LeaderElectionID:       "neo4j-operator-leader-election",
+			LeaderElectionID:       settings.leaderElectionID,
 			Cache:                  cacheOpts,
 		})
 	}
@@ -598,12 +835,34 @@ func runManager(ctx context.Context, settings managerSettings, selection watchNa
 		return fmt.Errorf("unable to start manager: %w", err)
 	}
 
-	if err = setupControllers(mgr, settings.operatorMode, settings.controllersToLoad); err != nil {
+	detected := detectCapabilities(settings.config)
+	operatormetrics.RecordCapabilities(detected)
+	// securityContext.appArmorProfile needs Kubernetes 1.30; without a
+	// detected version the annotations are the safe choice
+	resources.SetAppArmorAnnotations(!detected.AtLeast(1, 30))
+
+	if err = setupControllers(mgr, settings.operatorMode, settings.controllersToLoad, settings.slowReconcileThreshold, settings.securityAudit, detected); err != nil {
 		return fmt.Errorf("failed to setup controllers: %w", err)
 	}
 
+	if settings.enableWebhooks {
+		if err := webhookv1alpha1.SetupNeo4jEnterpriseClusterWebhookWithManager(mgr, settings.watchLabelSelector); err != nil {
+			return fmt.Errorf("failed to setup Neo4jEnterpriseCluster webhook: %w", err)
+		}
+		if err := webhookv1alpha1.SetupNeo4jEnterpriseStandaloneWebhookWithManager(mgr, settings.watchLabelSelector); err != nil {
+			return fmt.Errorf("failed to setup Neo4jEnterpriseStandalone webhook: %w", err)
+		}
+		if err := webhookv1alpha1.SetupNeo4jBackupWebhookWithManager(mgr, settings.watchLabelSelector); err != nil {
+			return fmt.Errorf("failed to setup Neo4jBackup webhook: %w", err)
+		}
+		if err := webhookv1alpha1.SetupNeo4jRestoreWebhookWithManager(mgr, settings.watchLabelSelector); err != nil {
+			return fmt.Errorf("failed to setup Neo4jRestore webhook: %w", err)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
+	operatormetrics.SetInventoryReader(mgr.GetClient())
+
 	if cacheManager != nil {
 		cacheManager.SetClient(mgr.GetClient())
 		cacheManager.StartMemoryMonitoring(ctx)
@@ -1117,6 +1376,66 @@ func applyWatchNamespaces(cacheOpts *cache.Options, selection watchNamespaceSele
 	}
 }
 
+// parseWatchLabelSelector parses --watch-label-selector. An empty value
+// returns nil, which manages every custom resource.
+func parseWatchLabelSelector(raw string) (labels.Selector, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", raw, err)
+	}
+	if selector.Empty() {
+		return nil, nil
+	}
+	return selector, nil
+}
+
+// watchLabelSelectorObjects are the custom resources --watch-label-selector
+// applies to. Cluster classes are shared templates and stay visible to every
+// operator.
+func watchLabelSelectorObjects() []client.Object {
+	return []client.Object{
+		&neo4jv1alpha1.Neo4jEnterpriseCluster{},
+		&neo4jv1alpha1.Neo4jEnterpriseStandalone{},
+		&neo4jv1alpha1.Neo4jDatabase{},
+		&neo4jv1alpha1.Neo4jBackup{},
+		&neo4jv1alpha1.Neo4jRestore{},
+		&neo4jv1alpha1.Neo4jPlugin{},
+		&neo4jv1alpha1.Neo4jShardedDatabase{},
+		&neo4jv1alpha1.Neo4jWorkload{},
+		&neo4jv1alpha1.Neo4jUserSync{},
+		&neo4jv1alpha1.Neo4jMigration{},
+		&neo4jv1alpha1.Neo4jCDC{},
+	}
+}
+
+// applyWatchLabelSelector hides the custom resources that do not match the
+// selector from the cache. The controllers never see them, so objects owned
+// by an unlabeled resource are left to the operator that manages it.
+func applyWatchLabelSelector(cacheOpts *cache.Options, selector labels.Selector) {
+	if selector == nil {
+		return
+	}
+	byObject := make(map[client.Object]cache.ByObject, len(cacheOpts.ByObject))
+	byType := make(map[reflect.Type]client.Object, len(cacheOpts.ByObject))
+	for obj, opts := range cacheOpts.ByObject {
+		byObject[obj] = opts
+		byType[reflect.TypeOf(obj)] = obj
+	}
+	for _, obj := range watchLabelSelectorObjects() {
+		if existing, ok := byType[reflect.TypeOf(obj)]; ok {
+			obj = existing
+		}
+		opts := byObject[obj]
+		opts.Label = selector
+		byObject[obj] = opts
+	}
+	cacheOpts.ByObject = byObject
+}
+
 func watchNamespaceChanges(ctx context.Context, clientset kubernetes.Interface, changeCh chan<- struct{}) {
 	backoff := 1 * time.Second
 	for {
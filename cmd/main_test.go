@@ -7,7 +7,12 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 )
 
 func TestParseWatchNamespaceConfigEmpty(t *testing.T) {
@@ -96,3 +101,61 @@ func TestWatchNamespaceSelectionEqual(t *testing.T) {
 		t.Fatalf("expected selections to differ")
 	}
 }
+
+func TestParseWatchLabelSelector(t *testing.T) {
+	selector, err := parseWatchLabelSelector(" ")
+	if err != nil || selector != nil {
+		t.Fatalf("expected no selector for empty input, got %v, %v", selector, err)
+	}
+	if _, err := parseWatchLabelSelector("team in (payments"); err == nil {
+		t.Fatalf("expected an error for a malformed selector")
+	}
+	selector, err = parseWatchLabelSelector("team=payments")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !selector.Matches(labels.Set{"team": "payments"}) || selector.Matches(labels.Set{"team": "search"}) {
+		t.Fatalf("unexpected selector: %s", selector)
+	}
+}
+
+func TestApplyWatchLabelSelector(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	base := cache.Options{ByObject: map[client.Object]cache.ByObject{
+		cluster:       {},
+		&corev1.Pod{}: {},
+	}}
+	selector := labels.SelectorFromSet(labels.Set{"team": "payments"})
+
+	opts := base
+	applyWatchLabelSelector(&opts, selector)
+	if len(opts.ByObject) != len(watchLabelSelectorObjects())+1 {
+		t.Fatalf("expected every custom resource and the pod entry, got %d entries", len(opts.ByObject))
+	}
+	if label := opts.ByObject[cluster].Label; label == nil || label.String() != "team=payments" {
+		t.Fatalf("expected the existing cluster entry to get the selector")
+	}
+	for obj, byObject := range opts.ByObject {
+		if _, isPod := obj.(*corev1.Pod); isPod && byObject.Label != nil {
+			t.Fatalf("expected owned resources to stay unfiltered")
+		}
+		if _, isClass := obj.(*neo4jv1alpha1.Neo4jClusterClass); isClass {
+			t.Fatalf("expected cluster classes to stay unfiltered")
+		}
+	}
+	if base.ByObject[cluster].Label != nil {
+		t.Fatalf("expected the base cache options to be left alone")
+	}
+}
+
+func TestOTLPEndpointFromEnv(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4318")
+	if got := otlpEndpointFromEnv(); got != "http://collector:4318" {
+		t.Fatalf("expected the generic endpoint, got %q", got)
+	}
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "tempo:4317")
+	if got := otlpEndpointFromEnv(); got != "tempo:4317" {
+		t.Fatalf("expected the traces endpoint to win, got %q", got)
+	}
+}
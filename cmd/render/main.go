@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command render prints the objects the operator would create for the
+// Neo4jEnterpriseCluster and Neo4jEnterpriseStandalone resources of a
+// manifest, without connecting to a Kubernetes cluster:
+//
+//	go run ./cmd/render -f my-cluster.yaml -n neo4j > rendered.yaml
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/controller"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(neo4jv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(certv1.AddToScheme(scheme))
+}
+
+func main() {
+	file := flag.String("f", "-", "Manifest with the resources to render, - for stdin")
+	namespace := flag.String("n", "default", "Namespace of resources that do not set one")
+	flag.Parse()
+
+	in := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "render: %v\n", err)
+			os.Exit(1)
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	if err := render(in, os.Stdout, os.Stderr, *namespace); err != nil {
+		fmt.Fprintf(os.Stderr, "render: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// render writes the objects generated for every cluster and standalone of
+// the manifest to out as a multi-document YAML stream. Other kinds are
+// skipped with a note on errOut.
+func render(in io.Reader, out, errOut io.Writer, namespace string) error {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(in, 4096)
+	for {
+		var doc map[string]any
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		objects, err := renderDocument(doc, namespace)
+		if err != nil {
+			return err
+		}
+		if objects == nil {
+			fmt.Fprintf(errOut, "skipping %v %v: not rendered by the operator\n", doc["kind"], name(doc))
+			continue
+		}
+		for _, obj := range objects {
+			if err := writeObject(out, obj); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// renderDocument renders one manifest document, returning nil for kinds the
+// operator does not generate objects for.
+func renderDocument(doc map[string]any, namespace string) ([]client.Object, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch doc["kind"] {
+	case "Neo4jEnterpriseCluster":
+		cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := json.Unmarshal(data, cluster); err != nil {
+			return nil, fmt.Errorf("decode Neo4jEnterpriseCluster %s: %w", name(doc), err)
+		}
+		if cluster.Namespace == "" {
+			cluster.Namespace = namespace
+		}
+		return controller.RenderCluster(cluster, scheme)
+	case "Neo4jEnterpriseStandalone":
+		standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+		if err := json.Unmarshal(data, standalone); err != nil {
+			return nil, fmt.Errorf("decode Neo4jEnterpriseStandalone %s: %w", name(doc), err)
+		}
+		if standalone.Namespace == "" {
+			standalone.Namespace = namespace
+		}
+		return controller.RenderStandalone(standalone, scheme)
+	}
+	return nil, nil
+}
+
+// writeObject writes one YAML document, dropping the empty status and
+// creation timestamp every typed object serializes.
+func writeObject(out io.Writer, obj client.Object) error {
+	doc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return fmt.Errorf("convert %s: %w", obj.GetName(), err)
+	}
+	delete(doc, "status")
+	if metadata, ok := doc["metadata"].(map[string]any); ok {
+		delete(metadata, "creationTimestamp")
+	}
+
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", obj.GetName(), err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(data)
+	_, err = out.Write(buf.Bytes())
+	return err
+}
+
+// name returns the metadata.name of a manifest document.
+func name(doc map[string]any) any {
+	if metadata, ok := doc["metadata"].(map[string]any); ok {
+		return metadata["name"]
+	}
+	return nil
+}
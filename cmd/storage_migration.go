@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// migrateStorageCommand is the subcommand that rewrites stored custom
+// resources in the storage version of their CRD
+const migrateStorageCommand = "migrate-storage"
+
+// storageMigrationOptions selects the CRDs a storage migration covers
+type storageMigrationOptions struct {
+	group    string
+	crds     []string
+	pageSize int64
+	dryRun   bool
+}
+
+// runStorageMigration runs the migrate-storage subcommand and returns its
+// exit code
+func runStorageMigration(args []string, out io.Writer) int {
+	flags := flag.NewFlagSet(migrateStorageCommand, flag.ContinueOnError)
+	group := flags.String("group", neo4jv1alpha1.GroupVersion.Group, "API group whose CRDs are migrated")
+	crds := flags.String("crds", "", "Comma-separated CRD names to migrate, e.g. neo4jenterpriseclusters.neo4j.neo4j.com (empty migrates every CRD of the group)")
+	pageSize := flags.Int64("page-size", 500, "Objects listed per request")
+	dryRun := flags.Bool("dry-run", false, "Only report what would be migrated")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	opts := storageMigrationOptions{group: *group, pageSize: *pageSize, dryRun: *dryRun}
+	for _, name := range strings.Split(*crds, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			opts.crds = append(opts.crds, name)
+		}
+	}
+
+	migrationScheme := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(migrationScheme); err != nil {
+		fmt.Fprintf(out, "%s: %v\n", migrateStorageCommand, err)
+		return 1
+	}
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: migrationScheme})
+	if err != nil {
+		fmt.Fprintf(out, "%s: failed to create client: %v\n", migrateStorageCommand, err)
+		return 1
+	}
+
+	if err := migrateStorage(ctrl.SetupSignalHandler(), c, out, opts); err != nil {
+		fmt.Fprintf(out, "%s: %v\n", migrateStorageCommand, err)
+		return 1
+	}
+	return 0
+}
+
+// migrateStorage rewrites every object of the selected CRDs, which makes the
+// API server store it in the current storage version, and then trims
+// status.storedVersions to that version so older versions can stop being
+// served. Progress is written to out per CRD.
+func migrateStorage(ctx context.Context, c client.Client, out io.Writer, opts storageMigrationOptions) error {
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, crdList); err != nil {
+		return fmt.Errorf("failed to list CRDs: %w", err)
+	}
+
+	var failed, found []string
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		if crd.Spec.Group != opts.group || (len(opts.crds) > 0 && !slices.Contains(opts.crds, crd.Name)) {
+			continue
+		}
+		found = append(found, crd.Name)
+		if err := migrateCRDStorage(ctx, c, out, crd, opts); err != nil {
+			fmt.Fprintf(out, "%s: %v\n", crd.Name, err)
+			failed = append(failed, crd.Name)
+		}
+	}
+	for _, name := range opts.crds {
+		if !slices.Contains(found, name) {
+			failed = append(failed, name+" (not found)")
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("migration failed for %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// migrateCRDStorage migrates the objects of one CRD
+func migrateCRDStorage(ctx context.Context, c client.Client, out io.Writer, crd *apiextensionsv1.CustomResourceDefinition, opts storageMigrationOptions) error {
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+	if storageVersion == "" {
+		return fmt.Errorf("no storage version")
+	}
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		fmt.Fprintf(out, "%s: already stored as %s only\n", crd.Name, storageVersion)
+		return nil
+	}
+	fmt.Fprintf(out, "%s: stored versions %v, migrating to %s\n",
+		crd.Name, crd.Status.StoredVersions, storageVersion)
+
+	gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: storageVersion, Kind: crd.Spec.Names.Kind}
+	migrated := 0
+	continueToken := ""
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, list, client.Limit(opts.pageSize), client.Continue(continueToken)); err != nil {
+			return fmt.Errorf("failed to list objects: %w", err)
+		}
+		for i := range list.Items {
+			if !opts.dryRun {
+				if err := rewriteObject(ctx, c, &list.Items[i]); err != nil {
+					return fmt.Errorf("failed to rewrite %s after %d objects: %w",
+						client.ObjectKeyFromObject(&list.Items[i]), migrated, err)
+				}
+			}
+			migrated++
+		}
+		fmt.Fprintf(out, "%s: %d objects rewritten\n", crd.Name, migrated)
+		if continueToken = list.GetContinue(); continueToken == "" {
+			break
+		}
+	}
+
+	if opts.dryRun {
+		fmt.Fprintf(out, "%s: dry run, %d objects would be rewritten as %s\n", crd.Name, migrated, storageVersion)
+		return nil
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &apiextensionsv1.CustomResourceDefinition{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(crd), latest); err != nil {
+			return err
+		}
+		latest.Status.StoredVersions = []string{storageVersion}
+		return c.Status().Update(ctx, latest)
+	}); err != nil {
+		return fmt.Errorf("failed to update stored versions: %w", err)
+	}
+	fmt.Fprintf(out, "%s: done, %d objects stored as %s\n", crd.Name, migrated, storageVersion)
+	return nil
+}
+
+// rewriteObject writes an object back unchanged. An object that changed or
+// went away in the meantime has been stored in the current version already.
+func rewriteObject(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	err := c.Update(ctx, obj)
+	if errors.IsConflict(err) || errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
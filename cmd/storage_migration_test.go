@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func storageMigrationCRD(name, kind string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: neo4jv1alpha1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: kind},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func TestMigrateStorage(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := neo4jv1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clusters := storageMigrationCRD("neo4jenterpriseclusters.neo4j.neo4j.com", "Neo4jEnterpriseCluster", "v1alpha0", "v1alpha1")
+	backups := storageMigrationCRD("neo4jbackups.neo4j.neo4j.com", "Neo4jBackup", "v1alpha1")
+	c := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(clusters, backups,
+			&neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "team-a"}},
+			&neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-b"}}).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).Build()
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	opts := storageMigrationOptions{group: neo4jv1alpha1.GroupVersion.Group, pageSize: 500, dryRun: true}
+	if err := migrateStorage(ctx, c, &out, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "dry run, 2 objects would be rewritten as v1alpha1") {
+		t.Fatalf("unexpected dry run output:\n%s", out.String())
+	}
+	latest := &apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(clusters), latest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(latest.Status.StoredVersions) != 2 {
+		t.Fatalf("dry run changed stored versions: %v", latest.Status.StoredVersions)
+	}
+
+	out.Reset()
+	opts.dryRun = false
+	if err := migrateStorage(ctx, c, &out, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(clusters), latest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(latest.Status.StoredVersions, []string{"v1alpha1"}) {
+		t.Fatalf("expected stored versions [v1alpha1], got %v", latest.Status.StoredVersions)
+	}
+	for _, line := range []string{
+		"neo4jenterpriseclusters.neo4j.neo4j.com: done, 2 objects stored as v1alpha1",
+		"neo4jbackups.neo4j.neo4j.com: already stored as v1alpha1 only",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Fatalf("expected %q in output:\n%s", line, out.String())
+		}
+	}
+
+	opts.crds = []string{"neo4jmissing.neo4j.neo4j.com"}
+	if err := migrateStorage(ctx, c, &out, opts); err == nil {
+		t.Fatalf("expected an error for an unknown CRD")
+	}
+}
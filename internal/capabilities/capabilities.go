@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capabilities detects the Kubernetes version and the optional APIs
+// served by the cluster the operator runs in
+package capabilities
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/discovery"
+)
+
+// Names of the optional APIs the operator looks for
+const (
+	// Route is the OpenShift Route API
+	Route = "Route"
+	// ServiceMonitor is the Prometheus Operator API for scrape targets
+	ServiceMonitor = "ServiceMonitor"
+	// PodMonitor is the Prometheus Operator API for scraping pods directly
+	PodMonitor = "PodMonitor"
+	// PrometheusRule is the Prometheus Operator API for alerting rules
+	PrometheusRule = "PrometheusRule"
+	// GatewayAPI is the Kubernetes Gateway API
+	GatewayAPI = "GatewayAPI"
+	// VolumeSnapshot is the CSI volume snapshot API
+	VolumeSnapshot = "VolumeSnapshot"
+	// HPAv2 is the autoscaling/v2 HorizontalPodAutoscaler API
+	HPAv2 = "HPAv2"
+	// VerticalPodAutoscaler is the API of the Kubernetes vertical pod autoscaler
+	VerticalPodAutoscaler = "VerticalPodAutoscaler"
+)
+
+// OptionalAPI is an API group the operator uses when it is present
+type OptionalAPI struct {
+	Name         string
+	GroupVersion string
+	Kind         string
+}
+
+// OptionalAPIs lists the APIs Detect looks for
+var OptionalAPIs = []OptionalAPI{
+	{Name: Route, GroupVersion: "route.openshift.io/v1", Kind: "Route"},
+	{Name: ServiceMonitor, GroupVersion: "monitoring.coreos.com/v1", Kind: "ServiceMonitor"},
+	{Name: PodMonitor, GroupVersion: "monitoring.coreos.com/v1", Kind: "PodMonitor"},
+	{Name: PrometheusRule, GroupVersion: "monitoring.coreos.com/v1", Kind: "PrometheusRule"},
+	{Name: GatewayAPI, GroupVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"},
+	{Name: VolumeSnapshot, GroupVersion: "snapshot.storage.k8s.io/v1", Kind: "VolumeSnapshot"},
+	{Name: HPAv2, GroupVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+	{Name: VerticalPodAutoscaler, GroupVersion: "autoscaling.k8s.io/v1", Kind: "VerticalPodAutoscaler"},
+}
+
+// Capabilities is what the cluster offers the operator
+type Capabilities struct {
+	// KubernetesVersion is the git version of the API server, e.g. v1.30.2
+	KubernetesVersion string
+	// APIs tells per optional API name whether it is served
+	APIs map[string]bool
+}
+
+// Detect asks the API server for its version and the optional APIs it serves
+func Detect(client discovery.DiscoveryInterface) (*Capabilities, error) {
+	serverVersion, err := client.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Kubernetes version: %w", err)
+	}
+
+	capabilities := &Capabilities{KubernetesVersion: serverVersion.GitVersion, APIs: map[string]bool{}}
+	for _, api := range OptionalAPIs {
+		resources, err := client.ServerResourcesForGroupVersion(api.GroupVersion)
+		if errors.IsNotFound(err) {
+			capabilities.APIs[api.Name] = false
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover %s: %w", api.GroupVersion, err)
+		}
+		capabilities.APIs[api.Name] = slices.ContainsFunc(resources.APIResources, func(resource metav1.APIResource) bool {
+			return resource.Kind == api.Kind
+		})
+	}
+	return capabilities, nil
+}
+
+// Has tells whether an optional API is served. Capabilities that were not
+// detected report every API as served, so callers fall back to trying.
+func (c *Capabilities) Has(name string) bool {
+	if c == nil {
+		return true
+	}
+	served, known := c.APIs[name]
+	return served || !known
+}
+
+// AtLeast tells whether the cluster runs Kubernetes major.minor or later.
+// Unlike Has, it is false when the version was not detected.
+func (c *Capabilities) AtLeast(major, minor uint) bool {
+	if c == nil {
+		return false
+	}
+	detected, err := version.ParseGeneric(c.KubernetesVersion)
+	if err != nil {
+		return false
+	}
+	return detected.AtLeast(version.MajorMinor(major, minor))
+}
+
+// Missing returns the names of the optional APIs that are not served
+func (c *Capabilities) Missing() []string {
+	var missing []string
+	for _, api := range OptionalAPIs {
+		if !c.Has(api.Name) {
+			missing = append(missing, api.Name)
+		}
+	}
+	return missing
+}
+
+// GroupVersion returns the group version of an optional API
+func GroupVersion(name string) string {
+	for _, api := range OptionalAPIs {
+		if api.Name == name {
+			return api.GroupVersion
+		}
+	}
+	return ""
+}
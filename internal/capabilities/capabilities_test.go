@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capabilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestDetect(t *testing.T) {
+	client := &fakediscovery.FakeDiscovery{
+		Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+			{GroupVersion: "autoscaling/v2", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler"}}},
+			{GroupVersion: "monitoring.coreos.com/v1", APIResources: []metav1.APIResource{{Name: "podmonitors", Kind: "PodMonitor"}}},
+			{GroupVersion: "snapshot.storage.k8s.io/v1", APIResources: []metav1.APIResource{{Name: "volumesnapshots", Kind: "VolumeSnapshot"}}},
+		}},
+		FakedServerVersion: &version.Info{GitVersion: "v1.30.2"},
+	}
+
+	capabilities, err := Detect(client)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.30.2", capabilities.KubernetesVersion)
+	assert.True(t, capabilities.Has(HPAv2))
+	assert.True(t, capabilities.Has(VolumeSnapshot))
+	// The group is served, but without the ServiceMonitor kind
+	assert.False(t, capabilities.Has(ServiceMonitor))
+	assert.True(t, capabilities.Has(PodMonitor))
+	assert.Equal(t, []string{Route, ServiceMonitor, PrometheusRule, GatewayAPI, VerticalPodAutoscaler}, capabilities.Missing())
+	assert.True(t, capabilities.AtLeast(1, 30))
+	assert.False(t, capabilities.AtLeast(1, 33))
+}
+
+func TestHasWithoutDetection(t *testing.T) {
+	var capabilities *Capabilities
+	assert.True(t, capabilities.Has(Route))
+	assert.Empty(t, capabilities.Missing())
+	assert.False(t, capabilities.AtLeast(1, 0))
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// adminCredentialsRequeueInterval is how often a cluster waiting for the
+// External Secrets Operator to sync its admin credentials is checked
+const adminCredentialsRequeueInterval = 10 * time.Second
+
+// adminPasswordClient changes the password of the admin user
+type adminPasswordClient interface {
+	SetUserPassword(ctx context.Context, username, password string) error
+	Close() error
+}
+
+// reconcileAdminCredentials syncs the admin credentials of
+// spec.auth.externalSecret. The External Secrets Operator writes them to a
+// Secret of their own, which the operator copies into the admin Secret: right
+// away when there is none yet, or once Neo4j uses a rotated password. The
+// admin Secret so always holds the password the servers accept. It returns
+// true while the credentials have not been synced, and the servers must not
+// start.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileAdminCredentials(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	desired := resources.BuildAdminExternalSecret(cluster)
+	if desired == nil {
+		return false, nil
+	}
+	externalSecret := &unstructured.Unstructured{}
+	externalSecret.SetUnstructuredContent(desired)
+	if err := r.createOrUpdateUnstructuredResource(ctx, externalSecret, cluster); err != nil {
+		return false, fmt.Errorf("failed to reconcile ExternalSecret %s: %w", externalSecret.GetName(), err)
+	}
+
+	syncedName := resources.AdminExternalSecretTarget(cluster)
+	synced := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: syncedName, Namespace: cluster.Namespace}, synced); err != nil {
+		if errors.IsNotFound(err) {
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonAdminCredentialsPending,
+				fmt.Sprintf("Waiting for the External Secrets Operator to sync the admin credentials into %s", syncedName))
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get Secret %s: %w", syncedName, err)
+	}
+	username, password := synced.Data["username"], synced.Data["password"]
+	if len(username) == 0 || len(password) == 0 {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonAdminCredentialsPending,
+			fmt.Sprintf("Secret %s needs a username and a password", syncedName))
+		return true, nil
+	}
+
+	adminName := getClusterAdminSecretName(cluster)
+	admin := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: adminName, Namespace: cluster.Namespace}, admin); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get Secret %s: %w", adminName, err)
+		}
+		admin = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      adminName,
+				Namespace: cluster.Namespace,
+				Labels:    map[string]string{"app.kubernetes.io/name": "neo4j", "neo4j.com/cluster": cluster.Name},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{"username": username, "password": password},
+		}
+		if err := controllerutil.SetControllerReference(cluster, admin, r.Scheme); err != nil {
+			return false, err
+		}
+		if err := r.Create(ctx, admin); err != nil {
+			return false, fmt.Errorf("failed to create Secret %s: %w", adminName, err)
+		}
+		return false, nil
+	}
+
+	if bytes.Equal(admin.Data["password"], password) && bytes.Equal(admin.Data["username"], username) {
+		return false, nil
+	}
+	if !bytes.Equal(admin.Data["username"], username) {
+		return false, fmt.Errorf("secret %s renames the admin user from %s to %s, which the operator does not do",
+			syncedName, admin.Data["username"], username)
+	}
+	if cluster.Status.Phase != "Ready" {
+		// The servers run, or start, with the password of the admin Secret
+		return false, nil
+	}
+
+	if err := r.rotateAdminPassword(ctx, cluster, string(username), string(password)); err != nil {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonAdminPasswordRotationFailed,
+			fmt.Sprintf("Failed to change the admin password: %v", err))
+		return false, err
+	}
+	admin.Data["password"] = password
+	if err := r.Update(ctx, admin); err != nil {
+		// The next reconcile sets the password again and retries
+		return false, fmt.Errorf("failed to update Secret %s: %w", adminName, err)
+	}
+	log.FromContext(ctx).Info("Rotated the admin password", "secret", adminName)
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonAdminPasswordRotated,
+		fmt.Sprintf("Changed the password of %s to the one synced into %s", username, syncedName))
+	return false, nil
+}
+
+// rotateAdminPassword sets the password of the admin user, connected with
+// the current one
+func (r *Neo4jEnterpriseClusterReconciler) rotateAdminPassword(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, username, password string) error {
+	var neo4jClient adminPasswordClient
+	var err error
+	if r.newAdminPasswordClient != nil {
+		neo4jClient, err = r.newAdminPasswordClient(ctx, cluster)
+	} else {
+		// The operator user holds no user management of its own
+		neo4jClient, err = neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
+	}
+	if err != nil {
+		return err
+	}
+	defer neo4jClient.Close()
+	return neo4jClient.SetUserPassword(ctx, username, password)
+}
+
+// syncsAdminCredentials reports whether a Secret holds the admin credentials
+// the External Secrets Operator synced for the cluster
+func syncsAdminCredentials(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, obj client.Object) bool {
+	return cluster.Spec.Auth != nil && cluster.Spec.Auth.ExternalSecret != nil &&
+		obj.GetName() == resources.AdminExternalSecretTarget(cluster)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+type fakeAdminPasswordClient struct {
+	passwords map[string]string
+}
+
+func (f *fakeAdminPasswordClient) SetUserPassword(_ context.Context, username, password string) error {
+	f.passwords[username] = password
+	return nil
+}
+
+func (f *fakeAdminPasswordClient) Close() error { return nil }
+
+func TestReconcileAdminCredentials(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{
+		AdminSecret: "prod-admin",
+		ExternalSecret: &neo4jv1alpha1.AdminExternalSecretSpec{
+			SecretStoreRef: neo4jv1alpha1.SecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"},
+			Key:            "neo4j/prod",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	passwords := map[string]string{}
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	r.newAdminPasswordClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (adminPasswordClient, error) {
+		return &fakeAdminPasswordClient{passwords: passwords}, nil
+	}
+
+	// The servers wait for the External Secrets Operator
+	pending, err := r.reconcileAdminCredentials(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	externalSecret := &unstructured.Unstructured{}
+	externalSecret.SetGroupVersionKind(schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1beta1", Kind: "ExternalSecret"})
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-admin-external-secret", Namespace: "default"}, externalSecret))
+	data, _, _ := unstructured.NestedSlice(externalSecret.Object, "spec", "data")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"secretKey": "username", "remoteRef": map[string]interface{}{"key": "neo4j/prod", "property": "username"}},
+		map[string]interface{}{"secretKey": "password", "remoteRef": map[string]interface{}{"key": "neo4j/prod", "property": "password"}},
+	}, data)
+
+	// Synced credentials become the admin Secret
+	synced := tlsSecret("prod-admin-secret", "default", map[string]string{"username": "neo4j", "password": "first"})
+	require.NoError(t, c.Create(ctx, synced))
+	pending, err = r.reconcileAdminCredentials(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	admin := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-admin", Namespace: "default"}, admin))
+	assert.Equal(t, "first", string(admin.Data["password"]))
+
+	// A rotated password waits for a Ready cluster, then is changed in Neo4j
+	synced.Data["password"] = []byte("second")
+	require.NoError(t, c.Update(ctx, synced))
+	_, err = r.reconcileAdminCredentials(ctx, cluster)
+	require.NoError(t, err)
+	assert.Empty(t, passwords)
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-admin", Namespace: "default"}, admin))
+	assert.Equal(t, "first", string(admin.Data["password"]))
+
+	cluster.Status.Phase = "Ready"
+	_, err = r.reconcileAdminCredentials(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"neo4j": "second"}, passwords)
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-admin", Namespace: "default"}, admin))
+	assert.Equal(t, "second", string(admin.Data["password"]))
+
+	// Renaming the admin user is refused
+	synced.Data["username"] = []byte("admin")
+	require.NoError(t, c.Update(ctx, synced))
+	_, err = r.reconcileAdminCredentials(ctx, cluster)
+	require.EqualError(t, err, "secret prod-admin-secret renames the admin user from neo4j to admin, which the operator does not do")
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+// BackupBenchmarkAnnotation requests a backup/restore throughput benchmark
+// for a Neo4jBackup. Every new value starts a new run, e.g.
+//
+//	kubectl annotate neo4jbackup nightly neo4j.neo4j.com/benchmark="$(date +%s)" --overwrite
+const BackupBenchmarkAnnotation = "neo4j.neo4j.com/benchmark"
+
+const (
+	benchmarkContainerName = "benchmark"
+	// benchmarkScratchPath is the emptyDir the benchmark restores into
+	benchmarkScratchPath = "/benchmark"
+)
+
+// benchmarkReport is the JSON document the benchmark script writes to its
+// termination log.
+type benchmarkReport struct {
+	Database      string `json:"database"`
+	StoreBytes    int64  `json:"storeBytes"`
+	BackupMillis  int64  `json:"backupMs"`
+	RestoreMillis int64  `json:"restoreMs"`
+}
+
+// reconcileBenchmark runs the benchmark requested by BackupBenchmarkAnnotation
+// once per annotation value. The benchmark takes a full backup of one
+// database to the backup's storage location and restores it into scratch
+// space inside the benchmark pod, so the live deployment is never touched.
+func (r *Neo4jBackupReconciler) reconcileBenchmark(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	trigger := backup.Annotations[BackupBenchmarkAnnotation]
+	if trigger == "" {
+		return nil
+	}
+	if current := backup.Status.Benchmark; current != nil && current.Trigger == trigger &&
+		(current.Phase == "Completed" || current.Phase == "Failed") {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: backup.Name + "-benchmark", Namespace: backup.Namespace}, job)
+	switch {
+	case err == nil && job.Annotations[BackupBenchmarkAnnotation] == trigger:
+		return r.handleBenchmarkJob(ctx, backup, job)
+	case err == nil:
+		// Job of an earlier run; the deletion requeues the backup and the
+		// next reconcile starts the new run.
+		propagation := metav1.DeletePropagationBackground
+		if err := r.Delete(ctx, job, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete previous benchmark job: %w", err)
+		}
+		return nil
+	case !errors.IsNotFound(err):
+		return err
+	}
+
+	if err := r.ensureBackupServiceAccount(ctx, backup); err != nil {
+		return err
+	}
+	job, database, err := r.buildBenchmarkJob(backup, cluster, trigger)
+	if err != nil {
+		r.updateBenchmarkStatus(ctx, backup, &neo4jv1alpha1.BackupBenchmark{
+			Trigger: trigger,
+			Phase:   "Failed",
+			Message: err.Error(),
+		})
+		return err
+	}
+	if err := controllerutil.SetControllerReference(backup, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		return fmt.Errorf("failed to create benchmark job: %w", err)
+	}
+
+	now := metav1.Now()
+	r.updateBenchmarkStatus(ctx, backup, &neo4jv1alpha1.BackupBenchmark{
+		Trigger:   trigger,
+		Phase:     "Running",
+		Message:   fmt.Sprintf("Benchmark job %s created", job.Name),
+		Database:  database,
+		StartTime: &now,
+	})
+	r.Recorder.Eventf(backup, corev1.EventTypeNormal, EventReasonBenchmarkStarted,
+		"Benchmarking backup and restore of database %s against %s storage", database, backup.Spec.Storage.Type)
+	return nil
+}
+
+// handleBenchmarkJob records the outcome of a finished benchmark job.
+func (r *Neo4jBackupReconciler) handleBenchmarkJob(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup, job *batchv1.Job) error {
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		return nil
+	}
+
+	result := &neo4jv1alpha1.BackupBenchmark{Trigger: job.Annotations[BackupBenchmarkAnnotation]}
+	if backup.Status.Benchmark != nil {
+		result = backup.Status.Benchmark.DeepCopy()
+	}
+	now := metav1.Now()
+	result.CompletionTime = &now
+
+	message, err := jobTerminationMessage(ctx, r.Client, job, benchmarkContainerName)
+	if err != nil {
+		log.FromContext(ctx).Info("Benchmark report not available", "job", job.Name, "error", err.Error())
+	}
+
+	report := &benchmarkReport{}
+	if job.Status.Succeeded > 0 && message != "" && json.Unmarshal([]byte(message), report) == nil {
+		report.apply(result)
+		result.Phase = "Completed"
+		result.Message = fmt.Sprintf("Backup %s, restore %s (%s store)", result.BackupThroughput, result.RestoreThroughput, result.StoreSize)
+		r.updateBenchmarkStatus(ctx, backup, result)
+		r.Recorder.Event(backup, corev1.EventTypeNormal, EventReasonBenchmarkCompleted, result.Message)
+		return nil
+	}
+
+	result.Phase = "Failed"
+	result.Message = "Benchmark job failed"
+	if lines := strings.Split(strings.TrimSpace(message), "\n"); lines[len(lines)-1] != "" {
+		result.Message += ": " + lines[len(lines)-1]
+	}
+	r.updateBenchmarkStatus(ctx, backup, result)
+	r.Recorder.Event(backup, corev1.EventTypeWarning, EventReasonBenchmarkFailed, result.Message)
+	return nil
+}
+
+// buildBenchmarkJob returns the benchmark Job and the database it benchmarks.
+// Cluster backups are benchmarked with the default "neo4j" database.
+func (r *Neo4jBackupReconciler) buildBenchmarkJob(backup *neo4jv1alpha1.Neo4jBackup, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, trigger string) (*batchv1.Job, string, error) {
+	image := resources.ImageReference(cluster.Spec.Image)
+	imageVersion, err := version.FromImage(image)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to determine Neo4j version of %s: %w", image, err)
+	}
+
+	database := "neo4j"
+	if backup.Spec.Target.Kind == "Database" {
+		database = backup.Spec.Target.Name
+	}
+
+	target := r.benchmarkPath(backup)
+	backupCmd := neo4j.GetBackupCommand(imageVersion, database, target, false, resources.BuildBackupFromAddresses(cluster)) + " --type=FULL"
+	restoreCmd := neo4j.GetRestoreCommand(imageVersion, database, target) +
+		fmt.Sprintf(" --to-path-data=%s/data --to-path-txn=%s/txn", benchmarkScratchPath, benchmarkScratchPath)
+
+	prepare, cleanup := "", ""
+	if backup.Spec.Storage.Type == "pvc" {
+		prepare = fmt.Sprintf("rm -rf %s && mkdir -p %s\n", target, target)
+		cleanup = fmt.Sprintf("rm -rf %s\n", target)
+	}
+
+	script := fmt.Sprintf(`set -e
+now_ms() { echo $(( $(date +%%s%%N) / 1000000 )); }
+%sstart=$(now_ms)
+%s
+backed_up=$(now_ms)
+%s
+restored=$(now_ms)
+bytes=$(du -sb %s/data %s/txn | awk '{ s += $1 } END { print s }')
+%sprintf '{"database":"%s","storeBytes":%%s,"backupMs":%%s,"restoreMs":%%s}' "$bytes" "$((backed_up - start))" "$((restored - backed_up))" > /dev/termination-log`,
+		prepare, backupCmd, restoreCmd, benchmarkScratchPath, benchmarkScratchPath, cleanup, database)
+
+	backoffLimit := int32(0)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backup.Name + "-benchmark",
+			Namespace: backup.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "neo4j-backup",
+				"app.kubernetes.io/instance":   backup.Name,
+				"app.kubernetes.io/component":  "benchmark",
+				"app.kubernetes.io/managed-by": "neo4j-operator",
+			},
+			Annotations: map[string]string{BackupBenchmarkAnnotation: trigger},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: backupServiceAccountName,
+					ImagePullSecrets:   resources.ImagePullSecrets(cluster.Spec.Image),
+					Containers: []corev1.Container{
+						{
+							Name:            benchmarkContainerName,
+							Image:           image,
+							ImagePullPolicy: resources.ImagePullPolicy(cluster.Spec.Image),
+							Command:         []string{"/bin/sh"},
+							Args:            []string{"-c", withCloudTrustStore(cloudBlockForBackup(backup), script)},
+							Env:             r.buildCloudEnvVars(backup),
+							VolumeMounts: append(r.buildVolumeMounts(backup),
+								corev1.VolumeMount{Name: "benchmark-scratch", MountPath: benchmarkScratchPath}),
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					Volumes: append(r.buildVolumes(backup), corev1.Volume{
+						Name:         "benchmark-scratch",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					}),
+				},
+			},
+		},
+	}
+
+	resources.ApplySecurityProfiles(&job.Spec.Template, cluster.Spec.SecurityContext)
+	return job, database, nil
+}
+
+// benchmarkPath returns where the benchmark backup is written: a .benchmark
+// directory next to the regular backups, so it never joins their backup chain.
+func (r *Neo4jBackupReconciler) benchmarkPath(backup *neo4jv1alpha1.Neo4jBackup) string {
+	switch backup.Spec.Storage.Type {
+	case "s3", "gcs", "azure":
+		return r.buildToPath(backup) + ".benchmark/"
+	default: // pvc
+		return "/backup/.benchmark"
+	}
+}
+
+func (r *Neo4jBackupReconciler) updateBenchmarkStatus(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup, benchmark *neo4jv1alpha1.BackupBenchmark) {
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jBackup{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(backup), latest); err != nil {
+			return err
+		}
+		latest.Status.Benchmark = benchmark
+		return r.Status().Update(ctx, latest)
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update backup benchmark status")
+	}
+}
+
+// apply copies the measured sizes, durations and throughputs into the status.
+func (b *benchmarkReport) apply(result *neo4jv1alpha1.BackupBenchmark) {
+	result.Database = b.Database
+	result.StoreSize = formatBinaryBytes(float64(b.StoreBytes))
+	result.BackupDuration = (time.Duration(b.BackupMillis) * time.Millisecond).String()
+	result.RestoreDuration = (time.Duration(b.RestoreMillis) * time.Millisecond).String()
+	result.BackupThroughput = benchmarkThroughput(b.StoreBytes, b.BackupMillis)
+	result.RestoreThroughput = benchmarkThroughput(b.StoreBytes, b.RestoreMillis)
+}
+
+func benchmarkThroughput(bytes, millis int64) string {
+	if millis <= 0 {
+		return ""
+	}
+	return formatBinaryBytes(float64(bytes)*1000/float64(millis)) + "/s"
+}
+
+// formatBinaryBytes formats a byte count with binary units, e.g. "85.3MiB".
+func formatBinaryBytes(bytes float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	i := 0
+	for bytes >= 1024 && i < len(units)-1 {
+		bytes /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f%s", bytes, units[i])
+	}
+	return fmt.Sprintf("%.1f%s", bytes, units[i])
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func newBenchmarkTestReconciler(objs ...client.Object) (*Neo4jBackupReconciler, client.Client, *record.FakeRecorder) {
+	scheme := newTestScheme()
+	_ = batchv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jBackup{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	return &Neo4jBackupReconciler{Client: c, Scheme: scheme, Recorder: recorder}, c, recorder
+}
+
+func benchmarkBackup(trigger string) *neo4jv1alpha1.Neo4jBackup {
+	return &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nightly",
+			Namespace:   "default",
+			Annotations: map[string]string{BackupBenchmarkAnnotation: trigger},
+		},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target:  neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "orders", ClusterRef: "prod"},
+			Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "neo4j-backups", Path: "prod"},
+		},
+	}
+}
+
+func TestReconcileBenchmark_CreatesJob(t *testing.T) {
+	ctx := context.Background()
+	backup := benchmarkBackup("1")
+	cluster := minimalCluster("prod", "default")
+	r, c, recorder := newBenchmarkTestReconciler(backup)
+
+	require.NoError(t, r.reconcileBenchmark(ctx, backup, cluster))
+
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "nightly-benchmark", Namespace: "default"}, job))
+	assert.Equal(t, "1", job.Annotations[BackupBenchmarkAnnotation])
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, container.TerminationMessagePolicy)
+	script := container.Args[1]
+	assert.Contains(t, script, "--to-path=s3://neo4j-backups/prod/.benchmark/ orders --type=FULL")
+	assert.Contains(t, script, "--from-path=s3://neo4j-backups/prod/.benchmark/ orders --to-path-data=/benchmark/data --to-path-txn=/benchmark/txn")
+	assert.NotContains(t, script, "rm -rf", "cloud artifacts are left to bucket lifecycle rules")
+
+	updated := &neo4jv1alpha1.Neo4jBackup{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(backup), updated))
+	require.NotNil(t, updated.Status.Benchmark)
+	assert.Equal(t, "Running", updated.Status.Benchmark.Phase)
+	assert.Equal(t, "orders", updated.Status.Benchmark.Database)
+	assert.Len(t, recorder.Events, 1)
+
+	sa := &corev1.ServiceAccount{}
+	assert.NoError(t, c.Get(ctx, types.NamespacedName{Name: backupServiceAccountName, Namespace: "default"}, sa))
+}
+
+func TestReconcileBenchmark_PVCStorageIsCleanedUp(t *testing.T) {
+	backup := benchmarkBackup("1")
+	backup.Spec.Target = neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "prod"}
+	backup.Spec.Storage = neo4jv1alpha1.StorageLocation{Type: "pvc", PVC: &neo4jv1alpha1.PVCSpec{Name: "backups"}}
+	r, _, _ := newBenchmarkTestReconciler()
+
+	job, database, err := r.buildBenchmarkJob(backup, minimalCluster("prod", "default"), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "neo4j", database)
+	script := job.Spec.Template.Spec.Containers[0].Args[1]
+	assert.Contains(t, script, "rm -rf /backup/.benchmark && mkdir -p /backup/.benchmark")
+	assert.Contains(t, script, "--to-path=/backup/.benchmark neo4j --type=FULL")
+	assert.Contains(t, script, `"database":"neo4j"`)
+}
+
+func TestReconcileBenchmark_UsesClusterImagePullSettings(t *testing.T) {
+	backup := benchmarkBackup("1")
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Image.Registry = "mirror.example.com"
+	cluster.Spec.Image.PullPolicy = "Always"
+	cluster.Spec.Image.PullSecrets = []string{"mirror-credentials"}
+	r, _, _ := newBenchmarkTestReconciler()
+
+	job, _, err := r.buildBenchmarkJob(backup, cluster, "1")
+	require.NoError(t, err)
+	podSpec := job.Spec.Template.Spec
+	assert.Equal(t, "mirror.example.com/library/neo4j:5.26.0-enterprise", podSpec.Containers[0].Image)
+	assert.Equal(t, corev1.PullAlways, podSpec.Containers[0].ImagePullPolicy)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "mirror-credentials"}}, podSpec.ImagePullSecrets)
+}
+
+func TestReconcileBenchmark_RecordsReport(t *testing.T) {
+	ctx := context.Background()
+	backup := benchmarkBackup("1")
+	backup.Status.Benchmark = &neo4jv1alpha1.BackupBenchmark{Trigger: "1", Phase: "Running", Database: "orders"}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nightly-benchmark",
+			Namespace:   "default",
+			Annotations: map[string]string{BackupBenchmarkAnnotation: "1"},
+		},
+		Status: batchv1.JobStatus{Succeeded: 1},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-benchmark-x7k2p", Namespace: "default", Labels: map[string]string{"job-name": "nightly-benchmark"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name: benchmarkContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				Message: `{"database":"orders","storeBytes":2147483648,"backupMs":32000,"restoreMs":16000}`,
+			}},
+		}}},
+	}
+	r, c, recorder := newBenchmarkTestReconciler(backup, job, pod)
+
+	require.NoError(t, r.reconcileBenchmark(ctx, backup, minimalCluster("prod", "default")))
+
+	updated := &neo4jv1alpha1.Neo4jBackup{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(backup), updated))
+	result := updated.Status.Benchmark
+	require.NotNil(t, result)
+	assert.Equal(t, "Completed", result.Phase)
+	assert.Equal(t, "2.0GiB", result.StoreSize)
+	assert.Equal(t, "32s", result.BackupDuration)
+	assert.Equal(t, "64.0MiB/s", result.BackupThroughput)
+	assert.Equal(t, "128.0MiB/s", result.RestoreThroughput)
+	assert.NotNil(t, result.CompletionTime)
+	assert.Equal(t, "Normal BenchmarkCompleted Backup 64.0MiB/s, restore 128.0MiB/s (2.0GiB store)", <-recorder.Events)
+
+	// The same trigger is not benchmarked again
+	updated.Annotations = backup.Annotations
+	require.NoError(t, r.reconcileBenchmark(ctx, updated, minimalCluster("prod", "default")))
+	assert.Empty(t, recorder.Events)
+}
+
+func TestReconcileBenchmark_FailedJob(t *testing.T) {
+	ctx := context.Background()
+	backup := benchmarkBackup("1")
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nightly-benchmark",
+			Namespace:   "default",
+			Annotations: map[string]string{BackupBenchmarkAnnotation: "1"},
+		},
+		Status: batchv1.JobStatus{Failed: 1},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-benchmark-x7k2p", Namespace: "default", Labels: map[string]string{"job-name": "nightly-benchmark"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name: benchmarkContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				Message: "Starting backup\nBackup command failed: Access Denied\n",
+			}},
+		}}},
+	}
+	r, c, recorder := newBenchmarkTestReconciler(backup, job, pod)
+
+	require.NoError(t, r.reconcileBenchmark(ctx, backup, minimalCluster("prod", "default")))
+
+	updated := &neo4jv1alpha1.Neo4jBackup{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(backup), updated))
+	require.NotNil(t, updated.Status.Benchmark)
+	assert.Equal(t, "Failed", updated.Status.Benchmark.Phase)
+	assert.Equal(t, "Benchmark job failed: Backup command failed: Access Denied", updated.Status.Benchmark.Message)
+	assert.Equal(t, "Warning BenchmarkFailed Benchmark job failed: Backup command failed: Access Denied", <-recorder.Events)
+}
+
+func TestReconcileBenchmark_NewTriggerReplacesJob(t *testing.T) {
+	ctx := context.Background()
+	backup := benchmarkBackup("2")
+	backup.Status.Benchmark = &neo4jv1alpha1.BackupBenchmark{Trigger: "1", Phase: "Completed"}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nightly-benchmark",
+			Namespace:   "default",
+			Annotations: map[string]string{BackupBenchmarkAnnotation: "1"},
+		},
+		Status: batchv1.JobStatus{Succeeded: 1},
+	}
+	r, c, _ := newBenchmarkTestReconciler(backup, job)
+	cluster := minimalCluster("prod", "default")
+
+	require.NoError(t, r.reconcileBenchmark(ctx, backup, cluster))
+	err := c.Get(ctx, client.ObjectKeyFromObject(job), &batchv1.Job{})
+	assert.True(t, errors.IsNotFound(err), "previous benchmark job should be deleted")
+
+	require.NoError(t, r.reconcileBenchmark(ctx, backup, cluster))
+	replaced := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(job), replaced))
+	assert.Equal(t, "2", replaced.Annotations[BackupBenchmarkAnnotation])
+}
+
+func TestFormatBinaryBytes(t *testing.T) {
+	assert.Equal(t, "512B", formatBinaryBytes(512))
+	assert.Equal(t, "1.5KiB", formatBinaryBytes(1536))
+	assert.Equal(t, "85.3MiB", formatBinaryBytes(85.3*1024*1024))
+	assert.Equal(t, "12.4GiB", formatBinaryBytes(12.4*1024*1024*1024))
+}
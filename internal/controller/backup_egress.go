@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// cloudCAVolumeName is the volume carrying the user-supplied CA bundle.
+	cloudCAVolumeName = "cloud-ca-bundle"
+	// cloudCAMountPath is where the CA bundle Secret is mounted.
+	cloudCAMountPath = "/var/secrets/cloud-ca"
+	// cloudCAFile is the file name the selected Secret key is projected to.
+	cloudCAFile = "ca.crt"
+	// cloudTrustStorePath is the JVM truststore built from the default JDK
+	// cacerts plus the CA bundle before neo4j-admin starts.
+	cloudTrustStorePath = "/tmp/cloud-truststore.jks"
+	// cloudTrustStorePassword is the well-known default JDK cacerts password;
+	// the store only holds public certificates.
+	cloudTrustStorePassword = "changeit"
+)
+
+// cloudEgressEnvVars returns the proxy environment variables and the merged
+// JAVA_TOOL_OPTIONS for a job that reaches object storage via cloud.
+// neo4j-admin runs as a JVM process, so the proxy and truststore settings are
+// passed as system properties in addition to the conventional *_PROXY variables.
+func cloudEgressEnvVars(cloud *neo4jv1alpha1.CloudBlock) []corev1.EnvVar {
+	if cloud == nil {
+		return nil
+	}
+
+	var envVars []corev1.EnvVar
+	if p := cloud.Proxy; p != nil {
+		add := func(name, value string) {
+			if value == "" {
+				return
+			}
+			// Tools disagree on the casing they read, so set both.
+			envVars = append(envVars,
+				corev1.EnvVar{Name: name, Value: value},
+				corev1.EnvVar{Name: strings.ToLower(name), Value: value},
+			)
+		}
+		add("HTTP_PROXY", p.HTTPProxy)
+		add("HTTPS_PROXY", p.HTTPSProxy)
+		add("NO_PROXY", p.NoProxy)
+	}
+
+	if opts := cloudJavaToolOptions(cloud); opts != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: "JAVA_TOOL_OPTIONS", Value: opts})
+	}
+	return envVars
+}
+
+// cloudJavaToolOptions assembles the JVM system properties required by the
+// cloud block: S3 path-style addressing, proxy routing and the custom truststore.
+func cloudJavaToolOptions(cloud *neo4jv1alpha1.CloudBlock) string {
+	var opts []string
+
+	// Path-style addressing is required for MinIO and most self-hosted stores.
+	if cloud.Provider == "aws" && cloud.ForcePathStyle {
+		opts = append(opts, "-Daws.s3.forcePathStyle=true")
+	}
+
+	if p := cloud.Proxy; p != nil {
+		opts = append(opts, jvmProxyOptions("http", p.HTTPProxy)...)
+		opts = append(opts, jvmProxyOptions("https", p.HTTPSProxy)...)
+		if hosts := jvmNonProxyHosts(p.NoProxy); hosts != "" {
+			// http.nonProxyHosts is honoured for both http and https.
+			opts = append(opts, "-Dhttp.nonProxyHosts="+hosts)
+		}
+	}
+
+	if cloud.CABundle != nil {
+		opts = append(opts,
+			"-Djavax.net.ssl.trustStore="+cloudTrustStorePath,
+			"-Djavax.net.ssl.trustStorePassword="+cloudTrustStorePassword,
+		)
+	}
+
+	return strings.Join(opts, " ")
+}
+
+// jvmProxyOptions converts a proxy URL into <scheme>.proxyHost/proxyPort
+// properties. Unparseable URLs are rejected by the backup validator, so they
+// are silently skipped here.
+func jvmProxyOptions(scheme, proxyURL string) []string {
+	if proxyURL == "" {
+		return nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return []string{
+		fmt.Sprintf("-D%s.proxyHost=%s", scheme, u.Hostname()),
+		fmt.Sprintf("-D%s.proxyPort=%s", scheme, port),
+	}
+}
+
+// jvmNonProxyHosts translates a NO_PROXY style list (comma separated, leading
+// dot for domains) into the JVM http.nonProxyHosts syntax (pipe separated,
+// leading wildcard for domains).
+func jvmNonProxyHosts(noProxy string) string {
+	var hosts []string
+	for _, h := range strings.Split(noProxy, ",") {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		if strings.HasPrefix(h, ".") {
+			h = "*" + h
+		}
+		hosts = append(hosts, h)
+	}
+	return strings.Join(hosts, "|")
+}
+
+// cloudCAVolumeMounts mounts the CA bundle Secret when one is configured.
+func cloudCAVolumeMounts(cloud *neo4jv1alpha1.CloudBlock) []corev1.VolumeMount {
+	if cloud == nil || cloud.CABundle == nil {
+		return nil
+	}
+	return []corev1.VolumeMount{{
+		Name:      cloudCAVolumeName,
+		MountPath: cloudCAMountPath,
+		ReadOnly:  true,
+	}}
+}
+
+// cloudCAVolumes projects the selected CA bundle key onto a fixed file name.
+func cloudCAVolumes(cloud *neo4jv1alpha1.CloudBlock) []corev1.Volume {
+	if cloud == nil || cloud.CABundle == nil {
+		return nil
+	}
+	return []corev1.Volume{{
+		Name: cloudCAVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cloud.CABundle.Name,
+				Items: []corev1.KeyToPath{
+					{Key: cloud.CABundle.Key, Path: cloudCAFile},
+				},
+			},
+		},
+	}}
+}
+
+// withCloudTrustStore prefixes cmd with a shell prelude that builds the JVM
+// truststore referenced by cloudJavaToolOptions. The JDK cacerts are copied
+// first so public endpoints keep working, then every certificate in the
+// PEM bundle is imported under its own alias (keytool only reads the first
+// certificate of a file).
+func withCloudTrustStore(cloud *neo4jv1alpha1.CloudBlock, cmd string) string {
+	if cloud == nil || cloud.CABundle == nil {
+		return cmd
+	}
+	bundle := cloudCAMountPath + "/" + cloudCAFile
+	prelude := strings.Join([]string{
+		fmt.Sprintf(`cp "${JAVA_HOME}/lib/security/cacerts" %s`, cloudTrustStorePath),
+		fmt.Sprintf(`chmod u+w %s`, cloudTrustStorePath),
+		fmt.Sprintf(`awk '/-----BEGIN CERTIFICATE-----/{n++} n>0{print > ("/tmp/cloud-ca-" n ".pem")}' %s`, bundle),
+		fmt.Sprintf(`for f in /tmp/cloud-ca-*.pem; do keytool -importcert -noprompt -alias "custom-$(basename "$f" .pem)" -file "$f" -keystore %s -storepass %s >/dev/null || exit 1; done`,
+			cloudTrustStorePath, cloudTrustStorePassword),
+	}, " && ")
+	return prelude + " && " + cmd
+}
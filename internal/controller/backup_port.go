@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// reconcileBackupPort keeps the backup port Service and NetworkPolicy in line
+// with spec.backupPort, deleting them once they are no longer wanted.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileBackupPort(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	name := resources.BackupPortName(cluster)
+
+	if service := resources.BuildBackupServiceForEnterprise(cluster); service != nil {
+		if err := r.createOrUpdateResource(ctx, service, cluster); err != nil {
+			return fmt.Errorf("failed to create Service %s: %w", name, err)
+		}
+	} else {
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}}
+		if err := r.Delete(ctx, service); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Service %s: %w", name, err)
+		}
+	}
+
+	return reconcileNetworkPolicy(ctx, r.Client, r.Scheme, cluster, name, cluster.Namespace,
+		resources.BuildBackupNetworkPolicyForEnterprise(cluster))
+}
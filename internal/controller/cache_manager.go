@@ -161,6 +161,10 @@ func (cm *CacheManager) GetCacheOptions() cache.Options {
 			&neo4jv1alpha1.Neo4jRestore{}:              {},
 			&neo4jv1alpha1.Neo4jPlugin{}:               {},
 			&neo4jv1alpha1.Neo4jShardedDatabase{}:      {},
+			&neo4jv1alpha1.Neo4jWorkload{}:             {},
+			&neo4jv1alpha1.Neo4jUserSync{}:             {},
+			&neo4jv1alpha1.Neo4jMigration{}:            {},
+			&neo4jv1alpha1.Neo4jCDC{}:                  {},
 
 			// Core Kubernetes resources - filtered by labels
 			&corev1.Secret{}: {
@@ -565,6 +569,10 @@ func (cm *CacheManager) namespaceHasNeo4jResources(ctx context.Context, namespac
 		{name: "Neo4jBackup", list: &neo4jv1alpha1.Neo4jBackupList{}},
 		{name: "Neo4jRestore", list: &neo4jv1alpha1.Neo4jRestoreList{}},
 		{name: "Neo4jShardedDatabase", list: &neo4jv1alpha1.Neo4jShardedDatabaseList{}},
+		{name: "Neo4jWorkload", list: &neo4jv1alpha1.Neo4jWorkloadList{}},
+		{name: "Neo4jUserSync", list: &neo4jv1alpha1.Neo4jUserSyncList{}},
+		{name: "Neo4jMigration", list: &neo4jv1alpha1.Neo4jMigrationList{}},
+		{name: "Neo4jCDC", list: &neo4jv1alpha1.Neo4jCDCList{}},
 	}
 
 	for _, check := range checks {
@@ -595,6 +603,14 @@ func listHasItems(list client.ObjectList) bool {
 		return len(typed.Items) > 0
 	case *neo4jv1alpha1.Neo4jShardedDatabaseList:
 		return len(typed.Items) > 0
+	case *neo4jv1alpha1.Neo4jWorkloadList:
+		return len(typed.Items) > 0
+	case *neo4jv1alpha1.Neo4jUserSyncList:
+		return len(typed.Items) > 0
+	case *neo4jv1alpha1.Neo4jMigrationList:
+		return len(typed.Items) > 0
+	case *neo4jv1alpha1.Neo4jCDCList:
+		return len(typed.Items) > 0
 	default:
 		return false
 	}
@@ -0,0 +1,338 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+const (
+	// canaryRequeueInterval is how often the canary is looked at while it
+	// starts and soaks
+	canaryRequeueInterval = 15 * time.Second
+
+	// defaultCanarySoakDuration applies when spec.upgradeStrategy.canary
+	// leaves soakDuration empty
+	defaultCanarySoakDuration = 10 * time.Minute
+)
+
+// canaryUpgradeClient is the part of the Neo4j client a canary upgrade uses
+type canaryUpgradeClient interface {
+	GetServerMembers(ctx context.Context) ([]neo4jclient.ServerMember, error)
+	GetDatabaseLeaders(ctx context.Context) (map[string][]string, error)
+	TransferLeadership(ctx context.Context, database, serverID string) error
+	GetDatabaseStores(ctx context.Context) ([]neo4jclient.DatabaseStore, error)
+}
+
+// canaryUpgradeInProgress reports whether a canary waits to start or soaks
+func canaryUpgradeInProgress(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	upgrade := cluster.Status.UpgradeStatus
+	return upgrade != nil && upgrade.Canary != nil && upgrade.Phase == "InProgress"
+}
+
+// startCanaryUpgrade moves the database leaderships off the highest ordinal
+// server and rolls only that server to the new image, by staging the image
+// with the partition of the StatefulSet right below it. The other servers
+// follow once reconcileCanaryUpgrade saw it pass the soak period.
+func (r *Neo4jEnterpriseClusterReconciler) startCanaryUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, sts); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	canary := fmt.Sprintf("%s-server-%d", cluster.Name, replicas-1)
+	previousImage := sts.Spec.Template.Spec.Containers[0].Image
+	previousVersion, err := r.runningVersion(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	neo4jClient, closeClient, err := r.connectForCanaryUpgrade(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, fmt.Errorf("failed to connect to Neo4j: %w", err)
+	}
+	defer closeClient()
+
+	// The canary should only serve secondaries while it runs the new image
+	leaders, err := neo4jClient.GetDatabaseLeaders(ctx)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if led := databasesLedBy(leaders, canary); len(led) > 0 {
+		members, err := neo4jClient.GetServerMembers(ctx)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+		}
+		if target := leadershipTarget(members, canary, nil, cluster.Status.MaintenanceServers); target != nil {
+			for _, database := range led {
+				if err := neo4jClient.TransferLeadership(ctx, database, target.ID); err != nil {
+					logger.Info("Could not transfer leadership off the canary", "pod", canary, "database", database, "error", err)
+				}
+			}
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonLeadershipTransferred,
+				fmt.Sprintf("Leadership of %s moved off %s ahead of its upgrade", strings.Join(led, ", "), canary))
+		}
+	}
+
+	target := resources.ImageReference(cluster.Spec.Image)
+	if err := r.stageServerImage(ctx, cluster, target, replicas-1); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := metav1.Now()
+	if err := r.updateUpgradeStatus(ctx, cluster, func(upgrade *neo4jv1alpha1.UpgradeStatus) {
+		*upgrade = neo4jv1alpha1.UpgradeStatus{
+			Phase:           "InProgress",
+			StartTime:       &now,
+			CurrentStep:     fmt.Sprintf("Waiting for canary %s to start with %s", canary, cluster.Spec.Image.Tag),
+			PreviousVersion: previousVersion,
+			TargetVersion:   cluster.Spec.Image.Tag,
+			Progress:        &neo4jv1alpha1.UpgradeProgress{Total: replicas, InProgress: 1, Pending: replicas - 1},
+			Canary:          &neo4jv1alpha1.CanaryStatus{Pod: canary, PreviousImage: previousImage},
+		}
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Info("Started canary upgrade", "pod", canary, "image", target)
+	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonCanaryStarted,
+		"Upgrading canary %s to %s", canary, cluster.Spec.Image.Tag)
+	return ctrl.Result{RequeueAfter: canaryRequeueInterval}, nil
+}
+
+// reconcileCanaryUpgrade follows the canary of a Canary upgrade. It waits
+// for the canary to run the new image and rejoin the cluster, then soaks it:
+// the pod has to stay ready, stay within spec.upgradeStrategy.canary.maxRestarts,
+// its server has to stay available and none of its databases may be
+// quarantined. A canary that fails or does not start within the upgrade
+// timeout is rolled back. Once the soak period passed the remaining servers
+// are upgraded as in a rolling upgrade.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileCanaryUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (ctrl.Result, error) {
+	upgrade := cluster.Status.UpgradeStatus
+	canary := upgrade.Canary
+	soaking := canary.SoakStartTime != nil
+
+	if cluster.Spec.Image.Tag != upgrade.TargetVersion {
+		return r.failCanary(ctx, cluster, fmt.Sprintf("spec.image.tag changed to %s", cluster.Spec.Image.Tag))
+	}
+
+	// notHealthy fails a soaking canary, and one that does not start within
+	// the upgrade timeout
+	notHealthy := func(message string) (ctrl.Result, error) {
+		if soaking {
+			return r.failCanary(ctx, cluster, message)
+		}
+		timeout := NewRollingUpgradeOrchestrator(r.Client, cluster.Name, cluster.Namespace).getUpgradeTimeout(cluster)
+		if upgrade.StartTime != nil && time.Since(upgrade.StartTime.Time) > timeout {
+			return r.failCanary(ctx, cluster, fmt.Sprintf("%s after %s", message, timeout))
+		}
+		return ctrl.Result{RequeueAfter: canaryRequeueInterval}, r.setCanaryStep(ctx, cluster, "Waiting for canary: "+message)
+	}
+
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, client.ObjectKey{Name: canary.Pod, Namespace: cluster.Namespace}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return notHealthy(fmt.Sprintf("pod %s does not exist", canary.Pod))
+		}
+		return ctrl.Result{}, err
+	}
+	target := resources.ImageReference(cluster.Spec.Image)
+	var restarts int32
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == "neo4j" {
+			restarts = status.RestartCount
+		}
+	}
+	if pod.DeletionTimestamp != nil || len(pod.Spec.Containers) == 0 || pod.Spec.Containers[0].Image != target {
+		return notHealthy(fmt.Sprintf("pod %s does not run %s yet", canary.Pod, cluster.Spec.Image.Tag))
+	}
+	maxRestarts := int32(0)
+	if spec := canarySpec(cluster); spec != nil {
+		maxRestarts = spec.MaxRestarts
+	}
+	if soaking && restarts-canary.Restarts > maxRestarts {
+		return r.failCanary(ctx, cluster, fmt.Sprintf("pod %s restarted %d times during the soak period", canary.Pod, restarts-canary.Restarts))
+	}
+	if !isPodReady(pod) {
+		return notHealthy(fmt.Sprintf("pod %s is not ready", canary.Pod))
+	}
+
+	neo4jClient, closeClient, err := r.connectForCanaryUpgrade(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: canaryRequeueInterval}, fmt.Errorf("failed to connect to Neo4j: %w", err)
+	}
+	defer closeClient()
+
+	members, err := neo4jClient.GetServerMembers(ctx)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: canaryRequeueInterval}, err
+	}
+	if member := memberForPod(members, canary.Pod); member == nil || member.State != "Enabled" || member.Health != "Available" {
+		return notHealthy(fmt.Sprintf("the server of %s is not available", canary.Pod))
+	}
+	stores, err := neo4jClient.GetDatabaseStores(ctx)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: canaryRequeueInterval}, err
+	}
+	for _, store := range stores {
+		if podForAddress(store.Address) == canary.Pod && store.Status == "quarantined" {
+			return r.failCanary(ctx, cluster, fmt.Sprintf("database %s is quarantined on %s: %s", store.Name, canary.Pod, store.StatusMessage))
+		}
+	}
+
+	soak := canarySoakDuration(cluster)
+	if !soaking {
+		now := metav1.Now()
+		return ctrl.Result{RequeueAfter: canaryRequeueInterval}, r.updateUpgradeStatus(ctx, cluster, func(upgrade *neo4jv1alpha1.UpgradeStatus) {
+			upgrade.Canary.SoakStartTime = &now
+			upgrade.Canary.Restarts = restarts
+			upgrade.CurrentStep = fmt.Sprintf("Soaking canary %s for %s", canary.Pod, soak)
+		})
+	}
+	if remaining := soak - time.Since(canary.SoakStartTime.Time); remaining > 0 {
+		return ctrl.Result{RequeueAfter: min(remaining, canaryRequeueInterval)}, nil
+	}
+
+	log.FromContext(ctx).Info("Canary passed the soak period", "pod", canary.Pod, "soak", soak)
+	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonCanaryPassed,
+		"Canary %s ran %s for %s, upgrading the other servers", canary.Pod, cluster.Spec.Image.Tag, soak)
+	return r.runRollingUpgrade(ctx, cluster)
+}
+
+// failCanary rolls the canary back to the image it ran before and holds the
+// upgrade of this target
+func (r *Neo4jEnterpriseClusterReconciler) failCanary(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, reason string) (ctrl.Result, error) {
+	canary := cluster.Status.UpgradeStatus.Canary
+	failures, err := r.serverReadinessFailures(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.rollBackServers(ctx, cluster, serverTemplate{image: canary.PreviousImage}); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.recordUpgradeRollback(ctx, cluster, canary.PreviousImage, ConditionReasonCanaryFailed, EventReasonCanaryFailed,
+		reason, failures); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+}
+
+// stageServerImage sets the image of the server StatefulSet and its
+// partition, so that only the pods from that ordinal up roll to it
+func (r *Neo4jEnterpriseClusterReconciler) stageServerImage(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, image string, partition int32) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, sts); err != nil {
+			return fmt.Errorf("failed to get server StatefulSet: %w", err)
+		}
+		sts.Spec.Template.Spec.Containers[0].Image = image
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = map[string]string{}
+		}
+		sts.Spec.Template.Annotations["neo4j.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
+		sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+		}
+		return r.Update(ctx, sts)
+	})
+}
+
+// canarySpec returns spec.upgradeStrategy.canary, nil if it is not set
+func canarySpec(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *neo4jv1alpha1.CanaryUpgradeSpec {
+	if cluster.Spec.UpgradeStrategy == nil {
+		return nil
+	}
+	return cluster.Spec.UpgradeStrategy.Canary
+}
+
+// canarySoakDuration returns spec.upgradeStrategy.canary.soakDuration
+func canarySoakDuration(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) time.Duration {
+	if spec := canarySpec(cluster); spec != nil && spec.SoakDuration != "" {
+		if soak, err := time.ParseDuration(spec.SoakDuration); err == nil {
+			return soak
+		}
+	}
+	return defaultCanarySoakDuration
+}
+
+// setCanaryStep records what the canary upgrade waits for
+func (r *Neo4jEnterpriseClusterReconciler) setCanaryStep(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, step string) error {
+	return r.updateUpgradeStatus(ctx, cluster, func(upgrade *neo4jv1alpha1.UpgradeStatus) {
+		upgrade.CurrentStep = step
+	})
+}
+
+// updateUpgradeStatus applies mutate to status.upgradeStatus
+func (r *Neo4jEnterpriseClusterReconciler) updateUpgradeStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, mutate func(*neo4jv1alpha1.UpgradeStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		desired := &neo4jv1alpha1.UpgradeStatus{}
+		if latest.Status.UpgradeStatus != nil {
+			desired = latest.Status.UpgradeStatus.DeepCopy()
+		}
+		mutate(desired)
+		if equality.Semantic.DeepEqual(latest.Status.UpgradeStatus, desired) {
+			cluster.Status.UpgradeStatus = latest.Status.UpgradeStatus
+			return nil
+		}
+		latest.Status.UpgradeStatus = desired
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.UpgradeStatus = latest.Status.UpgradeStatus
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// connectForCanaryUpgrade opens a Neo4j connection for a canary upgrade
+func (r *Neo4jEnterpriseClusterReconciler) connectForCanaryUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (canaryUpgradeClient, func(), error) {
+	if r.newCanaryUpgradeClient != nil {
+		c, err := r.newCanaryUpgradeClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeCanaryUpgradeClient struct {
+	fakeRollingRestartClient
+	stores []neo4jclient.DatabaseStore
+}
+
+func (c *fakeCanaryUpgradeClient) GetDatabaseStores(context.Context) ([]neo4jclient.DatabaseStore, error) {
+	return c.stores, nil
+}
+
+func canaryTestSetup(t *testing.T, neo4j *fakeCanaryUpgradeClient) (client.Client, *Neo4jEnterpriseClusterReconciler, *record.FakeRecorder, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Image.Tag = "2025.01.0-enterprise"
+	cluster.Spec.UpgradeStrategy = &neo4jv1alpha1.UpgradeStrategySpec{
+		Strategy: "Canary",
+		Canary:   &neo4jv1alpha1.CanaryUpgradeSpec{SoakDuration: "30m", MaxRestarts: 1},
+	}
+	cluster.Status.Phase = "Ready"
+	sts := serverSTS("prod", "default")
+	sts.Spec.Replicas = int32PtrCM(3)
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, sts).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:       c,
+		Scheme:       c.Scheme(),
+		Recorder:     recorder,
+		RequeueAfter: time.Minute,
+		newCanaryUpgradeClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (canaryUpgradeClient, error) {
+			return neo4j, nil
+		},
+	}
+	return c, r, recorder, cluster
+}
+
+func canaryTestPod(image string, restarts int32) *corev1.Pod {
+	pod := restartTestPod("prod-server-2", "prod-server-new")
+	pod.Spec.Containers = []corev1.Container{{Name: "neo4j", Image: image}}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "neo4j", RestartCount: restarts}}
+	return pod
+}
+
+func TestStartCanaryUpgrade(t *testing.T) {
+	neo4j := &fakeCanaryUpgradeClient{fakeRollingRestartClient: fakeRollingRestartClient{
+		members: []neo4jclient.ServerMember{
+			restartTestMember("id-0", "prod-server-0"),
+			restartTestMember("id-1", "prod-server-1"),
+			restartTestMember("id-2", "prod-server-2"),
+		},
+		leaders: map[string][]string{"prod-server-2.prod-internals.default.svc.cluster.local:7687": {"neo4j"}},
+	}}
+	// dbms.cluster.switchLeader takes the server ID, not the server name
+	neo4j.members[0].Name = "server-0"
+	c, r, recorder, cluster := canaryTestSetup(t, neo4j)
+	ctx := context.Background()
+
+	result, err := r.startCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, canaryRequeueInterval, result.RequeueAfter)
+
+	// The canary leads no database and is the only pod above the partition
+	assert.Equal(t, []string{"neo4j->id-0"}, neo4j.transfers)
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.Equal(t, "neo4j:2025.01.0-enterprise", sts.Spec.Template.Spec.Containers[0].Image)
+	require.NotNil(t, sts.Spec.UpdateStrategy.RollingUpdate)
+	assert.Equal(t, int32(2), *sts.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	upgrade := latest.Status.UpgradeStatus
+	require.NotNil(t, upgrade)
+	assert.Equal(t, "InProgress", upgrade.Phase)
+	assert.Equal(t, "5.26.0-enterprise", upgrade.PreviousVersion)
+	assert.Equal(t, &neo4jv1alpha1.CanaryStatus{Pod: "prod-server-2", PreviousImage: "neo4j:5.26.0-enterprise"}, upgrade.Canary)
+	assert.True(t, canaryUpgradeInProgress(latest))
+	assert.Contains(t, <-recorder.Events, EventReasonLeadershipTransferred)
+	assert.Contains(t, <-recorder.Events, EventReasonCanaryStarted)
+}
+
+func TestReconcileCanaryUpgradeSoak(t *testing.T) {
+	neo4j := &fakeCanaryUpgradeClient{fakeRollingRestartClient: fakeRollingRestartClient{
+		members: []neo4jclient.ServerMember{restartTestMember("id-2", "prod-server-2")},
+		leaders: map[string][]string{},
+	}}
+	c, r, _, cluster := canaryTestSetup(t, neo4j)
+	ctx := context.Background()
+	_, err := r.startCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+
+	// The old pod is still running
+	require.NoError(t, c.Create(ctx, canaryTestPod("neo4j:5.26.0-enterprise", 0)))
+	result, err := r.reconcileCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, canaryRequeueInterval, result.RequeueAfter)
+	assert.Contains(t, cluster.Status.UpgradeStatus.CurrentStep, "does not run 2025.01.0-enterprise yet")
+
+	// The new pod is healthy, which starts the soak period
+	pod := &corev1.Pod{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-2", Namespace: "default"}, pod))
+	require.NoError(t, c.Delete(ctx, pod))
+	require.NoError(t, c.Create(ctx, canaryTestPod("neo4j:2025.01.0-enterprise", 2)))
+	_, err = r.reconcileCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+	canary := cluster.Status.UpgradeStatus.Canary
+	require.NotNil(t, canary.SoakStartTime)
+	assert.Equal(t, int32(2), canary.Restarts)
+	assert.Equal(t, "Soaking canary prod-server-2 for 30m0s", cluster.Status.UpgradeStatus.CurrentStep)
+
+	// One restart is tolerated, the soak continues
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-2", Namespace: "default"}, pod))
+	pod.Status.ContainerStatuses[0].RestartCount = 3
+	require.NoError(t, c.Status().Update(ctx, pod))
+	result, err = r.reconcileCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, canaryRequeueInterval, result.RequeueAfter)
+	assert.Equal(t, "InProgress", cluster.Status.UpgradeStatus.Phase)
+}
+
+func TestReconcileCanaryUpgradeRollsBack(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*corev1.Pod, *fakeCanaryUpgradeClient)
+		message string
+	}{
+		{
+			name: "restarts",
+			mutate: func(pod *corev1.Pod, _ *fakeCanaryUpgradeClient) {
+				pod.Status.ContainerStatuses[0].RestartCount = 2
+			},
+			message: "restarted 2 times during the soak period",
+		},
+		{
+			name: "unavailable server",
+			mutate: func(_ *corev1.Pod, neo4j *fakeCanaryUpgradeClient) {
+				neo4j.members[0].Health = "Unavailable"
+			},
+			message: "the server of prod-server-2 is not available",
+		},
+		{
+			name: "quarantined database",
+			mutate: func(_ *corev1.Pod, neo4j *fakeCanaryUpgradeClient) {
+				neo4j.stores = []neo4jclient.DatabaseStore{{
+					Name: "neo4j", Address: "prod-server-2.prod-internals.default.svc.cluster.local:7687",
+					Status: "quarantined", StatusMessage: "store corrupted",
+				}}
+			},
+			message: "database neo4j is quarantined on prod-server-2: store corrupted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			neo4j := &fakeCanaryUpgradeClient{fakeRollingRestartClient: fakeRollingRestartClient{
+				members: []neo4jclient.ServerMember{restartTestMember("id-2", "prod-server-2")},
+				leaders: map[string][]string{},
+			}}
+			c, r, recorder, cluster := canaryTestSetup(t, neo4j)
+			ctx := context.Background()
+			_, err := r.startCanaryUpgrade(ctx, cluster)
+			require.NoError(t, err)
+			<-recorder.Events
+
+			pod := canaryTestPod("neo4j:2025.01.0-enterprise", 0)
+			require.NoError(t, c.Create(ctx, pod))
+			_, err = r.reconcileCanaryUpgrade(ctx, cluster)
+			require.NoError(t, err)
+			require.NotNil(t, cluster.Status.UpgradeStatus.Canary.SoakStartTime)
+
+			tt.mutate(pod, neo4j)
+			require.NoError(t, c.Status().Update(ctx, pod))
+			_, err = r.reconcileCanaryUpgrade(ctx, cluster)
+			require.NoError(t, err)
+
+			sts := &appsv1.StatefulSet{}
+			require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+			assert.Equal(t, "neo4j:5.26.0-enterprise", sts.Spec.Template.Spec.Containers[0].Image)
+			assert.Equal(t, int32(0), *sts.Spec.UpdateStrategy.RollingUpdate.Partition)
+			latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+			assert.Equal(t, "Failed", latest.Status.UpgradeStatus.Phase)
+			assert.Contains(t, latest.Status.UpgradeStatus.Message, "rolled back to neo4j:5.26.0-enterprise")
+			assert.Contains(t, latest.Status.UpgradeStatus.Message, tt.message)
+			assert.Equal(t, "neo4j:5.26.0-enterprise", latest.Status.UpgradeStatus.RolledBackTo)
+			condition := findCondition(latest.Status.Conditions, ConditionTypeUpgradeFailed)
+			require.NotNil(t, condition)
+			assert.Equal(t, ConditionReasonCanaryFailed, condition.Reason)
+			assert.Contains(t, <-recorder.Events, EventReasonCanaryFailed)
+
+			// The failed target is held until spec.image.tag changes
+			assert.False(t, canaryUpgradeInProgress(latest))
+			assert.True(t, upgradeRolledBackFor(latest))
+			latest.Spec.Image.Tag = "2025.02.0-enterprise"
+			assert.False(t, upgradeRolledBackFor(latest))
+		})
+	}
+}
+
+func TestReconcileCanaryUpgradeStartTimeout(t *testing.T) {
+	neo4j := &fakeCanaryUpgradeClient{fakeRollingRestartClient: fakeRollingRestartClient{leaders: map[string][]string{}}}
+	c, r, _, cluster := canaryTestSetup(t, neo4j)
+	ctx := context.Background()
+	_, err := r.startCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+
+	started := metav1.NewTime(time.Now().Add(-time.Hour))
+	cluster.Status.UpgradeStatus.StartTime = &started
+	_, err = r.reconcileCanaryUpgrade(ctx, cluster)
+	require.NoError(t, err)
+
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, "Failed", latest.Status.UpgradeStatus.Phase)
+	assert.Equal(t, "pod prod-server-2 does not exist after 30m0s", latest.Status.UpgradeStatus.LastError)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// clusterOptionalAPIs returns the optional APIs the spec of a cluster uses
+func clusterOptionalAPIs(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []string {
+	var apis []string
+	if resources.BuildRouteForEnterprise(cluster) != nil || resources.BuildMCPRouteForCluster(cluster) != nil {
+		apis = append(apis, capabilities.Route)
+	}
+	if cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled {
+		apis = append(apis, capabilities.ServiceMonitor)
+	}
+	if resources.ServiceMonitorEnabled(cluster) {
+		if cluster.Spec.Monitoring.ServiceMonitor.Kind == capabilities.PodMonitor {
+			apis = append(apis, capabilities.PodMonitor)
+		} else if !slices.Contains(apis, capabilities.ServiceMonitor) {
+			apis = append(apis, capabilities.ServiceMonitor)
+		}
+	}
+	if resources.AlertsEnabled(cluster) {
+		apis = append(apis, capabilities.PrometheusRule)
+	}
+	if resources.BuildVerticalPodAutoscalerForEnterprise(cluster) != nil {
+		apis = append(apis, capabilities.VerticalPodAutoscaler)
+	}
+	return apis
+}
+
+// standaloneOptionalAPIs returns the optional APIs the spec of a standalone
+// deployment uses
+func standaloneOptionalAPIs(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) []string {
+	if resources.BuildRouteForStandalone(standalone) != nil || resources.BuildMCPRouteForStandalone(standalone) != nil {
+		return []string{capabilities.Route}
+	}
+	return nil
+}
+
+// setOptionalAPIsCondition records on a cluster or standalone deployment
+// which of the optional APIs its spec uses are not served, and were skipped.
+// A deployment that uses none and never had the condition is left alone.
+func setOptionalAPIsCondition(ctx context.Context, c client.Client, detected *capabilities.Capabilities, obj client.Object, used []string) {
+	var missing []string
+	for _, api := range used {
+		if !detected.Has(api) {
+			missing = append(missing, fmt.Sprintf("%s (%s)", api, capabilities.GroupVersion(api)))
+		}
+	}
+	status, reason, message := metav1.ConditionTrue, ConditionReasonAPIsServed, "Every optional API the spec uses is served"
+	if len(missing) > 0 {
+		status, reason = metav1.ConditionFalse, ConditionReasonAPIMissing
+		message = fmt.Sprintf("Not served by the cluster, skipped: %s", strings.Join(missing, ", "))
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var latest client.Object
+		var conditions *[]metav1.Condition
+		switch obj.(type) {
+		case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+			cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			latest, conditions = cluster, &cluster.Status.Conditions
+		case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+			standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+			latest, conditions = standalone, &standalone.Status.Conditions
+		default:
+			return nil
+		}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		existing := findCondition(*conditions, ConditionTypeOptionalAPIsAvailable)
+		if existing == nil && len(missing) == 0 {
+			return nil
+		}
+		if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		SetNamedCondition(conditions, ConditionTypeOptionalAPIsAvailable, latest.GetGeneration(), status, reason, message)
+		if err := c.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+
+		// Keep later status writes of this reconcile from conflicting
+		obj.SetResourceVersion(latest.GetResourceVersion())
+		switch typed := obj.(type) {
+		case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+			typed.Status.Conditions = *conditions
+		case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+			typed.Status.Conditions = *conditions
+		}
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update OptionalAPIsAvailable condition")
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+)
+
+func TestSetOptionalAPIsCondition(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	ctx := context.Background()
+	detected := &capabilities.Capabilities{
+		KubernetesVersion: "v1.30.2",
+		APIs:              map[string]bool{capabilities.Route: false, capabilities.ServiceMonitor: true},
+	}
+	condition := func() *metav1.Condition {
+		t.Helper()
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+		return findCondition(latest.Status.Conditions, ConditionTypeOptionalAPIsAvailable)
+	}
+
+	// A cluster using no optional API gets no condition
+	setOptionalAPIsCondition(ctx, c, detected, cluster, clusterOptionalAPIs(cluster))
+	assert.Nil(t, condition())
+
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{Route: &neo4jv1alpha1.RouteSpec{Enabled: true}}
+	cluster.Spec.QueryMonitoring = &neo4jv1alpha1.QueryMonitoringSpec{Enabled: true}
+	setOptionalAPIsCondition(ctx, c, detected, cluster, clusterOptionalAPIs(cluster))
+	missing := condition()
+	require.NotNil(t, missing)
+	assert.Equal(t, metav1.ConditionFalse, missing.Status)
+	assert.Equal(t, ConditionReasonAPIMissing, missing.Reason)
+	assert.Equal(t, "Not served by the cluster, skipped: Route (route.openshift.io/v1)", missing.Message)
+
+	// Once the API is installed the condition clears
+	detected.APIs[capabilities.Route] = true
+	setOptionalAPIsCondition(ctx, c, detected, cluster, clusterOptionalAPIs(cluster))
+	assert.Equal(t, metav1.ConditionTrue, condition().Status)
+}
+
+func TestReconcileRouteSkipsMissingAPI(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{Route: &neo4jv1alpha1.RouteSpec{Enabled: true}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:       c,
+		Scheme:       c.Scheme(),
+		Recorder:     recorder,
+		Capabilities: &capabilities.Capabilities{APIs: map[string]bool{capabilities.Route: false}},
+	}
+
+	// The Route is not attempted, so no warning is emitted on every reconcile
+	require.NoError(t, r.reconcileRoute(context.Background(), cluster))
+	assert.Empty(t, recorder.Events)
+}
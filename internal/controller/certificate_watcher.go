@@ -0,0 +1,356 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// certificateReloadDelay is how long renewed certificates are given to
+// reach the pods before the servers reload them. The kubelet refreshes
+// mounted Secrets periodically rather than on change.
+const certificateReloadDelay = 2 * time.Minute
+
+// sharedCertificateScope is the scope of the certificate every connector
+// without one of its own uses
+const sharedCertificateScope = "default"
+
+// tlsReloadClient reloads the certificates of one running Neo4j server
+type tlsReloadClient interface {
+	ReloadTLS(ctx context.Context) error
+	Close() error
+}
+
+// mountedCertificate is a certificate Secret the servers mount
+type mountedCertificate struct {
+	scope  string
+	secret string
+	// reload is set for the certificates cert-manager renews in place.
+	// Supplied certificates and server certificates restart the servers
+	// through their pod template hash instead.
+	reload bool
+}
+
+// mountedCertificates returns the certificate Secrets the servers of a
+// cluster mount
+func mountedCertificates(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []mountedCertificate {
+	tls := cluster.Spec.TLS
+	if !resources.TLSEnabled(tls) {
+		return nil
+	}
+	certificates := []mountedCertificate{{
+		scope:  sharedCertificateScope,
+		secret: resources.TLSSecretName(cluster.Name, tls),
+		reload: tls.Mode == resources.CertManagerMode,
+	}}
+	for _, scope := range resources.SSLPolicyScopes {
+		if secret := resources.SSLPolicySecretName(cluster.Name, tls, scope); secret != "" {
+			certificates = append(certificates, mountedCertificate{
+				scope:  scope,
+				secret: secret,
+				reload: resources.SSLPolicy(tls, scope).CertificateSecret == "",
+			})
+		}
+	}
+	for _, certificate := range resources.BuildServerCertificates(cluster) {
+		certificates = append(certificates, mountedCertificate{
+			scope:  resources.SSLPolicyCluster,
+			secret: certificate.Spec.SecretName,
+		})
+	}
+	return certificates
+}
+
+// mountsCertificateSecret reports whether the servers of a cluster mount the
+// named certificate Secret
+func mountsCertificateSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, name string) bool {
+	for _, certificate := range mountedCertificates(cluster) {
+		if certificate.secret == name {
+			return true
+		}
+	}
+	return false
+}
+
+// certificateRenewalTime returns when a certificate should have been
+// renewed: spec.tls.renewBefore ahead of its expiry, or after two thirds of
+// its lifetime like cert-manager does by default
+func certificateRenewalTime(tls *neo4jv1alpha1.TLSSpec, certificate *x509.Certificate) time.Time {
+	if tls != nil && tls.RenewBefore != nil {
+		if renewBefore, err := time.ParseDuration(*tls.RenewBefore); err == nil {
+			return certificate.NotAfter.Add(-renewBefore)
+		}
+	}
+	lifetime := certificate.NotAfter.Sub(certificate.NotBefore)
+	return certificate.NotBefore.Add(lifetime * 2 / 3)
+}
+
+// parseCertificate parses the first certificate of a PEM bundle, the one of
+// the server rather than its chain
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// reconcileCertificates records the expiry of the certificates the servers
+// mount in status.tls, the certificate expiry metric and the
+// CertificateExpiring condition, and has the servers reload renewed
+// cert-manager certificates. Servers that cannot reload them are restarted
+// one at a time. It returns true while renewed certificates wait for
+// certificateReloadDelay.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileCertificates(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	logger := log.FromContext(ctx)
+	certificateMetrics := metrics.NewCertificateMetrics(cluster.Name, cluster.Namespace)
+
+	certificates := mountedCertificates(cluster)
+	if len(certificates) == 0 {
+		certificateMetrics.ForgetAll()
+		return false, r.updateCertificateStatus(ctx, cluster, nil, nil)
+	}
+
+	now := time.Now()
+	status := &neo4jv1alpha1.TLSStatus{}
+	if cluster.Status.TLS != nil {
+		status = cluster.Status.TLS.DeepCopy()
+	}
+	status.Certificates = nil
+	var expiring []string
+	reloadHash := sha256.New()
+	reloadable := false
+	for _, mounted := range certificates {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: mounted.secret, Namespace: cluster.Namespace}, secret); err != nil {
+			if errors.IsNotFound(err) {
+				// Not issued yet, the servers wait for it
+				continue
+			}
+			return false, fmt.Errorf("failed to get Secret %s: %w", mounted.secret, err)
+		}
+
+		if mounted.reload {
+			reloadable = true
+			fmt.Fprintf(reloadHash, "%s\n", mounted.secret)
+			for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, "ca.crt"} {
+				fmt.Fprintf(reloadHash, "%s=%x\n", key, secret.Data[key])
+			}
+		}
+
+		certificate, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			logger.Info("Skipping the expiry of an unreadable certificate", "secret", mounted.secret, "error", err)
+			continue
+		}
+		notAfter := metav1.NewTime(certificate.NotAfter)
+		renewalTime := metav1.NewTime(certificateRenewalTime(cluster.Spec.TLS, certificate))
+		status.Certificates = append(status.Certificates, neo4jv1alpha1.CertificateStatus{
+			Scope:       mounted.scope,
+			SecretName:  mounted.secret,
+			NotAfter:    &notAfter,
+			RenewalTime: &renewalTime,
+		})
+		certificateMetrics.RecordExpiry(mounted.scope, mounted.secret, certificate.NotAfter.Sub(now))
+		if !now.Before(renewalTime.Time) {
+			expiring = append(expiring, fmt.Sprintf("%s (%s, expires %s)",
+				mounted.secret, mounted.scope, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		}
+	}
+	if cluster.Status.TLS != nil {
+		for _, previous := range cluster.Status.TLS.Certificates {
+			if !certificateListed(status.Certificates, previous) {
+				certificateMetrics.ForgetExpiry(previous.Scope, previous.SecretName)
+			}
+		}
+	}
+
+	condition := &metav1.Condition{
+		Type:    ConditionTypeCertificateExpiring,
+		Status:  metav1.ConditionFalse,
+		Reason:  ConditionReasonCertificatesValid,
+		Message: fmt.Sprintf("%d certificates are before their renewal time", len(status.Certificates)),
+	}
+	if len(expiring) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ConditionReasonCertificateExpiring
+		condition.Message = "Certificates past their renewal time: " + strings.Join(expiring, ", ")
+		if existing := findCondition(cluster.Status.Conditions, ConditionTypeCertificateExpiring); existing == nil || existing.Status != metav1.ConditionTrue {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonCertificateExpiring, condition.Message)
+		}
+	}
+
+	hash := ""
+	if reloadable {
+		hash = hex.EncodeToString(reloadHash.Sum(nil))[:16]
+	}
+	pending, restart := false, false
+	switch {
+	case hash == "":
+		status.ReloadedHash, status.PendingHash, status.PendingSince = "", "", nil
+	case status.ReloadedHash == "":
+		// The servers started with these certificates
+		status.ReloadedHash = hash
+	case status.ReloadedHash == hash:
+		status.PendingHash, status.PendingSince = "", nil
+	default:
+		if status.PendingHash != hash {
+			logger.Info("Certificates were renewed", "hash", hash)
+			pendingSince := metav1.NewTime(now.Truncate(time.Second))
+			status.PendingHash, status.PendingSince = hash, &pendingSince
+		}
+		if now.Sub(status.PendingSince.Time) < certificateReloadDelay {
+			pending = true
+			break
+		}
+		if err := r.reloadCertificates(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to reload the renewed certificates, restarting the servers")
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonCertificateReloadFailed,
+				fmt.Sprintf("Restarting the servers one at a time, they could not reload the renewed certificates: %v", err))
+			status.RestartHash = hash
+			restart = true
+		} else {
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonCertificatesReloaded,
+				"The servers reloaded the renewed certificates")
+		}
+		status.ReloadedHash, status.PendingHash, status.PendingSince = hash, "", nil
+	}
+
+	if err := r.updateCertificateStatus(ctx, cluster, status, condition); err != nil {
+		return pending, err
+	}
+	// The servers restarted for another rollout read the certificates too
+	if restart && cluster.Status.RollingRestart == nil {
+		return false, recordRollingRestart(ctx, r.Client, cluster, hash, rollingRestartReasonCertificates)
+	}
+	return pending, nil
+}
+
+// certificateListed reports whether a certificate status lists the Secret
+// of another one
+func certificateListed(certificates []neo4jv1alpha1.CertificateStatus, certificate neo4jv1alpha1.CertificateStatus) bool {
+	for _, listed := range certificates {
+		if listed.Scope == certificate.Scope && listed.SecretName == certificate.SecretName {
+			return true
+		}
+	}
+	return false
+}
+
+// updateCertificateStatus writes status.tls and the CertificateExpiring
+// condition, and removes both when the condition is nil
+func (r *Neo4jEnterpriseClusterReconciler) updateCertificateStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status *neo4jv1alpha1.TLSStatus, condition *metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		changed := !equality.Semantic.DeepEqual(latest.Status.TLS, status)
+		latest.Status.TLS = status
+		if condition == nil {
+			changed = meta.RemoveStatusCondition(&latest.Status.Conditions, ConditionTypeCertificateExpiring) || changed
+		} else {
+			existing := findCondition(latest.Status.Conditions, ConditionTypeCertificateExpiring)
+			if existing == nil || existing.Status != condition.Status || existing.Reason != condition.Reason || existing.Message != condition.Message {
+				SetNamedCondition(&latest.Status.Conditions, ConditionTypeCertificateExpiring, latest.Generation,
+					condition.Status, condition.Reason, condition.Message)
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.TLS = latest.Status.TLS
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+}
+
+// reloadCertificates has every running server re-read its certificates.
+// Pods that are not running read them when they start.
+func (r *Neo4jEnterpriseClusterReconciler) reloadCertificates(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name}, client.HasLabels{"neo4j.com/server-name"}); err != nil {
+		return fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	newClient := r.newTLSReloadClient
+	if newClient == nil {
+		newClient = r.connectForTLSReload
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		neo4jClient, err := newClient(ctx, cluster, pod)
+		if err != nil {
+			return fmt.Errorf("pod %s: failed to create Neo4j client: %w", pod.Name, err)
+		}
+		err = neo4jClient.ReloadTLS(ctx)
+		neo4jClient.Close()
+		if err != nil {
+			return fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// connectForTLSReload connects to the Neo4j server running in pod
+func (r *Neo4jEnterpriseClusterReconciler) connectForTLSReload(_ context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (tlsReloadClient, error) {
+	podURL := fmt.Sprintf("bolt://%s.%s-headless.%s.svc.cluster.local:7687", pod.Name, cluster.Name, cluster.Namespace)
+	return neo4jclient.NewClientForPod(cluster, r.Client, getClusterConnectionSecretName(cluster), podURL)
+}
+
+// setCertificatesRestart stamps the hash of the certificates the servers
+// could not reload on a pod template, which restarts them
+func setCertificatesRestart(template *corev1.PodTemplateSpec, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	if cluster.Status.TLS == nil || cluster.Status.TLS.RestartHash == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[resources.CertificatesRestartAnnotation] = cluster.Status.TLS.RestartHash
+}
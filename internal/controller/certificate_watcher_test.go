@@ -0,0 +1,223 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+type fakeTLSReloadClient struct {
+	pod      string
+	reloaded *[]string
+	err      error
+}
+
+func (f *fakeTLSReloadClient) ReloadTLS(context.Context) error {
+	if f.err != nil {
+		return f.err
+	}
+	*f.reloaded = append(*f.reloaded, f.pod)
+	return nil
+}
+
+func (f *fakeTLSReloadClient) Close() error { return nil }
+
+// certificatePEM returns a self-signed certificate valid between two times
+func certificatePEM(t *testing.T, notBefore, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "prod"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func certificateTestSetup(t *testing.T, notAfter time.Time) (client.Client, *Neo4jEnterpriseClusterReconciler, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	renewBefore := "720h"
+	cluster.Spec.TLS = &neo4jv1alpha1.TLSSpec{
+		Mode:        "cert-manager",
+		IssuerRef:   &neo4jv1alpha1.IssuerRef{Name: "ca-issuer"},
+		RenewBefore: &renewBefore,
+	}
+	cluster.Status.Phase = "Ready"
+	secret := tlsSecret("prod-tls-secret", "default", map[string]string{
+		"tls.crt": certificatePEM(t, notAfter.Add(-90*24*time.Hour), notAfter),
+		"tls.key": "key",
+	})
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, secret, restartTestPod("prod-server-0", "rev"), restartTestPod("prod-server-1", "rev")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	return c, r, cluster
+}
+
+func TestReconcileCertificates_Expiry(t *testing.T) {
+	ctx := context.Background()
+	notAfter := time.Now().Add(10 * 24 * time.Hour).Truncate(time.Second)
+	c, r, cluster := certificateTestSetup(t, notAfter)
+
+	pending, err := r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	require.NotNil(t, latest.Status.TLS)
+	require.Len(t, latest.Status.TLS.Certificates, 1)
+	certificate := latest.Status.TLS.Certificates[0]
+	assert.Equal(t, "default", certificate.Scope)
+	assert.Equal(t, "prod-tls-secret", certificate.SecretName)
+	assert.True(t, notAfter.Equal(certificate.NotAfter.Time))
+	assert.True(t, notAfter.Add(-720*time.Hour).Equal(certificate.RenewalTime.Time))
+	assert.NotEmpty(t, latest.Status.TLS.ReloadedHash)
+
+	// Ten days left is inside the 30 day renewal window
+	condition := findCondition(latest.Status.Conditions, ConditionTypeCertificateExpiring)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ConditionReasonCertificateExpiring, condition.Reason)
+	assert.Contains(t, condition.Message, "prod-tls-secret (default, expires ")
+	assert.Contains(t, <-r.Recorder.(*record.FakeRecorder).Events, EventReasonCertificateExpiring)
+
+	// Turning TLS off clears the status
+	cluster.Spec.TLS = nil
+	_, err = r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Nil(t, latest.Status.TLS)
+	assert.Nil(t, findCondition(latest.Status.Conditions, ConditionTypeCertificateExpiring))
+}
+
+func TestReconcileCertificates_ReloadsRenewedCertificates(t *testing.T) {
+	ctx := context.Background()
+	c, r, cluster := certificateTestSetup(t, time.Now().Add(60*24*time.Hour))
+	var reloaded []string
+	r.newTLSReloadClient = func(_ context.Context, _ *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (tlsReloadClient, error) {
+		return &fakeTLSReloadClient{pod: pod.Name, reloaded: &reloaded}, nil
+	}
+
+	_, err := r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	initial := cluster.Status.TLS.ReloadedHash
+	condition := findCondition(cluster.Status.Conditions, ConditionTypeCertificateExpiring)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+
+	// cert-manager renews the certificate in place
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-tls-secret", Namespace: "default"}, secret))
+	secret.Data["tls.crt"] = []byte(certificatePEM(t, time.Now(), time.Now().Add(90*24*time.Hour)))
+	require.NoError(t, c.Update(ctx, secret))
+
+	// The servers wait for the kubelet to refresh the mounted Secret
+	pending, err := r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Empty(t, reloaded)
+	assert.Equal(t, initial, cluster.Status.TLS.ReloadedHash)
+	renewed := cluster.Status.TLS.PendingHash
+	assert.NotEqual(t, initial, renewed)
+
+	past := metav1.NewTime(time.Now().Add(-certificateReloadDelay))
+	cluster.Status.TLS.PendingSince = &past
+	require.NoError(t, c.Status().Update(ctx, cluster))
+	pending, err = r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.ElementsMatch(t, []string{"prod-server-0", "prod-server-1"}, reloaded)
+	assert.Equal(t, renewed, cluster.Status.TLS.ReloadedHash)
+	assert.Empty(t, cluster.Status.TLS.PendingHash)
+	assert.Empty(t, cluster.Status.TLS.RestartHash)
+	assert.Nil(t, cluster.Status.RollingRestart)
+}
+
+func TestReconcileCertificates_RestartsWhenReloadFails(t *testing.T) {
+	ctx := context.Background()
+	c, r, cluster := certificateTestSetup(t, time.Now().Add(60*24*time.Hour))
+	r.newTLSReloadClient = func(_ context.Context, _ *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (tlsReloadClient, error) {
+		return &fakeTLSReloadClient{pod: pod.Name, err: errors.New("There is no procedure with the name `dbms.security.reloadTLS`")}, nil
+	}
+	past := metav1.NewTime(time.Now().Add(-certificateReloadDelay))
+	cluster.Status.TLS = &neo4jv1alpha1.TLSStatus{ReloadedHash: "old", PendingHash: "stale", PendingSince: &past}
+	require.NoError(t, c.Status().Update(ctx, cluster))
+
+	// A newly seen hash waits for the delay first
+	pending, err := r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	cluster.Status.TLS.PendingSince = &past
+	require.NoError(t, c.Status().Update(ctx, cluster))
+
+	_, err = r.reconcileCertificates(ctx, cluster)
+	require.NoError(t, err)
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	hash := latest.Status.TLS.ReloadedHash
+	assert.Equal(t, hash, latest.Status.TLS.RestartHash)
+	require.NotNil(t, latest.Status.RollingRestart)
+	assert.Equal(t, rollingRestartReasonCertificates, latest.Status.RollingRestart.Reason)
+
+	// The next StatefulSet revision restarts the servers
+	template := &corev1.PodTemplateSpec{}
+	setCertificatesRestart(template, latest)
+	assert.Equal(t, hash, template.Annotations["neo4j.neo4j.com/certificates-restart"])
+}
+
+func TestMountsCertificateSecret(t *testing.T) {
+	cluster := mTLSCluster("prod", "default")
+	cluster.Spec.TLS.Policies = &neo4jv1alpha1.SSLPolicies{
+		Bolt: &neo4jv1alpha1.SSLPolicySpec{IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "public"}},
+	}
+
+	certificates := mountedCertificates(cluster)
+	assert.Equal(t, []mountedCertificate{
+		{scope: "default", secret: "prod-tls-secret", reload: true},
+		{scope: "bolt", secret: "prod-bolt-tls-secret", reload: true},
+		{scope: "cluster", secret: "prod-server-0-cluster-tls-secret"},
+		{scope: "cluster", secret: "prod-server-1-cluster-tls-secret"},
+	}, certificates)
+	assert.True(t, mountsCertificateSecret(cluster, "prod-bolt-tls-secret"))
+	assert.False(t, mountsCertificateSecret(cluster, "other-tls-secret"))
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// ClusterClassAppliedAnnotation records the class template last applied to a
+// cluster, so that values inherited from the class follow it when it changes
+const ClusterClassAppliedAnnotation = "neo4j.com/cluster-class-applied"
+
+// applyClusterClass writes the template of the cluster's Neo4jClusterClass
+// into its spec and checks the policies of the class, so that every
+// controller reading the cluster sees the same configuration. It reports
+// whether the cluster was updated.
+func (r *Neo4jEnterpriseClusterReconciler) applyClusterClass(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	className := cluster.Spec.ClusterClassName
+	if className == "" {
+		return false, nil
+	}
+
+	class := &neo4jv1alpha1.Neo4jClusterClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: className}, class); err != nil {
+		if errors.IsNotFound(err) {
+			return false, fmt.Errorf("cluster class %s not found", className)
+		}
+		return false, err
+	}
+
+	validator := validation.NewClusterClassValidator()
+	updated := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		before, err := json.Marshal(latest.Spec)
+		if err != nil {
+			return err
+		}
+
+		applied, errs := validator.ApplyClass(class, latest, []byte(latest.Annotations[ClusterClassAppliedAnnotation]))
+		if len(errs) == 0 {
+			errs = validator.Validate(class, latest)
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("cluster class %s: %s", className, errs.ToAggregate().Error())
+		}
+
+		after, err := json.Marshal(latest.Spec)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(before, after) && latest.Annotations[ClusterClassAppliedAnnotation] == string(applied) {
+			updated = false
+			return nil
+		}
+		if latest.Annotations == nil {
+			latest.Annotations = map[string]string{}
+		}
+		latest.Annotations[ClusterClassAppliedAnnotation] = string(applied)
+		if err := r.Update(ctx, latest); err != nil {
+			return err
+		}
+		updated = true
+		latest.DeepCopyInto(cluster)
+		return nil
+	})
+	if err != nil || !updated {
+		return false, err
+	}
+
+	log.FromContext(ctx).Info("Applied cluster class", "clusterClass", className)
+	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonClusterClassApplied,
+		"Applied cluster class %s", className)
+	return true, nil
+}
+
+// clustersForClass maps a cluster class to the clusters created from it, so
+// that they pick up changes to its template and policies
+func (r *Neo4jEnterpriseClusterReconciler) clustersForClass(ctx context.Context, obj client.Object) []reconcile.Request {
+	clusters := &neo4jv1alpha1.Neo4jEnterpriseClusterList{}
+	if err := r.List(ctx, clusters); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list clusters for cluster class", "clusterClass", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.ClusterClassName != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cluster)})
+	}
+	return requests
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func productionClass() *neo4jv1alpha1.Neo4jClusterClass {
+	return &neo4jv1alpha1.Neo4jClusterClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: neo4jv1alpha1.Neo4jClusterClassSpec{
+			Template: runtime.RawExtension{Raw: []byte(`{"image":{"repo":"neo4j","tag":"5.26.0-enterprise"},` +
+				`"topology":{"servers":3},"storage":{"className":"standard","size":"10Gi"}}`)},
+			AllowedOverrides: []string{"topology.servers"},
+		},
+	}
+}
+
+func TestApplyClusterClass(t *testing.T) {
+	ctx := context.Background()
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "team-a"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			ClusterClassName: "production",
+			Topology:         neo4jv1alpha1.TopologyConfiguration{Servers: 5},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(productionClass(), cluster).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Recorder: recorder}
+
+	applied, err := r.applyClusterClass(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, "Normal ClusterClassApplied Applied cluster class production", <-recorder.Events)
+
+	stored := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), stored))
+	assert.Equal(t, "5.26.0-enterprise", stored.Spec.Image.Tag)
+	assert.Equal(t, "10Gi", stored.Spec.Storage.Size)
+	assert.Equal(t, int32(5), stored.Spec.Topology.Servers, "allowed override is kept")
+	assert.NotEmpty(t, stored.Annotations[ClusterClassAppliedAnnotation])
+
+	// Applying the class again changes nothing
+	applied, err = r.applyClusterClass(ctx, stored)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	// Locked fields cannot be overridden
+	stored.Spec.Storage.Size = "100Gi"
+	require.NoError(t, c.Update(ctx, stored))
+	_, err = r.applyClusterClass(ctx, stored)
+	assert.ErrorContains(t, err, "spec.storage.size: Forbidden")
+
+	// A missing class is reported
+	orphan := minimalCluster("orphan", "team-a")
+	orphan.Spec.ClusterClassName = "missing"
+	_, err = r.applyClusterClass(ctx, orphan)
+	assert.ErrorContains(t, err, "cluster class missing not found")
+}
+
+func TestClustersForClass(t *testing.T) {
+	withClass := func(name, namespace, class string) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+		cluster := minimalCluster(name, namespace)
+		cluster.Spec.ClusterClassName = class
+		return cluster
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		withClass("orders", "team-a", "production"),
+		withClass("users", "team-b", "production"),
+		withClass("scratch", "team-a", "development"),
+		minimalCluster("legacy", "team-a"),
+	).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c}
+
+	var names []string
+	for _, request := range r.clustersForClass(context.Background(), productionClass()) {
+		names = append(names, request.String())
+	}
+	assert.ElementsMatch(t, []string{"team-a/orders", "team-b/users"}, names)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// serverCertificateRequeueInterval is how often StatefulSets waiting for
+// cert-manager to issue server certificates are checked
+const serverCertificateRequeueInterval = 10 * time.Second
+
+// reconcileServerCertificates creates the Certificate of every server pod
+// when spec.tls.cluster.mTLS is required
+func (r *Neo4jEnterpriseClusterReconciler) reconcileServerCertificates(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	for _, desired := range resources.BuildServerCertificates(cluster) {
+		certificate := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, certificate, func() error {
+			certificate.Labels = desired.Labels
+			certificate.Spec = desired.Spec
+			return controllerutil.SetControllerReference(cluster, certificate, r.Scheme)
+		}); err != nil {
+			return fmt.Errorf("failed to reconcile Certificate %s: %w", desired.Name, err)
+		}
+	}
+	return nil
+}
+
+// deleteStaleServerCertificates deletes the Certificates and Secrets of
+// server pods that no longer exist, or of all servers once mTLS is turned
+// off. It runs after the StatefulSets were updated, so no pod template
+// still mounts them. Without cert-manager there are none to delete.
+func (r *Neo4jEnterpriseClusterReconciler) deleteStaleServerCertificates(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if cluster.Spec.TLS == nil || cluster.Spec.TLS.Mode != resources.CertManagerMode {
+		return nil
+	}
+	wanted := map[string]bool{}
+	for _, certificate := range resources.BuildServerCertificates(cluster) {
+		wanted[certificate.Labels[resources.ServerCertificateLabel]] = true
+	}
+	selector := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name},
+		client.HasLabels{resources.ServerCertificateLabel},
+	}
+
+	certificates := &certmanagerv1.CertificateList{}
+	if err := r.List(ctx, certificates, selector...); err != nil {
+		return fmt.Errorf("failed to list server Certificates: %w", err)
+	}
+	for i := range certificates.Items {
+		certificate := &certificates.Items[i]
+		if wanted[certificate.Labels[resources.ServerCertificateLabel]] || !metav1.IsControlledBy(certificate, cluster) {
+			continue
+		}
+		if err := r.Delete(ctx, certificate); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Certificate %s: %w", certificate.Name, err)
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, selector...); err != nil {
+		return fmt.Errorf("failed to list server certificate Secrets: %w", err)
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if wanted[secret.Labels[resources.ServerCertificateLabel]] {
+			continue
+		}
+		if err := r.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Secret %s: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// serverCertificatesHash hashes the server certificates of the pods of a
+// server pool, empty without mTLS. It also returns the first pod whose
+// certificate cert-manager has not issued yet: its StatefulSet waits for it
+// rather than leave the pod stuck mounting a missing Secret. A certificate
+// without the CA of its issuer is an error, as the servers could not verify
+// each other.
+func serverCertificatesHash(ctx context.Context, c client.Client, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) (string, string, error) {
+	if !resources.ClusterMTLSEnabled(cluster.Spec.TLS) {
+		return "", "", nil
+	}
+
+	hash := sha256.New()
+	for _, pod := range resources.ServerPodNames(cluster, pool) {
+		name := resources.ServerCertificateSecretName(pod)
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: cluster.Namespace}, secret); err != nil {
+			if errors.IsNotFound(err) {
+				return "", pod, nil
+			}
+			return "", "", fmt.Errorf("failed to get Secret %s: %w", name, err)
+		}
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+			if len(secret.Data[key]) == 0 {
+				return "", pod, nil
+			}
+		}
+		if len(secret.Data["ca.crt"]) == 0 {
+			return "", "", fmt.Errorf("secret %s has no ca.crt: mTLS needs an issuer that publishes its CA", name)
+		}
+		fmt.Fprintf(hash, "%s\n", name)
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, "ca.crt"} {
+			fmt.Fprintf(hash, "%s=%x\n", key, secret.Data[key])
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16], "", nil
+}
+
+// setServerCertificatesHash stamps the hash of the server certificates on a
+// pod template
+func setServerCertificatesHash(template *corev1.PodTemplateSpec, hash string) {
+	if hash == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[resources.ServerCertificatesHashAnnotation] = hash
+}
+
+// prepareCertificateRollout hands the restarts of the servers over to the
+// rolling restart when their certificates were renewed, or a server was
+// added, so that leaders restart last and the cluster keeps serving.
+// Turning mTLS on or off changes the configuration, which restarts the
+// servers already.
+func (r *Neo4jEnterpriseClusterReconciler) prepareCertificateRollout(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, desired *appsv1.StatefulSet) error {
+	existing := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	current := existing.Spec.Template.Annotations[resources.ServerCertificatesHashAnnotation]
+	target := desired.Spec.Template.Annotations[resources.ServerCertificatesHashAnnotation]
+	if current == "" || target == "" || current == target {
+		return nil
+	}
+	desired.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+
+	// The pods restarted for another rollout get the new certificates too
+	if cluster.Status.RollingRestart != nil {
+		return nil
+	}
+	log.FromContext(ctx).Info("Rolling out new server certificates", "hash", target)
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServerCertificatesRenewed,
+		"Restarting the servers one at a time for new server certificates")
+	return recordRollingRestart(ctx, r.Client, cluster, target, rollingRestartReasonCertificates)
+}
+
+// ownsServerCertificateSecret reports whether a Secret holds the certificate
+// of a server of the cluster
+func ownsServerCertificateSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, obj client.Object) bool {
+	labels := obj.GetLabels()
+	return labels[resources.ServerCertificateLabel] != "" && labels["neo4j.com/cluster"] == cluster.Name
+}
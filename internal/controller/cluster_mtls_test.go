@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func mTLSCluster(name, namespace string) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := minimalCluster(name, namespace)
+	cluster.Spec.TLS = &neo4jv1alpha1.TLSSpec{
+		Mode:      "cert-manager",
+		IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "ca-issuer"},
+		Cluster:   &neo4jv1alpha1.ClusterTLSSpec{MTLS: "required"},
+	}
+	return cluster
+}
+
+func TestServerCertificatesHash(t *testing.T) {
+	ctx := context.Background()
+	cluster := mTLSCluster("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+
+	// The StatefulSet waits until cert-manager issued every certificate
+	_, pending, err := serverCertificatesHash(ctx, c, cluster, "server")
+	require.NoError(t, err)
+	assert.Equal(t, "prod-server-0", pending)
+
+	server0 := tlsSecret("prod-server-0-cluster-tls-secret", "default", map[string]string{"tls.crt": "cert-0", "tls.key": "key-0", "ca.crt": "ca"})
+	require.NoError(t, c.Create(ctx, server0))
+	server1 := tlsSecret("prod-server-1-cluster-tls-secret", "default", map[string]string{"tls.crt": "cert-1", "tls.key": "key-1"})
+	require.NoError(t, c.Create(ctx, server1))
+	_, _, err = serverCertificatesHash(ctx, c, cluster, "server")
+	require.EqualError(t, err, "secret prod-server-1-cluster-tls-secret has no ca.crt: mTLS needs an issuer that publishes its CA")
+
+	server1.Data["ca.crt"] = []byte("ca")
+	require.NoError(t, c.Update(ctx, server1))
+	hash, pending, err := serverCertificatesHash(ctx, c, cluster, "server")
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.Len(t, hash, 16)
+
+	// A renewed certificate changes the hash
+	server1.Data["tls.crt"] = []byte("cert-1-renewed")
+	require.NoError(t, c.Update(ctx, server1))
+	renewed, _, err := serverCertificatesHash(ctx, c, cluster, "server")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, renewed)
+
+	// Without mTLS there is nothing to wait for
+	hash, pending, err = serverCertificatesHash(ctx, c, minimalCluster("prod", "default"), "server")
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+	assert.Empty(t, pending)
+}
+
+func TestPrepareCertificateRollout(t *testing.T) {
+	ctx := context.Background()
+	cluster := mTLSCluster("prod", "default")
+	existing := resources.BuildServerStatefulSetForEnterprise(cluster)
+	setServerCertificatesHash(&existing.Spec.Template, "old-hash")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, existing).
+		WithStatusSubresource(cluster).
+		Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	// Unchanged certificates leave the StatefulSet alone
+	desired := resources.BuildServerStatefulSetForEnterprise(cluster)
+	setServerCertificatesHash(&desired.Spec.Template, "old-hash")
+	require.NoError(t, r.prepareCertificateRollout(ctx, cluster, desired))
+	assert.NotEqual(t, appsv1.OnDeleteStatefulSetStrategyType, desired.Spec.UpdateStrategy.Type)
+	assert.Nil(t, cluster.Status.RollingRestart)
+
+	// Renewed ones are rolled out by the operator, leaders last
+	setServerCertificatesHash(&desired.Spec.Template, "new-hash")
+	require.NoError(t, r.prepareCertificateRollout(ctx, cluster, desired))
+	assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, desired.Spec.UpdateStrategy.Type)
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	require.NotNil(t, latest.Status.RollingRestart)
+	assert.Equal(t, rollingRestartReasonCertificates, latest.Status.RollingRestart.Reason)
+	assert.Equal(t, "new-hash", latest.Status.RollingRestart.ConfigHash)
+	assert.Equal(t, "the new server certificates", rolloutDescription(latest.Status.RollingRestart))
+}
+
+func TestClustersForTLSSecret_ServerCertificate(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(mTLSCluster("prod", "default"), mTLSCluster("staging", "default")).
+		Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme()}
+
+	secret := tlsSecret("prod-server-0-cluster-tls-secret", "default", nil)
+	secret.Labels = map[string]string{"neo4j.com/cluster": "prod", resources.ServerCertificateLabel: "prod-server-0"}
+	requests := r.clustersForTLSSecret(ctx, secret)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "prod", requests[0].Name)
+
+	assert.Empty(t, r.clustersForTLSSecret(ctx, tlsSecret("unrelated", "default", nil)))
+}
@@ -19,6 +19,50 @@ const (
 
 	// ConditionTypeDatabasesHealthy indicates all expected user databases are online.
 	ConditionTypeDatabasesHealthy = "DatabasesHealthy"
+
+	// ConditionTypeResourcesAdmitted indicates the generated StatefulSets and
+	// Services passed a server-side dry run, including admission webhooks
+	// and policies.
+	ConditionTypeResourcesAdmitted = "ResourcesAdmitted"
+
+	// ConditionTypeStackReady indicates the deployment is Ready, every
+	// Neo4jDatabase targeting it is online and the credentials Secrets they
+	// reference exist. Applications wait on it before connecting.
+	ConditionTypeStackReady = "StackReady"
+
+	// ConditionTypeOptionalAPIsAvailable indicates the cluster serves the
+	// optional APIs the spec uses, such as OpenShift Routes. Resources of a
+	// missing API are skipped instead of failing the reconcile.
+	ConditionTypeOptionalAPIsAvailable = "OptionalAPIsAvailable"
+
+	// ConditionTypeUpgradeReady indicates a pending image change passed the
+	// upgrade path, database and configuration checks. It is only present
+	// while an upgrade is pending.
+	ConditionTypeUpgradeReady = "UpgradeReady"
+
+	// ConditionTypeUpgradeFailed indicates the last upgrade failed and the
+	// servers were rolled back to the previous image
+	ConditionTypeUpgradeFailed = "UpgradeFailed"
+
+	// ConditionTypeConfigValid indicates spec.config passed the settings
+	// check for the cluster's Neo4j version. It is False while the ConfigMap
+	// keeps the last accepted configuration.
+	ConditionTypeConfigValid = "ConfigValid"
+
+	// ConditionTypeCertificateExpiring indicates a certificate the servers
+	// mount is past its renewal time: spec.tls.renewBefore ahead of its
+	// expiry, or the last third of its lifetime.
+	ConditionTypeCertificateExpiring = "CertificateExpiring"
+
+	// ConditionTypeLicenseCompliant indicates the servers need no more cores
+	// than the Neo4j license entitlement of the cluster. It is only present
+	// while an entitlement applies.
+	ConditionTypeLicenseCompliant = "LicenseCompliant"
+
+	// ConditionTypeDependenciesReady indicates the resources a resource
+	// depends on, through spec.dependsOn or its target deployment, report
+	// Ready. It is False while the reconcile waits for them.
+	ConditionTypeDependenciesReady = "DependenciesReady"
 )
 
 // Reason constants for the Ready condition across all CRDs.
@@ -36,12 +80,40 @@ const (
 	ConditionReasonRestoreFailed   = "RestoreFailed"
 	ConditionReasonPluginInstalled = "PluginInstalled"
 	ConditionReasonPluginFailed    = "PluginInstallFailed"
+	ConditionReasonScheduledStop   = "ScheduledStop"
+	ConditionReasonUsersSynced     = "UsersSynced"
+	ConditionReasonMigrated        = "Migrated"
+	ConditionReasonMaintenance     = "MaintenanceMode"
+	ConditionReasonHibernated      = "Hibernated"
 
 	ConditionReasonAllServersHealthy      = "AllServersHealthy"
 	ConditionReasonServerDegraded         = "ServerDegraded"
 	ConditionReasonAllDatabasesOnline     = "AllDatabasesOnline"
 	ConditionReasonDatabaseOffline        = "DatabaseOffline"
 	ConditionReasonDiagnosticsUnavailable = "DiagnosticsUnavailable"
+	ConditionReasonDryRunPassed           = "DryRunPassed"
+	ConditionReasonAdmissionDenied        = "AdmissionDenied"
+	ConditionReasonStackReady             = "AllDependenciesReady"
+	ConditionReasonStackNotReady          = "DependenciesNotReady"
+	ConditionReasonAPIsServed             = "AllAPIsServed"
+	ConditionReasonAPIMissing             = "APIMissing"
+	ConditionReasonUpgradeChecksPassed    = "UpgradeChecksPassed"
+	ConditionReasonUpgradeChecksRunning   = "UpgradeChecksRunning"
+	ConditionReasonUnsupportedUpgradePath = "UnsupportedUpgradePath"
+	ConditionReasonStoreCheckFailed       = "StoreCheckFailed"
+	ConditionReasonConfigCheckFailed      = "ConfigCheckFailed"
+	ConditionReasonCanaryFailed           = "CanaryFailed"
+	ConditionReasonUpgradeRolledBack      = "UpgradeRolledBack"
+	ConditionReasonConfigAccepted         = "ConfigAccepted"
+	ConditionReasonConfigWarnings         = "ConfigWarnings"
+	ConditionReasonConfigRejected         = "ConfigRejected"
+	ConditionReasonCertificateExpiring    = "CertificateExpiring"
+	ConditionReasonCertificatesValid      = "CertificatesValid"
+	ConditionReasonWithinLicense          = "WithinLicense"
+	ConditionReasonLicenseExceeded        = "LicenseExceeded"
+	ConditionReasonLicenseUnavailable     = "LicenseUnavailable"
+	ConditionReasonDependenciesReady      = "DependenciesReady"
+	ConditionReasonWaitingForDependencies = "WaitingForDependencies"
 )
 
 // SetReadyCondition sets the standard "Ready" condition on a conditions slice.
@@ -98,11 +170,22 @@ func PhaseToConditionStatus(phase string) (metav1.ConditionStatus, string) {
 		return metav1.ConditionTrue, ConditionReasonBackupSucceeded
 	case "Failed", "Degraded", "Suspended":
 		return metav1.ConditionFalse, ConditionReasonFailed
+	case "Stopped":
+		return metav1.ConditionFalse, ConditionReasonScheduledStop
+	case "Synced":
+		return metav1.ConditionTrue, ConditionReasonUsersSynced
+	case "Migrated":
+		return metav1.ConditionTrue, ConditionReasonMigrated
 	case "Upgrading":
 		return metav1.ConditionUnknown, ConditionReasonUpgrading
+	case "Maintenance":
+		return metav1.ConditionUnknown, ConditionReasonMaintenance
+	case "Hibernated":
+		return metav1.ConditionFalse, ConditionReasonHibernated
 	case "Forming", "Creating":
 		return metav1.ConditionUnknown, ConditionReasonForming
-	case "Installing", "Running", "Validating", "Pending":
+	case "Installing", "Running", "Validating", "Pending", "Syncing", "Migrating", "Enabling",
+		"Hibernating", "Resuming":
 		return metav1.ConditionUnknown, ConditionReasonPending
 	default:
 		return metav1.ConditionUnknown, ConditionReasonPending
@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// reconcileConfigOverrides creates the per-pod ConfigMaps of
+// spec.configOverrides and deletes those of pods no override applies to
+// anymore. The pods pick up a change when the StatefulSet rolls them for
+// the new overrides hash.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileConfigOverrides(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	wanted := map[string]bool{}
+	for _, configMap := range resources.BuildConfigOverrideConfigMaps(cluster) {
+		if err := r.createOrUpdateResource(ctx, configMap, cluster); err != nil {
+			return fmt.Errorf("failed to create ConfigMap %s: %w", configMap.Name, err)
+		}
+		wanted[configMap.Name] = true
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name},
+		client.HasLabels{resources.ConfigOverrideLabel}); err != nil {
+		return fmt.Errorf("failed to list config override ConfigMaps: %w", err)
+	}
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		if wanted[configMap.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ConfigMap %s: %w", configMap.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestReconcileConfigOverrides(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.UID = "prod-uid"
+	cluster.Spec.ConfigOverrides = []neo4jv1alpha1.ConfigOverrideSpec{
+		{Name: "debug", Servers: []int32{0, 1}, Config: map[string]string{"db.logs.query.enabled": "VERBOSE"}},
+	}
+	shared := configMapWithData("prod-config", "default", map[string]string{"neo4j.conf": ""})
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, shared).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	get := func(name string) (*corev1.ConfigMap, error) {
+		configMap := &corev1.ConfigMap{}
+		return configMap, c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, configMap)
+	}
+
+	require.NoError(t, r.reconcileConfigOverrides(ctx, cluster))
+	server0, err := get("prod-server-0-config")
+	require.NoError(t, err)
+	assert.Contains(t, server0.Data["neo4j.conf"], "db.logs.query.enabled=VERBOSE\n")
+	assert.Equal(t, "prod-uid", string(server0.OwnerReferences[0].UID))
+	_, err = get("prod-server-1-config")
+	require.NoError(t, err)
+
+	// Narrowing the override deletes the ConfigMap of the other pod and
+	// nothing else
+	cluster.Spec.ConfigOverrides[0].Servers = []int32{0}
+	require.NoError(t, r.reconcileConfigOverrides(ctx, cluster))
+	_, err = get("prod-server-0-config")
+	require.NoError(t, err)
+	_, err = get("prod-config")
+	require.NoError(t, err)
+	_, err = get("prod-server-1-config")
+	assert.True(t, errors.IsNotFound(err))
+}
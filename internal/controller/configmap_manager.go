@@ -31,11 +31,13 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // ConfigMapManager handles ConfigMap updates and pod restarts
@@ -43,6 +45,10 @@ type ConfigMapManager struct {
 	client.Client
 	lastUpdateTime map[string]time.Time
 	mu             sync.RWMutex
+
+	// newServerConfigClient connects to one server to apply dynamic settings.
+	// Nil uses a bolt client for the pod.
+	newServerConfigClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (serverConfigClient, error)
 }
 
 // NewConfigMapManager creates a new ConfigMap manager
@@ -57,8 +63,19 @@ func NewConfigMapManager(client client.Client) *ConfigMapManager {
 func (cm *ConfigMapManager) ReconcileConfigMap(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
 	logger := log.FromContext(ctx)
 
+	// Settings Neo4j refuses would crash-loop every restarted pod, so they
+	// never reach the ConfigMap
+	if err := cm.checkConfig(ctx, cluster); err != nil {
+		return err
+	}
+
+	scripts, err := cm.lifecycleScripts(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
 	// Generate desired ConfigMap
-	desiredConfigMap := resources.BuildConfigMapForEnterprise(cluster)
+	desiredConfigMap := resources.BuildConfigMapWithLifecycleScripts(cluster, scripts)
 
 	// Get existing ConfigMap
 	existingConfigMap := &corev1.ConfigMap{}
@@ -67,7 +84,7 @@ func (cm *ConfigMapManager) ReconcileConfigMap(ctx context.Context, cluster *neo
 		Namespace: cluster.Namespace,
 	}
 
-	err := cm.Get(ctx, configMapKey, existingConfigMap)
+	err = cm.Get(ctx, configMapKey, existingConfigMap)
 	configMapExists := err == nil
 
 	var configChanged bool
@@ -140,6 +157,15 @@ func (cm *ConfigMapManager) ReconcileConfigMap(ctx context.Context, cluster *neo
 			changes := cm.analyzeConfigChanges(existingConfigMap, desiredConfigMap)
 			needsRestart := cm.requiresRestart(changes)
 
+			// Dynamic settings are applied to the running servers instead
+			if settings, dynamic := cm.dynamicConfigChanges(existingConfigMap, desiredConfigMap); needsRestart && dynamic {
+				if err := cm.applyDynamicConfig(ctx, cluster, settings); err != nil {
+					logger.Error(err, "Failed to apply dynamic configuration changes, restarting the servers instead")
+				} else {
+					needsRestart = false
+				}
+			}
+
 			if needsRestart {
 				logger.Info("Configuration changes require pod restart, triggering rolling restart",
 					"cluster", cluster.Name,
@@ -167,6 +193,100 @@ func (cm *ConfigMapManager) ReconcileConfigMap(ctx context.Context, cluster *neo
 	return nil
 }
 
+// checkConfig checks spec.config and spec.configOverrides against the
+// settings catalog for the cluster's Neo4j version and reports the result in the ConfigValid
+// condition. It returns an error when Neo4j would refuse the settings.
+func (cm *ConfigMapManager) checkConfig(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	// Tags that do not parse are reported by the image validator
+	imageVersion, _ := version.Parse(cluster.Spec.Image.Tag)
+
+	var invalid, warnings []string
+	record := func(prefix string, config map[string]string) {
+		for _, problem := range version.CheckSettings(config, imageVersion) {
+			if problem.Invalid {
+				invalid = append(invalid, prefix+problem.String())
+			} else {
+				warnings = append(warnings, prefix+problem.String())
+			}
+		}
+	}
+	record("", cluster.Spec.Config)
+	for _, override := range cluster.Spec.ConfigOverrides {
+		record(fmt.Sprintf("configOverrides[%s] ", override.Name), override.Config)
+	}
+
+	switch {
+	case len(invalid) > 0:
+		message := strings.Join(invalid, "; ")
+		cm.setConfigValidCondition(ctx, cluster, metav1.ConditionFalse, ConditionReasonConfigRejected, message)
+		return fmt.Errorf("spec.config rejected: %s", message)
+	case len(warnings) > 0:
+		log.FromContext(ctx).Info("spec.config has settings Neo4j may not accept", "cluster", cluster.Name, "warnings", warnings)
+		cm.setConfigValidCondition(ctx, cluster, metav1.ConditionTrue, ConditionReasonConfigWarnings, strings.Join(warnings, "; "))
+	default:
+		cm.setConfigValidCondition(ctx, cluster, metav1.ConditionTrue, ConditionReasonConfigAccepted, "spec.config passed the settings check")
+	}
+	return nil
+}
+
+// lifecycleScripts reads the snippets spec.lifecycle references. A missing
+// ConfigMap or key is an error rather than an empty snippet, so that a typo
+// does not silently drop a site's startup tweaks.
+func (cm *ConfigMapManager) lifecycleScripts(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (resources.LifecycleScripts, error) {
+	var scripts resources.LifecycleScripts
+	if cluster.Spec.Lifecycle == nil {
+		return scripts, nil
+	}
+
+	read := func(field string, ref *neo4jv1alpha1.ConfigMapKeySelector) (string, error) {
+		if ref == nil {
+			return "", nil
+		}
+		source := &corev1.ConfigMap{}
+		if err := cm.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: cluster.Namespace}, source); err != nil {
+			return "", fmt.Errorf("failed to get ConfigMap %s for spec.lifecycle.%s: %w", ref.Name, field, err)
+		}
+		script, ok := source.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("ConfigMap %s has no key %s for spec.lifecycle.%s", ref.Name, ref.Key, field)
+		}
+		return script, nil
+	}
+
+	var err error
+	if scripts.PreStart, err = read("preStartScript", cluster.Spec.Lifecycle.PreStartScript); err != nil {
+		return scripts, err
+	}
+	if scripts.PostStart, err = read("postStartScript", cluster.Spec.Lifecycle.PostStartScript); err != nil {
+		return scripts, err
+	}
+	return scripts, nil
+}
+
+// setConfigValidCondition records the result of the settings check
+func (cm *ConfigMapManager) setConfigValidCondition(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status metav1.ConditionStatus, reason, message string) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := cm.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		existing := findCondition(latest.Status.Conditions, ConditionTypeConfigValid)
+		if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		SetNamedCondition(&latest.Status.Conditions, ConditionTypeConfigValid, latest.Generation, status, reason, message)
+		if err := cm.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update ConfigValid condition")
+	}
+}
+
 // updateConfigMapImmediate immediately updates the ConfigMap
 func (cm *ConfigMapManager) updateConfigMapImmediate(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, configMap *corev1.ConfigMap) error {
 	logger := log.FromContext(ctx)
@@ -210,13 +330,14 @@ func (cm *ConfigMapManager) updateConfigMapImmediate(ctx context.Context, cluste
 }
 
 // triggerRollingRestartForConfigChange triggers a rolling restart when configuration changes.
-// It stamps a config-hash annotation on the pod template of each server StatefulSet, which
-// causes Kubernetes to perform a rolling restart without any image change.
+// It stamps a config-hash annotation on the pod template of the server StatefulSet and
+// switches it to the OnDelete strategy, so that the pods are restarted one by one by
+// reconcileRollingRestart rather than rolled by Kubernetes regardless of leadership.
 func (cm *ConfigMapManager) triggerRollingRestartForConfigChange(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, configHash string) error {
 	logger := log.FromContext(ctx)
 
 	// The current architecture uses a single {cluster}-server StatefulSet.
-	// Update it so Kubernetes rolls the pods to pick up the new neo4j.conf.
+	// Update it so the restarted pods pick up the new neo4j.conf.
 	serverSts := &appsv1.StatefulSet{}
 	serverKey := types.NamespacedName{
 		Name:      fmt.Sprintf("%s-server", cluster.Name),
@@ -231,6 +352,11 @@ func (cm *ConfigMapManager) triggerRollingRestartForConfigChange(ctx context.Con
 		return fmt.Errorf("failed to get server StatefulSet: %w", err)
 	}
 
+	// Record the restart first: the StatefulSet is built with OnDelete from now on
+	if err := cm.recordRollingRestart(ctx, cluster, configHash, rollingRestartReasonConfigChange); err != nil {
+		return fmt.Errorf("failed to record rolling restart: %w", err)
+	}
+
 	if err := cm.updateStatefulSetWithConfigHash(ctx, serverSts, configHash); err != nil {
 		return fmt.Errorf("failed to update server StatefulSet: %w", err)
 	}
@@ -242,16 +368,52 @@ func (cm *ConfigMapManager) triggerRollingRestartForConfigChange(ctx context.Con
 	return nil
 }
 
-// updateStatefulSetWithConfigHash updates a StatefulSet with config hash annotation to trigger rolling restart
+// recordRollingRestart sets status.rollingRestart for a configuration hash. A
+// restart already in progress keeps its start time.
+func (cm *ConfigMapManager) recordRollingRestart(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, configHash, reason string) error {
+	return recordRollingRestart(ctx, cm.Client, cluster, configHash, reason)
+}
+
+// recordRollingRestart sets status.rollingRestart, which the server
+// StatefulSet is built with the OnDelete strategy for
+func recordRollingRestart(ctx context.Context, c client.Client, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, configHash, reason string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		now := metav1.Now()
+		restart := &neo4jv1alpha1.RollingRestartStatus{
+			ConfigHash: configHash,
+			Reason:     reason,
+			StartTime:  &now,
+			Message:    "Waiting for the servers to be restarted",
+		}
+		if previous := latest.Status.RollingRestart; previous != nil {
+			restart.StartTime = previous.StartTime
+		}
+		latest.Status.RollingRestart = restart
+		if err := c.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.RollingRestart = latest.Status.RollingRestart
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// updateStatefulSetWithConfigHash stamps the config hash on the pod template and hands
+// the pod restarts over to the operator
 func (cm *ConfigMapManager) updateStatefulSetWithConfigHash(ctx context.Context, sts *appsv1.StatefulSet, configHash string) error {
 	// Initialize annotations if nil
 	if sts.Spec.Template.Annotations == nil {
 		sts.Spec.Template.Annotations = make(map[string]string)
 	}
 
-	// Set config hash annotation to trigger rolling restart
+	// Set config hash annotation so restarted pods get the new revision
 	sts.Spec.Template.Annotations["neo4j.neo4j.com/config-hash"] = configHash
 	sts.Spec.Template.Annotations["neo4j.neo4j.com/config-restart"] = time.Now().Format(time.RFC3339)
+	sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
 
 	return cm.Update(ctx, sts)
 }
@@ -262,7 +424,8 @@ func (cm *ConfigMapManager) calculateConfigMapHash(configMap *corev1.ConfigMap)
 	hasher := sha256.New()
 
 	// Process each key in deterministic order
-	keys := []string{"neo4j.conf", "startup.sh", "health.sh"}
+	// The log forwarder only reads its configuration on start
+	keys := []string{"neo4j.conf", "startup.sh", "health.sh", "krb5.conf", "kerberos.conf", "fluent-bit.conf", "parsers.conf"}
 	for _, key := range keys {
 		value, exists := configMap.Data[key]
 		if !exists {
@@ -452,6 +615,10 @@ func (cm *ConfigMapManager) HasMemoryConfigChanged(oldCluster, newCluster *neo4j
 		return true
 	}
 
+	if !reflect.DeepEqual(oldCluster.Spec.Memory, newCluster.Spec.Memory) {
+		return true
+	}
+
 	// Check Neo4j memory config changes
 	oldHeap := ""
 	newHeap := ""
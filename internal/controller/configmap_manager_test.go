@@ -21,7 +21,9 @@ import (
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,7 +41,9 @@ func newTestScheme() *runtime.Scheme {
 	s := runtime.NewScheme()
 	_ = neo4jv1alpha1.AddToScheme(s)
 	_ = appsv1.AddToScheme(s)
+	_ = batchv1.AddToScheme(s)
 	_ = corev1.AddToScheme(s)
+	_ = networkingv1.AddToScheme(s)
 	return s
 }
 
@@ -268,6 +272,7 @@ func TestTriggerRollingRestartForConfigChange_StampsAnnotation(t *testing.T) {
 	fc := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(cluster, sts).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
 		Build()
 
 	cm := NewConfigMapManager(fc)
@@ -287,6 +292,13 @@ func TestTriggerRollingRestartForConfigChange_StampsAnnotation(t *testing.T) {
 	if updated.Spec.Template.Annotations["neo4j.neo4j.com/config-restart"] == "" {
 		t.Error("expected config-restart timestamp annotation to be set")
 	}
+	// The operator restarts the pods itself
+	if updated.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		t.Errorf("expected OnDelete update strategy, got %q", updated.Spec.UpdateStrategy.Type)
+	}
+	if cluster.Status.RollingRestart == nil || cluster.Status.RollingRestart.ConfigHash != "abc123" {
+		t.Errorf("expected rolling restart of config hash 'abc123', got %+v", cluster.Status.RollingRestart)
+	}
 }
 
 func TestTriggerRollingRestartForConfigChange_MissingSTS(t *testing.T) {
@@ -330,6 +342,13 @@ func TestHasMemoryConfigChanged(t *testing.T) {
 	if !cm.HasMemoryConfigChanged(base, withLimit) {
 		t.Error("expected memory change detected when resource limits differ")
 	}
+
+	// Turning on memory auto-tuning
+	autoTuned := minimalCluster("c", "ns")
+	autoTuned.Spec.Memory = &neo4jv1alpha1.MemorySpec{AutoTune: true}
+	if !cm.HasMemoryConfigChanged(base, autoTuned) {
+		t.Error("expected memory change detected when auto-tuning is turned on")
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -359,6 +378,65 @@ func TestReconcileConfigMap_Creates(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// TestReconcileConfigMap_ChecksSettings
+// ---------------------------------------------------------------------------
+
+func TestReconcileConfigMap_ChecksSettings(t *testing.T) {
+	cluster := minimalCluster("checked", "default")
+	cluster.Spec.Config = map[string]string{
+		"db.transaction.timeout": "ten seconds",
+		"custom.setting":         "value",
+	}
+	fc := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cluster, serverSTS("checked", "default")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
+		Build()
+	cm := NewConfigMapManager(fc)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "checked", Namespace: "default"}
+
+	// An invalid value keeps the ConfigMap from being written
+	if err := cm.ReconcileConfigMap(ctx, cluster); err == nil {
+		t.Fatal("expected ReconcileConfigMap to reject the invalid duration")
+	}
+	if err := fc.Get(ctx, types.NamespacedName{Name: "checked-config", Namespace: "default"}, &corev1.ConfigMap{}); err == nil {
+		t.Error("expected no ConfigMap for a rejected config")
+	}
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	if err := fc.Get(ctx, key, latest); err != nil {
+		t.Fatal(err)
+	}
+	condition := findCondition(latest.Status.Conditions, ConditionTypeConfigValid)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != ConditionReasonConfigRejected {
+		t.Fatalf("expected a rejected ConfigValid condition, got %+v", condition)
+	}
+	want := `db.transaction.timeout: invalid duration "ten seconds", use a number with a unit such as 500ms, 30s or 5m`
+	if condition.Message != want {
+		t.Errorf("condition message = %q, want %q", condition.Message, want)
+	}
+
+	// Unknown settings are written with a warning
+	cluster.Spec.Config["db.transaction.timeout"] = "30s"
+	if err := cm.ReconcileConfigMap(ctx, cluster); err != nil {
+		t.Fatalf("ReconcileConfigMap returned error: %v", err)
+	}
+	if err := fc.Get(ctx, types.NamespacedName{Name: "checked-config", Namespace: "default"}, &corev1.ConfigMap{}); err != nil {
+		t.Errorf("expected ConfigMap to be created, got error: %v", err)
+	}
+	if err := fc.Get(ctx, key, latest); err != nil {
+		t.Fatal(err)
+	}
+	condition = findCondition(latest.Status.Conditions, ConditionTypeConfigValid)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != ConditionReasonConfigWarnings {
+		t.Fatalf("expected a ConfigValid condition with warnings, got %+v", condition)
+	}
+	if condition.Message != "custom.setting: unknown setting" {
+		t.Errorf("condition message = %q", condition.Message)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Small string helpers (avoid importing strings in test without adding to prod)
 // ---------------------------------------------------------------------------
@@ -0,0 +1,272 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// isCompositeDatabase reports whether the database is a composite database
+func isCompositeDatabase(database *neo4jv1alpha1.Neo4jDatabase) bool {
+	return database.Spec.Type == "composite"
+}
+
+// desiredDatabaseAliases returns the constituents and aliases of the spec as
+// alias definitions, reading the credentials of remote aliases from their
+// Secrets.
+func desiredDatabaseAliases(ctx context.Context, c client.Client, database *neo4jv1alpha1.Neo4jDatabase) ([]neo4j.AliasDefinition, error) {
+	var definitions []neo4j.AliasDefinition
+	for _, constituent := range database.Spec.Constituents {
+		definition, err := aliasDefinition(ctx, c, database, database.Spec.Name, constituent)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	for _, alias := range database.Spec.Aliases {
+		definition, err := aliasDefinition(ctx, c, database, "", alias)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+func aliasDefinition(ctx context.Context, c client.Client, database *neo4jv1alpha1.Neo4jDatabase, composite string, alias neo4jv1alpha1.DatabaseAlias) (neo4j.AliasDefinition, error) {
+	definition := neo4j.AliasDefinition{
+		Composite:      composite,
+		Name:           alias.Name,
+		TargetDatabase: alias.TargetDatabase,
+	}
+	if definition.TargetDatabase == "" {
+		definition.TargetDatabase = database.Spec.Name
+	}
+	if alias.Remote == nil {
+		return definition, nil
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: alias.Remote.CredentialsSecret, Namespace: database.Namespace}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return definition, fmt.Errorf("failed to get credentials secret %s of alias %s: %w", key.Name, alias.Name, err)
+	}
+	username, password := string(secret.Data["username"]), string(secret.Data["password"])
+	if username == "" || password == "" {
+		return definition, fmt.Errorf("credentials secret %s of alias %s must contain username and password", key.Name, alias.Name)
+	}
+
+	driver, err := aliasDriverSettings(alias.Remote.Driver)
+	if err != nil {
+		return definition, fmt.Errorf("invalid driver settings of alias %s: %w", alias.Name, err)
+	}
+	definition.URL = alias.Remote.URL
+	definition.User = username
+	definition.Password = password
+	definition.Driver = driver
+	return definition, nil
+}
+
+// aliasDriverSettings converts the driver settings of a remote alias to the
+// Cypher setting names and value types of neo4j.AliasDefinition
+func aliasDriverSettings(settings *neo4jv1alpha1.AliasDriverSettings) (map[string]interface{}, error) {
+	if settings == nil {
+		return nil, nil
+	}
+
+	driver := map[string]interface{}{}
+	if settings.SSLEnforced != nil {
+		driver["ssl_enforced"] = *settings.SSLEnforced
+	}
+	durations := []struct{ key, value string }{
+		{"connection_timeout", settings.ConnectionTimeout},
+		{"connection_max_lifetime", settings.ConnectionMaxLifetime},
+		{"connection_pool_acquisition_timeout", settings.ConnectionPoolAcquisitionTimeout},
+		{"connection_pool_idle_test", settings.ConnectionPoolIdleTest},
+	}
+	for _, duration := range durations {
+		if duration.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(duration.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", duration.key, err)
+		}
+		driver[duration.key] = parsed
+	}
+	if settings.ConnectionPoolMaxSize != nil {
+		driver["connection_pool_max_size"] = int64(*settings.ConnectionPoolMaxSize)
+	}
+	if settings.LoggingLevel != "" {
+		driver["logging_level"] = settings.LoggingLevel
+	}
+	return driver, nil
+}
+
+// aliasDrifted reports whether an existing alias no longer matches its
+// definition. Passwords are not listed by Neo4j, so a changed password is
+// only applied when something else changes too.
+func aliasDrifted(current neo4j.AliasInfo, desired neo4j.AliasDefinition) bool {
+	if current.Database != desired.TargetDatabase || current.URL != desired.URL {
+		return true
+	}
+	if desired.URL == "" {
+		return false
+	}
+	if current.User != desired.User {
+		return true
+	}
+	if len(current.Driver) == 0 && len(desired.Driver) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(current.Driver, desired.Driver)
+}
+
+// reconcileDatabaseAliases creates the constituents and aliases missing from
+// Neo4j, alters the ones that drifted from the spec and drops the ones that
+// were removed from it, then records the managed aliases in the status.
+func (r *Neo4jDatabaseReconciler) reconcileDatabaseAliases(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+	logger := log.FromContext(ctx)
+
+	if len(database.Spec.Constituents) == 0 && len(database.Spec.Aliases) == 0 && len(database.Status.Aliases) == 0 {
+		return nil
+	}
+
+	desired, err := desiredDatabaseAliases(ctx, r.Client, database)
+	if err != nil {
+		return err
+	}
+	existing, err := neo4jClient.ListAliases(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]neo4j.AliasInfo, len(existing))
+	for _, alias := range existing {
+		current[alias.Name] = alias
+	}
+
+	var managed []string
+	wanted := map[string]bool{}
+	for _, definition := range desired {
+		name := aliasDefinitionName(definition)
+		managed = append(managed, name)
+		wanted[name] = true
+
+		alias, found := current[name]
+		switch {
+		case !found:
+			if err := neo4jClient.CreateAlias(ctx, definition); err != nil {
+				return err
+			}
+			logger.Info("Created database alias", "alias", name, "target", definition.TargetDatabase)
+			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonAliasCreated,
+				"Created alias %s for database %s", name, definition.TargetDatabase)
+		case (alias.URL == "") != (definition.URL == ""):
+			// Local and remote aliases cannot be altered into each other
+			if err := neo4jClient.DropAlias(ctx, definition.Composite, definition.Name); err != nil {
+				return err
+			}
+			if err := neo4jClient.CreateAlias(ctx, definition); err != nil {
+				return err
+			}
+			logger.Info("Recreated database alias", "alias", name, "target", definition.TargetDatabase)
+			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonAliasUpdated,
+				"Recreated alias %s for database %s", name, definition.TargetDatabase)
+		case aliasDrifted(alias, definition):
+			if err := neo4jClient.AlterAlias(ctx, definition); err != nil {
+				return err
+			}
+			logger.Info("Altered database alias", "alias", name, "target", definition.TargetDatabase)
+			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonAliasUpdated,
+				"Altered alias %s for database %s", name, definition.TargetDatabase)
+		}
+	}
+
+	for _, name := range database.Status.Aliases {
+		if wanted[name] {
+			continue
+		}
+		composite, alias := r.splitManagedAliasName(database, name)
+		if err := neo4jClient.DropAlias(ctx, composite, alias); err != nil {
+			return err
+		}
+		logger.Info("Dropped database alias", "alias", name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonAliasDropped, "Dropped alias %s", name)
+	}
+
+	if reflect.DeepEqual(managed, database.Status.Aliases) {
+		return nil
+	}
+	database.Status.Aliases = managed
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jDatabase{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(database), latest); err != nil {
+			return err
+		}
+		latest.Status.Aliases = managed
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		database.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// dropDatabaseAliases drops the aliases recorded in the status before the
+// database itself is dropped. Constituents go with their composite database.
+func (r *Neo4jDatabaseReconciler) dropDatabaseAliases(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+	for _, name := range database.Status.Aliases {
+		composite, alias := r.splitManagedAliasName(database, name)
+		if composite != "" {
+			continue
+		}
+		if err := neo4jClient.DropAlias(ctx, composite, alias); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitManagedAliasName splits a status entry into the composite database
+// and the alias name. Only constituents of this database are qualified.
+func (r *Neo4jDatabaseReconciler) splitManagedAliasName(database *neo4jv1alpha1.Neo4jDatabase, name string) (string, string) {
+	if prefix := database.Spec.Name + "."; isCompositeDatabase(database) && strings.HasPrefix(name, prefix) {
+		return database.Spec.Name, strings.TrimPrefix(name, prefix)
+	}
+	return "", name
+}
+
+// aliasDefinitionName returns the name SHOW ALIASES lists a definition under
+func aliasDefinitionName(definition neo4j.AliasDefinition) string {
+	if definition.Composite == "" {
+		return definition.Name
+	}
+	return definition.Composite + "." + definition.Name
+}
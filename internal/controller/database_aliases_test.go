@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func compositeDatabase() *neo4jv1alpha1.Neo4jDatabase {
+	return &neo4jv1alpha1.Neo4jDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "garden", Namespace: "neo4j"},
+		Spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+			ClusterRef: "prod",
+			Name:       "garden",
+			Type:       "composite",
+			Constituents: []neo4jv1alpha1.DatabaseAlias{
+				{Name: "flowers", TargetDatabase: "flowerdb"},
+				{
+					Name:           "trees",
+					TargetDatabase: "treedb",
+					Remote: &neo4jv1alpha1.RemoteAliasTarget{
+						URL:               "neo4j+s://remote.example.com:7687",
+						CredentialsSecret: "remote-creds",
+						Driver: &neo4jv1alpha1.AliasDriverSettings{
+							SSLEnforced:           ptr.To(true),
+							ConnectionTimeout:     "5s",
+							ConnectionPoolMaxSize: ptr.To(int32(20)),
+						},
+					},
+				},
+			},
+			Aliases: []neo4jv1alpha1.DatabaseAlias{{Name: "plants"}},
+		},
+	}
+}
+
+func TestDesiredDatabaseAliases(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-creds", Namespace: "neo4j"},
+		Data:       map[string][]byte{"username": []byte("reader"), "password": []byte("secret")},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(secret).Build()
+
+	definitions, err := desiredDatabaseAliases(context.Background(), c, compositeDatabase())
+	require.NoError(t, err)
+	require.Len(t, definitions, 3)
+
+	assert.Equal(t, neo4j.AliasDefinition{Composite: "garden", Name: "flowers", TargetDatabase: "flowerdb"}, definitions[0])
+	assert.Equal(t, "garden.trees", aliasDefinitionName(definitions[1]))
+	assert.Equal(t, "reader", definitions[1].User)
+	assert.Equal(t, "secret", definitions[1].Password)
+	assert.Equal(t, map[string]interface{}{
+		"ssl_enforced":             true,
+		"connection_timeout":       5 * time.Second,
+		"connection_pool_max_size": int64(20),
+	}, definitions[1].Driver)
+
+	// Local aliases default to the database itself
+	assert.Equal(t, neo4j.AliasDefinition{Name: "plants", TargetDatabase: "garden"}, definitions[2])
+}
+
+func TestDesiredDatabaseAliasesMissingSecret(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+
+	_, err := desiredDatabaseAliases(context.Background(), c, compositeDatabase())
+	assert.ErrorContains(t, err, "remote-creds")
+}
+
+func TestAliasDrifted(t *testing.T) {
+	local := neo4j.AliasDefinition{Name: "plants", TargetDatabase: "garden"}
+	assert.False(t, aliasDrifted(neo4j.AliasInfo{Name: "plants", Database: "garden", Location: "local"}, local))
+	assert.True(t, aliasDrifted(neo4j.AliasInfo{Name: "plants", Database: "other", Location: "local"}, local))
+
+	remote := neo4j.AliasDefinition{
+		Name:           "sales",
+		TargetDatabase: "salesdb",
+		URL:            "neo4j+s://remote.example.com:7687",
+		User:           "reader",
+		Driver:         map[string]interface{}{"connection_timeout": 5 * time.Second},
+	}
+	current := neo4j.AliasInfo{
+		Name:     "sales",
+		Database: "salesdb",
+		URL:      "neo4j+s://remote.example.com:7687",
+		User:     "reader",
+		Driver:   map[string]interface{}{"connection_timeout": 5 * time.Second},
+	}
+	assert.False(t, aliasDrifted(current, remote))
+
+	current.User = "writer"
+	assert.True(t, aliasDrifted(current, remote))
+
+	current.User = "reader"
+	current.Driver = map[string]interface{}{"connection_timeout": 10 * time.Second}
+	assert.True(t, aliasDrifted(current, remote))
+
+	// No driver settings on either side is not drift
+	remote.Driver, current.Driver = nil, map[string]interface{}{}
+	assert.False(t, aliasDrifted(current, remote))
+}
+
+func TestSplitManagedAliasName(t *testing.T) {
+	r := &Neo4jDatabaseReconciler{}
+	database := compositeDatabase()
+
+	composite, name := r.splitManagedAliasName(database, "garden.flowers")
+	assert.Equal(t, "garden", composite)
+	assert.Equal(t, "flowers", name)
+
+	composite, name = r.splitManagedAliasName(database, "plants")
+	assert.Empty(t, composite)
+	assert.Equal(t, "plants", name)
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+const (
+	// DatabaseConfirmDropAnnotation confirms dropping a database whose drop
+	// policy requires it. Its value must be the name of the database.
+	DatabaseConfirmDropAnnotation = "neo4j.com/confirm-drop"
+
+	// FinalDumpDatabaseUIDLabel ties a final dump backup to the
+	// Neo4jDatabase it was taken for, so that a backup left behind by an
+	// earlier resource of the same name is not mistaken for it
+	FinalDumpDatabaseUIDLabel = "neo4j.com/final-dump-of"
+
+	databaseStateOffline = "offline"
+)
+
+// wantsOffline reports whether spec.desiredState asks for the database to be
+// stopped. Composite databases have no store to stop.
+func wantsOffline(database *neo4jv1alpha1.Neo4jDatabase) bool {
+	return database.Spec.DesiredState == databaseStateOffline && !isCompositeDatabase(database)
+}
+
+// dropPolicyRetains reports whether the database is kept in Neo4j when its
+// resource is deleted
+func dropPolicyRetains(database *neo4jv1alpha1.Neo4jDatabase) bool {
+	return database.Spec.DropPolicy != nil && database.Spec.DropPolicy.Action == "Retain"
+}
+
+// dropConfirmed reports whether the drop of the database was acknowledged
+// with the confirmation annotation
+func dropConfirmed(database *neo4jv1alpha1.Neo4jDatabase) bool {
+	return database.Annotations[DatabaseConfirmDropAnnotation] == database.Spec.Name
+}
+
+// finalDumpName returns the name of the Neo4jBackup taken before the
+// database is dropped
+func finalDumpName(database *neo4jv1alpha1.Neo4jDatabase) string {
+	return database.Name + "-final-dump"
+}
+
+// reconcileDatabaseState stops or starts the database to match
+// spec.desiredState and records the state Neo4j reports in the status.
+func (r *Neo4jDatabaseReconciler) reconcileDatabaseState(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+	if isCompositeDatabase(database) {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	name := database.Spec.Name
+	offline := database.Status.State == databaseStateOffline
+	switch {
+	case wantsOffline(database) && !offline:
+		// Stopping ends the transactions still running, so they are reported
+		if transactions, err := neo4jClient.ShowTransactions(ctx, name); err == nil && len(transactions) > 0 {
+			logger.Info("Stopping database with running transactions", "database", name, "transactions", len(transactions))
+		}
+		if err := neo4jClient.StopDatabase(ctx, name, true); err != nil {
+			return err
+		}
+		logger.Info("Stopped database", "database", name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonDatabaseStopped, "Stopped database %s", name)
+	case !wantsOffline(database) && offline:
+		if err := neo4jClient.StartDatabase(ctx, name, true); err != nil {
+			return err
+		}
+		logger.Info("Started database", "database", name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonDatabaseStarted, "Started database %s", name)
+	default:
+		return r.updateDatabaseState(ctx, database, database.Status.State)
+	}
+
+	state, err := neo4jClient.GetDatabaseState(ctx, name)
+	if err != nil {
+		return err
+	}
+	return r.updateDatabaseState(ctx, database, state)
+}
+
+// updateDatabaseState stores the state of the database in its status when it
+// changed.
+func (r *Neo4jDatabaseReconciler) updateDatabaseState(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, state string) error {
+	if state == "" {
+		return nil
+	}
+	database.Status.State = state
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jDatabase{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(database), latest); err != nil {
+			return err
+		}
+		if latest.Status.State == state {
+			return nil
+		}
+		latest.Status.State = state
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		database.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// prepareDatabaseDrop checks the drop policy of a deleted database and
+// reports whether it can be dropped now: the drop has been confirmed if the
+// policy requires it, and the final dump, if any, has completed.
+func (r *Neo4jDatabaseReconciler) prepareDatabaseDrop(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) (bool, error) {
+	policy := database.Spec.DropPolicy
+	if policy == nil {
+		return true, nil
+	}
+
+	if policy.RequireConfirmation && !dropConfirmed(database) {
+		message := fmt.Sprintf("Dropping database %s requires the %s annotation set to %q",
+			database.Spec.Name, DatabaseConfirmDropAnnotation, database.Spec.Name)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonDropPending, message)
+		r.Recorder.Event(database, corev1.EventTypeWarning, EventReasonDropPending, message)
+		return false, nil
+	}
+
+	if policy.FinalDump == nil || isCompositeDatabase(database) {
+		return true, nil
+	}
+	return r.ensureFinalDump(ctx, neo4jClient, database)
+}
+
+// ensureFinalDump creates the Neo4jBackup of the final dump and reports
+// whether it has completed. A failed dump holds the drop until the backup is
+// deleted, which starts a new one.
+func (r *Neo4jDatabaseReconciler) ensureFinalDump(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	backup := &neo4jv1alpha1.Neo4jBackup{}
+	key := types.NamespacedName{Name: finalDumpName(database), Namespace: database.Namespace}
+	err := r.Get(ctx, key, backup)
+	if errors.IsNotFound(err) {
+		// Backups are taken from a running database
+		if state, err := neo4jClient.GetDatabaseState(ctx, database.Spec.Name); err == nil && state == databaseStateOffline {
+			if err := neo4jClient.StartDatabase(ctx, database.Spec.Name, true); err != nil {
+				return false, fmt.Errorf("failed to start database for final dump: %w", err)
+			}
+		}
+
+		backup = buildFinalDumpBackup(database)
+		if err := r.Create(ctx, backup); err != nil {
+			return false, fmt.Errorf("failed to create final dump backup %s: %w", backup.Name, err)
+		}
+		message := fmt.Sprintf("Taking final dump %s of database %s before dropping it", backup.Name, database.Spec.Name)
+		logger.Info("Created final dump backup", "backup", backup.Name, "database", database.Spec.Name)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonFinalDumpStarted, message)
+		r.Recorder.Event(database, corev1.EventTypeNormal, EventReasonFinalDumpStarted, message)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if backup.Labels[FinalDumpDatabaseUIDLabel] != string(database.UID) {
+		message := fmt.Sprintf("Neo4jBackup %s was not taken for this database; delete it to take the final dump", backup.Name)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonFinalDumpFailed, message)
+		r.Recorder.Event(database, corev1.EventTypeWarning, EventReasonFinalDumpFailed, message)
+		return false, nil
+	}
+
+	switch backup.Status.Phase {
+	case "Completed":
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonFinalDumpCompleted,
+			"Final dump %s of database %s completed", backup.Name, database.Spec.Name)
+		return true, nil
+	case "Failed":
+		message := fmt.Sprintf("Final dump %s failed: %s; delete it to retry", backup.Name, backup.Status.Message)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonFinalDumpFailed, message)
+		r.Recorder.Event(database, corev1.EventTypeWarning, EventReasonFinalDumpFailed, message)
+	}
+	return false, nil
+}
+
+// buildFinalDumpBackup returns the one-off Neo4jBackup of the final dump. It
+// is not owned by the database, so the record of the dump stays after the
+// database is gone.
+func buildFinalDumpBackup(database *neo4jv1alpha1.Neo4jDatabase) *neo4jv1alpha1.Neo4jBackup {
+	dump := database.Spec.DropPolicy.FinalDump
+	return &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      finalDumpName(database),
+			Namespace: database.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "neo4j-operator",
+				FinalDumpDatabaseUIDLabel:      string(database.UID),
+			},
+		},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target: neo4jv1alpha1.BackupTarget{
+				Kind:       "Database",
+				Name:       database.Spec.Name,
+				ClusterRef: database.Spec.ClusterRef,
+			},
+			Storage: *dump.Storage.DeepCopy(),
+			Cloud:   dump.Cloud.DeepCopy(),
+		},
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func lifecycleDatabase(policy *neo4jv1alpha1.DatabaseDropPolicy) *neo4jv1alpha1.Neo4jDatabase {
+	return &neo4jv1alpha1.Neo4jDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default", UID: types.UID("db-uid")},
+		Spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+			ClusterRef: "prod",
+			Name:       "orders",
+			DropPolicy: policy,
+		},
+	}
+}
+
+func TestDatabaseLifecyclePolicies(t *testing.T) {
+	database := lifecycleDatabase(nil)
+	assert.False(t, wantsOffline(database))
+	assert.False(t, dropPolicyRetains(database))
+
+	database.Spec.DesiredState = "offline"
+	assert.True(t, wantsOffline(database))
+	database.Spec.Type = "composite"
+	assert.False(t, wantsOffline(database))
+
+	database.Spec.DropPolicy = &neo4jv1alpha1.DatabaseDropPolicy{Action: "Retain"}
+	assert.True(t, dropPolicyRetains(database))
+
+	assert.False(t, dropConfirmed(database))
+	database.Annotations = map[string]string{DatabaseConfirmDropAnnotation: "other"}
+	assert.False(t, dropConfirmed(database))
+	database.Annotations[DatabaseConfirmDropAnnotation] = "orders"
+	assert.True(t, dropConfirmed(database))
+}
+
+func TestBuildFinalDumpBackup(t *testing.T) {
+	database := lifecycleDatabase(&neo4jv1alpha1.DatabaseDropPolicy{
+		FinalDump: &neo4jv1alpha1.DatabaseFinalDump{
+			Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "dumps", Path: "final"},
+			Cloud:   &neo4jv1alpha1.CloudBlock{Provider: "aws"},
+		},
+	})
+
+	backup := buildFinalDumpBackup(database)
+	assert.Equal(t, "orders-final-dump", backup.Name)
+	assert.Equal(t, "default", backup.Namespace)
+	assert.Equal(t, "db-uid", backup.Labels[FinalDumpDatabaseUIDLabel])
+	assert.Empty(t, backup.OwnerReferences)
+	assert.Equal(t, neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "orders", ClusterRef: "prod"}, backup.Spec.Target)
+	assert.Equal(t, "dumps", backup.Spec.Storage.Bucket)
+	assert.Equal(t, "aws", backup.Spec.Cloud.Provider)
+	assert.Empty(t, backup.Spec.Schedule)
+}
+
+func TestPrepareDatabaseDrop(t *testing.T) {
+	dump := &neo4jv1alpha1.DatabaseFinalDump{Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "dumps"}}
+	finalDump := func(uid, phase string) *neo4jv1alpha1.Neo4jBackup {
+		return &neo4jv1alpha1.Neo4jBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "orders-final-dump",
+				Namespace: "default",
+				Labels:    map[string]string{FinalDumpDatabaseUIDLabel: uid},
+			},
+			Status: neo4jv1alpha1.Neo4jBackupStatus{Phase: phase, Message: "Backup job failed"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		policy    *neo4jv1alpha1.DatabaseDropPolicy
+		confirmed bool
+		backup    *neo4jv1alpha1.Neo4jBackup
+		ready     bool
+		phase     string
+	}{
+		{name: "no policy", ready: true},
+		{
+			name:   "unconfirmed drop",
+			policy: &neo4jv1alpha1.DatabaseDropPolicy{RequireConfirmation: true},
+			phase:  "Deleting",
+		},
+		{
+			name:      "confirmed drop",
+			policy:    &neo4jv1alpha1.DatabaseDropPolicy{RequireConfirmation: true},
+			confirmed: true,
+			ready:     true,
+		},
+		{
+			name:   "final dump running",
+			policy: &neo4jv1alpha1.DatabaseDropPolicy{FinalDump: dump},
+			backup: finalDump("db-uid", "Running"),
+		},
+		{
+			name:   "final dump completed",
+			policy: &neo4jv1alpha1.DatabaseDropPolicy{FinalDump: dump},
+			backup: finalDump("db-uid", "Completed"),
+			ready:  true,
+		},
+		{
+			name:   "final dump failed",
+			policy: &neo4jv1alpha1.DatabaseDropPolicy{FinalDump: dump},
+			backup: finalDump("db-uid", "Failed"),
+			phase:  "Failed",
+		},
+		{
+			name:   "final dump of an earlier database",
+			policy: &neo4jv1alpha1.DatabaseDropPolicy{FinalDump: dump},
+			backup: finalDump("old-uid", "Completed"),
+			phase:  "Failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := lifecycleDatabase(tt.policy)
+			if tt.confirmed {
+				database.Annotations = map[string]string{DatabaseConfirmDropAnnotation: "orders"}
+			}
+			objects := []client.Object{database}
+			if tt.backup != nil {
+				objects = append(objects, tt.backup)
+			}
+			c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+				WithObjects(objects...).
+				WithStatusSubresource(&neo4jv1alpha1.Neo4jDatabase{}).
+				Build()
+			r := &Neo4jDatabaseReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+			ready, err := r.prepareDatabaseDrop(context.Background(), nil, database)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ready, ready)
+
+			latest := &neo4jv1alpha1.Neo4jDatabase{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(database), latest))
+			assert.Equal(t, tt.phase, latest.Status.Phase)
+		})
+	}
+}
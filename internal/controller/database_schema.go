@@ -0,0 +1,331 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// schemaPlan is the result of comparing the indexes and constraints of a
+// database with spec.schema
+type schemaPlan struct {
+	createIndexes        []neo4j.IndexDefinition
+	createConstraints    []neo4j.ConstraintDefinition
+	drifted              []string
+	unmanagedIndexes     []string
+	unmanagedConstraints []string
+}
+
+// unmanaged returns the names of the undeclared indexes and constraints
+func (p schemaPlan) unmanaged() []string {
+	names := append(append([]string(nil), p.unmanagedConstraints...), p.unmanagedIndexes...)
+	sort.Strings(names)
+	return names
+}
+
+// indexDefinition converts a declared index to the definition it is created
+// from
+func indexDefinition(index neo4jv1alpha1.SchemaIndex) neo4j.IndexDefinition {
+	definition := neo4j.IndexDefinition{
+		Name:          index.Name,
+		Type:          index.Type,
+		LabelsOrTypes: index.Labels,
+		Properties:    index.Properties,
+	}
+	if definition.Type == "" {
+		definition.Type = "RANGE"
+	}
+	if len(index.RelationshipTypes) > 0 {
+		definition.Relationship = true
+		definition.LabelsOrTypes = index.RelationshipTypes
+	}
+	if len(index.Options) > 0 {
+		definition.Config = make(map[string]interface{}, len(index.Options))
+		for key, value := range index.Options {
+			definition.Config[key] = indexOptionValue(value)
+		}
+	}
+	return definition
+}
+
+// indexOptionValue passes numeric and boolean option values to Neo4j as such
+func indexOptionValue(value string) interface{} {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b
+	}
+	return value
+}
+
+// constraintDefinition converts a declared constraint to the definition it
+// is created from
+func constraintDefinition(constraint neo4jv1alpha1.SchemaConstraint) neo4j.ConstraintDefinition {
+	definition := neo4j.ConstraintDefinition{
+		Name:         constraint.Name,
+		Type:         constraint.Type,
+		LabelOrType:  constraint.Label,
+		Properties:   constraint.Properties,
+		PropertyType: constraint.PropertyType,
+	}
+	if constraint.RelationshipType != "" {
+		definition.Relationship = true
+		definition.LabelOrType = constraint.RelationshipType
+	}
+	return definition
+}
+
+func schemaEntityType(relationship bool) string {
+	if relationship {
+		return "RELATIONSHIP"
+	}
+	return "NODE"
+}
+
+// indexDrifted reports whether an existing index differs from its
+// definition. Only the settings the definition declares are compared, as
+// Neo4j fills in defaults for the others.
+func indexDrifted(current neo4j.IndexInfo, desired neo4j.IndexDefinition) bool {
+	if current.Type != desired.Type || current.EntityType != schemaEntityType(desired.Relationship) {
+		return true
+	}
+	tokens, wantTokens := current.LabelsOrTypes, desired.LabelsOrTypes
+	if desired.Type == "FULLTEXT" {
+		// The order of the labels of a fulltext index has no meaning
+		tokens, wantTokens = sortedCopy(tokens), sortedCopy(wantTokens)
+	}
+	if !reflect.DeepEqual(tokens, wantTokens) || !reflect.DeepEqual(current.Properties, desired.Properties) {
+		return true
+	}
+	for key, value := range desired.Config {
+		actual, found := current.Config[key]
+		if !found || !strings.EqualFold(fmt.Sprintf("%v", actual), fmt.Sprintf("%v", value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// constraintDrifted reports whether an existing constraint differs from its
+// definition
+func constraintDrifted(current neo4j.ConstraintInfo, desired neo4j.ConstraintDefinition) bool {
+	if neo4j.ConstraintKind(current.Type) != desired.Type || current.EntityType != schemaEntityType(desired.Relationship) {
+		return true
+	}
+	if !reflect.DeepEqual(current.LabelsOrTypes, []string{desired.LabelOrType}) || !reflect.DeepEqual(current.Properties, desired.Properties) {
+		return true
+	}
+	return desired.Type == "PROPERTY_TYPE" && normalizePropertyType(current.PropertyType) != normalizePropertyType(desired.PropertyType)
+}
+
+// normalizePropertyType makes Cypher type names comparable regardless of
+// case and spacing, e.g. "list<integer not null>" and "LIST<INTEGER NOT NULL>"
+func normalizePropertyType(propertyType string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(propertyType)), " ")
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// planDatabaseSchema compares the indexes and constraints of a database with
+// the spec. Token lookup indexes and indexes backing a constraint are never
+// reported as unmanaged.
+func planDatabaseSchema(schema *neo4jv1alpha1.DatabaseSchema, indexes []neo4j.IndexInfo, constraints []neo4j.ConstraintInfo) schemaPlan {
+	existingIndexes := make(map[string]neo4j.IndexInfo, len(indexes))
+	for _, index := range indexes {
+		existingIndexes[index.Name] = index
+	}
+	existingConstraints := make(map[string]neo4j.ConstraintInfo, len(constraints))
+	for _, constraint := range constraints {
+		existingConstraints[constraint.Name] = constraint
+	}
+
+	var plan schemaPlan
+	declared := map[string]bool{}
+	for _, constraint := range schema.Constraints {
+		declared[constraint.Name] = true
+		definition := constraintDefinition(constraint)
+		current, found := existingConstraints[constraint.Name]
+		switch {
+		case found:
+			if constraintDrifted(current, definition) {
+				plan.drifted = append(plan.drifted, constraint.Name)
+			}
+		case existingIndexes[constraint.Name].Name != "":
+			// Indexes and constraints share one namespace
+			plan.drifted = append(plan.drifted, constraint.Name)
+		default:
+			plan.createConstraints = append(plan.createConstraints, definition)
+		}
+	}
+	for _, index := range schema.Indexes {
+		declared[index.Name] = true
+		definition := indexDefinition(index)
+		current, found := existingIndexes[index.Name]
+		switch {
+		case found:
+			if indexDrifted(current, definition) {
+				plan.drifted = append(plan.drifted, index.Name)
+			}
+		case existingConstraints[index.Name].Name != "":
+			plan.drifted = append(plan.drifted, index.Name)
+		default:
+			plan.createIndexes = append(plan.createIndexes, definition)
+		}
+	}
+
+	for _, constraint := range constraints {
+		if !declared[constraint.Name] {
+			plan.unmanagedConstraints = append(plan.unmanagedConstraints, constraint.Name)
+		}
+	}
+	for _, index := range indexes {
+		if declared[index.Name] || index.Type == "LOOKUP" || index.OwningConstraint != "" {
+			continue
+		}
+		plan.unmanagedIndexes = append(plan.unmanagedIndexes, index.Name)
+	}
+	sort.Strings(plan.drifted)
+	return plan
+}
+
+// reconcileDatabaseSchema creates the declared indexes and constraints that
+// are missing, drops undeclared ones when pruning, and reports the ones that
+// drifted from their declaration in the status. Drifted entries are left as
+// they are, as recreating an index or constraint can be expensive.
+func (r *Neo4jDatabaseReconciler) reconcileDatabaseSchema(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+	logger := log.FromContext(ctx)
+
+	schema := database.Spec.Schema
+	if schema == nil || isCompositeDatabase(database) {
+		if database.Status.Schema == nil {
+			return nil
+		}
+		return r.updateSchemaStatus(ctx, database, nil)
+	}
+	if database.Status.State == databaseStateOffline {
+		// A stopped database cannot be queried; the schema is reconciled
+		// once it is started again
+		return nil
+	}
+
+	name := database.Spec.Name
+	indexes, err := neo4jClient.ListIndexes(ctx, name)
+	if err != nil {
+		return err
+	}
+	constraints, err := neo4jClient.ListConstraints(ctx, name)
+	if err != nil {
+		return err
+	}
+	plan := planDatabaseSchema(schema, indexes, constraints)
+
+	changed := false
+	for _, constraint := range plan.createConstraints {
+		if err := neo4jClient.CreateConstraint(ctx, name, constraint); err != nil {
+			return err
+		}
+		changed = true
+		logger.Info("Created constraint", "database", name, "constraint", constraint.Name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonSchemaUpdated,
+			"Created constraint %s in database %s", constraint.Name, name)
+	}
+	for _, index := range plan.createIndexes {
+		if err := neo4jClient.CreateIndex(ctx, name, index); err != nil {
+			return err
+		}
+		changed = true
+		logger.Info("Created index", "database", name, "index", index.Name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonSchemaUpdated,
+			"Created index %s in database %s", index.Name, name)
+	}
+
+	unmanaged := plan.unmanaged()
+	if schema.Prune {
+		// Constraints go first, taking the indexes backing them along
+		for _, constraint := range plan.unmanagedConstraints {
+			if err := neo4jClient.DropConstraint(ctx, name, constraint); err != nil {
+				return err
+			}
+			logger.Info("Dropped unmanaged constraint", "database", name, "constraint", constraint)
+			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonSchemaUpdated,
+				"Dropped unmanaged constraint %s from database %s", constraint, name)
+		}
+		for _, index := range plan.unmanagedIndexes {
+			if err := neo4jClient.DropIndex(ctx, name, index); err != nil {
+				return err
+			}
+			logger.Info("Dropped unmanaged index", "database", name, "index", index)
+			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonSchemaUpdated,
+				"Dropped unmanaged index %s from database %s", index, name)
+		}
+		changed = changed || len(unmanaged) > 0
+		unmanaged = nil
+	}
+
+	status := &neo4jv1alpha1.DatabaseSchemaStatus{Drifted: plan.drifted, Unmanaged: unmanaged}
+	previous := database.Status.Schema
+	if previous != nil && !changed && reflect.DeepEqual(previous.Drifted, status.Drifted) && reflect.DeepEqual(previous.Unmanaged, status.Unmanaged) {
+		return nil
+	}
+	if len(plan.drifted) > 0 && (previous == nil || !reflect.DeepEqual(previous.Drifted, plan.drifted)) {
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonSchemaDrift,
+			"Indexes and constraints of database %s differ from spec.schema: %s", name, strings.Join(plan.drifted, ", "))
+	}
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+	return r.updateSchemaStatus(ctx, database, status)
+}
+
+// updateSchemaStatus stores the schema report on the latest version of the
+// database and keeps the in-memory copy in step with it.
+func (r *Neo4jDatabaseReconciler) updateSchemaStatus(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, schema *neo4jv1alpha1.DatabaseSchemaStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jDatabase{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(database), latest); err != nil {
+			return err
+		}
+		latest.Status.Schema = schema
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		database.Status.Schema = schema
+		database.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
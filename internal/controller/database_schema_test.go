@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func TestPlanDatabaseSchema(t *testing.T) {
+	schema := &neo4jv1alpha1.DatabaseSchema{
+		Indexes: []neo4jv1alpha1.SchemaIndex{
+			{Name: "person_name", Labels: []string{"Person"}, Properties: []string{"name"}},
+			{Name: "search", Type: "FULLTEXT", Labels: []string{"Movie", "Book"}, Properties: []string{"title"}},
+			{Name: "since", RelationshipTypes: []string{"KNOWS"}, Properties: []string{"since"}},
+			{Name: "embedding", Type: "VECTOR", Labels: []string{"Chunk"}, Properties: []string{"embedding"},
+				Options: map[string]string{"vector.dimensions": "1536", "vector.similarity_function": "cosine"}},
+		},
+		Constraints: []neo4jv1alpha1.SchemaConstraint{
+			{Name: "person_id", Type: "UNIQUE", Label: "Person", Properties: []string{"id"}},
+			{Name: "person_age", Type: "PROPERTY_TYPE", Label: "Person", Properties: []string{"age"}, PropertyType: "INTEGER"},
+		},
+	}
+	indexes := []neo4j.IndexInfo{
+		{Name: "person_name", Type: "RANGE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}},
+		{Name: "search", Type: "FULLTEXT", EntityType: "NODE", LabelsOrTypes: []string{"Book", "Movie"}, Properties: []string{"title"}},
+		{Name: "embedding", Type: "VECTOR", EntityType: "NODE", LabelsOrTypes: []string{"Chunk"}, Properties: []string{"embedding"},
+			Config: map[string]interface{}{"vector.dimensions": int64(768), "vector.similarity_function": "COSINE"}},
+		{Name: "person_id", Type: "RANGE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"id"}, OwningConstraint: "person_id"},
+		{Name: "legacy", Type: "TEXT", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"bio"}},
+		{Name: "index_343aff4e", Type: "LOOKUP", EntityType: "NODE"},
+	}
+	constraints := []neo4j.ConstraintInfo{
+		{Name: "person_id", Type: "UNIQUENESS", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"id"}},
+		{Name: "old_key", Type: "NODE_KEY", EntityType: "NODE", LabelsOrTypes: []string{"Company"}, Properties: []string{"id"}},
+	}
+
+	plan := planDatabaseSchema(schema, indexes, constraints)
+
+	if assert.Len(t, plan.createIndexes, 1) {
+		created := plan.createIndexes[0]
+		assert.Equal(t, "since", created.Name)
+		assert.Equal(t, "RANGE", created.Type)
+		assert.True(t, created.Relationship)
+		assert.Equal(t, []string{"KNOWS"}, created.LabelsOrTypes)
+	}
+	if assert.Len(t, plan.createConstraints, 1) {
+		assert.Equal(t, "person_age", plan.createConstraints[0].Name)
+	}
+	// The vector index has other dimensions; the fulltext labels only differ in order
+	assert.Equal(t, []string{"embedding"}, plan.drifted)
+	assert.Equal(t, []string{"old_key"}, plan.unmanagedConstraints)
+	assert.Equal(t, []string{"legacy"}, plan.unmanagedIndexes)
+	assert.Equal(t, []string{"legacy", "old_key"}, plan.unmanaged())
+}
+
+func TestPlanDatabaseSchemaDrift(t *testing.T) {
+	schema := &neo4jv1alpha1.DatabaseSchema{
+		Indexes: []neo4jv1alpha1.SchemaIndex{
+			{Name: "person_name", Type: "TEXT", Labels: []string{"Person"}, Properties: []string{"name"}},
+			{Name: "taken", Labels: []string{"Person"}, Properties: []string{"email"}},
+		},
+		Constraints: []neo4jv1alpha1.SchemaConstraint{
+			{Name: "person_age", Type: "PROPERTY_TYPE", Label: "Person", Properties: []string{"age"}, PropertyType: "INTEGER"},
+			{Name: "person_key", Type: "KEY", Label: "Person", Properties: []string{"id", "tenant"}},
+		},
+	}
+	indexes := []neo4j.IndexInfo{
+		{Name: "person_name", Type: "RANGE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}},
+	}
+	constraints := []neo4j.ConstraintInfo{
+		{Name: "taken", Type: "UNIQUENESS", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"email"}},
+		{Name: "person_age", Type: "NODE_PROPERTY_TYPE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"age"}, PropertyType: "integer"},
+		{Name: "person_key", Type: "NODE_KEY", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"tenant", "id"}},
+	}
+
+	plan := planDatabaseSchema(schema, indexes, constraints)
+
+	assert.Empty(t, plan.createIndexes)
+	assert.Empty(t, plan.createConstraints)
+	assert.Equal(t, []string{"person_key", "person_name", "taken"}, plan.drifted)
+	assert.Empty(t, plan.unmanaged())
+}
+
+func TestIndexOptionValue(t *testing.T) {
+	assert.Equal(t, int64(1536), indexOptionValue("1536"))
+	assert.Equal(t, 0.5, indexOptionValue("0.5"))
+	assert.Equal(t, true, indexOptionValue("true"))
+	assert.Equal(t, "cosine", indexOptionValue("cosine"))
+}
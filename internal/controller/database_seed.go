@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+const (
+	// SeedPhaseSeeding is reported while a seeded database is coming online
+	SeedPhaseSeeding = "Seeding"
+	// SeedPhaseCompleted is reported once every allocation of the seeded
+	// database is online
+	SeedPhaseCompleted = "Completed"
+	// SeedPhaseFailed is reported when an allocation of the seeded database
+	// did not come online
+	SeedPhaseFailed = "Failed"
+)
+
+// resolveDatabaseSeedURI returns the URI the database is created from: the
+// URI or Neo4jBackup of spec.seed, or the legacy spec.seedURI. It is empty
+// for databases that are created empty.
+func resolveDatabaseSeedURI(ctx context.Context, c client.Client, database *neo4jv1alpha1.Neo4jDatabase) (string, error) {
+	seed := database.Spec.Seed
+	if seed == nil {
+		return database.Spec.SeedURI, nil
+	}
+	if seed.URI != "" {
+		return seed.URI, nil
+	}
+	if seed.BackupRef == "" {
+		return "", fmt.Errorf("seed must set uri or backupRef")
+	}
+
+	backup := &neo4jv1alpha1.Neo4jBackup{}
+	key := types.NamespacedName{Name: seed.BackupRef, Namespace: database.Namespace}
+	if err := c.Get(ctx, key, backup); err != nil {
+		return "", fmt.Errorf("failed to get backup %s to seed from: %w", seed.BackupRef, err)
+	}
+	return seedURIForBackup(backup)
+}
+
+// seedURIForBackup returns the storage location a Neo4jBackup writes to as a
+// seed URI. PVC storage is only mounted in the backup job, so the servers
+// cannot seed from it.
+func seedURIForBackup(backup *neo4jv1alpha1.Neo4jBackup) (string, error) {
+	if backup.Spec.Storage.Type == "pvc" {
+		return "", fmt.Errorf("backup %s is stored on a PVC, which cannot be used to seed a database; use a backup in s3, gcs or azure storage", backup.Name)
+	}
+	// The location is derived from the spec alone, without the reconciler
+	return (&Neo4jBackupReconciler{}).buildToPath(backup), nil
+}
+
+// seedProgress derives the seeding phase of a database from the state of
+// its allocations as listed by SHOW DATABASES.
+func seedProgress(databases []neo4j.DatabaseInfo, name string) (string, string) {
+	allocations, online := 0, 0
+	for _, db := range databases {
+		if db.Name != name {
+			continue
+		}
+		allocations++
+		switch {
+		case db.Status == "online":
+			online++
+		case db.Status == "quarantined",
+			db.Status == "offline" && db.RequestedStatus == "online":
+			return SeedPhaseFailed, fmt.Sprintf("Database %s is %s after seeding", name, db.Status)
+		}
+	}
+	if allocations > 0 && online == allocations {
+		return SeedPhaseCompleted, fmt.Sprintf("Database %s seeded and online", name)
+	}
+	return SeedPhaseSeeding, fmt.Sprintf("Database %s is being seeded (%d of %d allocations online)", name, online, allocations)
+}
+
+// startSeedStatus records that the database was created from seedURI.
+func (r *Neo4jDatabaseReconciler) startSeedStatus(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, seedURI string) error {
+	now := metav1.Now()
+	return r.updateSeedStatus(ctx, database, &neo4jv1alpha1.DatabaseSeedStatus{
+		URI:       seedURI,
+		Phase:     SeedPhaseSeeding,
+		StartTime: &now,
+		Message:   fmt.Sprintf("Creating database %s from %s", database.Spec.Name, seedURI),
+	})
+}
+
+// trackSeedProgress updates the seed status of a database created from
+// spec.seed until it completes or fails, and reports whether it completed.
+func (r *Neo4jDatabaseReconciler) trackSeedProgress(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase, seedURI string) (bool, error) {
+	status := database.Status.Seed
+	if database.Spec.Seed == nil || status == nil {
+		// Databases that existed before spec.seed was set are not seeded
+		return true, nil
+	}
+	if status.Phase == SeedPhaseCompleted {
+		return true, nil
+	}
+
+	databases, err := neo4jClient.GetDatabases(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check seeding progress: %w", err)
+	}
+	phase, message := seedProgress(databases, database.Spec.Name)
+	if phase == status.Phase && message == status.Message {
+		return false, nil
+	}
+
+	updated := status.DeepCopy()
+	updated.URI = seedURI
+	updated.Phase = phase
+	updated.Message = message
+	switch phase {
+	case SeedPhaseCompleted:
+		now := metav1.Now()
+		updated.CompletionTime = &now
+		log.FromContext(ctx).Info("Database seeding completed", "database", database.Spec.Name, "seedURI", seedURI)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonDataSeeded,
+			"Database %s seeded from %s", database.Spec.Name, seedURI)
+	case SeedPhaseFailed:
+		r.Recorder.Event(database, corev1.EventTypeWarning, EventReasonSeedFailed, message)
+	}
+	if err := r.updateSeedStatus(ctx, database, updated); err != nil {
+		return false, err
+	}
+	return phase == SeedPhaseCompleted, nil
+}
+
+// updateSeedStatus stores the seed status on the latest version of the
+// database and keeps the in-memory copy in step with it.
+func (r *Neo4jDatabaseReconciler) updateSeedStatus(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, seed *neo4jv1alpha1.DatabaseSeedStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jDatabase{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(database), latest); err != nil {
+			return err
+		}
+		latest.Status.Seed = seed
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		database.Status.Seed = seed
+		database.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
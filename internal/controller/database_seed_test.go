@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func seededDatabase(seed *neo4jv1alpha1.DatabaseSeed) *neo4jv1alpha1.Neo4jDatabase {
+	return &neo4jv1alpha1.Neo4jDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "neo4j"},
+		Spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+			ClusterRef: "prod",
+			Name:       "orders",
+			Seed:       seed,
+		},
+	}
+}
+
+func TestResolveDatabaseSeedURI(t *testing.T) {
+	ctx := context.Background()
+	backups := []*neo4jv1alpha1.Neo4jBackup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "neo4j"},
+			Spec: neo4jv1alpha1.Neo4jBackupSpec{
+				Target:  neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "orders"},
+				Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "bucket", Path: "path"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "neo4j"},
+			Spec: neo4jv1alpha1.Neo4jBackupSpec{
+				Target:  neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "orders"},
+				Storage: neo4jv1alpha1.StorageLocation{Type: "pvc"},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(backups[0], backups[1]).Build()
+
+	uri, err := resolveDatabaseSeedURI(ctx, c, seededDatabase(nil))
+	require.NoError(t, err)
+	assert.Empty(t, uri)
+
+	legacy := seededDatabase(nil)
+	legacy.Spec.SeedURI = "gs://bucket/orders.backup"
+	uri, err = resolveDatabaseSeedURI(ctx, c, legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "gs://bucket/orders.backup", uri)
+
+	uri, err = resolveDatabaseSeedURI(ctx, c, seededDatabase(&neo4jv1alpha1.DatabaseSeed{URI: "s3://bucket/orders.backup"}))
+	require.NoError(t, err)
+	assert.Equal(t, "s3://bucket/orders.backup", uri)
+
+	uri, err = resolveDatabaseSeedURI(ctx, c, seededDatabase(&neo4jv1alpha1.DatabaseSeed{BackupRef: "nightly"}))
+	require.NoError(t, err)
+	assert.Equal(t, "s3://bucket/path/", uri)
+
+	_, err = resolveDatabaseSeedURI(ctx, c, seededDatabase(&neo4jv1alpha1.DatabaseSeed{BackupRef: "local"}))
+	assert.ErrorContains(t, err, "stored on a PVC")
+
+	_, err = resolveDatabaseSeedURI(ctx, c, seededDatabase(&neo4jv1alpha1.DatabaseSeed{BackupRef: "missing"}))
+	assert.ErrorContains(t, err, "failed to get backup missing")
+}
+
+func TestSeedProgress(t *testing.T) {
+	allocation := func(status, requested string) neo4j.DatabaseInfo {
+		return neo4j.DatabaseInfo{Name: "orders", Status: status, RequestedStatus: requested}
+	}
+
+	phase, _ := seedProgress(nil, "orders")
+	assert.Equal(t, SeedPhaseSeeding, phase)
+
+	phase, message := seedProgress([]neo4j.DatabaseInfo{
+		allocation("online", "online"),
+		allocation("starting", "online"),
+		{Name: "other", Status: "offline", RequestedStatus: "online"},
+	}, "orders")
+	assert.Equal(t, SeedPhaseSeeding, phase)
+	assert.Equal(t, "Database orders is being seeded (1 of 2 allocations online)", message)
+
+	phase, _ = seedProgress([]neo4j.DatabaseInfo{
+		allocation("online", "online"),
+		allocation("online", "online"),
+	}, "orders")
+	assert.Equal(t, SeedPhaseCompleted, phase)
+
+	phase, message = seedProgress([]neo4j.DatabaseInfo{
+		allocation("online", "online"),
+		allocation("quarantined", "online"),
+	}, "orders")
+	assert.Equal(t, SeedPhaseFailed, phase)
+	assert.Equal(t, "Database orders is quarantined after seeding", message)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// topologyDrifted reports whether the topology requested in Neo4j differs
+// from spec.topology. Databases without a topology keep whatever they have.
+func topologyDrifted(desired *neo4jv1alpha1.DatabaseTopology, primaries, secondaries int32) bool {
+	if desired == nil {
+		return false
+	}
+	return desired.Primaries != primaries || desired.Secondaries != secondaries
+}
+
+// reconcileDatabaseTopology alters the topology of an existing database when
+// spec.topology no longer matches the one requested in Neo4j, for example
+// after the spec was edited or the cluster was scaled to make room for it.
+func (r *Neo4jDatabaseReconciler) reconcileDatabaseTopology(ctx context.Context, neo4jClient *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+	desired := database.Spec.Topology
+	if desired == nil || isCompositeDatabase(database) {
+		return nil
+	}
+
+	primaries, secondaries, err := neo4jClient.GetDatabaseTopology(ctx, database.Spec.Name)
+	if err != nil {
+		return err
+	}
+	if !topologyDrifted(desired, primaries, secondaries) {
+		return nil
+	}
+
+	if err := neo4jClient.AlterDatabaseTopology(ctx, database.Spec.Name, desired.Primaries, desired.Secondaries); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Altered database topology", "database", database.Spec.Name,
+		"primaries", desired.Primaries, "secondaries", desired.Secondaries)
+	r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonTopologyUpdated,
+		"Altered topology of database %s from %d primaries and %d secondaries to %d primaries and %d secondaries",
+		database.Spec.Name, primaries, secondaries, desired.Primaries, desired.Secondaries)
+	return nil
+}
+
+// databasesForCluster maps a cluster to the databases that reference it, so
+// that they are revalidated and their topology reapplied when it changes.
+func (r *Neo4jDatabaseReconciler) databasesForCluster(ctx context.Context, obj client.Object) []reconcile.Request {
+	databases := &neo4jv1alpha1.Neo4jDatabaseList{}
+	if err := r.List(ctx, databases, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list databases for cluster", "cluster", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, database := range databases.Items {
+		if database.Spec.ClusterRef != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&database)})
+	}
+	return requests
+}
+
+// clusterTopologyChanged filters cluster updates down to the ones that can
+// change where databases fit: a new server count or a cluster becoming ready.
+func clusterTopologyChanged(e event.UpdateEvent) bool {
+	oldCluster, ok := e.ObjectOld.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return false
+	}
+	newCluster, ok := e.ObjectNew.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return false
+	}
+	return oldCluster.Spec.Topology.Servers != newCluster.Spec.Topology.Servers ||
+		oldCluster.Spec.Topology.ServerModeConstraint != newCluster.Spec.Topology.ServerModeConstraint ||
+		oldCluster.Status.Phase != newCluster.Status.Phase
+}
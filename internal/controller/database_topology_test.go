@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestTopologyDrifted(t *testing.T) {
+	desired := &neo4jv1alpha1.DatabaseTopology{Primaries: 3, Secondaries: 1}
+
+	assert.False(t, topologyDrifted(nil, 1, 0))
+	assert.False(t, topologyDrifted(desired, 3, 1))
+	assert.True(t, topologyDrifted(desired, 1, 1))
+	assert.True(t, topologyDrifted(desired, 3, 0))
+}
+
+func TestDatabasesForCluster(t *testing.T) {
+	database := func(name, namespace, clusterRef string) *neo4jv1alpha1.Neo4jDatabase {
+		return &neo4jv1alpha1.Neo4jDatabase{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       neo4jv1alpha1.Neo4jDatabaseSpec{ClusterRef: clusterRef, Name: name},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		database("orders", "default", "prod"),
+		database("users", "default", "prod"),
+		database("scratch", "default", "dev"),
+		database("orders", "other", "prod"),
+	).Build()
+	r := &Neo4jDatabaseReconciler{Client: c}
+
+	requests := r.databasesForCluster(context.Background(), minimalCluster("prod", "default"))
+	var names []string
+	for _, request := range requests {
+		assert.Equal(t, "default", request.Namespace)
+		names = append(names, request.Name)
+	}
+	assert.ElementsMatch(t, []string{"orders", "users"}, names)
+}
+
+func TestClusterTopologyChanged(t *testing.T) {
+	old := minimalCluster("prod", "default")
+	old.Status.Phase = "Ready"
+
+	scaled := old.DeepCopy()
+	scaled.Spec.Topology.Servers = 4
+	assert.True(t, clusterTopologyChanged(event.UpdateEvent{ObjectOld: old, ObjectNew: scaled}))
+
+	recovered := old.DeepCopy()
+	recovered.Status.Phase = "Failed"
+	assert.True(t, clusterTopologyChanged(event.UpdateEvent{ObjectOld: recovered, ObjectNew: old}))
+
+	relabelled := old.DeepCopy()
+	relabelled.Labels = map[string]string{"team": "data"}
+	assert.False(t, clusterTopologyChanged(event.UpdateEvent{ObjectOld: old, ObjectNew: relabelled}))
+}
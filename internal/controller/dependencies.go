@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// dependencyKinds returns an empty object of each kind spec.dependsOn may
+// name
+var dependencyKinds = map[string]func() client.Object{
+	"Neo4jEnterpriseCluster":    func() client.Object { return &neo4jv1alpha1.Neo4jEnterpriseCluster{} },
+	"Neo4jEnterpriseStandalone": func() client.Object { return &neo4jv1alpha1.Neo4jEnterpriseStandalone{} },
+	"Neo4jDatabase":             func() client.Object { return &neo4jv1alpha1.Neo4jDatabase{} },
+	"Neo4jShardedDatabase":      func() client.Object { return &neo4jv1alpha1.Neo4jShardedDatabase{} },
+	"Neo4jPlugin":               func() client.Object { return &neo4jv1alpha1.Neo4jPlugin{} },
+	"Neo4jUserSync":             func() client.Object { return &neo4jv1alpha1.Neo4jUserSync{} },
+	"Neo4jBackup":               func() client.Object { return &neo4jv1alpha1.Neo4jBackup{} },
+	"Neo4jRestore":              func() client.Object { return &neo4jv1alpha1.Neo4jRestore{} },
+	"Neo4jMigration":            func() client.Object { return &neo4jv1alpha1.Neo4jMigration{} },
+}
+
+// statusConditions returns the status conditions of a resource of one of the
+// dependency kinds, nil for any other resource
+func statusConditions(obj client.Object) *[]metav1.Condition {
+	switch o := obj.(type) {
+	case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jDatabase:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jShardedDatabase:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jPlugin:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jUserSync:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jBackup:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jRestore:
+		return &o.Status.Conditions
+	case *neo4jv1alpha1.Neo4jMigration:
+		return &o.Status.Conditions
+	}
+	return nil
+}
+
+// resourceReady reports whether the Ready condition of a resource is True
+func resourceReady(obj client.Object) bool {
+	conditions := statusConditions(obj)
+	if conditions == nil {
+		return false
+	}
+	ready := findCondition(*conditions, ConditionTypeReady)
+	return ready != nil && ready.Status == metav1.ConditionTrue
+}
+
+// waitingDependency returns why the reconcile of obj waits for the resources
+// of its spec.dependsOn, or "" when all of them report Ready. Dependencies
+// are looked up in the namespace of obj.
+func waitingDependency(ctx context.Context, c client.Client, obj client.Object, deps []neo4jv1alpha1.ResourceDependency) (string, error) {
+	for _, dep := range deps {
+		newObject, ok := dependencyKinds[dep.Kind]
+		if !ok {
+			return fmt.Sprintf("Dependency %s %s is of an unsupported kind", dep.Kind, dep.Name), nil
+		}
+		dependency := newObject()
+		if reflect.TypeOf(dependency) == reflect.TypeOf(obj) && dep.Name == obj.GetName() {
+			return fmt.Sprintf("%s %s depends on itself", dep.Kind, dep.Name), nil
+		}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: dep.Name}, dependency); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Sprintf("Waiting for %s %s, which does not exist", dep.Kind, dep.Name), nil
+			}
+			return "", fmt.Errorf("failed to get dependency %s %s: %w", dep.Kind, dep.Name, err)
+		}
+		if !resourceReady(dependency) {
+			return fmt.Sprintf("Waiting for %s %s to be Ready", dep.Kind, dep.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// setDependenciesCondition records on obj why its reconcile waits, or with
+// an empty waiting that its dependencies are Ready. Only the condition is
+// changed, so the phase and Ready condition stay with the status update of
+// the controller.
+func setDependenciesCondition(ctx context.Context, c client.Client, obj client.Object, waiting string) {
+	status, reason, message := metav1.ConditionTrue, ConditionReasonDependenciesReady, "All dependencies are Ready"
+	if waiting != "" {
+		status, reason, message = metav1.ConditionFalse, ConditionReasonWaitingForDependencies, waiting
+	}
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+		conditions := statusConditions(latest)
+		if conditions == nil {
+			return fmt.Errorf("%T has no status conditions", obj)
+		}
+		existing := findCondition(*conditions, ConditionTypeDependenciesReady)
+		if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		SetNamedCondition(conditions, ConditionTypeDependenciesReady, latest.GetGeneration(), status, reason, message)
+		if err := c.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		// Keep later writes of this reconcile from conflicting
+		obj.SetResourceVersion(latest.GetResourceVersion())
+		*statusConditions(obj) = *conditions
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update DependenciesReady condition")
+	}
+}
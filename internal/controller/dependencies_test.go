@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestWaitingDependency(t *testing.T) {
+	ctx := context.Background()
+	readyPlugin := &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "apoc", Namespace: "default"},
+		Status: neo4jv1alpha1.Neo4jPluginStatus{Conditions: []metav1.Condition{
+			{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ConditionReasonReady},
+		}},
+	}
+	pendingDatabase := &neo4jv1alpha1.Neo4jDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"},
+		Status: neo4jv1alpha1.Neo4jDatabaseStatus{Conditions: []metav1.Condition{
+			{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ConditionReasonPending},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(readyPlugin, pendingDatabase).Build()
+	userSync := &neo4jv1alpha1.Neo4jUserSync{ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: "default"}}
+
+	tests := []struct {
+		name    string
+		deps    []neo4jv1alpha1.ResourceDependency
+		waiting string
+	}{
+		{name: "no dependencies"},
+		{name: "ready", deps: []neo4jv1alpha1.ResourceDependency{{Kind: "Neo4jPlugin", Name: "apoc"}}},
+		{
+			name:    "not ready",
+			deps:    []neo4jv1alpha1.ResourceDependency{{Kind: "Neo4jPlugin", Name: "apoc"}, {Kind: "Neo4jDatabase", Name: "orders"}},
+			waiting: "Waiting for Neo4jDatabase orders to be Ready",
+		},
+		{
+			name:    "missing",
+			deps:    []neo4jv1alpha1.ResourceDependency{{Kind: "Neo4jBackup", Name: "nightly"}},
+			waiting: "Waiting for Neo4jBackup nightly, which does not exist",
+		},
+		{
+			name:    "itself",
+			deps:    []neo4jv1alpha1.ResourceDependency{{Kind: "Neo4jUserSync", Name: "users"}},
+			waiting: "Neo4jUserSync users depends on itself",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waiting, err := waitingDependency(ctx, c, userSync, tt.deps)
+			require.NoError(t, err)
+			assert.Equal(t, tt.waiting, waiting)
+		})
+	}
+}
+
+func TestSetDependenciesCondition(t *testing.T) {
+	ctx := context.Background()
+	backup := &neo4jv1alpha1.Neo4jBackup{ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(backup).WithStatusSubresource(backup).Build()
+
+	setDependenciesCondition(ctx, c, backup, "Target cluster is not ready")
+	latest := &neo4jv1alpha1.Neo4jBackup{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(backup), latest))
+	condition := findCondition(latest.Status.Conditions, ConditionTypeDependenciesReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ConditionReasonWaitingForDependencies, condition.Reason)
+	assert.Equal(t, latest.ResourceVersion, backup.ResourceVersion)
+
+	setDependenciesCondition(ctx, c, backup, "")
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(backup), latest))
+	condition = findCondition(latest.Status.Conditions, ConditionTypeDependenciesReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ConditionReasonDependenciesReady, condition.Reason)
+}
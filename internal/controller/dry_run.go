@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// AdmissionError reports a generated object that the API server rejected in
+// a server-side dry run, by an admission webhook, an admission policy or
+// its own validation
+type AdmissionError struct {
+	Kind string
+	Name string
+	Err  error
+}
+
+func (e *AdmissionError) Error() string {
+	return fmt.Sprintf("%s %s rejected by admission: %v", e.Kind, e.Name, e.Err)
+}
+
+func (e *AdmissionError) Unwrap() error {
+	return e.Err
+}
+
+// dryRunKind returns the kind of the objects that are dry-run before they
+// are applied, or an empty string for objects that are applied directly
+func dryRunKind(obj client.Object) string {
+	switch obj.(type) {
+	case *appsv1.StatefulSet:
+		return "StatefulSet"
+	case *corev1.Service:
+		return "Service"
+	}
+	return ""
+}
+
+// isAdmissionRejection reports whether a dry-run error means the object
+// would not be admitted, as opposed to a transient failure that the real
+// update reports on its own
+func isAdmissionRejection(err error) bool {
+	return errors.IsForbidden(err) || errors.IsInvalid(err) ||
+		(errors.IsBadRequest(err) && strings.Contains(err.Error(), "denied the request"))
+}
+
+// dryRunResource applies a StatefulSet or Service with a server-side dry run,
+// the same way createOrUpdateResource will, and returns an AdmissionError if
+// it is rejected. Webhooks with side effects refuse dry runs, in which case
+// the check is skipped.
+func (r *Neo4jEnterpriseClusterReconciler) dryRunResource(ctx context.Context, obj client.Object, owner client.Object) error {
+	kind := dryRunKind(obj)
+	if kind == "" {
+		return nil
+	}
+
+	candidate, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil
+	}
+	err := r.createOrUpdateResourceInternal(ctx, client.NewDryRunClient(r.Client), candidate, owner)
+	switch {
+	case err == nil:
+		return nil
+	case strings.Contains(err.Error(), "does not support dry run"):
+		log.FromContext(ctx).V(1).Info("Skipping dry run of resource, an admission webhook does not support it",
+			"kind", kind, "name", obj.GetName())
+		return nil
+	case !isAdmissionRejection(err):
+		return nil
+	}
+
+	admissionErr := &AdmissionError{Kind: kind, Name: obj.GetName(), Err: err}
+	if cluster, ok := owner.(*neo4jv1alpha1.Neo4jEnterpriseCluster); ok {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonAdmissionDenied, admissionErr.Error())
+		r.setResourcesAdmittedCondition(ctx, cluster, metav1.ConditionFalse, ConditionReasonAdmissionDenied, admissionErr.Error())
+	}
+	return admissionErr
+}
+
+// setResourcesAdmittedCondition records the outcome of the dry runs of a
+// reconcile on the cluster. Only the condition is changed, so the phase and
+// Ready condition stay with updateClusterStatus.
+func (r *Neo4jEnterpriseClusterReconciler) setResourcesAdmittedCondition(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status metav1.ConditionStatus, reason, message string) {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		existing := findCondition(latest.Status.Conditions, ConditionTypeResourcesAdmitted)
+		if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		SetNamedCondition(&latest.Status.Conditions, ConditionTypeResourcesAdmitted, latest.Generation, status, reason, message)
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		// Keep later status writes of this reconcile from conflicting
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update ResourcesAdmitted condition")
+	}
+}
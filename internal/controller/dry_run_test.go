@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestIsAdmissionRejection(t *testing.T) {
+	resource := schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+
+	assert.True(t, isAdmissionRejection(errors.NewForbidden(resource, "prod-server", stderrors.New("policy"))))
+	assert.True(t, isAdmissionRejection(errors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "StatefulSet"}, "prod-server", nil)))
+	assert.True(t, isAdmissionRejection(errors.NewBadRequest(`admission webhook "validation.gatekeeper.sh" denied the request: privileged`)))
+	assert.False(t, isAdmissionRejection(errors.NewBadRequest("malformed request")))
+	assert.False(t, isAdmissionRejection(errors.NewConflict(resource, "prod-server", stderrors.New("stale"))))
+	assert.False(t, isAdmissionRejection(stderrors.New("connection refused")))
+}
+
+func TestDryRunResource(t *testing.T) {
+	statefulSet := func() *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "prod-server", Namespace: "default"}}
+	}
+
+	tests := []struct {
+		name      string
+		obj       client.Object
+		dryRunErr error
+		denied    bool
+	}{
+		{name: "admitted", obj: statefulSet()},
+		{
+			name:      "denied by webhook",
+			obj:       statefulSet(),
+			dryRunErr: errors.NewBadRequest(`admission webhook "validation.gatekeeper.sh" denied the request: privileged containers are not allowed`),
+			denied:    true,
+		},
+		{
+			name: "denied by policy",
+			obj:  &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "prod-client", Namespace: "default"}},
+			dryRunErr: errors.NewForbidden(schema.GroupResource{Resource: "services"}, "prod-client",
+				stderrors.New("LoadBalancer services are not allowed")),
+			denied: true,
+		},
+		{
+			name:      "webhook without dry run support",
+			obj:       statefulSet(),
+			dryRunErr: errors.NewBadRequest(`admission webhook "audit.example.com" does not support dry run`),
+		},
+		{
+			name:      "transient failure",
+			obj:       statefulSet(),
+			dryRunErr: errors.NewServiceUnavailable("etcd unavailable"),
+		},
+		{
+			name:      "not dry-run",
+			obj:       &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "prod-config", Namespace: "default"}},
+			dryRunErr: errors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "prod-config", stderrors.New("denied")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := minimalCluster("prod", "default")
+			dryRuns := 0
+			c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+				WithObjects(cluster).
+				WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						createOptions := &client.CreateOptions{}
+						createOptions.ApplyOptions(opts)
+						if len(createOptions.DryRun) > 0 {
+							dryRuns++
+							if tt.dryRunErr != nil {
+								return tt.dryRunErr
+							}
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: recorder}
+
+			err := r.dryRunResource(context.Background(), tt.obj, cluster)
+
+			latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cluster), latest))
+			condition := findCondition(latest.Status.Conditions, ConditionTypeResourcesAdmitted)
+			if !tt.denied {
+				assert.NoError(t, err)
+				assert.Nil(t, condition)
+				return
+			}
+
+			var admissionErr *AdmissionError
+			require.True(t, stderrors.As(err, &admissionErr), "expected an AdmissionError, got %v", err)
+			assert.Equal(t, tt.obj.GetName(), admissionErr.Name)
+			assert.Equal(t, 1, dryRuns)
+			require.NotNil(t, condition)
+			assert.Equal(t, metav1.ConditionFalse, condition.Status)
+			assert.Equal(t, ConditionReasonAdmissionDenied, condition.Reason)
+			assert.Contains(t, condition.Message, tt.dryRunErr.Error())
+			assert.Contains(t, <-recorder.Events, EventReasonAdmissionDenied)
+			assert.Equal(t, latest.ResourceVersion, cluster.ResourceVersion)
+		})
+	}
+}
+
+func TestDryRunDoesNotPersist(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "prod-server", Namespace: "default"}}
+	require.NoError(t, r.dryRunResource(context.Background(), statefulSet, cluster))
+
+	err := c.Get(context.Background(), client.ObjectKeyFromObject(statefulSet), &appsv1.StatefulSet{})
+	assert.True(t, errors.IsNotFound(err))
+	assert.Empty(t, statefulSet.OwnerReferences, "the dry run must not modify the desired object")
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// dynamicSettings are the neo4j.conf settings Neo4j marks as dynamic, which
+// dbms.setConfigValue changes on a running server. A change to any other
+// setting needs a restart.
+// See: https://neo4j.com/docs/operations-manual/current/configuration/dynamic-settings/
+var dynamicSettings = map[string]bool{
+	"db.checkpoint.iops.limit":                           true,
+	"db.lock.acquisition.timeout":                        true,
+	"db.logs.query.early_raw_logging_enabled":            true,
+	"db.logs.query.enabled":                              true,
+	"db.logs.query.max_parameter_length":                 true,
+	"db.logs.query.obfuscate_literals":                   true,
+	"db.logs.query.parameter_logging_enabled":            true,
+	"db.logs.query.plan_description_enabled":             true,
+	"db.logs.query.threshold":                            true,
+	"db.logs.query.transaction.enabled":                  true,
+	"db.logs.query.transaction.threshold":                true,
+	"db.memory.transaction.max":                          true,
+	"db.memory.transaction.total.max":                    true,
+	"db.track_query_cpu_time":                            true,
+	"db.transaction.concurrent.maximum":                  true,
+	"db.transaction.sampling.percentage":                 true,
+	"db.transaction.timeout":                             true,
+	"db.transaction.tracing.level":                       true,
+	"dbms.cypher.render_plan_description":                true,
+	"dbms.memory.transaction.total.max":                  true,
+	"dbms.routing.client_side.enforce_for_domains":       true,
+	"dbms.routing.reads_on_writers_enabled":              true,
+	"server.memory.pagecache.flush.buffer.enabled":       true,
+	"server.memory.pagecache.flush.buffer.size_in_pages": true,
+}
+
+// serverConfigClient changes the settings of one running Neo4j server
+type serverConfigClient interface {
+	SetConfiguration(ctx context.Context, key, value string) error
+	Close() error
+}
+
+// dynamicConfigChanges returns the neo4j.conf settings that differ between
+// two ConfigMaps, with an empty value for a removed setting, and whether all
+// of them can be applied without a restart. Changes to the scripts always
+// need one.
+func (cm *ConfigMapManager) dynamicConfigChanges(oldConfigMap, newConfigMap *corev1.ConfigMap) (map[string]string, bool) {
+	for _, key := range []string{"startup.sh", "health.sh"} {
+		oldValue, oldExists := oldConfigMap.Data[key]
+		newValue, newExists := newConfigMap.Data[key]
+		if oldExists != newExists || cm.normalizeConfigContent(key, oldValue) != cm.normalizeConfigContent(key, newValue) {
+			return nil, false
+		}
+	}
+
+	oldProps := cm.parseNeo4jProperties(cm.normalizeNeo4jConf(oldConfigMap.Data["neo4j.conf"]))
+	newProps := cm.parseNeo4jProperties(cm.normalizeNeo4jConf(newConfigMap.Data["neo4j.conf"]))
+	settings := map[string]string{}
+	for key, value := range newProps {
+		if oldValue, exists := oldProps[key]; !exists || oldValue != value {
+			settings[key] = value
+		}
+	}
+	for key := range oldProps {
+		if _, exists := newProps[key]; !exists {
+			// An empty value resets the setting to its default
+			settings[key] = ""
+		}
+	}
+	for key := range settings {
+		if !dynamicSettings[key] {
+			return nil, false
+		}
+	}
+	return settings, true
+}
+
+// applyDynamicConfig sets the settings on every running server of the
+// cluster. dbms.setConfigValue only changes the server it runs on, and the
+// servers keep the values until they restart with the updated neo4j.conf.
+func (cm *ConfigMapManager) applyDynamicConfig(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, settings map[string]string) error {
+	if len(settings) == 0 {
+		return nil
+	}
+	pods := &corev1.PodList{}
+	if err := cm.List(ctx, pods, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name}, client.HasLabels{"neo4j.com/server-name"}); err != nil {
+		return fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			// The server reads the updated neo4j.conf when it starts
+			continue
+		}
+		if err := cm.applyServerConfig(ctx, cluster, pod, keys, settings); err != nil {
+			return fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+	}
+	log.FromContext(ctx).Info("Applied dynamic configuration to the running servers",
+		"cluster", cluster.Name, "settings", keys)
+	return nil
+}
+
+func (cm *ConfigMapManager) applyServerConfig(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod, keys []string, settings map[string]string) error {
+	newClient := cm.newServerConfigClient
+	if newClient == nil {
+		newClient = cm.connectToServer
+	}
+	neo4jClient, err := newClient(ctx, cluster, pod)
+	if err != nil {
+		return fmt.Errorf("failed to create Neo4j client: %w", err)
+	}
+	defer neo4jClient.Close()
+
+	for _, key := range keys {
+		if err := neo4jClient.SetConfiguration(ctx, key, settings[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectToServer connects to the Neo4j server running in pod
+func (cm *ConfigMapManager) connectToServer(_ context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (serverConfigClient, error) {
+	podURL := fmt.Sprintf("bolt://%s.%s-headless.%s.svc.cluster.local:7687", pod.Name, cluster.Name, cluster.Namespace)
+	return neo4jclient.NewClientForPod(cluster, cm.Client, getClusterConnectionSecretName(cluster), podURL)
+}
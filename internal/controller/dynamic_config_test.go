@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+type fakeServerConfigClient struct {
+	pod      string
+	settings map[string]map[string]string
+	err      error
+}
+
+func (f *fakeServerConfigClient) SetConfiguration(_ context.Context, key, value string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.settings[f.pod] == nil {
+		f.settings[f.pod] = map[string]string{}
+	}
+	f.settings[f.pod][key] = value
+	return nil
+}
+
+func (f *fakeServerConfigClient) Close() error { return nil }
+
+func TestDynamicConfigChanges(t *testing.T) {
+	cm := NewConfigMapManager(nil)
+	base := map[string]string{
+		"neo4j.conf": "db.transaction.timeout=60s\ndb.logs.query.enabled=INFO\nserver.bolt.enabled=true\n",
+		"startup.sh": "#!/bin/bash\n",
+		"health.sh":  "#!/bin/bash\n",
+	}
+	with := func(key, value string) *corev1.ConfigMap {
+		data := map[string]string{}
+		for k, v := range base {
+			data[k] = v
+		}
+		data[key] = value
+		return &corev1.ConfigMap{Data: data}
+	}
+
+	tests := []struct {
+		name     string
+		updated  *corev1.ConfigMap
+		settings map[string]string
+		dynamic  bool
+	}{
+		{
+			name:     "changed dynamic setting",
+			updated:  with("neo4j.conf", "db.transaction.timeout=120s\ndb.logs.query.enabled=INFO\nserver.bolt.enabled=true\n"),
+			settings: map[string]string{"db.transaction.timeout": "120s"},
+			dynamic:  true,
+		},
+		{
+			name:     "removed dynamic setting resets it",
+			updated:  with("neo4j.conf", "db.transaction.timeout=60s\nserver.bolt.enabled=true\n"),
+			settings: map[string]string{"db.logs.query.enabled": ""},
+			dynamic:  true,
+		},
+		{
+			name:    "static setting",
+			updated: with("neo4j.conf", "db.transaction.timeout=120s\ndb.logs.query.enabled=INFO\nserver.bolt.enabled=false\n"),
+		},
+		{
+			name:    "startup script",
+			updated: with("startup.sh", "#!/bin/bash\necho changed\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, dynamic := cm.dynamicConfigChanges(&corev1.ConfigMap{Data: base}, tt.updated)
+			assert.Equal(t, tt.dynamic, dynamic)
+			if tt.dynamic {
+				assert.Equal(t, tt.settings, settings)
+			}
+		})
+	}
+}
+
+func dynamicConfigTestSetup(t *testing.T, config map[string]string) (client.Client, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Config = config
+	existing := resources.BuildConfigMapForEnterprise(cluster)
+	running := restartTestPod("prod-server-0", "rev")
+	pending := restartTestPod("prod-server-1", "rev")
+	pending.Status.Phase = corev1.PodPending
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, serverSTS("prod", "default"), existing, running, pending).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	return c, cluster
+}
+
+func TestReconcileConfigMapAppliesDynamicSettings(t *testing.T) {
+	c, cluster := dynamicConfigTestSetup(t, map[string]string{"db.transaction.timeout": "60s"})
+	applied := map[string]map[string]string{}
+	cm := NewConfigMapManager(c)
+	cm.newServerConfigClient = func(_ context.Context, _ *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (serverConfigClient, error) {
+		return &fakeServerConfigClient{pod: pod.Name, settings: applied}, nil
+	}
+	ctx := context.Background()
+
+	cluster.Spec.Config["db.transaction.timeout"] = "120s"
+	require.NoError(t, cm.ReconcileConfigMap(ctx, cluster))
+
+	// The running server is configured live, the pending one reads the file
+	assert.Equal(t, map[string]map[string]string{
+		"prod-server-0": {"db.transaction.timeout": "120s"},
+	}, applied)
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-config", Namespace: "default"}, configMap))
+	assert.Contains(t, configMap.Data["neo4j.conf"], "db.transaction.timeout=120s")
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.NotContains(t, sts.Spec.Template.Annotations, "neo4j.neo4j.com/config-hash", "no restart")
+	assert.Nil(t, cluster.Status.RollingRestart)
+}
+
+func TestReconcileConfigMapRestartsForStaticSettings(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		client error
+	}{
+		{name: "static setting", key: "dbms.security.auth_minimum_password_length"},
+		{name: "dynamic setting that fails to apply", key: "db.transaction.timeout", client: errors.New("connection refused")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, cluster := dynamicConfigTestSetup(t, map[string]string{tt.key: "60"})
+			applied := map[string]map[string]string{}
+			cm := NewConfigMapManager(c)
+			cm.newServerConfigClient = func(_ context.Context, _ *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (serverConfigClient, error) {
+				return &fakeServerConfigClient{pod: pod.Name, settings: applied, err: tt.client}, nil
+			}
+
+			cluster.Spec.Config[tt.key] = "120"
+			require.NoError(t, cm.ReconcileConfigMap(context.Background(), cluster))
+
+			assert.Empty(t, applied)
+			sts := &appsv1.StatefulSet{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+			assert.NotEmpty(t, sts.Spec.Template.Annotations["neo4j.neo4j.com/config-hash"])
+			require.NotNil(t, cluster.Status.RollingRestart)
+		})
+	}
+}
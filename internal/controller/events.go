@@ -6,7 +6,11 @@ const (
 	EventReasonClusterFormationFailed  = "ClusterFormationFailed"
 	EventReasonClusterReady            = "ClusterReady"
 	EventReasonTopologyWarning         = "TopologyWarning"
+	EventReasonTopologyDeprecated      = "TopologyDeprecated"
+	EventReasonClusterClassApplied     = "ClusterClassApplied"
+	EventReasonClusterClassFailed      = "ClusterClassFailed"
 	EventReasonValidationFailed        = "ValidationFailed"
+	EventReasonLicenseExceeded         = "LicenseExceeded"
 	EventReasonTopologyPlacementFailed = "TopologyPlacementFailed"
 	EventReasonTopologyPlacementCalc   = "TopologyPlacementCalculated"
 	EventReasonPropertyShardingFailed  = "PropertyShardingValidationFailed"
@@ -14,15 +18,80 @@ const (
 	EventReasonRouteAPINotFound        = "RouteAPINotFound"
 	EventReasonMCPApocMissing          = "MCPApocMissing"
 	EventReasonReconcileFailed         = "ReconcileFailed"
+	EventReasonSlowReconcile           = "SlowReconcile"
+	EventReasonAdmissionDenied         = "AdmissionDenied"
+	EventReasonServersDrained          = "ServersDrained"
+	EventReasonServersRemoved          = "ServersRemoved"
+	EventReasonScaleDownBlocked        = "ScaleDownBlocked"
+	EventReasonMaintenanceStarted      = "MaintenanceStarted"
+	EventReasonMaintenanceEnded        = "MaintenanceEnded"
+	EventReasonPhaseChanged            = "PhaseChanged"
 )
 
 // Rolling upgrade events
 const (
-	EventReasonUpgradeStarted    = "UpgradeStarted"
-	EventReasonUpgradeCompleted  = "UpgradeCompleted"
-	EventReasonUpgradePaused     = "UpgradePaused"
-	EventReasonUpgradeFailed     = "UpgradeFailed"
-	EventReasonUpgradeRolledBack = "UpgradeRolledBack"
+	EventReasonUpgradeStarted     = "UpgradeStarted"
+	EventReasonUpgradeCompleted   = "UpgradeCompleted"
+	EventReasonUpgradePaused      = "UpgradePaused"
+	EventReasonUpgradeFailed      = "UpgradeFailed"
+	EventReasonUpgradeRolledBack  = "UpgradeRolledBack"
+	EventReasonUpgradeBlocked     = "UpgradeBlocked"
+	EventReasonUpgradeProgressing = "UpgradeProgressing"
+	EventReasonCanaryStarted      = "CanaryStarted"
+	EventReasonCanaryPassed       = "CanaryPassed"
+	EventReasonCanaryFailed       = "CanaryFailed"
+)
+
+// Rolling restart events
+const (
+	EventReasonRollingRestartStarted   = "RollingRestartStarted"
+	EventReasonServerRestarted         = "ServerRestarted"
+	EventReasonLeadershipTransferred   = "LeadershipTransferred"
+	EventReasonRollingRestartCompleted = "RollingRestartCompleted"
+)
+
+// Vertical scaling events
+const (
+	EventReasonResizeStarted        = "ResizeStarted"
+	EventReasonServerResized        = "ServerResized"
+	EventReasonResizeInfeasible     = "ResizeInfeasible"
+	EventReasonInPlaceResizeSkipped = "InPlaceResizeSkipped"
+	EventReasonResizeCompleted      = "ResizeCompleted"
+)
+
+// Cluster mTLS events
+const (
+	EventReasonServerCertificatesPending = "ServerCertificatesPending"
+	EventReasonServerCertificatesRenewed = "ServerCertificatesRenewed"
+)
+
+// Admin credential events
+const (
+	EventReasonAdminCredentialsPending     = "AdminCredentialsPending"
+	EventReasonAdminPasswordRotated        = "AdminPasswordRotated"
+	EventReasonAdminPasswordRotationFailed = "AdminPasswordRotationFailed"
+)
+
+// Operator user events
+const (
+	EventReasonOperatorUserReady  = "OperatorUserReady"
+	EventReasonOperatorUserFailed = "OperatorUserFailed"
+)
+
+// Certificate expiry and reload events
+const (
+	EventReasonCertificateExpiring     = "CertificateExpiring"
+	EventReasonCertificatesReloaded    = "CertificatesReloaded"
+	EventReasonCertificateReloadFailed = "CertificateReloadFailed"
+)
+
+// Hibernation events
+const (
+	EventReasonHibernationStarted = "HibernationStarted"
+	EventReasonCheckpointFailed   = "CheckpointFailed"
+	EventReasonClusterHibernated  = "ClusterHibernated"
+	EventReasonResumeStarted      = "ResumeStarted"
+	EventReasonClusterResumed     = "ClusterResumed"
 )
 
 // Backup and restore events
@@ -31,6 +100,9 @@ const (
 	EventReasonBackupStarted        = "BackupStarted"
 	EventReasonBackupCompleted      = "BackupCompleted"
 	EventReasonBackupFailed         = "BackupFailed"
+	EventReasonBenchmarkStarted     = "BenchmarkStarted"
+	EventReasonBenchmarkCompleted   = "BenchmarkCompleted"
+	EventReasonBenchmarkFailed      = "BenchmarkFailed"
 	EventReasonRestoreStarted       = "RestoreStarted"
 	EventReasonRestoreCompleted     = "RestoreCompleted"
 	EventReasonRestoreFailed        = "RestoreFailed"
@@ -48,14 +120,35 @@ const (
 	EventReasonDataImported        = "DataImported"
 	EventReasonDataImportFailed    = "DataImportFailed"
 	EventReasonDataSeeded          = "DataSeeded"
+	EventReasonDatabaseSeeding     = "DatabaseSeeding"
+	EventReasonSeedFailed          = "SeedFailed"
 	EventReasonValidationWarning   = "ValidationWarning"
 	EventReasonConnectionFailed    = "ConnectionFailed"
+	EventReasonAliasCreated        = "AliasCreated"
+	EventReasonAliasUpdated        = "AliasUpdated"
+	EventReasonAliasDropped        = "AliasDropped"
+	EventReasonAliasFailed         = "AliasFailed"
+	EventReasonTopologyUpdated     = "DatabaseTopologyUpdated"
+	EventReasonTopologyFailed      = "DatabaseTopologyFailed"
+	EventReasonDatabaseStarted     = "DatabaseStarted"
+	EventReasonDatabaseStopped     = "DatabaseStopped"
+	EventReasonDatabaseOffline     = "DatabaseOffline"
+	EventReasonStateChangeFailed   = "DatabaseStateChangeFailed"
+	EventReasonDatabaseRetained    = "DatabaseRetained"
+	EventReasonDropPending         = "DropPending"
+	EventReasonFinalDumpStarted    = "FinalDumpStarted"
+	EventReasonFinalDumpCompleted  = "FinalDumpCompleted"
+	EventReasonFinalDumpFailed     = "FinalDumpFailed"
+	EventReasonSchemaUpdated       = "SchemaUpdated"
+	EventReasonSchemaDrift         = "SchemaDrift"
+	EventReasonSchemaFailed        = "SchemaFailed"
 )
 
 // Plugin events
 const (
 	EventReasonPluginInstalled     = "PluginInstalled"
 	EventReasonPluginInstallFailed = "PluginInstallFailed"
+	EventReasonPluginIncompatible  = "PluginIncompatible"
 	EventReasonPluginEnabled       = "PluginEnabled"
 	EventReasonPluginDisabled      = "PluginDisabled"
 )
@@ -74,9 +167,52 @@ const (
 	EventReasonAuraFleetRegistered        = "AuraFleetManagementRegistered"
 )
 
+// Standalone schedule events
+const (
+	EventReasonScheduledStop  = "ScheduledStop"
+	EventReasonScheduledStart = "ScheduledStart"
+)
+
 // Sharded database events
 const (
 	EventReasonShardedDatabaseReady = "ShardedDatabaseReady"
 	EventReasonClusterNotReady      = "ClusterNotReady"
 	EventReasonClientCreationFailed = "ClientCreationFailed"
 )
+
+// Workload events
+const (
+	EventReasonWorkloadStarted   = "WorkloadStarted"
+	EventReasonWorkloadCompleted = "WorkloadCompleted"
+	EventReasonWorkloadFailed    = "WorkloadFailed"
+)
+
+// User sync events
+const (
+	EventReasonUsersSynced    = "UsersSynced"
+	EventReasonUserSyncFailed = "UserSyncFailed"
+)
+
+// Migration events
+const (
+	EventReasonMigrationApplied = "MigrationApplied"
+	EventReasonMigrationFailed  = "MigrationFailed"
+)
+
+// Change data capture events
+const (
+	EventReasonCDCEnabled = "CDCEnabled"
+	EventReasonCDCFailed  = "CDCFailed"
+)
+
+// Security audit events
+const (
+	EventReasonSecurityStatement       = "SecurityStatement"
+	EventReasonSecurityStatementFailed = "SecurityStatementFailed"
+)
+
+// Query monitoring events
+const (
+	EventReasonIndexRecommended = "IndexRecommended"
+	EventReasonQueryTerminated  = "QueryTerminated"
+)
@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// hibernationRequeueInterval is how often the server pods are counted while
+// a hibernating cluster shuts down
+const hibernationRequeueInterval = 10 * time.Second
+
+// hibernationClient is the part of the Neo4j client hibernation uses
+type hibernationClient interface {
+	GetDatabases(ctx context.Context) ([]neo4jclient.DatabaseInfo, error)
+	Checkpoint(ctx context.Context, database string) error
+}
+
+// reconcileHibernation scales a cluster with spec.hibernate to zero. The
+// databases are checkpointed first so that the servers start without
+// replaying their transaction logs. Scaling a StatefulSet down keeps its
+// PersistentVolumeClaims, and the ConfigMaps, Secrets and Services are left
+// alone, so resuming only has to scale the servers up again.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileHibernation(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	serverSts := &appsv1.StatefulSet{}
+	err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, serverSts)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	found := err == nil
+
+	if cluster.Status.Hibernation == nil {
+		servers := cluster.Spec.Topology.Servers
+		if found && serverSts.Spec.Replicas != nil && *serverSts.Spec.Replicas > 0 {
+			servers = *serverSts.Spec.Replicas
+		}
+		var checkpointed []string
+		if found && serverSts.Status.ReadyReplicas > 0 {
+			// Servers checkpoint on a graceful shutdown too, so a failed
+			// checkpoint only makes the next start slower
+			if checkpointed, err = r.checkpointDatabases(ctx, cluster); err != nil {
+				logger.Error(err, "Failed to checkpoint databases before hibernation")
+				r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonCheckpointFailed,
+					"Checkpoint before hibernation failed, stopping the servers anyway: %v", err)
+			}
+		}
+		now := metav1.Now()
+		if err := r.setHibernationStatus(ctx, cluster, &neo4jv1alpha1.HibernationStatus{
+			StartTime:             &now,
+			Servers:               servers,
+			CheckpointedDatabases: checkpointed,
+			Message:               "Stopping the servers",
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonHibernationStarted,
+			"Hibernating cluster, stopping %d servers", servers)
+	}
+
+	if found {
+		if err := r.scaleToZero(ctx, serverSts); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	backupSts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-backup", Namespace: cluster.Namespace}, backupSts); err == nil {
+		if err := r.scaleToZero(ctx, backupSts); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if !errors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get backup StatefulSet: %w", err)
+	}
+
+	for _, group := range cluster.Spec.Topology.ServerGroups {
+		groupSts := &appsv1.StatefulSet{}
+		name := resources.ServerGroupStatefulSetName(cluster, group.Name)
+		if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: cluster.Namespace}, groupSts); err == nil {
+			if err := r.scaleToZero(ctx, groupSts); err != nil {
+				return ctrl.Result{}, err
+			}
+		} else if !errors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get StatefulSet %s: %w", name, err)
+		}
+	}
+
+	pods, err := r.listServerPods(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(pods.Items) > 0 {
+		_ = r.updateClusterStatus(ctx, cluster, "Hibernating",
+			fmt.Sprintf("Stopping the servers, %d pods left", len(pods.Items)))
+		return ctrl.Result{RequeueAfter: hibernationRequeueInterval}, nil
+	}
+
+	message := "Scaled to zero by spec.hibernate"
+	if r.updateClusterStatus(ctx, cluster, "Hibernated", message) {
+		logger.Info("Cluster hibernated")
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonClusterHibernated, message)
+	}
+	if cluster.Status.Hibernation.Message != message {
+		hibernation := cluster.Status.Hibernation.DeepCopy()
+		hibernation.Message = message
+		if err := r.setHibernationStatus(ctx, cluster, hibernation); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// startResume moves a hibernated or hibernating cluster whose spec.hibernate
+// was cleared to the Resuming phase. The regular reconcile then scales the
+// servers back to spec.topology.servers.
+func (r *Neo4jEnterpriseClusterReconciler) startResume(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if cluster.Status.Hibernation == nil ||
+		(cluster.Status.Phase != "Hibernated" && cluster.Status.Phase != "Hibernating") {
+		return nil
+	}
+	message := fmt.Sprintf("Starting %d servers", cluster.Spec.Topology.Servers)
+	hibernation := cluster.Status.Hibernation.DeepCopy()
+	hibernation.Message = message
+	if err := r.setHibernationStatus(ctx, cluster, hibernation); err != nil {
+		return err
+	}
+	if r.updateClusterStatus(ctx, cluster, "Resuming", message) {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonResumeStarted,
+			"Resuming cluster, "+message)
+	}
+	return nil
+}
+
+// finishResume clears the hibernation status once the resumed cluster is
+// ready
+func (r *Neo4jEnterpriseClusterReconciler) finishResume(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if cluster.Status.Hibernation == nil {
+		return nil
+	}
+	if err := r.setHibernationStatus(ctx, cluster, nil); err != nil {
+		return err
+	}
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonClusterResumed, "Cluster resumed from hibernation")
+	return nil
+}
+
+// checkpointDatabases checkpoints every online database and returns their
+// names
+func (r *Neo4jEnterpriseClusterReconciler) checkpointDatabases(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) ([]string, error) {
+	c, closeClient, err := r.connectForHibernation(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Neo4j: %w", err)
+	}
+	defer closeClient()
+
+	databases, err := c.GetDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var checkpointed []string
+	for _, database := range databases {
+		// SHOW DATABASES lists a database once per server hosting it
+		if database.Status != "online" || slices.Contains(checkpointed, database.Name) {
+			continue
+		}
+		if err := c.Checkpoint(ctx, database.Name); err != nil {
+			return checkpointed, err
+		}
+		checkpointed = append(checkpointed, database.Name)
+	}
+	slices.Sort(checkpointed)
+	return checkpointed, nil
+}
+
+// connectForHibernation opens a Neo4j connection for the checkpoint before
+// hibernation
+func (r *Neo4jEnterpriseClusterReconciler) connectForHibernation(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (hibernationClient, func(), error) {
+	if r.newHibernationClient != nil {
+		c, err := r.newHibernationClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+// scaleToZero sets the replicas of a StatefulSet to zero
+func (r *Neo4jEnterpriseClusterReconciler) scaleToZero(ctx context.Context, sts *appsv1.StatefulSet) error {
+	if sts.Spec.Replicas != nil && *sts.Spec.Replicas == 0 {
+		return nil
+	}
+	patch := client.MergeFrom(sts.DeepCopy())
+	sts.Spec.Replicas = ptr.To(int32(0))
+	if err := r.Patch(ctx, sts, patch); err != nil {
+		return fmt.Errorf("failed to scale StatefulSet %s to zero: %w", sts.Name, err)
+	}
+	return nil
+}
+
+// setHibernationStatus records the hibernation of the cluster, nil clears it
+func (r *Neo4jEnterpriseClusterReconciler) setHibernationStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, hibernation *neo4jv1alpha1.HibernationStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		latest.Status.Hibernation = hibernation
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.Hibernation = latest.Status.Hibernation
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeHibernationClient struct {
+	databases     []neo4jclient.DatabaseInfo
+	checkpointErr error
+	checkpointed  []string
+}
+
+func (f *fakeHibernationClient) GetDatabases(context.Context) ([]neo4jclient.DatabaseInfo, error) {
+	return f.databases, nil
+}
+
+func (f *fakeHibernationClient) Checkpoint(_ context.Context, database string) error {
+	if f.checkpointErr != nil {
+		return f.checkpointErr
+	}
+	f.checkpointed = append(f.checkpointed, database)
+	return nil
+}
+
+func hibernationTestSetup(t *testing.T, neo4j *fakeHibernationClient) (client.Client, *Neo4jEnterpriseClusterReconciler, *record.FakeRecorder, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Hibernate = true
+	cluster.Status.Phase = "Ready"
+	sts := serverSTS("prod", "default")
+	sts.Spec.Replicas = int32PtrCM(3)
+	sts.Status.ReadyReplicas = 3
+	backup := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-backup", Namespace: "default"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: int32PtrCM(1)},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, sts, backup,
+			restartTestPod("prod-server-0", "rev"),
+			restartTestPod("prod-server-1", "rev"),
+			restartTestPod("prod-server-2", "rev")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: recorder,
+		newHibernationClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (hibernationClient, error) {
+			return neo4j, nil
+		},
+	}
+	return c, r, recorder, cluster
+}
+
+func TestReconcileHibernation(t *testing.T) {
+	neo4j := &fakeHibernationClient{databases: []neo4jclient.DatabaseInfo{
+		{Name: "system", Status: "online"},
+		{Name: "neo4j", Status: "online"},
+		{Name: "neo4j", Status: "online"},
+		{Name: "archive", Status: "offline"},
+	}}
+	c, r, _, cluster := hibernationTestSetup(t, neo4j)
+	ctx := context.Background()
+	replicas := func(name string) int32 {
+		t.Helper()
+		sts := &appsv1.StatefulSet{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, sts))
+		return *sts.Spec.Replicas
+	}
+
+	// The databases are checkpointed once and the StatefulSets scaled to
+	// zero, the cluster hibernates while pods are left
+	result, err := r.reconcileHibernation(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, hibernationRequeueInterval, result.RequeueAfter)
+	assert.Equal(t, []string{"system", "neo4j"}, neo4j.checkpointed)
+	assert.Equal(t, int32(0), replicas("prod-server"))
+	assert.Equal(t, int32(0), replicas("prod-backup"))
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, "Hibernating", latest.Status.Phase)
+	require.NotNil(t, cluster.Status.Hibernation)
+	assert.Equal(t, int32(3), cluster.Status.Hibernation.Servers)
+	assert.Equal(t, []string{"neo4j", "system"}, cluster.Status.Hibernation.CheckpointedDatabases)
+
+	for _, name := range []string{"prod-server-0", "prod-server-1", "prod-server-2"} {
+		require.NoError(t, c.Delete(ctx, restartTestPod(name, "rev")))
+	}
+	result, err = r.reconcileHibernation(ctx, cluster)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Len(t, neo4j.checkpointed, 2)
+
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, "Hibernated", latest.Status.Phase)
+	ready := findCondition(latest.Status.Conditions, ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, ConditionReasonHibernated, ready.Reason)
+
+	// Resuming keeps the hibernation status until the cluster is ready
+	latest.Spec.Hibernate = false
+	require.NoError(t, r.startResume(ctx, latest))
+	assert.Equal(t, "Starting 3 servers", latest.Status.Hibernation.Message)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, "Resuming", latest.Status.Phase)
+	latest.Status.Phase = "Forming"
+	latest.Status.Hibernation.Message = "unchanged"
+	require.NoError(t, r.startResume(ctx, latest))
+	assert.Equal(t, "unchanged", latest.Status.Hibernation.Message)
+
+	require.NoError(t, r.finishResume(ctx, latest))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Nil(t, latest.Status.Hibernation)
+}
+
+func TestReconcileHibernationCheckpointFailure(t *testing.T) {
+	neo4j := &fakeHibernationClient{
+		databases:     []neo4jclient.DatabaseInfo{{Name: "neo4j", Status: "online"}},
+		checkpointErr: fmt.Errorf("connection refused"),
+	}
+	c, r, recorder, cluster := hibernationTestSetup(t, neo4j)
+	ctx := context.Background()
+
+	_, err := r.reconcileHibernation(ctx, cluster)
+	require.NoError(t, err)
+	assert.Empty(t, cluster.Status.Hibernation.CheckpointedDatabases)
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.Equal(t, int32(0), *sts.Spec.Replicas)
+	assert.Contains(t, <-recorder.Events, EventReasonCheckpointFailed)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+const (
+	// maxIndexRecommendations caps status.queryMonitoring.recommendations
+	maxIndexRecommendations = 20
+	// indexRecommendationRetention is how long a recommendation stays in
+	// the status after a slow query last lacked the index
+	indexRecommendationRetention = 7 * 24 * time.Hour
+	// explainInterval is how long a slow query is not sampled again
+	explainInterval = time.Hour
+	// defaultExplainsPerSecond limits the EXPLAINs per cluster when
+	// spec.queryMonitoring.sampling.maxQueriesPerSecond is unset
+	defaultExplainsPerSecond = 1
+)
+
+// indexAdvisorClient explains queries for the indexes they lack
+type indexAdvisorClient interface {
+	MissingIndexes(ctx context.Context, database, query string) ([]neo4jclient.IndexCandidate, error)
+	Close() error
+}
+
+// sampledQueries holds, per cluster, when each slow query was last sampled,
+// so that a query running for hours is not explained on every poll, and
+// the limiter of its EXPLAINs
+var sampledQueries = struct {
+	sync.Mutex
+	queries  map[string]map[string]time.Time
+	limiters map[string]*rate.Limiter
+}{
+	queries:  map[string]map[string]time.Time{},
+	limiters: map[string]*rate.Limiter{},
+}
+
+// sampleSlowQueries picks the slow queries to explain: those not sampled in
+// the last explainInterval, at spec.queryMonitoring.sampling.rate and up to
+// its maxQueriesPerSecond
+func sampleSlowQueries(key string, sampling *neo4jv1alpha1.QuerySamplingConfig, queries []slowQuery, now time.Time) ([]slowQuery, error) {
+	sampleRate := 1.0
+	explainsPerSecond := defaultExplainsPerSecond
+	if sampling != nil {
+		if sampling.Rate != "" {
+			parsed, err := strconv.ParseFloat(sampling.Rate, 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				return nil, fmt.Errorf("invalid sampling rate %q: must be between 0.0 and 1.0", sampling.Rate)
+			}
+			sampleRate = parsed
+		}
+		if sampling.MaxQueriesPerSecond > 0 {
+			explainsPerSecond = int(sampling.MaxQueriesPerSecond)
+		}
+	}
+
+	sampledQueries.Lock()
+	defer sampledQueries.Unlock()
+
+	sampled := sampledQueries.queries[key]
+	if sampled == nil {
+		sampled = map[string]time.Time{}
+		sampledQueries.queries[key] = sampled
+	}
+	for query, at := range sampled {
+		if now.Sub(at) > explainInterval {
+			delete(sampled, query)
+		}
+	}
+	limiter := sampledQueries.limiters[key]
+	if limiter == nil || limiter.Burst() != explainsPerSecond {
+		limiter = rate.NewLimiter(rate.Limit(explainsPerSecond), explainsPerSecond)
+		sampledQueries.limiters[key] = limiter
+	}
+
+	var picked []slowQuery
+	for _, query := range queries {
+		if !explainable(query) {
+			continue
+		}
+		sampleKey := query.Database + "\x00" + query.Query
+		if _, seen := sampled[sampleKey]; seen {
+			continue
+		}
+		if !limiter.AllowN(now, 1) {
+			break
+		}
+		// Queries left out by the rate are not rolled for again until the
+		// interval passes
+		sampled[sampleKey] = now
+		if rand.Float64() < sampleRate {
+			picked = append(picked, query)
+		}
+	}
+	return picked, nil
+}
+
+// explainable reports whether a slow query can be planned with EXPLAIN:
+// complete, not already explained or profiled, and not administrative
+func explainable(query slowQuery) bool {
+	if query.Database == "system" || strings.HasSuffix(query.Query, "…") {
+		return false
+	}
+	keyword, _, _ := strings.Cut(strings.TrimSpace(query.Query), " ")
+	switch strings.ToUpper(keyword) {
+	case "", "EXPLAIN", "PROFILE", "SHOW", "CYPHER", "TERMINATE":
+		return false
+	}
+	return true
+}
+
+// recommendIndexes explains a sample of the running slow queries and
+// records the indexes they lack in status.queryMonitoring, with an Event
+// for each new recommendation. The recommendations are cleared when
+// spec.queryMonitoring.indexRecommendations is disabled.
+func (qm *QueryMonitor) recommendIndexes(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, queries []slowQuery) error {
+	queryMonitoring := cluster.Spec.QueryMonitoring
+	if queryMonitoring == nil || !queryMonitoring.IndexRecommendations {
+		return qm.updateQueryMonitoringStatus(ctx, cluster, nil)
+	}
+
+	now := time.Now()
+	sampled, err := sampleSlowQueries(cluster.Namespace+"/"+cluster.Name, queryMonitoring.Sampling, queries, now)
+	if err != nil {
+		return err
+	}
+
+	status := &neo4jv1alpha1.QueryMonitoringStatus{}
+	if cluster.Status.QueryMonitoring != nil {
+		status = cluster.Status.QueryMonitoring.DeepCopy()
+	}
+	var found []neo4jv1alpha1.IndexRecommendation
+	var errs []error
+	if len(sampled) > 0 {
+		newClient := qm.newIndexAdvisorClient
+		if newClient == nil {
+			newClient = qm.connectToCluster
+		}
+		neo4jClient, err := newClient(ctx, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to create Neo4j client: %w", err)
+		}
+		defer neo4jClient.Close()
+
+		for _, query := range sampled {
+			candidates, err := neo4jClient.MissingIndexes(ctx, query.Database, query.Query)
+			if err != nil {
+				// Queries with unsupported syntax fail, the others are still explained
+				errs = append(errs, fmt.Errorf("transaction %s on %s: %w", query.TransactionID, query.Server, err))
+				continue
+			}
+			for _, candidate := range candidates {
+				found = append(found, neo4jv1alpha1.IndexRecommendation{
+					Database:  query.Database,
+					Label:     candidate.Label,
+					Property:  candidate.Property,
+					Statement: candidate.Statement(),
+					Query:     query.Query,
+					LastSeen:  metav1.NewTime(now),
+				})
+			}
+		}
+		analyzed := metav1.NewTime(now)
+		status.LastAnalyzed = &analyzed
+	}
+
+	previous := map[string]bool{}
+	for _, recommendation := range status.Recommendations {
+		previous[indexRecommendationKey(recommendation)] = true
+	}
+	status.Recommendations = mergeIndexRecommendations(status.Recommendations, found, now)
+	if len(status.Recommendations) == 0 && status.LastAnalyzed == nil {
+		status = nil
+	}
+	if err := qm.updateQueryMonitoringStatus(ctx, cluster, status); err != nil {
+		return err
+	}
+
+	for _, recommendation := range found {
+		key := indexRecommendationKey(recommendation)
+		if previous[key] {
+			continue
+		}
+		previous[key] = true
+		log.FromContext(ctx).Info("Recommending an index for slow queries", "cluster", cluster.Name,
+			"database", recommendation.Database, "statement", recommendation.Statement)
+		if qm.Recorder != nil {
+			qm.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonIndexRecommended,
+				"Slow queries on %s filter :%s nodes on %s without an index: %s",
+				recommendation.Database, recommendation.Label, recommendation.Property, recommendation.Statement)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// connectToCluster connects to the cluster, routing to any of its servers
+func (qm *QueryMonitor) connectToCluster(_ context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (indexAdvisorClient, error) {
+	return neo4jclient.NewClientForEnterprise(cluster, qm.Client, getClusterConnectionSecretName(cluster))
+}
+
+// updateQueryMonitoringStatus records status.queryMonitoring unless it is
+// unchanged
+func (qm *QueryMonitor) updateQueryMonitoringStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status *neo4jv1alpha1.QueryMonitoringStatus) error {
+	if equality.Semantic.DeepEqual(status, cluster.Status.QueryMonitoring) {
+		return nil
+	}
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := qm.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		latest.Status.QueryMonitoring = status
+		return qm.Status().Update(ctx, latest)
+	}); err != nil {
+		return fmt.Errorf("failed to update query monitoring status: %w", err)
+	}
+	cluster.Status.QueryMonitoring = status
+	return nil
+}
+
+// indexRecommendationKey identifies the index of a recommendation
+func indexRecommendationKey(recommendation neo4jv1alpha1.IndexRecommendation) string {
+	return recommendation.Database + "/" + recommendation.Label + "/" + recommendation.Property
+}
+
+// mergeIndexRecommendations merges the recommendations found into the
+// previous ones, dropping those last seen longer than
+// indexRecommendationRetention ago, and keeps the most recently seen
+func mergeIndexRecommendations(previous, found []neo4jv1alpha1.IndexRecommendation, now time.Time) []neo4jv1alpha1.IndexRecommendation {
+	merged := map[string]neo4jv1alpha1.IndexRecommendation{}
+	for _, recommendation := range previous {
+		if now.Sub(recommendation.LastSeen.Time) <= indexRecommendationRetention {
+			merged[indexRecommendationKey(recommendation)] = recommendation
+		}
+	}
+	for _, recommendation := range found {
+		merged[indexRecommendationKey(recommendation)] = recommendation
+	}
+
+	recommendations := make([]neo4jv1alpha1.IndexRecommendation, 0, len(merged))
+	for _, recommendation := range merged {
+		recommendations = append(recommendations, recommendation)
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		if !recommendations[i].LastSeen.Equal(&recommendations[j].LastSeen) {
+			return recommendations[j].LastSeen.Before(&recommendations[i].LastSeen)
+		}
+		return indexRecommendationKey(recommendations[i]) < indexRecommendationKey(recommendations[j])
+	})
+	if len(recommendations) > maxIndexRecommendations {
+		recommendations = recommendations[:maxIndexRecommendations]
+	}
+	if len(recommendations) == 0 {
+		return nil
+	}
+	return recommendations
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeIndexAdvisorClient struct {
+	explained *[]string
+}
+
+func (f *fakeIndexAdvisorClient) MissingIndexes(_ context.Context, _, query string) ([]neo4jclient.IndexCandidate, error) {
+	*f.explained = append(*f.explained, query)
+	return []neo4jclient.IndexCandidate{{Label: "Person", Property: "email"}}, nil
+}
+
+func (f *fakeIndexAdvisorClient) Close() error { return nil }
+
+func TestRecommendIndexes(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("advised", "default")
+	cluster.Spec.QueryMonitoring = &neo4jv1alpha1.QueryMonitoringSpec{Enabled: true, IndexRecommendations: true}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	var explained []string
+	qm := NewQueryMonitor(c, c.Scheme())
+	qm.Recorder = recorder
+	qm.newIndexAdvisorClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (indexAdvisorClient, error) {
+		return &fakeIndexAdvisorClient{explained: &explained}, nil
+	}
+	queries := []slowQuery{
+		{Server: "advised-server-0", TransactionID: "neo4j-transaction-1", Database: "neo4j", Query: "MATCH (p:Person {email: $email}) RETURN p"},
+		{Server: "advised-server-0", TransactionID: "system-transaction-2", Database: "system", Query: "SHOW USERS"},
+	}
+
+	require.NoError(t, qm.recommendIndexes(ctx, cluster, queries))
+	assert.Equal(t, []string{"MATCH (p:Person {email: $email}) RETURN p"}, explained)
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	require.NotNil(t, latest.Status.QueryMonitoring)
+	require.Len(t, latest.Status.QueryMonitoring.Recommendations, 1)
+	recommendation := latest.Status.QueryMonitoring.Recommendations[0]
+	assert.Equal(t, "neo4j", recommendation.Database)
+	assert.Equal(t, "CREATE INDEX IF NOT EXISTS FOR (n:`Person`) ON (n.`email`)", recommendation.Statement)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonIndexRecommended)
+
+	// Queries still running are not explained again
+	require.NoError(t, qm.recommendIndexes(ctx, cluster, queries))
+	assert.Len(t, explained, 1)
+	assert.Empty(t, recorder.Events)
+
+	// Disabling recommendations clears them
+	cluster.Spec.QueryMonitoring.IndexRecommendations = false
+	require.NoError(t, qm.recommendIndexes(ctx, cluster, queries))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Nil(t, latest.Status.QueryMonitoring)
+}
+
+func TestSampleSlowQueries(t *testing.T) {
+	now := time.Now()
+	queries := []slowQuery{
+		{Database: "neo4j", Query: "MATCH (n:A) WHERE n.x = 1 RETURN n"},
+		{Database: "neo4j", Query: "MATCH (n:B) WHERE n.y = 2 RETURN n"},
+		{Database: "neo4j", Query: "MATCH (n:C) WHERE n.z = 3 RETURN n"},
+		{Database: "neo4j", Query: "PROFILE MATCH (n) RETURN n"},
+		{Database: "neo4j", Query: "MATCH (n) RETURN n…"},
+	}
+
+	sampled, err := sampleSlowQueries("default/limited", &neo4jv1alpha1.QuerySamplingConfig{MaxQueriesPerSecond: 2}, queries, now)
+	require.NoError(t, err)
+	assert.Len(t, sampled, 2)
+	// The rest are sampled once the limiter allows it
+	sampled, err = sampleSlowQueries("default/limited", &neo4jv1alpha1.QuerySamplingConfig{MaxQueriesPerSecond: 2}, queries, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, sampled, 1)
+	assert.Equal(t, queries[2].Query, sampled[0].Query)
+	// and again after the interval
+	sampled, err = sampleSlowQueries("default/limited", &neo4jv1alpha1.QuerySamplingConfig{MaxQueriesPerSecond: 5}, queries, now.Add(2*explainInterval))
+	require.NoError(t, err)
+	assert.Len(t, sampled, 3)
+
+	sampled, err = sampleSlowQueries("default/unsampled", &neo4jv1alpha1.QuerySamplingConfig{Rate: "0"}, queries, now)
+	require.NoError(t, err)
+	assert.Empty(t, sampled)
+
+	_, err = sampleSlowQueries("default/invalid", &neo4jv1alpha1.QuerySamplingConfig{Rate: "1.5"}, queries, now)
+	require.Error(t, err)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// jobWaitInterval is how often a Job being waited for is read. Reads are
+// served by the informer cache of the manager, not the API server.
+var jobWaitInterval = 5 * time.Second
+
+// jobLogTailLines caps the log lines quoted from a failed Job
+const jobLogTailLines = 20
+
+// JobFailedError reports a Job that ran out of retries
+type JobFailedError struct {
+	Job     string
+	Reason  string
+	Message string
+	// LogTail holds the last log lines of the failed container, when the
+	// container reports them as its termination message
+	LogTail string
+}
+
+func (e *JobFailedError) Error() string {
+	msg := fmt.Sprintf("job %s failed", e.Job)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	if e.Message != "" {
+		msg += " (" + e.Message + ")"
+	}
+	if e.LogTail != "" {
+		msg += "\n" + e.LogTail
+	}
+	return msg
+}
+
+// jobFinished reports whether a Job has finished and, if so, whether it
+// failed together with the reason and message of its condition. Jobs without
+// conditions count as finished once a pod succeeded or the retries are used up.
+func jobFinished(job *batchv1.Job) (finished, failed bool, reason, message string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, false, condition.Reason, condition.Message
+		case batchv1.JobFailed:
+			return true, true, condition.Reason, condition.Message
+		}
+	}
+	if job.Status.Succeeded > 0 {
+		return true, false, "", ""
+	}
+	backoffLimit := int32(6)
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+	if job.Status.Failed > backoffLimit {
+		return true, true, "BackoffLimitExceeded", ""
+	}
+	return false, false, "", ""
+}
+
+// waitForJob blocks until the Job completes, fails or the timeout passes. A
+// failed Job is returned as a *JobFailedError carrying the log tail of its
+// last failed pod.
+func waitForJob(ctx context.Context, c client.Client, job *batchv1.Job, timeout time.Duration) error {
+	logger := log.FromContext(ctx).WithValues("job", job.Name)
+
+	var failed bool
+	var reason, message string
+	err := wait.PollUntilContextTimeout(ctx, jobWaitInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
+			return false, fmt.Errorf("failed to get job %s: %w", job.Name, err)
+		}
+		var finished bool
+		finished, failed, reason, message = jobFinished(job)
+		return finished, nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) {
+			return fmt.Errorf("job %s did not finish within %s", job.Name, timeout)
+		}
+		return err
+	}
+
+	if !failed {
+		logger.Info("Job completed successfully")
+		return nil
+	}
+	return &JobFailedError{Job: job.Name, Reason: reason, Message: message, LogTail: jobLogTail(ctx, c, job)}
+}
+
+// jobFailureMessage describes why a Job failed for a status message, with the
+// log tail of its failed pod when there is one.
+func jobFailureMessage(ctx context.Context, c client.Client, job *batchv1.Job, summary string) string {
+	if tail := jobLogTail(ctx, c, job); tail != "" {
+		return summary + ": " + tail
+	}
+	return summary
+}
+
+// jobLogTail returns the last log lines of the most recent failed pod of a
+// Job. Containers with the FallbackToLogsOnError termination message policy
+// report their log tail as termination message, which avoids reading pod
+// logs through the API server.
+func jobLogTail(ctx context.Context, c client.Client, job *batchv1.Job) string {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{"job-name": job.Name}); err != nil {
+		log.FromContext(ctx).V(1).Info("Could not list pods of job", "job", job.Name, "error", err)
+		return ""
+	}
+
+	var latest *corev1.ContainerStateTerminated
+	for i := range pods.Items {
+		for _, status := range pods.Items[i].Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 || terminated.Message == "" {
+				continue
+			}
+			if latest == nil || terminated.FinishedAt.After(latest.FinishedAt.Time) {
+				latest = terminated
+			}
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(latest.Message, "\n"), "\n")
+	if len(lines) > jobLogTailLines {
+		lines = lines[len(lines)-jobLogTailLines:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestJobFinished(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       batchv1.JobStatus
+		backoffLimit *int32
+		wantFinished bool
+		wantFailed   bool
+		wantReason   string
+	}{
+		{name: "running", status: batchv1.JobStatus{Active: 1}},
+		{
+			name: "complete condition",
+			status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}},
+			wantFinished: true,
+		},
+		{
+			name: "failed condition",
+			status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"},
+			}},
+			wantFinished: true,
+			wantFailed:   true,
+			wantReason:   "DeadlineExceeded",
+		},
+		{name: "retrying", status: batchv1.JobStatus{Failed: 1}, backoffLimit: int32PtrCM(2)},
+		{
+			name:         "retries used up",
+			status:       batchv1.JobStatus{Failed: 3},
+			backoffLimit: int32PtrCM(2),
+			wantFinished: true,
+			wantFailed:   true,
+			wantReason:   "BackoffLimitExceeded",
+		},
+		{name: "succeeded without conditions", status: batchv1.JobStatus{Succeeded: 1}, wantFinished: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &batchv1.Job{Spec: batchv1.JobSpec{BackoffLimit: tt.backoffLimit}, Status: tt.status}
+			finished, failed, reason, _ := jobFinished(job)
+			assert.Equal(t, tt.wantFinished, finished)
+			assert.Equal(t, tt.wantFailed, failed)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	previous := jobWaitInterval
+	jobWaitInterval = 10 * time.Millisecond
+	defer func() { jobWaitInterval = previous }()
+
+	var logs []string
+	for i := 1; i <= 30; i++ {
+		logs = append(logs, fmt.Sprintf("line %d", i))
+	}
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "hook", Namespace: "default"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "hook-abcde", Namespace: "default", Labels: map[string]string{"job-name": "hook"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name: "hook",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 1,
+				Message:  strings.Join(logs, "\n") + "\n",
+			}},
+		}}},
+	}
+	runningJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "slow", Namespace: "default"},
+		Status:     batchv1.JobStatus{Active: 1},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(failedJob, pod, runningJob).Build()
+	ctx := context.Background()
+
+	err := waitForJob(ctx, c, failedJob, time.Second)
+	var jobErr *JobFailedError
+	require.True(t, errors.As(err, &jobErr), "expected a JobFailedError, got %v", err)
+	assert.Equal(t, "BackoffLimitExceeded", jobErr.Reason)
+	tail := strings.Split(jobErr.LogTail, "\n")
+	require.Len(t, tail, jobLogTailLines)
+	assert.Equal(t, "line 11", tail[0])
+	assert.Equal(t, "line 30", tail[len(tail)-1])
+
+	err = waitForJob(ctx, c, runningJob, 50*time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not finish within")
+	assert.False(t, errors.As(err, &jobErr))
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// reconcileLicense checks the cores of the cluster against its Neo4j license
+// entitlement and records the outcome in the LicenseCompliant condition. It
+// returns an error, which keeps the StatefulSets as they are, when the spec
+// needs more cores than licensed and than the servers use now. A cluster
+// already past a lowered entitlement is reported but keeps running.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileLicense(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	validator := validation.NewLicenseValidator(r.Client)
+	maxCores, source, err := validator.Entitlement(ctx, cluster)
+	if err != nil {
+		r.setLicenseCondition(ctx, cluster, metav1.ConditionUnknown, ConditionReasonLicenseUnavailable, err.Error())
+		return fmt.Errorf("license check failed: %w", err)
+	}
+	if maxCores == 0 {
+		return nil
+	}
+
+	deployedCores, err := r.deployedCores(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to count the cores of the servers: %w", err)
+	}
+	if errs := validator.Validate(ctx, cluster, deployedCores); len(errs) > 0 {
+		message := fmt.Sprintf("Scale-up blocked: %s", errs.ToAggregate().Error())
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonLicenseExceeded, message)
+		r.setLicenseCondition(ctx, cluster, metav1.ConditionFalse, ConditionReasonLicenseExceeded, message)
+		return fmt.Errorf("license check failed: %s", errs.ToAggregate().Error())
+	}
+
+	cores, _ := validation.RequestedCores(cluster)
+	if cores > maxCores {
+		message := fmt.Sprintf("The servers need %d cores, more than the %d licensed in %s", cores, maxCores, source)
+		if !licenseConditionMatches(cluster, metav1.ConditionFalse, ConditionReasonLicenseExceeded, message) {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonLicenseExceeded, message)
+		}
+		r.setLicenseCondition(ctx, cluster, metav1.ConditionFalse, ConditionReasonLicenseExceeded, message)
+		return nil
+	}
+	r.setLicenseCondition(ctx, cluster, metav1.ConditionTrue, ConditionReasonWithinLicense,
+		fmt.Sprintf("The servers need %d of the %d cores licensed in %s", cores, maxCores, source))
+	return nil
+}
+
+// deployedCores returns the cores the running server pods of the cluster are
+// limited to, rounded up to whole cores per pod
+func (r *Neo4jEnterpriseClusterReconciler) deployedCores(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (int64, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name}, client.HasLabels{"neo4j.com/server-name"}); err != nil {
+		return 0, err
+	}
+	var cores int64
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			if container.Name != resources.Neo4jContainer {
+				continue
+			}
+			if limit, ok := container.Resources.Limits[corev1.ResourceCPU]; ok {
+				cores += limit.Value()
+			}
+		}
+	}
+	return cores, nil
+}
+
+// conditionMatches reports whether the LicenseCompliant condition of the
+// cluster already records the outcome, so that it is not reported again
+func licenseConditionMatches(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status metav1.ConditionStatus, reason, message string) bool {
+	existing := findCondition(cluster.Status.Conditions, ConditionTypeLicenseCompliant)
+	return existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message
+}
+
+// setLicenseCondition records the outcome of the license check on the
+// cluster. Only the condition is changed, so the phase and Ready condition
+// stay with updateClusterStatus.
+func (r *Neo4jEnterpriseClusterReconciler) setLicenseCondition(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status metav1.ConditionStatus, reason, message string) {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		if licenseConditionMatches(latest, status, reason, message) {
+			return nil
+		}
+		SetNamedCondition(&latest.Status.Conditions, ConditionTypeLicenseCompliant, latest.Generation, status, reason, message)
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		// Keep later status writes of this reconcile from conflicting
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update LicenseCompliant condition")
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// clustersForLifecycleConfigMap maps a ConfigMap to the clusters whose
+// spec.lifecycle scripts it holds, so that editing a script rolls them
+func (r *Neo4jEnterpriseClusterReconciler) clustersForLifecycleConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	clusters := &neo4jv1alpha1.Neo4jEnterpriseClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list clusters for ConfigMap", "configMap", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, cluster := range clusters.Items {
+		if referencesLifecycleConfigMap(&cluster, obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cluster)})
+		}
+	}
+	return requests
+}
+
+// referencesLifecycleConfigMap reports whether a spec.lifecycle script of the
+// cluster is read from the named ConfigMap
+func referencesLifecycleConfigMap(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, name string) bool {
+	lifecycle := cluster.Spec.Lifecycle
+	if lifecycle == nil {
+		return false
+	}
+	return (lifecycle.PreStartScript != nil && lifecycle.PreStartScript.Name == name) ||
+		(lifecycle.PostStartScript != nil && lifecycle.PostStartScript.Name == name)
+}
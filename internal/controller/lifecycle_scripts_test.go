@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func lifecycleCluster(name, namespace string) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := minimalCluster(name, namespace)
+	cluster.Spec.Lifecycle = &neo4jv1alpha1.LifecycleSpec{
+		PreStartScript:  &neo4jv1alpha1.ConfigMapKeySelector{Name: "site-scripts", Key: "pre-start.sh"},
+		PostStartScript: &neo4jv1alpha1.ConfigMapKeySelector{Name: "site-scripts", Key: "post-start.sh"},
+	}
+	return cluster
+}
+
+func TestReconcileConfigMap_LifecycleScripts(t *testing.T) {
+	cluster := lifecycleCluster("hooked", "default")
+	fc := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cluster, serverSTS("hooked", "default")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
+		Build()
+	cm := NewConfigMapManager(fc)
+	ctx := context.Background()
+
+	// A missing ConfigMap keeps the cluster ConfigMap from being written
+	err := cm.ReconcileConfigMap(ctx, cluster)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.lifecycle.preStartScript")
+
+	// So does a missing key
+	scripts := configMapWithData("site-scripts", "default", map[string]string{
+		"pre-start.sh": "echo 'dbms.usage_report.enabled=false' >> /tmp/neo4j-config/neo4j.conf",
+	})
+	require.NoError(t, fc.Create(ctx, scripts))
+	err = cm.ReconcileConfigMap(ctx, cluster)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no key post-start.sh")
+
+	scripts.Data["post-start.sh"] = "curl -sf http://localhost:7474/ > /dev/null"
+	require.NoError(t, fc.Update(ctx, scripts))
+	require.NoError(t, cm.ReconcileConfigMap(ctx, cluster))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, fc.Get(ctx, types.NamespacedName{Name: "hooked-config", Namespace: "default"}, configMap))
+	assert.Contains(t, configMap.Data["startup.sh"], scripts.Data["pre-start.sh"])
+	assert.Contains(t, configMap.Data["health.sh"], scripts.Data["post-start.sh"])
+
+	// The snippets are part of the config hash, so editing one rolls the servers
+	withoutScripts := resources.BuildConfigMapForEnterprise(cluster)
+	assert.NotEqual(t, cm.calculateConfigMapHash(withoutScripts), cm.calculateConfigMapHash(configMap))
+}
+
+func TestClustersForLifecycleConfigMap(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		lifecycleCluster("orders", "team-a"),
+		lifecycleCluster("users", "team-b"),
+		minimalCluster("legacy", "team-a"),
+	).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c}
+
+	var names []string
+	for _, request := range r.clustersForLifecycleConfigMap(context.Background(), configMapWithData("site-scripts", "team-a", nil)) {
+		names = append(names, request.String())
+	}
+	assert.Equal(t, []string{"team-a/orders"}, names)
+	assert.Empty(t, r.clustersForLifecycleConfigMap(context.Background(), configMapWithData("other", "team-a", nil)))
+}
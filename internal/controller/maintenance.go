@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// maintenanceRequeueInterval is how often server pods are relabeled while a
+// server is in maintenance. A pod that restarts in the meantime is not
+// reached through the client Service until it is labeled.
+const maintenanceRequeueInterval = 10 * time.Second
+
+// maintenanceClient is the part of the Neo4j client server maintenance uses
+type maintenanceClient interface {
+	GetServerList(ctx context.Context) ([]neo4jclient.ServerInfo, error)
+	CordonServer(ctx context.Context, server string) error
+	EnableServer(ctx context.Context, server string) error
+}
+
+// reconcileClusterMaintenance records that spec.maintenance pauses the
+// cluster. Nothing but the status is touched until it is lifted.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileClusterMaintenance(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	message := "Reconciliation paused by spec.maintenance"
+	if reason := cluster.Spec.Maintenance.Reason; reason != "" {
+		message += ": " + reason
+	}
+	if r.updateClusterStatus(ctx, cluster, "Maintenance", message) {
+		log.FromContext(ctx).Info("Cluster in maintenance, skipping reconciliation")
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonMaintenanceStarted, message)
+	}
+}
+
+// reconcileServerMaintenance drains the server pods annotated with
+// neo4j.com/maintenance=true from the client Service and cordons their
+// servers, and reverts both once the annotation is gone. The pods are
+// recorded in status.maintenanceServers, which the client Service and the
+// health checks go by. It returns true while a server is in maintenance.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileServerMaintenance(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return false, fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	var draining []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		routing := resources.RoutingEnabled
+		if resources.PodInMaintenance(pod) {
+			routing = resources.RoutingDrained
+			draining = append(draining, pod.Name)
+		}
+		if pod.Labels[resources.RoutingLabel] == routing {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[resources.RoutingLabel] = routing
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return false, fmt.Errorf("failed to label pod %s for routing: %w", pod.Name, err)
+		}
+	}
+	slices.Sort(draining)
+
+	previous := cluster.Status.MaintenanceServers
+	if len(draining) == 0 && len(previous) == 0 {
+		return false, nil
+	}
+
+	tracked, err := r.cordonMaintenanceServers(ctx, cluster, draining, previous)
+	if statusErr := r.setMaintenanceServers(ctx, cluster, tracked); statusErr != nil {
+		return true, statusErr
+	}
+	return len(tracked) > 0, err
+}
+
+// cordonMaintenanceServers cordons the servers of newly drained pods and
+// enables those of pods that left maintenance again. It returns the pods
+// still to be tracked: the drained ones and those whose server could not be
+// enabled yet.
+func (r *Neo4jEnterpriseClusterReconciler) cordonMaintenanceServers(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, draining, previous []string) ([]string, error) {
+	tracked := append([]string(nil), draining...)
+	for _, pod := range previous {
+		if !slices.Contains(tracked, pod) {
+			tracked = append(tracked, pod)
+		}
+	}
+
+	neo4jClient, closeClient, err := r.connectForMaintenance(ctx, cluster)
+	if err != nil {
+		return tracked, err
+	}
+	defer closeClient()
+
+	serverList, err := neo4jClient.GetServerList(ctx)
+	if err != nil {
+		return tracked, err
+	}
+
+	for _, pod := range draining {
+		server := serverForPod(serverList, pod)
+		if server == nil || server.State != "Enabled" {
+			continue
+		}
+		if err := neo4jClient.CordonServer(ctx, server.Name); err != nil {
+			return tracked, err
+		}
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonMaintenanceStarted,
+			fmt.Sprintf("Server %s of %s cordoned for maintenance", server.Name, pod))
+	}
+
+	tracked = append([]string(nil), draining...)
+	for _, pod := range previous {
+		if slices.Contains(draining, pod) {
+			continue
+		}
+		if server := serverForPod(serverList, pod); server != nil && server.State == "Cordoned" {
+			if err := neo4jClient.EnableServer(ctx, server.Name); err != nil {
+				tracked = append(tracked, pod)
+				return tracked, err
+			}
+		}
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonMaintenanceEnded,
+			fmt.Sprintf("%s is back from maintenance", pod))
+	}
+	return tracked, nil
+}
+
+// connectForMaintenance opens a Neo4j connection for server maintenance
+func (r *Neo4jEnterpriseClusterReconciler) connectForMaintenance(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (maintenanceClient, func(), error) {
+	if r.newMaintenanceClient != nil {
+		c, err := r.newMaintenanceClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+// setMaintenanceServers records the server pods in maintenance
+func (r *Neo4jEnterpriseClusterReconciler) setMaintenanceServers(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pods []string) error {
+	slices.Sort(pods)
+	if slices.Equal(cluster.Status.MaintenanceServers, pods) {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		latest.Status.MaintenanceServers = pods
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.MaintenanceServers = latest.Status.MaintenanceServers
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// maintenanceRequeueAfter shortens the requeue interval while a server is
+// in maintenance
+func (r *Neo4jEnterpriseClusterReconciler) maintenanceRequeueAfter(inMaintenance bool) time.Duration {
+	if inMaintenance && (r.RequeueAfter == 0 || r.RequeueAfter > maintenanceRequeueInterval) {
+		return maintenanceRequeueInterval
+	}
+	return r.RequeueAfter
+}
+
+// serverInMaintenance reports whether a server belongs to a pod in
+// maintenance
+func serverInMaintenance(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, server neo4jclient.ServerInfo) bool {
+	for _, pod := range cluster.Status.MaintenanceServers {
+		if serverForPod([]neo4jclient.ServerInfo{server}, pod) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// fakeMaintenanceClient models SHOW SERVERS for cordoning and enabling
+type fakeMaintenanceClient struct {
+	servers []neo4jclient.ServerInfo
+}
+
+func (c *fakeMaintenanceClient) GetServerList(_ context.Context) ([]neo4jclient.ServerInfo, error) {
+	return c.servers, nil
+}
+
+func (c *fakeMaintenanceClient) setState(server, state string) {
+	for i := range c.servers {
+		if c.servers[i].Name == server {
+			c.servers[i].State = state
+		}
+	}
+}
+
+func (c *fakeMaintenanceClient) CordonServer(_ context.Context, server string) error {
+	c.setState(server, "Cordoned")
+	return nil
+}
+
+func (c *fakeMaintenanceClient) EnableServer(_ context.Context, server string) error {
+	c.setState(server, "Enabled")
+	return nil
+}
+
+func TestReconcileServerMaintenance(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	pod0 := zoneTestPod("prod-server-0", "node-a")
+	pod1 := zoneTestPod("prod-server-1", "node-b")
+	pod1.Annotations = map[string]string{resources.MaintenanceAnnotation: "true"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, pod0, pod1).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	fakeClient := &fakeMaintenanceClient{servers: []neo4jclient.ServerInfo{
+		scaleDownServer("id-0", "prod-server-0"),
+		scaleDownServer("id-1", "prod-server-1"),
+	}}
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: record.NewFakeRecorder(10),
+		newMaintenanceClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (maintenanceClient, error) {
+			return fakeClient, nil
+		},
+	}
+	ctx := context.Background()
+
+	active, err := r.reconcileServerMaintenance(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.Equal(t, []string{"prod-server-1"}, cluster.Status.MaintenanceServers)
+	assert.Equal(t, "Cordoned", fakeClient.servers[1].State)
+	assert.Equal(t, "Enabled", fakeClient.servers[0].State)
+
+	pod := &corev1.Pod{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-1", Namespace: "default"}, pod))
+	assert.Equal(t, resources.RoutingDrained, pod.Labels[resources.RoutingLabel])
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-0", Namespace: "default"}, pod))
+	assert.Equal(t, resources.RoutingEnabled, pod.Labels[resources.RoutingLabel])
+
+	// The client Service only reaches routable servers now
+	service := resources.BuildClientServiceForEnterprise(cluster)
+	assert.Equal(t, resources.RoutingEnabled, service.Spec.Selector[resources.RoutingLabel])
+
+	// Removing the annotation enables the server again
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-1", Namespace: "default"}, pod))
+	delete(pod.Annotations, resources.MaintenanceAnnotation)
+	require.NoError(t, c.Update(ctx, pod))
+	active, err = r.reconcileServerMaintenance(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, active)
+	assert.Empty(t, cluster.Status.MaintenanceServers)
+	assert.Equal(t, "Enabled", fakeClient.servers[1].State)
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-1", Namespace: "default"}, pod))
+	assert.Equal(t, resources.RoutingEnabled, pod.Labels[resources.RoutingLabel])
+	assert.NotContains(t, resources.BuildClientServiceForEnterprise(cluster).Spec.Selector, resources.RoutingLabel)
+}
+
+func TestReconcileClusterMaintenance(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Maintenance = &neo4jv1alpha1.MaintenanceSpec{Enabled: true, Reason: "node upgrades"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cluster)})
+	require.NoError(t, err)
+	assert.Equal(t, r.RequeueAfter, result.RequeueAfter)
+
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "prod", Namespace: "default"}, latest))
+	assert.Equal(t, "Maintenance", latest.Status.Phase)
+	assert.Equal(t, "Reconciliation paused by spec.maintenance: node upgrades", latest.Status.Message)
+	assert.Empty(t, latest.Finalizers, "nothing is reconciled while the cluster is in maintenance")
+}
+
+func TestUpdateServersConditionSkipsMaintenance(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Status.MaintenanceServers = []string{"prod-server-1"}
+	rebooting := scaleDownServer("id-1", "prod-server-1")
+	rebooting.State, rebooting.Health = "Cordoned", "Unavailable"
+
+	qm := &QueryMonitor{}
+	qm.updateServersCondition(cluster, []neo4jclient.ServerInfo{scaleDownServer("id-0", "prod-server-0"), rebooting}, nil)
+	condition := findCondition(cluster.Status.Conditions, ConditionTypeServersHealthy)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+}
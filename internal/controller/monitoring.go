@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// monitoringAPIs maps the Prometheus Operator kinds to their optional API
+var monitoringAPIs = map[schema.GroupVersionKind]string{
+	resources.ServiceMonitorGVK: capabilities.ServiceMonitor,
+	resources.PodMonitorGVK:     capabilities.PodMonitor,
+	resources.PrometheusRuleGVK: capabilities.PrometheusRule,
+}
+
+// reconcileMonitoring keeps the monitor, PrometheusRule and Grafana
+// dashboard of spec.monitoring
+func (r *Neo4jEnterpriseClusterReconciler) reconcileMonitoring(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if err := r.reconcileMonitor(ctx, cluster); err != nil {
+		return err
+	}
+	if err := r.reconcileAlertRules(ctx, cluster); err != nil {
+		return err
+	}
+	return r.reconcileDashboard(ctx, cluster)
+}
+
+// reconcileMonitor keeps the ServiceMonitor or PodMonitor of
+// spec.monitoring.serviceMonitor, and deletes the monitor of the other kind,
+// or both when it is disabled
+func (r *Neo4jEnterpriseClusterReconciler) reconcileMonitor(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	monitor := resources.BuildMonitorForEnterprise(cluster)
+	for _, gvk := range []schema.GroupVersionKind{resources.ServiceMonitorGVK, resources.PodMonitorGVK} {
+		if monitor != nil && monitor.GroupVersionKind() == gvk {
+			continue
+		}
+		if err := r.deletePrometheusOperatorResource(ctx, cluster, gvk, resources.MonitorName(cluster)); err != nil {
+			return err
+		}
+	}
+	if monitor == nil {
+		return nil
+	}
+	return r.applyPrometheusOperatorResource(ctx, cluster, monitor)
+}
+
+// reconcileAlertRules keeps the PrometheusRule of spec.monitoring.alerts,
+// alerting on the failures of the Neo4jBackups of the cluster in its
+// namespace
+func (r *Neo4jEnterpriseClusterReconciler) reconcileAlertRules(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if !resources.AlertsEnabled(cluster) {
+		return r.deletePrometheusOperatorResource(ctx, cluster, resources.PrometheusRuleGVK, resources.PrometheusRuleName(cluster))
+	}
+
+	backupList := &neo4jv1alpha1.Neo4jBackupList{}
+	if err := r.List(ctx, backupList, client.InNamespace(cluster.Namespace)); err != nil {
+		return fmt.Errorf("failed to list backups: %w", err)
+	}
+	var backups []string
+	for _, backup := range backupList.Items {
+		target := backup.Spec.Target
+		if target.Namespace != "" && target.Namespace != cluster.Namespace {
+			continue
+		}
+		if (target.Kind == "Cluster" && target.Name == cluster.Name) || (target.Kind == "Database" && target.ClusterRef == cluster.Name) {
+			backups = append(backups, backup.Name)
+		}
+	}
+	return r.applyPrometheusOperatorResource(ctx, cluster, resources.BuildPrometheusRuleForEnterprise(cluster, backups))
+}
+
+// reconcileDashboard keeps the Grafana dashboard ConfigMap of
+// spec.monitoring.dashboards
+func (r *Neo4jEnterpriseClusterReconciler) reconcileDashboard(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	desired, err := resources.BuildDashboardConfigMapForEnterprise(cluster)
+	if err != nil {
+		return err
+	}
+	if desired == nil {
+		stale := &corev1.ConfigMap{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: resources.DashboardConfigMapName(cluster)}, stale)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get dashboard ConfigMap: %w", err)
+		}
+		if err := r.Delete(ctx, stale); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete dashboard ConfigMap: %w", err)
+		}
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: desired.ObjectMeta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		configMap.Labels = desired.Labels
+		configMap.Data = desired.Data
+		return controllerutil.SetControllerReference(cluster, configMap, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to create or update dashboard ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// applyPrometheusOperatorResource creates or updates a monitor or
+// PrometheusRule, unless its API is not served
+func (r *Neo4jEnterpriseClusterReconciler) applyPrometheusOperatorResource(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, obj *unstructured.Unstructured) error {
+	logger := log.FromContext(ctx)
+
+	kind := obj.GetKind()
+	if !r.Capabilities.Has(monitoringAPIs[obj.GroupVersionKind()]) {
+		logger.V(1).Info("Prometheus Operator API not served; skipping", "kind", kind)
+		return nil
+	}
+	if err := controllerutil.SetControllerReference(cluster, obj, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference on %s: %w", kind, err)
+	}
+	desired := obj.DeepCopy()
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		obj.SetLabels(desired.GetLabels())
+		obj.Object["spec"] = desired.Object["spec"]
+		return nil
+	}); err != nil {
+		if meta.IsNoMatchError(err) {
+			logger.Info("Prometheus Operator API not available; skipping", "kind", kind)
+			return nil
+		}
+		return fmt.Errorf("failed to create or update %s: %w", kind, err)
+	}
+	return nil
+}
+
+// deletePrometheusOperatorResource deletes a monitor or PrometheusRule of a
+// cluster, if its API is served
+func (r *Neo4jEnterpriseClusterReconciler) deletePrometheusOperatorResource(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, gvk schema.GroupVersionKind, name string) error {
+	if !r.Capabilities.Has(monitoringAPIs[gvk]) {
+		return nil
+	}
+	stale := &unstructured.Unstructured{}
+	stale.SetGroupVersionKind(gvk)
+	stale.SetName(name)
+	stale.SetNamespace(cluster.Namespace)
+	if err := r.Delete(ctx, stale); err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to delete %s: %w", gvk.Kind, err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func getMonitor(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(gvk)
+	return c.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: resources.MonitorName(cluster)}, monitor)
+}
+
+func TestReconcileMonitor(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("scraped", "default")
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	require.NoError(t, r.reconcileMonitor(ctx, cluster))
+	require.NoError(t, getMonitor(ctx, c, resources.ServiceMonitorGVK, cluster))
+
+	// Switching the kind replaces the monitor
+	cluster.Spec.Monitoring.ServiceMonitor.Kind = "PodMonitor"
+	require.NoError(t, r.reconcileMonitor(ctx, cluster))
+	require.NoError(t, getMonitor(ctx, c, resources.PodMonitorGVK, cluster))
+	assert.True(t, errors.IsNotFound(getMonitor(ctx, c, resources.ServiceMonitorGVK, cluster)))
+
+	cluster.Spec.Monitoring.ServiceMonitor.Enabled = false
+	require.NoError(t, r.reconcileMonitor(ctx, cluster))
+	assert.True(t, errors.IsNotFound(getMonitor(ctx, c, resources.PodMonitorGVK, cluster)))
+}
+
+func TestReconcileMonitorWithoutPrometheusOperator(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("unscraped", "default")
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:       c,
+		Scheme:       c.Scheme(),
+		Capabilities: &capabilities.Capabilities{APIs: map[string]bool{capabilities.ServiceMonitor: false, capabilities.PodMonitor: false}},
+	}
+
+	require.NoError(t, r.reconcileMonitor(ctx, cluster))
+	assert.True(t, errors.IsNotFound(getMonitor(ctx, c, resources.ServiceMonitorGVK, cluster)))
+	assert.Equal(t, []string{capabilities.ServiceMonitor}, clusterOptionalAPIs(cluster))
+}
+
+func TestMetricsPortAddedToExistingHeadlessService(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("scraped", "default")
+	existing := resources.BuildHeadlessServiceForEnterprise(cluster)
+	existing.Spec.Ports[0].NodePort = 30687
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, existing).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true}}
+	require.NoError(t, r.createOrUpdateResource(ctx, resources.BuildHeadlessServiceForEnterprise(cluster), cluster))
+
+	svc := &corev1.Service{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(existing), svc))
+	assert.Equal(t, "metrics", svc.Spec.Ports[len(svc.Spec.Ports)-1].Name)
+	// Allocated node ports are kept
+	assert.Equal(t, int32(30687), svc.Spec.Ports[0].NodePort)
+}
+
+func TestReconcileAlertRulesAndDashboard(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("charted", "default")
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{
+		ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true},
+		Alerts:         &neo4jv1alpha1.MonitoringAlertsSpec{Enabled: true},
+		Dashboards:     &neo4jv1alpha1.MonitoringDashboardsSpec{Enabled: true},
+	}
+	backup := &neo4jv1alpha1.Neo4jBackup{}
+	backup.Name = "nightly"
+	backup.Namespace = "default"
+	backup.Spec.Target = neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "charted"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, backup).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+
+	require.NoError(t, r.reconcileMonitoring(ctx, cluster))
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(resources.PrometheusRuleGVK)
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "charted-alerts"}, rule))
+	assert.Contains(t, fmt.Sprint(rule.Object["spec"]), "Neo4jBackupFailed")
+	dashboard := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "charted-dashboard"}, dashboard))
+	assert.Equal(t, "1", dashboard.Labels["grafana_dashboard"])
+
+	cluster.Spec.Monitoring.Alerts.Enabled = false
+	cluster.Spec.Monitoring.Dashboards.Enabled = false
+	require.NoError(t, r.reconcileMonitoring(ctx, cluster))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(rule), rule)))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(dashboard), dashboard)))
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -238,3 +239,131 @@ func TestBuildCloudEnvVars_NilCredentialsSecretRef(t *testing.T) {
 	envs := r.buildCloudEnvVars(backup)
 	assert.Nil(t, envs)
 }
+
+// ─── Proxy / custom CA ──────────────────────────────────────────────────────
+
+func TestBuildCloudEnvVars_ProxyWithoutCredentials(t *testing.T) {
+	r := newReconcilerForCloudTest()
+	// Workload identity behind an egress proxy: no credentials, but proxy vars.
+	backup := backupWithCloud(&neo4jv1alpha1.CloudBlock{
+		Provider: "aws",
+		Proxy: &neo4jv1alpha1.ProxySpec{
+			HTTPProxy:  "http://proxy.corp.example:3128",
+			HTTPSProxy: "http://proxy.corp.example:3128",
+			NoProxy:    "localhost,.svc,.cluster.local",
+		},
+	})
+
+	envs := r.buildCloudEnvVars(backup)
+
+	require.NotNil(t, envs)
+	m := envVarMap(envs)
+	assert.NotContains(t, m, "AWS_ACCESS_KEY_ID")
+	assert.Equal(t, "http://proxy.corp.example:3128", m["HTTP_PROXY"])
+	assert.Equal(t, "http://proxy.corp.example:3128", m["https_proxy"])
+	assert.Equal(t, "localhost,.svc,.cluster.local", m["NO_PROXY"])
+	assert.Equal(t, "localhost,.svc,.cluster.local", m["no_proxy"])
+	assert.Equal(t,
+		"-Dhttp.proxyHost=proxy.corp.example -Dhttp.proxyPort=3128 "+
+			"-Dhttps.proxyHost=proxy.corp.example -Dhttps.proxyPort=3128 "+
+			"-Dhttp.nonProxyHosts=localhost|*.svc|*.cluster.local",
+		m["JAVA_TOOL_OPTIONS"])
+}
+
+func TestBuildCloudEnvVars_JavaToolOptionsMerged(t *testing.T) {
+	r := newReconcilerForCloudTest()
+	backup := backupWithCloud(&neo4jv1alpha1.CloudBlock{
+		Provider:             "aws",
+		CredentialsSecretRef: "minio-secret",
+		EndpointURL:          "https://minio.example.com",
+		ForcePathStyle:       true,
+		Proxy:                &neo4jv1alpha1.ProxySpec{HTTPSProxy: "https://proxy.corp.example"},
+		CABundle:             &neo4jv1alpha1.SecretKeySelector{Name: "corp-ca", Key: "bundle.pem"},
+	})
+
+	envs := r.buildCloudEnvVars(backup)
+
+	count := 0
+	for _, e := range envs {
+		if e.Name == "JAVA_TOOL_OPTIONS" {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count, "JAVA_TOOL_OPTIONS must be set exactly once")
+	assert.Equal(t,
+		"-Daws.s3.forcePathStyle=true "+
+			"-Dhttps.proxyHost=proxy.corp.example -Dhttps.proxyPort=443 "+
+			"-Djavax.net.ssl.trustStore=/tmp/cloud-truststore.jks -Djavax.net.ssl.trustStorePassword=changeit",
+		envVarMap(envs)["JAVA_TOOL_OPTIONS"])
+}
+
+func TestBackupJob_CABundleMountedAndImported(t *testing.T) {
+	r := newReconcilerForCloudTest()
+	backup := backupWithCloud(&neo4jv1alpha1.CloudBlock{
+		Provider: "aws",
+		CABundle: &neo4jv1alpha1.SecretKeySelector{Name: "corp-ca", Key: "bundle.pem"},
+	})
+	backup.Name = "nightly"
+	backup.Spec.Target = neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "prod"}
+	backup.Spec.Storage.Type = "s3"
+	backup.Spec.Storage.Bucket = "backups"
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	cluster.Name = "prod"
+	cluster.Spec.Image = neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"}
+	cluster.Spec.Topology.Servers = 3
+
+	var caVolume *corev1.Volume
+	volumes := r.buildVolumes(backup)
+	for i := range volumes {
+		if volumes[i].Name == cloudCAVolumeName {
+			caVolume = &volumes[i]
+		}
+	}
+	require.NotNil(t, caVolume)
+	require.NotNil(t, caVolume.Secret)
+	assert.Equal(t, "corp-ca", caVolume.Secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "bundle.pem", Path: "ca.crt"}}, caVolume.Secret.Items)
+
+	assert.Contains(t, r.buildVolumeMounts(backup), corev1.VolumeMount{
+		Name: cloudCAVolumeName, MountPath: cloudCAMountPath, ReadOnly: true,
+	})
+
+	cmd, err := r.buildBackupCommand(backup, cluster)
+	require.NoError(t, err)
+	assert.Contains(t, cmd, "keytool -importcert -noprompt")
+	assert.Contains(t, cmd, "/var/secrets/cloud-ca/ca.crt")
+	// The truststore must be built before neo4j-admin runs.
+	assert.Less(t, strings.Index(cmd, "keytool"), strings.Index(cmd, "neo4j-admin"))
+}
+
+func TestRestoreJob_ProxyAndCAFromSourceStorage(t *testing.T) {
+	restore := &neo4jv1alpha1.Neo4jRestore{}
+	restore.Spec.Source = neo4jv1alpha1.RestoreSource{
+		Type: "storage",
+		Storage: &neo4jv1alpha1.StorageLocation{
+			Type:   "s3",
+			Bucket: "backups",
+			Cloud: &neo4jv1alpha1.CloudBlock{
+				Provider: "aws",
+				Proxy:    &neo4jv1alpha1.ProxySpec{HTTPSProxy: "http://proxy.corp.example:3128"},
+				CABundle: &neo4jv1alpha1.SecretKeySelector{Name: "corp-ca", Key: "ca.crt"},
+			},
+		},
+	}
+
+	cloud := cloudBlockForRestore(restore)
+	require.NotNil(t, cloud)
+	m := envVarMap(cloudEgressEnvVars(cloud))
+	assert.Equal(t, "http://proxy.corp.example:3128", m["HTTPS_PROXY"])
+	assert.Contains(t, m["JAVA_TOOL_OPTIONS"], "-Djavax.net.ssl.trustStore=")
+
+	r := &Neo4jRestoreReconciler{}
+	assert.Contains(t, r.buildRestoreVolumeMounts(restore), corev1.VolumeMount{
+		Name: cloudCAVolumeName, MountPath: cloudCAMountPath, ReadOnly: true,
+	})
+	found := false
+	for _, v := range r.buildRestoreVolumes(restore) {
+		found = found || v.Name == cloudCAVolumeName
+	}
+	assert.True(t, found, "restore job should mount the CA bundle secret")
+}
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // Neo4jBackupReconciler reconciles a Neo4jBackup object
@@ -86,6 +88,7 @@ func GetTestRequeueAfter() time.Duration {
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=pods/exec,verbs=create;get
 //+kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
 // Reconcile handles the reconciliation of Neo4jBackup resources
 func (r *Neo4jBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -117,6 +120,18 @@ func (r *Neo4jBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Wait for the resources of spec.dependsOn
+	waiting, err := waitingDependency(ctx, r.Client, backup, backup.Spec.DependsOn)
+	if err != nil {
+		logger.Error(err, "Failed to check dependencies")
+		return ctrl.Result{}, err
+	}
+	if waiting != "" {
+		setDependenciesCondition(ctx, r.Client, backup, waiting)
+		r.updateBackupStatus(ctx, backup, "Waiting", waiting)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
 	// Get target cluster
 	targetCluster, err := r.getTargetCluster(ctx, backup)
 	if err != nil {
@@ -132,11 +147,25 @@ func (r *Neo4jBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	// The servers have to listen for backups
+	if !resources.BackupPortEnabled(targetCluster) {
+		r.updateBackupStatus(ctx, backup, "Failed",
+			fmt.Sprintf("Cluster %s disables its backup port (spec.backupPort.enabled=false)", targetCluster.Name))
+		return ctrl.Result{}, nil
+	}
+
 	// Check if cluster is ready
 	if !r.isClusterReady(targetCluster) {
+		setDependenciesCondition(ctx, r.Client, backup, "Target cluster is not ready")
 		r.updateBackupStatus(ctx, backup, "Waiting", "Target cluster is not ready")
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
+	setDependenciesCondition(ctx, r.Client, backup, "")
+
+	// Run a requested storage benchmark alongside the regular backups
+	if err := r.reconcileBenchmark(ctx, backup, targetCluster); err != nil {
+		logger.Error(err, "Failed to reconcile backup benchmark")
+	}
 
 	// Handle scheduled backups
 	if backup.Spec.Schedule != "" {
@@ -259,7 +288,7 @@ func (r *Neo4jBackupReconciler) handleExistingBackupJob(ctx context.Context, bac
 
 	if job.Status.Failed > 0 {
 		// Backup failed
-		r.updateBackupStatus(ctx, backup, "Failed", "Backup job failed")
+		r.updateBackupStatus(ctx, backup, "Failed", jobFailureMessage(ctx, r.Client, job, "Backup job failed"))
 		r.Recorder.Event(backup, corev1.EventTypeWarning, EventReasonBackupFailed, "Backup job failed")
 		backupM.RecordBackup(ctx, false, time.Since(backupStart), 0)
 		return ctrl.Result{}, nil
@@ -280,7 +309,7 @@ func (r *Neo4jBackupReconciler) createBackupJob(ctx context.Context, backup *neo
 	}
 	logger.Info("Running backup command", "cmd", backupCmd)
 
-	image := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
+	image := resources.ImageReference(cluster.Spec.Image)
 	backoffLimit := int32(3)
 
 	job := &batchv1.Job{
@@ -297,17 +326,22 @@ func (r *Neo4jBackupReconciler) createBackupJob(ctx context.Context, backup *neo
 		Spec: batchv1.JobSpec{
 			BackoffLimit: &backoffLimit,
 			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: maps.Clone(resources.BackupJobPodLabels)},
 				Spec: corev1.PodSpec{
 					RestartPolicy:      corev1.RestartPolicyNever,
 					ServiceAccountName: backupServiceAccountName,
+					ImagePullSecrets:   resources.ImagePullSecrets(cluster.Spec.Image),
 					Containers: []corev1.Container{
 						{
-							Name:         "backup",
-							Image:        image,
-							Command:      []string{"/bin/sh"},
-							Args:         []string{"-c", backupCmd},
-							Env:          r.buildCloudEnvVars(backup),
-							VolumeMounts: r.buildVolumeMounts(backup),
+							Name:            "backup",
+							Image:           image,
+							ImagePullPolicy: resources.ImagePullPolicy(cluster.Spec.Image),
+							Command:         []string{"/bin/sh"},
+							Args:            []string{"-c", backupCmd},
+							// Report the log tail of a failed backup in its pod status
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							Env:                      r.buildCloudEnvVars(backup),
+							VolumeMounts:             r.buildVolumeMounts(backup),
 						},
 					},
 					Volumes: r.buildVolumes(backup),
@@ -316,6 +350,8 @@ func (r *Neo4jBackupReconciler) createBackupJob(ctx context.Context, backup *neo
 		},
 	}
 
+	resources.ApplySecurityProfiles(&job.Spec.Template, cluster.Spec.SecurityContext)
+
 	if err := controllerutil.SetControllerReference(backup, job, r.Scheme); err != nil {
 		return nil, err
 	}
@@ -333,7 +369,7 @@ func (r *Neo4jBackupReconciler) createBackupCronJob(ctx context.Context, backup
 		return nil, fmt.Errorf("failed to build backup command: %w", err)
 	}
 
-	image := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
+	image := resources.ImageReference(cluster.Spec.Image)
 	backoffLimit := int32(3)
 
 	cronJob := &batchv1.CronJob{
@@ -355,17 +391,22 @@ func (r *Neo4jBackupReconciler) createBackupCronJob(ctx context.Context, backup
 			Spec: batchv1.JobSpec{
 				BackoffLimit: &backoffLimit,
 				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: maps.Clone(resources.BackupJobPodLabels)},
 					Spec: corev1.PodSpec{
 						RestartPolicy:      corev1.RestartPolicyNever,
 						ServiceAccountName: backupServiceAccountName,
+						ImagePullSecrets:   resources.ImagePullSecrets(cluster.Spec.Image),
 						Containers: []corev1.Container{
 							{
-								Name:         "backup",
-								Image:        image,
-								Command:      []string{"/bin/sh"},
-								Args:         []string{"-c", backupCmd},
-								Env:          r.buildCloudEnvVars(backup),
-								VolumeMounts: r.buildVolumeMounts(backup),
+								Name:            "backup",
+								Image:           image,
+								ImagePullPolicy: resources.ImagePullPolicy(cluster.Spec.Image),
+								Command:         []string{"/bin/sh"},
+								Args:            []string{"-c", backupCmd},
+								// Report the log tail of a failed backup in its pod status
+								TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+								Env:                      r.buildCloudEnvVars(backup),
+								VolumeMounts:             r.buildVolumeMounts(backup),
 							},
 						},
 						Volumes: r.buildVolumes(backup),
@@ -373,6 +414,7 @@ func (r *Neo4jBackupReconciler) createBackupCronJob(ctx context.Context, backup
 				},
 			},
 		}
+		resources.ApplySecurityProfiles(&cronJob.Spec.JobTemplate.Spec.Template, cluster.Spec.SecurityContext)
 		return controllerutil.SetControllerReference(backup, cronJob, r.Scheme)
 	})
 	if err != nil {
@@ -383,23 +425,23 @@ func (r *Neo4jBackupReconciler) createBackupCronJob(ctx context.Context, backup
 
 func (r *Neo4jBackupReconciler) buildBackupCommand(backup *neo4jv1alpha1.Neo4jBackup, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (string, error) {
 	imageTag := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
-	version, err := neo4j.GetImageVersion(imageTag)
+	imageVersion, err := version.FromImage(imageTag)
 	if err != nil {
-		version = &neo4j.Version{Major: 5, Minor: 26, Patch: 0}
+		imageVersion = &version.Version{Major: 5, Minor: 26, Patch: 0}
 	}
 
 	// Validate version-gated flags individually.
 	if backup.Spec.Options != nil {
-		if backup.Spec.Options.ParallelDownload && !version.SupportsParallelDownload() {
+		if backup.Spec.Options.ParallelDownload && !imageVersion.SupportsParallelDownload() {
 			return "", fmt.Errorf("--parallel-download requires CalVer 2025.11+ (image: %s)", cluster.Spec.Image.Tag)
 		}
-		if backup.Spec.Options.RemoteAddressResolution && !version.SupportsRemoteAddressResolution() {
+		if backup.Spec.Options.RemoteAddressResolution && !imageVersion.SupportsRemoteAddressResolution() {
 			return "", fmt.Errorf("--remote-address-resolution requires CalVer 2025.09+ (image: %s)", cluster.Spec.Image.Tag)
 		}
-		if backup.Spec.Options.SkipRecovery && !version.SupportsSkipRecovery() {
+		if backup.Spec.Options.SkipRecovery && !imageVersion.SupportsSkipRecovery() {
 			return "", fmt.Errorf("--skip-recovery requires CalVer 2025.11+ (image: %s)", cluster.Spec.Image.Tag)
 		}
-		if backup.Spec.Options.PreferDiffAsParent && !version.SupportsPreferDiffAsParent() {
+		if backup.Spec.Options.PreferDiffAsParent && !imageVersion.SupportsPreferDiffAsParent() {
 			return "", fmt.Errorf("--prefer-diff-as-parent requires CalVer 2025.04+ (image: %s)", cluster.Spec.Image.Tag)
 		}
 	}
@@ -412,7 +454,7 @@ func (r *Neo4jBackupReconciler) buildBackupCommand(backup *neo4jv1alpha1.Neo4jBa
 		dbName = backup.Spec.Target.Name
 	}
 
-	cmd := neo4j.GetBackupCommand(version, dbName, toPath, allDatabases, fromAddresses)
+	cmd := neo4j.GetBackupCommand(imageVersion, dbName, toPath, allDatabases, fromAddresses)
 
 	if backup.Spec.Options != nil {
 		if backup.Spec.Options.BackupType != "" {
@@ -448,7 +490,7 @@ func (r *Neo4jBackupReconciler) buildBackupCommand(backup *neo4jv1alpha1.Neo4jBa
 		cmd = fmt.Sprintf("mkdir -p %s && %s", toPath, cmd)
 	}
 
-	return cmd, nil
+	return withCloudTrustStore(cloudBlockForBackup(backup), cmd), nil
 }
 
 // buildToPath returns the --to-path value: a cloud URI for cloud storage or a
@@ -481,12 +523,22 @@ func cloudBlockForBackup(backup *neo4jv1alpha1.Neo4jBackup) *neo4jv1alpha1.Cloud
 }
 
 // buildCloudEnvVars injects cloud provider credentials from a Kubernetes Secret
-// into the backup job container as environment variables.
-// When CredentialsSecretRef is empty the function returns nil, which means the
-// Job relies on ambient cloud identity (IRSA, GKE Workload Identity, etc.).
+// into the backup job container as environment variables, followed by any
+// proxy and truststore settings from the cloud block.
+// When CredentialsSecretRef is empty no credentials are injected, which means
+// the Job relies on ambient cloud identity (IRSA, GKE Workload Identity, etc.).
 func (r *Neo4jBackupReconciler) buildCloudEnvVars(backup *neo4jv1alpha1.Neo4jBackup) []corev1.EnvVar {
 	cloud := cloudBlockForBackup(backup)
-	if cloud == nil || cloud.CredentialsSecretRef == "" {
+	if cloud == nil {
+		return nil
+	}
+	return append(buildCloudCredentialEnvVars(cloud), cloudEgressEnvVars(cloud)...)
+}
+
+// buildCloudCredentialEnvVars maps the provider-specific keys of
+// CredentialsSecretRef onto the environment variables each SDK reads.
+func buildCloudCredentialEnvVars(cloud *neo4jv1alpha1.CloudBlock) []corev1.EnvVar {
+	if cloud.CredentialsSecretRef == "" {
 		return nil
 	}
 	ref := cloud.CredentialsSecretRef
@@ -510,15 +562,8 @@ func (r *Neo4jBackupReconciler) buildCloudEnvVars(backup *neo4jv1alpha1.Neo4jBac
 				Value: cloud.EndpointURL,
 			})
 		}
-		// Path-style addressing is required for MinIO and most self-hosted stores.
-		// neo4j-admin runs as a JVM process; JAVA_TOOL_OPTIONS is read by the JVM
-		// before main() so this system property reaches the AWS SDK reliably.
-		if cloud.ForcePathStyle {
-			envVars = append(envVars, corev1.EnvVar{
-				Name:  "JAVA_TOOL_OPTIONS",
-				Value: "-Daws.s3.forcePathStyle=true",
-			})
-		}
+		// Path-style addressing is passed via JAVA_TOOL_OPTIONS together with
+		// the proxy settings; see cloudJavaToolOptions.
 		return envVars
 	case "azure":
 		return []corev1.EnvVar{
@@ -549,7 +594,7 @@ func (r *Neo4jBackupReconciler) buildVolumeMounts(backup *neo4jv1alpha1.Neo4jBac
 		})
 	}
 
-	return mounts
+	return append(mounts, cloudCAVolumeMounts(cloud)...)
 }
 
 func (r *Neo4jBackupReconciler) buildVolumes(backup *neo4jv1alpha1.Neo4jBackup) []corev1.Volume {
@@ -592,7 +637,7 @@ func (r *Neo4jBackupReconciler) buildVolumes(backup *neo4jv1alpha1.Neo4jBackup)
 		})
 	}
 
-	return volumes
+	return append(volumes, cloudCAVolumes(cloud)...)
 }
 
 func (r *Neo4jBackupReconciler) getTargetCluster(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup) (*neo4jv1alpha1.Neo4jEnterpriseCluster, error) {
@@ -787,11 +832,13 @@ func parseFindTimeArg(maxAge string) string {
 }
 
 func (r *Neo4jBackupReconciler) updateBackupStatus(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup, phase, message string) {
+	var previousPhase string
 	update := func() error {
 		latest := &neo4jv1alpha1.Neo4jBackup{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(backup), latest); err != nil {
 			return err
 		}
+		previousPhase = latest.Status.Phase
 		latest.Status.Phase = phase
 		latest.Status.Message = message
 		condStatus, condReason := PhaseToConditionStatus(phase)
@@ -808,7 +855,9 @@ func (r *Neo4jBackupReconciler) updateBackupStatus(ctx context.Context, backup *
 	err := retry.RetryOnConflict(retry.DefaultBackoff, update)
 	if err != nil {
 		log.FromContext(ctx).Error(err, "Failed to update backup status")
+		return
 	}
+	recordPhaseChange(r.Recorder, backup, previousPhase, phase, message)
 }
 
 func (r *Neo4jBackupReconciler) updateBackupStats(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup, job *batchv1.Job) {
@@ -908,6 +957,7 @@ func (r *Neo4jBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&batchv1.CronJob{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
 		}).
-		Complete(r)
+		Complete(observeReconcileErrors("neo4jbackup", r))
 }
@@ -0,0 +1,484 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// Neo4jCDCReconciler reconciles a Neo4jCDC object
+type Neo4jCDCReconciler struct {
+	client.Client
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	MaxConcurrentReconciles int
+	RequeueAfter            time.Duration
+
+	// readOffset returns the change identifier a connector last published;
+	// nil reads it from the Kafka Connect REST API
+	readOffset func(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, connector string) (string, error)
+}
+
+const (
+	// cdcLagInterval is how often the lag of running connectors is measured
+	cdcLagInterval = 30 * time.Second
+	// cdcCheckInterval is how often the enrichment mode of the databases is
+	// checked when no connector runs
+	cdcCheckInterval = 5 * time.Minute
+)
+
+// cdcHTTPClient reads connector offsets from the Kafka Connect REST API.
+var cdcHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// cdcClient is the part of the Neo4j client that manages change data capture.
+type cdcClient interface {
+	GetTxLogEnrichment(ctx context.Context, databaseName string) (string, error)
+	SetTxLogEnrichment(ctx context.Context, databaseName, mode string) error
+	OldestChangeAfter(ctx context.Context, databaseName, changeID string) (time.Time, error)
+}
+
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jcdcs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jcdcs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jcdcs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile sets the transaction log enrichment of the databases, runs a
+// Kafka Connect worker for the databases that list topics and measures how
+// far each connector lags behind the changes of its database. Deleting a
+// Neo4jCDC removes the worker but leaves the enrichment of the databases in
+// place, as turning it off discards the captured changes.
+func (r *Neo4jCDCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cdc := &neo4jv1alpha1.Neo4jCDC{}
+	if err := r.Get(ctx, req.NamespacedName, cdc); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Neo4jCDC resource not found")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Neo4jCDC")
+		return ctrl.Result{}, err
+	}
+	if cdc.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	target, err := getClusterRefTarget(ctx, r.Client, cdc.Namespace, cdc.Spec.ClusterRef)
+	if err != nil {
+		r.updateCDCStatus(ctx, cdc, "Pending", err.Error(), nil, false)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	if !target.ready {
+		r.updateCDCStatus(ctx, cdc, "Pending", "Target deployment is not ready", nil, false)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	neo4jClient, err := connectClusterRef(ctx, r.Client, cdc.Namespace, cdc.Spec.ClusterRef)
+	if err != nil {
+		r.updateCDCStatus(ctx, cdc, "Pending", err.Error(), nil, false)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	defer neo4jClient.Close()
+
+	results := r.enableCDC(ctx, neo4jClient, cdc)
+
+	connectorReady := false
+	if cdcConnectorEnabled(cdc) {
+		if err := r.reconcileConnector(ctx, cdc, target); err != nil {
+			logger.Error(err, "Failed to reconcile CDC connector")
+			r.failCDC(ctx, cdc, fmt.Sprintf("Failed to reconcile connector: %v", err), results)
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+		}
+		if connectorReady, err = r.connectorReady(ctx, cdc); err != nil {
+			return ctrl.Result{}, err
+		}
+		if connectorReady {
+			r.measureCDCLag(ctx, neo4jClient, cdc, results)
+		}
+	} else if err := r.deleteConnector(ctx, cdc); err != nil {
+		return ctrl.Result{}, err
+	}
+	forgetDroppedCDCLag(cdc)
+
+	requeue := cdcCheckInterval
+	if cdcConnectorEnabled(cdc) {
+		requeue = cdcLagInterval
+	}
+
+	var failed []string
+	for _, result := range results {
+		if !result.Enabled {
+			failed = append(failed, result.Name)
+		}
+	}
+	switch {
+	case len(failed) > 0:
+		r.failCDC(ctx, cdc, fmt.Sprintf("Change data capture is not enabled on %s", strings.Join(failed, ", ")), results)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	case cdcConnectorEnabled(cdc) && !connectorReady:
+		r.updateCDCStatus(ctx, cdc, "Enabling", "Waiting for the Kafka Connect worker to become ready", results, false)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	message := fmt.Sprintf("Change data capture is enabled on %d databases", len(results))
+	if connectorReady {
+		message += fmt.Sprintf(", %d published to Kafka", len(resources.CDCPublishedDatabases(cdc)))
+	}
+	r.updateCDCStatus(ctx, cdc, "Ready", message, results, connectorReady)
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+func cdcConnectorEnabled(cdc *neo4jv1alpha1.Neo4jCDC) bool {
+	return cdc.Spec.Connector != nil && len(resources.CDCPublishedDatabases(cdc)) > 0
+}
+
+func cdcEnrichmentMode(database neo4jv1alpha1.CDCDatabase) string {
+	if database.EnrichmentMode != "" {
+		return database.EnrichmentMode
+	}
+	return "FULL"
+}
+
+// enableCDC sets the enrichment mode of every database of the spec that runs
+// with another one. A database that fails is reported in its result and
+// does not stop the others.
+func (r *Neo4jCDCReconciler) enableCDC(ctx context.Context, c cdcClient, cdc *neo4jv1alpha1.Neo4jCDC) []neo4jv1alpha1.CDCDatabaseStatus {
+	logger := log.FromContext(ctx)
+
+	results := make([]neo4jv1alpha1.CDCDatabaseStatus, 0, len(cdc.Spec.Databases))
+	for _, database := range cdc.Spec.Databases {
+		mode := cdcEnrichmentMode(database)
+		result := neo4jv1alpha1.CDCDatabaseStatus{Name: database.Name}
+
+		current, err := c.GetTxLogEnrichment(ctx, database.Name)
+		if err == nil && current != mode {
+			logger.Info("Setting transaction log enrichment", "database", database.Name, "from", current, "to", mode)
+			if err = c.SetTxLogEnrichment(ctx, database.Name, mode); err == nil {
+				r.Recorder.Eventf(cdc, corev1.EventTypeNormal, EventReasonCDCEnabled,
+					"Set transaction log enrichment of database %s to %s", database.Name, mode)
+				current = mode
+			}
+		}
+		if err != nil {
+			logger.Error(err, "Failed to enable change data capture", "database", database.Name)
+			result.Message = err.Error()
+		}
+		result.EnrichmentMode = current
+		result.Enabled = err == nil && current == mode
+		results = append(results, result)
+	}
+	return results
+}
+
+// reconcileConnector creates or updates the ConfigMap, Service and
+// StatefulSet of the Kafka Connect worker.
+func (r *Neo4jCDCReconciler) reconcileConnector(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, target *workloadTarget) error {
+	credentials := cdc.Spec.Connector.CredentialsSecret
+	if credentials == "" {
+		credentials = target.adminSecret
+	}
+
+	configMap := resources.BuildCDCConnectorConfigMap(cdc, target.uri)
+	service := resources.BuildCDCConnectorService(cdc)
+	statefulSet := resources.BuildCDCConnectorStatefulSet(cdc, configMap, credentials)
+
+	desired := []client.Object{configMap, service, statefulSet}
+	for _, obj := range desired {
+		if err := r.createOrUpdateOwned(ctx, cdc, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createOrUpdateOwned creates obj or copies the spec of obj onto the
+// existing object, leaving fields set by the API server in place.
+func (r *Neo4jCDCReconciler) createOrUpdateOwned(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, obj client.Object) error {
+	var mutate func()
+	switch desired := obj.(type) {
+	case *corev1.ConfigMap:
+		data := desired.Data
+		mutate = func() { desired.Data = data }
+	case *corev1.Service:
+		ports, selector := desired.Spec.Ports, desired.Spec.Selector
+		mutate = func() {
+			desired.Spec.Ports = ports
+			desired.Spec.Selector = selector
+		}
+	case *appsv1.StatefulSet:
+		// The selector and volume claims of a StatefulSet are immutable
+		template := desired.Spec.Template
+		mutate = func() { desired.Spec.Template = template }
+	}
+	labels := obj.GetLabels()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+			obj.SetLabels(labels)
+			mutate()
+			return controllerutil.SetControllerReference(cdc, obj, r.Scheme)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create or update %T %s: %w", obj, obj.GetName(), err)
+		}
+		return nil
+	})
+}
+
+// deleteConnector removes the Kafka Connect worker once no database is
+// published any more. The offsets volume is kept, so the connectors resume
+// where they stopped when publishing is configured again.
+func (r *Neo4jCDCReconciler) deleteConnector(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC) error {
+	name := resources.CDCConnectorName(cdc)
+	owned := []client.Object{
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cdc.Namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cdc.Namespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cdc.Namespace}},
+	}
+	for _, obj := range owned {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !metav1.IsControlledBy(obj, cdc) {
+			continue
+		}
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %T %s: %w", obj, obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (r *Neo4jCDCReconciler) connectorReady(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC) (bool, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	key := client.ObjectKey{Name: resources.CDCConnectorName(cdc), Namespace: cdc.Namespace}
+	if err := r.Get(ctx, key, statefulSet); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return statefulSet.Status.ReadyReplicas > 0 &&
+		statefulSet.Status.ObservedGeneration >= statefulSet.Generation, nil
+}
+
+// measureCDCLag sets the lag of every published database to the age of the
+// oldest change its connector has not published yet. A connector that has
+// not committed an offset yet has no lag.
+func (r *Neo4jCDCReconciler) measureCDCLag(ctx context.Context, c cdcClient, cdc *neo4jv1alpha1.Neo4jCDC, results []neo4jv1alpha1.CDCDatabaseStatus) {
+	logger := log.FromContext(ctx)
+	readOffset := r.readOffset
+	if readOffset == nil {
+		readOffset = readConnectorOffset
+	}
+	cdcMetrics := metrics.NewCDCMetrics(cdc.Name, cdc.Namespace)
+
+	published := make(map[string]bool)
+	for _, database := range resources.CDCPublishedDatabases(cdc) {
+		published[database.Name] = true
+	}
+	now := time.Now()
+	for i := range results {
+		result := &results[i]
+		if !published[result.Name] || !result.Enabled {
+			continue
+		}
+		changeID, err := readOffset(ctx, cdc, resources.CDCConnectorInstanceName(cdc, result.Name))
+		if err != nil {
+			logger.Info("Failed to read connector offset", "database", result.Name, "error", err.Error())
+			result.Message = fmt.Sprintf("Failed to read connector offset: %v", err)
+			continue
+		}
+		if changeID == "" {
+			continue
+		}
+		oldest, err := c.OldestChangeAfter(ctx, result.Name, changeID)
+		if err != nil {
+			logger.Info("Failed to measure connector lag", "database", result.Name, "error", err.Error())
+			result.Message = fmt.Sprintf("Failed to measure lag: %v", err)
+			continue
+		}
+		lag := time.Duration(0)
+		if !oldest.IsZero() && now.After(oldest) {
+			lag = now.Sub(oldest)
+		}
+		seconds := int64(lag.Seconds())
+		result.LagSeconds = &seconds
+		cdcMetrics.RecordLag(result.Name, lag)
+	}
+	checked := metav1.NewTime(now)
+	cdc.Status.LastLagCheckTime = &checked
+}
+
+// forgetDroppedCDCLag removes the lag metrics of databases that were
+// published in the last status but are not any more.
+func forgetDroppedCDCLag(cdc *neo4jv1alpha1.Neo4jCDC) {
+	published := make(map[string]bool)
+	if cdc.Spec.Connector != nil {
+		for _, database := range resources.CDCPublishedDatabases(cdc) {
+			published[database.Name] = true
+		}
+	}
+	cdcMetrics := metrics.NewCDCMetrics(cdc.Name, cdc.Namespace)
+	for _, result := range cdc.Status.Databases {
+		if result.LagSeconds != nil && !published[result.Name] {
+			cdcMetrics.ForgetLag(result.Name)
+		}
+	}
+}
+
+// connectorOffsets is the response of GET /connectors/{name}/offsets.
+type connectorOffsets struct {
+	Offsets []struct {
+		Offset map[string]interface{} `json:"offset"`
+	} `json:"offsets"`
+}
+
+// readConnectorOffset reads the change identifier a connector last
+// committed from the Kafka Connect REST API of the worker.
+func readConnectorOffset(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, connector string) (string, error) {
+	endpoint := fmt.Sprintf("http://%s.%s.svc:%d/connectors/%s/offsets",
+		resources.CDCConnectorName(cdc), cdc.Namespace, resources.CDCConnectorPort, url.PathEscape(connector))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := cdcHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("kafka connect returned %s", resp.Status)
+	}
+
+	var offsets connectorOffsets
+	if err := json.NewDecoder(resp.Body).Decode(&offsets); err != nil {
+		return "", fmt.Errorf("failed to decode offsets: %w", err)
+	}
+	return connectorChangeID(offsets), nil
+}
+
+// connectorChangeID returns the change identifier of the offsets: the first
+// string value of the first offset, by key.
+func connectorChangeID(offsets connectorOffsets) string {
+	if len(offsets.Offsets) == 0 {
+		return ""
+	}
+	offset := offsets.Offsets[0].Offset
+	keys := make([]string, 0, len(offset))
+	for key := range offset {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if value, ok := offset[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (r *Neo4jCDCReconciler) failCDC(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, message string, results []neo4jv1alpha1.CDCDatabaseStatus) {
+	if cdc.Status.Phase != "Degraded" || cdc.Status.Message != message {
+		r.Recorder.Event(cdc, corev1.EventTypeWarning, EventReasonCDCFailed, message)
+	}
+	r.updateCDCStatus(ctx, cdc, "Degraded", message, results, false)
+}
+
+func (r *Neo4jCDCReconciler) updateCDCStatus(ctx context.Context, cdc *neo4jv1alpha1.Neo4jCDC, phase, message string, results []neo4jv1alpha1.CDCDatabaseStatus, connectorReady bool) {
+	lastLagCheck := cdc.Status.LastLagCheckTime
+	var previousPhase string
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jCDC{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cdc), latest); err != nil {
+			return err
+		}
+		previousPhase = latest.Status.Phase
+		latest.Status.Phase = phase
+		latest.Status.Message = message
+		latest.Status.ConnectorReady = connectorReady
+		latest.Status.ObservedGeneration = latest.Generation
+		condStatus, condReason := PhaseToConditionStatus(phase)
+		SetReadyCondition(&latest.Status.Conditions, latest.Generation, condStatus, condReason, message)
+		if results != nil {
+			latest.Status.Databases = results
+		}
+		if lastLagCheck != nil {
+			latest.Status.LastLagCheckTime = lastLagCheck
+		}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cdc.Status = latest.Status
+		cdc.ResourceVersion = latest.ResourceVersion
+		return nil
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update CDC status")
+		return
+	}
+	recordPhaseChange(r.Recorder, cdc, previousPhase, phase, message)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Neo4jCDCReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status writes must not restart the loop; the lag is polled instead
+		For(&neo4jv1alpha1.Neo4jCDC{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&appsv1.StatefulSet{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
+		}).
+		Complete(observeReconcileErrors("neo4jcdc", r))
+}
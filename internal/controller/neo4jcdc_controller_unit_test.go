@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// fakeCDCClient keeps the enrichment mode of each database in memory.
+type fakeCDCClient struct {
+	modes   map[string]string
+	failOn  map[string]bool
+	set     []string
+	changes map[string]time.Time
+}
+
+func (c *fakeCDCClient) GetTxLogEnrichment(_ context.Context, database string) (string, error) {
+	if mode, ok := c.modes[database]; ok {
+		return mode, nil
+	}
+	return "OFF", nil
+}
+
+func (c *fakeCDCClient) SetTxLogEnrichment(_ context.Context, database, mode string) error {
+	if c.failOn[database] {
+		return fmt.Errorf("database %s does not exist", database)
+	}
+	c.set = append(c.set, database+"="+mode)
+	c.modes[database] = mode
+	return nil
+}
+
+func (c *fakeCDCClient) OldestChangeAfter(_ context.Context, database, _ string) (time.Time, error) {
+	return c.changes[database], nil
+}
+
+func newCDCTestReconciler(objs ...client.Object) (*Neo4jCDCReconciler, client.Client) {
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jCDC{}).Build()
+	return &Neo4jCDCReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10), RequeueAfter: time.Minute}, c
+}
+
+func testCDC(databases ...neo4jv1alpha1.CDCDatabase) *neo4jv1alpha1.Neo4jCDC {
+	return &neo4jv1alpha1.Neo4jCDC{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default", Generation: 1},
+		Spec: neo4jv1alpha1.Neo4jCDCSpec{
+			ClusterRef: "prod",
+			Databases:  databases,
+		},
+	}
+}
+
+func TestEnableCDC_SetsOnlyChangedModes(t *testing.T) {
+	cdc := testCDC(
+		neo4jv1alpha1.CDCDatabase{Name: "neo4j"},
+		neo4jv1alpha1.CDCDatabase{Name: "orders", EnrichmentMode: "DIFF"},
+	)
+	r, _ := newCDCTestReconciler(cdc)
+	fakeClient := &fakeCDCClient{modes: map[string]string{"neo4j": "FULL"}}
+
+	results := r.enableCDC(context.Background(), fakeClient, cdc)
+
+	assert.Equal(t, []string{"orders=DIFF"}, fakeClient.set)
+	require.Len(t, results, 2)
+	assert.True(t, results[0].Enabled)
+	assert.Equal(t, "FULL", results[0].EnrichmentMode)
+	assert.True(t, results[1].Enabled)
+	assert.Equal(t, "DIFF", results[1].EnrichmentMode)
+}
+
+func TestEnableCDC_ReportsFailedDatabase(t *testing.T) {
+	cdc := testCDC(
+		neo4jv1alpha1.CDCDatabase{Name: "missing"},
+		neo4jv1alpha1.CDCDatabase{Name: "neo4j"},
+	)
+	r, _ := newCDCTestReconciler(cdc)
+	fakeClient := &fakeCDCClient{modes: map[string]string{}, failOn: map[string]bool{"missing": true}}
+
+	results := r.enableCDC(context.Background(), fakeClient, cdc)
+
+	require.Len(t, results, 2)
+	assert.False(t, results[0].Enabled)
+	assert.Equal(t, "OFF", results[0].EnrichmentMode)
+	assert.Contains(t, results[0].Message, "does not exist")
+	assert.True(t, results[1].Enabled, "a failing database must not stop the others")
+}
+
+func TestMeasureCDCLag(t *testing.T) {
+	cdc := testCDC(
+		neo4jv1alpha1.CDCDatabase{Name: "neo4j", Topics: []neo4jv1alpha1.CDCTopic{{Name: "people", Patterns: []string{"(:Person)"}}}},
+		neo4jv1alpha1.CDCDatabase{Name: "orders", Topics: []neo4jv1alpha1.CDCTopic{{Name: "orders", Patterns: []string{"(:Order)"}}}},
+		neo4jv1alpha1.CDCDatabase{Name: "audit"},
+	)
+	cdc.Spec.Connector = &neo4jv1alpha1.CDCConnectorSpec{BootstrapServers: "kafka:9092"}
+	r, _ := newCDCTestReconciler(cdc)
+	r.readOffset = func(_ context.Context, _ *neo4jv1alpha1.Neo4jCDC, connector string) (string, error) {
+		if connector == "orders-orders" {
+			return "", nil
+		}
+		return "change-1", nil
+	}
+	fakeClient := &fakeCDCClient{changes: map[string]time.Time{"neo4j": time.Now().Add(-2 * time.Minute)}}
+	results := []neo4jv1alpha1.CDCDatabaseStatus{
+		{Name: "neo4j", Enabled: true},
+		{Name: "orders", Enabled: true},
+		{Name: "audit", Enabled: true},
+	}
+
+	r.measureCDCLag(context.Background(), fakeClient, cdc, results)
+
+	require.NotNil(t, results[0].LagSeconds)
+	assert.InDelta(t, 120, *results[0].LagSeconds, 5)
+	assert.Nil(t, results[1].LagSeconds, "a connector without offsets has no lag yet")
+	assert.Nil(t, results[2].LagSeconds, "unpublished databases have no lag")
+	assert.NotNil(t, cdc.Status.LastLagCheckTime)
+}
+
+func TestConnectorChangeID(t *testing.T) {
+	offsets := connectorOffsets{}
+	assert.Empty(t, connectorChangeID(offsets))
+
+	offsets.Offsets = append(offsets.Offsets, struct {
+		Offset map[string]interface{} `json:"offset"`
+	}{Offset: map[string]interface{}{"txId": float64(7), "value": "A1B2"}})
+	assert.Equal(t, "A1B2", connectorChangeID(offsets))
+}
+
+func TestNeo4jCDCReconcile_TargetNotFound(t *testing.T) {
+	cdc := testCDC(neo4jv1alpha1.CDCDatabase{Name: "neo4j"})
+	r, c := newCDCTestReconciler(cdc)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cdc)})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+
+	updated := &neo4jv1alpha1.Neo4jCDC{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cdc), updated))
+	assert.Equal(t, "Pending", updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "not found")
+}
+
+func TestReconcileConnector_CreatesOwnedWorker(t *testing.T) {
+	cdc := testCDC(neo4jv1alpha1.CDCDatabase{Name: "neo4j", Topics: []neo4jv1alpha1.CDCTopic{{Name: "people", Patterns: []string{"(:Person)"}}}})
+	cdc.UID = "cdc-uid"
+	cdc.Spec.Connector = &neo4jv1alpha1.CDCConnectorSpec{
+		Image:            neo4jv1alpha1.ImageSpec{Repo: "confluentinc/cp-kafka-connect", Tag: "7.7.1"},
+		BootstrapServers: "kafka:9092",
+	}
+	r, c := newCDCTestReconciler(cdc)
+	target := &workloadTarget{uri: "neo4j://prod-client.default.svc.cluster.local:7687", adminSecret: "prod-admin", ready: true}
+	ctx := context.Background()
+
+	require.NoError(t, r.reconcileConnector(ctx, cdc, target))
+
+	statefulSet := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "orders-connector", Namespace: "default"}, statefulSet))
+	assert.True(t, metav1.IsControlledBy(statefulSet, cdc))
+	env := statefulSet.Spec.Template.Spec.Containers[0].Env
+	assert.Equal(t, "prod-admin", env[0].ValueFrom.SecretKeyRef.Name, "credentials default to the admin secret of the target")
+
+	ready, err := r.connectorReady(ctx, cdc)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	// Dropping every topic removes the worker
+	cdc.Spec.Databases[0].Topics = nil
+	require.NoError(t, r.deleteConnector(ctx, cdc))
+	assert.Error(t, c.Get(ctx, client.ObjectKey{Name: "orders-connector", Namespace: "default"}, statefulSet))
+	assert.Error(t, c.Get(ctx, client.ObjectKey{Name: "orders-connector", Namespace: "default"}, &corev1.ConfigMap{}))
+}
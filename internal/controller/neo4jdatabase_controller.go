@@ -29,10 +29,13 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
@@ -59,6 +62,11 @@ const (
 // +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jdatabases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jdatabases/finalizers,verbs=update
 // +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jbackups,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile handles the reconciliation of Neo4jDatabase resources
@@ -84,6 +92,8 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		logger.Error(err, "Failed to get Neo4jDatabase")
 		return ctrl.Result{}, err
 	}
+	// Whatever the outcome, the target's StackReady condition reflects it
+	defer refreshStackReadyCondition(ctx, r.Client, database.Namespace, database.Spec.ClusterRef)
 
 	// Handle deletion
 	if database.DeletionTimestamp != nil {
@@ -120,10 +130,23 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			logger.Error(nil, message)
 			r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonValidationFailed, message)
 			r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonValidationFailed, message)
+			recordValidationFailure(ctx)
 			return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 		}
 	}
 
+	// Wait for the resources of spec.dependsOn
+	waiting, err := waitingDependency(ctx, r.Client, database, database.Spec.DependsOn)
+	if err != nil {
+		logger.Error(err, "Failed to check dependencies")
+		return ctrl.Result{}, err
+	}
+	if waiting != "" {
+		setDependenciesCondition(ctx, r.Client, database, waiting)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, ConditionReasonWaitingForDependencies, waiting)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
 	// Get referenced cluster or standalone
 	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
 	clusterKey := types.NamespacedName{
@@ -160,23 +183,25 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, clusterErr
 	}
 
-	// Check if cluster/standalone is ready
+	// The target is a dependency too
 	var clusterReady bool
 	if isStandalone {
-		clusterReady = r.isStandaloneReady(standalone)
+		clusterReady = resourceReady(standalone)
 	} else {
-		clusterReady = r.isClusterReady(cluster)
+		clusterReady = resourceReady(cluster)
 	}
 
 	if !clusterReady {
+		setDependenciesCondition(ctx, r.Client, database, "Referenced cluster is not ready")
 		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonClusterNotReady,
 			"Referenced cluster is not ready")
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
+	setDependenciesCondition(ctx, r.Client, database, "")
 
 	// Create Neo4j client with retry for transient connection issues
 	var neo4jClient *neo4j.Client
-	err := retry.OnError(retry.DefaultBackoff, func(err error) bool {
+	err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
 		// Retry on connection errors
 		return strings.Contains(err.Error(), "connection") || strings.Contains(err.Error(), "timeout")
 	}, func() error {
@@ -201,10 +226,21 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}()
 
+	// Resolve the backup the database is seeded from, if any
+	seedURI, err := resolveDatabaseSeedURI(ctx, r.Client, database)
+	if err != nil {
+		logger.Error(err, "Failed to resolve database seed")
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonCreationFailed,
+			fmt.Sprintf("Failed to resolve database seed: %v", err))
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonCreationFailed,
+			"Failed to resolve database seed: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Ensure database exists (with seed URI support)
 	logger.Info("Starting database creation/verification", "database", database.Spec.Name, "wait", database.Spec.Wait, "topology", database.Spec.Topology)
 	dbCreateStart := time.Now()
-	if err := r.ensureDatabase(ctx, neo4jClient, database); err != nil {
+	if err := r.ensureDatabase(ctx, neo4jClient, database, seedURI); err != nil {
 		duration := time.Since(dbCreateStart)
 		logger.Error(err, "Failed to ensure database", "database", database.Spec.Name, "duration", duration)
 		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonCreationFailed,
@@ -216,8 +252,54 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	duration := time.Since(dbCreateStart)
 	logger.Info("Database creation/verification completed successfully", "database", database.Spec.Name, "duration", duration)
 
+	// Wait for a database created from spec.seed to come online
+	seeded, err := r.trackSeedProgress(ctx, neo4jClient, database, seedURI)
+	if err != nil {
+		logger.Error(err, "Failed to track database seeding")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if !seeded {
+		seedStatus := database.Status.Seed
+		if seedStatus.Phase == SeedPhaseFailed {
+			r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonSeedFailed, seedStatus.Message)
+		} else {
+			r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonDatabaseSeeding, seedStatus.Message)
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	// Apply spec.topology to a database that already exists
+	if err := r.reconcileDatabaseTopology(ctx, neo4jClient, database); err != nil {
+		logger.Error(err, "Failed to reconcile database topology")
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonTopologyFailed,
+			fmt.Sprintf("Failed to alter database topology: %v", err))
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonTopologyFailed,
+			"Failed to alter database topology: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	// Reconcile constituents and aliases of the database
+	if err := r.reconcileDatabaseAliases(ctx, neo4jClient, database); err != nil {
+		logger.Error(err, "Failed to reconcile database aliases")
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonAliasFailed,
+			fmt.Sprintf("Failed to reconcile aliases: %v", err))
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonAliasFailed,
+			"Failed to reconcile aliases: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	// Create the indexes and constraints declared in spec.schema
+	if err := r.reconcileDatabaseSchema(ctx, neo4jClient, database); err != nil {
+		logger.Error(err, "Failed to reconcile database schema")
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonSchemaFailed,
+			fmt.Sprintf("Failed to reconcile schema: %v", err))
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonSchemaFailed,
+			"Failed to reconcile schema: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Import initial data if specified (skip if using seed URI since data comes from the seed)
-	if database.Spec.InitialData != nil && database.Spec.SeedURI == "" && database.Status.DataImported == nil {
+	if database.Spec.InitialData != nil && seedURI == "" && database.Status.DataImported == nil {
 		if err := r.importInitialData(ctx, neo4jClient, database); err != nil {
 			logger.Error(err, "Failed to import initial data")
 			r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonDataImportFailed,
@@ -235,7 +317,7 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			return ctrl.Result{}, err
 		}
 		r.Recorder.Event(database, corev1.EventTypeNormal, EventReasonDataImported, "Initial data imported successfully")
-	} else if database.Spec.SeedURI != "" && database.Status.DataImported == nil {
+	} else if seedURI != "" && database.Status.DataImported == nil {
 		// Mark data as imported for seed URI databases (data comes from the seed)
 		imported := true
 		database.Status.DataImported = &imported
@@ -243,7 +325,24 @@ func (r *Neo4jDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			logger.Error(err, "Failed to update data import status for seeded database")
 			return ctrl.Result{}, err
 		}
-		r.Recorder.Event(database, corev1.EventTypeNormal, EventReasonDataSeeded, "Database seeded from URI successfully")
+		if database.Spec.Seed == nil {
+			r.Recorder.Event(database, corev1.EventTypeNormal, EventReasonDataSeeded, "Database seeded from URI successfully")
+		}
+	}
+
+	// Stop or start the database to match spec.desiredState
+	if err := r.reconcileDatabaseState(ctx, neo4jClient, database); err != nil {
+		logger.Error(err, "Failed to reconcile database state", "desiredState", database.Spec.DesiredState)
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonStateChangeFailed,
+			fmt.Sprintf("Failed to change database state: %v", err))
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonStateChangeFailed,
+			"Failed to change database state: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if wantsOffline(database) {
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionTrue, EventReasonDatabaseOffline,
+			"Database is offline as requested")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
 	// Update status to ready
@@ -265,6 +364,19 @@ func (r *Neo4jDatabaseReconciler) handleDeletion(ctx context.Context, database *
 
 	logger.Info("Starting deletion handler", "finalizers", database.Finalizers, "deletionTimestamp", database.DeletionTimestamp)
 
+	// Keep the database in Neo4j when the drop policy retains it
+	if dropPolicyRetains(database) {
+		logger.Info("Drop policy retains database, removing finalizer", "database", database.Spec.Name)
+		r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonDatabaseRetained,
+			"Database %s retained in Neo4j", database.Spec.Name)
+		controllerutil.RemoveFinalizer(database, DatabaseFinalizer)
+		err := r.Update(ctx, database)
+		if err != nil {
+			logger.Error(err, "Failed to update database after removing finalizer")
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Get referenced cluster
 	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
 	clusterKey := types.NamespacedName{
@@ -303,8 +415,33 @@ func (r *Neo4jDatabaseReconciler) handleDeletion(ctx context.Context, database *
 		}
 	}()
 
+	// Hold the drop until it is confirmed and the final dump has completed
+	ready, err := r.prepareDatabaseDrop(ctx, neo4jClient, database)
+	if err != nil {
+		logger.Error(err, "Failed to prepare database drop")
+		r.updateDatabaseStatus(ctx, database, metav1.ConditionFalse, EventReasonFinalDumpFailed, err.Error())
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonFinalDumpFailed,
+			"Failed to take final dump: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if !ready {
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	// Drop the aliases of the database before the database itself
+	if err := r.dropDatabaseAliases(ctx, neo4jClient, database); err != nil {
+		logger.Error(err, "Failed to drop database aliases")
+		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonDeletionFailed,
+			"Failed to drop database aliases: %v", err)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Drop database
-	if err := neo4jClient.DropDatabase(ctx, database.Spec.Name); err != nil {
+	dropDatabase := neo4jClient.DropDatabase
+	if isCompositeDatabase(database) {
+		dropDatabase = neo4jClient.DropCompositeDatabase
+	}
+	if err := dropDatabase(ctx, database.Spec.Name); err != nil {
 		logger.Error(err, "Failed to drop database")
 		r.Recorder.Eventf(database, corev1.EventTypeWarning, EventReasonDeletionFailed,
 			"Failed to drop database: %v", err)
@@ -324,7 +461,7 @@ func (r *Neo4jDatabaseReconciler) handleDeletion(ctx context.Context, database *
 	return ctrl.Result{}, nil
 }
 
-func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *neo4j.Client, database *neo4jv1alpha1.Neo4jDatabase, seedURI string) error {
 	logger := log.FromContext(ctx)
 
 	// Check if database exists
@@ -335,26 +472,37 @@ func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *ne
 
 	if !exists {
 		// Prepare cloud credentials if using seed URI with explicit credentials
-		if database.Spec.SeedURI != "" && database.Spec.SeedCredentials != nil {
-			if err := client.PrepareCloudCredentials(ctx, r.Client, database); err != nil {
+		if seedURI != "" && database.Spec.SeedCredentials != nil {
+			if err := client.PrepareCloudCredentials(ctx, r.Client, database, seedURI); err != nil {
 				return fmt.Errorf("failed to prepare cloud credentials: %w", err)
 			}
 		}
 
-		// Determine which creation method to use based on seed URI
-		if database.Spec.SeedURI != "" {
+		// Determine which creation method to use based on type and seed URI
+		if isCompositeDatabase(database) {
+			logger.Info("Creating composite database",
+				"database", database.Spec.Name,
+				"wait", database.Spec.Wait)
+
+			err = client.CreateCompositeDatabase(
+				ctx,
+				database.Spec.Name,
+				database.Spec.Wait,
+				database.Spec.IfNotExists,
+			)
+		} else if seedURI != "" {
 			// Create database from seed URI
 			if database.Spec.Topology != nil {
 				logger.Info("Creating database from seed URI with topology",
 					"database", database.Spec.Name,
-					"seedURI", database.Spec.SeedURI,
+					"seedURI", seedURI,
 					"primaries", database.Spec.Topology.Primaries,
 					"secondaries", database.Spec.Topology.Secondaries)
 
 				err = client.CreateDatabaseFromSeedURIWithTopology(
 					ctx,
 					database.Spec.Name,
-					database.Spec.SeedURI,
+					seedURI,
 					database.Spec.Topology.Primaries,
 					database.Spec.Topology.Secondaries,
 					database.Spec.SeedConfig,
@@ -366,12 +514,12 @@ func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *ne
 			} else {
 				logger.Info("Creating database from seed URI",
 					"database", database.Spec.Name,
-					"seedURI", database.Spec.SeedURI)
+					"seedURI", seedURI)
 
 				err = client.CreateDatabaseFromSeedURI(
 					ctx,
 					database.Spec.Name,
-					database.Spec.SeedURI,
+					seedURI,
 					database.Spec.SeedConfig,
 					database.Spec.Options,
 					database.Spec.Wait,
@@ -425,12 +573,19 @@ func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *ne
 			return fmt.Errorf("failed to create database: %w", err)
 		}
 
+		// Track the progress of databases seeded from spec.seed
+		if database.Spec.Seed != nil && seedURI != "" {
+			if err := r.startSeedStatus(ctx, database, seedURI); err != nil {
+				logger.Error(err, "Failed to record seeding start")
+			}
+		}
+
 		// Record appropriate success event based on creation method
-		if database.Spec.SeedURI != "" {
+		if seedURI != "" {
 			r.Recorder.Eventf(database, corev1.EventTypeNormal, EventReasonDatabaseCreatedSeed,
 				"Database %s created successfully from seed URI", database.Spec.Name)
 			logger.Info("Database created successfully from seed URI",
-				"database", database.Spec.Name, "seedURI", database.Spec.SeedURI)
+				"database", database.Spec.Name, "seedURI", seedURI)
 		} else {
 			logger.Info("Database created successfully", "database", database.Spec.Name)
 		}
@@ -438,21 +593,19 @@ func (r *Neo4jDatabaseReconciler) ensureDatabase(ctx context.Context, client *ne
 		logger.Info("Database already exists", "database", database.Spec.Name)
 	}
 
-	// Always update database state and servers after creation or verification
-	// Check and update database state in status
-	state, err := client.GetDatabaseState(ctx, database.Spec.Name)
+	// Always update database state and servers after creation or verification,
+	// from the entry SHOW DATABASES lists for every server hosting it
+	allocations, err := client.ShowDatabases(ctx, database.Spec.Name)
 	if err != nil {
-		logger.Error(err, "Failed to get database state")
+		logger.Error(err, "Failed to get database state and servers")
 	} else {
-		database.Status.State = state
-	}
-
-	// Get servers hosting the database
-	servers, err := client.GetDatabaseServers(ctx, database.Spec.Name)
-	if err != nil {
-		logger.Error(err, "Failed to get database servers")
-	} else {
-		database.Status.Servers = servers
+		if len(allocations) > 0 {
+			database.Status.State = allocations[0].Status
+		}
+		database.Status.Servers = make([]string, 0, len(allocations))
+		for _, allocation := range allocations {
+			database.Status.Servers = append(database.Status.Servers, allocation.Address)
+		}
 	}
 
 	return nil
@@ -469,40 +622,31 @@ func (r *Neo4jDatabaseReconciler) importInitialData(ctx context.Context, client
 
 func (r *Neo4jDatabaseReconciler) createNeo4jClient(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*neo4j.Client, error) {
 	// Use the enterprise client method
-	return neo4j.NewClientForEnterprise(cluster, r.Client, cluster.Spec.Auth.AdminSecret)
+	return neo4j.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 }
 
 func (r *Neo4jDatabaseReconciler) createNeo4jClientForStandalone(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) (*neo4j.Client, error) {
 	// Use the enterprise client method for standalone
-	return neo4j.NewClientForEnterpriseStandalone(standalone, r.Client, standalone.Spec.Auth.AdminSecret)
-}
-
-func (r *Neo4jDatabaseReconciler) isClusterReady(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
-	for _, condition := range cluster.Status.Conditions {
-		if condition.Type == "Ready" && condition.Status == metav1.ConditionTrue {
-			return true
-		}
-	}
-	return false
-}
-
-func (r *Neo4jDatabaseReconciler) isStandaloneReady(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) bool {
-	// Standalone uses a simple ready boolean field, not conditions array
-	return standalone.Status.Ready
+	return neo4j.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
 }
 
 func (r *Neo4jDatabaseReconciler) updateDatabaseStatus(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, status metav1.ConditionStatus, reason, message string) {
+	var previousPhase, phase string
 	update := func() error {
 		latest := &neo4jv1alpha1.Neo4jDatabase{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(database), latest); err != nil {
 			return err
 		}
+		previousPhase = latest.Status.Phase
 		SetReadyCondition(&latest.Status.Conditions, latest.Generation, status, reason, message)
 
 		// Set Phase field based on condition status for API consistency
 		switch status {
 		case metav1.ConditionTrue:
 			latest.Status.Phase = "Ready"
+			if reason == EventReasonDatabaseOffline {
+				latest.Status.Phase = "Offline"
+			}
 			// Set creation time if this is the first time the database becomes ready
 			if latest.Status.CreationTime == nil && reason == EventReasonDatabaseReady {
 				now := metav1.Now()
@@ -513,10 +657,13 @@ func (r *Neo4jDatabaseReconciler) updateDatabaseStatus(ctx context.Context, data
 			switch reason {
 			case EventReasonValidationFailed:
 				latest.Status.Phase = EventReasonValidationFailed
-			case EventReasonClusterNotFound, EventReasonClusterNotReady:
+			case EventReasonClusterNotFound, EventReasonClusterNotReady, EventReasonDatabaseSeeding, ConditionReasonWaitingForDependencies:
 				latest.Status.Phase = "Pending"
-			case EventReasonConnectionFailed, EventReasonCreationFailed, EventReasonDataImportFailed:
+			case EventReasonConnectionFailed, EventReasonCreationFailed, EventReasonDataImportFailed, EventReasonAliasFailed, EventReasonSeedFailed,
+				EventReasonStateChangeFailed, EventReasonFinalDumpFailed, EventReasonSchemaFailed:
 				latest.Status.Phase = "Failed"
+			case EventReasonDropPending, EventReasonFinalDumpStarted:
+				latest.Status.Phase = "Deleting"
 			default:
 				latest.Status.Phase = "Unknown"
 			}
@@ -527,21 +674,27 @@ func (r *Neo4jDatabaseReconciler) updateDatabaseStatus(ctx context.Context, data
 		// Also update the message field for quick access
 		latest.Status.Message = message
 		latest.Status.ObservedGeneration = latest.Generation
+		phase = latest.Status.Phase
 		return r.Status().Update(ctx, latest)
 	}
 	err := retry.RetryOnConflict(retry.DefaultBackoff, update)
 	if err != nil {
 		log.FromContext(ctx).Error(err, "Failed to update database status")
+		return
 	}
+	recordPhaseChange(r.Recorder, database, previousPhase, phase, message)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Neo4jDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&neo4jv1alpha1.Neo4jDatabase{}).
-		Owns(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
+		Watches(&neo4jv1alpha1.Neo4jEnterpriseCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.databasesForCluster),
+			builder.WithPredicates(predicate.Funcs{UpdateFunc: clusterTopologyChanged})).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
 		}).
-		Complete(r)
+		Complete(observeReconcileErrors("neo4jdatabase", r))
 }
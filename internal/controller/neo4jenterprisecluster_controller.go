@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -46,6 +47,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
 	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
@@ -62,6 +64,43 @@ type Neo4jEnterpriseClusterReconciler struct {
 	Validator          *validation.ClusterValidator
 	ConfigMapManager   *ConfigMapManager
 	SplitBrainDetector *SplitBrainDetector
+	// SlowReconcileThreshold is the reconcile budget; slower reconciles emit a
+	// warning Event with the per-phase breakdown. Zero uses
+	// DefaultSlowReconcileThreshold, a negative value disables the check.
+	SlowReconcileThreshold time.Duration
+	// Capabilities are the optional APIs the cluster serves. Resources of
+	// APIs it lacks are skipped. Nil tries every API.
+	Capabilities *capabilities.Capabilities
+
+	// newScaleDownClient replaces the Neo4j connection of scale-downs in tests
+	newScaleDownClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (scaleDownClient, error)
+	// newMaintenanceClient replaces the Neo4j connection of server
+	// maintenance in tests
+	newMaintenanceClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (maintenanceClient, error)
+	// newServerStatusClient replaces the Neo4j connection of the server
+	// status in tests
+	newServerStatusClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (serverStatusClient, error)
+	// newRollingRestartClient replaces the Neo4j connection of rolling
+	// restarts in tests
+	newRollingRestartClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (rollingRestartClient, error)
+	// newHibernationClient replaces the Neo4j connection of the checkpoint
+	// before hibernation in tests
+	newHibernationClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (hibernationClient, error)
+	// newUpgradePreflightClient replaces the Neo4j connection of the upgrade
+	// checks in tests
+	newUpgradePreflightClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (upgradePreflightClient, error)
+	// newCanaryUpgradeClient replaces the Neo4j connection of canary
+	// upgrades in tests
+	newCanaryUpgradeClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (canaryUpgradeClient, error)
+	// newTLSReloadClient replaces the Neo4j connection of certificate
+	// reloads in tests
+	newTLSReloadClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (tlsReloadClient, error)
+	// newAdminPasswordClient replaces the Neo4j connection of admin password
+	// rotations in tests
+	newAdminPasswordClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (adminPasswordClient, error)
+	// newOperatorUserClient replaces the admin connection the operator user
+	// is set up with in tests
+	newOperatorUserClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (operatorUserClient, error)
 }
 
 const (
@@ -74,13 +113,14 @@ const (
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters/finalizers,verbs=update
+//+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jclusterclasses,verbs=get;list;watch
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
-//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch;delete
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
@@ -95,10 +135,16 @@ const (
 //+kubebuilder:rbac:groups=external-secrets.io,resources=secretstores,verbs=get;list;watch
 //+kubebuilder:rbac:groups=external-secrets.io,resources=clustersecretstores,verbs=get;list;watch
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods/resize,verbs=patch
+//+kubebuilder:rbac:groups=autoscaling.k8s.io,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;podmonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
 
 func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
+	timer := newReconcileTimer()
+	timer.startPhase(ReconcilePhaseFetch)
 
 	// Fetch the Neo4jEnterpriseCluster instance
 	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
@@ -111,18 +157,51 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{}, err
 	}
 
-	reconcileStart := time.Now()
 	reconcileM := metrics.NewReconcileMetrics(cluster.Name, cluster.Namespace)
 	defer func() {
 		success := cluster.Status.Phase == "Ready"
-		reconcileM.RecordReconcile(ctx, "cluster", time.Since(reconcileStart), success)
+		duration := timer.finish()
+		reconcileM.RecordReconcile(ctx, "cluster", duration, success)
+		timer.record(reconcileM)
+		r.reportSlowReconcile(ctx, cluster, timer, duration)
 	}()
 
 	// Handle deletion
 	if cluster.DeletionTimestamp != nil {
+		timer.startPhase(ReconcilePhaseDeletion)
 		return r.handleDeletion(ctx, cluster)
 	}
 
+	// Leave the cluster alone while spec.maintenance pauses it
+	if resources.ClusterInMaintenance(cluster) {
+		r.reconcileClusterMaintenance(ctx, cluster)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	if cluster.Status.Phase == "Maintenance" {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonMaintenanceEnded, "Reconciliation resumed")
+	}
+
+	timer.startPhase(ReconcilePhaseValidate)
+
+	// Persist the conversion of the deprecated primaries/secondaries topology
+	// before anything is derived from it
+	if migrated, err := r.migrateDeprecatedTopology(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to convert deprecated topology")
+		return ctrl.Result{}, err
+	} else if migrated {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Persist the template of the cluster class and check its policies
+	if applied, err := r.applyClusterClass(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to apply cluster class")
+		r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonClusterClassFailed, "Failed to apply cluster class: %v", err)
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to apply cluster class: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	} else if applied {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	// Apply defaults and validate the cluster
 	if r.Validator != nil {
 		// Apply defaults to the cluster
@@ -159,7 +238,7 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 					logger.Error(err, "Cluster update validation failed")
 					r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonValidationFailed, "Cluster update validation failed: %v", err)
 					_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Update validation failed: %v", err))
-					return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+					return ctrl.Result{RequeueAfter: r.RequeueAfter}, &validationError{err}
 				}
 			}
 		}
@@ -179,7 +258,7 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 				logger.Error(err, "Cluster validation failed")
 				r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonValidationFailed, "Cluster validation failed: %v", err)
 				_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Validation failed: %v", err))
-				return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+				return ctrl.Result{RequeueAfter: r.RequeueAfter}, &validationError{err}
 			}
 		}
 
@@ -192,7 +271,7 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 			}
 			err := fmt.Errorf("server role validation failed: %v", roleHintErrors)
 			_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Server role validation failed: %v", roleHintErrors))
-			return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, &validationError{err}
 		}
 	}
 
@@ -202,10 +281,17 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 			logger.Error(err, "Property sharding validation failed")
 			r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonPropertyShardingFailed, "Property sharding validation failed: %v", err)
 			_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Property sharding validation failed: %v", err))
-			return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, &validationError{err}
 		}
 	}
 
+	// Keep the servers within the Neo4j license entitlement
+	if err := r.reconcileLicense(ctx, cluster); err != nil {
+		logger.Error(err, "License check failed")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", err.Error())
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Add finalizer if not present
 	if !controllerutil.ContainsFinalizer(cluster, ClusterFinalizer) {
 		controllerutil.AddFinalizer(cluster, ClusterFinalizer)
@@ -216,11 +302,33 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// spec.hibernate scales the cluster to zero and nothing else is reconciled
+	if cluster.Spec.Hibernate {
+		return r.reconcileHibernation(ctx, cluster)
+	}
+	if err := r.startResume(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to start resuming the cluster")
+		return ctrl.Result{}, err
+	}
+
+	// A canary upgrade holds the other servers on the running image until
+	// its canary passed
+	if canaryUpgradeInProgress(cluster) {
+		timer.startPhase(ReconcilePhaseUpgrade)
+		return r.reconcileCanaryUpgrade(ctx, cluster)
+	}
+
 	// Check if this is an upgrade scenario
 	if r.isUpgradeRequired(ctx, cluster) {
 		logger.Info("Image upgrade detected, initiating rolling upgrade")
+		timer.startPhase(ReconcilePhaseUpgrade)
 		return r.handleRollingUpgrade(ctx, cluster)
 	}
+	if cluster.Status.Phase == "Ready" {
+		if err := r.resetUpgradeChecks(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to clean up the upgrade checks")
+		}
+	}
 
 	// Neo4jEnterpriseCluster is always multi-node from the start
 	// (minimum 1 primary + 1 secondary OR 2+ primaries)
@@ -232,6 +340,8 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		_ = r.updateClusterStatus(ctx, cluster, "Initializing", "Starting cluster reconciliation")
 	}
 
+	timer.startPhase(ReconcilePhaseConfigMap)
+
 	// Create Certificate if cert-manager is enabled
 	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == "cert-manager" {
 		certificate := resources.BuildCertificateForEnterprise(cluster)
@@ -241,6 +351,16 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 				_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to create Certificate: %v", err))
 				return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 			}
+			if err := reconcileSSLPolicyCertificates(ctx, r.Client, r.Scheme, cluster, certificate, cluster.Spec.TLS, resources.SSLPolicyScopes); err != nil {
+				logger.Error(err, "Failed to reconcile SSL policy certificates")
+				_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to create Certificate: %v", err))
+				return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+			}
+			if err := r.reconcileServerCertificates(ctx, cluster); err != nil {
+				logger.Error(err, "Failed to reconcile server certificates")
+				_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to create Certificate: %v", err))
+				return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+			}
 		}
 	}
 
@@ -261,6 +381,18 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	// The servers read the admin Secret, which waits for the synced credentials
+	credentialsPending, err := r.reconcileAdminCredentials(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Failed to sync the admin credentials")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to sync the admin credentials: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if credentialsPending {
+		_ = r.updateClusterStatus(ctx, cluster, "Pending", "Waiting for the External Secrets Operator to sync the admin credentials")
+		return ctrl.Result{RequeueAfter: adminCredentialsRequeueInterval}, nil
+	}
+
 	// Reconcile ConfigMap with immediate updates and pod restarts
 	if err := r.ConfigMapManager.ReconcileConfigMap(ctx, cluster); err != nil {
 		logger.Error(err, "Failed to reconcile ConfigMap")
@@ -268,6 +400,14 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
 
+	if err := r.reconcileConfigOverrides(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to reconcile config overrides")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile config overrides: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	timer.startPhase(ReconcilePhaseServices)
+
 	// Create RBAC resources for Kubernetes discovery
 	serviceAccount := resources.BuildDiscoveryServiceAccountForEnterprise(cluster)
 	if err := r.createOrUpdateResource(ctx, serviceAccount, cluster); err != nil {
@@ -290,6 +430,14 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
 
+	// Drain servers in maintenance before the client Service selects by routing
+	inMaintenance, err := r.reconcileServerMaintenance(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Failed to reconcile server maintenance")
+		r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonConnectionFailed,
+			"Server maintenance is not applied yet: %v", err)
+	}
+
 	// Create Services
 	services := []*corev1.Service{
 		resources.BuildHeadlessServiceForEnterprise(cluster),  // Headless service for StatefulSet
@@ -315,6 +463,30 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	// Enforce allowedCIDRs with a NetworkPolicy where the client Service
+	// cannot apply source ranges itself
+	if err := reconcileNetworkPolicy(ctx, r.Client, r.Scheme, cluster,
+		resources.ClientNetworkPolicyName(fmt.Sprintf("%s-client", cluster.Name)), cluster.Namespace,
+		resources.BuildClientNetworkPolicyForEnterprise(cluster)); err != nil {
+		logger.Error(err, "Failed to reconcile client NetworkPolicy")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile client NetworkPolicy: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	// Expose and lock down the backup port as spec.backupPort asks
+	if err := r.reconcileBackupPort(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to reconcile backup port")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile backup port: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	// Expose the secondaries through the read-only Service when asked for
+	if err := r.reconcileReadOnlyService(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to reconcile read-only Service")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile read-only Service: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Create Ingress if configured
 	if cluster.Spec.Service != nil && cluster.Spec.Service.Ingress != nil && cluster.Spec.Service.Ingress.Enabled {
 		ingress := resources.BuildIngressForEnterprise(cluster)
@@ -334,12 +506,20 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
 
+	// Scrape, alert on and chart the metrics of the servers if configured
+	if err := r.reconcileMonitoring(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to reconcile monitoring resources")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile monitoring resources: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Reconcile MCP resources if enabled
 	if err := r.reconcileMCP(ctx, cluster); err != nil {
 		logger.Error(err, "Failed to reconcile MCP resources")
 		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile MCP resources: %v", err))
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
+	setOptionalAPIsCondition(ctx, r.Client, r.Capabilities, cluster, clusterOptionalAPIs(cluster))
 
 	// Reconcile Aura Fleet Management registration if enabled
 	if cluster.Spec.AuraFleetManagement != nil && cluster.Spec.AuraFleetManagement.Enabled {
@@ -352,6 +532,8 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	timer.startPhase(ReconcilePhaseStatefulSet)
+
 	// Calculate topology placement if topology scheduler is available
 	var topologyPlacement *TopologyPlacement
 	if r.TopologyScheduler != nil {
@@ -377,6 +559,31 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 
 	// Create single StatefulSet for all servers
 	serverStatefulSet := resources.BuildServerStatefulSetForEnterprise(cluster)
+	if image := rolledBackImage(cluster); image != "" {
+		// The servers stay on the image a failed upgrade was rolled back to
+		serverStatefulSet.Spec.Template.Spec.Containers[0].Image = image
+	}
+	tlsHash, err := tlsSecretHash(ctx, r.Client, cluster.Namespace, cluster.Spec.TLS, resources.SSLPolicyScopes)
+	if err != nil {
+		logger.Error(err, "Failed to read TLS Secrets")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to read TLS Secrets: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	setTLSSecretHash(&serverStatefulSet.Spec.Template, tlsHash)
+	certificatesHash, pendingCertificate, err := serverCertificatesHash(ctx, r.Client, cluster, "server")
+	if err != nil {
+		logger.Error(err, "Failed to read server certificates")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to read server certificates: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if pendingCertificate != "" {
+		logger.Info("Waiting for cert-manager to issue the server certificate", "pod", pendingCertificate)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServerCertificatesPending,
+			fmt.Sprintf("Waiting for cert-manager to issue the certificate of %s", pendingCertificate))
+		return ctrl.Result{RequeueAfter: serverCertificateRequeueInterval}, nil
+	}
+	setServerCertificatesHash(&serverStatefulSet.Spec.Template, certificatesHash)
+	setCertificatesRestart(&serverStatefulSet.Spec.Template, cluster)
 
 	// Apply topology constraints to the server StatefulSet
 	if r.TopologyScheduler != nil && topologyPlacement != nil {
@@ -387,12 +594,54 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	// Move databases off departing servers before their pods are removed
+	scalingDown, scaleDownErr := r.reconcileScaleDown(ctx, cluster, serverStatefulSet)
+	if scaleDownErr != nil {
+		logger.Error(scaleDownErr, "Scale-down is blocked")
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonScaleDownBlocked, scaleDownErr.Error())
+	}
+
+	// Changed CPU and memory reach the servers one at a time
+	if err := r.prepareResize(ctx, cluster, serverStatefulSet); err != nil {
+		logger.Error(err, "Failed to start resizing the servers")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	// Renewed server certificates reach the servers one at a time as well
+	if err := r.prepareCertificateRollout(ctx, cluster, serverStatefulSet); err != nil {
+		logger.Error(err, "Failed to start rolling out the server certificates")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	if err := r.createOrUpdateResource(ctx, serverStatefulSet, cluster); err != nil {
 		logger.Error(err, "Failed to create server StatefulSet")
 		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to create server StatefulSet: %v", err))
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
 
+	if scalingDown || scaleDownErr != nil {
+		return ctrl.Result{RequeueAfter: scaleDownRequeueInterval}, nil
+	}
+
+	// Server groups run in StatefulSets of their own, and shrink as safely
+	groupsScalingDown, err := r.reconcileServerGroups(ctx, cluster)
+	if err != nil && groupsScalingDown {
+		logger.Error(err, "Server group scale-down is blocked")
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonScaleDownBlocked, err.Error())
+	} else if err != nil {
+		logger.Error(err, "Failed to reconcile server groups")
+		_ = r.updateClusterStatus(ctx, cluster, "Failed", fmt.Sprintf("Failed to reconcile server groups: %v", err))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if groupsScalingDown {
+		return ctrl.Result{RequeueAfter: scaleDownRequeueInterval}, nil
+	}
+
+	if err := r.deleteStaleServerCertificates(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to delete the certificates of removed servers")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
 	// Create centralized backup StatefulSet if backups are enabled
 	if cluster.Spec.Backups != nil {
 		backupSts := resources.BuildBackupStatefulSet(cluster)
@@ -405,9 +654,45 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	// Label scheduled server pods with their zone so they can tag themselves
+	zoneLabelsPending, err := r.reconcileZoneLabels(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Failed to label server pods with their zone")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if zoneLabelsPending {
+		return ctrl.Result{RequeueAfter: zoneLabelRequeueInterval}, nil
+	}
+
+	// Restart the servers for a configuration change, leaders last
+	restarting, err := r.reconcileRollingRestart(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Rolling restart is blocked")
+		return ctrl.Result{RequeueAfter: rollingRestartRequeueInterval}, nil
+	}
+	if restarting {
+		return ctrl.Result{RequeueAfter: rollingRestartRequeueInterval}, nil
+	}
+
+	resizing, err := r.reconcileInPlaceResize(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "In-place resize is blocked")
+		return ctrl.Result{RequeueAfter: resizeRequeueInterval}, nil
+	}
+	if resizing {
+		return ctrl.Result{RequeueAfter: resizeRequeueInterval}, nil
+	}
+
+	// Every generated StatefulSet and Service made it past admission
+	r.setResourcesAdmittedCondition(ctx, cluster, metav1.ConditionTrue, ConditionReasonDryRunPassed,
+		"Generated StatefulSets and Services passed server-side dry run")
+
+	timer.startPhase(ReconcilePhaseStatus)
+
 	// Handle Query Performance Monitoring
 	if cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled {
 		queryMonitor := NewQueryMonitor(r.Client, r.Scheme)
+		queryMonitor.Capabilities = r.Capabilities
 		if err := queryMonitor.ReconcileQueryMonitoring(ctx, cluster); err != nil {
 			logger.Error(err, "Failed to reconcile query monitoring")
 			// Don't fail the entire reconciliation for monitoring issues
@@ -415,6 +700,11 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		}
 	}
 
+	// Resource recommendations are informational, failures do not block the cluster
+	if err := r.reconcileResourceRecommendations(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to reconcile resource recommendations")
+	}
+
 	// Collect live diagnostics when QueryMonitoring is enabled and cluster is Ready.
 	// Diagnostics collection is non-fatal: failures are surfaced in status.diagnostics.collectionError.
 	if cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled &&
@@ -429,6 +719,11 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 				logger.Error(diagErr, "Failed to collect cluster diagnostics (non-fatal)")
 			}
 		}
+		slowQueryMonitor := NewQueryMonitor(r.Client, r.Scheme)
+		slowQueryMonitor.Recorder = r.Recorder
+		if err := slowQueryMonitor.CollectSlowQueries(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to collect slow queries (non-fatal)")
+		}
 	}
 
 	// Plugin management is now handled by the separate Neo4jPlugin CRD and controller
@@ -443,19 +738,42 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
+	// Record the current members; the previous list stays when Neo4j cannot be queried
+	if err := r.updateServerStatuses(ctx, cluster); err != nil {
+		logger.V(1).Info("Could not refresh server status", "error", err)
+	}
+	if err := r.labelServerRoles(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to label server roles")
+	}
+
 	if !clusterFormed {
 		if cluster.Status.Phase != "Forming" {
 			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonClusterFormationStarted,
 				"Neo4j cluster formation started")
 		}
 		_ = r.updateClusterStatus(ctx, cluster, "Forming", formationMessage)
-		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+		return ctrl.Result{RequeueAfter: r.maintenanceRequeueAfter(inMaintenance)}, nil
 	}
 
 	// Update status to "Ready" only if cluster formation is verified
 	// Note: Split-brain detection is already performed in verifyNeo4jClusterFormation
 	statusChanged := r.updateClusterStatus(ctx, cluster, "Ready", "Neo4j cluster is fully formed and ready")
 
+	if err := r.finishResume(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to clear the hibernation status")
+	}
+
+	// The operator connects as the admin until its own user is set up
+	if err := r.reconcileOperatorUser(ctx, cluster); err != nil {
+		logger.Error(err, "Failed to set up the operator user")
+	}
+
+	// Certificate expiry is informational, a failed reload restarts the servers
+	reloadPending, err := r.reconcileCertificates(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Failed to reconcile the certificate status")
+	}
+
 	// Update property sharding readiness status if enabled
 	if cluster.Spec.PropertySharding != nil && cluster.Spec.PropertySharding.Enabled {
 		if err := r.updatePropertyShardingStatus(ctx, cluster, true); err != nil {
@@ -469,7 +787,11 @@ func (r *Neo4jEnterpriseClusterReconciler) Reconcile(ctx context.Context, req ct
 		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonClusterReady, "Neo4j Enterprise cluster is ready")
 	}
 
-	return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	requeueAfter := r.maintenanceRequeueAfter(inMaintenance)
+	if reloadPending && (requeueAfter == 0 || requeueAfter > certificateReloadDelay) {
+		requeueAfter = certificateReloadDelay
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *Neo4jEnterpriseClusterReconciler) handleDeletion(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (ctrl.Result, error) {
@@ -492,6 +814,9 @@ func (r *Neo4jEnterpriseClusterReconciler) handleDeletion(ctx context.Context, c
 		logger.Info("Retaining PVCs due to Retain retention policy", "retentionPolicy", retentionPolicy)
 	}
 
+	// The servers are going away, so are the connections to them
+	neo4jclient.InvalidateDrivers(cluster.UID)
+
 	logger.Info("Removing finalizer from cluster", "finalizers", cluster.Finalizers, "deletionTimestamp", cluster.DeletionTimestamp)
 	controllerutil.RemoveFinalizer(cluster, ClusterFinalizer)
 	err := r.Update(ctx, cluster)
@@ -547,6 +872,12 @@ func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResource(ctx context.Co
 	conflictMetrics := metrics.NewConflictMetrics()
 	startTime := time.Now()
 
+	// Run the change past admission first, so that a webhook or policy
+	// rejecting it is reported on the cluster instead of as a failed update
+	if err := r.dryRunResource(ctx, obj, owner); err != nil {
+		return err
+	}
+
 	// Use retry logic to handle resource version conflicts
 	retryCount := 0
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -559,7 +890,7 @@ func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResource(ctx context.Co
 			conflictMetrics.RecordConflict(fmt.Sprintf("%T", obj), obj.GetNamespace())
 		}
 		retryCount++
-		return r.createOrUpdateResourceInternal(ctx, obj, owner)
+		return r.createOrUpdateResourceInternal(ctx, r.Client, obj, owner)
 	})
 
 	// Record metrics if we had conflicts
@@ -612,7 +943,7 @@ func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateUnstructured(ctx contex
 // The template comparison is essential for Neo4j cluster stability - without it, resource version conflicts
 // during reconciliation loops would cause the highest-indexed pods (Pod-2) to restart repeatedly, disrupting
 // cluster formation especially for Neo4j 2025.01.0 which is more sensitive to discovery timing.
-func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResourceInternal(ctx context.Context, obj client.Object, owner client.Object) error {
+func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResourceInternal(ctx context.Context, c client.Client, obj client.Object, owner client.Object) error {
 	// Set owner reference
 	if err := controllerutil.SetControllerReference(owner, obj, r.Scheme); err != nil {
 		return err
@@ -624,13 +955,29 @@ func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResourceInternal(ctx co
 		desiredSpec = *sts.Spec.DeepCopy()
 	}
 
+	// Source ranges, the selector and the ports are the only Service fields
+	// kept in sync after creation
+	var desiredSourceRanges []string
+	var desiredSelector map[string]string
+	var desiredPorts []corev1.ServicePort
+	if svc, ok := obj.(*corev1.Service); ok {
+		desiredSourceRanges = append([]string(nil), svc.Spec.LoadBalancerSourceRanges...)
+		desiredSelector = maps.Clone(svc.Spec.Selector)
+		desiredPorts = append([]corev1.ServicePort(nil), svc.Spec.Ports...)
+	}
+
 	logger := log.FromContext(ctx)
 	logger.Info("Starting CreateOrUpdate operation",
 		"resource", fmt.Sprintf("%T", obj),
 		"name", obj.GetName(),
 		"namespace", obj.GetNamespace())
 
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+	_, err := controllerutil.CreateOrUpdate(ctx, c, obj, func() error {
+		if svc, ok := obj.(*corev1.Service); ok {
+			svc.Spec.LoadBalancerSourceRanges = desiredSourceRanges
+			svc.Spec.Selector = desiredSelector
+			svc.Spec.Ports = keepNodePorts(desiredPorts, svc.Spec.Ports)
+		}
 		if sts, ok := obj.(*appsv1.StatefulSet); ok {
 			// Check if this is an update (object already exists in cluster)
 			// CRITICAL FIX: Use UID to determine if this is an existing object, not ResourceVersion
@@ -665,6 +1012,25 @@ func (r *Neo4jEnterpriseClusterReconciler) createOrUpdateResourceInternal(ctx co
 					for key, value := range sts.Spec.Selector.MatchLabels {
 						updatedTemplate.Labels[key] = value
 					}
+					// Keep plugin JAR delivery init containers (and their source
+					// volumes) injected by the Neo4jPlugin controller; they are not
+					// part of the desired template.
+					for _, c := range sts.Spec.Template.Spec.InitContainers {
+						if isPluginInitContainer(c) {
+							updatedTemplate.Spec.InitContainers = append(updatedTemplate.Spec.InitContainers, c)
+							if profile, ok := sts.Spec.Template.Annotations[resources.AppArmorAnnotationPrefix+c.Name]; ok {
+								if updatedTemplate.Annotations == nil {
+									updatedTemplate.Annotations = map[string]string{}
+								}
+								updatedTemplate.Annotations[resources.AppArmorAnnotationPrefix+c.Name] = profile
+							}
+						}
+					}
+					for _, v := range sts.Spec.Template.Spec.Volumes {
+						if isPluginSourceVolume(v) {
+							updatedTemplate.Spec.Volumes = append(updatedTemplate.Spec.Volumes, v)
+						}
+					}
 
 					// Check if template update is significant enough to warrant pod restarts
 					// This prevents resource version conflicts from causing unnecessary pod disruption
@@ -754,6 +1120,11 @@ func (r *Neo4jEnterpriseClusterReconciler) hasCriticalTemplateChanges(current, d
 		return true
 	}
 
+	// AppArmor profiles are set through annotations but are security context changes too
+	if !appArmorAnnotationsEqual(current.Annotations, desired.Annotations) {
+		return true
+	}
+
 	// Check for service account changes (critical for RBAC)
 	if current.Spec.ServiceAccountName != desired.Spec.ServiceAccountName {
 		return true
@@ -793,6 +1164,22 @@ func (r *Neo4jEnterpriseClusterReconciler) hasSignificantTemplateChanges(current
 		return true
 	}
 
+	// Changed config overrides only reach the servers when they restart
+	if current.Annotations[resources.ConfigOverridesHashAnnotation] != desired.Annotations[resources.ConfigOverridesHashAnnotation] {
+		return true
+	}
+
+	// So do rotated certificates
+	if current.Annotations[resources.TLSSecretHashAnnotation] != desired.Annotations[resources.TLSSecretHashAnnotation] {
+		return true
+	}
+	if current.Annotations[resources.ServerCertificatesHashAnnotation] != desired.Annotations[resources.ServerCertificatesHashAnnotation] {
+		return true
+	}
+	if current.Annotations[resources.CertificatesRestartAnnotation] != desired.Annotations[resources.CertificatesRestartAnnotation] {
+		return true
+	}
+
 	return false
 }
 
@@ -818,6 +1205,27 @@ func (r *Neo4jEnterpriseClusterReconciler) securityContextEqual(current, desired
 	return equality.Semantic.DeepEqual(current, desired)
 }
 
+// appArmorAnnotationsEqual compares only the per-container AppArmor annotations;
+// other pod annotations (e.g. restartedAt) are managed elsewhere.
+func appArmorAnnotationsEqual(current, desired map[string]string) bool {
+	count := 0
+	for key, value := range desired {
+		if !strings.HasPrefix(key, resources.AppArmorAnnotationPrefix) {
+			continue
+		}
+		if current[key] != value {
+			return false
+		}
+		count++
+	}
+	for key := range current {
+		if strings.HasPrefix(key, resources.AppArmorAnnotationPrefix) {
+			count--
+		}
+	}
+	return count == 0
+}
+
 func (r *Neo4jEnterpriseClusterReconciler) containerSecurityContextEqual(current, desired *corev1.SecurityContext) bool {
 	return equality.Semantic.DeepEqual(current, desired)
 }
@@ -872,6 +1280,15 @@ func envVarSourceEqual(a, b *corev1.EnvVarSource) bool {
 }
 
 func (r *Neo4jEnterpriseClusterReconciler) volumesEqual(current, desired []corev1.Volume) bool {
+	// Plugin source volumes belong to the plugin init containers, see initContainersEqual.
+	filtered := make([]corev1.Volume, 0, len(current))
+	for _, vol := range current {
+		if !isPluginSourceVolume(vol) {
+			filtered = append(filtered, vol)
+		}
+	}
+	current = filtered
+
 	if len(current) != len(desired) {
 		return false
 	}
@@ -895,6 +1312,16 @@ func (r *Neo4jEnterpriseClusterReconciler) volumesEqual(current, desired []corev
 }
 
 func (r *Neo4jEnterpriseClusterReconciler) initContainersEqual(current, desired []corev1.Container) bool {
+	// Plugin delivery init containers are owned by the Neo4jPlugin controller
+	// (same reasoning as the extra env vars tolerated in envVarsEqual).
+	filtered := make([]corev1.Container, 0, len(current))
+	for _, c := range current {
+		if !isPluginInitContainer(c) {
+			filtered = append(filtered, c)
+		}
+	}
+	current = filtered
+
 	if len(current) != len(desired) {
 		return false
 	}
@@ -918,6 +1345,7 @@ func (r *Neo4jEnterpriseClusterReconciler) initContainersEqual(current, desired
 func (r *Neo4jEnterpriseClusterReconciler) updateClusterStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, phase, message string) bool {
 	logger := log.FromContext(ctx)
 	statusChanged := false
+	var previousPhase string
 
 	update := func() error {
 		// Get latest version
@@ -958,6 +1386,7 @@ func (r *Neo4jEnterpriseClusterReconciler) updateClusterStatus(ctx context.Conte
 			"conditionNeedsUpdate", conditionNeedsUpdate)
 
 		// Update status fields
+		previousPhase = latest.Status.Phase
 		latest.Status.Phase = phase
 		latest.Status.Message = message
 
@@ -985,6 +1414,10 @@ func (r *Neo4jEnterpriseClusterReconciler) updateClusterStatus(ctx context.Conte
 		logger.Error(err, "Failed to update cluster status")
 		return false
 	}
+	if statusChanged {
+		recordPhaseChange(r.Recorder, cluster, previousPhase, phase, message)
+		refreshStackReadyCondition(ctx, r.Client, cluster.Namespace, cluster.Name)
+	}
 	return statusChanged
 }
 
@@ -1069,7 +1502,7 @@ func (r *Neo4jEnterpriseClusterReconciler) isUpgradeRequired(ctx context.Context
 		return false // StatefulSet has no containers defined
 	}
 	currentImage := serverSts.Spec.Template.Spec.Containers[0].Image
-	desiredImage := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
+	desiredImage := resources.ImageReference(cluster.Spec.Image)
 
 	return currentImage != desiredImage
 }
@@ -1084,6 +1517,37 @@ func (r *Neo4jEnterpriseClusterReconciler) handleRollingUpgrade(ctx context.Cont
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
+	// A rolled back upgrade is not retried until the target changes
+	if upgradeRolledBackFor(cluster) {
+		r.blockUpgrade(ctx, cluster, ConditionReasonUpgradeRolledBack,
+			fmt.Sprintf("Upgrade to %s was rolled back, set spec.image.tag to another version to retry", cluster.Spec.Image.Tag))
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	canary := cluster.Spec.UpgradeStrategy != nil && cluster.Spec.UpgradeStrategy.Strategy == "Canary"
+
+	// The servers keep the running image until the checks pass
+	ready, err := r.reconcileUpgradeChecks(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Failed to run the upgrade checks")
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if !ready {
+		if condition := findCondition(cluster.Status.Conditions, ConditionTypeUpgradeReady); condition != nil && condition.Status == metav1.ConditionUnknown {
+			return ctrl.Result{RequeueAfter: upgradeCheckRequeueInterval}, nil
+		}
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	if canary {
+		return r.startCanaryUpgrade(ctx, cluster)
+	}
+	return r.runRollingUpgrade(ctx, cluster)
+}
+
+// runRollingUpgrade rolls the servers to the new image, after a canary
+// upgrade the ones that still run the previous image
+func (r *Neo4jEnterpriseClusterReconciler) runRollingUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithName("rolling-upgrade-handler")
+
 	// Create Neo4j client for cluster health checks
 	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
 	if err != nil {
@@ -1097,8 +1561,19 @@ func (r *Neo4jEnterpriseClusterReconciler) handleRollingUpgrade(ctx context.Cont
 		}
 	}()
 
+	// A failed upgrade may be rolled back to the template the servers run
+	// now, or ran before the canary
+	previous, err := r.currentServerTemplate(ctx, cluster)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+	if upgrade := cluster.Status.UpgradeStatus; upgrade != nil && upgrade.Canary != nil {
+		previous.image = upgrade.Canary.PreviousImage
+	}
+
 	// Create rolling upgrade orchestrator
 	upgrader := NewRollingUpgradeOrchestrator(r.Client, cluster.Name, cluster.Namespace)
+	upgrader.Recorder = r.Recorder
 
 	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, EventReasonUpgradeStarted,
 		"Rolling upgrade started: %s -> %s", cluster.Status.Version, cluster.Spec.Image.Tag)
@@ -1107,6 +1582,10 @@ func (r *Neo4jEnterpriseClusterReconciler) handleRollingUpgrade(ctx context.Cont
 	if err := upgrader.ExecuteRollingUpgrade(ctx, cluster, neo4jClient); err != nil {
 		logger.Error(err, "Rolling upgrade failed")
 
+		if rolledBack, rollbackErr := r.rollBackFailedUpgrade(ctx, cluster, previous, err); rolledBack || rollbackErr != nil {
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, rollbackErr
+		}
+
 		// Check if auto-pause is enabled
 		if cluster.Spec.UpgradeStrategy != nil && cluster.Spec.UpgradeStrategy.AutoPauseOnFailure {
 			_ = r.updateClusterStatus(ctx, cluster, "Paused", "Upgrade paused due to failure - manual intervention required")
@@ -1135,14 +1614,8 @@ func (r *Neo4jEnterpriseClusterReconciler) handleRollingUpgrade(ctx context.Cont
 
 // createNeo4jClient creates a Neo4j client for cluster operations
 func (r *Neo4jEnterpriseClusterReconciler) createNeo4jClient(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*neo4jclient.Client, error) {
-	// Get admin credentials
-	adminSecretName := DefaultAdminSecretName
-	if cluster.Spec.Auth != nil && cluster.Spec.Auth.AdminSecret != "" {
-		adminSecretName = cluster.Spec.Auth.AdminSecret
-	}
-
 	// Create Neo4j client
-	neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, adminSecretName)
+	neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Neo4j client: %w", err)
 	}
@@ -1159,6 +1632,8 @@ func (r *Neo4jEnterpriseClusterReconciler) verifyNeo4jClusterFormation(ctx conte
 	if expectedServers == 1 {
 		return true, "Single server cluster - formation complete", nil
 	}
+	// Cordoned servers in maintenance are not counted as available
+	expectedServers -= len(cluster.Status.MaintenanceServers)
 
 	// First, check if Neo4j is ready to accept connections using legacy check
 	// Only run split-brain detection if we can connect to Neo4j
@@ -1365,6 +1840,14 @@ func NewQueryMonitor(client client.Client, scheme *runtime.Scheme) *QueryMonitor
 type QueryMonitor struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// Capabilities tell whether the Prometheus Operator API is served
+	Capabilities *capabilities.Capabilities
+	// Recorder emits index recommendation Events, skipped when nil
+	Recorder record.EventRecorder
+	// newSlowQueryClient connects to one server, replaced in tests
+	newSlowQueryClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (slowQueryClient, error)
+	// newIndexAdvisorClient connects to the cluster, replaced in tests
+	newIndexAdvisorClient func(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (indexAdvisorClient, error)
 }
 
 // ReconcileQueryMonitoring sets up query monitoring for the cluster
@@ -1393,6 +1876,11 @@ func (qm *QueryMonitor) setupMetricsCollection(ctx context.Context, cluster *neo
 	logger := log.FromContext(ctx)
 	logger.Info("Setting up metrics collection", "cluster", cluster.Name)
 
+	if !qm.Capabilities.Has(capabilities.ServiceMonitor) {
+		logger.Info("ServiceMonitor API not served; skipping metrics collection setup")
+		return nil
+	}
+
 	// Create ServiceMonitor for Prometheus integration
 	serviceMonitor := &unstructured.Unstructured{}
 	serviceMonitor.SetGroupVersionKind(schema.GroupVersionKind{
@@ -1446,6 +1934,11 @@ func (qm *QueryMonitor) setupAlertingRules(ctx context.Context, cluster *neo4jv1
 	logger := log.FromContext(ctx)
 	logger.Info("Setting up alerting rules", "cluster", cluster.Name)
 
+	if !qm.Capabilities.Has(capabilities.ServiceMonitor) {
+		logger.Info("Prometheus Operator API not served; skipping alerting rules setup")
+		return nil
+	}
+
 	// Create PrometheusRule for alerting
 	prometheusRule := &unstructured.Unstructured{}
 	prometheusRule.SetGroupVersionKind(schema.GroupVersionKind{
@@ -1608,7 +2101,12 @@ func (qm *QueryMonitor) updateServersCondition(cluster *neo4jv1alpha1.Neo4jEnter
 	}
 
 	var degraded []string
+	inMaintenance := 0
 	for _, s := range servers {
+		if serverInMaintenance(cluster, s) {
+			inMaintenance++
+			continue
+		}
 		if s.State != "Enabled" || s.Health != "Available" {
 			degraded = append(degraded, fmt.Sprintf("%s (state=%s health=%s)", s.Name, s.State, s.Health))
 		}
@@ -1620,10 +2118,13 @@ func (qm *QueryMonitor) updateServersCondition(cluster *neo4jv1alpha1.Neo4jEnter
 			ConditionReasonServerDegraded,
 			fmt.Sprintf("%d server(s) unhealthy: %s", len(degraded), strings.Join(degraded, ", ")))
 	} else {
+		message := fmt.Sprintf("All %d servers are Enabled and Available", len(servers))
+		if inMaintenance > 0 {
+			message = fmt.Sprintf("All %d servers out of maintenance are Enabled and Available", len(servers)-inMaintenance)
+		}
 		SetNamedCondition(&cluster.Status.Conditions, ConditionTypeServersHealthy,
 			cluster.Generation, metav1.ConditionTrue,
-			ConditionReasonAllServersHealthy,
-			fmt.Sprintf("All %d servers are Enabled and Available", len(servers)))
+			ConditionReasonAllServersHealthy, message)
 	}
 }
 
@@ -1779,6 +2280,10 @@ func (r *Neo4jEnterpriseClusterReconciler) reconcileRoute(ctx context.Context, c
 	if route == nil {
 		return nil
 	}
+	if !r.Capabilities.Has(capabilities.Route) {
+		logger.V(1).Info("Route API not served; skipping Route reconciliation")
+		return nil
+	}
 
 	if err := controllerutil.SetControllerReference(cluster, route, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set owner reference on route: %w", err)
@@ -1845,6 +2350,10 @@ func (r *Neo4jEnterpriseClusterReconciler) reconcileMCPRoute(ctx context.Context
 	if route == nil {
 		return nil
 	}
+	if !r.Capabilities.Has(capabilities.Route) {
+		logger.V(1).Info("Route API not served; skipping MCP Route reconciliation")
+		return nil
+	}
 
 	if err := controllerutil.SetControllerReference(cluster, route, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set owner reference on MCP route: %w", err)
@@ -1969,7 +2478,7 @@ func (r *Neo4jEnterpriseClusterReconciler) reconcileAuraFleetManagement(ctx cont
 	}
 	token := strings.TrimSpace(string(tokenBytes))
 
-	neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
+	neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 	if err != nil {
 		return r.setFleetManagementStatus(ctx, cluster, false, fmt.Sprintf("cannot connect to Neo4j: %v", err))
 	}
@@ -2077,8 +2586,13 @@ func (r *Neo4jEnterpriseClusterReconciler) SetupWithManager(mgr ctrl.Manager) er
 		// Note: Removed ConfigMap from Owns() to prevent reconciliation feedback loops
 		// ConfigMaps are managed manually by ConfigMapManager with debounce
 		Owns(&corev1.Secret{}).
+		Watches(&neo4jv1alpha1.Neo4jClusterClass{}, handler.EnqueueRequestsFromMapFunc(r.clustersForClass)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.clustersForLifecycleConfigMap)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.clustersForTLSSecret)).
+		Watches(&neo4jv1alpha1.Neo4jUserSync{}, handler.EnqueueRequestsFromMapFunc(userSyncTargetRequests)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1, // Limit concurrent reconciliations
+			NewQueue:                observedQueue,
 			RateLimiter: workqueue.NewTypedMaxOfRateLimiter(
 				// Exponential backoff starting at 5 seconds
 				workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](5*time.Second, 30*time.Second),
@@ -2097,5 +2611,23 @@ func (r *Neo4jEnterpriseClusterReconciler) SetupWithManager(mgr ctrl.Manager) er
 		builder = builder.WatchesRawSource(source.Kind(mgr.GetCache(), routeObj, routeHandler))
 	}
 
-	return builder.Complete(r)
+	return builder.Complete(observeReconcileErrors("neo4jenterprisecluster", r))
+}
+
+// keepNodePorts returns the desired ports of a Service with the node ports
+// allocated to the existing ports of the same name, so that updating the
+// ports does not move them
+func keepNodePorts(desired, existing []corev1.ServicePort) []corev1.ServicePort {
+	ports := make([]corev1.ServicePort, len(desired))
+	for i, port := range desired {
+		if port.NodePort == 0 {
+			for _, existingPort := range existing {
+				if existingPort.Name == port.Name {
+					port.NodePort = existingPort.NodePort
+				}
+			}
+		}
+		ports[i] = port
+	}
+	return ports
 }
@@ -25,6 +25,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -47,6 +49,7 @@ import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
 	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
@@ -60,6 +63,9 @@ type Neo4jEnterpriseStandaloneReconciler struct {
 	RequeueAfter     time.Duration
 	Validator        *validation.StandaloneValidator
 	ConfigMapManager *ConfigMapManager
+	// Capabilities are the optional APIs the cluster serves. Resources of
+	// APIs it lacks are skipped. Nil tries every API.
+	Capabilities *capabilities.Capabilities
 }
 
 func podSecurityContextForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) *corev1.PodSecurityContext {
@@ -97,21 +103,6 @@ func containerSecurityContextForStandalone(standalone *neo4jv1alpha1.Neo4jEnterp
 	}
 }
 
-// standaloneImagePullSecrets converts the standalone's image pull secret names to []corev1.LocalObjectReference.
-func standaloneImagePullSecrets(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) []corev1.LocalObjectReference {
-	if len(standalone.Spec.Image.PullSecrets) == 0 {
-		return nil
-	}
-	refs := make([]corev1.LocalObjectReference, 0, len(standalone.Spec.Image.PullSecrets))
-	for _, name := range standalone.Spec.Image.PullSecrets {
-		if name == "" {
-			continue
-		}
-		refs = append(refs, corev1.LocalObjectReference{Name: name})
-	}
-	return refs
-}
-
 const (
 	// StandaloneFinalizer is the finalizer for Neo4j enterprise standalone deployments
 	StandaloneFinalizer = "neo4j.neo4j.com/standalone-finalizer"
@@ -168,6 +159,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) Reconcile(ctx context.Context, req
 		if err := r.Status().Update(ctx, standalone); err != nil {
 			logger.Error(err, "Failed to update status")
 		}
+		recordValidationFailure(ctx)
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
@@ -201,6 +193,8 @@ func (r *Neo4jEnterpriseStandaloneReconciler) handleDeletion(ctx context.Context
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
 	}
 
+	neo4jclient.InvalidateDrivers(standalone.UID)
+
 	// Remove finalizer
 	controllerutil.RemoveFinalizer(standalone, StandaloneFinalizer)
 	if err := r.Update(ctx, standalone); err != nil {
@@ -237,9 +231,17 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStandalone(ctx context.Co
 	if err := r.reconcileMCP(ctx, standalone); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile MCP resources: %w", err)
 	}
+	setOptionalAPIsCondition(ctx, r.Client, r.Capabilities, standalone, standaloneOptionalAPIs(standalone))
+
+	// Work out whether the active hours schedule keeps the instance running
+	now := time.Now()
+	schedule, err := evaluateSchedule(standalone, now)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to evaluate schedule: %w", err)
+	}
 
 	// Reconcile StatefulSet
-	if err := r.reconcileStatefulSet(ctx, standalone); err != nil {
+	if err := r.reconcileStatefulSet(ctx, standalone, schedule); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile StatefulSet: %w", err)
 	}
 
@@ -267,12 +269,21 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStandalone(ctx context.Co
 	}
 
 	// Update status once at the end
-	if err := r.updateStatus(ctx, standalone); err != nil {
+	if err := r.updateStatus(ctx, standalone, schedule); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
 	}
 
-	// Reconcile Aura Fleet Management registration if enabled (non-fatal if it fails)
-	if standalone.Spec.AuraFleetManagement != nil && standalone.Spec.AuraFleetManagement.Enabled {
+	// The operator connects as the admin until its own user is set up
+	scheduledStop := schedule != nil && !schedule.Active
+	if standalone.Status.Phase == "Ready" && !scheduledStop {
+		if err := r.reconcileOperatorUser(ctx, standalone); err != nil {
+			logger.Error(err, "Failed to set up the operator user")
+		}
+	}
+
+	// Reconcile Aura Fleet Management registration if enabled (non-fatal if it fails).
+	// A stopped instance has no server to register.
+	if standalone.Spec.AuraFleetManagement != nil && standalone.Spec.AuraFleetManagement.Enabled && !scheduledStop {
 		if err := r.reconcileAuraFleetManagement(ctx, standalone); err != nil {
 			logger.Error(err, "Failed to reconcile Aura Fleet Management registration")
 			if r.Recorder != nil {
@@ -283,7 +294,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStandalone(ctx context.Co
 	}
 
 	logger.Info("Successfully reconciled Neo4jEnterpriseStandalone")
-	return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	return ctrl.Result{RequeueAfter: scheduledRequeue(schedule, r.RequeueAfter, now)}, nil
 }
 
 // reconcileConfigMap reconciles the ConfigMap for the standalone deployment
@@ -327,9 +338,11 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileService(ctx context.Conte
 	}
 
 	// Create or update Service with retry logic to handle resource version conflicts
+	sourceRanges := service.Spec.LoadBalancerSourceRanges
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, service, func() error {
-			// Service updates for standalone deployments
+			// Source ranges are the only Service field kept in sync after creation
+			service.Spec.LoadBalancerSourceRanges = sourceRanges
 			return nil
 		})
 		return err
@@ -339,6 +352,12 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileService(ctx context.Conte
 	}
 	logger.Info("Successfully created or updated Service", "name", service.Name)
 
+	// Enforce allowedCIDRs where the Service cannot apply source ranges
+	if err := reconcileClientNetworkPolicy(ctx, r.Client, r.Scheme, standalone,
+		r.createService(standalone), standalone.Spec.Service); err != nil {
+		return err
+	}
+
 	// Reconcile OpenShift Route if requested
 	if err := r.reconcileRoute(ctx, standalone); err != nil {
 		return err
@@ -348,7 +367,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileService(ctx context.Conte
 }
 
 // reconcileStatefulSet reconciles the StatefulSet for the standalone deployment
-func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStatefulSet(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) error {
+func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStatefulSet(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone, schedule *neo4jv1alpha1.StandaloneScheduleStatus) error {
 	logger := log.FromContext(ctx)
 
 	// Create StatefulSet using the standalone configuration
@@ -359,10 +378,19 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStatefulSet(ctx context.C
 		return fmt.Errorf("failed to set owner reference: %w", err)
 	}
 
+	tlsHash, err := tlsSecretHash(ctx, r.Client, standalone.Namespace, standalone.Spec.TLS, standaloneSSLPolicyScopes)
+	if err != nil {
+		return err
+	}
+
 	// Create or update StatefulSet with retry logic to handle resource version conflicts
-	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	var transition string
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, statefulSet, func() error {
 			// StatefulSet template updates for standalone deployments
+			transition = applyScheduledReplicas(statefulSet, schedule)
+			// A rotated certificate restarts the server
+			setTLSSecretHash(&statefulSet.Spec.Template, tlsHash)
 			return nil
 		})
 		return err
@@ -372,6 +400,10 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileStatefulSet(ctx context.C
 	}
 	logger.Info("Successfully created or updated StatefulSet", "name", statefulSet.Name)
 
+	if transition != "" && r.Recorder != nil {
+		r.Recorder.Event(standalone, corev1.EventTypeNormal, transition, scheduleTransitionMessage(transition, schedule))
+	}
+
 	return nil
 }
 
@@ -421,6 +453,10 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileRoute(ctx context.Context
 	if route == nil {
 		return nil
 	}
+	if !r.Capabilities.Has(capabilities.Route) {
+		logger.V(1).Info("Route API not served; skipping Route reconciliation")
+		return nil
+	}
 
 	if err := controllerutil.SetControllerReference(standalone, route, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set owner reference on route: %w", err)
@@ -487,6 +523,10 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileMCPRoute(ctx context.Cont
 	if route == nil {
 		return nil
 	}
+	if !r.Capabilities.Has(capabilities.Route) {
+		logger.V(1).Info("Route API not served; skipping MCP Route reconciliation")
+		return nil
+	}
 
 	if err := controllerutil.SetControllerReference(standalone, route, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set owner reference on MCP route: %w", err)
@@ -566,42 +606,52 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createConfigMap(standalone *neo4jv
 
 	// Add basic server configuration
 	configLines = append(configLines, "# Basic Server Configuration")
-	configLines = append(configLines, "server.default_listen_address=0.0.0.0")
+	configLines = append(configLines, "server.default_listen_address="+resources.ListenHost(standalone.Spec.Service))
 	configLines = append(configLines, "server.bolt.enabled=true")
 	configLines = append(configLines, "server.bolt.listen_address=:7687")
 	configLines = append(configLines, "server.http.enabled=true")
 	configLines = append(configLines, "server.http.listen_address=:7474")
+	// Delivered plugin JARs land in the plugins volume
+	configLines = append(configLines, "server.directories.plugins=/plugins")
 	configLines = append(configLines, "")
+	if jvmConfig := resources.IPFamilyJVMConfig(standalone.Spec.Service); jvmConfig != "" {
+		configLines = append(configLines, strings.Split(strings.TrimSpace(jvmConfig), "\n")...)
+		configLines = append(configLines, "")
+	}
 
 	// Add TLS configuration if enabled
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(standalone.Spec.TLS) {
 		configLines = append(configLines, "# TLS Configuration")
 		configLines = append(configLines, "server.https.enabled=true")
-		configLines = append(configLines, "server.https.listen_address=0.0.0.0:7473")
+		configLines = append(configLines, "server.https.listen_address="+resources.ListenAddress(standalone.Spec.Service, resources.HTTPSPort))
 		configLines = append(configLines, "server.bolt.enabled=true")
-		configLines = append(configLines, "server.bolt.listen_address=0.0.0.0:7687")
+		configLines = append(configLines, "server.bolt.listen_address="+resources.ListenAddress(standalone.Spec.Service, resources.BoltPort))
 		configLines = append(configLines, "server.bolt.tls_level=REQUIRED")
 		configLines = append(configLines, "")
 		configLines = append(configLines, "# SSL Policy for HTTPS")
-		configLines = append(configLines, "dbms.ssl.policy.https.enabled=true")
-		configLines = append(configLines, "dbms.ssl.policy.https.base_directory=/ssl")
-		configLines = append(configLines, "dbms.ssl.policy.https.private_key=tls.key")
-		configLines = append(configLines, "dbms.ssl.policy.https.public_certificate=tls.crt")
-		configLines = append(configLines, "dbms.ssl.policy.https.client_auth=NONE")
-		configLines = append(configLines, "dbms.ssl.policy.https.tls_versions=TLSv1.3,TLSv1.2")
+		configLines = append(configLines, resources.BuildSSLPolicyConfig(standalone.Name, standalone.Spec.TLS, resources.SSLPolicyHTTPS, false)...)
 		configLines = append(configLines, "")
 		configLines = append(configLines, "# SSL Policy for Bolt")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.enabled=true")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.base_directory=/ssl")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.private_key=tls.key")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.public_certificate=tls.crt")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.client_auth=NONE")
-		configLines = append(configLines, "dbms.ssl.policy.bolt.tls_versions=TLSv1.3,TLSv1.2")
+		configLines = append(configLines, resources.BuildSSLPolicyConfig(standalone.Name, standalone.Spec.TLS, resources.SSLPolicyBolt, false)...)
 		configLines = append(configLines, "")
+		if backup := resources.BuildSSLPolicyConfig(standalone.Name, standalone.Spec.TLS, resources.SSLPolicyBackup, false); backup != nil {
+			configLines = append(configLines, "# SSL Policy for Backup")
+			configLines = append(configLines, backup...)
+			configLines = append(configLines, "")
+		}
 	}
 
 	if standalone.Spec.QueryMonitoring != nil && standalone.Spec.QueryMonitoring.Enabled {
-		configLines = append(configLines, strings.Split(resources.BuildQueryMonitoringConfig(standalone.Spec.QueryMonitoring), "\n")...)
+		configLines = append(configLines, strings.Split(resources.BuildQueryMonitoringConfig(standalone.Spec.QueryMonitoring, standalone.Spec.Service), "\n")...)
+		configLines = append(configLines, "")
+	}
+
+	if resources.LogForwarderEnabled(standalone.Spec.Logging) {
+		// The forwarder tails the logs volume
+		configLines = append(configLines, "server.directories.logs=/logs")
+	}
+	if loggingConfig := resources.BuildLoggingConfig(standalone.Spec.Logging); loggingConfig != "" {
+		configLines = append(configLines, strings.Split(strings.TrimSpace(loggingConfig), "\n")...)
 		configLines = append(configLines, "")
 	}
 
@@ -621,7 +671,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createConfigMap(standalone *neo4jv
 	// Join all lines
 	neo4jConf := strings.Join(configLines, "\n")
 
-	return &corev1.ConfigMap{
+	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-config", standalone.Name),
 			Namespace: standalone.Namespace,
@@ -630,6 +680,10 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createConfigMap(standalone *neo4jv
 			"neo4j.conf": neo4jConf,
 		},
 	}
+	for name, content := range resources.BuildLoggingConfigFiles(standalone.Spec.Logging) {
+		configMap.Data[name] = content
+	}
+	return configMap
 }
 
 // createService creates a Service for the standalone deployment
@@ -672,7 +726,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createService(standalone *neo4jv1a
 	}
 
 	// Add HTTPS port if TLS is enabled
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(standalone.Spec.TLS) {
 		ports = append(ports, corev1.ServicePort{
 			Name:       "https",
 			Port:       7473,
@@ -694,7 +748,9 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createService(standalone *neo4jv1a
 			},
 		},
 		Spec: corev1.ServiceSpec{
-			Type: serviceType,
+			Type:           serviceType,
+			IPFamilyPolicy: resources.ServiceIPFamilyPolicy(standalone.Spec.Service),
+			IPFamilies:     resources.ServiceIPFamilies(standalone.Spec.Service),
 			Selector: map[string]string{
 				"app": standalone.Name,
 			},
@@ -708,9 +764,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createService(standalone *neo4jv1a
 		if standalone.Spec.Service.LoadBalancerIP != "" {
 			svc.Spec.LoadBalancerIP = standalone.Spec.Service.LoadBalancerIP
 		}
-		if len(standalone.Spec.Service.LoadBalancerSourceRanges) > 0 {
-			svc.Spec.LoadBalancerSourceRanges = standalone.Spec.Service.LoadBalancerSourceRanges
-		}
+		svc.Spec.LoadBalancerSourceRanges = resources.ServiceSourceRanges(standalone.Spec.Service)
 
 		// External traffic policy
 		if standalone.Spec.Service.ExternalTrafficPolicy != "" {
@@ -762,7 +816,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createStatefulSet(standalone *neo4
 		})
 	}
 
-	return &appsv1.StatefulSet{
+	sts := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      standalone.Name,
 			Namespace: standalone.Namespace,
@@ -785,7 +839,8 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createStatefulSet(standalone *neo4
 					Containers: []corev1.Container{
 						{
 							Name:            "neo4j",
-							Image:           fmt.Sprintf("%s:%s", standalone.Spec.Image.Repo, standalone.Spec.Image.Tag),
+							Image:           resources.ImageReference(standalone.Spec.Image),
+							ImagePullPolicy: resources.ImagePullPolicy(standalone.Spec.Image),
 							SecurityContext: containerSecurityContextForStandalone(standalone),
 							Ports:           ports,
 							Env:             r.buildEnvVars(standalone),
@@ -804,7 +859,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createStatefulSet(standalone *neo4
 					NodeSelector:     standalone.Spec.NodeSelector,
 					Tolerations:      standalone.Spec.Tolerations,
 					Affinity:         standalone.Spec.Affinity,
-					ImagePullSecrets: standaloneImagePullSecrets(standalone),
+					ImagePullSecrets: resources.ImagePullSecrets(standalone.Spec.Image),
 				},
 			},
 			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
@@ -827,10 +882,17 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createStatefulSet(standalone *neo4
 			},
 		},
 	}
+	if resources.LogForwarderEnabled(standalone.Spec.Logging) {
+		sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers,
+			resources.BuildLogForwarderContainer(standalone.Name, standalone.Spec.Logging, "neo4j-config", "neo4j-logs",
+				containerSecurityContextForStandalone(standalone)))
+	}
+	resources.ApplySecurityProfiles(&sts.Spec.Template, standalone.Spec.SecurityContext)
+	return sts
 }
 
 // updateStatus updates the status of the standalone deployment
-func (r *Neo4jEnterpriseStandaloneReconciler) updateStatus(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) error {
+func (r *Neo4jEnterpriseStandaloneReconciler) updateStatus(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone, schedule *neo4jv1alpha1.StandaloneScheduleStatus) error {
 	logger := log.FromContext(ctx)
 
 	// Get the latest version of the resource to avoid conflicts
@@ -853,6 +915,13 @@ func (r *Neo4jEnterpriseStandaloneReconciler) updateStatus(ctx context.Context,
 		phase = "Ready"
 		message = "Standalone deployment is ready"
 		ready = true
+	} else if schedule != nil && !schedule.Active {
+		phase = "Stopped"
+		message = "Stopped outside active hours"
+		if schedule.NextTransition != nil {
+			message = fmt.Sprintf("Stopped outside active hours, starting at %s", schedule.NextTransition.UTC().Format(time.RFC3339))
+		}
+		ready = false
 	} else {
 		phase = "Pending"
 		message = "Waiting for standalone deployment to be ready"
@@ -864,16 +933,19 @@ func (r *Neo4jEnterpriseStandaloneReconciler) updateStatus(ctx context.Context,
 		latestStandalone.Status.Message == message &&
 		latestStandalone.Status.Ready == ready &&
 		latestStandalone.Status.Version == standalone.Spec.Image.Tag &&
-		latestStandalone.Status.Endpoints != nil {
+		latestStandalone.Status.Endpoints != nil &&
+		equality.Semantic.DeepEqual(latestStandalone.Status.Schedule, schedule) {
 		logger.V(1).Info("Status unchanged, skipping update")
 		return nil
 	}
 
 	// Update status on the latest version
+	previousPhase := latestStandalone.Status.Phase
 	latestStandalone.Status.Phase = phase
 	latestStandalone.Status.Message = message
 	latestStandalone.Status.Ready = ready
 	latestStandalone.Status.Version = standalone.Spec.Image.Tag
+	latestStandalone.Status.Schedule = schedule
 
 	// Update Ready condition using standard helper
 	condStatus, condReason := PhaseToConditionStatus(phase)
@@ -893,6 +965,8 @@ func (r *Neo4jEnterpriseStandaloneReconciler) updateStatus(ctx context.Context,
 	}
 
 	logger.V(1).Info("Status updated successfully", "phase", phase, "ready", ready)
+	recordPhaseChange(r.Recorder, standalone, previousPhase, phase, message)
+	refreshStackReadyCondition(ctx, r.Client, standalone.Namespace, standalone.Name)
 	return nil
 }
 
@@ -1010,13 +1084,26 @@ func (r *Neo4jEnterpriseStandaloneReconciler) buildVolumeMounts(standalone *neo4
 		ReadOnly:  true,
 	})
 
+	// Plugin JARs delivered by the plugin controller's init containers
+	volumeMounts = append(volumeMounts, pluginsVolumeMount())
+
 	// Add TLS certificate mount if TLS is enabled
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(standalone.Spec.TLS) {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "neo4j-certs",
 			MountPath: "/ssl",
 			ReadOnly:  true,
 		})
+		_, policyMounts := resources.BuildSSLPolicyVolumes(standalone.Name, standalone.Spec.TLS, standaloneSSLPolicyScopes)
+		volumeMounts = append(volumeMounts, policyMounts...)
+	}
+
+	// Share the logs with the log forwarder
+	if resources.LogForwarderEnabled(standalone.Spec.Logging) {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "neo4j-logs",
+			MountPath: "/logs",
+		})
 	}
 
 	return volumeMounts
@@ -1039,15 +1126,13 @@ func (r *Neo4jEnterpriseStandaloneReconciler) buildVolumes(standalone *neo4jv1al
 	})
 
 	// Add TLS certificate volume if TLS is enabled
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(standalone.Spec.TLS) {
 		volumes = append(volumes, corev1.Volume{
-			Name: "neo4j-certs",
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: fmt.Sprintf("%s-tls-secret", standalone.Name),
-				},
-			},
+			Name:         "neo4j-certs",
+			VolumeSource: resources.TLSSecretVolumeSource(standalone.Spec.TLS, resources.TLSSecretName(standalone.Name, standalone.Spec.TLS)),
 		})
+		policyVolumes, _ := resources.BuildSSLPolicyVolumes(standalone.Name, standalone.Spec.TLS, standaloneSSLPolicyScopes)
+		volumes = append(volumes, policyVolumes...)
 	}
 
 	// Add backup requests volume for backup sidecar
@@ -1058,6 +1143,17 @@ func (r *Neo4jEnterpriseStandaloneReconciler) buildVolumes(standalone *neo4jv1al
 		},
 	})
 
+	volumes = append(volumes, pluginsVolume())
+
+	if resources.LogForwarderEnabled(standalone.Spec.Logging) {
+		volumes = append(volumes, corev1.Volume{
+			Name: "neo4j-logs",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
 	return volumes
 }
 
@@ -1093,7 +1189,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileTLSCertificate(ctx contex
 		}
 	}
 
-	return nil
+	return reconcileSSLPolicyCertificates(ctx, r.Client, r.Scheme, standalone, certificate, standalone.Spec.TLS, standaloneSSLPolicyScopes)
 }
 
 // createTLSCertificate creates a TLS certificate for the standalone deployment
@@ -1124,8 +1220,8 @@ func (r *Neo4jEnterpriseStandaloneReconciler) createTLSCertificate(standalone *n
 func (r *Neo4jEnterpriseStandaloneReconciler) buildBackupSidecarContainer(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) corev1.Container {
 	return corev1.Container{
 		Name:            "backup-sidecar",
-		Image:           fmt.Sprintf("%s:%s", standalone.Spec.Image.Repo, standalone.Spec.Image.Tag),
-		ImagePullPolicy: corev1.PullPolicy(standalone.Spec.Image.PullPolicy),
+		Image:           resources.ImageReference(standalone.Spec.Image),
+		ImagePullPolicy: resources.ImagePullPolicy(standalone.Spec.Image),
 		SecurityContext: containerSecurityContextForStandalone(standalone),
 		Command: []string{
 			"/bin/bash",
@@ -1343,7 +1439,10 @@ func (r *Neo4jEnterpriseStandaloneReconciler) SetupWithManager(mgr ctrl.Manager)
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
-		Owns(&networkingv1.Ingress{})
+		Owns(&networkingv1.Ingress{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.standalonesForTLSSecret)).
+		Watches(&neo4jv1alpha1.Neo4jUserSync{}, handler.EnqueueRequestsFromMapFunc(userSyncTargetRequests)).
+		WithOptions(controller.Options{NewQueue: observedQueue})
 
 	// Only watch Certificate resources if cert-manager is available
 	// This allows tests to run without cert-manager CRDs
@@ -1359,7 +1458,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) SetupWithManager(mgr ctrl.Manager)
 		builder = builder.WatchesRawSource(source.Kind(mgr.GetCache(), routeObj, routeHandler))
 	}
 
-	return builder.Complete(r)
+	return builder.Complete(observeReconcileErrors("neo4jenterprisestandalone", r))
 }
 
 // reconcileAuraFleetManagement handles Aura Fleet Management for standalone deployments.
@@ -1421,7 +1520,7 @@ func (r *Neo4jEnterpriseStandaloneReconciler) reconcileAuraFleetManagement(ctx c
 	}
 	token := strings.TrimSpace(string(tokenBytes))
 
-	neo4jClient, err := neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneAdminSecretName(standalone))
+	neo4jClient, err := neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
 	if err != nil {
 		return r.setFleetManagementStatus(ctx, standalone, false, fmt.Sprintf("cannot connect to Neo4j: %v", err))
 	}
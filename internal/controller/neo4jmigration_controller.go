@@ -0,0 +1,464 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// Neo4jMigrationReconciler reconciles a Neo4jMigration object
+type Neo4jMigrationReconciler struct {
+	client.Client
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	MaxConcurrentReconciles int
+	RequeueAfter            time.Duration
+}
+
+const (
+	migrationStateApplied          = "Applied"
+	migrationStatePending          = "Pending"
+	migrationStateFailed           = "Failed"
+	migrationStateChecksumMismatch = "ChecksumMismatch"
+)
+
+// migrationScript is a script of the spec with its statements loaded.
+type migrationScript struct {
+	version     string
+	description string
+	statements  []string
+	checksum    string
+}
+
+// migrationPlan is the state of every script of the spec and the scripts
+// left to apply, in order.
+type migrationPlan struct {
+	results []neo4jv1alpha1.MigrationScriptStatus
+	pending []int
+	// failure stops the migration before any script is applied
+	failure string
+}
+
+// migrationClient is the part of the Neo4j client that applies migrations.
+type migrationClient interface {
+	ApplyMigration(ctx context.Context, databaseName, migration string, script neo4jclient.AppliedMigration, statements []string) (time.Duration, error)
+}
+
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jmigrations/finalizers,verbs=update
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile applies the scripts of the migration that the history of the
+// database does not list yet, in version order, stopping at the first
+// failure. A failed migration is retried once its spec changes. Deleting a
+// Neo4jMigration leaves the database and its history in place.
+func (r *Neo4jMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	migration := &neo4jv1alpha1.Neo4jMigration{}
+	if err := r.Get(ctx, req.NamespacedName, migration); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Neo4jMigration resource not found")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Neo4jMigration")
+		return ctrl.Result{}, err
+	}
+	if migration.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+	scripts, err := r.loadMigrationScripts(ctx, migration)
+	if err != nil {
+		var sourceErr *migrationSourceError
+		if stderrors.As(err, &sourceErr) {
+			// ConfigMaps change without a new generation, so keep reading them
+			r.updateMigrationStatus(ctx, migration, "Pending", err.Error(), nil)
+			return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+		}
+		r.failMigration(ctx, migration, err.Error(), nil)
+		return ctrl.Result{}, nil
+	}
+	if migrationFailureUnchanged(migration, scripts) {
+		// Scripts are not idempotent in general, so a failed script is only
+		// run again once it or the spec changed
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	neo4jClient, err := connectClusterRef(ctx, r.Client, migration.Namespace, migration.Spec.ClusterRef)
+	if err != nil {
+		r.updateMigrationStatus(ctx, migration, "Pending", err.Error(), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	defer neo4jClient.Close()
+
+	database := migrationDatabase(migration)
+	applied, err := neo4jClient.ListAppliedMigrations(ctx, database, migration.Name)
+	if err != nil {
+		r.updateMigrationStatus(ctx, migration, "Pending", fmt.Sprintf("Failed to read migration history: %v", err), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	plan := planMigration(scripts, applied, migration.Spec.OutOfOrder, migration.Spec.IgnoreChecksumMismatch)
+	if plan.failure != "" {
+		r.failMigration(ctx, migration, plan.failure, plan.results)
+		return ctrl.Result{}, nil
+	}
+	if len(plan.pending) > 0 {
+		r.updateMigrationStatus(ctx, migration, "Migrating",
+			fmt.Sprintf("Applying %d scripts to database %s", len(plan.pending), database), plan.results)
+	}
+
+	appliedCount, failure := r.applyMigrationPlan(ctx, neo4jClient, migration, scripts, &plan)
+	if appliedCount > 0 {
+		r.Recorder.Eventf(migration, corev1.EventTypeNormal, EventReasonMigrationApplied,
+			"Applied %d scripts to database %s, now at version %s", appliedCount, database, currentMigrationVersion(plan.results))
+	}
+	if failure != "" {
+		r.failMigration(ctx, migration, failure, plan.results)
+		return ctrl.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Database %s is at version %s", database, currentMigrationVersion(plan.results))
+	r.updateMigrationStatus(ctx, migration, "Migrated", message, plan.results)
+	return ctrl.Result{}, nil
+}
+
+// migrationFailureUnchanged reports whether the last run of the current
+// generation failed applying a script that has not changed since.
+func migrationFailureUnchanged(migration *neo4jv1alpha1.Neo4jMigration, scripts []migrationScript) bool {
+	if migration.Status.Phase != "Failed" || migration.Status.ObservedGeneration != migration.Generation {
+		return false
+	}
+	for _, result := range migration.Status.Scripts {
+		if result.State != migrationStateFailed {
+			continue
+		}
+		for _, script := range scripts {
+			if script.version == result.Version {
+				return script.checksum == result.Checksum
+			}
+		}
+	}
+	return false
+}
+
+// migrationSourceError reports a script whose ConfigMap is missing or does
+// not hold a valid script.
+type migrationSourceError struct {
+	err error
+}
+
+func (e *migrationSourceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *migrationSourceError) Unwrap() error {
+	return e.err
+}
+
+func migrationDatabase(migration *neo4jv1alpha1.Neo4jMigration) string {
+	if migration.Spec.Database != "" {
+		return migration.Spec.Database
+	}
+	return "neo4j"
+}
+
+// loadMigrationScripts reads the scripts of the spec, splits them into
+// statements and checks that their versions ascend.
+func (r *Neo4jMigrationReconciler) loadMigrationScripts(ctx context.Context, migration *neo4jv1alpha1.Neo4jMigration) ([]migrationScript, error) {
+	scripts := make([]migrationScript, 0, len(migration.Spec.Scripts))
+	for i, spec := range migration.Spec.Scripts {
+		if i > 0 {
+			if previous := migration.Spec.Scripts[i-1].Version; compareMigrationVersions(spec.Version, previous) <= 0 {
+				return nil, fmt.Errorf("script version %s must be greater than the version %s before it", spec.Version, previous)
+			}
+		}
+
+		var cypher string
+		fromConfigMap := spec.ConfigMapRef != nil
+		switch {
+		case (spec.Cypher == "") == (spec.ConfigMapRef == nil):
+			return nil, fmt.Errorf("script version %s must set exactly one of cypher and configMapRef", spec.Version)
+		case spec.ConfigMapRef != nil:
+			ref := spec.ConfigMapRef
+			configMap := &corev1.ConfigMap{}
+			if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: migration.Namespace}, configMap); err != nil {
+				return nil, &migrationSourceError{fmt.Errorf("failed to get ConfigMap %s of script version %s: %w", ref.Name, spec.Version, err)}
+			}
+			var ok bool
+			if cypher, ok = configMap.Data[ref.Key]; !ok {
+				return nil, &migrationSourceError{fmt.Errorf("ConfigMap %s of script version %s has no key %q", ref.Name, spec.Version, ref.Key)}
+			}
+		default:
+			cypher = spec.Cypher
+		}
+
+		statements, err := neo4jclient.SplitCypherStatements(cypher)
+		if err == nil && len(statements) == 0 {
+			err = stderrors.New("no statements")
+		}
+		if err != nil {
+			err = fmt.Errorf("script version %s: %w", spec.Version, err)
+			if fromConfigMap {
+				return nil, &migrationSourceError{err}
+			}
+			return nil, err
+		}
+		scripts = append(scripts, migrationScript{
+			version:     spec.Version,
+			description: spec.Description,
+			statements:  statements,
+			checksum:    migrationChecksum(statements),
+		})
+	}
+	return scripts, nil
+}
+
+// migrationChecksum hashes the statements of a script, so that whitespace
+// around statements can change without the script counting as modified.
+func migrationChecksum(statements []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(statements, ";\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// compareMigrationVersions compares two dotted versions numerically segment
+// by segment, with missing segments counting as zero, so that "1.10" is
+// after "1.9" and "1" equals "1.0".
+func compareMigrationVersions(a, b string) int {
+	left, right := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var x, y string
+		if i < len(left) {
+			x = strings.TrimLeft(left[i], "0")
+		}
+		if i < len(right) {
+			y = strings.TrimLeft(right[i], "0")
+		}
+		// Segments are digits only, so the longer one is the larger number
+		if len(x) != len(y) {
+			if len(x) < len(y) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// planMigration compares the scripts with the history of the database. A
+// changed applied script, or an unapplied one older than the latest applied
+// version, fails the migration unless the spec allows it.
+func planMigration(scripts []migrationScript, applied []neo4jclient.AppliedMigration, outOfOrder, ignoreChecksumMismatch bool) migrationPlan {
+	history := make(map[string]neo4jclient.AppliedMigration, len(applied))
+	latest := ""
+	for _, entry := range applied {
+		history[entry.Version] = entry
+		if latest == "" || compareMigrationVersions(entry.Version, latest) > 0 {
+			latest = entry.Version
+		}
+	}
+
+	var plan migrationPlan
+	var failures []string
+	for i, script := range scripts {
+		result := neo4jv1alpha1.MigrationScriptStatus{
+			Version:     script.version,
+			Description: script.description,
+			State:       migrationStatePending,
+			Checksum:    script.checksum,
+		}
+		entry, done := history[script.version]
+		switch {
+		case done:
+			result.State = migrationStateApplied
+			result.ExecutionTimeMillis = entry.ExecutionTime.Milliseconds()
+			if !entry.AppliedAt.IsZero() {
+				appliedAt := metav1.NewTime(entry.AppliedAt)
+				result.AppliedAt = &appliedAt
+			}
+			if entry.Checksum != script.checksum {
+				result.Checksum = entry.Checksum
+				if !ignoreChecksumMismatch {
+					result.State = migrationStateChecksumMismatch
+					result.Message = "The script changed after it was applied; applied scripts are never re-applied"
+					failures = append(failures, fmt.Sprintf("version %s changed after it was applied", script.version))
+				}
+			}
+		case latest != "" && compareMigrationVersions(script.version, latest) < 0 && !outOfOrder:
+			result.Message = fmt.Sprintf("Older than the applied version %s", latest)
+			failures = append(failures, fmt.Sprintf("version %s is older than the applied version %s; set outOfOrder to apply it", script.version, latest))
+		default:
+			plan.pending = append(plan.pending, i)
+		}
+		plan.results = append(plan.results, result)
+	}
+	if len(failures) > 0 {
+		plan.failure = strings.ToUpper(failures[0][:1]) + strings.Join(failures, ", ")[1:]
+		plan.pending = nil
+	}
+	return plan
+}
+
+// applyMigrationPlan applies the pending scripts in order, updating their
+// results, until one fails. It returns the number of scripts applied and the
+// failure, if any.
+func (r *Neo4jMigrationReconciler) applyMigrationPlan(ctx context.Context, c migrationClient, migration *neo4jv1alpha1.Neo4jMigration, scripts []migrationScript, plan *migrationPlan) (int, string) {
+	logger := log.FromContext(ctx)
+	database := migrationDatabase(migration)
+
+	applied := 0
+	for _, i := range plan.pending {
+		script := scripts[i]
+		result := &plan.results[i]
+		elapsed, err := c.ApplyMigration(ctx, database, migration.Name, neo4jclient.AppliedMigration{
+			Version:     script.version,
+			Description: script.description,
+			Checksum:    script.checksum,
+		}, script.statements)
+		result.ExecutionTimeMillis = elapsed.Milliseconds()
+		if err != nil {
+			logger.Error(err, "Failed to apply migration script", "database", database, "version", script.version)
+			result.State = migrationStateFailed
+			result.Message = err.Error()
+			return applied, fmt.Sprintf("Version %s failed: %v", script.version, err)
+		}
+		now := metav1.Now()
+		result.State = migrationStateApplied
+		result.AppliedAt = &now
+		applied++
+		logger.Info("Applied migration script", "database", database, "version", script.version, "duration", elapsed)
+	}
+	return applied, ""
+}
+
+// currentMigrationVersion returns the latest applied version, or "none".
+func currentMigrationVersion(results []neo4jv1alpha1.MigrationScriptStatus) string {
+	current := ""
+	for _, result := range results {
+		if result.State == migrationStateApplied || result.State == migrationStateChecksumMismatch {
+			if current == "" || compareMigrationVersions(result.Version, current) > 0 {
+				current = result.Version
+			}
+		}
+	}
+	if current == "" {
+		return "none"
+	}
+	return current
+}
+
+func (r *Neo4jMigrationReconciler) failMigration(ctx context.Context, migration *neo4jv1alpha1.Neo4jMigration, message string, results []neo4jv1alpha1.MigrationScriptStatus) {
+	if migration.Status.Phase != "Failed" || migration.Status.Message != message {
+		r.Recorder.Event(migration, corev1.EventTypeWarning, EventReasonMigrationFailed, message)
+	}
+	r.updateMigrationStatus(ctx, migration, "Failed", message, results)
+}
+
+func (r *Neo4jMigrationReconciler) updateMigrationStatus(ctx context.Context, migration *neo4jv1alpha1.Neo4jMigration, phase, message string, results []neo4jv1alpha1.MigrationScriptStatus) {
+	var previousPhase string
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jMigration{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(migration), latest); err != nil {
+			return err
+		}
+		previousPhase = latest.Status.Phase
+		if latest.Status.Phase == phase && latest.Status.Message == message && results == nil &&
+			latest.Status.ObservedGeneration == latest.Generation {
+			return nil
+		}
+		latest.Status.Phase = phase
+		latest.Status.Message = message
+		latest.Status.ObservedGeneration = latest.Generation
+		condStatus, condReason := PhaseToConditionStatus(phase)
+		SetReadyCondition(&latest.Status.Conditions, latest.Generation, condStatus, condReason, message)
+		if results != nil {
+			latest.Status.Scripts = results
+			latest.Status.CurrentVersion = ""
+			if version := currentMigrationVersion(results); version != "none" {
+				latest.Status.CurrentVersion = version
+			}
+			var pending int32
+			var lastApplied *metav1.Time
+			for _, result := range results {
+				if result.State == migrationStatePending || result.State == migrationStateFailed {
+					pending++
+				}
+				if result.AppliedAt != nil && (lastApplied == nil || result.AppliedAt.After(lastApplied.Time)) {
+					lastApplied = result.AppliedAt
+				}
+			}
+			latest.Status.PendingScripts = pending
+			if lastApplied != nil {
+				latest.Status.LastMigrationTime = lastApplied
+			}
+		}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		migration.Status = latest.Status
+		migration.ResourceVersion = latest.ResourceVersion
+		return nil
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update migration status")
+		return
+	}
+	recordPhaseChange(r.Recorder, migration, previousPhase, phase, message)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Neo4jMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Only spec changes start a new run; status writes must not
+		For(&neo4jv1alpha1.Neo4jMigration{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
+		}).
+		Complete(observeReconcileErrors("neo4jmigration", r))
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// recordingMigrationClient records the versions it applies and fails those
+// listed in failOn.
+type recordingMigrationClient struct {
+	applied []string
+	failOn  map[string]bool
+}
+
+func (c *recordingMigrationClient) ApplyMigration(_ context.Context, _, _ string, script neo4jclient.AppliedMigration, _ []string) (time.Duration, error) {
+	if c.failOn[script.Version] {
+		return time.Millisecond, fmt.Errorf("statement 1 of version %s failed", script.Version)
+	}
+	c.applied = append(c.applied, script.Version)
+	return 20 * time.Millisecond, nil
+}
+
+func newMigrationTestReconciler(objs ...client.Object) (*Neo4jMigrationReconciler, client.Client) {
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jMigration{}).Build()
+	return &Neo4jMigrationReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10), RequeueAfter: time.Minute}, c
+}
+
+func testMigration(scripts ...neo4jv1alpha1.MigrationScript) *neo4jv1alpha1.Neo4jMigration {
+	return &neo4jv1alpha1.Neo4jMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "schema", Namespace: "default", Generation: 1},
+		Spec: neo4jv1alpha1.Neo4jMigrationSpec{
+			ClusterRef: "prod",
+			Scripts:    scripts,
+		},
+	}
+}
+
+func testMigrationScripts(versions ...string) []migrationScript {
+	scripts := make([]migrationScript, 0, len(versions))
+	for _, version := range versions {
+		statements := []string{"CREATE (:Version {v: '" + version + "'})"}
+		scripts = append(scripts, migrationScript{version: version, statements: statements, checksum: migrationChecksum(statements)})
+	}
+	return scripts
+}
+
+func TestCompareMigrationVersions(t *testing.T) {
+	assert.Equal(t, -1, compareMigrationVersions("1.9", "1.10"))
+	assert.Equal(t, 1, compareMigrationVersions("2", "1.99"))
+	assert.Equal(t, 0, compareMigrationVersions("1", "1.0"))
+	assert.Equal(t, 0, compareMigrationVersions("01.2", "1.2"))
+	assert.Equal(t, -1, compareMigrationVersions("1.0", "1.0.1"))
+	assert.Equal(t, 1, compareMigrationVersions("2025.10.1", "2025.9.30"))
+}
+
+func TestLoadMigrationScripts(t *testing.T) {
+	ctx := context.Background()
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrations", Namespace: "default"},
+		Data:       map[string]string{"V2.cypher": "// Backfill\nMATCH (p:Person) SET p.active = true;\n\n"},
+	}
+	migration := testMigration(
+		neo4jv1alpha1.MigrationScript{Version: "1", Cypher: "CREATE INDEX a FOR (p:Person) ON (p.a);\nCREATE INDEX b FOR (p:Person) ON (p.b)"},
+		neo4jv1alpha1.MigrationScript{Version: "2", ConfigMapRef: &neo4jv1alpha1.MigrationScriptRef{Name: "migrations", Key: "V2.cypher"}},
+	)
+	r, _ := newMigrationTestReconciler(configMap, migration)
+
+	scripts, err := r.loadMigrationScripts(ctx, migration)
+	require.NoError(t, err)
+	require.Len(t, scripts, 2)
+	assert.Equal(t, []string{"CREATE INDEX a FOR (p:Person) ON (p.a)", "CREATE INDEX b FOR (p:Person) ON (p.b)"}, scripts[0].statements)
+	assert.Equal(t, []string{"// Backfill\nMATCH (p:Person) SET p.active = true"}, scripts[1].statements)
+
+	// Whitespace around statements does not change the checksum
+	migration.Spec.Scripts[0].Cypher = "  CREATE INDEX a FOR (p:Person) ON (p.a) ;\n\nCREATE INDEX b FOR (p:Person) ON (p.b);\n"
+	reloaded, err := r.loadMigrationScripts(ctx, migration)
+	require.NoError(t, err)
+	assert.Equal(t, scripts[0].checksum, reloaded[0].checksum)
+
+	migration.Spec.Scripts[1].Version = "1.0"
+	_, err = r.loadMigrationScripts(ctx, migration)
+	assert.ErrorContains(t, err, "must be greater than the version 1")
+
+	migration.Spec.Scripts[1].Version = "2"
+	migration.Spec.Scripts[1].ConfigMapRef.Key = "missing"
+	_, err = r.loadMigrationScripts(ctx, migration)
+	var sourceErr *migrationSourceError
+	assert.ErrorAs(t, err, &sourceErr)
+}
+
+func TestPlanMigration(t *testing.T) {
+	scripts := testMigrationScripts("1", "2", "3")
+	appliedAt := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	applied := []neo4jclient.AppliedMigration{
+		{Version: "1", Checksum: scripts[0].checksum, AppliedAt: appliedAt, ExecutionTime: 40 * time.Millisecond},
+		{Version: "0.9", Checksum: "removed-from-spec"},
+	}
+
+	plan := planMigration(scripts, applied, false, false)
+	require.Empty(t, plan.failure)
+	assert.Equal(t, []int{1, 2}, plan.pending)
+	require.Len(t, plan.results, 3)
+	assert.Equal(t, migrationStateApplied, plan.results[0].State)
+	assert.Equal(t, int64(40), plan.results[0].ExecutionTimeMillis)
+	assert.True(t, plan.results[0].AppliedAt.Time.Equal(appliedAt))
+	assert.Equal(t, migrationStatePending, plan.results[1].State)
+}
+
+func TestPlanMigration_ChecksumMismatch(t *testing.T) {
+	scripts := testMigrationScripts("1", "2")
+	applied := []neo4jclient.AppliedMigration{{Version: "1", Checksum: "edited"}}
+
+	plan := planMigration(scripts, applied, false, false)
+	assert.Contains(t, plan.failure, "Version 1 changed after it was applied")
+	assert.Empty(t, plan.pending)
+	assert.Equal(t, migrationStateChecksumMismatch, plan.results[0].State)
+
+	plan = planMigration(scripts, applied, false, true)
+	assert.Empty(t, plan.failure)
+	assert.Equal(t, []int{1}, plan.pending)
+	assert.Equal(t, migrationStateApplied, plan.results[0].State)
+	assert.Equal(t, "edited", plan.results[0].Checksum, "the status reports the checksum that was applied")
+}
+
+func TestPlanMigration_OutOfOrder(t *testing.T) {
+	scripts := testMigrationScripts("1", "1.5", "2")
+	applied := []neo4jclient.AppliedMigration{
+		{Version: "1", Checksum: scripts[0].checksum},
+		{Version: "2", Checksum: scripts[2].checksum},
+	}
+
+	plan := planMigration(scripts, applied, false, false)
+	assert.Contains(t, plan.failure, "Version 1.5 is older than the applied version 2")
+	assert.Empty(t, plan.pending)
+
+	plan = planMigration(scripts, applied, true, false)
+	assert.Empty(t, plan.failure)
+	assert.Equal(t, []int{1}, plan.pending)
+}
+
+func TestApplyMigrationPlan_StopsAtFirstFailure(t *testing.T) {
+	ctx := context.Background()
+	migration := testMigration()
+	r, _ := newMigrationTestReconciler()
+	scripts := testMigrationScripts("1", "2", "3")
+	plan := planMigration(scripts, nil, false, false)
+	c := &recordingMigrationClient{failOn: map[string]bool{"2": true}}
+
+	applied, failure := r.applyMigrationPlan(ctx, c, migration, scripts, &plan)
+	assert.Equal(t, 1, applied)
+	assert.Equal(t, []string{"1"}, c.applied)
+	assert.Contains(t, failure, "Version 2 failed")
+	assert.Equal(t, migrationStateApplied, plan.results[0].State)
+	assert.Equal(t, int64(20), plan.results[0].ExecutionTimeMillis)
+	assert.NotNil(t, plan.results[0].AppliedAt)
+	assert.Equal(t, migrationStateFailed, plan.results[1].State)
+	assert.Contains(t, plan.results[1].Message, "statement 1 of version 2 failed")
+	assert.Equal(t, migrationStatePending, plan.results[2].State)
+	assert.Equal(t, "1", currentMigrationVersion(plan.results))
+}
+
+func TestMigrationFailureUnchanged(t *testing.T) {
+	scripts := testMigrationScripts("1", "2")
+	migration := testMigration()
+	migration.Status = neo4jv1alpha1.Neo4jMigrationStatus{
+		Phase:              "Failed",
+		ObservedGeneration: 1,
+		Scripts: []neo4jv1alpha1.MigrationScriptStatus{
+			{Version: "1", State: migrationStateApplied, Checksum: scripts[0].checksum},
+			{Version: "2", State: migrationStateFailed, Checksum: scripts[1].checksum},
+		},
+	}
+	assert.True(t, migrationFailureUnchanged(migration, scripts))
+
+	edited := testMigrationScripts("1")
+	edited = append(edited, migrationScript{version: "2", checksum: "fixed"})
+	assert.False(t, migrationFailureUnchanged(migration, edited), "a fixed script is retried")
+
+	migration.Generation = 2
+	assert.False(t, migrationFailureUnchanged(migration, scripts), "a new generation is retried")
+}
+
+func TestReconcileMigration_PendingWithoutTarget(t *testing.T) {
+	ctx := context.Background()
+	migration := testMigration(neo4jv1alpha1.MigrationScript{Version: "1", Cypher: "RETURN 1"})
+	r, c := newMigrationTestReconciler(migration)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(migration)})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+
+	updated := &neo4jv1alpha1.Neo4jMigration{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(migration), updated))
+	assert.Equal(t, "Pending", updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, `target "prod" not found`)
+}
+
+func TestReconcileMigration_FailsOnInvalidScripts(t *testing.T) {
+	ctx := context.Background()
+	migration := testMigration(
+		neo4jv1alpha1.MigrationScript{Version: "2", Cypher: "RETURN 2"},
+		neo4jv1alpha1.MigrationScript{Version: "1", Cypher: "RETURN 1"},
+	)
+	r, c := newMigrationTestReconciler(migration)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(migration)})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	updated := &neo4jv1alpha1.Neo4jMigration{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(migration), updated))
+	assert.Equal(t, "Failed", updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "must be greater than")
+}
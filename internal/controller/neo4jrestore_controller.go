@@ -40,7 +40,9 @@ import (
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // Status constants
@@ -266,7 +268,7 @@ func (r *Neo4jRestoreReconciler) checkRestoreProgress(ctx context.Context, resto
 
 	if job.Status.Failed > 0 {
 		// Restore failed
-		r.updateRestoreStatus(ctx, restore, "Failed", "Restore job failed")
+		r.updateRestoreStatus(ctx, restore, "Failed", jobFailureMessage(ctx, r.Client, job, "Restore job failed"))
 		r.Recorder.Event(restore, corev1.EventTypeWarning, EventReasonRestoreFailed, "Restore job failed")
 		return ctrl.Result{}, nil
 	}
@@ -282,6 +284,9 @@ func (r *Neo4jRestoreReconciler) handleRestoreSuccess(ctx context.Context, resto
 	now := metav1.Now()
 	restore.Status.CompletionTime = &now
 
+	// The job only succeeds after replaying the security metadata
+	restore.Status.SecurityRestored = restoreSecurityEnabled(restore)
+
 	// Update statistics
 	r.updateRestoreStats(ctx, restore, job)
 
@@ -318,8 +323,12 @@ func (r *Neo4jRestoreReconciler) handleRestoreSuccess(ctx context.Context, resto
 	}
 
 	// Restore completed successfully
-	r.updateRestoreStatus(ctx, restore, "Completed", "Restore completed successfully")
-	r.Recorder.Event(restore, corev1.EventTypeNormal, EventReasonRestoreCompleted, "Restore completed successfully")
+	message := "Restore completed successfully"
+	if restore.Status.SecurityRestored {
+		message = "Restore completed successfully, users, roles and privileges replayed"
+	}
+	r.updateRestoreStatus(ctx, restore, "Completed", message)
+	r.Recorder.Event(restore, corev1.EventTypeNormal, EventReasonRestoreCompleted, message)
 
 	return ctrl.Result{}, nil
 }
@@ -380,6 +389,10 @@ func (r *Neo4jRestoreReconciler) validateRestore(ctx context.Context, restore *n
 		return fmt.Errorf("databaseName is required")
 	}
 
+	if restoreSecurityEnabled(restore) && restore.Spec.StopCluster {
+		return fmt.Errorf("restoreSecurity requires an online restore: the cluster is stopped while the metadata would be replayed")
+	}
+
 	return nil
 }
 
@@ -416,6 +429,9 @@ func (r *Neo4jRestoreReconciler) createRestoreJob(ctx context.Context, restore *
 	if err != nil {
 		return nil, fmt.Errorf("failed to build restore command: %w", err)
 	}
+	restoreCmd = withSecurityReplay(restore, cluster, restoreCmd)
+	cloud := cloudBlockForRestore(restore)
+	restoreCmd = withCloudTrustStore(cloud, restoreCmd)
 
 	// Create job spec
 	job := &batchv1.Job{
@@ -433,16 +449,20 @@ func (r *Neo4jRestoreReconciler) createRestoreJob(ctx context.Context, restore *
 			BackoffLimit: func(i int32) *int32 { return &i }(1), // Restore should not retry
 			Template: corev1.PodTemplateSpec{
 				Spec: corev1.PodSpec{
-					RestartPolicy:   corev1.RestartPolicyNever,
-					SecurityContext: hardenedRestorePodSecurityContext(),
+					RestartPolicy:    corev1.RestartPolicyNever,
+					SecurityContext:  hardenedRestorePodSecurityContext(),
+					ImagePullSecrets: resources.ImagePullSecrets(cluster.Spec.Image),
 					Containers: []corev1.Container{
 						{
 							Name:            "neo4j-restore",
-							Image:           fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag),
+							Image:           resources.ImageReference(cluster.Spec.Image),
+							ImagePullPolicy: resources.ImagePullPolicy(cluster.Spec.Image),
 							SecurityContext: hardenedRestoreContainerSecurityContext(),
 							Command:         []string{"/bin/sh"},
 							Args:            []string{"-c", restoreCmd},
-							Env: []corev1.EnvVar{
+							// Report the log tail of a failed restore in its pod status
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							Env: append([]corev1.EnvVar{
 								{
 									Name: "NEO4J_ADMIN_PASSWORD",
 									ValueFrom: &corev1.EnvVarSource{
@@ -454,7 +474,7 @@ func (r *Neo4jRestoreReconciler) createRestoreJob(ctx context.Context, restore *
 										},
 									},
 								},
-							},
+							}, append(restoreSecurityEnvVars(restore, cluster), cloudEgressEnvVars(cloud)...)...),
 							VolumeMounts: r.buildRestoreVolumeMounts(restore),
 						},
 					},
@@ -464,6 +484,8 @@ func (r *Neo4jRestoreReconciler) createRestoreJob(ctx context.Context, restore *
 		},
 	}
 
+	resources.ApplySecurityProfiles(&job.Spec.Template, cluster.Spec.SecurityContext)
+
 	// Set controller reference
 	if err := controllerutil.SetControllerReference(restore, job, r.Scheme); err != nil {
 		return nil, err
@@ -498,13 +520,13 @@ func (r *Neo4jRestoreReconciler) buildRestoreCommand(ctx context.Context, restor
 
 	// Extract Neo4j version from cluster image
 	imageTag := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
-	version, err := neo4j.GetImageVersion(imageTag)
+	imageVersion, err := version.FromImage(imageTag)
 	if err != nil {
-		version = &neo4j.Version{Major: 5, Minor: 26, Patch: 0}
+		imageVersion = &version.Version{Major: 5, Minor: 26, Patch: 0}
 	}
 
 	// Build the neo4j-admin restore command with correct Neo4j 5.26+ syntax
-	cmd := neo4j.GetRestoreCommand(version, restore.Spec.DatabaseName, backupPath)
+	cmd := neo4j.GetRestoreCommand(imageVersion, restore.Spec.DatabaseName, backupPath)
 
 	// Add --overwrite-destination flag if force is specified
 	if restore.Spec.Force {
@@ -537,9 +559,9 @@ func (r *Neo4jRestoreReconciler) buildPITRRestoreCommand(_ context.Context, rest
 	}
 
 	imageTag := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
-	version, err := neo4j.GetImageVersion(imageTag)
+	imageVersion, err := version.FromImage(imageTag)
 	if err != nil {
-		version = &neo4j.Version{Major: 5, Minor: 26, Patch: 0}
+		imageVersion = &version.Version{Major: 5, Minor: 26, Patch: 0}
 	}
 
 	// Determine backup source path from base backup
@@ -559,7 +581,7 @@ func (r *Neo4jRestoreReconciler) buildPITRRestoreCommand(_ context.Context, rest
 		return "", fmt.Errorf("no backup source path could be determined for PITR restore")
 	}
 
-	cmd := neo4j.GetRestoreCommand(version, restore.Spec.DatabaseName, backupPath)
+	cmd := neo4j.GetRestoreCommand(imageVersion, restore.Spec.DatabaseName, backupPath)
 
 	if restore.Spec.Force {
 		cmd += " --overwrite-destination=true"
@@ -596,7 +618,7 @@ func (r *Neo4jRestoreReconciler) buildRestoreVolumeMounts(restore *neo4jv1alpha1
 		})
 	}
 
-	return mounts
+	return append(mounts, cloudCAVolumeMounts(cloudBlockForRestore(restore))...)
 }
 
 func (r *Neo4jRestoreReconciler) buildRestoreVolumes(restore *neo4jv1alpha1.Neo4jRestore) []corev1.Volume {
@@ -699,7 +721,20 @@ func (r *Neo4jRestoreReconciler) buildRestoreVolumes(restore *neo4jv1alpha1.Neo4
 		}
 	}
 
-	return volumes
+	return append(volumes, cloudCAVolumes(cloudBlockForRestore(restore))...)
+}
+
+// cloudBlockForRestore returns the cloud settings of the storage the restore
+// reads from: the direct source storage, or the PITR base backup storage.
+func cloudBlockForRestore(restore *neo4jv1alpha1.Neo4jRestore) *neo4jv1alpha1.CloudBlock {
+	src := restore.Spec.Source
+	if src.Storage != nil && src.Storage.Cloud != nil {
+		return src.Storage.Cloud
+	}
+	if src.PITR != nil && src.PITR.BaseBackup != nil && src.PITR.BaseBackup.Storage != nil {
+		return src.PITR.BaseBackup.Storage.Cloud
+	}
+	return nil
 }
 
 func (r *Neo4jRestoreReconciler) stopCluster(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
@@ -892,7 +927,7 @@ func (r *Neo4jRestoreReconciler) runRestoreHooks(ctx context.Context, restore *n
 
 	// Execute job hooks if any
 	if hooks.Job != nil {
-		if err := r.runHookJob(ctx, restore, phase); err != nil {
+		if err := r.runHookJob(ctx, restore, cluster, phase); err != nil {
 			return fmt.Errorf("failed to execute job hook in %s: %w", phase, err)
 		}
 	}
@@ -900,7 +935,7 @@ func (r *Neo4jRestoreReconciler) runRestoreHooks(ctx context.Context, restore *n
 	return nil
 }
 
-func (r *Neo4jRestoreReconciler) runHookJob(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore, phase string) error {
+func (r *Neo4jRestoreReconciler) runHookJob(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, phase string) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Running hook job", "restore", restore.Name, "phase", phase)
 
@@ -940,6 +975,8 @@ func (r *Neo4jRestoreReconciler) runHookJob(ctx context.Context, restore *neo4jv
 							Args:            hookSpec.Template.Container.Args,
 							Env:             convertEnvVars(hookSpec.Template.Container.Env),
 							SecurityContext: hardenedRestoreContainerSecurityContext(),
+							// Report the log tail of a failed hook in its pod status
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 						},
 					},
 				},
@@ -951,6 +988,7 @@ func (r *Neo4jRestoreReconciler) runHookJob(ctx context.Context, restore *neo4jv
 	if job.Spec.BackoffLimit == nil {
 		job.Spec.BackoffLimit = ptr.To(int32(3))
 	}
+	resources.ApplySecurityProfiles(&job.Spec.Template, cluster.Spec.SecurityContext)
 
 	if err := r.Create(ctx, job); err != nil {
 		return fmt.Errorf("failed to create hook job: %w", err)
@@ -964,32 +1002,7 @@ func (r *Neo4jRestoreReconciler) runHookJob(ctx context.Context, restore *neo4jv
 		}
 	}
 
-	// Wait for job completion
-	timeoutChan := time.After(timeout)
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-timeoutChan:
-			return fmt.Errorf("hook job timed out")
-		case <-ticker.C:
-			if err := r.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
-				return fmt.Errorf("failed to get hook job status: %w", err)
-			}
-
-			if job.Status.Succeeded > 0 {
-				logger.Info("Hook job completed successfully")
-				return nil
-			}
-
-			if job.Status.Failed > 0 {
-				return fmt.Errorf("hook job failed")
-			}
-
-			// Still running, continue waiting
-		}
-	}
+	return waitForJob(ctx, r.Client, job, timeout)
 }
 
 // convertEnvVars converts custom EnvVar to corev1.EnvVar
@@ -1040,7 +1053,7 @@ func (r *Neo4jRestoreReconciler) getTargetCluster(ctx context.Context, restore *
 }
 
 func (r *Neo4jRestoreReconciler) createNeo4jClient(_ context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*neo4j.Client, error) {
-	return neo4j.NewClientForEnterprise(cluster, r.Client, cluster.Spec.Auth.AdminSecret)
+	return neo4j.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 }
 
 func (r *Neo4jRestoreReconciler) cleanupRestoreJobs(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore) error {
@@ -1063,11 +1076,13 @@ func (r *Neo4jRestoreReconciler) cleanupRestoreJobs(ctx context.Context, restore
 }
 
 func (r *Neo4jRestoreReconciler) updateRestoreStatus(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore, phase, message string) {
+	var previousPhase string
 	update := func() error {
 		latest := &neo4jv1alpha1.Neo4jRestore{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(restore), latest); err != nil {
 			return err
 		}
+		previousPhase = latest.Status.Phase
 		latest.Status.Phase = phase
 		latest.Status.Message = message
 		latest.Status.ObservedGeneration = latest.Generation
@@ -1078,7 +1093,9 @@ func (r *Neo4jRestoreReconciler) updateRestoreStatus(ctx context.Context, restor
 	err := retry.RetryOnConflict(retry.DefaultBackoff, update)
 	if err != nil {
 		log.FromContext(ctx).Error(err, "Failed to update restore status")
+		return
 	}
+	recordPhaseChange(r.Recorder, restore, previousPhase, phase, message)
 }
 
 func (r *Neo4jRestoreReconciler) updateRestoreStats(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore, job *batchv1.Job) {
@@ -1105,8 +1122,9 @@ func (r *Neo4jRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&batchv1.Job{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
 		}).
-		Complete(r)
+		Complete(observeReconcileErrors("neo4jrestore", r))
 }
 
 // validateNeo4jVersion validates that the target cluster uses Neo4j 5.26+ or 2025.01+
@@ -119,6 +119,7 @@ func (r *Neo4jShardedDatabaseReconciler) Reconcile(ctx context.Context, req ctrl
 		if statusErr := r.updateStatus(ctx, &shardedDatabase, "Failed", fmt.Sprintf("Validation failed: %v", err), nil); statusErr != nil {
 			logger.Error(statusErr, "Failed to update status after validation failure")
 		}
+		recordValidationFailure(ctx)
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
@@ -263,7 +264,7 @@ func (r *Neo4jShardedDatabaseReconciler) clusterSupportsPropertySharding(cluster
 // createNeo4jClient creates a Neo4j client for the specified cluster
 func (r *Neo4jShardedDatabaseReconciler) createNeo4jClient(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*neo4j.Client, error) {
 	// Use the same pattern as the database controller
-	return neo4j.NewClientForEnterprise(cluster, r.Client, cluster.Spec.Auth.AdminSecret)
+	return neo4j.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 }
 
 // reconcileShardedDatabase handles the creation and management of sharded databases
@@ -523,7 +524,8 @@ func (r *Neo4jShardedDatabaseReconciler) updateShardStatus(ctx context.Context,
 
 // updateStatus updates the sharded database status
 func (r *Neo4jShardedDatabaseReconciler) updateStatus(ctx context.Context, shardedDB *neo4jv1alpha1.Neo4jShardedDatabase, phase, message string, shardingReady *bool) error {
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	var previousPhase string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Fetch latest version
 		var latest neo4jv1alpha1.Neo4jShardedDatabase
 		if err := r.Get(ctx, client.ObjectKeyFromObject(shardedDB), &latest); err != nil {
@@ -531,6 +533,7 @@ func (r *Neo4jShardedDatabaseReconciler) updateStatus(ctx context.Context, shard
 		}
 
 		// Update status
+		previousPhase = latest.Status.Phase
 		latest.Status.Phase = phase
 		latest.Status.Message = message
 		latest.Status.ObservedGeneration = latest.Generation
@@ -545,6 +548,11 @@ func (r *Neo4jShardedDatabaseReconciler) updateStatus(ctx context.Context, shard
 
 		return r.Status().Update(ctx, &latest)
 	})
+	if err != nil {
+		return err
+	}
+	recordPhaseChange(r.Recorder, shardedDB, previousPhase, phase, message)
+	return nil
 }
 
 // waitForNeo4jReadiness waits for Neo4j to be ready for database operations
@@ -650,6 +658,7 @@ func (r *Neo4jShardedDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) erro
 		For(&neo4jv1alpha1.Neo4jShardedDatabase{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxConcurrentReconciles,
+			NewQueue:                observedQueue,
 		}).
-		Complete(r)
+		Complete(observeReconcileErrors("neo4jshardeddatabase", r))
 }
@@ -0,0 +1,694 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/yaml"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// Neo4jUserSyncReconciler reconciles a Neo4jUserSync object
+type Neo4jUserSyncReconciler struct {
+	client.Client
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	MaxConcurrentReconciles int
+	RequeueAfter            time.Duration
+	// SecurityAudit configures where user changes are recorded besides events
+	SecurityAudit SecurityAuditConfig
+}
+
+const (
+	// userSyncBatchInterval is the delay between two batches of the same sync.
+	userSyncBatchInterval = 5 * time.Second
+
+	defaultUserSyncBatchSize = 50
+	defaultUserSyncInterval  = 10 * time.Minute
+
+	userSyncStateSynced  = "Synced"
+	userSyncStatePending = "Pending"
+	userSyncStateRemoved = "Removed"
+	userSyncStateFailed  = "Failed"
+)
+
+// userSyncEntry is one user of a "users" document.
+type userSyncEntry struct {
+	Username  string   `json:"username"`
+	Roles     []string `json:"roles,omitempty"`
+	Suspended bool     `json:"suspended,omitempty"`
+	Password  string   `json:"password,omitempty"`
+}
+
+// desiredUser is the state the source asks for a user.
+type desiredUser struct {
+	roles     []string
+	suspended bool
+	password  string
+	// mustChangePassword is unset only for passwords the source lists
+	mustChangePassword bool
+}
+
+// userSyncAction is the change needed to bring one managed user in line.
+type userSyncAction struct {
+	username string
+	previous *neo4jv1alpha1.UserSyncResult
+
+	create             bool
+	password           string
+	mustChangePassword bool
+	grant              []string
+	revoke             []string
+	suspend            bool
+	activate           bool
+	drop               bool
+
+	// held are the desired roles the user already has
+	held []string
+	// removed is set for users the source no longer lists
+	removed bool
+	// forget drops the user from the status without changing it
+	forget bool
+}
+
+// noop reports whether the action changes nothing in the DBMS.
+func (a *userSyncAction) noop() bool {
+	return !a.create && !a.suspend && !a.activate && !a.drop && len(a.grant) == 0 && len(a.revoke) == 0
+}
+
+// userSyncClient is the part of the Neo4j client that changes users.
+type userSyncClient interface {
+	CreateUser(ctx context.Context, username, password string, mustChangePassword bool) error
+	DropUser(ctx context.Context, username string) error
+	GrantRoleToUser(ctx context.Context, roleName, username string) error
+	RevokeRoleFromUser(ctx context.Context, roleName, username string) error
+	SuspendUser(ctx context.Context, username string) error
+	ActivateUser(ctx context.Context, username string) error
+}
+
+// userSyncOutcome summarises one batch.
+type userSyncOutcome struct {
+	results                   []neo4jv1alpha1.UserSyncResult
+	created, updated, removed int
+	desired, pending, failed  int32
+}
+
+// phase derives the sync phase and a summary from the batch.
+func (o *userSyncOutcome) phase() (string, string) {
+	message := fmt.Sprintf("%d users: %d created, %d updated, %d removed", o.desired, o.created, o.updated, o.removed)
+	switch {
+	case o.failed > 0:
+		return "Degraded", fmt.Sprintf("%s, %d failed", message, o.failed)
+	case o.pending > 0:
+		return "Syncing", fmt.Sprintf("%s, %d pending", message, o.pending)
+	default:
+		return "Synced", message
+	}
+}
+
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jusersyncs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jusersyncs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jusersyncs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile compares the users listed by the source with the DBMS and applies
+// up to spec.batchSize user changes, requeueing until every user is synced.
+// Deleting a Neo4jUserSync leaves its users in place.
+func (r *Neo4jUserSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	userSync := &neo4jv1alpha1.Neo4jUserSync{}
+	if err := r.Get(ctx, req.NamespacedName, userSync); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Neo4jUserSync resource not found")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Neo4jUserSync")
+		return ctrl.Result{}, err
+	}
+	if userSync.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	interval, err := userSyncInterval(userSync)
+	if err != nil {
+		r.failUserSync(ctx, userSync, err.Error())
+		return ctrl.Result{}, nil
+	}
+
+	// Wait for the resources of spec.dependsOn
+	waiting, err := waitingDependency(ctx, r.Client, userSync, userSync.Spec.DependsOn)
+	if err != nil {
+		logger.Error(err, "Failed to check dependencies")
+		return ctrl.Result{}, err
+	}
+	setDependenciesCondition(ctx, r.Client, userSync, waiting)
+	if waiting != "" {
+		r.updateUserSyncStatus(ctx, userSync, "Pending", waiting, nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	desired, err := r.loadDesiredUsers(ctx, userSync)
+	if err != nil {
+		r.failUserSync(ctx, userSync, err.Error())
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	neo4jClient, err := r.connectUserSyncTarget(ctx, userSync)
+	if err != nil {
+		r.updateUserSyncStatus(ctx, userSync, "Pending", err.Error(), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	defer neo4jClient.Close()
+
+	audit := newSecurityAudit(r.Client, r.Recorder, r.SecurityAudit, userSync, "Neo4jUserSync", userSync.Spec.ClusterRef, neo4jClient.Username())
+	neo4jClient.SetSecurityAudit(audit.record)
+	defer func() {
+		if err := audit.flush(ctx); err != nil {
+			logger.Error(err, "Failed to record security audit")
+		}
+	}()
+
+	current, err := neo4jClient.ListUsers(ctx)
+	if err != nil {
+		r.updateUserSyncStatus(ctx, userSync, "Pending", fmt.Sprintf("Failed to list users: %v", err), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	// The operator's own user is never changed, whatever the source lists
+	delete(desired, neo4jClient.Username())
+	actions := planUserSync(desired, current, userSync.Status.Users, userSync.Spec.RemovalPolicy, neo4jClient.Username())
+
+	batchSize := int(userSync.Spec.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultUserSyncBatchSize
+	}
+	outcome := applyUserSync(ctx, neo4jClient, actions, batchSize, metav1.Now())
+	outcome.desired = int32(len(desired))
+
+	phase, message := outcome.phase()
+	r.updateUserSyncStatus(ctx, userSync, phase, message, outcome)
+	if outcome.created+outcome.updated+outcome.removed > 0 {
+		r.Recorder.Event(userSync, corev1.EventTypeNormal, EventReasonUsersSynced, message)
+	}
+	if outcome.failed > 0 {
+		r.Recorder.Event(userSync, corev1.EventTypeWarning, EventReasonUserSyncFailed,
+			fmt.Sprintf("%d users failed to sync, see status.users", outcome.failed))
+	}
+
+	if outcome.pending > 0 {
+		return ctrl.Result{RequeueAfter: userSyncBatchInterval}, nil
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// userSyncInterval parses spec.syncInterval, defaulting to ten minutes.
+func userSyncInterval(userSync *neo4jv1alpha1.Neo4jUserSync) (time.Duration, error) {
+	if userSync.Spec.SyncInterval == "" {
+		return defaultUserSyncInterval, nil
+	}
+	d, err := time.ParseDuration(userSync.Spec.SyncInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid syncInterval %q: %w", userSync.Spec.SyncInterval, err)
+	}
+	if d < time.Minute {
+		return 0, fmt.Errorf("syncInterval %q must be at least 1m", userSync.Spec.SyncInterval)
+	}
+	return d, nil
+}
+
+// loadDesiredUsers reads and parses the source document and resolves the
+// initial password of users listed without one.
+func (r *Neo4jUserSyncReconciler) loadDesiredUsers(ctx context.Context, userSync *neo4jv1alpha1.Neo4jUserSync) (map[string]desiredUser, error) {
+	source := userSync.Spec.Source
+	if (source.ConfigMapRef == nil) == (source.SecretRef == nil) {
+		return nil, fmt.Errorf("exactly one of source.configMapRef and source.secretRef must be set")
+	}
+
+	var data []byte
+	fromSecret := source.SecretRef != nil
+	if fromSecret {
+		ref := source.SecretRef
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: userSync.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get source Secret %s: %w", ref.Name, err)
+		}
+		var ok bool
+		if data, ok = secret.Data[userSyncSourceKey(ref)]; !ok {
+			return nil, fmt.Errorf("source Secret %s has no key %q", ref.Name, userSyncSourceKey(ref))
+		}
+	} else {
+		ref := source.ConfigMapRef
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: userSync.Namespace}, configMap); err != nil {
+			return nil, fmt.Errorf("failed to get source ConfigMap %s: %w", ref.Name, err)
+		}
+		if value, ok := configMap.Data[userSyncSourceKey(ref)]; ok {
+			data = []byte(value)
+		} else if data, ok = configMap.BinaryData[userSyncSourceKey(ref)]; !ok {
+			return nil, fmt.Errorf("source ConfigMap %s has no key %q", ref.Name, userSyncSourceKey(ref))
+		}
+	}
+
+	desired, err := parseUserSyncSource(source.Format, data, fromSecret, userSync.Spec.GroupRoles, userSync.Spec.DefaultRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	initialPassword := ""
+	if name := userSync.Spec.InitialPasswordSecret; name != "" {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: userSync.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get initial password Secret %s: %w", name, err)
+		}
+		if initialPassword = string(secret.Data["password"]); initialPassword == "" {
+			return nil, fmt.Errorf("initial password Secret %s has no password key", name)
+		}
+	}
+	for username, user := range desired {
+		if user.password == "" {
+			user.password = initialPassword
+			desired[username] = user
+		}
+	}
+	return desired, nil
+}
+
+func userSyncSourceKey(ref *neo4jv1alpha1.UserSyncSourceRef) string {
+	if ref.Key != "" {
+		return ref.Key
+	}
+	return "users.yaml"
+}
+
+// parseUserSyncSource turns a "users" or "groups" document, YAML or JSON,
+// into the desired users with their full, sorted role list. Per-user
+// passwords are only accepted from a Secret.
+func parseUserSyncSource(format string, data []byte, allowPasswords bool, groupRoles map[string][]string, defaultRoles []string) (map[string]desiredUser, error) {
+	desired := map[string]desiredUser{}
+	add := func(username string, roles []string) error {
+		if err := validateUserSyncName("username", username); err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if err := validateUserSyncName("role", role); err != nil {
+				return err
+			}
+		}
+		user := desired[username]
+		user.roles = sortedUnion(user.roles, roles, defaultRoles)
+		desired[username] = user
+		return nil
+	}
+
+	switch format {
+	case "", "users":
+		var entries []userSyncEntry
+		if err := yaml.UnmarshalStrict(data, &entries); err != nil {
+			return nil, fmt.Errorf("invalid users document: %w", err)
+		}
+		for _, entry := range entries {
+			if _, ok := desired[entry.Username]; ok {
+				return nil, fmt.Errorf("user %q is listed twice", entry.Username)
+			}
+			if entry.Password != "" && !allowPasswords {
+				return nil, fmt.Errorf("user %q has a password, passwords are only read from a Secret source", entry.Username)
+			}
+			if err := add(entry.Username, entry.Roles); err != nil {
+				return nil, err
+			}
+			user := desired[entry.Username]
+			user.suspended = entry.Suspended
+			user.password = entry.Password
+			user.mustChangePassword = entry.Password == ""
+			desired[entry.Username] = user
+		}
+	case "groups":
+		var groups map[string][]string
+		if err := yaml.UnmarshalStrict(data, &groups); err != nil {
+			return nil, fmt.Errorf("invalid groups document: %w", err)
+		}
+		for group, members := range groups {
+			for _, member := range members {
+				if err := add(member, groupRoles[group]); err != nil {
+					return nil, fmt.Errorf("group %q: %w", group, err)
+				}
+				user := desired[member]
+				user.mustChangePassword = true
+				desired[member] = user
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported source format %q", format)
+	}
+	return desired, nil
+}
+
+// validateUserSyncName rejects names that cannot be quoted as a Cypher
+// identifier.
+func validateUserSyncName(kind, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("empty %s", kind)
+	}
+	if strings.Contains(name, "`") {
+		return fmt.Errorf("%s %q must not contain backticks", kind, name)
+	}
+	return nil
+}
+
+// sortedUnion merges role lists into one sorted list without duplicates.
+func sortedUnion(lists ...[]string) []string {
+	seen := map[string]bool{}
+	var union []string
+	for _, list := range lists {
+		for _, item := range list {
+			if !seen[item] {
+				seen[item] = true
+				union = append(union, item)
+			}
+		}
+	}
+	sort.Strings(union)
+	return union
+}
+
+// planUserSync lists the action for every user the source asks for and
+// every user managed so far, sorted by username. Roles are only revoked when
+// this resource granted them; users it never managed are left alone, as is
+// the reserved user.
+func planUserSync(desired map[string]desiredUser, current []neo4jclient.UserInfo, previous []neo4jv1alpha1.UserSyncResult, removalPolicy, reserved string) []userSyncAction {
+	existing := make(map[string]neo4jclient.UserInfo, len(current))
+	for _, user := range current {
+		existing[user.Name] = user
+	}
+	managed := make(map[string]*neo4jv1alpha1.UserSyncResult, len(previous))
+	for i := range previous {
+		managed[previous[i].Username] = &previous[i]
+	}
+
+	var actions []userSyncAction
+	for username, want := range desired {
+		action := userSyncAction{username: username, previous: managed[username]}
+		have, exists := existing[username]
+		if !exists {
+			action.create = true
+			action.password = want.password
+			action.mustChangePassword = want.mustChangePassword
+			action.grant = want.roles
+			action.suspend = want.suspended
+			actions = append(actions, action)
+			continue
+		}
+
+		held := map[string]bool{}
+		for _, role := range have.Roles {
+			held[role] = true
+		}
+		wanted := map[string]bool{}
+		for _, role := range want.roles {
+			wanted[role] = true
+			if held[role] {
+				action.held = append(action.held, role)
+			} else {
+				action.grant = append(action.grant, role)
+			}
+		}
+		if action.previous != nil {
+			for _, role := range action.previous.Roles {
+				if held[role] && !wanted[role] {
+					action.revoke = append(action.revoke, role)
+				}
+			}
+		}
+		action.suspend = want.suspended && !have.Suspended
+		action.activate = !want.suspended && have.Suspended
+		actions = append(actions, action)
+	}
+
+	for i := range previous {
+		result := &previous[i]
+		if _, ok := desired[result.Username]; ok {
+			continue
+		}
+		action := userSyncAction{username: result.Username, previous: result, removed: true}
+		have, exists := existing[result.Username]
+		switch {
+		case !exists || result.Username == reserved || removalPolicy == "Retain":
+			action.forget = true
+		case removalPolicy == "Delete":
+			action.drop = true
+		default:
+			action.suspend = !have.Suspended
+			action.held = result.Roles
+		}
+		actions = append(actions, action)
+	}
+
+	sort.Slice(actions, func(i, j int) bool { return actions[i].username < actions[j].username })
+	return actions
+}
+
+// applyUserSync applies the actions until batchSize users were changed and
+// reports a result for every user that stays managed. Users left for a later
+// batch keep their previous roles and are reported as Pending.
+func applyUserSync(ctx context.Context, c userSyncClient, actions []userSyncAction, batchSize int, now metav1.Time) *userSyncOutcome {
+	outcome := &userSyncOutcome{}
+	changed := 0
+	for i := range actions {
+		action := &actions[i]
+		if action.forget {
+			continue
+		}
+
+		state := userSyncStateSynced
+		if action.removed {
+			state = userSyncStateRemoved
+		}
+
+		if action.noop() {
+			result := neo4jv1alpha1.UserSyncResult{Username: action.username, State: state, Roles: sortedUnion(action.held)}
+			if action.previous != nil && action.previous.State == state {
+				result.Message = action.previous.Message
+				result.LastChanged = action.previous.LastChanged
+			}
+			outcome.results = append(outcome.results, result)
+			continue
+		}
+
+		if changed >= batchSize {
+			result := neo4jv1alpha1.UserSyncResult{Username: action.username, State: userSyncStatePending, Message: "Waiting for the next batch"}
+			if action.previous != nil {
+				result.Roles = action.previous.Roles
+				result.LastChanged = action.previous.LastChanged
+			}
+			outcome.results = append(outcome.results, result)
+			outcome.pending++
+			continue
+		}
+		changed++
+
+		message, roles, err := applyUserSyncAction(ctx, c, action)
+		if err == nil && action.drop {
+			outcome.removed++
+			continue
+		}
+		result := neo4jv1alpha1.UserSyncResult{Username: action.username, State: state, Roles: roles, Message: message, LastChanged: &now}
+		switch {
+		case err != nil:
+			result.State = userSyncStateFailed
+			result.Message = err.Error()
+			outcome.failed++
+		case action.removed:
+			outcome.removed++
+		case action.create:
+			outcome.created++
+		default:
+			outcome.updated++
+		}
+		outcome.results = append(outcome.results, result)
+	}
+	return outcome
+}
+
+// applyUserSyncAction runs the statements of one action, stopping at the
+// first failure. It returns the roles this resource has granted afterwards.
+func applyUserSyncAction(ctx context.Context, c userSyncClient, action *userSyncAction) (string, []string, error) {
+	roles := sortedUnion(action.held, action.revoke)
+
+	if action.drop {
+		return "", roles, c.DropUser(ctx, action.username)
+	}
+
+	var changes []string
+	if action.create {
+		password := action.password
+		if password == "" {
+			var err error
+			if password, err = randomUserPassword(); err != nil {
+				return "", roles, err
+			}
+		}
+		if err := c.CreateUser(ctx, action.username, password, action.mustChangePassword); err != nil {
+			return "", roles, err
+		}
+		changes = append(changes, "created")
+	}
+
+	for _, role := range action.grant {
+		if err := c.GrantRoleToUser(ctx, role, action.username); err != nil {
+			return "", roles, err
+		}
+		roles = sortedUnion(roles, []string{role})
+	}
+	if len(action.grant) > 0 {
+		changes = append(changes, "granted "+strings.Join(action.grant, ", "))
+	}
+
+	for _, role := range action.revoke {
+		if err := c.RevokeRoleFromUser(ctx, role, action.username); err != nil {
+			return "", roles, err
+		}
+		roles = removeRole(roles, role)
+	}
+	if len(action.revoke) > 0 {
+		changes = append(changes, "revoked "+strings.Join(action.revoke, ", "))
+	}
+
+	if action.suspend {
+		if err := c.SuspendUser(ctx, action.username); err != nil {
+			return "", roles, err
+		}
+		if action.removed {
+			changes = append(changes, "suspended after removal from the source")
+		} else {
+			changes = append(changes, "suspended")
+		}
+	}
+	if action.activate {
+		if err := c.ActivateUser(ctx, action.username); err != nil {
+			return "", roles, err
+		}
+		changes = append(changes, "activated")
+	}
+
+	message := strings.Join(changes, ", ")
+	return strings.ToUpper(message[:1]) + message[1:], roles, nil
+}
+
+func removeRole(roles []string, role string) []string {
+	var kept []string
+	for _, r := range roles {
+		if r != role {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// randomUserPassword generates the password of a user the source lists
+// without one and no initial password is configured for.
+func randomUserPassword() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// connectUserSyncTarget connects to the deployment named by spec.clusterRef.
+func (r *Neo4jUserSyncReconciler) connectUserSyncTarget(ctx context.Context, userSync *neo4jv1alpha1.Neo4jUserSync) (*neo4jclient.Client, error) {
+	return connectClusterRef(ctx, r.Client, userSync.Namespace, userSync.Spec.ClusterRef)
+}
+
+func (r *Neo4jUserSyncReconciler) failUserSync(ctx context.Context, userSync *neo4jv1alpha1.Neo4jUserSync, message string) {
+	if userSync.Status.Phase != "Failed" || userSync.Status.Message != message {
+		r.Recorder.Event(userSync, corev1.EventTypeWarning, EventReasonUserSyncFailed, message)
+	}
+	r.updateUserSyncStatus(ctx, userSync, "Failed", message, nil)
+}
+
+func (r *Neo4jUserSyncReconciler) updateUserSyncStatus(ctx context.Context, userSync *neo4jv1alpha1.Neo4jUserSync, phase, message string, outcome *userSyncOutcome) {
+	var previousPhase string
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jUserSync{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(userSync), latest); err != nil {
+			return err
+		}
+		previousPhase = latest.Status.Phase
+		if latest.Status.Phase == phase && latest.Status.Message == message && outcome == nil {
+			return nil
+		}
+		latest.Status.Phase = phase
+		latest.Status.Message = message
+		latest.Status.ObservedGeneration = latest.Generation
+		condStatus, condReason := PhaseToConditionStatus(phase)
+		SetReadyCondition(&latest.Status.Conditions, latest.Generation, condStatus, condReason, message)
+		if outcome != nil {
+			now := metav1.Now()
+			latest.Status.LastSyncTime = &now
+			latest.Status.DesiredUsers = outcome.desired
+			latest.Status.PendingUsers = outcome.pending
+			latest.Status.FailedUsers = outcome.failed
+			latest.Status.Users = outcome.results
+		}
+		return r.Status().Update(ctx, latest)
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update user sync status")
+		return
+	}
+	recordPhaseChange(r.Recorder, userSync, previousPhase, phase, message)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Neo4jUserSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status writes happen on every sync and must not trigger another;
+		// the source is re-read on the requeue interval instead
+		For(&neo4jv1alpha1.Neo4jUserSync{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
+		}).
+		Complete(observeReconcileErrors("neo4jusersync", r))
+}
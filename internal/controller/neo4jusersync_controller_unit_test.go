@@ -0,0 +1,292 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// recordingUserClient records the user statements it is asked to run and
+// fails those listed in failOn.
+type recordingUserClient struct {
+	calls  []string
+	failOn map[string]bool
+}
+
+func (c *recordingUserClient) record(call string) error {
+	c.calls = append(c.calls, call)
+	if c.failOn[call] {
+		return fmt.Errorf("%s failed", call)
+	}
+	return nil
+}
+
+func (c *recordingUserClient) CreateUser(_ context.Context, username, _ string, mustChangePassword bool) error {
+	return c.record(fmt.Sprintf("create %s change=%t", username, mustChangePassword))
+}
+
+func (c *recordingUserClient) DropUser(_ context.Context, username string) error {
+	return c.record("drop " + username)
+}
+
+func (c *recordingUserClient) GrantRoleToUser(_ context.Context, role, username string) error {
+	return c.record(fmt.Sprintf("grant %s %s", role, username))
+}
+
+func (c *recordingUserClient) RevokeRoleFromUser(_ context.Context, role, username string) error {
+	return c.record(fmt.Sprintf("revoke %s %s", role, username))
+}
+
+func (c *recordingUserClient) SuspendUser(_ context.Context, username string) error {
+	return c.record("suspend " + username)
+}
+
+func (c *recordingUserClient) ActivateUser(_ context.Context, username string) error {
+	return c.record("activate " + username)
+}
+
+func TestParseUserSyncSource_Users(t *testing.T) {
+	data := []byte(`
+- username: alice
+  roles: [editor, reader]
+- username: bob
+  suspended: true
+`)
+	desired, err := parseUserSyncSource("users", data, false, nil, []string{"reader"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]desiredUser{
+		"alice": {roles: []string{"editor", "reader"}, mustChangePassword: true},
+		"bob":   {roles: []string{"reader"}, suspended: true, mustChangePassword: true},
+	}, desired)
+
+	_, err = parseUserSyncSource("users", []byte(`[{"username": "alice", "password": "secret"}]`), false, nil, nil)
+	assert.ErrorContains(t, err, "only read from a Secret")
+
+	desired, err = parseUserSyncSource("users", []byte(`[{"username": "alice", "password": "secret"}]`), true, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, desiredUser{password: "secret"}, desired["alice"])
+
+	_, err = parseUserSyncSource("users", []byte("- username: alice\n- username: alice\n"), false, nil, nil)
+	assert.ErrorContains(t, err, "listed twice")
+
+	_, err = parseUserSyncSource("users", []byte("- username: alice\n  role: reader\n"), false, nil, nil)
+	assert.Error(t, err, "unknown fields are rejected")
+
+	_, err = parseUserSyncSource("users", []byte("- username: \"a`b\"\n"), false, nil, nil)
+	assert.ErrorContains(t, err, "backticks")
+}
+
+func TestParseUserSyncSource_Groups(t *testing.T) {
+	data := []byte(`
+analysts: [alice, bob]
+engineers: [bob]
+contractors: [carol]
+`)
+	groupRoles := map[string][]string{"analysts": {"reader"}, "engineers": {"editor", "reader"}}
+	desired, err := parseUserSyncSource("groups", data, false, groupRoles, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]desiredUser{
+		"alice": {roles: []string{"reader"}, mustChangePassword: true},
+		"bob":   {roles: []string{"editor", "reader"}, mustChangePassword: true},
+		"carol": {mustChangePassword: true},
+	}, desired)
+
+	_, err = parseUserSyncSource("ldif", data, false, nil, nil)
+	assert.ErrorContains(t, err, "unsupported source format")
+}
+
+func TestPlanUserSync(t *testing.T) {
+	desired := map[string]desiredUser{
+		"alice": {roles: []string{"editor", "reader"}},
+		"bob":   {roles: []string{"reader"}, suspended: true},
+		"carol": {roles: []string{"reader"}, password: "initial", mustChangePassword: true},
+	}
+	current := []neo4jclient.UserInfo{
+		{Name: "neo4j", Roles: []string{"admin", "PUBLIC"}},
+		{Name: "alice", Roles: []string{"reader", "architect", "PUBLIC"}},
+		{Name: "bob", Roles: []string{"reader", "editor", "PUBLIC"}},
+		{Name: "dave", Roles: []string{"reader", "PUBLIC"}},
+		{Name: "erin", Roles: []string{"reader", "PUBLIC"}},
+	}
+	previous := []neo4jv1alpha1.UserSyncResult{
+		{Username: "alice", State: userSyncStateSynced, Roles: []string{"reader"}},
+		{Username: "bob", State: userSyncStateSynced, Roles: []string{"editor", "reader"}},
+		{Username: "dave", State: userSyncStateSynced, Roles: []string{"reader"}},
+		{Username: "frank", State: userSyncStateRemoved},
+	}
+
+	actions := planUserSync(desired, current, previous, "Suspend", "neo4j")
+	byName := map[string]userSyncAction{}
+	var names []string
+	for _, action := range actions {
+		byName[action.username] = action
+		names = append(names, action.username)
+	}
+	// erin was never managed and neo4j is not listed, so both are left alone
+	assert.Equal(t, []string{"alice", "bob", "carol", "dave", "frank"}, names)
+
+	alice := byName["alice"]
+	assert.Equal(t, []string{"editor"}, alice.grant)
+	assert.Empty(t, alice.revoke, "architect was not granted by the sync")
+	assert.Equal(t, []string{"reader"}, alice.held)
+
+	bob := byName["bob"]
+	assert.Equal(t, []string{"editor"}, bob.revoke)
+	assert.True(t, bob.suspend)
+
+	carol := byName["carol"]
+	assert.True(t, carol.create)
+	assert.True(t, carol.mustChangePassword)
+	assert.Equal(t, "initial", carol.password)
+	assert.Equal(t, []string{"reader"}, carol.grant)
+
+	dave := byName["dave"]
+	assert.True(t, dave.removed)
+	assert.True(t, dave.suspend)
+	assert.True(t, byName["frank"].forget, "frank no longer exists")
+
+	for policy, check := range map[string]func(userSyncAction) bool{
+		"Delete": func(a userSyncAction) bool { return a.drop },
+		"Retain": func(a userSyncAction) bool { return a.forget && a.noop() },
+	} {
+		for _, action := range planUserSync(desired, current, previous, policy, "neo4j") {
+			if action.username == "dave" {
+				assert.True(t, check(action), policy)
+			}
+		}
+	}
+}
+
+func TestPlanUserSync_NeverRemovesReservedUser(t *testing.T) {
+	current := []neo4jclient.UserInfo{{Name: "neo4j", Roles: []string{"admin"}}}
+	previous := []neo4jv1alpha1.UserSyncResult{{Username: "neo4j", State: userSyncStateSynced}}
+
+	actions := planUserSync(map[string]desiredUser{}, current, previous, "Delete", "neo4j")
+	require.Len(t, actions, 1)
+	assert.True(t, actions[0].forget)
+}
+
+func TestApplyUserSync_BatchesAndReportsPerUser(t *testing.T) {
+	ctx := context.Background()
+	earlier := metav1.Now()
+	actions := []userSyncAction{
+		{username: "alice", grant: []string{"editor"}, held: []string{"reader"}, previous: &neo4jv1alpha1.UserSyncResult{Username: "alice", Roles: []string{"reader"}}},
+		{username: "bob", held: []string{"reader"}, previous: &neo4jv1alpha1.UserSyncResult{Username: "bob", State: userSyncStateSynced, Message: "Created", LastChanged: &earlier}},
+		{username: "carol", create: true, grant: []string{"missing"}, mustChangePassword: true},
+		{username: "dave", drop: true, removed: true, previous: &neo4jv1alpha1.UserSyncResult{Username: "dave"}},
+		{username: "erin", create: true},
+		{username: "frank", forget: true},
+	}
+	c := &recordingUserClient{failOn: map[string]bool{"grant missing carol": true}}
+	now := metav1.Now()
+
+	outcome := applyUserSync(ctx, c, actions, 3, now)
+
+	assert.Equal(t, []string{
+		"grant editor alice",
+		"create carol change=true",
+		"grant missing carol",
+		"drop dave",
+	}, c.calls)
+	assert.Equal(t, []neo4jv1alpha1.UserSyncResult{
+		{Username: "alice", State: userSyncStateSynced, Roles: []string{"editor", "reader"}, Message: "Granted editor", LastChanged: &now},
+		{Username: "bob", State: userSyncStateSynced, Roles: []string{"reader"}, Message: "Created", LastChanged: &earlier},
+		{Username: "carol", State: userSyncStateFailed, Message: "grant missing carol failed", LastChanged: &now},
+		{Username: "erin", State: userSyncStatePending, Message: "Waiting for the next batch"},
+	}, outcome.results)
+	assert.Equal(t, 1, outcome.updated)
+	assert.Equal(t, 1, outcome.removed)
+	assert.Equal(t, int32(1), outcome.failed)
+	assert.Equal(t, int32(1), outcome.pending)
+
+	outcome.desired = 4
+	phase, message := outcome.phase()
+	assert.Equal(t, "Degraded", phase)
+	assert.Equal(t, "4 users: 0 created, 1 updated, 1 removed, 1 failed", message)
+}
+
+func newUserSyncTestReconciler(objs ...client.Object) (*Neo4jUserSyncReconciler, client.Client) {
+	scheme := newTestScheme()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jUserSync{}).Build()
+	return &Neo4jUserSyncReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}, c
+}
+
+func testUserSync() *neo4jv1alpha1.Neo4jUserSync {
+	return &neo4jv1alpha1.Neo4jUserSync{
+		ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jUserSyncSpec{
+			ClusterRef: "prod",
+			Source: neo4jv1alpha1.UserSyncSource{
+				Format:       "users",
+				ConfigMapRef: &neo4jv1alpha1.UserSyncSourceRef{Name: "user-list"},
+			},
+			InitialPasswordSecret: "initial-password",
+		},
+	}
+}
+
+func TestLoadDesiredUsers_AppliesInitialPassword(t *testing.T) {
+	ctx := context.Background()
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-list", Namespace: "default"},
+		Data:       map[string]string{"users.yaml": "- username: alice\n  roles: [reader]\n"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "initial-password", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("welcome")},
+	}
+	userSync := testUserSync()
+	r, _ := newUserSyncTestReconciler(configMap, secret, userSync)
+
+	desired, err := r.loadDesiredUsers(ctx, userSync)
+	require.NoError(t, err)
+	assert.Equal(t, desiredUser{roles: []string{"reader"}, password: "welcome", mustChangePassword: true}, desired["alice"])
+
+	userSync.Spec.Source.SecretRef = &neo4jv1alpha1.UserSyncSourceRef{Name: "user-list"}
+	_, err = r.loadDesiredUsers(ctx, userSync)
+	assert.ErrorContains(t, err, "exactly one of")
+}
+
+func TestReconcileUserSync_FailsOnMissingSource(t *testing.T) {
+	ctx := context.Background()
+	userSync := testUserSync()
+	r, c := newUserSyncTestReconciler(userSync)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(userSync)})
+	require.NoError(t, err)
+	assert.Equal(t, defaultUserSyncInterval, result.RequeueAfter)
+
+	updated := &neo4jv1alpha1.Neo4jUserSync{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(userSync), updated))
+	assert.Equal(t, "Failed", updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "source ConfigMap user-list")
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// Neo4jWorkloadReconciler reconciles a Neo4jWorkload object
+type Neo4jWorkloadReconciler struct {
+	client.Client
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	MaxConcurrentReconciles int
+	RequeueAfter            time.Duration
+}
+
+const (
+	// workloadContainerName is the load generator container; its termination
+	// message carries the run report.
+	workloadContainerName = "load-generator"
+
+	// workloadDeadlineGrace is added to the workload duration for the Job's
+	// active deadline, covering connection setup and draining queued statements.
+	workloadDeadlineGrace = 10 * time.Minute
+
+	defaultWorkloadWriteQuery = "CREATE (:Neo4jWorkloadProbe {id: randomUUID(), createdAt: timestamp()})"
+	defaultWorkloadReadQuery  = "MATCH (n:Neo4jWorkloadProbe) RETURN n.id ORDER BY n.createdAt DESC LIMIT 10"
+	defaultWorkloadSetupQuery = "CREATE INDEX neo4j_workload_probe_created IF NOT EXISTS FOR (n:Neo4jWorkloadProbe) ON (n.createdAt)"
+)
+
+// workloadScript runs READ_SESSIONS + WRITE_SESSIONS cypher-shell sessions.
+// Each session is fed statements until the deadline, with every completed
+// statement acknowledged back to the feeder through a FIFO so only a few are
+// ever queued. cypher-shell's verbose output reports the time to the first
+// record and to the last record of every successful statement. The
+// aggregated report is written to the termination log, where the controller
+// picks it up.
+const workloadScript = `set -u
+work=$(mktemp -d)
+printf -v started '%(%s)T' -1
+deadline=$((started + DURATION_SECONDS))
+
+shell() {
+  cypher-shell -a "$NEO4J_URI" -u "$NEO4J_USERNAME" -p "$NEO4J_PASSWORD" -d "$NEO4J_DATABASE" "$@"
+}
+
+if [ -n "${SETUP_QUERY:-}" ]; then
+  shell "$SETUP_QUERY" >/dev/null || echo "setup query failed, continuing"
+fi
+
+session() {
+  kind=$1 id=$2 query=$3
+  lat="$work/$kind.$id.lat"
+  mkfifo "$work/$kind.$id.ack"
+  exec 3<> "$work/$kind.$id.ack"
+  : > "$lat"
+  {
+    sent=0
+    while :; do
+      printf -v now '%(%s)T' -1
+      [ "$now" -lt "$deadline" ] || break
+      printf '%s\n' "$query"
+      sent=$((sent + 1))
+      # Keep at most 4 statements queued so the run ends close to the deadline
+      [ "$sent" -le 4 ] || read -r -t 2 -u 3 _ || true
+    done
+    echo "$sent" > "$work/$kind.$id.sent"
+  } | shell --access-mode "$kind" --format verbose --fail-at-end 2>> "$work/errors.log" |
+    while IFS= read -r line; do
+      if [[ $line =~ query\ after\ ([0-9]+)\ ms,\ results\ consumed\ after\ another\ ([0-9]+)\ ms ]]; then
+        echo $((BASH_REMATCH[1] + BASH_REMATCH[2])) >> "$lat"
+        echo >&3
+      fi
+    done
+}
+
+echo "Running $READ_SESSIONS read and $WRITE_SESSIONS write sessions for ${DURATION_SECONDS}s against $NEO4J_URI"
+for i in $(seq 1 "$READ_SESSIONS"); do session read "$i" "$READ_QUERY" & done
+for i in $(seq 1 "$WRITE_SESSIONS"); do session write "$i" "$WRITE_QUERY" & done
+wait
+printf -v finished '%(%s)T' -1
+
+count() { cat "$work"/$1 2>/dev/null | wc -l; }
+sum() { cat "$work"/$1 2>/dev/null | awk '{ s += $1 } END { print s + 0 }'; }
+reads=$(count 'read.*.lat')
+writes=$(count 'write.*.lat')
+sent=$(sum '*.sent')
+errors=$((sent - reads - writes))
+[ "$errors" -ge 0 ] || errors=0
+
+cat "$work"/*.lat | sort -n > "$work/all"
+total=$(wc -l < "$work/all")
+pct() { awk -v q="$1" -v n="$total" 'n > 0 && NR == int((n - 1) * q) + 1 { print; exit } END { if (n == 0) print 0 }' "$work/all"; }
+
+report=$(printf '{"durationSeconds":%d,"reads":%d,"writes":%d,"errors":%d,"p50Ms":%d,"p95Ms":%d,"p99Ms":%d}' \
+  "$((finished - started))" "$reads" "$writes" "$errors" "$(pct 0.50)" "$(pct 0.95)" "$(pct 0.99)")
+echo "$report"
+echo "$report" > /dev/termination-log
+if [ "$errors" -gt 0 ]; then
+  echo "Last errors:"
+  tail -n 5 "$work/errors.log"
+fi
+[ "$total" -gt 0 ]
+`
+
+// workloadReport is the JSON document the load generator writes to its
+// termination log.
+type workloadReport struct {
+	DurationSeconds int64 `json:"durationSeconds"`
+	Reads           int64 `json:"reads"`
+	Writes          int64 `json:"writes"`
+	Errors          int64 `json:"errors"`
+	P50Ms           int64 `json:"p50Ms"`
+	P95Ms           int64 `json:"p95Ms"`
+	P99Ms           int64 `json:"p99Ms"`
+}
+
+// workloadTarget is the deployment a workload runs against.
+type workloadTarget struct {
+	uri             string
+	image           neo4jv1alpha1.ImageSpec
+	adminSecret     string
+	securityContext *neo4jv1alpha1.SecurityContextSpec
+	ready           bool
+}
+
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jworkloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jworkloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jworkloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile runs the load generator Job of a Neo4jWorkload once and records
+// its results. A finished workload is not run again; delete and recreate it
+// to start another run.
+func (r *Neo4jWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	workload := &neo4jv1alpha1.Neo4jWorkload{}
+	if err := r.Get(ctx, req.NamespacedName, workload); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Neo4jWorkload resource not found")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Neo4jWorkload")
+		return ctrl.Result{}, err
+	}
+
+	if workload.DeletionTimestamp != nil || workload.Status.Phase == "Completed" || workload.Status.Phase == "Failed" {
+		return ctrl.Result{}, nil
+	}
+
+	duration, err := workloadDuration(workload)
+	if err != nil {
+		r.updateWorkloadStatus(ctx, workload, "Failed", err.Error(), nil)
+		r.Recorder.Event(workload, corev1.EventTypeWarning, EventReasonWorkloadFailed, err.Error())
+		return ctrl.Result{}, nil
+	}
+
+	jobName := workload.Name + "-workload"
+	job := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: workload.Namespace}, job)
+	if err == nil {
+		return r.handleExistingWorkloadJob(ctx, workload, job)
+	}
+	if !errors.IsNotFound(err) {
+		logger.Error(err, "Failed to get workload job")
+		return ctrl.Result{}, err
+	}
+
+	target, err := getClusterRefTarget(ctx, r.Client, workload.Namespace, workload.Spec.ClusterRef)
+	if err != nil {
+		r.updateWorkloadStatus(ctx, workload, "Pending", err.Error(), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+	if !target.ready {
+		r.updateWorkloadStatus(ctx, workload, "Pending", "Target deployment is not ready", nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	job = r.buildWorkloadJob(workload, target, duration)
+	job.Name = jobName
+	if err := controllerutil.SetControllerReference(workload, job, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		logger.Error(err, "Failed to create workload job")
+		r.updateWorkloadStatus(ctx, workload, "Failed", fmt.Sprintf("Failed to create workload job: %v", err), nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, err
+	}
+
+	r.updateWorkloadStatus(ctx, workload, "Running", fmt.Sprintf("Load generator job %s started", job.Name), nil)
+	r.Recorder.Event(workload, corev1.EventTypeNormal, EventReasonWorkloadStarted,
+		fmt.Sprintf("Load generator job %s started for %s", job.Name, duration))
+	return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+}
+
+func (r *Neo4jWorkloadReconciler) handleExistingWorkloadJob(ctx context.Context, workload *neo4jv1alpha1.Neo4jWorkload, job *batchv1.Job) (ctrl.Result, error) {
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		r.updateWorkloadStatus(ctx, workload, "Running", "Load generator is running", nil)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
+	report, err := r.readWorkloadReport(ctx, job)
+	if err != nil {
+		log.FromContext(ctx).Info("Load generator report not available", "job", job.Name, "error", err.Error())
+	}
+	var results *neo4jv1alpha1.WorkloadResults
+	if report != nil {
+		results = report.results()
+	}
+
+	if job.Status.Succeeded > 0 && results != nil {
+		message := fmt.Sprintf("%d transactions, %s tx/s, p99 %s", results.Transactions, results.Throughput, results.LatencyP99)
+		r.updateWorkloadStatus(ctx, workload, "Completed", message, results)
+		r.Recorder.Event(workload, corev1.EventTypeNormal, EventReasonWorkloadCompleted, message)
+		return ctrl.Result{}, nil
+	}
+
+	message := "Load generator job failed"
+	if results != nil {
+		message = fmt.Sprintf("Load generator job failed after %d transactions with %d errors", results.Transactions, results.Errors)
+	}
+	r.updateWorkloadStatus(ctx, workload, "Failed", message, results)
+	r.Recorder.Event(workload, corev1.EventTypeWarning, EventReasonWorkloadFailed, message)
+	return ctrl.Result{}, nil
+}
+
+// getClusterRefTarget resolves a clusterRef to a cluster or, failing that,
+// a standalone deployment in the given namespace.
+func getClusterRefTarget(ctx context.Context, c client.Client, namespace, clusterRef string) (*workloadTarget, error) {
+	key := types.NamespacedName{Name: clusterRef, Namespace: namespace}
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	if err := c.Get(ctx, key, cluster); err == nil {
+		// neo4j:// routes write sessions to the database leader
+		return &workloadTarget{
+			uri:             fmt.Sprintf("%s://%s-client.%s.svc.cluster.local:7687", workloadURIScheme("neo4j", cluster.Spec.TLS), cluster.Name, cluster.Namespace),
+			image:           cluster.Spec.Image,
+			adminSecret:     workloadAdminSecret(cluster.Spec.Auth),
+			securityContext: cluster.Spec.SecurityContext,
+			ready:           cluster.Status.Phase == "Ready",
+		}, nil
+	}
+
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+	if err := c.Get(ctx, key, standalone); err != nil {
+		return nil, fmt.Errorf("target %q not found as Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone in namespace %q", key.Name, key.Namespace)
+	}
+	return &workloadTarget{
+		uri:             fmt.Sprintf("%s://%s-service.%s.svc.cluster.local:7687", workloadURIScheme("bolt", standalone.Spec.TLS), standalone.Name, standalone.Namespace),
+		image:           standalone.Spec.Image,
+		adminSecret:     workloadAdminSecret(standalone.Spec.Auth),
+		securityContext: standalone.Spec.SecurityContext,
+		ready:           standalone.Status.Phase == "Ready",
+	}, nil
+}
+
+// workloadURIScheme accepts self-signed certificates when TLS is enabled,
+// matching the operator's own driver configuration.
+func workloadURIScheme(scheme string, tls *neo4jv1alpha1.TLSSpec) string {
+	if resources.TLSEnabled(tls) {
+		return scheme + "+ssc"
+	}
+	return scheme
+}
+
+func workloadAdminSecret(auth *neo4jv1alpha1.AuthSpec) string {
+	if auth != nil && auth.AdminSecret != "" {
+		return auth.AdminSecret
+	}
+	return resources.DefaultAdminSecret
+}
+
+// workloadDuration parses spec.duration, defaulting to five minutes.
+func workloadDuration(workload *neo4jv1alpha1.Neo4jWorkload) (time.Duration, error) {
+	if workload.Spec.Duration == "" {
+		return 5 * time.Minute, nil
+	}
+	d, err := time.ParseDuration(workload.Spec.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", workload.Spec.Duration, err)
+	}
+	if d < time.Second {
+		return 0, fmt.Errorf("duration %q must be at least 1s", workload.Spec.Duration)
+	}
+	return d, nil
+}
+
+// workloadSessionSplit divides the client sessions between readers and
+// writers according to readPercent, keeping at least one session of each
+// kind the mix asks for.
+func workloadSessionSplit(concurrency, readPercent int32) (reads, writes int32) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	reads = (concurrency*readPercent + 50) / 100
+	switch {
+	case readPercent > 0 && reads == 0:
+		reads = 1
+	case readPercent < 100 && reads == concurrency && concurrency > 1:
+		reads = concurrency - 1
+	}
+	return reads, concurrency - reads
+}
+
+// cypherStatement terminates a statement for cypher-shell's stdin mode.
+func cypherStatement(query string) string {
+	query = strings.TrimSpace(query)
+	if !strings.HasSuffix(query, ";") {
+		query += ";"
+	}
+	return query
+}
+
+func (r *Neo4jWorkloadReconciler) buildWorkloadJob(workload *neo4jv1alpha1.Neo4jWorkload, target *workloadTarget, duration time.Duration) *batchv1.Job {
+	spec := workload.Spec
+
+	image := target.image
+	if spec.Image != nil {
+		image = *spec.Image
+	}
+	database := spec.Database
+	if database == "" {
+		database = "neo4j"
+	}
+	readPercent := int32(80)
+	if spec.ReadPercent != nil {
+		readPercent = *spec.ReadPercent
+	}
+	concurrency := spec.Concurrency
+	if concurrency == 0 {
+		concurrency = 4
+	}
+	reads, writes := workloadSessionSplit(concurrency, readPercent)
+
+	readQuery, writeQuery, setupQuery := spec.ReadQuery, spec.WriteQuery, ""
+	if readQuery == "" {
+		readQuery = defaultWorkloadReadQuery
+	}
+	if writeQuery == "" {
+		writeQuery = defaultWorkloadWriteQuery
+		setupQuery = defaultWorkloadSetupQuery
+	}
+
+	secretEnv := func(name, key string) corev1.EnvVar {
+		return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: target.adminSecret},
+				Key:                  key,
+			},
+		}}
+	}
+
+	container := corev1.Container{
+		Name:            workloadContainerName,
+		Image:           resources.ImageReference(image),
+		ImagePullPolicy: resources.ImagePullPolicy(image),
+		Command:         []string{"/bin/bash", "-c", workloadScript},
+		Env: []corev1.EnvVar{
+			{Name: "NEO4J_URI", Value: target.uri},
+			{Name: "NEO4J_DATABASE", Value: database},
+			secretEnv("NEO4J_USERNAME", "username"),
+			secretEnv("NEO4J_PASSWORD", "password"),
+			{Name: "DURATION_SECONDS", Value: strconv.FormatInt(int64(duration/time.Second), 10)},
+			{Name: "READ_SESSIONS", Value: strconv.Itoa(int(reads))},
+			{Name: "WRITE_SESSIONS", Value: strconv.Itoa(int(writes))},
+			{Name: "READ_QUERY", Value: cypherStatement(readQuery)},
+			{Name: "WRITE_QUERY", Value: cypherStatement(writeQuery)},
+			{Name: "SETUP_QUERY", Value: setupQuery},
+		},
+	}
+	if spec.Resources != nil {
+		container.Resources = *spec.Resources
+	}
+
+	backoffLimit := int32(0)
+	deadline := int64((duration + workloadDeadlineGrace) / time.Second)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "neo4j-workload",
+				"app.kubernetes.io/instance":   workload.Name,
+				"app.kubernetes.io/component":  "load-generator",
+				"app.kubernetes.io/managed-by": "neo4j-operator",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			// A retried run would report a mix of two runs
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &deadline,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: resources.ImagePullSecrets(image),
+					Containers:       []corev1.Container{container},
+				},
+			},
+		},
+	}
+	resources.ApplySecurityProfiles(&job.Spec.Template, target.securityContext)
+	return job
+}
+
+// readWorkloadReport reads the report from the termination message of the
+// Job's load generator pod.
+func (r *Neo4jWorkloadReconciler) readWorkloadReport(ctx context.Context, job *batchv1.Job) (*workloadReport, error) {
+	message, err := jobTerminationMessage(ctx, r.Client, job, workloadContainerName)
+	if err != nil {
+		return nil, err
+	}
+	report := &workloadReport{}
+	if err := json.Unmarshal([]byte(message), report); err != nil {
+		return nil, fmt.Errorf("invalid load generator report: %w", err)
+	}
+	return report, nil
+}
+
+// results converts the raw report into the status representation.
+func (w *workloadReport) results() *neo4jv1alpha1.WorkloadResults {
+	transactions := w.Reads + w.Writes
+	throughput := "0.0"
+	if w.DurationSeconds > 0 {
+		throughput = strconv.FormatFloat(float64(transactions)/float64(w.DurationSeconds), 'f', 1, 64)
+	}
+	ms := func(v int64) string { return (time.Duration(v) * time.Millisecond).String() }
+	return &neo4jv1alpha1.WorkloadResults{
+		Duration:     (time.Duration(w.DurationSeconds) * time.Second).String(),
+		Transactions: transactions,
+		Reads:        w.Reads,
+		Writes:       w.Writes,
+		Errors:       w.Errors,
+		Throughput:   throughput,
+		LatencyP50:   ms(w.P50Ms),
+		LatencyP95:   ms(w.P95Ms),
+		LatencyP99:   ms(w.P99Ms),
+	}
+}
+
+func (r *Neo4jWorkloadReconciler) updateWorkloadStatus(ctx context.Context, workload *neo4jv1alpha1.Neo4jWorkload, phase, message string, results *neo4jv1alpha1.WorkloadResults) {
+	var previousPhase string
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jWorkload{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(workload), latest); err != nil {
+			return err
+		}
+		previousPhase = latest.Status.Phase
+		if latest.Status.Phase == phase && latest.Status.Message == message && results == nil {
+			return nil
+		}
+		latest.Status.Phase = phase
+		latest.Status.Message = message
+		latest.Status.ObservedGeneration = latest.Generation
+		condStatus, condReason := PhaseToConditionStatus(phase)
+		SetReadyCondition(&latest.Status.Conditions, latest.Generation, condStatus, condReason, message)
+		now := metav1.Now()
+		switch phase {
+		case "Running":
+			if latest.Status.StartTime == nil {
+				latest.Status.StartTime = &now
+			}
+		case "Completed", "Failed":
+			latest.Status.CompletionTime = &now
+		}
+		if results != nil {
+			latest.Status.Results = results
+		}
+		return r.Status().Update(ctx, latest)
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update workload status")
+		return
+	}
+	recordPhaseChange(r.Recorder, workload, previousPhase, phase, message)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Neo4jWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&neo4jv1alpha1.Neo4jWorkload{}).
+		Owns(&batchv1.Job{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			NewQueue:                observedQueue,
+		}).
+		Complete(observeReconcileErrors("neo4jworkload", r))
+}
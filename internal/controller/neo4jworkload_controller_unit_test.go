@@ -0,0 +1,212 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func newWorkloadTestReconciler(objs ...client.Object) (*Neo4jWorkloadReconciler, client.Client, *record.FakeRecorder) {
+	scheme := newTestScheme()
+	_ = batchv1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jWorkload{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	return &Neo4jWorkloadReconciler{Client: c, Scheme: scheme, Recorder: recorder}, c, recorder
+}
+
+func testWorkload() *neo4jv1alpha1.Neo4jWorkload {
+	readPercent := int32(75)
+	return &neo4jv1alpha1.Neo4jWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "soak", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jWorkloadSpec{
+			ClusterRef:  "prod",
+			Duration:    "2m",
+			Concurrency: 8,
+			ReadPercent: &readPercent,
+		},
+	}
+}
+
+func TestWorkloadSessionSplit(t *testing.T) {
+	tests := []struct {
+		concurrency, readPercent int32
+		reads, writes            int32
+	}{
+		{concurrency: 8, readPercent: 75, reads: 6, writes: 2},
+		{concurrency: 4, readPercent: 80, reads: 3, writes: 1},
+		{concurrency: 4, readPercent: 99, reads: 3, writes: 1},
+		{concurrency: 4, readPercent: 1, reads: 1, writes: 3},
+		{concurrency: 4, readPercent: 100, reads: 4, writes: 0},
+		{concurrency: 4, readPercent: 0, reads: 0, writes: 4},
+		{concurrency: 1, readPercent: 50, reads: 1, writes: 0},
+	}
+	for _, tt := range tests {
+		reads, writes := workloadSessionSplit(tt.concurrency, tt.readPercent)
+		assert.Equal(t, tt.reads, reads, "reads for %d/%d%%", tt.concurrency, tt.readPercent)
+		assert.Equal(t, tt.writes, writes, "writes for %d/%d%%", tt.concurrency, tt.readPercent)
+	}
+}
+
+func TestWorkloadReportResults(t *testing.T) {
+	report := &workloadReport{DurationSeconds: 120, Reads: 40000, Writes: 9500, Errors: 3, P50Ms: 2, P95Ms: 14, P99Ms: 1250}
+	assert.Equal(t, &neo4jv1alpha1.WorkloadResults{
+		Duration:     "2m0s",
+		Transactions: 49500,
+		Reads:        40000,
+		Writes:       9500,
+		Errors:       3,
+		Throughput:   "412.5",
+		LatencyP50:   "2ms",
+		LatencyP95:   "14ms",
+		LatencyP99:   "1.25s",
+	}, report.results())
+}
+
+func TestReconcileWorkload_CreatesLoadGeneratorJob(t *testing.T) {
+	ctx := context.Background()
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Auth:  &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin"},
+			TLS:   &neo4jv1alpha1.TLSSpec{Mode: "cert-manager"},
+		},
+		Status: neo4jv1alpha1.Neo4jEnterpriseClusterStatus{Phase: "Ready"},
+	}
+	workload := testWorkload()
+	r, c, recorder := newWorkloadTestReconciler(cluster, workload)
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(workload)})
+	require.NoError(t, err)
+
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "soak-workload", Namespace: "default"}, job))
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "neo4j:5.26.0-enterprise", container.Image)
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int64(120+600), *job.Spec.ActiveDeadlineSeconds)
+
+	env := envVarMap(container.Env)
+	assert.Equal(t, "neo4j+ssc://prod-client.default.svc.cluster.local:7687", env["NEO4J_URI"])
+	assert.Equal(t, "neo4j", env["NEO4J_DATABASE"])
+	assert.Equal(t, "120", env["DURATION_SECONDS"])
+	assert.Equal(t, "6", env["READ_SESSIONS"])
+	assert.Equal(t, "2", env["WRITE_SESSIONS"])
+	assert.Equal(t, defaultWorkloadWriteQuery+";", env["WRITE_QUERY"])
+	assert.Equal(t, defaultWorkloadSetupQuery, env["SETUP_QUERY"])
+	assert.Equal(t, "password", envVarSecretKey(container.Env, "NEO4J_PASSWORD"))
+
+	updated := &neo4jv1alpha1.Neo4jWorkload{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(workload), updated))
+	assert.Equal(t, "Running", updated.Status.Phase)
+	assert.NotNil(t, updated.Status.StartTime)
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Normal PhaseChanged Phase set to Running: Load generator job soak-workload started", <-recorder.Events)
+	assert.Contains(t, <-recorder.Events, EventReasonWorkloadStarted)
+}
+
+func TestReconcileWorkload_WaitsForTarget(t *testing.T) {
+	ctx := context.Background()
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+		},
+		Status: neo4jv1alpha1.Neo4jEnterpriseStandaloneStatus{Phase: "Pending"},
+	}
+	workload := testWorkload()
+	r, c, _ := newWorkloadTestReconciler(standalone, workload)
+	r.RequeueAfter = GetTestRequeueAfter()
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(workload)})
+	require.NoError(t, err)
+	assert.NotZero(t, result.RequeueAfter)
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(ctx, jobs))
+	assert.Empty(t, jobs.Items)
+
+	updated := &neo4jv1alpha1.Neo4jWorkload{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(workload), updated))
+	assert.Equal(t, "Pending", updated.Status.Phase)
+}
+
+func TestReconcileWorkload_RecordsReportOfFinishedJob(t *testing.T) {
+	ctx := context.Background()
+	workload := testWorkload()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "soak-workload", Namespace: "default"},
+		Status:     batchv1.JobStatus{Succeeded: 1},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "soak-workload-abcde", Namespace: "default", Labels: map[string]string{"job-name": "soak-workload"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name: workloadContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				Message: `{"durationSeconds":120,"reads":900,"writes":300,"errors":0,"p50Ms":3,"p95Ms":9,"p99Ms":21}`,
+			}},
+		}}},
+	}
+	r, c, recorder := newWorkloadTestReconciler(workload, job, pod)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(workload)})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+
+	updated := &neo4jv1alpha1.Neo4jWorkload{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(workload), updated))
+	assert.Equal(t, "Completed", updated.Status.Phase)
+	require.NotNil(t, updated.Status.Results)
+	assert.Equal(t, int64(1200), updated.Status.Results.Transactions)
+	assert.Equal(t, "10.0", updated.Status.Results.Throughput)
+	assert.Equal(t, "21ms", updated.Status.Results.LatencyP99)
+	assert.NotNil(t, updated.Status.CompletionTime)
+	assert.Equal(t, "Normal PhaseChanged Phase set to Completed: 1200 transactions, 10.0 tx/s, p99 21ms", <-recorder.Events)
+	assert.Equal(t, "Normal WorkloadCompleted 1200 transactions, 10.0 tx/s, p99 21ms", <-recorder.Events)
+}
+
+func TestWorkloadDuration(t *testing.T) {
+	w := testWorkload()
+	w.Spec.Duration = ""
+	d, err := workloadDuration(w)
+	require.NoError(t, err)
+	assert.Equal(t, "5m0s", d.String())
+
+	w.Spec.Duration = "soon"
+	_, err = workloadDuration(w)
+	assert.Error(t, err)
+
+	w.Spec.Duration = "10ms"
+	_, err = workloadDuration(w)
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// reconcileClientNetworkPolicy keeps the allowlist NetworkPolicy of a client
+// Service in line with spec.service.allowedCIDRs, deleting it once the CIDRs
+// are removed or the Service becomes a LoadBalancer.
+func reconcileClientNetworkPolicy(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, svc *corev1.Service, spec *neo4jv1alpha1.ServiceSpec) error {
+	return reconcileNetworkPolicy(ctx, c, scheme, owner, resources.ClientNetworkPolicyName(svc.Name), svc.Namespace,
+		resources.BuildClientNetworkPolicy(svc, spec))
+}
+
+// reconcileNetworkPolicy creates or updates the desired NetworkPolicy, or
+// deletes the named one when desired is nil.
+func reconcileNetworkPolicy(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, name, namespace string, desired *networkingv1.NetworkPolicy) error {
+	if desired == nil {
+		policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		if err := c.Delete(ctx, policy); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete NetworkPolicy %s: %w", policy.Name, err)
+		}
+		return nil
+	}
+
+	policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, c, policy, func() error {
+		policy.Labels = desired.Labels
+		policy.Spec = desired.Spec
+		return controllerutil.SetControllerReference(owner, policy, scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update NetworkPolicy %s: %w", desired.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func TestReconcileClientNetworkPolicy(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+	cluster := minimalCluster("prod", "default")
+	cluster.UID = "prod-uid"
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{Type: "NodePort", AllowedCIDRs: []string{"203.0.113.0/24"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	key := client.ObjectKey{Name: "prod-client-allowlist", Namespace: "default"}
+
+	svc := resources.BuildClientServiceForEnterprise(cluster)
+	require.NoError(t, reconcileClientNetworkPolicy(ctx, c, scheme, cluster, svc, cluster.Spec.Service))
+
+	policy := &networkingv1.NetworkPolicy{}
+	require.NoError(t, c.Get(ctx, key, policy))
+	require.Len(t, policy.OwnerReferences, 1)
+	assert.Equal(t, "prod", policy.OwnerReferences[0].Name)
+	require.Len(t, policy.Spec.Ingress, 2)
+	assert.Equal(t, "203.0.113.0/24", policy.Spec.Ingress[1].From[0].IPBlock.CIDR)
+
+	// Changed CIDRs are applied to the existing policy
+	cluster.Spec.Service.AllowedCIDRs = []string{"198.51.100.0/24", "203.0.113.0/24"}
+	require.NoError(t, reconcileClientNetworkPolicy(ctx, c, scheme, cluster, svc, cluster.Spec.Service))
+	require.NoError(t, c.Get(ctx, key, policy))
+	assert.Len(t, policy.Spec.Ingress[1].From, 2)
+
+	// Switching to a LoadBalancer moves enforcement to source ranges
+	cluster.Spec.Service.Type = "LoadBalancer"
+	svc = resources.BuildClientServiceForEnterprise(cluster)
+	require.NoError(t, reconcileClientNetworkPolicy(ctx, c, scheme, cluster, svc, cluster.Spec.Service))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, key, policy)))
+	assert.Equal(t, []string{"198.51.100.0/24", "203.0.113.0/24"}, svc.Spec.LoadBalancerSourceRanges)
+
+	// Deleting an absent policy is not an error
+	require.NoError(t, reconcileClientNetworkPolicy(ctx, c, scheme, cluster, svc, cluster.Spec.Service))
+}
+
+func TestReconcileBackupPort(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.UID = "prod-uid"
+	cluster.Spec.BackupPort = &neo4jv1alpha1.BackupPortSpec{
+		Expose:         true,
+		AllowedClients: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.20.0.0/16"}}},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	key := client.ObjectKey{Name: "prod-backup-port", Namespace: "default"}
+
+	require.NoError(t, r.reconcileBackupPort(ctx, cluster))
+	require.NoError(t, c.Get(ctx, key, &corev1.Service{}))
+	policy := &networkingv1.NetworkPolicy{}
+	require.NoError(t, c.Get(ctx, key, policy))
+	assert.Equal(t, "10.20.0.0/16", policy.Spec.Ingress[0].From[2].IPBlock.CIDR)
+
+	// Turning the port off removes the Service and the policy
+	disabled := false
+	cluster.Spec.BackupPort = &neo4jv1alpha1.BackupPortSpec{Enabled: &disabled}
+	require.NoError(t, r.reconcileBackupPort(ctx, cluster))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, key, &corev1.Service{})))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, key, policy)))
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// operatorUserClient sets up the user of spec.auth.operatorSecret
+type operatorUserClient interface {
+	EnsureOperatorUser(ctx context.Context, username, password string, userManagement bool) error
+	Close() error
+}
+
+// getClusterConnectionSecretName returns the Secret the operator connects to a
+// cluster with: that of spec.auth.operatorSecret once its user is set up,
+// the admin Secret before
+func getClusterConnectionSecretName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if operatorUserReady(cluster.Spec.Auth, cluster.Status.OperatorUser) {
+		return cluster.Spec.Auth.OperatorSecret
+	}
+	return getClusterAdminSecretName(cluster)
+}
+
+// getStandaloneConnectionSecretName returns the Secret the operator connects
+// to a standalone deployment with
+func getStandaloneConnectionSecretName(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) string {
+	if operatorUserReady(standalone.Spec.Auth, standalone.Status.OperatorUser) {
+		return standalone.Spec.Auth.OperatorSecret
+	}
+	return getStandaloneAdminSecretName(standalone)
+}
+
+// operatorUserReady reports whether the user of spec.auth.operatorSecret
+// was set up
+func operatorUserReady(auth *neo4jv1alpha1.AuthSpec, status *neo4jv1alpha1.OperatorUserStatus) bool {
+	return auth != nil && auth.OperatorSecret != "" && status != nil
+}
+
+// operatorCredentials reads the username and password of the operator
+// Secret, and hashes them
+func operatorCredentials(ctx context.Context, c client.Client, namespace, name string) (string, string, string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+		return "", "", "", fmt.Errorf("failed to get operator Secret %s: %w", name, err)
+	}
+	username, password := string(secret.Data["username"]), string(secret.Data["password"])
+	if username == "" || password == "" {
+		return "", "", "", fmt.Errorf("operator Secret %s needs a username and a password", name)
+	}
+	sum := sha256.Sum256([]byte(username + "\x00" + password))
+	return username, password, hex.EncodeToString(sum[:]), nil
+}
+
+// userSyncTarget reports whether a Neo4jUserSync manages the users of the
+// named deployment, which the operator user then needs user management for
+func userSyncTarget(ctx context.Context, c client.Client, namespace, name string) (bool, error) {
+	userSyncs := &neo4jv1alpha1.Neo4jUserSyncList{}
+	if err := c.List(ctx, userSyncs, client.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed to list user syncs: %w", err)
+	}
+	for _, userSync := range userSyncs.Items {
+		if userSync.Spec.ClusterRef == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// userSyncTargetRequests maps a Neo4jUserSync to the deployment it manages
+// the users of, so that its operator user gains or loses user management
+func userSyncTargetRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	userSync, ok := obj.(*neo4jv1alpha1.Neo4jUserSync)
+	if !ok || userSync.Spec.ClusterRef == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: userSync.Spec.ClusterRef, Namespace: userSync.Namespace}}}
+}
+
+// ensureOperatorUser sets up the user of the operator Secret, connected as
+// the admin, unless status records it with the current credentials and
+// user management. It returns the status to record, nil when
+// spec.auth.operatorSecret is unset.
+func ensureOperatorUser(ctx context.Context, c client.Client, namespace, name string, auth *neo4jv1alpha1.AuthSpec,
+	status *neo4jv1alpha1.OperatorUserStatus, connect func() (operatorUserClient, error)) (*neo4jv1alpha1.OperatorUserStatus, error) {
+	if auth == nil || auth.OperatorSecret == "" {
+		return nil, nil
+	}
+	username, password, hash, err := operatorCredentials(ctx, c, namespace, auth.OperatorSecret)
+	if err != nil {
+		return status, err
+	}
+	userManagement, err := userSyncTarget(ctx, c, namespace, name)
+	if err != nil {
+		return status, err
+	}
+	if status != nil && status.Username == username && status.CredentialsHash == hash && status.UserManagement == userManagement {
+		return status, nil
+	}
+
+	neo4jClient, err := connect()
+	if err != nil {
+		return status, err
+	}
+	defer neo4jClient.Close()
+	if err := neo4jClient.EnsureOperatorUser(ctx, username, password, userManagement); err != nil {
+		return status, err
+	}
+	log.FromContext(ctx).Info("Set up the operator user", "username", username, "role", neo4jclient.OperatorRole,
+		"userManagement", userManagement)
+	return &neo4jv1alpha1.OperatorUserStatus{Username: username, CredentialsHash: hash, UserManagement: userManagement}, nil
+}
+
+// reconcileOperatorUser sets up the user of spec.auth.operatorSecret once
+// the cluster is ready, and records it in the status, so that the operator
+// connects as it from then on
+func (r *Neo4jEnterpriseClusterReconciler) reconcileOperatorUser(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	connect := func() (operatorUserClient, error) {
+		if r.newOperatorUserClient != nil {
+			return r.newOperatorUserClient(ctx, cluster)
+		}
+		return neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
+	}
+	operatorUser, err := ensureOperatorUser(ctx, r.Client, cluster.Namespace, cluster.Name, cluster.Spec.Auth, cluster.Status.OperatorUser, connect)
+	if err != nil {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonOperatorUserFailed,
+			fmt.Sprintf("Failed to set up the operator user: %v", err))
+		return err
+	}
+	if equality.Semantic.DeepEqual(operatorUser, cluster.Status.OperatorUser) {
+		return nil
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		latest.Status.OperatorUser = operatorUser
+		return r.Status().Update(ctx, latest)
+	}); err != nil {
+		return err
+	}
+	cluster.Status.OperatorUser = operatorUser
+	if operatorUser != nil {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonOperatorUserReady,
+			fmt.Sprintf("The operator connects as %s", operatorUser.Username))
+	}
+	return nil
+}
+
+// reconcileOperatorUser sets up the user of spec.auth.operatorSecret once
+// the standalone deployment is ready
+func (r *Neo4jEnterpriseStandaloneReconciler) reconcileOperatorUser(ctx context.Context, standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) error {
+	connect := func() (operatorUserClient, error) {
+		return neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneAdminSecretName(standalone))
+	}
+	operatorUser, err := ensureOperatorUser(ctx, r.Client, standalone.Namespace, standalone.Name, standalone.Spec.Auth, standalone.Status.OperatorUser, connect)
+	if err != nil {
+		if r.Recorder != nil {
+			r.Recorder.Event(standalone, corev1.EventTypeWarning, EventReasonOperatorUserFailed,
+				fmt.Sprintf("Failed to set up the operator user: %v", err))
+		}
+		return err
+	}
+	if equality.Semantic.DeepEqual(operatorUser, standalone.Status.OperatorUser) {
+		return nil
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(standalone), latest); err != nil {
+			return err
+		}
+		latest.Status.OperatorUser = operatorUser
+		return r.Status().Update(ctx, latest)
+	}); err != nil {
+		return err
+	}
+	standalone.Status.OperatorUser = operatorUser
+	if operatorUser != nil && r.Recorder != nil {
+		r.Recorder.Event(standalone, corev1.EventTypeNormal, EventReasonOperatorUserReady,
+			fmt.Sprintf("The operator connects as %s", operatorUser.Username))
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+type fakeOperatorUserClient struct {
+	passwords      map[string]string
+	userManagement map[string]bool
+}
+
+func (f *fakeOperatorUserClient) EnsureOperatorUser(_ context.Context, username, password string, userManagement bool) error {
+	f.passwords[username] = password
+	if f.userManagement != nil {
+		f.userManagement[username] = userManagement
+	}
+	return nil
+}
+
+func (f *fakeOperatorUserClient) Close() error { return nil }
+
+func TestReconcileOperatorUser(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin", OperatorSecret: "prod-operator"}
+	operatorSecret := tlsSecret("prod-operator", "default", map[string]string{"username": "operator", "password": "first"})
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, operatorSecret).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	passwords := map[string]string{}
+	connections := 0
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	r.newOperatorUserClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (operatorUserClient, error) {
+		connections++
+		return &fakeOperatorUserClient{passwords: passwords}, nil
+	}
+
+	// The operator connects as the admin until its user is set up
+	assert.Equal(t, "prod-admin", getClusterConnectionSecretName(cluster))
+	require.NoError(t, r.reconcileOperatorUser(ctx, cluster))
+	assert.Equal(t, map[string]string{"operator": "first"}, passwords)
+	assert.Equal(t, "prod-operator", getClusterConnectionSecretName(cluster))
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	require.NotNil(t, latest.Status.OperatorUser)
+	assert.Equal(t, "operator", latest.Status.OperatorUser.Username)
+
+	// Set up users are left alone
+	require.NoError(t, r.reconcileOperatorUser(ctx, latest))
+	assert.Equal(t, 1, connections)
+
+	// A changed password is set again
+	operatorSecret.Data["password"] = []byte("second")
+	require.NoError(t, c.Update(ctx, operatorSecret))
+	require.NoError(t, r.reconcileOperatorUser(ctx, latest))
+	assert.Equal(t, 2, connections)
+	assert.Equal(t, "second", passwords["operator"])
+
+	// Without spec.auth.operatorSecret the operator connects as the admin again
+	latest.Spec.Auth.OperatorSecret = ""
+	require.NoError(t, r.reconcileOperatorUser(ctx, latest))
+	assert.Nil(t, latest.Status.OperatorUser)
+	assert.Equal(t, "prod-admin", getClusterConnectionSecretName(latest))
+
+	// Secrets without credentials are an error
+	latest.Spec.Auth.OperatorSecret = "prod-missing"
+	require.Error(t, r.reconcileOperatorUser(ctx, latest))
+	assert.Equal(t, "prod-admin", getClusterConnectionSecretName(latest))
+}
+
+func TestReconcileOperatorUserManagement(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin", OperatorSecret: "prod-operator"}
+	operatorSecret := tlsSecret("prod-operator", "default", map[string]string{"username": "operator", "password": "first"})
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, operatorSecret).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	fakeClient := &fakeOperatorUserClient{passwords: map[string]string{}, userManagement: map[string]bool{}}
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	r.newOperatorUserClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (operatorUserClient, error) {
+		return fakeClient, nil
+	}
+
+	// Without a Neo4jUserSync the role cannot manage users
+	require.NoError(t, r.reconcileOperatorUser(ctx, cluster))
+	assert.False(t, fakeClient.userManagement["operator"])
+	assert.False(t, cluster.Status.OperatorUser.UserManagement)
+
+	// A Neo4jUserSync of another deployment changes nothing
+	other := &neo4jv1alpha1.Neo4jUserSync{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jUserSyncSpec{ClusterRef: "staging"}}
+	require.NoError(t, c.Create(ctx, other))
+	require.NoError(t, r.reconcileOperatorUser(ctx, cluster))
+	assert.False(t, cluster.Status.OperatorUser.UserManagement)
+
+	// One of the cluster grants user management
+	userSync := &neo4jv1alpha1.Neo4jUserSync{ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jUserSyncSpec{ClusterRef: "prod"}}
+	require.NoError(t, c.Create(ctx, userSync))
+	assert.Equal(t, []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(cluster)}}, userSyncTargetRequests(ctx, userSync))
+	require.NoError(t, r.reconcileOperatorUser(ctx, cluster))
+	assert.True(t, fakeClient.userManagement["operator"])
+	assert.True(t, cluster.Status.OperatorUser.UserManagement)
+
+	// and deleting it revokes it
+	require.NoError(t, c.Delete(ctx, userSync))
+	require.NoError(t, r.reconcileOperatorUser(ctx, cluster))
+	assert.False(t, fakeClient.userManagement["operator"])
+	assert.False(t, cluster.Status.OperatorUser.UserManagement)
+}
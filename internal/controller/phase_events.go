@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// recordPhaseChange emits a PhaseChanged Event once a status update has
+// moved obj from one phase to another, so that kubectl describe shows the
+// lifecycle of every resource. Phases failing the Ready condition are
+// Warnings.
+func recordPhaseChange(recorder record.EventRecorder, obj runtime.Object, from, to, message string) {
+	if recorder == nil || to == "" || from == to {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if _, reason := PhaseToConditionStatus(to); reason == ConditionReasonFailed || to == EventReasonValidationFailed {
+		eventType = corev1.EventTypeWarning
+	}
+
+	transition := fmt.Sprintf("Phase changed from %s to %s", from, to)
+	if from == "" {
+		transition = fmt.Sprintf("Phase set to %s", to)
+	}
+	if message != "" {
+		transition += ": " + message
+	}
+	recorder.Event(obj, eventType, EventReasonPhaseChanged, transition)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestRecordPhaseChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		message  string
+		expected string
+	}{
+		{name: "unchanged", from: "Ready", to: "Ready"},
+		{name: "no phase", from: "Ready"},
+		{name: "first phase", to: "Pending", expected: "Normal PhaseChanged Phase set to Pending"},
+		{name: "transition", from: "Forming", to: "Ready", message: "Cluster is ready",
+			expected: "Normal PhaseChanged Phase changed from Forming to Ready: Cluster is ready"},
+		{name: "failure", from: "Ready", to: "Degraded", message: "1 of 3 servers down",
+			expected: "Warning PhaseChanged Phase changed from Ready to Degraded: 1 of 3 servers down"},
+		{name: "validation failure", from: "Pending", to: EventReasonValidationFailed,
+			expected: "Warning PhaseChanged Phase changed from Pending to ValidationFailed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			recordPhaseChange(recorder, minimalCluster("prod", "default"), tt.from, tt.to, tt.message)
+			if tt.expected == "" {
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, tt.expected, <-recorder.Events)
+		})
+	}
+
+	// Reconcilers without a recorder still update their status
+	recordPhaseChange(nil, minimalCluster("prod", "default"), "Forming", "Ready", "")
+}
+
+func TestUpdateBackupStatusRecordsPhaseChange(t *testing.T) {
+	ctx := context.Background()
+	backup := &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Status:     neo4jv1alpha1.Neo4jBackupStatus{Phase: "Running"},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(backup).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jBackup{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jBackupReconciler{Client: c, Recorder: recorder}
+
+	r.updateBackupStatus(ctx, backup, "Completed", "Backup completed successfully")
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal PhaseChanged Phase changed from Running to Completed: Backup completed successfully", <-recorder.Events)
+
+	r.updateBackupStatus(ctx, backup, "Completed", "Backup completed successfully")
+	assert.Empty(t, recorder.Events)
+}
+
+func TestUpdateUpgradeStatusRecordsSteps(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Status.UpgradeStatus = &neo4jv1alpha1.UpgradeStatus{Phase: "InProgress", CurrentStep: "Upgrading secondary nodes"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	orch := &RollingUpgradeOrchestrator{Client: c, Recorder: recorder}
+
+	orch.updateUpgradeStatus(ctx, cluster, "InProgress", "Upgrading primary nodes", "")
+	orch.updateUpgradeStatus(ctx, cluster, "InProgress", "Upgrading primary nodes", "")
+	orch.updateUpgradeStatus(ctx, cluster, "Completed", "Rolling upgrade completed successfully", "")
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal UpgradeProgressing Upgrading primary nodes", <-recorder.Events)
+}
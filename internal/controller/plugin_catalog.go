@@ -0,0 +1,202 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+// pluginBundling describes which JAR the Neo4j Enterprise image ships for a
+// catalog plugin, i.e. what NEO4J_PLUGINS can install without a download.
+type pluginBundling int
+
+const (
+	// bundledNone: the image does not ship the plugin.
+	bundledNone pluginBundling = iota
+	// bundledServerVersion: the image ships the plugin built for its own
+	// Neo4j version (APOC core in /var/lib/neo4j/labs).
+	bundledServerVersion
+	// bundledProduct: the image ships the plugin in /var/lib/neo4j/products.
+	bundledProduct
+)
+
+// officialPlugin is a catalog entry for a plugin published by Neo4j.
+type officialPlugin struct {
+	// urlTemplate is formatted with the plugin version; empty when the plugin
+	// is only distributed with the Enterprise image.
+	urlTemplate string
+	bundling    pluginBundling
+	// compatible reports whether the plugin version runs on the server version.
+	compatible func(plugin, server *version.Version) bool
+	// requirement describes the compatible versions in error messages.
+	requirement string
+}
+
+// gdsCompatibility lists the Neo4j versions supported by each GDS minor
+// release. GDS releases newer than the last entry are assumed to keep the
+// last entry's minimum.
+var gdsCompatibility = []struct {
+	minor    int
+	min, max string
+}{
+	{minor: 12, min: "5.26", max: "5.26"},
+	{minor: 13, min: "5.26", max: "2025.01"},
+	{minor: 14, min: "5.26", max: "2025.02"},
+	{minor: 15, min: "5.26", max: "2025.03"},
+	{minor: 16, min: "5.26", max: "2025.04"},
+	{minor: 17, min: "5.26", max: "2025.05"},
+	{minor: 18, min: "5.26", max: "2025.06"},
+	{minor: 19, min: "5.26", max: "2025.07"},
+	{minor: 20, min: "5.26", max: "2025.09"},
+}
+
+// officialPluginCatalog is keyed by the NEO4J_PLUGINS name (see mapPluginName).
+var officialPluginCatalog = map[string]officialPlugin{
+	"apoc": {
+		urlTemplate: "https://github.com/neo4j/apoc/releases/download/%[1]s/apoc-%[1]s-core.jar",
+		bundling:    bundledServerVersion,
+		compatible:  sameReleaseLine,
+		requirement: "the APOC release line must match the Neo4j version (e.g. APOC 5.26.x for Neo4j 5.26)",
+	},
+	"graph-data-science": {
+		urlTemplate: "https://github.com/neo4j/graph-data-science/releases/download/%[1]s/neo4j-graph-data-science-%[1]s.jar",
+		bundling:    bundledProduct,
+		compatible:  gdsCompatible,
+		requirement: "see the GDS compatibility matrix (GDS 2.12 or later is required for Neo4j 5.26)",
+	},
+	"bloom": {
+		bundling: bundledProduct,
+		compatible: func(plugin, _ *version.Version) bool {
+			return plugin.Major == 2
+		},
+		requirement: "Neo4j 5.26 and later require a Bloom 2.x plugin",
+	},
+	"n10s": {
+		urlTemplate: "https://github.com/neo4j-labs/neosemantics/releases/download/%[1]s/neosemantics-%[1]s.jar",
+		bundling:    bundledNone,
+		compatible:  sameReleaseLine,
+		requirement: "the neosemantics release line must match the Neo4j version (e.g. n10s 5.26.x for Neo4j 5.26)",
+	},
+}
+
+// sameReleaseLine is the rule for plugins versioned in lockstep with Neo4j.
+func sameReleaseLine(plugin, server *version.Version) bool {
+	return plugin.Major == server.Major && plugin.Minor == server.Minor
+}
+
+// gdsCompatible checks a GDS version against gdsCompatibility.
+func gdsCompatible(plugin, server *version.Version) bool {
+	if plugin.Major != 2 {
+		return false
+	}
+	for i, entry := range gdsCompatibility {
+		newest := i == len(gdsCompatibility)-1
+		switch {
+		case plugin.Minor == entry.minor:
+			return compareReleaseLine(server, entry.min) >= 0 && compareReleaseLine(server, entry.max) <= 0
+		case newest && plugin.Minor > entry.minor:
+			return compareReleaseLine(server, entry.min) >= 0
+		}
+	}
+	return false
+}
+
+// compareReleaseLine compares the major.minor part of v with a release line
+// such as "5.26" or "2025.01".
+func compareReleaseLine(v *version.Version, line string) int {
+	lv, err := version.Parse(line)
+	if err != nil {
+		return 0
+	}
+	if v.Major != lv.Major {
+		return v.Major - lv.Major
+	}
+	return v.Minor - lv.Minor
+}
+
+// resolvedPlugin is the outcome of a catalog lookup.
+type resolvedPlugin struct {
+	// Name is the NEO4J_PLUGINS name
+	Name    string
+	Version string
+	// URL is the JAR download URL; empty when the plugin is not published
+	// outside the Enterprise image.
+	URL string
+	// Download reports whether the image cannot provide the requested version
+	// itself, so the operator has to deliver URL through an init container.
+	Download bool
+}
+
+// isOfficialSource reports whether the plugin is resolved from the catalog.
+func isOfficialSource(plugin *neo4jv1alpha1.Neo4jPlugin) bool {
+	return plugin.Spec.Source == nil || plugin.Spec.Source.Type == "" || plugin.Spec.Source.Type == "official"
+}
+
+// resolveOfficialPlugin looks up an official plugin in the catalog and checks
+// that its version is compatible with the Neo4j server version. It returns
+// nil without error for plugins that are not in the catalog; those are left
+// to the Neo4j image to resolve.
+func (r *Neo4jPluginReconciler) resolveOfficialPlugin(plugin *neo4jv1alpha1.Neo4jPlugin, serverVersion string) (*resolvedPlugin, error) {
+	if !isOfficialSource(plugin) {
+		return nil, nil
+	}
+	name := r.mapPluginName(plugin.Spec.Name)
+	entry, ok := officialPluginCatalog[name]
+	if !ok {
+		return nil, nil
+	}
+
+	pluginVer, err := version.Parse(plugin.Spec.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s version %q: %w", name, plugin.Spec.Version, err)
+	}
+	serverVer, err := version.Parse(serverVersion)
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine Neo4j version from image tag %q: %w", serverVersion, err)
+	}
+	if !entry.compatible(pluginVer, serverVer) {
+		return nil, fmt.Errorf("%s %s is not compatible with Neo4j %s: %s",
+			name, plugin.Spec.Version, serverVersion, entry.requirement)
+	}
+
+	resolved := &resolvedPlugin{Name: name, Version: plugin.Spec.Version}
+	if entry.urlTemplate != "" {
+		resolved.URL = fmt.Sprintf(entry.urlTemplate, plugin.Spec.Version)
+	}
+	switch entry.bundling {
+	case bundledNone:
+		resolved.Download = resolved.URL != ""
+	case bundledServerVersion:
+		resolved.Download = resolved.URL != "" && pluginVer.Compare(serverVer) != 0
+	}
+	return resolved, nil
+}
+
+// checkPluginCompatibility rejects official plugins whose version does not
+// match the Neo4j version of the target deployment. Image tags that carry no
+// version are not checked.
+func (r *Neo4jPluginReconciler) checkPluginCompatibility(plugin *neo4jv1alpha1.Neo4jPlugin, deployment *DeploymentInfo) error {
+	tag := deploymentImageTag(deployment)
+	if _, err := version.Parse(tag); err != nil {
+		return nil
+	}
+	_, err := r.resolveOfficialPlugin(plugin, tag)
+	return err
+}
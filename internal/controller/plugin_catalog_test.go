@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func catalogPlugin(name, version string) *neo4jv1alpha1.Neo4jPlugin {
+	return &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "prod",
+			Name:       name,
+			Version:    version,
+			Source:     &neo4jv1alpha1.PluginSource{Type: "official"},
+		},
+	}
+}
+
+func TestResolveOfficialPlugin(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+
+	tests := []struct {
+		name         string
+		plugin       string
+		version      string
+		server       string
+		wantErr      string
+		wantName     string
+		wantURL      string
+		wantDownload bool
+	}{
+		{
+			name: "apoc bundled with the image", plugin: "apoc", version: "5.26.0", server: "5.26.0-enterprise",
+			wantName: "apoc", wantURL: "https://github.com/neo4j/apoc/releases/download/5.26.0/apoc-5.26.0-core.jar",
+		},
+		{
+			name: "apoc patch differs from server", plugin: "apoc", version: "5.26.2", server: "5.26.5-enterprise",
+			wantName: "apoc", wantURL: "https://github.com/neo4j/apoc/releases/download/5.26.2/apoc-5.26.2-core.jar", wantDownload: true,
+		},
+		{
+			name: "apoc calver", plugin: "apoc", version: "2025.06.0", server: "2025.06.0-enterprise",
+			wantName: "apoc", wantURL: "https://github.com/neo4j/apoc/releases/download/2025.06.0/apoc-2025.06.0-core.jar",
+		},
+		{name: "apoc for another release line", plugin: "apoc", version: "5.26.0", server: "2025.01.0-enterprise", wantErr: "not compatible"},
+		{
+			name: "gds alias", plugin: "gds", version: "2.13.2", server: "2025.01.0",
+			wantName: "graph-data-science", wantURL: "https://github.com/neo4j/graph-data-science/releases/download/2.13.2/neo4j-graph-data-science-2.13.2.jar",
+		},
+		{name: "gds too old for server", plugin: "graph-data-science", version: "2.12.0", server: "2025.01.0", wantErr: "not compatible"},
+		{name: "gds 1.x", plugin: "graph-data-science", version: "1.8.0", server: "5.26.0", wantErr: "not compatible"},
+		{name: "gds newer than catalog", plugin: "graph-data-science", version: "2.30.0", server: "2026.01.0", wantName: "graph-data-science",
+			wantURL: "https://github.com/neo4j/graph-data-science/releases/download/2.30.0/neo4j-graph-data-science-2.30.0.jar"},
+		{name: "bloom has no public artifact", plugin: "bloom", version: "2.15.0", server: "5.26.0", wantName: "bloom"},
+		{name: "bloom 1.x", plugin: "bloom", version: "1.9.0", server: "5.26.0", wantErr: "Bloom 2.x"},
+		{
+			name: "neosemantics is always downloaded", plugin: "neosemantics", version: "5.26.0", server: "5.26.0",
+			wantName: "n10s", wantURL: "https://github.com/neo4j-labs/neosemantics/releases/download/5.26.0/neosemantics-5.26.0.jar", wantDownload: true,
+		},
+		{name: "invalid plugin version", plugin: "apoc", version: "latest", server: "5.26.0", wantErr: "invalid apoc version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := r.resolveOfficialPlugin(catalogPlugin(tt.plugin, tt.version), tt.server)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resolved)
+			assert.Equal(t, tt.wantName, resolved.Name)
+			assert.Equal(t, tt.wantURL, resolved.URL)
+			assert.Equal(t, tt.wantDownload, resolved.Download)
+		})
+	}
+}
+
+func TestResolveOfficialPlugin_SkipsNonCatalogPlugins(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+
+	resolved, err := r.resolveOfficialPlugin(catalogPlugin("genai", "5.26.0"), "5.26.0")
+	require.NoError(t, err)
+	assert.Nil(t, resolved)
+
+	community := catalogPlugin("apoc", "5.20.0")
+	community.Spec.Source.Type = "community"
+	resolved, err = r.resolveOfficialPlugin(community, "5.26.0")
+	require.NoError(t, err)
+	assert.Nil(t, resolved)
+}
+
+func TestPluginArtifactFor_OfficialCatalog(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+
+	artifact, err := r.pluginArtifactFor(catalogPlugin("apoc", "5.26.0"), "neo4j:5.26.0-enterprise")
+	require.NoError(t, err)
+	assert.Nil(t, artifact, "bundled APOC is installed through NEO4J_PLUGINS")
+
+	n10s := catalogPlugin("n10s", "5.26.0")
+	n10s.Spec.Source.Checksum = "sha256:" + testPluginSHA256
+	artifact, err = r.pluginArtifactFor(n10s, "registry.example.com/neo4j:5.26.0-enterprise")
+	require.NoError(t, err)
+	require.NotNil(t, artifact)
+	assert.Equal(t, "https://github.com/neo4j-labs/neosemantics/releases/download/5.26.0/neosemantics-5.26.0.jar", artifact.URL)
+	assert.Equal(t, "sha256:"+testPluginSHA256, artifact.Checksum)
+	assert.Empty(t, r.environmentPluginList(n10s, true))
+}
+
+func TestReconcile_RejectsIncompatibleOfficialPlugin(t *testing.T) {
+	ctx := context.Background()
+	plugin := catalogPlugin("apoc", "5.26.0")
+	plugin.Finalizers = []string{PluginFinalizer}
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "2025.01.0-enterprise"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(plugin, cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jPlugin{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jPluginReconciler{Client: c, Recorder: recorder}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "apoc", Namespace: "default"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+
+	updated := &neo4jv1alpha1.Neo4jPlugin{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(plugin), updated))
+	assert.Equal(t, "Failed", updated.Status.Phase)
+	assert.Contains(t, updated.Status.Message, "not compatible with Neo4j 2025.01.0-enterprise")
+
+	require.Len(t, recorder.Events, 2)
+	event := <-recorder.Events
+	assert.True(t, strings.HasPrefix(event, "Warning PhaseChanged Phase set to Failed"), event)
+	event = <-recorder.Events
+	assert.True(t, strings.Contains(event, EventReasonPluginIncompatible), event)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,12 +30,19 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -73,9 +81,14 @@ type Neo4jPluginReconciler struct {
 // PluginFinalizer is the finalizer for Neo4j plugin resources
 const PluginFinalizer = "neo4j.neo4j.com/plugin-finalizer"
 
+// dynamicConfigResyncInterval is how often runtime plugin settings are
+// re-applied, so servers whose settings drifted from the spec get them back
+const dynamicConfigResyncInterval = 5 * time.Minute
+
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jplugins,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jplugins/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jplugins/finalizers,verbs=update
+//+kubebuilder:rbac:groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters;neo4jenterprisestandalones,verbs=get;list;watch
 
 // Reconcile handles the reconciliation of Neo4jPlugin resources
 func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -107,6 +120,18 @@ func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Wait for the resources of spec.dependsOn
+	waiting, err := waitingDependency(ctx, r.Client, plugin, plugin.Spec.DependsOn)
+	if err != nil {
+		logger.Error(err, "Failed to check dependencies")
+		return ctrl.Result{}, err
+	}
+	if waiting != "" {
+		setDependenciesCondition(ctx, r.Client, plugin, waiting)
+		r.updatePluginStatus(ctx, plugin, "Waiting", waiting)
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+	}
+
 	// Get target deployment (cluster or standalone)
 	deployment, err := r.getTargetDeployment(ctx, plugin)
 	if err != nil {
@@ -115,6 +140,16 @@ func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil // Don't return error - status is set correctly
 	}
 
+	// Reject official plugins built for a different Neo4j version before
+	// anything is changed on the deployment
+	if err := r.checkPluginCompatibility(plugin, deployment); err != nil {
+		logger.Info("Plugin version is not compatible with target deployment", "plugin", plugin.Spec.Name, "reason", err.Error())
+		r.updatePluginStatus(ctx, plugin, "Failed", fmt.Sprintf("Plugin compatibility check failed: %v", err))
+		r.Recorder.Eventf(plugin, corev1.EventTypeWarning, EventReasonPluginIncompatible,
+			"Plugin %s %s rejected: %v", plugin.Spec.Name, plugin.Spec.Version, err)
+		return ctrl.Result{}, nil
+	}
+
 	// Apply ConfigMap-based configurations first (before checking connectivity)
 	// This is critical for security settings that need to be in place before Neo4j starts
 	if deployment.Type == "standalone" {
@@ -129,12 +164,21 @@ func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Check if deployment is actually functional, not just status reporting
 	if !r.isDeploymentFunctional(ctx, deployment) {
 		logger.Info("Target deployment not functional, requeuing", "type", deployment.Type, "name", deployment.Name)
-		r.updatePluginStatus(ctx, plugin, "Waiting", fmt.Sprintf("Waiting for %s %s to be functional", deployment.Type, deployment.Name))
+		message := fmt.Sprintf("Waiting for %s %s to be functional", deployment.Type, deployment.Name)
+		setDependenciesCondition(ctx, r.Client, plugin, message)
+		r.updatePluginStatus(ctx, plugin, "Waiting", message)
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
+	setDependenciesCondition(ctx, r.Client, plugin, "")
+
+	// A Ready plugin whose spec did not change is only being resynced; the
+	// install below is then a no-op and runtime settings are re-applied.
+	resync := plugin.Status.Phase == "Ready" && plugin.Status.ObservedGeneration == plugin.Generation
 
 	// Update status to "Installing"
-	r.updatePluginStatus(ctx, plugin, "Installing", "Installing plugin")
+	if !resync {
+		r.updatePluginStatus(ctx, plugin, "Installing", "Installing plugin")
+	}
 
 	// Install plugin using NEO4J_PLUGINS environment variable (recommended by Neo4j docs)
 	if err := r.installPluginViaEnvironment(ctx, plugin, deployment); err != nil {
@@ -148,7 +192,13 @@ func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Check if deployment is ready after restart (non-blocking)
 	if !r.arePodsReady(ctx, deployment) {
 		logger.Info("Waiting for pods to be ready after plugin installation")
-		r.updatePluginStatus(ctx, plugin, "Installing", "Waiting for pods to be ready after plugin installation")
+		servers := r.collectServerStatus(ctx, plugin, deployment)
+		r.updatePluginServers(ctx, plugin, servers)
+		message := "Waiting for pods to be ready after plugin installation"
+		if len(servers) > 0 {
+			message = fmt.Sprintf("%s (loaded on %d/%d servers)", message, loadedServerCount(servers), len(servers))
+		}
+		r.updatePluginStatus(ctx, plugin, "Installing", message)
 		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
@@ -161,12 +211,22 @@ func (r *Neo4jPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil // Don't return error - status is set correctly
 	}
 
+	// Record what every server actually loaded, also on resyncs
+	r.updatePluginServers(ctx, plugin, r.collectServerStatus(ctx, plugin, deployment))
+
 	// Update status to "Ready"
-	r.updatePluginStatus(ctx, plugin, "Ready", "Plugin installed and configured successfully")
-	r.Recorder.Eventf(plugin, corev1.EventTypeNormal, EventReasonPluginInstalled,
-		"Plugin %s version %s installed successfully", plugin.Spec.Name, plugin.Spec.Version)
+	if !resync {
+		r.updatePluginStatus(ctx, plugin, "Ready", "Plugin installed and configured successfully")
+		r.Recorder.Eventf(plugin, corev1.EventTypeNormal, EventReasonPluginInstalled,
+			"Plugin %s version %s installed successfully", plugin.Spec.Name, plugin.Spec.Version)
+	}
 
 	logger.Info("Plugin reconciliation completed")
+
+	// Runtime settings can be changed behind the operator's back; resync them periodically
+	if len(r.filterNeo4jClientConfig(plugin.Spec.Config)) > 0 && !r.isEnvironmentVariableOnlyPlugin(plugin.Spec.Name) {
+		return ctrl.Result{RequeueAfter: dynamicConfigResyncInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -484,42 +544,80 @@ func (r *Neo4jPluginReconciler) configurePlugin(ctx context.Context, plugin *neo
 		return nil
 	}
 
-	// Create Neo4j client based on deployment type
-	var neo4jClient *neo4jclient.Client
-	var err error
-
-	if deployment.Type == "cluster" {
-		cluster := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
-		neo4jClient, err = neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
-	} else {
-		standalone := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
-		neo4jClient, err = neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneAdminSecretName(standalone))
-	}
+	// Dynamic settings are per server: dbms.setConfigValue only changes the
+	// member it runs on, so every cluster server is configured directly.
+	err := r.forEachServer(ctx, deployment, func(neo4jClient *neo4jclient.Client) error {
+		for key, value := range neo4jClientConfig {
+			if err := neo4jClient.SetConfiguration(ctx, key, value); err != nil {
+				return fmt.Errorf("failed to set configuration %s=%s: %w", key, value, err)
+			}
+		}
 
+		// Apply security configuration if specified
+		// Only apply runtime security configuration for settings that are dynamic
+		if plugin.Spec.Security != nil && r.hasRuntimeSecurityConfiguration(plugin.Spec.Security) {
+			if err := r.applySecurityConfiguration(ctx, neo4jClient, plugin); err != nil {
+				return fmt.Errorf("failed to apply security configuration: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create Neo4j client: %w", err)
+		return err
 	}
-	defer neo4jClient.Close()
 
-	// Configure plugin settings that should go through Neo4j configuration system
-	for key, value := range neo4jClientConfig {
-		if err := neo4jClient.SetConfiguration(ctx, key, value); err != nil {
-			return fmt.Errorf("failed to set configuration %s=%s: %w", key, value, err)
+	logger.Info("Plugin configuration applied successfully")
+	return nil
+}
+
+// forEachServer runs fn with a client for every running Neo4j server of the
+// deployment. Standalone deployments have a single server.
+func (r *Neo4jPluginReconciler) forEachServer(ctx context.Context, deployment *DeploymentInfo, fn func(*neo4jclient.Client) error) error {
+	if deployment.Type != "cluster" {
+		standalone := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+		neo4jClient, err := neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
+		if err != nil {
+			return fmt.Errorf("failed to create Neo4j client: %w", err)
 		}
+		defer neo4jClient.Close()
+		return fn(neo4jClient)
 	}
 
-	// Apply security configuration if specified
-	// Only apply runtime security configuration for settings that are dynamic
-	if plugin.Spec.Security != nil && r.hasRuntimeSecurityConfiguration(plugin.Spec.Security) {
-		if err := r.applySecurityConfiguration(ctx, neo4jClient, plugin); err != nil {
-			return fmt.Errorf("failed to apply security configuration: %w", err)
-		}
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(deployment.Namespace), client.MatchingLabels(r.getPodLabels(deployment))); err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
 	}
 
-	logger.Info("Plugin configuration applied successfully")
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		neo4jClient, err := r.serverClient(deployment, pod)
+		if err != nil {
+			return fmt.Errorf("failed to create Neo4j client for pod %s: %w", pod.Name, err)
+		}
+		err = fn(neo4jClient)
+		neo4jClient.Close()
+		if err != nil {
+			return fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+	}
 	return nil
 }
 
+// serverClient connects to the Neo4j server running in pod. A standalone
+// deployment is reached through its service.
+func (r *Neo4jPluginReconciler) serverClient(deployment *DeploymentInfo, pod *corev1.Pod) (*neo4jclient.Client, error) {
+	if deployment.Type != "cluster" {
+		standalone := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+		return neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
+	}
+	cluster := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	podURL := fmt.Sprintf("bolt://%s.%s-headless.%s.svc.cluster.local:7687", pod.Name, cluster.Name, cluster.Namespace)
+	return neo4jclient.NewClientForPod(cluster, r.Client, getClusterConnectionSecretName(cluster), podURL)
+}
+
 func (r *Neo4jPluginReconciler) applySecurityConfiguration(ctx context.Context, neo4jClient *neo4jclient.Client, plugin *neo4jv1alpha1.Neo4jPlugin) error {
 	logger := log.FromContext(ctx)
 
@@ -589,6 +687,13 @@ func (r *Neo4jPluginReconciler) removePluginFromDeployment(ctx context.Context,
 	logger := log.FromContext(ctx)
 	logger.Info("Removing plugin from deployment", "plugin", plugin.Spec.Name, "type", deployment.Type)
 
+	// Delivered JARs live in the pod's plugins volume; dropping the init
+	// container rolls the pods and the JAR disappears with the old volume.
+	removed, err := r.removePluginInitContainer(ctx, plugin, deployment)
+	if err != nil || removed || usesArtifactDelivery(plugin) {
+		return err
+	}
+
 	// Create a Job to remove the plugin from the cluster
 	removeJob := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -619,7 +724,8 @@ func (r *Neo4jPluginReconciler) removePluginFromDeployment(ctx context.Context,
 									echo "Plugin removal completed"
 								`, plugin.Spec.Name, deployment.Type, deployment.Name, plugin.Spec.Name),
 							},
-							SecurityContext: hardenedPluginContainerSecurityContext(),
+							SecurityContext:          hardenedPluginContainerSecurityContext(),
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:      "plugins",
@@ -641,12 +747,14 @@ func (r *Neo4jPluginReconciler) removePluginFromDeployment(ctx context.Context,
 		},
 	}
 
+	resources.ApplySecurityProfiles(&removeJob.Spec.Template, deploymentSecurityContext(deployment))
+
 	if err := r.Create(ctx, removeJob); err != nil {
 		return fmt.Errorf("failed to create plugin removal job: %w", err)
 	}
 
 	// Wait for job completion
-	return r.waitForJobCompletion(ctx, removeJob)
+	return waitForJob(ctx, r.Client, removeJob, 10*time.Minute)
 }
 
 func (r *Neo4jPluginReconciler) cleanupDependencies(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin) error {
@@ -668,11 +776,13 @@ func (r *Neo4jPluginReconciler) cleanupDependencies(ctx context.Context, plugin
 }
 
 func (r *Neo4jPluginReconciler) updatePluginStatus(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin, phase, message string) {
+	var previousPhase string
 	update := func() error {
 		latest := &neo4jv1alpha1.Neo4jPlugin{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(plugin), latest); err != nil {
 			return err
 		}
+		previousPhase = latest.Status.Phase
 		latest.Status.Phase = phase
 		latest.Status.Message = message
 		latest.Status.ObservedGeneration = latest.Generation
@@ -683,7 +793,9 @@ func (r *Neo4jPluginReconciler) updatePluginStatus(ctx context.Context, plugin *
 	err := retry.RetryOnConflict(retry.DefaultBackoff, update)
 	if err != nil {
 		log.FromContext(ctx).Error(err, "Failed to update plugin status")
+		return
 	}
+	recordPhaseChange(r.Recorder, plugin, previousPhase, phase, message)
 }
 
 // SetupWithManager configures the controller with the manager
@@ -720,13 +832,20 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 	// Prepare plugin name and dependencies for NEO4J_PLUGINS
 	pluginName := r.mapPluginName(plugin.Spec.Name)
 
-	// Collect all plugins to install (main plugin + dependencies)
-	pluginsToInstall := []string{pluginName}
-	for _, dep := range plugin.Spec.Dependencies {
-		depName := r.mapPluginName(dep.Name)
-		pluginsToInstall = append(pluginsToInstall, depName)
+	if err := r.checkPluginLicenseSecret(ctx, plugin); err != nil {
+		return err
+	}
+
+	// Resolve the JAR the operator has to download, if the image cannot
+	// provide the plugin itself
+	artifact, err := r.pluginArtifactFor(plugin, neo4jContainer.Image)
+	if err != nil {
+		return err
 	}
 
+	// Collect all plugins to install (main plugin + dependencies)
+	pluginsToInstall := r.environmentPluginList(plugin, artifact != nil)
+
 	// Find existing NEO4J_PLUGINS environment variable or create new one
 	var pluginsEnvVar *corev1.EnvVar
 	for i := range neo4jContainer.Env {
@@ -736,7 +855,10 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 		}
 	}
 
-	if pluginsEnvVar == nil {
+	switch {
+	case len(pluginsToInstall) == 0:
+		// JAR is delivered by an init container; nothing to resolve via NEO4J_PLUGINS.
+	case pluginsEnvVar == nil:
 		// Add new NEO4J_PLUGINS environment variable with all plugins
 		var quotedPlugins []string
 		for _, plugin := range pluginsToInstall {
@@ -746,7 +868,7 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 			Name:  "NEO4J_PLUGINS",
 			Value: fmt.Sprintf("[%s]", strings.Join(quotedPlugins, ",")),
 		})
-	} else {
+	default:
 		// Update existing NEO4J_PLUGINS - parse and add all new plugins
 		currentValue := pluginsEnvVar.Value
 		for _, plugin := range pluginsToInstall {
@@ -759,9 +881,12 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 		pluginsEnvVar.Value = currentValue
 	}
 
-	// Add plugin configuration as environment variables
-	for key, value := range plugin.Spec.Config {
-		envVarName := fmt.Sprintf("NEO4J_%s", strings.ToUpper(strings.ReplaceAll(key, ".", "_")))
+	// Add restart-only plugin configuration as environment variables
+	for key, value := range r.startupConfig(plugin) {
+		if !r.requiresRestart(key) {
+			continue
+		}
+		envVarName := neo4jSettingEnvVar(key)
 		neo4jContainer.Env = append(neo4jContainer.Env, corev1.EnvVar{
 			Name:  envVarName,
 			Value: value,
@@ -772,7 +897,7 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 	if r.getPluginType(plugin.Spec.Name) != PluginTypeEnvironmentOnly {
 		requiredSettings := r.getRequiredProcedureSecuritySettings(plugin.Spec.Name)
 		for key, value := range requiredSettings {
-			envVarName := fmt.Sprintf("NEO4J_%s", strings.ToUpper(strings.ReplaceAll(key, ".", "_")))
+			envVarName := neo4jSettingEnvVar(key)
 
 			// Check if this environment variable already exists
 			exists := false
@@ -798,13 +923,20 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 		}
 	}
 
+	// Persist dynamic settings before the pods can pick up the envFrom entry
+	if err := r.reconcilePluginSettings(ctx, plugin, deployment); err != nil {
+		return err
+	}
+
 	// Update the StatefulSet with retry on conflict
-	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+	var templateChanged bool
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		// Fetch latest version of StatefulSet for each retry
 		currentSts := &appsv1.StatefulSet{}
 		if err := r.Get(ctx, stsKey, currentSts); err != nil {
 			return err
 		}
+		original := currentSts.Spec.Template.DeepCopy()
 
 		// Find the Neo4j container in the current StatefulSet
 		var currentNeo4jContainer *corev1.Container
@@ -819,11 +951,37 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 		}
 
 		// Apply the same plugin changes to the current StatefulSet
-		pluginName := r.mapPluginName(plugin.Spec.Name)
-		pluginsToInstall := []string{pluginName}
-		for _, dep := range plugin.Spec.Dependencies {
-			depName := r.mapPluginName(dep.Name)
-			pluginsToInstall = append(pluginsToInstall, depName)
+		pluginsToInstall := r.environmentPluginList(plugin, artifact != nil)
+
+		// Deliver downloaded artifacts through an init container that fills
+		// the shared /plugins volume before Neo4j starts. Changing the pod
+		// template is what triggers the rolling restart, so pods only restart
+		// once the download step is in place.
+		if artifact != nil {
+			initContainer, err := r.buildPluginInitContainer(plugin, artifact, currentNeo4jContainer)
+			if err != nil {
+				return err
+			}
+			ensurePluginsVolume(&currentSts.Spec.Template.Spec, currentNeo4jContainer)
+			currentSts.Spec.Template.Spec.InitContainers, _ = upsertInitContainer(
+				currentSts.Spec.Template.Spec.InitContainers, initContainer)
+			if volume := pluginSourceVolume(plugin, artifact); volume != nil {
+				currentSts.Spec.Template.Spec.Volumes, _ = upsertVolume(
+					currentSts.Spec.Template.Spec.Volumes, *volume)
+			}
+			// The init container runs under the same AppArmor profile as Neo4j;
+			// only clusters older than 1.30 carry it as a per-container annotation.
+			if profile, ok := currentSts.Spec.Template.Annotations[resources.AppArmorAnnotationPrefix+currentNeo4jContainer.Name]; ok {
+				currentSts.Spec.Template.Annotations[resources.AppArmorAnnotationPrefix+initContainer.Name] = profile
+			}
+		}
+
+		// Mount the license file next to the other licenses of the image
+		if volume, mount := pluginLicenseVolume(plugin); volume != nil {
+			currentSts.Spec.Template.Spec.Volumes, _ = upsertVolume(currentSts.Spec.Template.Spec.Volumes, *volume)
+			currentNeo4jContainer.VolumeMounts = upsertVolumeMount(currentNeo4jContainer.VolumeMounts, *mount)
+		} else {
+			unmountPluginLicense(&currentSts.Spec.Template.Spec, currentNeo4jContainer, pluginLicenseVolumeName(plugin))
 		}
 
 		// Find existing NEO4J_PLUGINS environment variable or create new one
@@ -834,7 +992,10 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 				break
 			}
 		}
-		if pluginsEnvVar == nil {
+		switch {
+		case len(pluginsToInstall) == 0:
+			// Nothing to resolve via NEO4J_PLUGINS.
+		case pluginsEnvVar == nil:
 			// Add new NEO4J_PLUGINS environment variable with all plugins
 			var quotedPlugins []string
 			for _, plugin := range pluginsToInstall {
@@ -844,7 +1005,7 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 				Name:  "NEO4J_PLUGINS",
 				Value: fmt.Sprintf("[%s]", strings.Join(quotedPlugins, ",")),
 			})
-		} else {
+		default:
 			// Update existing NEO4J_PLUGINS - parse and add all new plugins
 			currentValue := pluginsEnvVar.Value
 			for _, plugin := range pluginsToInstall {
@@ -860,9 +1021,16 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 			}
 		}
 
-		// Add plugin-specific configuration as environment variables
-		for key, value := range plugin.Spec.Config {
-			envVarName := fmt.Sprintf("NEO4J_%s", strings.ToUpper(strings.ReplaceAll(key, ".", "_")))
+		// Add restart-only configuration as environment variables. Dynamic
+		// settings stay out of the pod template so changing them does not
+		// roll the pods; configurePlugin applies them to the running servers
+		// and the settings ConfigMap keeps them across restarts.
+		currentNeo4jContainer.EnvFrom = upsertEnvFrom(currentNeo4jContainer.EnvFrom, pluginSettingsEnvFrom(plugin))
+		for key, value := range r.startupConfig(plugin) {
+			if !r.requiresRestart(key) {
+				continue
+			}
+			envVarName := neo4jSettingEnvVar(key)
 			// Check if environment variable already exists
 			exists := false
 			for i := range currentNeo4jContainer.Env {
@@ -913,7 +1081,7 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 
 		// Apply security settings as environment variables (both automatic and user-provided)
 		for key, value := range userSecuritySettings {
-			envVarName := fmt.Sprintf("NEO4J_%s", strings.ToUpper(strings.ReplaceAll(key, ".", "_")))
+			envVarName := neo4jSettingEnvVar(key)
 			// Check if environment variable already exists
 			exists := false
 			for i := range currentNeo4jContainer.Env {
@@ -931,13 +1099,23 @@ func (r *Neo4jPluginReconciler) installPluginViaEnvironment(ctx context.Context,
 			}
 		}
 
+		// Only a changed JAR or restart-only setting alters the template; in
+		// that case the update rolls the pods, otherwise nothing is written.
+		templateChanged = !equality.Semantic.DeepEqual(original, &currentSts.Spec.Template)
+		if !templateChanged {
+			return nil
+		}
 		return r.Update(ctx, currentSts)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update StatefulSet with plugin configuration: %w", err)
 	}
 
-	logger.Info("Successfully updated StatefulSet with plugin configuration", "plugin", pluginName)
+	if templateChanged {
+		logger.Info("Successfully updated StatefulSet with plugin configuration, pods will be restarted", "plugin", pluginName)
+	} else {
+		logger.Info("StatefulSet already carries plugin configuration, no restart required", "plugin", pluginName)
+	}
 
 	// Note: ConfigMap updates for standalone deployments are now handled earlier in reconcile flow
 	// before connectivity checks to ensure security settings are applied before Neo4j starts
@@ -954,9 +1132,9 @@ func (r *Neo4jPluginReconciler) updateStandaloneConfigMapForPlugin(ctx context.C
 
 	// Get user-provided non-dynamic settings that must be applied at startup
 	nonDynamicUserSettings := make(map[string]string)
-	for key, value := range plugin.Spec.Config {
+	for key, value := range r.startupConfig(plugin) {
 		// Include settings that are non-dynamic and must be in neo4j.conf at startup
-		if r.isNonDynamicSetting(key) || r.isSecuritySetting(key) {
+		if r.requiresRestart(key) {
 			nonDynamicUserSettings[key] = value
 		}
 	}
@@ -1130,7 +1308,7 @@ func (r *Neo4jPluginReconciler) isDeploymentFunctional(ctx context.Context, depl
 	// For clusters, try to connect and verify cluster formation
 	if deployment.Type == "cluster" {
 		cluster := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
-		neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
+		neo4jClient, err := neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 		if err != nil {
 			logger.Info("Cannot create Neo4j client", "error", err)
 			return false
@@ -1158,7 +1336,7 @@ func (r *Neo4jPluginReconciler) isDeploymentFunctional(ctx context.Context, depl
 	// For standalone, just check basic connectivity
 	if deployment.Type == "standalone" {
 		standalone := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
-		neo4jClient, err := neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneAdminSecretName(standalone))
+		neo4jClient, err := neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
 		if err != nil {
 			logger.Info("Cannot create Neo4j standalone client", "error", err)
 			return false
@@ -1185,6 +1363,20 @@ func (r *Neo4jPluginReconciler) isDeploymentFunctional(ctx context.Context, depl
 	return false
 }
 
+// environmentPluginList returns the plugin names the Neo4j image should
+// resolve itself through NEO4J_PLUGINS: the main plugin (unless its JAR is
+// delivered by an init container) followed by its dependencies.
+func (r *Neo4jPluginReconciler) environmentPluginList(plugin *neo4jv1alpha1.Neo4jPlugin, delivered bool) []string {
+	var plugins []string
+	if !delivered {
+		plugins = append(plugins, r.mapPluginName(plugin.Spec.Name))
+	}
+	for _, dep := range plugin.Spec.Dependencies {
+		plugins = append(plugins, r.mapPluginName(dep.Name))
+	}
+	return plugins
+}
+
 // addPluginToList is a method shim that delegates to the package-level MergeNeo4jPluginList.
 func (r *Neo4jPluginReconciler) addPluginToList(existing string, newPlugin string) (string, error) {
 	return MergeNeo4jPluginList(existing, newPlugin)
@@ -1223,9 +1415,66 @@ func MergeNeo4jPluginList(existing string, newPlugin string) (string, error) {
 }
 
 func (r *Neo4jPluginReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &neo4jv1alpha1.Neo4jPlugin{},
+		pluginClusterRefIndex, pluginClusterRefIndexer); err != nil {
+		return err
+	}
+
+	// Plugins waiting for their deployment are only requeued on a timer;
+	// a phase change of the deployment re-reconciles them right away.
+	deploymentPhaseChanged := builder.WithPredicates(predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return deploymentPhase(e.ObjectOld) != deploymentPhase(e.ObjectNew)
+		},
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	})
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&neo4jv1alpha1.Neo4jPlugin{}).
-		Complete(r)
+		Watches(&neo4jv1alpha1.Neo4jEnterpriseCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.pluginsForDeployment), deploymentPhaseChanged).
+		Watches(&neo4jv1alpha1.Neo4jEnterpriseStandalone{},
+			handler.EnqueueRequestsFromMapFunc(r.pluginsForDeployment), deploymentPhaseChanged).
+		WithOptions(controller.Options{NewQueue: observedQueue}).
+		Complete(observeReconcileErrors("neo4jplugin", r))
+}
+
+// pluginClusterRefIndex indexes Neo4jPlugins by the deployment they target.
+const pluginClusterRefIndex = "spec.clusterRef"
+
+func pluginClusterRefIndexer(obj client.Object) []string {
+	plugin, ok := obj.(*neo4jv1alpha1.Neo4jPlugin)
+	if !ok || plugin.Spec.ClusterRef == "" {
+		return nil
+	}
+	return []string{plugin.Spec.ClusterRef}
+}
+
+// pluginsForDeployment maps a cluster or standalone to the plugins that
+// reference it through spec.clusterRef.
+func (r *Neo4jPluginReconciler) pluginsForDeployment(ctx context.Context, obj client.Object) []reconcile.Request {
+	plugins := &neo4jv1alpha1.Neo4jPluginList{}
+	if err := r.List(ctx, plugins, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{pluginClusterRefIndex: obj.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list plugins for deployment", "deployment", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(plugins.Items))
+	for _, plugin := range plugins.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&plugin)})
+	}
+	return requests
+}
+
+// deploymentPhase returns status.phase of a cluster or standalone.
+func deploymentPhase(obj client.Object) string {
+	switch deployment := obj.(type) {
+	case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+		return deployment.Status.Phase
+	case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+		return deployment.Status.Phase
+	}
+	return ""
 }
 
 // Helper functions
@@ -1239,6 +1488,30 @@ func (r *Neo4jPluginReconciler) getStatefulSetName(deployment *DeploymentInfo) s
 	return deployment.Name
 }
 
+// deploymentSecurityContext returns the security settings of the target deployment
+func deploymentSecurityContext(deployment *DeploymentInfo) *neo4jv1alpha1.SecurityContextSpec {
+	switch obj := deployment.Object.(type) {
+	case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+		return obj.Spec.SecurityContext
+	case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+		return obj.Spec.SecurityContext
+	default:
+		return nil
+	}
+}
+
+// deploymentImageTag returns the Neo4j image tag of the target deployment
+func deploymentImageTag(deployment *DeploymentInfo) string {
+	switch obj := deployment.Object.(type) {
+	case *neo4jv1alpha1.Neo4jEnterpriseCluster:
+		return obj.Spec.Image.Tag
+	case *neo4jv1alpha1.Neo4jEnterpriseStandalone:
+		return obj.Spec.Image.Tag
+	default:
+		return ""
+	}
+}
+
 // getPluginsPVCName returns the correct PVC name for plugins based on deployment type
 // Since plugins currently use EmptyDir, plugin installation will copy to running pods directly
 func (r *Neo4jPluginReconciler) getPluginsPVCName(deployment *DeploymentInfo) string {
@@ -1304,35 +1577,6 @@ func (r *Neo4jPluginReconciler) buildRegistryEnvVars(registry *neo4jv1alpha1.Plu
 	return envVars
 }
 
-func (r *Neo4jPluginReconciler) waitForJobCompletion(ctx context.Context, job *batchv1.Job) error {
-	logger := log.FromContext(ctx)
-	timeout := time.After(10 * time.Minute)
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-timeout:
-			return fmt.Errorf("job completion timeout")
-		case <-ticker.C:
-			if err := r.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
-				return fmt.Errorf("failed to get job status: %w", err)
-			}
-
-			if job.Status.Succeeded > 0 {
-				logger.Info("Job completed successfully")
-				return nil
-			}
-
-			if job.Status.Failed > 0 {
-				return fmt.Errorf("job failed")
-			}
-
-			// Still running, continue waiting
-		}
-	}
-}
-
 // PluginType represents different categories of Neo4j plugins
 type PluginType int
 
@@ -1424,60 +1668,35 @@ func (r *Neo4jPluginReconciler) getAutomaticSecuritySettings(pluginName string)
 	return settings
 }
 
-// filterNeo4jClientConfig filters out plugin-specific configurations that should be handled via environment variables
+// filterNeo4jClientConfig returns the settings that can be applied to running servers with dbms.setConfigValue
 func (r *Neo4jPluginReconciler) filterNeo4jClientConfig(config map[string]string) map[string]string {
 	filtered := make(map[string]string)
 
 	for key, value := range config {
-		// Skip APOC-specific settings - these must be handled via environment variables only in Neo4j 5.26+
-		if strings.HasPrefix(key, "apoc.") {
+		// Skip settings that are only read at startup
+		if r.requiresRestart(key) {
 			continue
 		}
-
-		// Skip non-dynamic GDS settings - these can only be set at startup, not runtime
-		if r.isNonDynamicSetting(key) {
-			continue
-		}
-
-		// Include settings that can be applied dynamically through Neo4j configuration system:
-		// - Dynamic GDS settings (gds.* except license_file)
-		// - Bloom settings (dbms.bloom.*, server.unmanaged_extension_classes, dbms.security.http_auth_allowlist)
-		// - GenAI settings (provider configurations, procedure security)
-		// - General Neo4j settings (dbms.*, server.*)
-		// - Plugin procedure security settings (dbms.security.procedures.*)
 		filtered[key] = value
 	}
 
 	return filtered
 }
 
-// isSecuritySetting determines if a configuration setting is security-related and must be in neo4j.conf at startup
-func (r *Neo4jPluginReconciler) isSecuritySetting(key string) bool {
-	return strings.HasPrefix(key, "dbms.security.") ||
-		strings.HasPrefix(key, "server.unmanaged_extension_classes") ||
-		strings.HasPrefix(key, "dbms.bloom.") // Bloom-specific settings
+// requiresRestart reports whether a plugin setting is only read when Neo4j
+// starts. Only the settings Neo4j documents as dynamic can be changed on a
+// running server; every other setting, including unknown and plugin-specific
+// keys, is rendered into the pod template, so changing it rolls the pods.
+func (r *Neo4jPluginReconciler) requiresRestart(key string) bool {
+	return !dynamicSettings[key]
 }
 
-// isNonDynamicSetting determines if a configuration setting can only be applied at startup
-func (r *Neo4jPluginReconciler) isNonDynamicSetting(key string) bool {
-	nonDynamicSettings := []string{
-		// Plugin license files (must be available at startup)
-		"gds.enterprise.license_file",
-		"dbms.bloom.license_file",
-		// Security settings (must be applied at startup via environment variables)
-		"dbms.security.procedures.allowlist",
-		"dbms.security.procedures.denylist",
-		"dbms.security.procedures.unrestricted",
-		// Add other non-dynamic settings as needed
-	}
-
-	for _, nonDynamic := range nonDynamicSettings {
-		if key == nonDynamic {
-			return true
-		}
-	}
-
-	return false
+// neo4jSettingEnvVar returns the environment variable the Neo4j image maps to
+// a setting: dots become underscores and underscores are doubled, e.g.
+// gds.enterprise.license_file -> NEO4J_GDS_ENTERPRISE_LICENSE__FILE.
+func neo4jSettingEnvVar(key string) string {
+	name := strings.ReplaceAll(key, "_", "__")
+	return "NEO4J_" + strings.ToUpper(strings.ReplaceAll(name, ".", "_"))
 }
 
 // hasRuntimeSecurityConfiguration checks if security configuration contains settings that can be applied at runtime
@@ -1506,10 +1725,10 @@ func (r *Neo4jPluginReconciler) applyAPOCSecurityConfiguration(ctx context.Conte
 
 		if deployment.Type == "cluster" {
 			cluster := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
-			neo4jClient, err = neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterAdminSecretName(cluster))
+			neo4jClient, err = neo4jclient.NewClientForEnterprise(cluster, r.Client, getClusterConnectionSecretName(cluster))
 		} else {
 			standalone := deployment.Object.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
-			neo4jClient, err = neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneAdminSecretName(standalone))
+			neo4jClient, err = neo4jclient.NewClientForEnterpriseStandalone(standalone, r.Client, getStandaloneConnectionSecretName(standalone))
 		}
 
 		if err != nil {
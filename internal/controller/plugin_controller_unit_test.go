@@ -17,10 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 )
@@ -333,6 +339,106 @@ func TestFleetManagementPluginType(t *testing.T) {
 	})
 }
 
+func TestPluginSettingRequiresRestart(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"apoc.export.file.enabled", true},
+		{"dbms.security.procedures.unrestricted", true},
+		{"dbms.security.procedures.allowlist", true},
+		{"gds.enterprise.license_file", true},
+		{"dbms.bloom.license_file", true},
+		{"server.unmanaged_extension_classes", true},
+		{"gds.progress_tracking_enabled", true},
+		{"genai.openai.model", true},
+		{"unknown.setting", true},
+		{"db.logs.query.enabled", false},
+		{"db.transaction.timeout", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.requiresRestart(tt.key))
+		})
+	}
+
+	filtered := r.filterNeo4jClientConfig(map[string]string{
+		"gds.enterprise.license_file":   "/licenses/gds.license",
+		"gds.progress_tracking_enabled": "false",
+		"db.transaction.timeout":        "30s",
+	})
+	assert.Equal(t, map[string]string{"db.transaction.timeout": "30s"}, filtered)
+}
+
+func TestInstallPluginViaEnvironment_DynamicConfigDoesNotRestart(t *testing.T) {
+	ctx := context.Background()
+	plugin := &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "gds", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "prod",
+			Name:       "graph-data-science",
+			Version:    "2.13.2",
+			Config: map[string]string{
+				"gds.enterprise.license_file": "/licenses/gds.license",
+				"db.logs.query.enabled":       "INFO",
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server")).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	container := sts.Spec.Template.Spec.Containers[0]
+	env := envVarMap(container.Env)
+	assert.Equal(t, "/licenses/gds.license", env["NEO4J_GDS_ENTERPRISE_LICENSE__FILE"])
+	assert.NotContains(t, env, "NEO4J_DB_LOGS_QUERY_ENABLED", "dynamic settings stay out of the pod template")
+	require.Len(t, container.EnvFrom, 1)
+	assert.Equal(t, "gds-plugin-settings", container.EnvFrom[0].ConfigMapRef.Name)
+
+	// Dynamic settings survive a restart through the settings ConfigMap.
+	settings := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "gds-plugin-settings", Namespace: "default"}, settings))
+	assert.Equal(t, map[string]string{"NEO4J_DB_LOGS_QUERY_ENABLED": "INFO"}, settings.Data)
+
+	// Changing only a dynamic setting must leave the StatefulSet untouched.
+	revision := sts.ResourceVersion
+	plugin.Spec.Config["db.logs.query.enabled"] = "OFF"
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Equal(t, revision, sts.ResourceVersion)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(settings), settings))
+	assert.Equal(t, "OFF", settings.Data["NEO4J_DB_LOGS_QUERY_ENABLED"])
+
+	// A restart-only setting does change the template.
+	plugin.Spec.Config["gds.enterprise.license_file"] = "/licenses/gds-2.license"
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.NotEqual(t, revision, sts.ResourceVersion)
+	assert.Equal(t, "/licenses/gds-2.license", envVarMap(sts.Spec.Template.Spec.Containers[0].Env)["NEO4J_GDS_ENTERPRISE_LICENSE__FILE"])
+
+	// So does a plugin setting Neo4j does not document as dynamic.
+	revision = sts.ResourceVersion
+	plugin.Spec.Config["gds.progress_tracking_enabled"] = "false"
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.NotEqual(t, revision, sts.ResourceVersion)
+	assert.Equal(t, "false", envVarMap(sts.Spec.Template.Spec.Containers[0].Env)["NEO4J_GDS_PROGRESS__TRACKING__ENABLED"])
+
+	// Removing the plugin drops the envFrom entry again.
+	_, err := r.removePluginInitContainer(ctx, plugin, deployment)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Empty(t, sts.Spec.Template.Spec.Containers[0].EnvFrom)
+}
+
 func TestPluginSourceValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -397,3 +503,41 @@ func TestPluginSourceValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginsForDeployment_UsesClusterRefIndex(t *testing.T) {
+	ctx := context.Background()
+	plugin := func(name, ns, clusterRef string) *neo4jv1alpha1.Neo4jPlugin {
+		return &neo4jv1alpha1.Neo4jPlugin{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       neo4jv1alpha1.Neo4jPluginSpec{ClusterRef: clusterRef, Name: name, Version: "5.26.0"},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithIndex(&neo4jv1alpha1.Neo4jPlugin{}, pluginClusterRefIndex, pluginClusterRefIndexer).
+		WithObjects(
+			plugin("apoc", "default", "prod"),
+			plugin("gds", "default", "prod"),
+			plugin("bloom", "default", "staging"),
+			plugin("apoc", "other", "prod"),
+		).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+
+	requests := r.pluginsForDeployment(ctx, minimalCluster("prod", "default"))
+	var names []string
+	for _, req := range requests {
+		assert.Equal(t, "default", req.Namespace)
+		names = append(names, req.Name)
+	}
+	assert.ElementsMatch(t, []string{"apoc", "gds"}, names)
+}
+
+func TestDeploymentPhase(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Status.Phase = "Ready"
+	assert.Equal(t, "Ready", deploymentPhase(cluster))
+
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+	standalone.Status.Phase = "Pending"
+	assert.Equal(t, "Pending", deploymentPhase(standalone))
+	assert.Empty(t, deploymentPhase(&neo4jv1alpha1.Neo4jPlugin{}))
+}
@@ -0,0 +1,410 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+// pluginInitContainerPrefix marks init containers (and the source and
+// license volumes of the plugin) owned by the Neo4jPlugin controller. The cluster controller
+// tolerates and preserves them when it reconciles the server StatefulSet
+// template.
+const pluginInitContainerPrefix = "plugin-"
+
+// pluginSourceMountPath is where the init container mounts a pvc plugin source.
+const pluginSourceMountPath = "/plugin-source"
+
+var nonDNSLabelChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// usesArtifactDelivery reports whether the plugin source points at an
+// arbitrary artifact that the image cannot fetch on its own: a download URL
+// (url and custom sources) or a JAR inside an image or volume (oci and pvc
+// sources). Official plugins may still be downloaded when the catalog
+// resolves a version the image does not ship, see pluginArtifactFor.
+func usesArtifactDelivery(plugin *neo4jv1alpha1.Neo4jPlugin) bool {
+	src := plugin.Spec.Source
+	if src == nil {
+		return false
+	}
+	switch src.Type {
+	case "url", "custom":
+		return src.URL != ""
+	case "oci":
+		return src.Image != "" && src.Path != ""
+	case "pvc":
+		return src.ClaimName != "" && src.Path != ""
+	}
+	return false
+}
+
+// pluginArtifact is a plugin JAR delivered by the operator. Exactly one of
+// URL (downloaded), Image (copied out of an OCI image) or ClaimName (copied
+// from a PersistentVolumeClaim) is set; Path locates the JAR in the latter two.
+type pluginArtifact struct {
+	URL        string
+	Image      string
+	ClaimName  string
+	Path       string
+	Checksum   string
+	AuthSecret string
+}
+
+// pluginArtifactFor returns the JAR to deliver through an init container, or
+// nil when the Neo4j image resolves the plugin through NEO4J_PLUGINS. Besides
+// url and custom sources this covers official plugins whose catalog version
+// is not shipped with neo4jImage.
+func (r *Neo4jPluginReconciler) pluginArtifactFor(plugin *neo4jv1alpha1.Neo4jPlugin, neo4jImage string) (*pluginArtifact, error) {
+	src := plugin.Spec.Source
+	artifact := &pluginArtifact{}
+	if src != nil {
+		artifact.Checksum = src.Checksum
+		artifact.AuthSecret = src.AuthSecret
+		if artifact.AuthSecret == "" && src.Registry != nil {
+			artifact.AuthSecret = src.Registry.AuthSecret
+		}
+	}
+
+	if usesArtifactDelivery(plugin) {
+		switch src.Type {
+		case "oci":
+			artifact.Image, artifact.Path = src.Image, src.Path
+		case "pvc":
+			artifact.ClaimName, artifact.Path = src.ClaimName, src.Path
+		default:
+			artifact.URL = src.URL
+		}
+		return artifact, nil
+	}
+
+	serverVersion, err := version.FromImage(neo4jImage)
+	if err != nil {
+		// Digest-pinned or unversioned images: leave it to NEO4J_PLUGINS
+		return nil, nil
+	}
+	resolved, err := r.resolveOfficialPlugin(plugin, serverVersion.Raw)
+	if err != nil || resolved == nil || !resolved.Download {
+		return nil, err
+	}
+	artifact.URL = resolved.URL
+	return artifact, nil
+}
+
+// pluginInitContainerName returns the init container name for a plugin,
+// truncated to the 63 character DNS label limit.
+func pluginInitContainerName(plugin *neo4jv1alpha1.Neo4jPlugin) string {
+	name := nonDNSLabelChars.ReplaceAllString(strings.ToLower(plugin.Spec.Name), "-")
+	name = pluginInitContainerPrefix + strings.Trim(name, "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+// isPluginInitContainer reports whether c was injected by the plugin controller.
+func isPluginInitContainer(c corev1.Container) bool {
+	return strings.HasPrefix(c.Name, pluginInitContainerPrefix)
+}
+
+// isPluginSourceVolume reports whether v was injected by the plugin controller.
+func isPluginSourceVolume(v corev1.Volume) bool {
+	return strings.HasPrefix(v.Name, pluginInitContainerPrefix)
+}
+
+// pluginJarFileName is the file name the artifact is stored under in /plugins.
+func pluginJarFileName(plugin *neo4jv1alpha1.Neo4jPlugin) string {
+	return fmt.Sprintf("%s-%s.jar", plugin.Spec.Name, plugin.Spec.Version)
+}
+
+// parsePluginChecksum splits a checksum of the form "sha256:<hex>",
+// "sha512:<hex>" or a bare hex digest into the sha*sum tool to use and the
+// digest. A bare digest is classified by its length.
+func parsePluginChecksum(checksum string) (tool, digest string, err error) {
+	algo, digest, found := strings.Cut(strings.TrimSpace(checksum), ":")
+	if !found {
+		digest = algo
+		switch len(digest) {
+		case 64:
+			algo = "sha256"
+		case 128:
+			algo = "sha512"
+		default:
+			return "", "", fmt.Errorf("cannot infer checksum algorithm from a %d character digest", len(digest))
+		}
+	}
+	algo = strings.ToLower(algo)
+	digest = strings.ToLower(digest)
+
+	wantLen := map[string]int{"sha256": 64, "sha512": 128}[algo]
+	if wantLen == 0 {
+		return "", "", fmt.Errorf("unsupported checksum algorithm %q (use sha256 or sha512)", algo)
+	}
+	if len(digest) != wantLen || strings.Trim(digest, "0123456789abcdef") != "" {
+		return "", "", fmt.Errorf("invalid %s digest", algo)
+	}
+	return algo + "sum", digest, nil
+}
+
+// shellSingleQuote quotes s for safe interpolation into a POSIX shell script.
+func shellSingleQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// buildPluginDownloadScript returns the init container script that downloads
+// (or, for oci and pvc sources, copies) the plugin JAR into /plugins. The
+// artifact is written to a temporary file and only renamed into place after
+// the checksum matched, so Neo4j never loads a partial or tampered JAR.
+func buildPluginDownloadScript(plugin *neo4jv1alpha1.Neo4jPlugin, artifact *pluginArtifact) (string, error) {
+	dest := "/plugins/" + pluginJarFileName(plugin)
+
+	verify := ""
+	if artifact.Checksum != "" {
+		tool, digest, err := parsePluginChecksum(artifact.Checksum)
+		if err != nil {
+			return "", fmt.Errorf("plugin %s: %w", plugin.Spec.Name, err)
+		}
+		verify = fmt.Sprintf(`echo "%s  ${tmp}" | %s -c -`, digest, tool)
+	}
+
+	lines := []string{
+		"set -e",
+		fmt.Sprintf("dest=%s", shellSingleQuote(dest)),
+		`tmp="${dest}.part"`,
+	}
+	if src := artifact.sourcePath(); src != "" {
+		lines = append(lines,
+			fmt.Sprintf(`echo "Copying plugin %s %s"`, plugin.Spec.Name, plugin.Spec.Version),
+			fmt.Sprintf(`cp %s "${tmp}"`, shellSingleQuote(src)),
+		)
+	} else {
+		lines = append(lines,
+			fmt.Sprintf(`echo "Downloading plugin %s %s"`, plugin.Spec.Name, plugin.Spec.Version),
+			fmt.Sprintf(`wget -q --timeout=300 --tries=3 ${REPO_USERNAME:+--user="$REPO_USERNAME" --password="$REPO_PASSWORD"} -O "${tmp}" %s`,
+				shellSingleQuote(artifact.URL)),
+		)
+	}
+	if verify != "" {
+		lines = append(lines, verify)
+	}
+	lines = append(lines,
+		`mv "${tmp}" "${dest}"`,
+		`echo "Plugin stored at ${dest}"`,
+	)
+	return strings.Join(lines, "\n"), nil
+}
+
+// sourcePath returns the path the init container copies the JAR from, or ""
+// when the artifact is downloaded.
+func (a *pluginArtifact) sourcePath() string {
+	switch {
+	case a.Image != "":
+		return a.Path
+	case a.ClaimName != "":
+		return path.Join(pluginSourceMountPath, a.Path)
+	}
+	return ""
+}
+
+// pluginSourceVolume returns the read-only volume backing a pvc plugin source,
+// or nil for other sources. It shares the init container's name.
+func pluginSourceVolume(plugin *neo4jv1alpha1.Neo4jPlugin, artifact *pluginArtifact) *corev1.Volume {
+	if artifact.ClaimName == "" {
+		return nil
+	}
+	return &corev1.Volume{
+		Name: pluginInitContainerName(plugin),
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: artifact.ClaimName,
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
+// buildPluginInitContainer builds the init container that delivers the plugin
+// JAR into the shared "plugins" volume of the Neo4j pod. It reuses the Neo4j
+// image (which ships wget and coreutils) and security context so no extra
+// image has to be pulled or admitted. For oci sources the init container runs
+// the plugin image itself, which must provide sh and cp.
+func (r *Neo4jPluginReconciler) buildPluginInitContainer(plugin *neo4jv1alpha1.Neo4jPlugin, artifact *pluginArtifact, neo4jContainer *corev1.Container) (corev1.Container, error) {
+	script, err := buildPluginDownloadScript(plugin, artifact)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+
+	c := corev1.Container{
+		Name:            pluginInitContainerName(plugin),
+		Image:           neo4jContainer.Image,
+		ImagePullPolicy: neo4jContainer.ImagePullPolicy,
+		Command:         []string{"/bin/sh", "-c"},
+		Args:            []string{script},
+		VolumeMounts:    []corev1.VolumeMount{pluginsVolumeMount()},
+	}
+	switch {
+	case artifact.Image != "":
+		c.Image = artifact.Image
+	case artifact.ClaimName != "":
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name: c.Name, MountPath: pluginSourceMountPath, ReadOnly: true,
+		})
+	default:
+		c.Env = r.buildRegistryEnvVars(&neo4jv1alpha1.PluginRegistry{AuthSecret: artifact.AuthSecret})
+	}
+	if neo4jContainer.SecurityContext != nil {
+		c.SecurityContext = neo4jContainer.SecurityContext.DeepCopy()
+	}
+	return c, nil
+}
+
+// pluginsVolume is the shared volume plugin init containers deliver JARs
+// into and Neo4j loads them from.
+func pluginsVolume() corev1.Volume {
+	return corev1.Volume{
+		Name:         "plugins",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+}
+
+// pluginsVolumeMount mounts the plugins volume where Neo4j looks for plugins.
+func pluginsVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{Name: "plugins", MountPath: "/plugins"}
+}
+
+// ensurePluginsVolume adds the plugins volume and its mount on the Neo4j
+// container when the template lacks them, e.g. for standalone StatefulSets
+// created before they carried the volume.
+func ensurePluginsVolume(spec *corev1.PodSpec, neo4jContainer *corev1.Container) {
+	if !slices.ContainsFunc(spec.Volumes, func(v corev1.Volume) bool { return v.Name == "plugins" }) {
+		spec.Volumes = append(spec.Volumes, pluginsVolume())
+	}
+	if !slices.ContainsFunc(neo4jContainer.VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == "plugins" }) {
+		neo4jContainer.VolumeMounts = append(neo4jContainer.VolumeMounts, pluginsVolumeMount())
+	}
+}
+
+// upsertInitContainer replaces the init container with the same name or
+// appends it. It reports whether the slice changed.
+func upsertInitContainer(containers []corev1.Container, c corev1.Container) ([]corev1.Container, bool) {
+	for i := range containers {
+		if containers[i].Name == c.Name {
+			// Compare only the fields set by buildPluginInitContainer; the API
+			// server defaults the rest, which would otherwise force an update
+			// (and a rolling restart) on every reconcile.
+			if containers[i].Image == c.Image &&
+				equality.Semantic.DeepEqual(containers[i].Args, c.Args) &&
+				equality.Semantic.DeepEqual(containers[i].Env, c.Env) {
+				return containers, false
+			}
+			containers[i] = c
+			return containers, true
+		}
+	}
+	return append(containers, c), true
+}
+
+// upsertVolume replaces the volume with the same name or appends it. It
+// reports whether the slice changed.
+func upsertVolume(volumes []corev1.Volume, v corev1.Volume) ([]corev1.Volume, bool) {
+	for i := range volumes {
+		if volumes[i].Name == v.Name {
+			if equality.Semantic.DeepEqual(volumes[i].VolumeSource, v.VolumeSource) {
+				return volumes, false
+			}
+			volumes[i] = v
+			return volumes, true
+		}
+	}
+	return append(volumes, v), true
+}
+
+// removeVolume drops the named volume. It reports whether the slice changed.
+func removeVolume(volumes []corev1.Volume, name string) ([]corev1.Volume, bool) {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return append(volumes[:i], volumes[i+1:]...), true
+		}
+	}
+	return volumes, false
+}
+
+// removeInitContainer drops the named init container. It reports whether the
+// slice changed.
+func removeInitContainer(containers []corev1.Container, name string) ([]corev1.Container, bool) {
+	for i := range containers {
+		if containers[i].Name == name {
+			return append(containers[:i], containers[i+1:]...), true
+		}
+	}
+	return containers, false
+}
+
+// removePluginInitContainer removes the plugin's delivery init container, its
+// source volume, its license volume and its settings envFrom entry from the
+// target StatefulSet. It reports whether the init container was present.
+func (r *Neo4jPluginReconciler) removePluginInitContainer(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin, deployment *DeploymentInfo) (bool, error) {
+	stsKey := types.NamespacedName{Name: r.getStatefulSetName(deployment), Namespace: deployment.Namespace}
+	name := pluginInitContainerName(plugin)
+
+	var removed bool
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		removed = false
+		sts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, stsKey, sts); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		sts.Spec.Template.Spec.InitContainers, removed = removeInitContainer(sts.Spec.Template.Spec.InitContainers, name)
+		if removed {
+			sts.Spec.Template.Spec.Volumes, _ = removeVolume(sts.Spec.Template.Spec.Volumes, name)
+			delete(sts.Spec.Template.Annotations, resources.AppArmorAnnotationPrefix+name)
+		}
+
+		var licenseRemoved, settingsRemoved bool
+		for i := range sts.Spec.Template.Spec.Containers {
+			if sts.Spec.Template.Spec.Containers[i].Name == "neo4j" {
+				container := &sts.Spec.Template.Spec.Containers[i]
+				licenseRemoved = unmountPluginLicense(&sts.Spec.Template.Spec, container, pluginLicenseVolumeName(plugin))
+				container.EnvFrom, settingsRemoved = removeEnvFrom(container.EnvFrom, pluginSettingsConfigMapName(plugin))
+			}
+		}
+
+		if !removed && !licenseRemoved && !settingsRemoved {
+			return nil
+		}
+		return r.Update(ctx, sts)
+	})
+	return removed, err
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const testPluginSHA256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func urlPlugin() *neo4jv1alpha1.Neo4jPlugin {
+	return &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom-procs", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "prod",
+			Name:       "custom-procs",
+			Version:    "1.2.3",
+			Source: &neo4jv1alpha1.PluginSource{
+				Type:       "url",
+				URL:        "https://artifacts.example.com/custom-procs-1.2.3.jar",
+				Checksum:   "sha256:" + testPluginSHA256,
+				AuthSecret: "artifact-creds",
+			},
+		},
+	}
+}
+
+func serverStatefulSet(name string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "neo4j",
+						Image: "neo4j:5.26.0-enterprise",
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "plugins", MountPath: "/plugins"},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestParsePluginChecksum(t *testing.T) {
+	tests := []struct {
+		name     string
+		checksum string
+		tool     string
+		wantErr  bool
+	}{
+		{name: "prefixed sha256", checksum: "sha256:" + testPluginSHA256, tool: "sha256sum"},
+		{name: "bare sha256", checksum: strings.ToUpper(testPluginSHA256), tool: "sha256sum"},
+		{name: "bare sha512", checksum: strings.Repeat("ab", 64), tool: "sha512sum"},
+		{name: "unsupported md5", checksum: "md5:d41d8cd98f00b204e9800998ecf8427e", wantErr: true},
+		{name: "wrong length", checksum: "sha256:abc", wantErr: true},
+		{name: "not hex", checksum: "sha256:" + strings.Repeat("z", 64), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool, digest, err := parsePluginChecksum(tt.checksum)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tool, tool)
+			assert.Equal(t, strings.ToLower(digest), digest)
+		})
+	}
+}
+
+func TestBuildPluginDownloadScript(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+	plugin := urlPlugin()
+	artifact, err := r.pluginArtifactFor(plugin, "neo4j:5.26.0-enterprise")
+	require.NoError(t, err)
+	require.NotNil(t, artifact)
+	script, err := buildPluginDownloadScript(plugin, artifact)
+	require.NoError(t, err)
+
+	assert.Contains(t, script, "'https://artifacts.example.com/custom-procs-1.2.3.jar'")
+	assert.Contains(t, script, testPluginSHA256+`  ${tmp}" | sha256sum -c -`)
+	assert.Contains(t, script, "dest='/plugins/custom-procs-1.2.3.jar'")
+	// The JAR must only be moved into place after verification.
+	assert.Less(t, strings.Index(script, "sha256sum"), strings.Index(script, `mv "${tmp}"`))
+
+	_, err = buildPluginDownloadScript(plugin, &pluginArtifact{URL: artifact.URL, Checksum: "md5:abc"})
+	assert.Error(t, err)
+}
+
+func TestPluginInitContainerName(t *testing.T) {
+	p := urlPlugin()
+	p.Spec.Name = "My_Plugin.v2"
+	assert.Equal(t, "plugin-my-plugin-v2", pluginInitContainerName(p))
+
+	p.Spec.Name = strings.Repeat("x", 80)
+	assert.LessOrEqual(t, len(pluginInitContainerName(p)), 63)
+}
+
+func TestInstallPluginViaEnvironment_URLSourceAddsInitContainer(t *testing.T) {
+	ctx := context.Background()
+	plugin := urlPlugin()
+	plugin.Spec.Dependencies = []neo4jv1alpha1.PluginDependency{{Name: "apoc"}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server")).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "prod-server", Namespace: "default"}, sts))
+	require.Len(t, sts.Spec.Template.Spec.InitContainers, 1)
+	init := sts.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, "plugin-custom-procs", init.Name)
+	assert.Equal(t, "neo4j:5.26.0-enterprise", init.Image)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "plugins", MountPath: "/plugins"}}, init.VolumeMounts)
+	assert.Equal(t, "username", envVarSecretKey(init.Env, "REPO_USERNAME"))
+
+	// The delivered plugin is not resolved by the image; its dependency still is.
+	env := envVarMap(sts.Spec.Template.Spec.Containers[0].Env)
+	assert.Equal(t, `["apoc"]`, env["NEO4J_PLUGINS"])
+
+	// A second reconcile must not duplicate the init container.
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Len(t, sts.Spec.Template.Spec.InitContainers, 1)
+
+	removed, err := r.removePluginInitContainer(ctx, plugin, deployment)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Empty(t, sts.Spec.Template.Spec.InitContainers)
+}
+
+func TestInstallPluginViaEnvironment_StandaloneGetsPluginsVolume(t *testing.T) {
+	ctx := context.Background()
+	plugin := urlPlugin()
+	plugin.Spec.ClusterRef = "dev"
+	// Standalone StatefulSets created before they carried the plugins volume
+	sts := serverStatefulSet("dev")
+	sts.Spec.Template.Spec.Containers[0].VolumeMounts = nil
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(sts).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "standalone", Name: "dev", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	podSpec := sts.Spec.Template.Spec
+	require.Len(t, podSpec.InitContainers, 1)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "plugins", MountPath: "/plugins"}}, podSpec.InitContainers[0].VolumeMounts)
+	assert.Equal(t, []corev1.Volume{{
+		Name:         "plugins",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}, podSpec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "plugins", MountPath: "/plugins"}}, podSpec.Containers[0].VolumeMounts)
+
+	// New standalone StatefulSets carry the volume from the start.
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"}}
+	sr := &Neo4jEnterpriseStandaloneReconciler{}
+	assert.Contains(t, sr.buildVolumes(standalone), pluginsVolume())
+	assert.Contains(t, sr.buildVolumeMounts(standalone), pluginsVolumeMount())
+}
+
+func TestInitContainersEqual_ToleratesPluginInitContainers(t *testing.T) {
+	r := &Neo4jEnterpriseClusterReconciler{}
+	current := []corev1.Container{{Name: "plugin-custom-procs", Image: "neo4j:5.26.0-enterprise"}}
+	assert.True(t, r.initContainersEqual(current, nil))
+
+	current = append(current, corev1.Container{Name: "other", Image: "busybox"})
+	assert.False(t, r.initContainersEqual(current, nil))
+}
+
+func TestInstallPluginViaEnvironment_OCISourceCopiesFromImage(t *testing.T) {
+	ctx := context.Background()
+	plugin := urlPlugin()
+	plugin.Spec.Source = &neo4jv1alpha1.PluginSource{
+		Type:     "oci",
+		Image:    "registry.internal/plugins/custom-procs:1.2.3",
+		Path:     "/jars/custom-procs.jar",
+		Checksum: "sha256:" + testPluginSHA256,
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server")).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "prod-server", Namespace: "default"}, sts))
+	require.Len(t, sts.Spec.Template.Spec.InitContainers, 1)
+	init := sts.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, "registry.internal/plugins/custom-procs:1.2.3", init.Image)
+	assert.Empty(t, init.Env)
+	assert.Contains(t, init.Args[0], `cp '/jars/custom-procs.jar' "${tmp}"`)
+	assert.NotContains(t, init.Args[0], "wget")
+	assert.Less(t, strings.Index(init.Args[0], "sha256sum"), strings.Index(init.Args[0], `mv "${tmp}"`))
+	// Only the shared plugins volume the test StatefulSet lacked; no source volume
+	assert.Equal(t, []corev1.Volume{pluginsVolume()}, sts.Spec.Template.Spec.Volumes)
+}
+
+func TestInstallPluginViaEnvironment_PVCSourceMountsClaim(t *testing.T) {
+	ctx := context.Background()
+	plugin := urlPlugin()
+	plugin.Spec.Source = &neo4jv1alpha1.PluginSource{
+		Type:      "pvc",
+		ClaimName: "plugin-artifacts",
+		Path:      "custom/custom-procs-1.2.3.jar",
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server")).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "prod-server", Namespace: "default"}, sts))
+	require.Len(t, sts.Spec.Template.Spec.InitContainers, 1)
+	init := sts.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, "neo4j:5.26.0-enterprise", init.Image)
+	assert.Contains(t, init.VolumeMounts, corev1.VolumeMount{Name: "plugin-custom-procs", MountPath: "/plugin-source", ReadOnly: true})
+	assert.Contains(t, init.Args[0], `cp '/plugin-source/custom/custom-procs-1.2.3.jar' "${tmp}"`)
+
+	// The shared plugins volume the test StatefulSet lacked comes first
+	require.Len(t, sts.Spec.Template.Spec.Volumes, 2)
+	volume := sts.Spec.Template.Spec.Volumes[1]
+	assert.Equal(t, "plugin-custom-procs", volume.Name)
+	require.NotNil(t, volume.PersistentVolumeClaim)
+	assert.Equal(t, "plugin-artifacts", volume.PersistentVolumeClaim.ClaimName)
+	assert.True(t, volume.PersistentVolumeClaim.ReadOnly)
+
+	// Reconciling again must not duplicate the volume.
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Len(t, sts.Spec.Template.Spec.Volumes, 2)
+
+	removed, err := r.removePluginInitContainer(ctx, plugin, deployment)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Empty(t, sts.Spec.Template.Spec.InitContainers)
+	assert.Equal(t, []corev1.Volume{pluginsVolume()}, sts.Spec.Template.Spec.Volumes)
+}
+
+func TestVolumesEqual_ToleratesPluginSourceVolumes(t *testing.T) {
+	r := &Neo4jEnterpriseClusterReconciler{}
+	desired := []corev1.Volume{{Name: "plugins"}}
+	current := []corev1.Volume{{Name: "plugins"}, {Name: "plugin-custom-procs"}}
+	assert.True(t, r.volumesEqual(current, desired))
+
+	current = append(current, corev1.Volume{Name: "other"})
+	assert.False(t, r.volumesEqual(current, desired))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// pluginLicenseDir is the licenses directory of the Neo4j image; every
+// licensed plugin gets its own subdirectory.
+const pluginLicenseDir = "/licenses"
+
+// pluginLicenseSettings maps licensed plugins to the setting that names their
+// license file.
+var pluginLicenseSettings = map[string]string{
+	"graph-data-science": "gds.enterprise.license_file",
+	"bloom":              "dbms.bloom.license_file",
+}
+
+// pluginLicenseVolumeName names the license volume after the plugin's init
+// container, so the cluster controller preserves it like a source volume.
+func pluginLicenseVolumeName(plugin *neo4jv1alpha1.Neo4jPlugin) string {
+	const suffix = "-license"
+	name := pluginInitContainerName(plugin)
+	if len(name) > 63-len(suffix) {
+		name = strings.TrimRight(name[:63-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// pluginLicensePath returns where the license file of the plugin is mounted,
+// or "" when spec.license.secretRef is not set.
+func pluginLicensePath(plugin *neo4jv1alpha1.Neo4jPlugin) string {
+	if plugin.Spec.License == nil || plugin.Spec.License.SecretRef == nil {
+		return ""
+	}
+	dir := strings.TrimPrefix(pluginInitContainerName(plugin), pluginInitContainerPrefix)
+	return path.Join(pluginLicenseDir, dir, plugin.Spec.License.SecretRef.Key)
+}
+
+// pluginLicenseVolume returns the Secret volume carrying the license file
+// and its mount in the Neo4j container, or nil when no license is referenced.
+func pluginLicenseVolume(plugin *neo4jv1alpha1.Neo4jPlugin) (*corev1.Volume, *corev1.VolumeMount) {
+	licensePath := pluginLicensePath(plugin)
+	if licensePath == "" {
+		return nil, nil
+	}
+	ref := plugin.Spec.License.SecretRef
+	name := pluginLicenseVolumeName(plugin)
+	volume := &corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: ref.Name,
+				Items:      []corev1.KeyToPath{{Key: ref.Key, Path: ref.Key}},
+			},
+		},
+	}
+	mount := &corev1.VolumeMount{Name: name, MountPath: path.Dir(licensePath), ReadOnly: true}
+	return volume, mount
+}
+
+// startupConfig returns the restart-only configuration candidates of the
+// plugin: spec.config plus the license file setting of a mounted license.
+// An explicit spec.config entry for the license setting wins.
+func (r *Neo4jPluginReconciler) startupConfig(plugin *neo4jv1alpha1.Neo4jPlugin) map[string]string {
+	setting, ok := pluginLicenseSettings[r.mapPluginName(plugin.Spec.Name)]
+	licensePath := pluginLicensePath(plugin)
+	if !ok || licensePath == "" {
+		return plugin.Spec.Config
+	}
+	config := map[string]string{setting: licensePath}
+	for key, value := range plugin.Spec.Config {
+		config[key] = value
+	}
+	return config
+}
+
+// checkPluginLicenseSecret verifies the referenced license exists before it
+// is mounted; a missing Secret would keep the restarted pods from starting.
+func (r *Neo4jPluginReconciler) checkPluginLicenseSecret(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin) error {
+	if pluginLicensePath(plugin) == "" {
+		return nil
+	}
+	ref := plugin.Spec.License.SecretRef
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: plugin.Namespace}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("license secret %q not found", ref.Name)
+		}
+		return fmt.Errorf("failed to get license secret %q: %w", ref.Name, err)
+	}
+	if _, ok := secret.Data[ref.Key]; !ok {
+		return fmt.Errorf("license secret %q has no key %q", ref.Name, ref.Key)
+	}
+	return nil
+}
+
+// unmountPluginLicense removes the named license volume and its mount in the
+// container, together with any setting still pointing into the mount so the
+// server does not start with a dangling license path. It reports whether the
+// pod spec changed.
+func unmountPluginLicense(podSpec *corev1.PodSpec, container *corev1.Container, name string) bool {
+	var changed bool
+	podSpec.Volumes, changed = removeVolume(podSpec.Volumes, name)
+	for _, m := range container.VolumeMounts {
+		if m.Name != name {
+			continue
+		}
+		env := container.Env[:0]
+		for _, e := range container.Env {
+			if strings.HasPrefix(e.Value, m.MountPath+"/") {
+				continue
+			}
+			env = append(env, e)
+		}
+		container.Env = env
+		container.VolumeMounts, _ = removeVolumeMount(container.VolumeMounts, name)
+		return true
+	}
+	return changed
+}
+
+// upsertVolumeMount replaces the mount with the same name or appends it.
+func upsertVolumeMount(mounts []corev1.VolumeMount, m corev1.VolumeMount) []corev1.VolumeMount {
+	for i := range mounts {
+		if mounts[i].Name == m.Name {
+			mounts[i] = m
+			return mounts
+		}
+	}
+	return append(mounts, m)
+}
+
+// removeVolumeMount drops the named mount. It reports whether the slice changed.
+func removeVolumeMount(mounts []corev1.VolumeMount, name string) ([]corev1.VolumeMount, bool) {
+	for i := range mounts {
+		if mounts[i].Name == name {
+			return append(mounts[:i], mounts[i+1:]...), true
+		}
+	}
+	return mounts, false
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func licensedGDSPlugin() *neo4jv1alpha1.Neo4jPlugin {
+	return &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "gds", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "prod",
+			Name:       "graph-data-science",
+			Version:    "2.13.2",
+			License: &neo4jv1alpha1.PluginLicense{
+				SecretRef: &neo4jv1alpha1.SecretKeySelector{Name: "gds-license", Key: "gds.license"},
+			},
+		},
+	}
+}
+
+func licenseSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gds-license", Namespace: "default"},
+		Data:       map[string][]byte{"gds.license": []byte("license-key")},
+	}
+}
+
+func TestNeo4jSettingEnvVar(t *testing.T) {
+	assert.Equal(t, "NEO4J_APOC_EXPORT_FILE_ENABLED", neo4jSettingEnvVar("apoc.export.file.enabled"))
+	assert.Equal(t, "NEO4J_GDS_ENTERPRISE_LICENSE__FILE", neo4jSettingEnvVar("gds.enterprise.license_file"))
+	assert.Equal(t, "NEO4J_DBMS_SECURITY_PROCEDURES_UNRESTRICTED", neo4jSettingEnvVar("dbms.security.procedures.unrestricted"))
+}
+
+func TestStartupConfig_AddsLicenseSetting(t *testing.T) {
+	r := &Neo4jPluginReconciler{}
+	plugin := licensedGDSPlugin()
+	assert.Equal(t, map[string]string{"gds.enterprise.license_file": "/licenses/graph-data-science/gds.license"}, r.startupConfig(plugin))
+
+	// An explicit setting in spec.config wins over the mounted path.
+	plugin.Spec.Config = map[string]string{"gds.enterprise.license_file": "/licenses/custom.license"}
+	assert.Equal(t, "/licenses/custom.license", r.startupConfig(plugin)["gds.enterprise.license_file"])
+
+	// Plugins without a known license setting only get the mount.
+	plugin = licensedGDSPlugin()
+	plugin.Spec.Name = "apoc"
+	assert.Empty(t, r.startupConfig(plugin))
+}
+
+func TestInstallPluginViaEnvironment_MountsLicenseSecret(t *testing.T) {
+	ctx := context.Background()
+	plugin := licensedGDSPlugin()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server"), licenseSecret()).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	container := sts.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "/licenses/graph-data-science/gds.license", envVarMap(container.Env)["NEO4J_GDS_ENTERPRISE_LICENSE__FILE"])
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "plugin-graph-data-science-license", MountPath: "/licenses/graph-data-science", ReadOnly: true})
+
+	require.Len(t, sts.Spec.Template.Spec.Volumes, 1)
+	volume := sts.Spec.Template.Spec.Volumes[0]
+	assert.Equal(t, "plugin-graph-data-science-license", volume.Name)
+	require.NotNil(t, volume.Secret)
+	assert.Equal(t, "gds-license", volume.Secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "gds.license", Path: "gds.license"}}, volume.Secret.Items)
+
+	// Reconciling again must not duplicate the volume or the mount.
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Len(t, sts.Spec.Template.Spec.Volumes, 1)
+	assert.Len(t, sts.Spec.Template.Spec.Containers[0].VolumeMounts, 2)
+
+	// Dropping the reference unmounts the license.
+	plugin.Spec.License = nil
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Empty(t, sts.Spec.Template.Spec.Volumes)
+	assert.Len(t, sts.Spec.Template.Spec.Containers[0].VolumeMounts, 1)
+	assert.NotContains(t, envVarMap(sts.Spec.Template.Spec.Containers[0].Env), "NEO4J_GDS_ENTERPRISE_LICENSE__FILE")
+}
+
+func TestRemovePluginInitContainer_RemovesLicenseVolume(t *testing.T) {
+	ctx := context.Background()
+	plugin := licensedGDSPlugin()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server"), licenseSecret()).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+	require.NoError(t, r.installPluginViaEnvironment(ctx, plugin, deployment))
+
+	removed, err := r.removePluginInitContainer(ctx, plugin, deployment)
+	require.NoError(t, err)
+	assert.False(t, removed, "GDS is delivered through NEO4J_PLUGINS, not an init container")
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.Empty(t, sts.Spec.Template.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "plugins", MountPath: "/plugins"}}, sts.Spec.Template.Spec.Containers[0].VolumeMounts)
+	assert.NotContains(t, envVarMap(sts.Spec.Template.Spec.Containers[0].Env), "NEO4J_GDS_ENTERPRISE_LICENSE__FILE")
+}
+
+func TestInstallPluginViaEnvironment_MissingLicenseSecret(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server")).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	err := r.installPluginViaEnvironment(ctx, licensedGDSPlugin(), deployment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `license secret "gds-license" not found`)
+
+	secret := licenseSecret()
+	secret.Data = map[string][]byte{"other": nil}
+	c = fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(serverStatefulSet("prod-server"), secret).Build()
+	r = &Neo4jPluginReconciler{Client: c}
+	err = r.installPluginViaEnvironment(ctx, licensedGDSPlugin(), deployment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `has no key "gds.license"`)
+}
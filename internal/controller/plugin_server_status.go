@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// pluginNamespaces maps plugins to the procedure namespace they register.
+// Most plugins are not listed by dbms.components(), so whether a server has
+// loaded them is told by their procedures and functions instead.
+var pluginNamespaces = map[string]string{
+	"apoc":               "apoc",
+	"apoc-extended":      "apoc",
+	"graph-data-science": "gds",
+	"bloom":              "bloom",
+	"genai":              "genai",
+	"n10s":               "n10s",
+	"graphql":            "graphql",
+	"fleet-management":   "fleetManagement",
+}
+
+// collectServerStatus reports the install state of the plugin on every pod of
+// the deployment. Pods that are not ready or cannot be queried are listed with
+// a message rather than failing the reconcile, so a partial rollout shows up.
+func (r *Neo4jPluginReconciler) collectServerStatus(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin, deployment *DeploymentInfo) []neo4jv1alpha1.PluginServerStatus {
+	logger := log.FromContext(ctx)
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(deployment.Namespace), client.MatchingLabels(r.getPodLabels(deployment))); err != nil {
+		logger.Error(err, "Failed to list pods for plugin server status")
+		return nil
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+
+	var serverIDs []neo4jclient.ServerInfo
+	servers := make([]neo4jv1alpha1.PluginServerStatus, 0, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		server := neo4jv1alpha1.PluginServerStatus{Pod: pod.Name}
+		if !isPodReady(pod) {
+			server.Message = fmt.Sprintf("Pod is not ready (%s)", pod.Status.Phase)
+			servers = append(servers, server)
+			continue
+		}
+
+		neo4jClient, err := r.serverClient(deployment, pod)
+		if err != nil {
+			server.Message = fmt.Sprintf("Failed to connect: %v", err)
+			servers = append(servers, server)
+			continue
+		}
+		if serverIDs == nil {
+			// Any member lists every server, so SHOW SERVERS runs once
+			if serverIDs, err = neo4jClient.GetServerList(ctx); err != nil {
+				logger.Info("Failed to list servers for plugin server status", "pod", pod.Name, "error", err.Error())
+			}
+		}
+		server.ServerID = serverIDForPod(serverIDs, pod.Name)
+		if server.ServerID == "" && deployment.Type != "cluster" && len(serverIDs) == 1 {
+			// A standalone server advertises its service address, not the pod
+			server.ServerID = serverIDs[0].Name
+		}
+		server.Loaded, server.InstalledVersion, err = r.serverPluginState(ctx, neo4jClient, plugin)
+		if err != nil {
+			server.Message = fmt.Sprintf("Failed to query plugin state: %v", err)
+		}
+		neo4jClient.Close()
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+// serverPluginState reports whether the server has loaded the plugin and the
+// version it runs. dbms.components() is consulted first; plugins that do not
+// register a component are detected through their procedure namespace.
+func (r *Neo4jPluginReconciler) serverPluginState(ctx context.Context, neo4jClient *neo4jclient.Client, plugin *neo4jv1alpha1.Neo4jPlugin) (bool, string, error) {
+	components, err := neo4jClient.GetLoadedComponents(ctx)
+	if err != nil {
+		return false, "", err
+	}
+	name := r.mapPluginName(plugin.Spec.Name)
+	for _, component := range components {
+		if strings.EqualFold(component.Name, plugin.Spec.Name) || strings.EqualFold(component.Name, name) {
+			return true, component.Version, nil
+		}
+	}
+
+	namespace, ok := pluginNamespaces[name]
+	if !ok {
+		return false, "", nil
+	}
+	return neo4jClient.GetNamespaceVersion(ctx, namespace)
+}
+
+// serverIDForPod finds the SHOW SERVERS entry whose address is the pod's
+// headless DNS name.
+func serverIDForPod(servers []neo4jclient.ServerInfo, pod string) string {
+	for _, server := range servers {
+		host := strings.Split(server.Address, ":")[0]
+		if host == pod || strings.HasPrefix(host, pod+".") {
+			return server.Name
+		}
+	}
+	return ""
+}
+
+// loadedServerCount counts the servers that report the plugin as loaded.
+func loadedServerCount(servers []neo4jv1alpha1.PluginServerStatus) int {
+	count := 0
+	for _, server := range servers {
+		if server.Loaded {
+			count++
+		}
+	}
+	return count
+}
+
+// updatePluginServers records the per-server install state. Nothing is
+// written when it did not change since the previous reconcile, or when the
+// pods could not be listed.
+func (r *Neo4jPluginReconciler) updatePluginServers(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin, servers []neo4jv1alpha1.PluginServerStatus) {
+	if servers == nil {
+		return
+	}
+	update := func() error {
+		latest := &neo4jv1alpha1.Neo4jPlugin{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(plugin), latest); err != nil {
+			return err
+		}
+		if equality.Semantic.DeepEqual(latest.Status.Servers, servers) {
+			return nil
+		}
+		latest.Status.Servers = servers
+		return r.Status().Update(ctx, latest)
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update plugin server status")
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func serverPod(name string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"app.kubernetes.io/name": "neo4j", "app.kubernetes.io/instance": "prod"},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestServerIDForPod(t *testing.T) {
+	servers := []neo4jclient.ServerInfo{
+		{Name: "8dc0ab3e-5e6c-4b6e-9f2a-1f0c2d1b7a11", Address: "prod-server-0.prod-headless.default.svc.cluster.local:7687"},
+		{Name: "1b6a2c7f-0d3e-4a51-8a0b-6e4f9c2d3e22", Address: "prod-server-1.prod-headless.default.svc.cluster.local:7687"},
+	}
+	assert.Equal(t, "1b6a2c7f-0d3e-4a51-8a0b-6e4f9c2d3e22", serverIDForPod(servers, "prod-server-1"))
+	assert.Empty(t, serverIDForPod(servers, "prod-server-10"), "pod name prefixes must not match")
+	assert.Empty(t, serverIDForPod(nil, "prod-server-0"))
+}
+
+func TestCollectServerStatus_ReportsPodsThatAreNotReady(t *testing.T) {
+	ctx := context.Background()
+	plugin := &neo4jv1alpha1.Neo4jPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "apoc", Namespace: "default"},
+		Spec:       neo4jv1alpha1.Neo4jPluginSpec{ClusterRef: "prod", Name: "apoc", Version: "5.26.0"},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(plugin, serverPod("prod-server-1", corev1.PodPending), serverPod("prod-server-0", corev1.PodFailed)).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jPlugin{}).Build()
+	r := &Neo4jPluginReconciler{Client: c}
+	deployment := &DeploymentInfo{Type: "cluster", Name: "prod", Namespace: "default"}
+
+	servers := r.collectServerStatus(ctx, plugin, deployment)
+	assert.Equal(t, []neo4jv1alpha1.PluginServerStatus{
+		{Pod: "prod-server-0", Message: "Pod is not ready (Failed)"},
+		{Pod: "prod-server-1", Message: "Pod is not ready (Pending)"},
+	}, servers)
+	assert.Equal(t, 0, loadedServerCount(servers))
+
+	r.updatePluginServers(ctx, plugin, servers)
+	updated := &neo4jv1alpha1.Neo4jPlugin{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(plugin), updated))
+	assert.Equal(t, servers, updated.Status.Servers)
+
+	// Unchanged state is not written again.
+	revision := updated.ResourceVersion
+	r.updatePluginServers(ctx, plugin, r.collectServerStatus(ctx, plugin, deployment))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(plugin), updated))
+	assert.Equal(t, revision, updated.ResourceVersion)
+}
+
+func TestLoadedServerCount(t *testing.T) {
+	servers := []neo4jv1alpha1.PluginServerStatus{
+		{Pod: "prod-server-0", Loaded: true, InstalledVersion: "5.26.0"},
+		{Pod: "prod-server-1", Loaded: true, InstalledVersion: "5.26.0"},
+		{Pod: "prod-server-2", Message: "Pod is not ready (Pending)"},
+	}
+	assert.Equal(t, 2, loadedServerCount(servers))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// pluginSettingsConfigMapName names the ConfigMap that holds the dynamic
+// settings of a plugin as NEO4J_* environment variables.
+func pluginSettingsConfigMapName(plugin *neo4jv1alpha1.Neo4jPlugin) string {
+	return fmt.Sprintf("%s-plugin-settings", plugin.Name)
+}
+
+// reconcilePluginSettings writes the dynamic settings of the plugin to its
+// settings ConfigMap. The neo4j container loads the ConfigMap through
+// envFrom, so a restarted server starts with the values that were applied
+// at runtime. Changing the ConfigMap does not touch the pod template and
+// therefore does not roll the pods.
+func (r *Neo4jPluginReconciler) reconcilePluginSettings(ctx context.Context, plugin *neo4jv1alpha1.Neo4jPlugin, deployment *DeploymentInfo) error {
+	data := make(map[string]string)
+	for key, value := range r.filterNeo4jClientConfig(plugin.Spec.Config) {
+		data[neo4jSettingEnvVar(key)] = value
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pluginSettingsConfigMapName(plugin),
+			Namespace: deployment.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		configMap.Labels["app.kubernetes.io/name"] = "neo4j-plugin"
+		configMap.Labels["app.kubernetes.io/instance"] = deployment.Name
+		configMap.Labels["neo4j.plugin/name"] = plugin.Spec.Name
+		configMap.Data = data
+		// Deleting the plugin garbage-collects its settings
+		return controllerutil.SetControllerReference(plugin, configMap, r.Client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update plugin settings ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// pluginSettingsEnvFrom returns the envFrom entry that loads the settings
+// ConfigMap of the plugin. It is optional so pods still start while the
+// ConfigMap is missing.
+func pluginSettingsEnvFrom(plugin *neo4jv1alpha1.Neo4jPlugin) corev1.EnvFromSource {
+	optional := true
+	return corev1.EnvFromSource{
+		ConfigMapRef: &corev1.ConfigMapEnvSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: pluginSettingsConfigMapName(plugin)},
+			Optional:             &optional,
+		},
+	}
+}
+
+// upsertEnvFrom appends the envFrom entry unless one for the same ConfigMap
+// is already present.
+func upsertEnvFrom(sources []corev1.EnvFromSource, s corev1.EnvFromSource) []corev1.EnvFromSource {
+	for _, existing := range sources {
+		if existing.ConfigMapRef != nil && existing.ConfigMapRef.Name == s.ConfigMapRef.Name {
+			return sources
+		}
+	}
+	return append(sources, s)
+}
+
+// removeEnvFrom drops the envFrom entry of the named ConfigMap. It reports
+// whether the slice changed.
+func removeEnvFrom(sources []corev1.EnvFromSource, configMapName string) ([]corev1.EnvFromSource, bool) {
+	for i := range sources {
+		if sources[i].ConfigMapRef != nil && sources[i].ConfigMapRef.Name == configMapName {
+			return append(sources[:i], sources[i+1:]...), true
+		}
+	}
+	return sources, false
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// Reasons a query is terminated for, as counted in
+// neo4j_operator_terminated_queries_total
+const (
+	terminationReasonDuration = "duration"
+	terminationReasonMemory   = "memory"
+)
+
+// queryEnforcer decides which transactions exceed the limits of
+// spec.queryMonitoring.enforcement
+type queryEnforcer struct {
+	maxDuration time.Duration
+	maxMemory   int64
+	allowlists  map[string][]queryAllowlist
+	// operatorUser is the user the operator connects as; its migrations,
+	// seeds and schema builds run without a timeout on user databases
+	operatorUser string
+}
+
+// queryAllowlist is a parsed allowlist of one database
+type queryAllowlist struct {
+	users    []string
+	patterns []*regexp.Regexp
+}
+
+// newQueryEnforcer parses spec.queryMonitoring.enforcement, and returns nil
+// when it sets no limit
+func newQueryEnforcer(spec *neo4jv1alpha1.QueryEnforcementSpec) (*queryEnforcer, error) {
+	if spec == nil || (spec.MaxQueryDuration == "" && spec.MaxAllocatedMemory == "") {
+		return nil, nil
+	}
+
+	enforcer := &queryEnforcer{allowlists: map[string][]queryAllowlist{}}
+	if spec.MaxQueryDuration != "" {
+		maxDuration, err := time.ParseDuration(spec.MaxQueryDuration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxQueryDuration %q: %w", spec.MaxQueryDuration, err)
+		}
+		enforcer.maxDuration = maxDuration
+	}
+	if spec.MaxAllocatedMemory != "" {
+		maxMemory, err := resource.ParseQuantity(spec.MaxAllocatedMemory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxAllocatedMemory %q: %w", spec.MaxAllocatedMemory, err)
+		}
+		enforcer.maxMemory = maxMemory.Value()
+	}
+	for _, allowlist := range spec.Allowlists {
+		parsed := queryAllowlist{users: allowlist.Users}
+		for _, pattern := range allowlist.QueryPatterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid query pattern %q of database %s: %w", pattern, allowlist.Database, err)
+			}
+			parsed.patterns = append(parsed.patterns, compiled)
+		}
+		enforcer.allowlists[allowlist.Database] = append(enforcer.allowlists[allowlist.Database], parsed)
+	}
+	return enforcer, nil
+}
+
+// violation returns the reason to terminate a transaction for, or an empty
+// string. Idle transactions, those of the system database and those of the
+// operator's own user, on any database, are never terminated.
+func (e *queryEnforcer) violation(transaction neo4jclient.TransactionInfo) string {
+	if transaction.Query == "" || transaction.Database == "system" ||
+		(e.operatorUser != "" && transaction.Username == e.operatorUser) || e.exempt(transaction) {
+		return ""
+	}
+	if e.maxDuration > 0 && transaction.ElapsedTime > e.maxDuration {
+		return terminationReasonDuration
+	}
+	if e.maxMemory > 0 && transaction.EstimatedUsedHeapMemory > e.maxMemory {
+		return terminationReasonMemory
+	}
+	return ""
+}
+
+// exempt reports whether an allowlist of the database of a transaction
+// covers it. Allowlists without users and patterns cover every query.
+func (e *queryEnforcer) exempt(transaction neo4jclient.TransactionInfo) bool {
+	for _, allowlist := range e.allowlists[transaction.Database] {
+		if len(allowlist.users) == 0 && len(allowlist.patterns) == 0 {
+			return true
+		}
+		if slices.Contains(allowlist.users, transaction.Username) {
+			return true
+		}
+		for _, pattern := range allowlist.patterns {
+			if pattern.MatchString(transaction.Query) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// operatorConnectionUser returns the user the operator connects to the
+// cluster as: that of spec.auth.operatorSecret once it is set up, the
+// admin user before
+func operatorConnectionUser(ctx context.Context, c client.Client, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (string, error) {
+	if operatorUserReady(cluster.Spec.Auth, cluster.Status.OperatorUser) {
+		return cluster.Status.OperatorUser.Username, nil
+	}
+	credentials, err := neo4jclient.SecretCredentials(ctx, c, cluster.Namespace, getClusterAdminSecretName(cluster))
+	if err != nil {
+		return "", err
+	}
+	return credentials.Username, nil
+}
+
+// enforceQueryLimits terminates the transactions running on the server of
+// pod that exceed the limits
+func (qm *QueryMonitor) enforceQueryLimits(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod,
+	neo4jClient slowQueryClient, enforcer *queryEnforcer, transactions []neo4jclient.TransactionInfo) error {
+	offending := map[string]neo4jclient.TransactionInfo{}
+	var ids []string
+	for _, transaction := range transactions {
+		if enforcer.violation(transaction) != "" {
+			offending[transaction.ID] = transaction
+			ids = append(ids, transaction.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	terminated, err := neo4jClient.TerminateTransactions(ctx, ids)
+	if err != nil {
+		return err
+	}
+	queryMetrics := metrics.NewQueryMetrics(cluster.Name, cluster.Namespace)
+	for _, id := range terminated {
+		transaction := offending[id]
+		reason := enforcer.violation(transaction)
+		queryMetrics.RecordTerminatedQuery(transaction.Database, reason)
+		log.FromContext(ctx).Info("Terminated a runaway query", "cluster", cluster.Name, "server", pod.Name,
+			"transaction", id, "database", transaction.Database, "username", transaction.Username, "reason", reason)
+		if qm.Recorder != nil {
+			qm.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonQueryTerminated,
+				"Terminated transaction %s of %s on %s after %s for exceeding the %s limit",
+				id, transaction.Username, pod.Name, transaction.ElapsedTime.Round(time.Second), reason)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func TestQueryEnforcerViolation(t *testing.T) {
+	enforcer, err := newQueryEnforcer(&neo4jv1alpha1.QueryEnforcementSpec{
+		MaxQueryDuration:   "10m",
+		MaxAllocatedMemory: "1Gi",
+		Allowlists: []neo4jv1alpha1.QueryAllowlist{
+			{Database: "reports"},
+			{Database: "neo4j", Users: []string{"etl"}, QueryPatterns: []string{`^CALL apoc\.periodic\.`}},
+		},
+	})
+	require.NoError(t, err)
+
+	long := 20 * time.Minute
+	tests := []struct {
+		name        string
+		transaction neo4jclient.TransactionInfo
+		want        string
+	}{
+		{"within limits", neo4jclient.TransactionInfo{Database: "neo4j", Query: "MATCH (n) RETURN n", ElapsedTime: time.Minute}, ""},
+		{"too long", neo4jclient.TransactionInfo{Database: "neo4j", Query: "MATCH (n) RETURN n", ElapsedTime: long}, terminationReasonDuration},
+		{"too much memory", neo4jclient.TransactionInfo{Database: "neo4j", Query: "MATCH (n) RETURN n", EstimatedUsedHeapMemory: 2 << 30}, terminationReasonMemory},
+		{"idle", neo4jclient.TransactionInfo{Database: "neo4j", ElapsedTime: long}, ""},
+		{"system database", neo4jclient.TransactionInfo{Database: "system", Query: "SHOW USERS", ElapsedTime: long}, ""},
+		{"allowlisted database", neo4jclient.TransactionInfo{Database: "reports", Query: "MATCH (n) RETURN n", ElapsedTime: long}, ""},
+		{"allowlisted user", neo4jclient.TransactionInfo{Database: "neo4j", Username: "etl", Query: "MATCH (n) RETURN n", ElapsedTime: long}, ""},
+		{"allowlisted query", neo4jclient.TransactionInfo{Database: "neo4j", Query: "CALL apoc.periodic.iterate('...')", ElapsedTime: long}, ""},
+		{"operator user", neo4jclient.TransactionInfo{Database: "neo4j", Username: "neo4j-operator", Query: "CREATE INDEX FOR (n:Person) ON (n.name)", ElapsedTime: long}, ""},
+	}
+	enforcer.operatorUser = "neo4j-operator"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, enforcer.violation(tt.transaction))
+		})
+	}
+
+	enforcer, err = newQueryEnforcer(&neo4jv1alpha1.QueryEnforcementSpec{})
+	require.NoError(t, err)
+	assert.Nil(t, enforcer)
+	_, err = newQueryEnforcer(&neo4jv1alpha1.QueryEnforcementSpec{MaxQueryDuration: "10m",
+		Allowlists: []neo4jv1alpha1.QueryAllowlist{{Database: "neo4j", QueryPatterns: []string{"("}}}})
+	require.Error(t, err)
+}
+
+func TestCollectSlowQueriesEnforcement(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("enforced", "default")
+	cluster.Spec.QueryMonitoring = &neo4jv1alpha1.QueryMonitoringSpec{
+		Enabled:     true,
+		Enforcement: &neo4jv1alpha1.QueryEnforcementSpec{MaxQueryDuration: "10m"},
+	}
+	adminSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: getClusterAdminSecretName(cluster), Namespace: cluster.Namespace},
+		Data:       map[string][]byte{"username": []byte("neo4j"), "password": []byte("secret")},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, adminSecret, clusterServerPod(cluster, 0)).Build()
+	server := &fakeSlowQueryClient{transactions: []neo4jclient.TransactionInfo{
+		{ID: "neo4j-transaction-1", Database: "neo4j", Username: "app", Query: "MATCH (a)-->(b) RETURN a, b", ElapsedTime: time.Hour},
+		{ID: "neo4j-transaction-2", Database: "neo4j", Username: "app", Query: "MATCH (n) RETURN n", ElapsedTime: time.Minute},
+		// A migration the operator runs as the admin user outlives the limit
+		{ID: "neo4j-transaction-3", Database: "neo4j", Username: "neo4j", Query: "MATCH (n:Person) SET n.migrated = true", ElapsedTime: time.Hour},
+	}}
+	recorder := record.NewFakeRecorder(10)
+	qm := NewQueryMonitor(c, c.Scheme())
+	qm.Recorder = recorder
+	qm.newSlowQueryClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster, *corev1.Pod) (slowQueryClient, error) {
+		return server, nil
+	}
+
+	require.NoError(t, qm.CollectSlowQueries(ctx, cluster))
+	assert.Equal(t, []string{"neo4j-transaction-1"}, server.terminated)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonQueryTerminated)
+}
+
+func TestOperatorConnectionUser(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("enforced", "default")
+	adminSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: getClusterAdminSecretName(cluster), Namespace: cluster.Namespace},
+		Data:       map[string][]byte{"NEO4J_AUTH": []byte("admin/secret")},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(adminSecret).Build()
+
+	user, err := operatorConnectionUser(ctx, c, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", user)
+
+	// Once the operator user is set up, the operator connects as it
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{Provider: "native", OperatorSecret: "operator-credentials"}
+	cluster.Status.OperatorUser = &neo4jv1alpha1.OperatorUserStatus{Username: "neo4j-operator"}
+	user, err = operatorConnectionUser(ctx, c, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, "neo4j-operator", user)
+}
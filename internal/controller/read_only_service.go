@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// reconcileReadOnlyService keeps the read-only Service and its Ingress in
+// line with spec.service.readOnly, deleting them once they are no longer
+// wanted.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileReadOnlyService(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if service := resources.BuildReadOnlyServiceForEnterprise(cluster); service != nil {
+		if err := r.createOrUpdateResource(ctx, service, cluster); err != nil {
+			return fmt.Errorf("failed to create Service %s: %w", service.Name, err)
+		}
+	} else {
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: resources.ReadOnlyServiceName(cluster), Namespace: cluster.Namespace}}
+		if err := r.Delete(ctx, service); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Service %s: %w", service.Name, err)
+		}
+	}
+
+	if ingress := resources.BuildReadOnlyIngressForEnterprise(cluster); ingress != nil {
+		if err := r.createOrUpdateResource(ctx, ingress, cluster); err != nil {
+			return fmt.Errorf("failed to create Ingress %s: %w", ingress.Name, err)
+		}
+	} else {
+		ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: resources.ReadOnlyIngressName(cluster), Namespace: cluster.Namespace}}
+		if err := r.Delete(ctx, ingress); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Ingress %s: %w", ingress.Name, err)
+		}
+	}
+	return nil
+}
+
+// labelServerRoles labels the server pods with their role from
+// status.servers, which moves them in and out of the read-only Service as
+// secondaries join, leave or change role. Pods that are not cluster members
+// yet keep the label they have.
+func (r *Neo4jEnterpriseClusterReconciler) labelServerRoles(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if !resources.ReadOnlyServiceEnabled(cluster) {
+		return nil
+	}
+
+	roles := map[string]string{}
+	for _, server := range cluster.Status.Servers {
+		if server.Role != "" {
+			roles[server.PodName] = server.Role
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return fmt.Errorf("failed to list server pods: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		role, known := roles[pod.Name]
+		if !known || pod.Labels[resources.ServerRoleLabel] == role {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[resources.ServerRoleLabel] = role
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return fmt.Errorf("failed to label pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
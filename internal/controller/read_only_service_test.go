@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func TestReconcileReadOnlyService(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.UID = "prod-uid"
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{ReadOnly: &neo4jv1alpha1.ReadOnlyServiceSpec{
+		Enabled: true,
+		Ingress: &neo4jv1alpha1.IngressSpec{Enabled: true, Host: "analytics.example.com"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	serviceKey := client.ObjectKey{Name: "prod-ro", Namespace: "default"}
+	ingressKey := client.ObjectKey{Name: "prod-ro-ingress", Namespace: "default"}
+
+	require.NoError(t, r.reconcileReadOnlyService(ctx, cluster))
+	require.NoError(t, c.Get(ctx, serviceKey, &corev1.Service{}))
+	require.NoError(t, c.Get(ctx, ingressKey, &networkingv1.Ingress{}))
+
+	// Dropping the Ingress keeps the Service, disabling removes both
+	cluster.Spec.Service.ReadOnly.Ingress = nil
+	require.NoError(t, r.reconcileReadOnlyService(ctx, cluster))
+	require.NoError(t, c.Get(ctx, serviceKey, &corev1.Service{}))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, ingressKey, &networkingv1.Ingress{})))
+
+	cluster.Spec.Service.ReadOnly.Enabled = false
+	require.NoError(t, r.reconcileReadOnlyService(ctx, cluster))
+	assert.True(t, errors.IsNotFound(c.Get(ctx, serviceKey, &corev1.Service{})))
+}
+
+func TestLabelServerRoles(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{ReadOnly: &neo4jv1alpha1.ReadOnlyServiceSpec{Enabled: true}}
+	cluster.Status.Servers = []neo4jv1alpha1.ServerStatus{
+		{PodName: "prod-server-0", ServerID: "id-0", Role: "primary"},
+		{PodName: "prod-server-1", ServerID: "id-1", Role: "secondary"},
+	}
+	joining := restartTestPod("prod-server-2", "rev")
+	joining.Labels[resources.ServerRoleLabel] = "secondary"
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(restartTestPod("prod-server-0", "rev"), restartTestPod("prod-server-1", "rev"), joining).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	role := func(name string) string {
+		t.Helper()
+		pod := &corev1.Pod{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, pod))
+		return pod.Labels[resources.ServerRoleLabel]
+	}
+
+	require.NoError(t, r.labelServerRoles(ctx, cluster))
+	assert.Equal(t, "primary", role("prod-server-0"))
+	assert.Equal(t, "secondary", role("prod-server-1"))
+	assert.Equal(t, "secondary", role("prod-server-2"))
+
+	// A server changing role moves in or out of the read-only Service
+	cluster.Status.Servers[1].Role = "primary"
+	require.NoError(t, r.labelServerRoles(ctx, cluster))
+	assert.Equal(t, "primary", role("prod-server-1"))
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+)
+
+// controllerNameKey is the context key of the name of the controller
+// reconciling
+type controllerNameKey struct{}
+
+// validationError marks a reconcile error as the spec failing validation
+type validationError struct {
+	err error
+}
+
+func (e *validationError) Error() string { return e.err.Error() }
+
+func (e *validationError) Unwrap() error { return e.err }
+
+// observedReconciler counts the failed reconciliations of a controller in
+// neo4j_operator_reconcile_errors_total by reason
+type observedReconciler struct {
+	controller string
+	reconciler reconcile.Reconciler
+}
+
+// observeReconcileErrors wraps the reconciler of the named controller to
+// count its errors
+func observeReconcileErrors(controller string, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return &observedReconciler{controller: controller, reconciler: reconciler}
+}
+
+// Reconcile implements reconcile.Reconciler
+func (o *observedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := o.reconciler.Reconcile(context.WithValue(ctx, controllerNameKey{}, o.controller), req)
+	if err != nil {
+		metrics.RecordReconcileError(o.controller, reconcileErrorReason(err))
+	}
+	return result, err
+}
+
+// recordValidationFailure counts a spec failing validation that the
+// reconciler reports in its status instead of returning an error
+func recordValidationFailure(ctx context.Context) {
+	if controller, ok := ctx.Value(controllerNameKey{}).(string); ok {
+		metrics.RecordReconcileError(controller, metrics.ReconcileErrorValidation)
+	}
+}
+
+// reconcileErrorReason classifies a reconcile error
+func reconcileErrorReason(err error) string {
+	var validation *validationError
+	var connectivity *neo4j.ConnectivityError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &validation):
+		return metrics.ReconcileErrorValidation
+	case apierrors.IsConflict(err):
+		return metrics.ReconcileErrorConflict
+	case errors.Is(err, context.DeadlineExceeded), apierrors.IsTimeout(err), apierrors.IsServerTimeout(err),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return metrics.ReconcileErrorTimeout
+	case errors.As(err, &connectivity):
+		return metrics.ReconcileErrorNeo4jUnreachable
+	default:
+		return metrics.ReconcileErrorOther
+	}
+}
+
+// observedQueue is the controller.Options NewQueue creating workqueues that
+// report their depth and oldest item age
+func observedQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return metrics.NewInstrumentedQueue(controllerName, rateLimiter)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+)
+
+func TestReconcileErrorReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "validation", err: &validationError{errors.New("invalid topology")}, want: metrics.ReconcileErrorValidation},
+		{name: "conflict", err: fmt.Errorf("failed to update status: %w",
+			apierrors.NewConflict(schema.GroupResource{Resource: "neo4jbackups"}, "nightly", errors.New("modified"))), want: metrics.ReconcileErrorConflict},
+		{name: "deadline", err: fmt.Errorf("failed to list databases: %w", context.DeadlineExceeded), want: metrics.ReconcileErrorTimeout},
+		{name: "server timeout", err: apierrors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "get", 1), want: metrics.ReconcileErrorTimeout},
+		{name: "neo4j unreachable", err: fmt.Errorf("failed to verify Neo4j connectivity: %w",
+			&neo4j.ConnectivityError{Inner: errors.New("connection refused")}), want: metrics.ReconcileErrorNeo4jUnreachable},
+		{name: "other", err: errors.New("backup job failed"), want: metrics.ReconcileErrorOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reconcileErrorReason(tt.err))
+		})
+	}
+}
+
+func TestObserveReconcileErrors(t *testing.T) {
+	var names []string
+	reconciler := observeReconcileErrors("neo4jtest", reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		name, _ := ctx.Value(controllerNameKey{}).(string)
+		names = append(names, name)
+		return reconcile.Result{}, nil
+	}))
+
+	_, err := reconciler.Reconcile(context.Background(), reconcile.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"neo4jtest"}, names)
+}
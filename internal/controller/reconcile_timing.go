@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+)
+
+// Named phases of a cluster reconcile, used as the "phase" label of
+// neo4j_operator_reconcile_phase_duration_seconds.
+const (
+	// ReconcilePhaseFetch reads the cluster resource
+	ReconcilePhaseFetch = "fetch"
+	// ReconcilePhaseDeletion covers finalizer cleanup of a deleted cluster
+	ReconcilePhaseDeletion = "deletion"
+	// ReconcilePhaseValidate covers defaulting, validation, the finalizer and upgrade detection
+	ReconcilePhaseValidate = "validate"
+	// ReconcilePhaseUpgrade covers a rolling upgrade step
+	ReconcilePhaseUpgrade = "upgrade"
+	// ReconcilePhaseConfigMap covers TLS certificates, external secrets and the ConfigMap
+	ReconcilePhaseConfigMap = "configmap"
+	// ReconcilePhaseServices covers RBAC, Services, Ingress/Route, MCP and Fleet Management
+	ReconcilePhaseServices = "services"
+	// ReconcilePhaseStatefulSet covers topology placement and the StatefulSets
+	ReconcilePhaseStatefulSet = "sts"
+	// ReconcilePhaseStatus covers query monitoring, formation checks and status updates
+	ReconcilePhaseStatus = "status"
+)
+
+// DefaultSlowReconcileThreshold is the reconcile budget used when none is configured
+const DefaultSlowReconcileThreshold = 30 * time.Second
+
+// phaseTiming is the time spent in one reconcile phase.
+type phaseTiming struct {
+	phase    string
+	duration time.Duration
+}
+
+// reconcileTimer splits a reconcile into consecutive named phases. Starting
+// a phase ends the previous one, so early returns only need finish().
+type reconcileTimer struct {
+	now        func() time.Time
+	start      time.Time
+	phase      string
+	phaseStart time.Time
+	phases     []phaseTiming
+}
+
+func newReconcileTimer() *reconcileTimer {
+	t := &reconcileTimer{now: time.Now}
+	t.start = t.now()
+	return t
+}
+
+// startPhase ends the current phase and starts the named one.
+func (t *reconcileTimer) startPhase(phase string) {
+	now := t.now()
+	t.endPhase(now)
+	t.phase = phase
+	t.phaseStart = now
+}
+
+func (t *reconcileTimer) endPhase(now time.Time) {
+	if t.phase == "" {
+		return
+	}
+	t.phases = append(t.phases, phaseTiming{phase: t.phase, duration: now.Sub(t.phaseStart)})
+	t.phase = ""
+}
+
+// finish ends the current phase and returns the total reconcile duration.
+func (t *reconcileTimer) finish() time.Duration {
+	now := t.now()
+	t.endPhase(now)
+	return now.Sub(t.start)
+}
+
+// record observes every completed phase in the phase histogram.
+func (t *reconcileTimer) record(m *metrics.ReconcileMetrics) {
+	for _, p := range t.phases {
+		m.RecordReconcilePhase(p.phase, p.duration)
+	}
+}
+
+// breakdown formats the phase timings, e.g. "fetch=2ms validate=15ms sts=41.2s".
+func (t *reconcileTimer) breakdown() string {
+	parts := make([]string, 0, len(t.phases))
+	for _, p := range t.phases {
+		parts = append(parts, fmt.Sprintf("%s=%s", p.phase, p.duration.Round(time.Millisecond)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// reportSlowReconcile emits a warning Event when a reconcile exceeded the
+// configured budget, naming the time spent in each phase.
+func (r *Neo4jEnterpriseClusterReconciler) reportSlowReconcile(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, timer *reconcileTimer, duration time.Duration) {
+	threshold := r.SlowReconcileThreshold
+	if threshold == 0 {
+		threshold = DefaultSlowReconcileThreshold
+	}
+	if threshold < 0 || duration <= threshold {
+		return
+	}
+
+	breakdown := timer.breakdown()
+	log.FromContext(ctx).Info("Slow reconcile", "duration", duration.String(), "threshold", threshold.String(), "phases", breakdown)
+	if r.Recorder != nil {
+		r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonSlowReconcile,
+			"Reconcile took %s (budget %s): %s", duration.Round(time.Millisecond), threshold, breakdown)
+	}
+}
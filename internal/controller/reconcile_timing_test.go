@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// steppedTimer returns a timer whose clock advances by the given steps, one per call.
+func steppedTimer(steps ...time.Duration) *reconcileTimer {
+	now := time.Unix(0, 0)
+	t := &reconcileTimer{now: func() time.Time {
+		if len(steps) > 0 {
+			now = now.Add(steps[0])
+			steps = steps[1:]
+		}
+		return now
+	}}
+	t.start = t.now()
+	return t
+}
+
+func TestReconcileTimer(t *testing.T) {
+	timer := steppedTimer(0, 0, 2*time.Millisecond, 40*time.Second, 5*time.Millisecond)
+	timer.startPhase(ReconcilePhaseFetch)
+	timer.startPhase(ReconcilePhaseValidate)
+	timer.startPhase(ReconcilePhaseStatefulSet)
+	total := timer.finish()
+
+	assert.Equal(t, 40*time.Second+7*time.Millisecond, total)
+	assert.Equal(t, []phaseTiming{
+		{phase: ReconcilePhaseFetch, duration: 2 * time.Millisecond},
+		{phase: ReconcilePhaseValidate, duration: 40 * time.Second},
+		{phase: ReconcilePhaseStatefulSet, duration: 5 * time.Millisecond},
+	}, timer.phases)
+	assert.Equal(t, "fetch=2ms validate=40s sts=5ms", timer.breakdown())
+
+	// finish is idempotent once the last phase has ended
+	timer.finish()
+	assert.Len(t, timer.phases, 3)
+}
+
+func TestReportSlowReconcile(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"}}
+	timer := steppedTimer(0, 0, time.Minute)
+	timer.startPhase(ReconcilePhaseStatefulSet)
+	duration := timer.finish()
+
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		wantEvent bool
+	}{
+		{name: "default budget exceeded", threshold: 0, wantEvent: true},
+		{name: "custom budget not exceeded", threshold: 2 * time.Minute},
+		{name: "disabled", threshold: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			r := &Neo4jEnterpriseClusterReconciler{Recorder: recorder, SlowReconcileThreshold: tt.threshold}
+			r.reportSlowReconcile(context.Background(), cluster, timer, duration)
+
+			if !tt.wantEvent {
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, "Warning SlowReconcile Reconcile took 1m0s (budget 30s): sts=1m0s", <-recorder.Events)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// RenderCluster returns the objects the cluster controller creates for a
+// Neo4jEnterpriseCluster, in the order it creates them, without talking to
+// the API server. Objects that depend on live state are left out: External
+// Secrets, topology constraints derived from the nodes, plugin changes, the
+// spec.lifecycle scripts, which are read from ConfigMaps, and owner
+// references, which need the UID of the stored resource.
+func RenderCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, scheme *runtime.Scheme) ([]client.Object, error) {
+	var objects []client.Object
+	add := func(obj client.Object) {
+		if obj != nil && !reflect.ValueOf(obj).IsNil() {
+			objects = append(objects, obj)
+		}
+	}
+
+	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == "cert-manager" {
+		certificate := resources.BuildCertificateForEnterprise(cluster)
+		add(certificate)
+		for _, policyCertificate := range resources.BuildSSLPolicyCertificates(certificate, cluster.Name, cluster.Spec.TLS, resources.SSLPolicyScopes) {
+			add(policyCertificate)
+		}
+	}
+	add(resources.BuildConfigMapForEnterprise(cluster))
+	for _, configMap := range resources.BuildConfigOverrideConfigMaps(cluster) {
+		add(configMap)
+	}
+	add(resources.BuildDiscoveryServiceAccountForEnterprise(cluster))
+	add(resources.BuildDiscoveryRoleForEnterprise(cluster))
+	add(resources.BuildDiscoveryRoleBindingForEnterprise(cluster))
+	add(resources.BuildHeadlessServiceForEnterprise(cluster))
+	add(resources.BuildDiscoveryServiceForEnterprise(cluster))
+	add(resources.BuildInternalsServiceForEnterprise(cluster))
+	add(resources.BuildClientServiceForEnterprise(cluster))
+	add(resources.BuildMetricsServiceForEnterprise(cluster))
+	add(resources.BuildClientNetworkPolicyForEnterprise(cluster))
+	add(resources.BuildBackupServiceForEnterprise(cluster))
+	add(resources.BuildBackupNetworkPolicyForEnterprise(cluster))
+	if cluster.Spec.Service != nil && cluster.Spec.Service.Ingress != nil && cluster.Spec.Service.Ingress.Enabled {
+		add(resources.BuildIngressForEnterprise(cluster))
+	}
+	add(resources.BuildRouteForEnterprise(cluster))
+	if cluster.Spec.MCP != nil && cluster.Spec.MCP.Enabled {
+		add(resources.BuildMCPServiceForCluster(cluster))
+		add(resources.BuildMCPDeploymentForCluster(cluster))
+		add(resources.BuildMCPIngressForCluster(cluster))
+		add(resources.BuildMCPRouteForCluster(cluster))
+	}
+	add(resources.BuildServerStatefulSetForEnterprise(cluster))
+	for _, sts := range resources.BuildServerGroupStatefulSetsForEnterprise(cluster) {
+		add(sts)
+	}
+	if cluster.Spec.Backups != nil {
+		add(resources.BuildBackupStatefulSet(cluster))
+	}
+
+	return objects, setRenderedKinds(objects, scheme)
+}
+
+// RenderStandalone returns the objects the standalone controller creates for
+// a Neo4jEnterpriseStandalone, in the order it creates them, without talking
+// to the API server. The StatefulSet is rendered as running; the active hours
+// schedule is only applied by the controller.
+func RenderStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone, scheme *runtime.Scheme) ([]client.Object, error) {
+	// The builders of the standalone controller do not use its clients
+	r := &Neo4jEnterpriseStandaloneReconciler{}
+
+	var objects []client.Object
+	add := func(obj client.Object) {
+		if obj != nil && !reflect.ValueOf(obj).IsNil() {
+			objects = append(objects, obj)
+		}
+	}
+
+	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+		certificate := r.createTLSCertificate(standalone)
+		add(certificate)
+		for _, policyCertificate := range resources.BuildSSLPolicyCertificates(certificate, standalone.Name, standalone.Spec.TLS, standaloneSSLPolicyScopes) {
+			add(policyCertificate)
+		}
+	}
+	add(r.createConfigMap(standalone))
+	add(r.createService(standalone))
+	add(resources.BuildClientNetworkPolicy(r.createService(standalone), standalone.Spec.Service))
+	if standalone.Spec.MCP != nil && standalone.Spec.MCP.Enabled {
+		add(resources.BuildMCPServiceForStandalone(standalone))
+		add(resources.BuildMCPDeploymentForStandalone(standalone))
+		add(resources.BuildMCPIngressForStandalone(standalone))
+		add(resources.BuildMCPRouteForStandalone(standalone))
+	}
+	add(r.createStatefulSet(standalone))
+	if standalone.Spec.Service != nil && standalone.Spec.Service.Ingress != nil && standalone.Spec.Service.Ingress.Enabled {
+		add(r.createIngress(standalone))
+	}
+	if standalone.Spec.Route != nil && standalone.Spec.Route.Enabled {
+		add(resources.BuildRouteForStandalone(standalone))
+	}
+
+	return objects, setRenderedKinds(objects, scheme)
+}
+
+// setRenderedKinds fills in the apiVersion and kind of typed objects, which
+// the builders leave empty, so the rendered manifests can be applied as is.
+func setRenderedKinds(objects []client.Object, scheme *runtime.Scheme) error {
+	for _, obj := range objects {
+		if !obj.GetObjectKind().GroupVersionKind().Empty() {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	return nil
+}
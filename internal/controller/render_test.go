@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// newRenderScheme registers the built-in kinds the rendered objects use.
+func newRenderScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = neo4jv1alpha1.AddToScheme(s)
+	return s
+}
+
+// renderedKinds lists the rendered objects as kind/name.
+func renderedKinds(objects []client.Object) []string {
+	var kinds []string
+	for _, obj := range objects {
+		kinds = append(kinds, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName())
+	}
+	return kinds
+}
+
+func TestRenderCluster(t *testing.T) {
+	scheme := newRenderScheme()
+	cluster := minimalCluster("prod", "neo4j")
+
+	objects, err := RenderCluster(cluster, scheme)
+	require.NoError(t, err)
+	kinds := renderedKinds(objects)
+	assert.Equal(t, "ConfigMap/prod-config", kinds[0])
+	assert.Contains(t, kinds, "ServiceAccount/prod-discovery")
+	assert.Contains(t, kinds, "Service/prod-client")
+	assert.Equal(t, "StatefulSet/prod-server", kinds[len(kinds)-1])
+	assert.NotContains(t, kinds, "NetworkPolicy/prod-client-allowlist")
+	for _, obj := range objects {
+		assert.Equal(t, "neo4j", obj.GetNamespace(), obj.GetName())
+		assert.NotEmpty(t, obj.GetObjectKind().GroupVersionKind().Version, obj.GetName())
+		assert.Empty(t, obj.GetOwnerReferences(), obj.GetName())
+	}
+
+	// Optional objects appear once they are configured
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{Type: "NodePort", AllowedCIDRs: []string{"203.0.113.0/24"}}
+	cluster.Spec.MCP = &neo4jv1alpha1.MCPServerSpec{Enabled: true}
+	objects, err = RenderCluster(cluster, scheme)
+	require.NoError(t, err)
+	kinds = renderedKinds(objects)
+	assert.Contains(t, kinds, "NetworkPolicy/prod-client-allowlist")
+	assert.Contains(t, kinds, "Deployment/prod-mcp")
+}
+
+func TestRenderStandalone(t *testing.T) {
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "neo4j"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image:   neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "1Gi"},
+		},
+	}
+
+	objects, err := RenderStandalone(standalone, newRenderScheme())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"ConfigMap/single-config",
+		"Service/single-service",
+		"StatefulSet/single",
+	}, renderedKinds(objects))
+	for _, obj := range objects {
+		assert.Equal(t, "v1", obj.GetObjectKind().GroupVersionKind().Version, obj.GetName())
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// restoreMetadataScript is where neo4j-admin database restore leaves the
+// Cypher that recreates the users, roles and privileges of the database.
+func restoreMetadataScript(database string) string {
+	return fmt.Sprintf("/data/scripts/%s/restore_metadata.cypher", database)
+}
+
+func restoreSecurityEnabled(restore *neo4jv1alpha1.Neo4jRestore) bool {
+	return restore.Spec.Options != nil && restore.Spec.Options.RestoreSecurity
+}
+
+// withSecurityReplay runs the restored metadata script against the system
+// database of the target cluster once the restore command succeeded. The
+// script takes the database name as $database; a backup without metadata
+// fails the job rather than silently skipping the auth model.
+func withSecurityReplay(restore *neo4jv1alpha1.Neo4jRestore, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, cmd string) string {
+	if !restoreSecurityEnabled(restore) {
+		return cmd
+	}
+	script := restoreMetadataScript(restore.Spec.DatabaseName)
+	uri := fmt.Sprintf("%s://%s-client.%s.svc.cluster.local:7687", workloadURIScheme("neo4j", cluster.Spec.TLS), cluster.Name, cluster.Namespace)
+	replay := strings.Join([]string{
+		fmt.Sprintf(`{ test -f %s || { echo "backup contains no security metadata (%s)" >&2; exit 1; }; }`, script, script),
+		fmt.Sprintf(`cypher-shell -a %s -u "$NEO4J_ADMIN_USERNAME" -p "$NEO4J_ADMIN_PASSWORD" -d system --param "database => '%s'" -f %s`,
+			uri, restore.Spec.DatabaseName, script),
+	}, " && ")
+	return cmd + " && " + replay
+}
+
+// restoreSecurityEnvVars adds the admin username the replay connects with.
+func restoreSecurityEnvVars(restore *neo4jv1alpha1.Neo4jRestore, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []corev1.EnvVar {
+	if !restoreSecurityEnabled(restore) {
+		return nil
+	}
+	return []corev1.EnvVar{{
+		Name: "NEO4J_ADMIN_USERNAME",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: workloadAdminSecret(cluster.Spec.Auth)},
+				Key:                  "username",
+			},
+		},
+	}}
+}
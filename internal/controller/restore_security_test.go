@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func securityRestore() *neo4jv1alpha1.Neo4jRestore {
+	return &neo4jv1alpha1.Neo4jRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "recover", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jRestoreSpec{
+			TargetCluster: "prod",
+			DatabaseName:  "orders",
+			Source:        neo4jv1alpha1.RestoreSource{Type: "s3", BackupPath: "s3://backups/orders"},
+			Options:       &neo4jv1alpha1.RestoreOptionsSpec{RestoreSecurity: true},
+		},
+	}
+}
+
+func TestWithSecurityReplay(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin"}
+	restore := securityRestore()
+
+	cmd := withSecurityReplay(restore, cluster, "neo4j-admin database restore --from-path=s3://backups/orders orders")
+	assert.Contains(t, cmd, "neo4j-admin database restore --from-path=s3://backups/orders orders && ")
+	assert.Contains(t, cmd, "test -f /data/scripts/orders/restore_metadata.cypher")
+	assert.Contains(t, cmd, `cypher-shell -a neo4j://prod-client.default.svc.cluster.local:7687 -u "$NEO4J_ADMIN_USERNAME" -p "$NEO4J_ADMIN_PASSWORD" -d system --param "database => 'orders'" -f /data/scripts/orders/restore_metadata.cypher`)
+
+	env := restoreSecurityEnvVars(restore, cluster)
+	require.Len(t, env, 1)
+	assert.Equal(t, "NEO4J_ADMIN_USERNAME", env[0].Name)
+	assert.Equal(t, "prod-admin", env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "username", env[0].ValueFrom.SecretKeyRef.Key)
+
+	// cert-manager clusters accept their self-signed certificate
+	cluster.Spec.TLS = &neo4jv1alpha1.TLSSpec{Mode: "cert-manager"}
+	assert.Contains(t, withSecurityReplay(restore, cluster, "restore"), "neo4j+ssc://prod-client")
+
+	restore.Spec.Options.RestoreSecurity = false
+	assert.Equal(t, "restore", withSecurityReplay(restore, cluster, "restore"))
+	assert.Empty(t, restoreSecurityEnvVars(restore, cluster))
+}
+
+func TestValidateRestore_RestoreSecurityNeedsOnlineRestore(t *testing.T) {
+	r := &Neo4jRestoreReconciler{}
+	restore := securityRestore()
+	require.NoError(t, r.validateRestore(context.Background(), restore))
+
+	restore.Spec.StopCluster = true
+	err := r.validateRestore(context.Background(), restore)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "restoreSecurity requires an online restore")
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// rollingRestartRequeueInterval is how often a rolling restart checks
+// whether the last restarted server is back
+const rollingRestartRequeueInterval = 10 * time.Second
+
+// What a rolling restart rolls out
+const (
+	rollingRestartReasonConfigChange = "ConfigChange"
+	rollingRestartReasonResize       = "Resize"
+	rollingRestartReasonCertificates = "Certificates"
+)
+
+// rollingRestartClient is the part of the Neo4j client rolling restarts use
+type rollingRestartClient interface {
+	GetServerMembers(ctx context.Context) ([]neo4jclient.ServerMember, error)
+	GetDatabaseLeaders(ctx context.Context) (map[string][]string, error)
+	TransferLeadership(ctx context.Context, database, serverID string) error
+}
+
+// reconcileRollingRestart restarts the server pods that still run an older
+// revision of the StatefulSet, one at a time. Pods leading no database go
+// first; a leader has its leaderships handed to an already restarted server
+// before it is deleted. Every step waits until all pods are ready and all
+// servers are available again. It returns true while the restart is in
+// progress.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileRollingRestart(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	restart := cluster.Status.RollingRestart
+	if restart == nil {
+		return false, nil
+	}
+	logger := log.FromContext(ctx)
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-server", cluster.Name), Namespace: cluster.Namespace}, sts); err != nil {
+		if errors.IsNotFound(err) {
+			return false, r.updateRollingRestart(ctx, cluster, nil)
+		}
+		return true, fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision == "" {
+		return true, r.setRollingRestartMessage(ctx, cluster, "Waiting for the StatefulSet controller to observe the new revision")
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return true, fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	var outdated, held []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || !isPodReady(pod) {
+			return true, r.setRollingRestartMessage(ctx, cluster, fmt.Sprintf("Waiting for %s to become ready", pod.Name))
+		}
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] == sts.Status.UpdateRevision {
+			continue
+		}
+		if slices.Contains(cluster.Status.MaintenanceServers, pod.Name) {
+			held = append(held, pod.Name)
+			continue
+		}
+		outdated = append(outdated, pod.Name)
+	}
+	if int32(len(pods.Items)) < replicas {
+		return true, r.setRollingRestartMessage(ctx, cluster, fmt.Sprintf("Waiting for %d server pods, found %d", replicas, len(pods.Items)))
+	}
+	if len(outdated) == 0 {
+		if len(held) > 0 {
+			slices.Sort(held)
+			return true, r.setRollingRestartMessage(ctx, cluster,
+				fmt.Sprintf("Waiting for %s to leave maintenance", strings.Join(held, ", ")))
+		}
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonRollingRestartCompleted,
+			"All servers restarted with "+rolloutDescription(restart))
+		return false, r.updateRollingRestart(ctx, cluster, nil)
+	}
+
+	neo4jClient, closeClient, err := r.connectForRollingRestart(ctx, cluster)
+	if err != nil {
+		return true, err
+	}
+	defer closeClient()
+
+	members, err := neo4jClient.GetServerMembers(ctx)
+	if err != nil {
+		return true, err
+	}
+	for i := range pods.Items {
+		pod := pods.Items[i].Name
+		if slices.Contains(cluster.Status.MaintenanceServers, pod) {
+			continue
+		}
+		member := memberForPod(members, pod)
+		if member == nil || member.Health != "Available" {
+			return true, r.setRollingRestartMessage(ctx, cluster, fmt.Sprintf("Waiting for the server of %s to become available", pod))
+		}
+	}
+
+	leaders, err := neo4jClient.GetDatabaseLeaders(ctx)
+	if err != nil {
+		return true, err
+	}
+	next := nextPodToRestart(outdated, leaders)
+
+	if led := databasesLedBy(leaders, next); len(led) > 0 && restart.LeadershipTransferredFrom != next {
+		if target := leadershipTarget(members, next, outdated, cluster.Status.MaintenanceServers); target != nil {
+			for _, database := range led {
+				if err := neo4jClient.TransferLeadership(ctx, database, target.ID); err != nil {
+					// Neo4j still hands leadership over when the server shuts down
+					logger.Info("Could not transfer leadership ahead of restart", "pod", next, "database", database, "error", err)
+				}
+			}
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonLeadershipTransferred,
+				fmt.Sprintf("Leadership of %s moved off %s ahead of its restart", strings.Join(led, ", "), next))
+			return true, r.updateRollingRestart(ctx, cluster, func(restart *neo4jv1alpha1.RollingRestartStatus) {
+				restart.LeadershipTransferredFrom = next
+				restart.Message = fmt.Sprintf("Transferring leadership of %s off %s", strings.Join(led, ", "), next)
+			})
+		}
+	}
+
+	if restart.LastRestartedPod == "" {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonRollingRestartStarted,
+			fmt.Sprintf("Restarting %d servers for %s %s", len(outdated), rolloutDescription(restart), restart.ConfigHash))
+	}
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, client.ObjectKey{Name: next, Namespace: cluster.Namespace}, pod); err != nil {
+		return true, client.IgnoreNotFound(err)
+	}
+	if err := r.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); err != nil && !errors.IsNotFound(err) {
+		return true, fmt.Errorf("failed to restart pod %s: %w", next, err)
+	}
+	logger.Info("Restarted server pod", "pod", next, "reason", restart.Reason, "remaining", len(outdated)-1)
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServerRestarted,
+		fmt.Sprintf("Restarted %s for %s", next, rolloutDescription(restart)))
+	return true, r.updateRollingRestart(ctx, cluster, func(restart *neo4jv1alpha1.RollingRestartStatus) {
+		restart.LastRestartedPod = next
+		restart.LeadershipTransferredFrom = ""
+		restart.Message = fmt.Sprintf("Restarting %s, %d servers left", next, len(outdated)-1)
+	})
+}
+
+// rolloutDescription names what a rolling restart rolls out
+func rolloutDescription(restart *neo4jv1alpha1.RollingRestartStatus) string {
+	switch restart.Reason {
+	case rollingRestartReasonResize:
+		return "the new resources"
+	case rollingRestartReasonCertificates:
+		return "the new server certificates"
+	}
+	return "the new configuration"
+}
+
+// nextPodToRestart picks the pod leading the fewest databases, the highest
+// ordinal first among equals
+func nextPodToRestart(outdated []string, leaders map[string][]string) string {
+	slices.SortFunc(outdated, func(a, b string) int { return int(podOrdinal(b) - podOrdinal(a)) })
+	next := outdated[0]
+	for _, pod := range outdated[1:] {
+		if len(databasesLedBy(leaders, pod)) < len(databasesLedBy(leaders, next)) {
+			next = pod
+		}
+	}
+	return next
+}
+
+// databasesLedBy returns the databases whose leader runs in a pod
+func databasesLedBy(leaders map[string][]string, pod string) []string {
+	var databases []string
+	for address, led := range leaders {
+		if podForAddress(address) == pod {
+			databases = append(databases, led...)
+		}
+	}
+	slices.Sort(databases)
+	return databases
+}
+
+// leadershipTarget picks the server that takes over the leaderships of a
+// pod: an available one that was restarted already, or any other available
+// one when none was.
+func leadershipTarget(members []neo4jclient.ServerMember, pod string, outdated, maintenance []string) *neo4jclient.ServerMember {
+	var fallback *neo4jclient.ServerMember
+	for i := range members {
+		member := &members[i]
+		memberPod := podForAddress(member.Address)
+		if memberPod == pod || member.Health != "Available" || member.State != "Enabled" || slices.Contains(maintenance, memberPod) {
+			continue
+		}
+		if !slices.Contains(outdated, memberPod) {
+			return member
+		}
+		if fallback == nil {
+			fallback = member
+		}
+	}
+	return fallback
+}
+
+// memberForPod returns the server running in a pod
+func memberForPod(members []neo4jclient.ServerMember, pod string) *neo4jclient.ServerMember {
+	for i := range members {
+		if podForAddress(members[i].Address) == pod {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+// connectForRollingRestart opens a Neo4j connection for a rolling restart
+func (r *Neo4jEnterpriseClusterReconciler) connectForRollingRestart(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (rollingRestartClient, func(), error) {
+	if r.newRollingRestartClient != nil {
+		c, err := r.newRollingRestartClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+// setRollingRestartMessage records what the rolling restart waits for
+func (r *Neo4jEnterpriseClusterReconciler) setRollingRestartMessage(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, message string) error {
+	return r.updateRollingRestart(ctx, cluster, func(restart *neo4jv1alpha1.RollingRestartStatus) {
+		restart.Message = message
+	})
+}
+
+// updateRollingRestart applies mutate to status.rollingRestart, or clears it
+// when mutate is nil. A restart recorded for a newer configuration in the
+// meantime is not cleared.
+func (r *Neo4jEnterpriseClusterReconciler) updateRollingRestart(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, mutate func(*neo4jv1alpha1.RollingRestartStatus)) error {
+	configHash := cluster.Status.RollingRestart.ConfigHash
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		current := latest.Status.RollingRestart
+		if current == nil {
+			cluster.Status.RollingRestart = nil
+			return nil
+		}
+		var desired *neo4jv1alpha1.RollingRestartStatus
+		if mutate != nil {
+			desired = current.DeepCopy()
+			mutate(desired)
+		} else if current.ConfigHash != configHash {
+			desired = current
+		}
+		if equality.Semantic.DeepEqual(current, desired) {
+			cluster.Status.RollingRestart = current
+			return nil
+		}
+		latest.Status.RollingRestart = desired
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.RollingRestart = latest.Status.RollingRestart
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
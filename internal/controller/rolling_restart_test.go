@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// fakeRollingRestartClient models the database leaders of a cluster
+type fakeRollingRestartClient struct {
+	members   []neo4jclient.ServerMember
+	leaders   map[string][]string
+	transfers []string
+}
+
+func (c *fakeRollingRestartClient) GetServerMembers(_ context.Context) ([]neo4jclient.ServerMember, error) {
+	return c.members, nil
+}
+
+func (c *fakeRollingRestartClient) GetDatabaseLeaders(_ context.Context) (map[string][]string, error) {
+	return c.leaders, nil
+}
+
+func (c *fakeRollingRestartClient) TransferLeadership(_ context.Context, database, serverID string) error {
+	c.transfers = append(c.transfers, database+"->"+serverID)
+	for address, led := range c.leaders {
+		for i, name := range led {
+			if name == database {
+				c.leaders[address] = append(led[:i], led[i+1:]...)
+				break
+			}
+		}
+	}
+	for _, member := range c.members {
+		if member.ID == serverID {
+			c.leaders[member.Address] = append(c.leaders[member.Address], database)
+		}
+	}
+	return nil
+}
+
+func restartTestPod(name, revision string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{
+			"neo4j.com/cluster":                   "prod",
+			"neo4j.com/server-name":               "server",
+			appsv1.ControllerRevisionHashLabelKey: revision,
+		}},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func restartTestMember(id, pod string) neo4jclient.ServerMember {
+	return neo4jclient.ServerMember{
+		ID:      id,
+		Name:    id,
+		Address: pod + ".prod-internals.default.svc.cluster.local:7687",
+		State:   "Enabled",
+		Health:  "Available",
+	}
+}
+
+func TestReconcileRollingRestart(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	cluster.Status.RollingRestart = &neo4jv1alpha1.RollingRestartStatus{ConfigHash: "abc123"}
+	sts := serverSTS("prod", "default")
+	sts.Spec.Replicas = int32PtrCM(3)
+	sts.Status.UpdateRevision = "prod-server-new"
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, sts,
+			restartTestPod("prod-server-0", "prod-server-old"),
+			restartTestPod("prod-server-1", "prod-server-old"),
+			restartTestPod("prod-server-2", "prod-server-old")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	fakeClient := &fakeRollingRestartClient{
+		members: []neo4jclient.ServerMember{
+			restartTestMember("id-0", "prod-server-0"),
+			restartTestMember("id-1", "prod-server-1"),
+			restartTestMember("id-2", "prod-server-2"),
+		},
+		leaders: map[string][]string{
+			"prod-server-0.prod-internals.default.svc.cluster.local:7687": {"neo4j", "system"},
+		},
+	}
+	// Renamed servers: dbms.cluster.switchLeader only accepts the server ID
+	for i := range fakeClient.members {
+		fakeClient.members[i].Name = fmt.Sprintf("server-%d", i)
+	}
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: record.NewFakeRecorder(20),
+		newRollingRestartClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (rollingRestartClient, error) {
+			return fakeClient, nil
+		},
+	}
+	ctx := context.Background()
+
+	reconcile := func() bool {
+		t.Helper()
+		restarting, err := r.reconcileRollingRestart(ctx, cluster)
+		require.NoError(t, err)
+		return restarting
+	}
+	deleted := func(name string) bool {
+		t.Helper()
+		err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &corev1.Pod{})
+		return errors.IsNotFound(err)
+	}
+
+	// Followers go first, highest ordinal first
+	assert.True(t, reconcile())
+	assert.True(t, deleted("prod-server-2"))
+	assert.Equal(t, "prod-server-2", cluster.Status.RollingRestart.LastRestartedPod)
+
+	// Nothing else restarts until the pod is back
+	assert.True(t, reconcile())
+	assert.Equal(t, "Waiting for 3 server pods, found 2", cluster.Status.RollingRestart.Message)
+	require.NoError(t, c.Create(ctx, restartTestPod("prod-server-2", "prod-server-new")))
+	fakeClient.members[2].Health = "Unavailable"
+	assert.True(t, reconcile())
+	assert.False(t, deleted("prod-server-1"))
+	assert.Equal(t, "Waiting for the server of prod-server-2 to become available", cluster.Status.RollingRestart.Message)
+	fakeClient.members[2].Health = "Available"
+
+	assert.True(t, reconcile())
+	assert.True(t, deleted("prod-server-1"))
+	require.NoError(t, c.Create(ctx, restartTestPod("prod-server-1", "prod-server-new")))
+
+	// The leader hands its databases to a restarted server first
+	assert.True(t, reconcile())
+	assert.False(t, deleted("prod-server-0"))
+	assert.Equal(t, []string{"neo4j->id-1", "system->id-1"}, fakeClient.transfers)
+	assert.Equal(t, "prod-server-0", cluster.Status.RollingRestart.LeadershipTransferredFrom)
+
+	assert.True(t, reconcile())
+	assert.True(t, deleted("prod-server-0"))
+	require.NoError(t, c.Create(ctx, restartTestPod("prod-server-0", "prod-server-new")))
+
+	assert.False(t, reconcile())
+	assert.Nil(t, cluster.Status.RollingRestart)
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Nil(t, latest.Status.RollingRestart)
+}
+
+func TestNextPodToRestart(t *testing.T) {
+	leaders := map[string][]string{
+		"prod-server-2.prod-internals.default.svc.cluster.local:7687": {"neo4j"},
+		"prod-server-0.prod-internals.default.svc.cluster.local:7687": {"system", "orders"},
+	}
+	assert.Equal(t, "prod-server-1", nextPodToRestart([]string{"prod-server-0", "prod-server-1", "prod-server-2"}, leaders))
+	assert.Equal(t, "prod-server-2", nextPodToRestart([]string{"prod-server-0", "prod-server-2"}, leaders))
+	assert.Equal(t, "prod-server-0", nextPodToRestart([]string{"prod-server-0"}, leaders))
+}
@@ -25,8 +25,10 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -34,12 +36,17 @@ import (
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
 	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // RollingUpgradeOrchestrator handles intelligent rolling upgrades for Neo4j clusters
 type RollingUpgradeOrchestrator struct {
 	client.Client
 	upgradeMetrics *metrics.UpgradeMetrics
+
+	// Recorder emits an Event for every step of the upgrade, skipped when nil
+	Recorder record.EventRecorder
 }
 
 // NewRollingUpgradeOrchestrator creates a new rolling upgrade orchestrator
@@ -240,10 +247,18 @@ func (r *RollingUpgradeOrchestrator) upgradeServers(
 		logger.Info("Could not determine system DB primary address, using ordinal 0 as roll-last position", "error", err)
 	}
 
-	newImage := fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag)
+	newImage := resources.ImageReference(cluster.Spec.Image)
+	// A canary upgrade leaves the target image staged with the canary above
+	// the partition; the roll then continues below it
+	start := replicas
 	if serverSts.Spec.Template.Spec.Containers[0].Image == newImage {
-		logger.Info("Server StatefulSet already has target image")
-		return nil
+		rollingUpdate := serverSts.Spec.UpdateStrategy.RollingUpdate
+		if rollingUpdate == nil || rollingUpdate.Partition == nil || *rollingUpdate.Partition == 0 {
+			logger.Info("Server StatefulSet already has target image")
+			return nil
+		}
+		start = min(*rollingUpdate.Partition, replicas)
+		logger.Info("Continuing upgrade below the partition", "partition", start)
 	}
 	timeout := r.getUpgradeTimeout(cluster)
 	// Per-pod timeout for the Neo4j cluster-membership health check that follows
@@ -253,26 +268,28 @@ func (r *RollingUpgradeOrchestrator) upgradeServers(
 
 	// Prime the StatefulSet with the target image and freeze all pod restarts by
 	// setting partition = replicas.  No pod will restart until we lower the partition.
-	serverSts, err = r.updateServerStatefulSet(ctx, cluster, func(sts *appsv1.StatefulSet) {
-		sts.Spec.Template.Spec.Containers[0].Image = newImage
-		if sts.Spec.Template.Annotations == nil {
-			sts.Spec.Template.Annotations = make(map[string]string)
-		}
-		sts.Spec.Template.Annotations["neo4j.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
+	if start == replicas {
+		serverSts, err = r.updateServerStatefulSet(ctx, cluster, func(sts *appsv1.StatefulSet) {
+			sts.Spec.Template.Spec.Containers[0].Image = newImage
+			if sts.Spec.Template.Annotations == nil {
+				sts.Spec.Template.Annotations = make(map[string]string)
+			}
+			sts.Spec.Template.Annotations["neo4j.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
 
-		if sts.Spec.UpdateStrategy.RollingUpdate == nil {
-			sts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+			if sts.Spec.UpdateStrategy.RollingUpdate == nil {
+				sts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+			}
+			partition := replicas
+			sts.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
+		})
+		if err != nil {
+			return fmt.Errorf("failed to stage server StatefulSet for upgrade: %w", err)
 		}
-		partition := replicas
-		sts.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
-	})
-	if err != nil {
-		return fmt.Errorf("failed to stage server StatefulSet for upgrade: %w", err)
 	}
 
 	// Roll pods from highest ordinal down to 1, skipping the leader (ordinal 0).
 	// Each step lowers the partition by one, causing exactly one new pod to restart.
-	for ord := replicas - 1; ord > int32(leaderOrdinal); ord-- {
+	for ord := start - 1; ord > int32(leaderOrdinal); ord-- {
 		if err := r.updatePartitionAndWait(ctx, cluster, ord, timeout); err != nil {
 			return fmt.Errorf("failed to roll ordinal %d: %w", ord, err)
 		}
@@ -449,6 +466,10 @@ func (r *RollingUpgradeOrchestrator) updateUpgradeStatus(
 	if cluster.Status.UpgradeStatus == nil {
 		return
 	}
+	// Started, completed and failed upgrades have Events of their own
+	if phase == "InProgress" && r.Recorder != nil && currentStep != cluster.Status.UpgradeStatus.CurrentStep {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonUpgradeProgressing, currentStep)
+	}
 
 	cluster.Status.UpgradeStatus.Phase = phase
 	cluster.Status.UpgradeStatus.CurrentStep = currentStep
@@ -479,164 +500,13 @@ func (r *RollingUpgradeOrchestrator) validateVersionCompatibility(currentVersion
 		return nil
 	}
 
-	// Parse current and target versions
-	current := r.parseVersion(currentVersion)
-	target := r.parseVersion(targetVersion)
-
-	if current == nil || target == nil {
+	current, currentErr := version.Parse(currentVersion)
+	target, targetErr := version.Parse(targetVersion)
+	if currentErr != nil || targetErr != nil {
 		return fmt.Errorf("invalid version format (current=%q, target=%q)", currentVersion, targetVersion)
 	}
 
-	// Prevent downgrades
-	if r.isDowngrade(current, target) {
-		return fmt.Errorf("downgrades are not supported (current: %s, target: %s)", currentVersion, targetVersion)
-	}
-
-	// Validate upgrade path based on versioning scheme
-	if r.isCalVer(current) && r.isCalVer(target) {
-		// CalVer to CalVer upgrade (2025.x.x -> 2025.y.y or 2026.x.x)
-		return r.validateCalVerUpgrade(current, target, currentVersion, targetVersion)
-	} else if r.isSemVer(current) && r.isSemVer(target) {
-		// SemVer to SemVer upgrade (5.x.x -> 5.y.y)
-		return r.validateSemVerUpgrade(current, target, currentVersion, targetVersion)
-	} else if r.isSemVer(current) && r.isCalVer(target) {
-		// SemVer to CalVer upgrade (5.x.x -> 2025.x.x)
-		return r.validateSemVerToCalVerUpgrade(current, target, currentVersion, targetVersion)
-	} else {
-		// CalVer to SemVer (not supported)
-		return fmt.Errorf("downgrade from CalVer to SemVer is not supported")
-	}
-}
-
-// Version parsing and validation helper methods
-func (r *RollingUpgradeOrchestrator) parseVersion(version string) *VersionInfo {
-	// Remove any prefix like "v" and suffixes like "-enterprise"
-	version = strings.TrimPrefix(version, "v")
-	if idx := strings.Index(version, "-"); idx != -1 {
-		version = version[:idx]
-	}
-
-	parts := strings.Split(version, ".")
-	if len(parts) < 2 {
-		return nil
-	}
-
-	major, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return nil
-	}
-
-	minor, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return nil
-	}
-
-	patch := 0
-	if len(parts) > 2 {
-		if p, err := strconv.Atoi(parts[2]); err == nil {
-			patch = p
-		}
-	}
-
-	return &VersionInfo{
-		Major: major,
-		Minor: minor,
-		Patch: patch,
-	}
-}
-
-func (r *RollingUpgradeOrchestrator) isDowngrade(current, target *VersionInfo) bool {
-	// For CalVer (year-based)
-	if r.isCalVer(current) && r.isCalVer(target) {
-		if target.Major < current.Major {
-			return true
-		}
-		if target.Major == current.Major && target.Minor < current.Minor {
-			return true
-		}
-		if target.Major == current.Major && target.Minor == current.Minor && target.Patch < current.Patch {
-			return true
-		}
-		return false
-	}
-
-	// For SemVer or mixed comparison
-	if target.Major < current.Major {
-		return true
-	}
-	if target.Major == current.Major && target.Minor < current.Minor {
-		return true
-	}
-	if target.Major == current.Major && target.Minor == current.Minor && target.Patch < current.Patch {
-		return true
-	}
-
-	// Special case: CalVer to SemVer is always a downgrade
-	if r.isCalVer(current) && r.isSemVer(target) {
-		return true
-	}
-
-	return false
-}
-
-func (r *RollingUpgradeOrchestrator) isCalVer(version *VersionInfo) bool {
-	return version.Major >= 2025
-}
-
-func (r *RollingUpgradeOrchestrator) isSemVer(version *VersionInfo) bool {
-	return version.Major >= 4 && version.Major <= 10 // Neo4j 4.x, 5.x
-}
-
-func (r *RollingUpgradeOrchestrator) validateCalVerUpgrade(current, target *VersionInfo, currentStr, targetStr string) error {
-	// Allow upgrades within same year (patch/minor)
-	if current.Major == target.Major {
-		return nil // 2025.1.0 -> 2025.1.1 or 2025.1.0 -> 2025.2.0
-	}
-
-	// Allow upgrades to newer years
-	if target.Major > current.Major {
-		return nil // 2025.x.x -> 2026.x.x
-	}
-
-	return fmt.Errorf("unsupported CalVer upgrade path from %s to %s", currentStr, targetStr)
-}
-
-func (r *RollingUpgradeOrchestrator) validateSemVerUpgrade(current, target *VersionInfo, currentStr, targetStr string) error {
-	// Only allow upgrades within same major version
-	if target.Major != current.Major {
-		return fmt.Errorf("major version upgrades are not supported")
-	}
-
-	// Only patch upgrades within 5.26.x are supported (last semver LTS; no 5.27+ exists)
-	if current.Major == 5 && target.Major == 5 {
-		if current.Minor == 26 && target.Minor == 26 {
-			return nil // Allow patch upgrades within 5.26.x
-		}
-		return fmt.Errorf("only Neo4j 5.26.x (last semver LTS) or 2025.x.x (CalVer) versions are supported")
-	}
-
-	// Neo4j 4.x is no longer supported
-	if current.Major == 4 || target.Major == 4 {
-		return fmt.Errorf("Neo4j 4.x versions are not supported - only 5.26.x (last semver LTS) or 2025.x.x (CalVer) versions are supported")
-	}
-
-	return fmt.Errorf("unsupported SemVer upgrade path from %s to %s", currentStr, targetStr)
-}
-
-func (r *RollingUpgradeOrchestrator) validateSemVerToCalVerUpgrade(current, _ *VersionInfo, currentStr, targetStr string) error {
-	// Only 5.26.x (last semver LTS) may upgrade to CalVer 2025.x.x
-	if current.Major == 5 && current.Minor == 26 {
-		return nil // 5.26.x -> 2025.x.x is the only supported semver-to-calver path
-	}
-
-	return fmt.Errorf("upgrade from %s to CalVer %s requires Neo4j 5.26.x (last semver LTS)", currentStr, targetStr)
-}
-
-// VersionInfo represents parsed version information
-type VersionInfo struct {
-	Major int
-	Minor int
-	Patch int
+	return version.CheckUpgrade(current, target)
 }
 
 func (r *RollingUpgradeOrchestrator) validateStatefulSetsReady(
@@ -806,33 +676,30 @@ func (r *RollingUpgradeOrchestrator) verifyVersionUpgrade(
 
 	logger.Info("Verifying cluster version after upgrade", "targetVersion", targetVersion)
 
-	// Get cluster overview to check versions of all members
-	members, err := neo4jClient.GetClusterOverview(ctx)
+	// SHOW SERVERS reports the version each server runs
+	servers, err := neo4jClient.ShowServers(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get cluster overview for version verification: %w", err)
+		return fmt.Errorf("failed to list servers for version verification: %w", err)
 	}
 
-	if len(members) == 0 {
+	if len(servers) == 0 {
 		return fmt.Errorf("no cluster members found during version verification")
 	}
 
 	// Verify each member is running the target version
 	var versionMismatches []string
-	for _, member := range members {
-		// Query the specific member for its version
-		memberVersion, err := r.getMemberVersion(ctx, neo4jClient, member.ID)
-		if err != nil {
-			logger.Error(err, "Failed to get version for member", "memberID", member.ID)
-			versionMismatches = append(versionMismatches, member.ID+": version query failed")
+	for _, server := range servers {
+		if server.Version == "" {
+			versionMismatches = append(versionMismatches, server.Name+": version not reported")
 			continue
 		}
 
 		// Compare versions (normalize for comparison)
-		if !r.versionsMatch(memberVersion, targetVersion) {
+		if !r.versionsMatch(server.Version, targetVersion) {
 			versionMismatches = append(versionMismatches,
-				fmt.Sprintf("%s: running %s, expected %s", member.ID, memberVersion, targetVersion))
+				fmt.Sprintf("%s: running %s, expected %s", server.Name, server.Version, targetVersion))
 		} else {
-			logger.Info("Member version verified", "memberID", member.ID, "version", memberVersion)
+			logger.Info("Member version verified", "server", server.Name, "version", server.Version)
 		}
 	}
 
@@ -850,93 +717,10 @@ func (r *RollingUpgradeOrchestrator) verifyVersionUpgrade(
 	}
 
 	logger.Info("Version verification completed successfully",
-		"targetVersion", targetVersion, "verifiedMembers", len(members))
+		"targetVersion", targetVersion, "verifiedMembers", len(servers))
 	return nil
 }
 
-// getMemberVersion queries a specific cluster member for its Neo4j version
-func (r *RollingUpgradeOrchestrator) getMemberVersion(ctx context.Context, neo4jClient *neo4jclient.Client, memberID string) (string, error) {
-	// Query Neo4j for version information
-	// This uses a system query to get the version
-	query := "CALL dbms.components() YIELD name, versions, edition WHERE name = 'Neo4j Kernel' RETURN versions[0] as version"
-	result, err := neo4jClient.ExecuteQuery(ctx, query)
-	if err != nil {
-		return "", fmt.Errorf("failed to query version from member %s: %w", memberID, err)
-	}
-
-	// Parse the result to extract version
-	version := r.parseVersionFromQueryResult(result)
-	if version == "" {
-		return "", fmt.Errorf("could not parse version from query result for member %s", memberID)
-	}
-
-	return version, nil
-}
-
-// parseVersionFromQueryResult extracts version string from Neo4j query result
-func (r *RollingUpgradeOrchestrator) parseVersionFromQueryResult(result string) string {
-	// In a real implementation, this would properly parse the JSON/tabular result
-	// For now, use a simple approach to extract version patterns
-	lines := strings.Split(result, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		// Look for version patterns like "5.26.0", "2025.01.0", etc.
-		if r.isVersionString(line) {
-			return line
-		}
-
-		// Also check if the line contains a version (e.g., "version: 5.26.0")
-		if strings.Contains(line, ":") {
-			parts := strings.Split(line, ":")
-			if len(parts) >= 2 {
-				potentialVersion := strings.TrimSpace(parts[1])
-				if r.isVersionString(potentialVersion) {
-					return potentialVersion
-				}
-			}
-		}
-	}
-
-	// If no version found, try to extract from anywhere in the result
-	// This is a fallback for different result formats
-	words := strings.Fields(result)
-	for _, word := range words {
-		if r.isVersionString(word) {
-			return word
-		}
-	}
-
-	return ""
-}
-
-// isVersionString checks if a string looks like a version number
-func (r *RollingUpgradeOrchestrator) isVersionString(s string) bool {
-	if s == "" {
-		return false
-	}
-
-	// Remove quotes if present
-	s = strings.Trim(s, `"'`)
-
-	// Check for semantic version pattern (X.Y.Z)
-	parts := strings.Split(s, ".")
-	if len(parts) >= 2 && len(parts) <= 4 {
-		for _, part := range parts {
-			if part == "" {
-				return false
-			}
-			// Check if each part is numeric (allowing for pre-release suffixes)
-			numPart := strings.Split(part, "-")[0] // Remove pre-release suffix
-			if _, err := strconv.Atoi(numPart); err != nil {
-				return false
-			}
-		}
-		return true
-	}
-
-	return false
-}
-
 // versionsMatch compares two version strings for equality, handling various formats
 func (r *RollingUpgradeOrchestrator) versionsMatch(actual, expected string) bool {
 	// Normalize versions by removing quotes and whitespace
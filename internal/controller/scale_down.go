@@ -0,0 +1,281 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// scaleDownRequeueInterval is how often a running scale-down is checked
+const scaleDownRequeueInterval = 15 * time.Second
+
+// Departing server states recorded in status.scaleDown
+const (
+	departingServerDeallocating = "Deallocating"
+	departingServerDrained      = "Drained"
+	departingServerDropped      = "Dropped"
+)
+
+// scaleDownClient is the part of the Neo4j client a scale-down uses
+type scaleDownClient interface {
+	GetServerList(ctx context.Context) ([]neo4jclient.ServerInfo, error)
+	DeallocateServer(ctx context.Context, server string) error
+	DropServer(ctx context.Context, server string) error
+}
+
+// reconcileScaleDown keeps the server StatefulSet at its current size until
+// the servers of the departing pods host nothing but the system database,
+// so shrinking spec.topology.servers never removes a pod that still holds
+// an allocation. Once the pods are gone their servers are dropped. It
+// returns true while the scale-down still needs attention.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileScaleDown(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, statefulSet *appsv1.StatefulSet) (bool, error) {
+	existing := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(statefulSet), existing); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	current := int32(1)
+	if existing.Spec.Replicas != nil {
+		current = *existing.Spec.Replicas
+	}
+	desired := *statefulSet.Spec.Replicas
+	if current <= desired && cluster.Status.ScaleDown == nil {
+		return false, nil
+	}
+
+	neo4jClient, closeClient, err := r.connectForScaleDown(ctx, cluster)
+	if current > desired {
+		// Hold the pods until their databases have moved
+		statefulSet.Spec.Replicas = &current
+	}
+	if err != nil {
+		return true, err
+	}
+	defer closeClient()
+
+	if current > desired {
+		var pods []string
+		for ordinal := desired; ordinal < current; ordinal++ {
+			pods = append(pods, fmt.Sprintf("%s-server-%d", cluster.Name, ordinal))
+		}
+		servers, drained, err := drainDepartingServers(ctx, neo4jClient, pods)
+		message := fmt.Sprintf("Moving databases off %s", strings.Join(pods, ", "))
+		if err != nil {
+			message = err.Error()
+		} else if drained {
+			message = fmt.Sprintf("Removing %s", strings.Join(pods, ", "))
+		}
+		if statusErr := r.setScaleDownStatus(ctx, cluster, desired, servers, message); statusErr != nil {
+			return true, statusErr
+		}
+		if err != nil || !drained {
+			return true, err
+		}
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServersDrained,
+			fmt.Sprintf("Databases moved off %s, scaling to %d servers", strings.Join(pods, ", "), desired))
+		statefulSet.Spec.Replicas = &desired
+		return true, nil
+	}
+
+	// The StatefulSet has shrunk: drop the servers once their pods are gone
+	if existing.Status.Replicas > desired {
+		return true, nil
+	}
+	servers, dropped, err := dropDepartedServers(ctx, neo4jClient, cluster.Status.ScaleDown.Servers, desired)
+	if err != nil {
+		return true, err
+	}
+	if !dropped {
+		return true, r.setScaleDownStatus(ctx, cluster, cluster.Status.ScaleDown.TargetServers, servers, "Waiting for departed servers to stop")
+	}
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServersRemoved,
+		fmt.Sprintf("Scaled down to %d servers", desired))
+	return false, r.setScaleDownStatus(ctx, cluster, 0, nil, "")
+}
+
+// connectForScaleDown opens a Neo4j connection for a scale-down
+func (r *Neo4jEnterpriseClusterReconciler) connectForScaleDown(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (scaleDownClient, func(), error) {
+	if r.newScaleDownClient != nil {
+		c, err := r.newScaleDownClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+// drainDepartingServers deallocates the servers of the given pods and
+// reports whether all of them host only the system database. Servers are
+// matched to pods through their advertised address, which starts with the
+// pod FQDN. Pods that never joined the cluster count as drained.
+func drainDepartingServers(ctx context.Context, c scaleDownClient, pods []string) ([]neo4jv1alpha1.DepartingServer, bool, error) {
+	serverList, err := c.GetServerList(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	drained := true
+	departing := make([]neo4jv1alpha1.DepartingServer, 0, len(pods))
+	for _, pod := range pods {
+		entry := neo4jv1alpha1.DepartingServer{Pod: pod, State: departingServerDrained}
+		server := serverForPod(serverList, pod)
+		if server == nil {
+			departing = append(departing, entry)
+			continue
+		}
+		entry.ServerID = server.Name
+
+		if server.State == "Enabled" || server.State == "Cordoned" {
+			if err := c.DeallocateServer(ctx, server.Name); err != nil {
+				entry.State = departingServerDeallocating
+				departing = append(departing, entry)
+				return departing, false, err
+			}
+			entry.State = departingServerDeallocating
+			drained = false
+		} else if hostsUserDatabases(server) {
+			entry.State = departingServerDeallocating
+			drained = false
+		}
+		departing = append(departing, entry)
+	}
+	return departing, drained, nil
+}
+
+// dropDepartedServers drops the drained servers whose pods have stopped and
+// reports whether none is left. Servers of pods that came back because the
+// cluster was scaled up again are left alone.
+func dropDepartedServers(ctx context.Context, c scaleDownClient, departing []neo4jv1alpha1.DepartingServer, replicas int32) ([]neo4jv1alpha1.DepartingServer, bool, error) {
+	serverList, err := c.GetServerList(ctx)
+	if err != nil {
+		return departing, false, err
+	}
+
+	done := true
+	result := make([]neo4jv1alpha1.DepartingServer, 0, len(departing))
+	for _, entry := range departing {
+		if entry.ServerID == "" || entry.State == departingServerDropped || podOrdinal(entry.Pod) < replicas {
+			continue
+		}
+		var server *neo4jclient.ServerInfo
+		for i := range serverList {
+			if serverList[i].Name == entry.ServerID {
+				server = &serverList[i]
+				break
+			}
+		}
+		switch {
+		case server == nil:
+			entry.State = departingServerDropped
+		case server.Health == "Available":
+			done = false
+		default:
+			if err := c.DropServer(ctx, entry.ServerID); err != nil {
+				return departing, false, err
+			}
+			entry.State = departingServerDropped
+		}
+		result = append(result, entry)
+	}
+	return result, done, nil
+}
+
+// setScaleDownStatus records the scale-down progress. A zero target clears it.
+func (r *Neo4jEnterpriseClusterReconciler) setScaleDownStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, target int32, servers []neo4jv1alpha1.DepartingServer, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		if target == 0 {
+			if latest.Status.ScaleDown == nil {
+				return nil
+			}
+			latest.Status.ScaleDown = nil
+		} else {
+			startTime := metav1.Now()
+			if latest.Status.ScaleDown != nil && latest.Status.ScaleDown.StartTime != nil {
+				startTime = *latest.Status.ScaleDown.StartTime
+			}
+			latest.Status.ScaleDown = &neo4jv1alpha1.ScaleDownStatus{
+				TargetServers: target,
+				Servers:       servers,
+				StartTime:     &startTime,
+				Message:       message,
+			}
+			log.FromContext(ctx).Info("Scale-down in progress", "targetServers", target, "message", message)
+		}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.ScaleDown = latest.Status.ScaleDown
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// serverForPod returns the server advertised by a pod, or nil
+func serverForPod(servers []neo4jclient.ServerInfo, pod string) *neo4jclient.ServerInfo {
+	for i := range servers {
+		if strings.HasPrefix(servers[i].Address, pod+".") || strings.HasPrefix(servers[i].Address, pod+":") {
+			return &servers[i]
+		}
+	}
+	return nil
+}
+
+// hostsUserDatabases reports whether a server hosts more than the system database
+func hostsUserDatabases(server *neo4jclient.ServerInfo) bool {
+	for _, database := range server.Hosting {
+		if database != "system" {
+			return true
+		}
+	}
+	return false
+}
+
+// podOrdinal returns the StatefulSet ordinal of a pod name, or -1
+func podOrdinal(pod string) int32 {
+	index := strings.LastIndex(pod, "-")
+	if index < 0 {
+		return -1
+	}
+	var ordinal int32
+	if _, err := fmt.Sscanf(pod[index+1:], "%d", &ordinal); err != nil {
+		return -1
+	}
+	return ordinal
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// fakeScaleDownClient models SHOW SERVERS: deallocating a server empties it
+// on the next listing, dropping removes it.
+type fakeScaleDownClient struct {
+	servers     []neo4jclient.ServerInfo
+	deallocated []string
+	dropped     []string
+}
+
+func (c *fakeScaleDownClient) GetServerList(_ context.Context) ([]neo4jclient.ServerInfo, error) {
+	return c.servers, nil
+}
+
+func (c *fakeScaleDownClient) DeallocateServer(_ context.Context, server string) error {
+	c.deallocated = append(c.deallocated, server)
+	for i := range c.servers {
+		if c.servers[i].Name == server {
+			c.servers[i].State = "Deallocating"
+		}
+	}
+	return nil
+}
+
+func (c *fakeScaleDownClient) DropServer(_ context.Context, server string) error {
+	c.dropped = append(c.dropped, server)
+	return nil
+}
+
+func scaleDownServer(id, pod string, hosting ...string) neo4jclient.ServerInfo {
+	return neo4jclient.ServerInfo{
+		Name:    id,
+		Address: pod + ".prod-internals.default.svc.cluster.local:7687",
+		State:   "Enabled",
+		Health:  "Available",
+		Hosting: hosting,
+	}
+}
+
+func TestDrainDepartingServers(t *testing.T) {
+	fakeClient := &fakeScaleDownClient{servers: []neo4jclient.ServerInfo{
+		scaleDownServer("id-0", "prod-server-0", "system", "neo4j"),
+		scaleDownServer("id-3", "prod-server-3", "system", "neo4j"),
+	}}
+
+	servers, drained, err := drainDepartingServers(context.Background(), fakeClient, []string{"prod-server-3", "prod-server-4"})
+	require.NoError(t, err)
+	assert.False(t, drained)
+	assert.Equal(t, []string{"id-3"}, fakeClient.deallocated)
+	assert.Equal(t, []neo4jv1alpha1.DepartingServer{
+		{Pod: "prod-server-3", ServerID: "id-3", State: "Deallocating"},
+		{Pod: "prod-server-4", State: "Drained"},
+	}, servers, "a pod that never joined has nothing to move")
+
+	// Store copies are still running while the server hosts neo4j
+	_, drained, err = drainDepartingServers(context.Background(), fakeClient, []string{"prod-server-3"})
+	require.NoError(t, err)
+	assert.False(t, drained)
+	assert.Len(t, fakeClient.deallocated, 1, "deallocation is only requested once")
+
+	fakeClient.servers[1].Hosting = []string{"system"}
+	_, drained, err = drainDepartingServers(context.Background(), fakeClient, []string{"prod-server-3"})
+	require.NoError(t, err)
+	assert.True(t, drained)
+}
+
+func TestReconcileScaleDown(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	existing := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-server", Namespace: "default"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: int32PtrCM(4)},
+		Status:     appsv1.StatefulSetStatus{Replicas: 4},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, existing).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}, &appsv1.StatefulSet{}).Build()
+	fakeClient := &fakeScaleDownClient{servers: []neo4jclient.ServerInfo{
+		scaleDownServer("id-0", "prod-server-0", "system", "neo4j"),
+		scaleDownServer("id-3", "prod-server-3", "system", "neo4j"),
+	}}
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: record.NewFakeRecorder(10),
+		newScaleDownClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (scaleDownClient, error) {
+			return fakeClient, nil
+		},
+	}
+	ctx := context.Background()
+	desired := func() *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-server", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: int32PtrCM(3)},
+		}
+	}
+
+	// Databases are still on prod-server-3: the pod is kept
+	statefulSet := desired()
+	pending, err := r.reconcileScaleDown(ctx, cluster, statefulSet)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, int32(4), *statefulSet.Spec.Replicas)
+	require.NotNil(t, cluster.Status.ScaleDown)
+	assert.Equal(t, int32(3), cluster.Status.ScaleDown.TargetServers)
+
+	// Drained: the StatefulSet may shrink
+	fakeClient.servers[1].Hosting = []string{"system"}
+	statefulSet = desired()
+	pending, err = r.reconcileScaleDown(ctx, cluster, statefulSet)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, int32(3), *statefulSet.Spec.Replicas)
+	assert.Empty(t, fakeClient.dropped, "the server is only dropped after its pod stopped")
+
+	// The pod has stopped: the server is dropped and the status cleared
+	existing.Spec.Replicas = int32PtrCM(3)
+	require.NoError(t, c.Update(ctx, existing))
+	existing.Status.Replicas = 3
+	require.NoError(t, c.Status().Update(ctx, existing))
+	fakeClient.servers[1].Health = "Unavailable"
+	pending, err = r.reconcileScaleDown(ctx, cluster, desired())
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Equal(t, []string{"id-3"}, fakeClient.dropped)
+
+	updated := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), updated))
+	assert.Nil(t, updated.Status.ScaleDown)
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// securityAuditLogKey holds the current entries of the audit ConfigMap,
+	// one JSON object per line.
+	securityAuditLogKey = "audit.log"
+
+	// securityAuditRotatedKey holds the entries of the previous rotation.
+	securityAuditRotatedKey = "audit.log.1"
+
+	// DefaultSecurityAuditMaxEntries is the number of entries kept in
+	// audit.log before it is rotated.
+	DefaultSecurityAuditMaxEntries = 500
+)
+
+// securityAuditHTTPClient posts audit entries to the webhook sink.
+var securityAuditHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// SecurityAuditConfig configures the sinks security statements are recorded
+// in besides the events on the resource that ran them.
+type SecurityAuditConfig struct {
+	// ConfigMapName is the ConfigMap, in the namespace of the resource, the
+	// entries are appended to. Empty disables the ConfigMap sink.
+	ConfigMapName string
+
+	// MaxEntries is the number of entries kept in audit.log before they are
+	// moved to audit.log.1, replacing the previous rotation.
+	MaxEntries int
+
+	// WebhookURL receives each batch of entries as a JSON array in a POST
+	// request. Empty disables the webhook sink.
+	WebhookURL string
+}
+
+// SecurityAuditEntry records one user, role or privilege statement.
+type SecurityAuditEntry struct {
+	// Time the statement ran
+	Time metav1.Time `json:"time"`
+
+	// Resource that ran the statement, as Kind/namespace/name
+	Resource string `json:"resource"`
+
+	// Target is the cluster or standalone the statement ran on
+	Target string `json:"target"`
+
+	// User is the Neo4j user the operator ran the statement as
+	User string `json:"user"`
+
+	// Statement with its password literals redacted
+	Statement string `json:"statement"`
+
+	// Result is Succeeded or Failed
+	Result string `json:"result"`
+
+	// Error of a failed statement
+	Error string `json:"error,omitempty"`
+}
+
+// securityAudit records the security statements run for one resource during
+// a reconcile: each one as an event right away, and all of them in the
+// configured sinks once flushed.
+type securityAudit struct {
+	client   client.Client
+	recorder record.EventRecorder
+	config   SecurityAuditConfig
+	owner    client.Object
+	resource string
+	target   string
+	user     string
+	entries  []SecurityAuditEntry
+}
+
+func newSecurityAudit(c client.Client, recorder record.EventRecorder, config SecurityAuditConfig, owner client.Object, kind, target, user string) *securityAudit {
+	return &securityAudit{
+		client:   c,
+		recorder: recorder,
+		config:   config,
+		owner:    owner,
+		resource: fmt.Sprintf("%s/%s/%s", kind, owner.GetNamespace(), owner.GetName()),
+		target:   target,
+		user:     user,
+	}
+}
+
+// record is the neo4j.SecurityAuditFunc of the audit.
+func (a *securityAudit) record(_ context.Context, statement string, err error) {
+	entry := SecurityAuditEntry{
+		Time:      metav1.Now(),
+		Resource:  a.resource,
+		Target:    a.target,
+		User:      a.user,
+		Statement: statement,
+		Result:    "Succeeded",
+	}
+	if err != nil {
+		entry.Result = "Failed"
+		entry.Error = err.Error()
+	}
+	a.entries = append(a.entries, entry)
+
+	if a.recorder == nil {
+		return
+	}
+	if err != nil {
+		a.recorder.Eventf(a.owner, corev1.EventTypeWarning, EventReasonSecurityStatementFailed,
+			"%s on %s as %s failed: %v", statement, a.target, a.user, err)
+		return
+	}
+	a.recorder.Eventf(a.owner, corev1.EventTypeNormal, EventReasonSecurityStatement,
+		"%s on %s as %s", statement, a.target, a.user)
+}
+
+// flush writes the recorded entries to the configured sinks and forgets
+// them. Both sinks are tried even if one fails.
+func (a *securityAudit) flush(ctx context.Context) error {
+	if len(a.entries) == 0 {
+		return nil
+	}
+	entries := a.entries
+	a.entries = nil
+
+	var failures []string
+	if a.config.ConfigMapName != "" {
+		if err := a.appendToConfigMap(ctx, entries); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	if a.config.WebhookURL != "" {
+		if err := postSecurityAudit(ctx, a.config.WebhookURL, entries); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to record %d security audit entries: %s", len(entries), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// appendToConfigMap appends entries to the audit ConfigMap, creating it when
+// missing and rotating audit.log once it holds more than MaxEntries entries.
+func (a *securityAudit) appendToConfigMap(ctx context.Context, entries []SecurityAuditEntry) error {
+	var lines strings.Builder
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		lines.Write(data)
+		lines.WriteByte('\n')
+	}
+	maxEntries := a.config.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultSecurityAuditMaxEntries
+	}
+
+	key := types.NamespacedName{Name: a.config.ConfigMapName, Namespace: a.owner.GetNamespace()}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap := &corev1.ConfigMap{}
+		if err := a.client.Get(ctx, key, configMap); err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to get audit ConfigMap %s: %w", key.Name, err)
+			}
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+					Labels: map[string]string{
+						"app.kubernetes.io/managed-by": "neo4j-operator",
+						"app.kubernetes.io/component":  "security-audit",
+					},
+				},
+				Data: map[string]string{securityAuditLogKey: lines.String()},
+			}
+			if err := a.client.Create(ctx, configMap); err != nil {
+				return fmt.Errorf("failed to create audit ConfigMap %s: %w", key.Name, err)
+			}
+			return nil
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		current := configMap.Data[securityAuditLogKey]
+		if strings.Count(current, "\n")+len(entries) > maxEntries && current != "" {
+			configMap.Data[securityAuditRotatedKey] = current
+			current = ""
+		}
+		configMap.Data[securityAuditLogKey] = current + lines.String()
+		if err := a.client.Update(ctx, configMap); err != nil {
+			if errors.IsConflict(err) {
+				return err
+			}
+			return fmt.Errorf("failed to update audit ConfigMap %s: %w", key.Name, err)
+		}
+		return nil
+	})
+}
+
+// postSecurityAudit sends entries to the webhook sink as a JSON array.
+func postSecurityAudit(ctx context.Context, url string, entries []SecurityAuditEntry) error {
+	body, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid security audit webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := securityAuditHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to security audit webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("security audit webhook returned %s", resp.Status)
+	}
+	return nil
+}
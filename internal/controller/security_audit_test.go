@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func auditedUserSync() *neo4jv1alpha1.Neo4jUserSync {
+	return &neo4jv1alpha1.Neo4jUserSync{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "neo4j"},
+		Spec:       neo4jv1alpha1.Neo4jUserSyncSpec{ClusterRef: "prod"},
+	}
+}
+
+func TestSecurityAuditEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	audit := newSecurityAudit(nil, recorder, SecurityAuditConfig{}, auditedUserSync(), "Neo4jUserSync", "prod", "neo4j")
+
+	audit.record(context.Background(), "GRANT ROLE `reader` TO `alice`", nil)
+	audit.record(context.Background(), "DROP USER `bob`", fmt.Errorf("user not found"))
+
+	assert.Equal(t, "Normal SecurityStatement GRANT ROLE `reader` TO `alice` on prod as neo4j", <-recorder.Events)
+	assert.Equal(t, "Warning SecurityStatementFailed DROP USER `bob` on prod as neo4j failed: user not found", <-recorder.Events)
+	require.Len(t, audit.entries, 2)
+	assert.Equal(t, "Neo4jUserSync/neo4j/ldap", audit.entries[0].Resource)
+	assert.Equal(t, "Failed", audit.entries[1].Result)
+
+	// Without sinks flushing only forgets the entries
+	require.NoError(t, audit.flush(context.Background()))
+	assert.Empty(t, audit.entries)
+}
+
+func TestSecurityAuditConfigMapRotation(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+	config := SecurityAuditConfig{ConfigMapName: "neo4j-security-audit", MaxEntries: 3}
+	audit := newSecurityAudit(c, nil, config, auditedUserSync(), "Neo4jUserSync", "prod", "neo4j")
+	key := client.ObjectKey{Name: "neo4j-security-audit", Namespace: "neo4j"}
+
+	for _, user := range []string{"a", "b"} {
+		audit.record(ctx, "CREATE USER `"+user+"` SET PASSWORD $password CHANGE REQUIRED", nil)
+	}
+	require.NoError(t, audit.flush(ctx))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, key, configMap))
+	assert.Equal(t, "neo4j-operator", configMap.Labels["app.kubernetes.io/managed-by"])
+	lines := strings.Split(strings.TrimSpace(configMap.Data["audit.log"]), "\n")
+	require.Len(t, lines, 2)
+	entry := SecurityAuditEntry{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "CREATE USER `a` SET PASSWORD $password CHANGE REQUIRED", entry.Statement)
+	assert.Equal(t, "Succeeded", entry.Result)
+
+	// Appending past MaxEntries moves the log to audit.log.1
+	audit.record(ctx, "SUSPEND", nil)
+	audit.record(ctx, "ACTIVATE", nil)
+	require.NoError(t, audit.flush(ctx))
+	require.NoError(t, c.Get(ctx, key, configMap))
+	assert.Equal(t, 2, strings.Count(configMap.Data["audit.log.1"], "\n"))
+	assert.Equal(t, 2, strings.Count(configMap.Data["audit.log"], "\n"))
+	assert.Contains(t, configMap.Data["audit.log"], "ACTIVATE")
+}
+
+func TestSecurityAuditWebhook(t *testing.T) {
+	var received []SecurityAuditEntry
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	audit := newSecurityAudit(nil, nil, SecurityAuditConfig{WebhookURL: server.URL}, auditedUserSync(), "Neo4jUserSync", "prod", "neo4j")
+	audit.record(context.Background(), "REVOKE ROLE `editor` FROM `alice`", nil)
+	require.NoError(t, audit.flush(context.Background()))
+	require.Len(t, received, 1)
+	assert.Equal(t, "REVOKE ROLE `editor` FROM `alice`", received[0].Statement)
+	assert.Equal(t, "neo4j", received[0].User)
+
+	// A failing sink is reported
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	audit.record(context.Background(), "DROP USER `alice`", nil)
+	assert.ErrorContains(t, audit.flush(context.Background()), "500")
+}
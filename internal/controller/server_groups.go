@@ -0,0 +1,295 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// reconcileServerGroups creates the StatefulSets of spec.topology.serverGroups
+// and shrinks or deletes those of groups that were reduced or removed. As
+// for spec.topology.servers, departing pods are kept until their servers
+// host nothing but the system database, and the servers are dropped once the
+// pods are gone; status.serverGroupScaleDown tracks them. It returns true
+// while a group scale-down still needs attention. The PersistentVolumeClaims
+// of departed pods are kept.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileServerGroups(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	tlsHash, err := tlsSecretHash(ctx, r.Client, cluster.Namespace, cluster.Spec.TLS, resources.SSLPolicyScopes)
+	if err != nil {
+		return false, err
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name},
+		client.HasLabels{resources.ServerGroupLabel}); err != nil {
+		return false, fmt.Errorf("failed to list server group StatefulSets: %w", err)
+	}
+	existing := map[string]*appsv1.StatefulSet{}
+	for i := range statefulSets.Items {
+		existing[statefulSets.Items[i].Name] = &statefulSets.Items[i]
+	}
+
+	scaleDown := &serverGroupScaleDown{r: r, cluster: cluster,
+		departing: append([]neo4jv1alpha1.DepartingServer(nil), cluster.Status.ServerGroupScaleDown...)}
+	defer scaleDown.close()
+
+	wanted := map[string]int32{}
+	held := map[string]bool{}
+	for _, sts := range resources.BuildServerGroupStatefulSetsForEnterprise(cluster) {
+		desired := *sts.Spec.Replicas
+		wanted[sts.Name] = desired
+		if current := statefulSetReplicas(existing[sts.Name]); current > desired {
+			drained, err := scaleDown.drain(ctx, sts.Name, desired, current)
+			if err != nil {
+				return true, scaleDown.fail(ctx, err)
+			}
+			if !drained {
+				// Hold the pods until their databases have moved
+				sts.Spec.Replicas = &current
+				held[sts.Name] = true
+			}
+		}
+
+		certificatesHash, pending, err := serverCertificatesHash(ctx, r.Client, cluster, sts.Labels[resources.ServerGroupLabel])
+		if err != nil {
+			return false, err
+		}
+		if pending != "" {
+			// Updated once cert-manager issued the certificate of the pod
+			continue
+		}
+		setTLSSecretHash(&sts.Spec.Template, tlsHash)
+		setServerCertificatesHash(&sts.Spec.Template, certificatesHash)
+		setCertificatesRestart(&sts.Spec.Template, cluster)
+		if err := r.createOrUpdateResource(ctx, sts, cluster); err != nil {
+			return false, fmt.Errorf("failed to create StatefulSet %s: %w", sts.Name, err)
+		}
+	}
+
+	for name, sts := range existing {
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+		drained, err := scaleDown.drain(ctx, name, 0, statefulSetReplicas(sts))
+		if err != nil {
+			return true, scaleDown.fail(ctx, err)
+		}
+		if !drained {
+			held[name] = true
+			continue
+		}
+		if err := r.Delete(ctx, sts); err != nil && !errors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete StatefulSet %s: %w", sts.Name, err)
+		}
+	}
+
+	if err := scaleDown.drop(ctx, wanted, held); err != nil {
+		return true, scaleDown.fail(ctx, err)
+	}
+	if err := scaleDown.record(ctx); err != nil {
+		return true, err
+	}
+	return len(scaleDown.departing) > 0, nil
+}
+
+// serverGroupScaleDown moves the databases off the departing servers of the
+// server groups and drops them, connecting to the cluster only when a group
+// shrinks
+type serverGroupScaleDown struct {
+	r           *Neo4jEnterpriseClusterReconciler
+	cluster     *neo4jv1alpha1.Neo4jEnterpriseCluster
+	departing   []neo4jv1alpha1.DepartingServer
+	neo4jClient scaleDownClient
+	closeClient func()
+}
+
+// connect opens the Neo4j connection on first use
+func (s *serverGroupScaleDown) connect(ctx context.Context) (scaleDownClient, error) {
+	if s.neo4jClient == nil {
+		neo4jClient, closeClient, err := s.r.connectForScaleDown(ctx, s.cluster)
+		if err != nil {
+			return nil, err
+		}
+		s.neo4jClient, s.closeClient = neo4jClient, closeClient
+	}
+	return s.neo4jClient, nil
+}
+
+func (s *serverGroupScaleDown) close() {
+	if s.closeClient != nil {
+		s.closeClient()
+	}
+}
+
+// drain deallocates the servers of the pods of a group StatefulSet from
+// ordinal desired on and reports whether they host only the system database
+func (s *serverGroupScaleDown) drain(ctx context.Context, statefulSet string, desired, current int32) (bool, error) {
+	neo4jClient, err := s.connect(ctx)
+	if err != nil {
+		return false, err
+	}
+	var pods []string
+	for ordinal := desired; ordinal < current; ordinal++ {
+		pods = append(pods, fmt.Sprintf("%s-%d", statefulSet, ordinal))
+	}
+	servers, drained, err := drainDepartingServers(ctx, neo4jClient, pods)
+	for _, server := range servers {
+		s.departing = upsertDepartingServer(s.departing, server)
+	}
+	if err != nil || !drained {
+		return false, err
+	}
+	s.r.Recorder.Event(s.cluster, corev1.EventTypeNormal, EventReasonServersDrained,
+		fmt.Sprintf("Databases moved off %s, scaling %s to %d servers", strings.Join(pods, ", "), statefulSet, desired))
+	return true, nil
+}
+
+// drop drops the drained servers of the groups that are no longer held
+// once their pods have stopped. Servers of pods that came back because a
+// group grew again are left alone.
+func (s *serverGroupScaleDown) drop(ctx context.Context, wanted map[string]int32, held map[string]bool) error {
+	byStatefulSet := map[string][]neo4jv1alpha1.DepartingServer{}
+	var statefulSetNames []string
+	var remaining []neo4jv1alpha1.DepartingServer
+	for _, entry := range s.departing {
+		name := podStatefulSet(entry.Pod)
+		if held[name] {
+			remaining = append(remaining, entry)
+			continue
+		}
+		if _, ok := byStatefulSet[name]; !ok {
+			statefulSetNames = append(statefulSetNames, name)
+		}
+		byStatefulSet[name] = append(byStatefulSet[name], entry)
+	}
+	if len(statefulSetNames) == 0 {
+		return nil
+	}
+
+	neo4jClient, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range statefulSetNames {
+		servers, _, err := dropDepartedServers(ctx, neo4jClient, byStatefulSet[name], wanted[name])
+		if err != nil {
+			return err
+		}
+		for _, server := range servers {
+			if server.State != departingServerDropped {
+				remaining = append(remaining, server)
+			}
+		}
+	}
+	s.departing = remaining
+	return nil
+}
+
+// fail records the progress so far and returns err
+func (s *serverGroupScaleDown) fail(ctx context.Context, err error) error {
+	if statusErr := s.record(ctx); statusErr != nil {
+		return statusErr
+	}
+	return err
+}
+
+// record stores the departing servers in status.serverGroupScaleDown
+func (s *serverGroupScaleDown) record(ctx context.Context) error {
+	if equality.Semantic.DeepEqual(s.departing, s.cluster.Status.ServerGroupScaleDown) {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := s.r.Get(ctx, client.ObjectKeyFromObject(s.cluster), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		latest.Status.ServerGroupScaleDown = s.departing
+		if err := s.r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		s.cluster.Status.ServerGroupScaleDown = latest.Status.ServerGroupScaleDown
+		s.cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// upsertDepartingServer replaces the entry of the same pod, or appends one
+func upsertDepartingServer(servers []neo4jv1alpha1.DepartingServer, server neo4jv1alpha1.DepartingServer) []neo4jv1alpha1.DepartingServer {
+	for i := range servers {
+		if servers[i].Pod == server.Pod {
+			servers[i] = server
+			return servers
+		}
+	}
+	return append(servers, server)
+}
+
+// podStatefulSet returns the StatefulSet name of a pod name
+func podStatefulSet(pod string) string {
+	if index := strings.LastIndex(pod, "-"); index >= 0 {
+		return pod[:index]
+	}
+	return pod
+}
+
+// statefulSetReplicas returns the replicas of a StatefulSet, 0 when it does
+// not exist
+func statefulSetReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts == nil {
+		return 0
+	}
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+	return *sts.Spec.Replicas
+}
+
+// listServerPods lists the pods of all servers of the cluster, including
+// those of the server groups
+func (r *Neo4jEnterpriseClusterReconciler) listServerPods(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*corev1.PodList, error) {
+	poolRequirement, err := labels.NewRequirement("neo4j.com/server-name", selection.In, resources.ServerPoolNames(cluster))
+	if err != nil {
+		return nil, err
+	}
+	clusterRequirement, err := labels.NewRequirement("neo4j.com/cluster", selection.Equals, []string{cluster.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabelsSelector{
+		Selector: labels.NewSelector().Add(*clusterRequirement, *poolRequirement),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list server pods: %w", err)
+	}
+	return pods, nil
+}
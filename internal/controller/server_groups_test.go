@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func TestReconcileServerGroups(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.UID = "prod-uid"
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{
+		{Name: "analytics", Servers: 2, ModeConstraint: "SECONDARY"},
+		{Name: "reporting", Servers: 1},
+	}
+	server := serverSTS("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, server).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	// The servers of the reporting group never joined, so nothing is moved
+	r.newScaleDownClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (scaleDownClient, error) {
+		return &fakeScaleDownClient{}, nil
+	}
+	get := func(name string) error {
+		return c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &appsv1.StatefulSet{})
+	}
+
+	pending, err := r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	analytics := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-analytics", Namespace: "default"}, analytics))
+	assert.Equal(t, int32(2), *analytics.Spec.Replicas)
+	require.NoError(t, get("prod-reporting"))
+
+	// Removing a group deletes its StatefulSet and nothing else
+	cluster.Spec.Topology.ServerGroups = cluster.Spec.Topology.ServerGroups[:1]
+	_, err = r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	require.NoError(t, get("prod-analytics"))
+	require.NoError(t, get("prod-server"))
+	assert.True(t, errors.IsNotFound(get("prod-reporting")))
+}
+
+func TestReconcileServerGroupsRemoval(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{{Name: "analytics", Servers: 2}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	fakeClient := &fakeScaleDownClient{servers: []neo4jclient.ServerInfo{
+		scaleDownServer("id-0", "prod-server-0", "system", "neo4j"),
+		scaleDownServer("id-a0", "prod-analytics-0", "system", "neo4j"),
+		scaleDownServer("id-a1", "prod-analytics-1", "system", "reports"),
+	}}
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	r.newScaleDownClient = func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (scaleDownClient, error) {
+		return fakeClient, nil
+	}
+	statefulSet := func() (*appsv1.StatefulSet, error) {
+		sts := &appsv1.StatefulSet{}
+		return sts, c.Get(ctx, client.ObjectKey{Name: "prod-analytics", Namespace: "default"}, sts)
+	}
+	pending, err := r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Empty(t, fakeClient.deallocated)
+
+	// Shrinking keeps prod-analytics-1 until its databases have moved
+	cluster.Spec.Topology.ServerGroups[0].Servers = 1
+	pending, err = r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, []string{"id-a1"}, fakeClient.deallocated)
+	sts, err := statefulSet()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), *sts.Spec.Replicas)
+
+	// Removing the group keeps the StatefulSet while any of its servers
+	// still hosts a user database
+	cluster.Spec.Topology.ServerGroups = nil
+	pending, err = r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, []string{"id-a1", "id-a0"}, fakeClient.deallocated)
+	_, err = statefulSet()
+	require.NoError(t, err)
+	assert.Len(t, cluster.Status.ServerGroupScaleDown, 2)
+
+	// Drained: the StatefulSet is deleted, the servers are still running
+	fakeClient.servers[1].Hosting = []string{"system"}
+	fakeClient.servers[2].Hosting = []string{"system"}
+	pending, err = r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	_, err = statefulSet()
+	assert.True(t, errors.IsNotFound(err))
+	assert.Empty(t, fakeClient.dropped, "the servers are only dropped after their pods stopped")
+
+	// The pods have stopped: the servers are dropped and the status cleared
+	fakeClient.servers[1].Health = "Unavailable"
+	fakeClient.servers[2].Health = "Unavailable"
+	pending, err = r.reconcileServerGroups(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.ElementsMatch(t, []string{"id-a0", "id-a1"}, fakeClient.dropped)
+	assert.NotContains(t, fakeClient.deallocated, "id-0")
+
+	updated := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), updated))
+	assert.Empty(t, updated.Status.ServerGroupScaleDown)
+}
+
+func TestListServerPods(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{{Name: "analytics", Servers: 1}}
+	analytics := restartTestPod("prod-analytics-0", "rev")
+	analytics.Labels["neo4j.com/server-name"] = "analytics"
+	backup := restartTestPod("prod-backup-0", "rev")
+	backup.Labels["neo4j.com/server-name"] = "backup"
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(restartTestPod("prod-server-0", "rev"), analytics, backup).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme()}
+
+	pods, err := r.listServerPods(context.Background(), cluster)
+	require.NoError(t, err)
+	var names []string
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	assert.ElementsMatch(t, []string{"prod-server-0", "prod-analytics-0"}, names)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+// serverStatusClient is the part of the Neo4j client the server status uses
+type serverStatusClient interface {
+	GetServerMembers(ctx context.Context) ([]neo4jclient.ServerMember, error)
+}
+
+// updateServerStatuses records the members of the cluster in
+// status.servers. The status is only written when the membership changed.
+func (r *Neo4jEnterpriseClusterReconciler) updateServerStatuses(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	neo4jClient, closeClient, err := r.connectForServerStatus(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	members, err := neo4jClient.GetServerMembers(ctx)
+	if err != nil {
+		return err
+	}
+
+	servers := make([]neo4jv1alpha1.ServerStatus, 0, len(members))
+	for _, member := range members {
+		servers = append(servers, neo4jv1alpha1.ServerStatus{
+			PodName:         podForAddress(member.Address),
+			ServerID:        member.ID,
+			Role:            member.Role,
+			State:           member.State,
+			Health:          member.Health,
+			HostedDatabases: int32(len(member.Hosting)),
+			Version:         member.Version,
+		})
+	}
+	slices.SortFunc(servers, func(a, b neo4jv1alpha1.ServerStatus) int {
+		if c := strings.Compare(a.PodName, b.PodName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ServerID, b.ServerID)
+	})
+	if slices.Equal(cluster.Status.Servers, servers) {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		latest.Status.Servers = servers
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.Servers = latest.Status.Servers
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// connectForServerStatus opens a Neo4j connection for the server status
+func (r *Neo4jEnterpriseClusterReconciler) connectForServerStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (serverStatusClient, func(), error) {
+	if r.newServerStatusClient != nil {
+		c, err := r.newServerStatusClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+// podForAddress returns the pod name of a server address such as
+// prod-server-0.prod-headless.default.svc.cluster.local:7687
+func podForAddress(address string) string {
+	if index := strings.IndexAny(address, ".:"); index >= 0 {
+		return address[:index]
+	}
+	return address
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeServerStatusClient struct {
+	members []neo4jclient.ServerMember
+}
+
+func (c *fakeServerStatusClient) GetServerMembers(_ context.Context) ([]neo4jclient.ServerMember, error) {
+	return c.members, nil
+}
+
+func TestUpdateServerStatuses(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	fakeClient := &fakeServerStatusClient{members: []neo4jclient.ServerMember{
+		{
+			ID: "id-1", Name: "id-1", Address: "prod-server-1.prod-headless.default.svc.cluster.local:7687",
+			State: "Enabled", Health: "Available", Hosting: []string{"neo4j", "system"}, Version: "5.26.0", Role: "secondary",
+		},
+		{
+			ID: "id-0", Name: "id-0", Address: "prod-server-0.prod-headless.default.svc.cluster.local:7687",
+			State: "Enabled", Health: "Available", Hosting: []string{"system"}, Version: "5.26.0", Role: "primary",
+		},
+	}}
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client: c,
+		Scheme: c.Scheme(),
+		newServerStatusClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (serverStatusClient, error) {
+			return fakeClient, nil
+		},
+	}
+	ctx := context.Background()
+
+	require.NoError(t, r.updateServerStatuses(ctx, cluster))
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, []neo4jv1alpha1.ServerStatus{
+		{PodName: "prod-server-0", ServerID: "id-0", Role: "primary", State: "Enabled", Health: "Available", HostedDatabases: 1, Version: "5.26.0"},
+		{PodName: "prod-server-1", ServerID: "id-1", Role: "secondary", State: "Enabled", Health: "Available", HostedDatabases: 2, Version: "5.26.0"},
+	}, latest.Status.Servers)
+
+	// An unchanged membership leaves the status alone
+	resourceVersion := latest.ResourceVersion
+	require.NoError(t, r.updateServerStatuses(ctx, cluster))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, resourceVersion, latest.ResourceVersion)
+
+	// A server that went down shows up as such
+	fakeClient.members[0].Health = "Unavailable"
+	require.NoError(t, r.updateServerStatuses(ctx, cluster))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Equal(t, "Unavailable", latest.Status.Servers[1].Health)
+}
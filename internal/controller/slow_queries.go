@@ -0,0 +1,316 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+const (
+	// slowQueriesKey is the key of the slow query ConfigMap holding the list
+	slowQueriesKey = "slow-queries.json"
+	// slowQueryTopN is the number of slow queries kept in the ConfigMap
+	slowQueryTopN = 20
+	// slowQueryRetention is how long a slow query stays in the ConfigMap
+	// after it was last seen running
+	slowQueryRetention = 24 * time.Hour
+	// maxSlowQueryLength caps the query text kept per slow query
+	maxSlowQueryLength = 2000
+	// defaultSlowQueryThreshold matches the default of
+	// spec.queryMonitoring.slowQueryThreshold
+	defaultSlowQueryThreshold = 5 * time.Second
+)
+
+// slowQueryClient lists and terminates the transactions running on one
+// Neo4j server
+type slowQueryClient interface {
+	ShowTransactions(ctx context.Context, database string) ([]neo4jclient.TransactionInfo, error)
+	TerminateTransactions(ctx context.Context, ids []string) ([]string, error)
+	Close() error
+}
+
+// slowQuery is a query seen running past the slow query threshold, as
+// listed in the slow query ConfigMap
+type slowQuery struct {
+	Server        string          `json:"server"`
+	Database      string          `json:"database"`
+	TransactionID string          `json:"transactionId"`
+	Username      string          `json:"username,omitempty"`
+	Query         string          `json:"query"`
+	ElapsedTime   metav1.Duration `json:"elapsedTime"`
+	LastSeen      metav1.Time     `json:"lastSeen"`
+}
+
+// key identifies the transaction of a slow query. Transaction ids are only
+// unique per server.
+func (q slowQuery) key() string {
+	return q.Server + "/" + q.TransactionID
+}
+
+// runningSlowQueries holds the slow queries seen running at the last poll of
+// each cluster, so that a query is counted once however many polls see it,
+// and its duration is recorded when a poll no longer does.
+var runningSlowQueries = struct {
+	sync.Mutex
+	queries map[string]map[string]slowQuery
+}{
+	queries: map[string]map[string]slowQuery{},
+}
+
+// slowQueryConfigMapName returns the name of the ConfigMap listing the top
+// slow queries of a cluster
+func slowQueryConfigMapName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return cluster.Name + "-slow-queries"
+}
+
+// slowQueryThreshold parses spec.queryMonitoring.slowQueryThreshold
+func slowQueryThreshold(queryMonitoring *neo4jv1alpha1.QueryMonitoringSpec) (time.Duration, error) {
+	if queryMonitoring == nil || queryMonitoring.SlowQueryThreshold == "" {
+		return defaultSlowQueryThreshold, nil
+	}
+	threshold, err := time.ParseDuration(queryMonitoring.SlowQueryThreshold)
+	if err != nil {
+		return 0, fmt.Errorf("invalid slowQueryThreshold %q: %w", queryMonitoring.SlowQueryThreshold, err)
+	}
+	return threshold, nil
+}
+
+// CollectSlowQueries lists the transactions running on every server of the
+// cluster with SHOW TRANSACTIONS, which only covers the server it runs on,
+// terminates those exceeding spec.queryMonitoring.enforcement, and keeps
+// those past the slow query threshold. They are counted in the
+// operator metrics, the slowest are kept in the slow query ConfigMap, and a
+// sample is explained for index recommendations.
+func (qm *QueryMonitor) CollectSlowQueries(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	threshold, err := slowQueryThreshold(cluster.Spec.QueryMonitoring)
+	if err != nil {
+		return err
+	}
+	enforcer, err := newQueryEnforcer(cluster.Spec.QueryMonitoring.Enforcement)
+	if err != nil {
+		return err
+	}
+	if enforcer != nil {
+		// Without the operator's user, its own statements could be terminated
+		if enforcer.operatorUser, err = operatorConnectionUser(ctx, qm.Client, cluster); err != nil {
+			return fmt.Errorf("failed to determine the operator user: %w", err)
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := qm.List(ctx, pods, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{"neo4j.com/cluster": cluster.Name}, client.HasLabels{"neo4j.com/server-name"}); err != nil {
+		return fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	now := time.Now()
+	var current []slowQuery
+	var errs []error
+	unreachable := map[string]bool{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		queries, err := qm.pollServer(ctx, cluster, pod, threshold, enforcer, now)
+		if err != nil {
+			// The queries of the server may still be running
+			unreachable[pod.Name] = true
+			errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+			continue
+		}
+		current = append(current, queries...)
+	}
+
+	trackSlowQueries(cluster.Namespace+"/"+cluster.Name, metrics.NewQueryMetrics(cluster.Name, cluster.Namespace), current, unreachable)
+	if err := qm.recordTopSlowQueries(ctx, cluster, current, now); err != nil {
+		errs = append(errs, err)
+	}
+	if err := qm.recommendIndexes(ctx, cluster, current); err != nil {
+		errs = append(errs, err)
+	}
+	if len(current) > 0 {
+		log.FromContext(ctx).V(1).Info("Slow queries running", "cluster", cluster.Name, "count", len(current))
+	}
+	return errors.Join(errs...)
+}
+
+// pollServer returns the queries running on the server of pod for at least
+// threshold, after terminating those exceeding the limits of enforcer
+func (qm *QueryMonitor) pollServer(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod,
+	threshold time.Duration, enforcer *queryEnforcer, now time.Time) ([]slowQuery, error) {
+	newClient := qm.newSlowQueryClient
+	if newClient == nil {
+		newClient = qm.connectToServer
+	}
+	neo4jClient, err := newClient(ctx, cluster, pod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Neo4j client: %w", err)
+	}
+	defer neo4jClient.Close()
+
+	transactions, err := neo4jClient.ShowTransactions(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if enforcer != nil {
+		if err := qm.enforceQueryLimits(ctx, cluster, pod, neo4jClient, enforcer, transactions); err != nil {
+			return nil, err
+		}
+	}
+	var queries []slowQuery
+	for _, transaction := range transactions {
+		// Idle transactions have no current query
+		if transaction.Query == "" || transaction.ElapsedTime < threshold {
+			continue
+		}
+		query := []rune(transaction.Query)
+		if len(query) > maxSlowQueryLength {
+			query = append(query[:maxSlowQueryLength], '…')
+		}
+		queries = append(queries, slowQuery{
+			Server:        pod.Name,
+			Database:      transaction.Database,
+			TransactionID: transaction.ID,
+			Username:      transaction.Username,
+			Query:         string(query),
+			ElapsedTime:   metav1.Duration{Duration: transaction.ElapsedTime},
+			LastSeen:      metav1.NewTime(now),
+		})
+	}
+	return queries, nil
+}
+
+// connectToServer connects to the Neo4j server running in pod
+func (qm *QueryMonitor) connectToServer(_ context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (slowQueryClient, error) {
+	podURL := fmt.Sprintf("bolt://%s.%s-headless.%s.svc.cluster.local:7687", pod.Name, cluster.Name, cluster.Namespace)
+	return neo4jclient.NewClientForPod(cluster, qm.Client, getClusterConnectionSecretName(cluster), podURL)
+}
+
+// trackSlowQueries counts the slow queries of a cluster not seen by the
+// previous poll, and records the duration of those the previous poll saw
+// that have finished since. The queries of unreachable servers are kept
+// until a poll reaches them again.
+func trackSlowQueries(key string, queryMetrics *metrics.QueryMetrics, current []slowQuery, unreachable map[string]bool) {
+	runningSlowQueries.Lock()
+	defer runningSlowQueries.Unlock()
+
+	previous := runningSlowQueries.queries[key]
+	running := make(map[string]slowQuery, len(current))
+	for _, query := range current {
+		if _, seen := previous[query.key()]; !seen {
+			queryMetrics.RecordSlowQuery(query.Database)
+		}
+		running[query.key()] = query
+	}
+	for queryKey, query := range previous {
+		if _, stillRunning := running[queryKey]; stillRunning {
+			continue
+		}
+		if unreachable[query.Server] {
+			running[queryKey] = query
+			continue
+		}
+		queryMetrics.RecordSlowQueryDuration(query.Database, query.ElapsedTime.Duration)
+	}
+
+	if len(running) == 0 {
+		delete(runningSlowQueries.queries, key)
+		return
+	}
+	runningSlowQueries.queries[key] = running
+}
+
+// recordTopSlowQueries merges the running slow queries into the slow query
+// ConfigMap of the cluster, owned by it
+func (qm *QueryMonitor) recordTopSlowQueries(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, current []slowQuery, now time.Time) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      slowQueryConfigMapName(cluster),
+			Namespace: cluster.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, qm.Client, configMap, func() error {
+		var previous []slowQuery
+		if data := configMap.Data[slowQueriesKey]; data != "" {
+			// A list that no longer parses is replaced
+			_ = json.Unmarshal([]byte(data), &previous)
+		}
+		encoded, err := json.MarshalIndent(topSlowQueries(previous, current, now), "", "  ")
+		if err != nil {
+			return err
+		}
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		configMap.Labels["app.kubernetes.io/name"] = "neo4j"
+		configMap.Labels["app.kubernetes.io/instance"] = cluster.Name
+		configMap.Labels["app.kubernetes.io/component"] = "query-monitoring"
+		configMap.Data = map[string]string{slowQueriesKey: string(encoded)}
+		return controllerutil.SetControllerReference(cluster, configMap, qm.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update slow query ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// topSlowQueries returns the slowQueryTopN slowest queries of previous and
+// current, slowest first. A query in both is taken from current, and those
+// last seen longer than slowQueryRetention ago are dropped.
+func topSlowQueries(previous, current []slowQuery, now time.Time) []slowQuery {
+	merged := map[string]slowQuery{}
+	for _, query := range previous {
+		if now.Sub(query.LastSeen.Time) <= slowQueryRetention {
+			merged[query.key()] = query
+		}
+	}
+	for _, query := range current {
+		merged[query.key()] = query
+	}
+
+	top := make([]slowQuery, 0, len(merged))
+	for _, query := range merged {
+		top = append(top, query)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].ElapsedTime.Duration != top[j].ElapsedTime.Duration {
+			return top[i].ElapsedTime.Duration > top[j].ElapsedTime.Duration
+		}
+		return top[i].key() < top[j].key()
+	})
+	if len(top) > slowQueryTopN {
+		top = top[:slowQueryTopN]
+	}
+	return top
+}
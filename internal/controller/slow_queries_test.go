@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeSlowQueryClient struct {
+	transactions []neo4jclient.TransactionInfo
+	err          error
+	terminated   []string
+}
+
+func (f *fakeSlowQueryClient) ShowTransactions(context.Context, string) ([]neo4jclient.TransactionInfo, error) {
+	return f.transactions, f.err
+}
+
+func (f *fakeSlowQueryClient) TerminateTransactions(_ context.Context, ids []string) ([]string, error) {
+	f.terminated = append(f.terminated, ids...)
+	return ids, nil
+}
+
+func (f *fakeSlowQueryClient) Close() error { return nil }
+
+func clusterServerPod(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, index int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-server-%d", cluster.Name, index),
+			Namespace: cluster.Namespace,
+			Labels:    map[string]string{"neo4j.com/cluster": cluster.Name, "neo4j.com/server-name": "server"},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestCollectSlowQueries(t *testing.T) {
+	ctx := context.Background()
+	cluster := minimalCluster("slow", "default")
+	cluster.Spec.QueryMonitoring = &neo4jv1alpha1.QueryMonitoringSpec{Enabled: true, SlowQueryThreshold: "2s"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, clusterServerPod(cluster, 0), clusterServerPod(cluster, 1)).Build()
+
+	servers := map[string]*fakeSlowQueryClient{
+		"slow-server-0": {transactions: []neo4jclient.TransactionInfo{
+			{ID: "neo4j-transaction-1", Database: "neo4j", Query: "MATCH (n) RETURN n", ElapsedTime: 10 * time.Second},
+			{ID: "neo4j-transaction-2", Database: "neo4j", Query: "RETURN 1", ElapsedTime: time.Second},
+			{ID: "neo4j-transaction-3", Database: "neo4j", ElapsedTime: time.Minute},
+		}},
+		"slow-server-1": {transactions: []neo4jclient.TransactionInfo{
+			{ID: "orders-transaction-7", Database: "orders", Query: "MATCH (o:Order) RETURN o", ElapsedTime: time.Minute},
+		}},
+	}
+	qm := NewQueryMonitor(c, c.Scheme())
+	qm.newSlowQueryClient = func(_ context.Context, _ *neo4jv1alpha1.Neo4jEnterpriseCluster, pod *corev1.Pod) (slowQueryClient, error) {
+		return servers[pod.Name], nil
+	}
+
+	topQueries := func() []slowQuery {
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "slow-slow-queries", Namespace: "default"}, configMap))
+		require.Len(t, configMap.OwnerReferences, 1)
+		var queries []slowQuery
+		require.NoError(t, json.Unmarshal([]byte(configMap.Data[slowQueriesKey]), &queries))
+		return queries
+	}
+
+	// Fast and idle transactions are left out, the slowest come first
+	require.NoError(t, qm.CollectSlowQueries(ctx, cluster))
+	queries := topQueries()
+	require.Len(t, queries, 2)
+	assert.Equal(t, "orders-transaction-7", queries[0].TransactionID)
+	assert.Equal(t, "slow-server-1", queries[0].Server)
+	assert.Equal(t, "neo4j-transaction-1", queries[1].TransactionID)
+	assert.Contains(t, runningSlowQueries.queries, "default/slow")
+
+	// Finished queries stay listed, unreachable servers keep their queries
+	servers["slow-server-0"].transactions = nil
+	servers["slow-server-1"].err = fmt.Errorf("connection refused")
+	require.Error(t, qm.CollectSlowQueries(ctx, cluster))
+	assert.Len(t, topQueries(), 2)
+	assert.Len(t, runningSlowQueries.queries["default/slow"], 1)
+
+	servers["slow-server-1"].err = nil
+	servers["slow-server-1"].transactions = nil
+	require.NoError(t, qm.CollectSlowQueries(ctx, cluster))
+	assert.NotContains(t, runningSlowQueries.queries, "default/slow")
+
+	cluster.Spec.QueryMonitoring.SlowQueryThreshold = "fast"
+	require.Error(t, qm.CollectSlowQueries(ctx, cluster))
+}
+
+func TestTopSlowQueries(t *testing.T) {
+	now := time.Now()
+	query := func(id string, elapsed time.Duration, lastSeen time.Time) slowQuery {
+		return slowQuery{Server: "server-0", TransactionID: id, ElapsedTime: metav1.Duration{Duration: elapsed}, LastSeen: metav1.NewTime(lastSeen)}
+	}
+
+	previous := []slowQuery{
+		query("expired", time.Hour, now.Add(-2*slowQueryRetention)),
+		query("running", 5*time.Second, now.Add(-time.Minute)),
+	}
+	top := topSlowQueries(previous, []slowQuery{query("running", 30*time.Second, now)}, now)
+	require.Len(t, top, 1)
+	assert.Equal(t, 30*time.Second, top[0].ElapsedTime.Duration)
+
+	var current []slowQuery
+	for i := range slowQueryTopN + 5 {
+		current = append(current, query(fmt.Sprintf("transaction-%d", i), time.Duration(i+1)*time.Second, now))
+	}
+	top = topSlowQueries(nil, current, now)
+	require.Len(t, top, slowQueryTopN)
+	assert.Equal(t, time.Duration(slowQueryTopN+5)*time.Second, top[0].ElapsedTime.Duration)
+}
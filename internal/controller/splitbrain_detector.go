@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -113,6 +114,12 @@ func (d *SplitBrainDetector) DetectSplitBrain(ctx context.Context, cluster *neo4
 		return nil, fmt.Errorf("failed to get server pods: %w", err)
 	}
 
+	// Servers in maintenance may be down or cut off on purpose
+	serverPods = slices.DeleteFunc(serverPods, func(pod corev1.Pod) bool {
+		return slices.Contains(cluster.Status.MaintenanceServers, pod.Name)
+	})
+	expectedServers -= len(cluster.Status.MaintenanceServers)
+
 	// If we don't have all pods running, this might be normal startup
 	runningPods := 0
 	for _, pod := range serverPods {
@@ -234,7 +241,7 @@ func (d *SplitBrainDetector) createPodSpecificNeo4jClient(ctx context.Context, c
 	}
 
 	// Create client with pod-specific URL and cluster credentials
-	return neo4jclient.NewClientForPod(cluster, d.Client, cluster.Spec.Auth.AdminSecret, podURL)
+	return neo4jclient.NewClientForPod(cluster, d.Client, getClusterConnectionSecretName(cluster), podURL)
 }
 
 // analyzeClusterViews analyzes all cluster views to determine if there's a split-brain
@@ -562,8 +569,11 @@ func (d *SplitBrainDetector) repairByRestartingAllPods(ctx context.Context, clus
 		return fmt.Errorf("failed to get server pods: %w", err)
 	}
 
-	// Delete all pods
+	// Delete all pods but those in maintenance
 	for _, pod := range serverPods {
+		if slices.Contains(cluster.Status.MaintenanceServers, pod.Name) {
+			continue
+		}
 		err := d.Client.Delete(ctx, &pod)
 		if err != nil {
 			logger.Error(err, "Failed to delete pod", "pod", pod.Name)
@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// standaloneSSLPolicyScopes are the SSL policies a standalone server uses;
+// it has no cluster traffic to secure.
+var standaloneSSLPolicyScopes = []string{resources.SSLPolicyHTTPS, resources.SSLPolicyBolt, resources.SSLPolicyBackup}
+
+// reconcileSSLPolicyCertificates creates a Certificate for every SSL policy
+// scope with its own issuer and deletes the Certificates of scopes that no
+// longer have one. base is the shared certificate of the deployment.
+func reconcileSSLPolicyCertificates(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, base *certmanagerv1.Certificate, tls *neo4jv1alpha1.TLSSpec, scopes []string) error {
+	wanted := map[string]bool{}
+	for _, desired := range resources.BuildSSLPolicyCertificates(base, owner.GetName(), tls, scopes) {
+		wanted[desired.Name] = true
+		certificate := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, c, certificate, func() error {
+			certificate.Labels = desired.Labels
+			certificate.Spec = desired.Spec
+			return controllerutil.SetControllerReference(owner, certificate, scheme)
+		}); err != nil {
+			return fmt.Errorf("failed to reconcile Certificate %s: %w", desired.Name, err)
+		}
+	}
+
+	for _, scope := range scopes {
+		name := resources.SSLPolicyCertificateName(owner.GetName(), scope)
+		if wanted[name] {
+			continue
+		}
+		stale := &certmanagerv1.Certificate{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: owner.GetNamespace()}, stale); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get Certificate %s: %w", name, err)
+		}
+		if !metav1.IsControlledBy(stale, owner) {
+			continue
+		}
+		if err := c.Delete(ctx, stale); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Certificate %s: %w", name, err)
+		}
+	}
+	return nil
+}
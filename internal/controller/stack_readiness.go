@@ -0,0 +1,165 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// refreshStackReadyCondition recomputes the StackReady condition of the
+// cluster or standalone deployment a clusterRef names. It is called whenever
+// the deployment or one of its databases changes status, so a single
+// condition tells applications that everything they connect to is in place.
+func refreshStackReadyCondition(ctx context.Context, c client.Client, namespace, clusterRef string) {
+	logger := log.FromContext(ctx)
+
+	target, err := getClusterRefTarget(ctx, c, namespace, clusterRef)
+	if err != nil {
+		return
+	}
+	status, message, err := stackReadiness(ctx, c, namespace, clusterRef, target)
+	if err != nil {
+		logger.Error(err, "Failed to evaluate stack readiness", "target", clusterRef)
+		return
+	}
+	reason := ConditionReasonStackNotReady
+	if status == metav1.ConditionTrue {
+		reason = ConditionReasonStackReady
+	}
+
+	key := types.NamespacedName{Name: clusterRef, Namespace: namespace}
+	update := func() error {
+		var obj client.Object
+		var conditions *[]metav1.Condition
+		cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		err := c.Get(ctx, key, cluster)
+		switch {
+		case err == nil:
+			obj, conditions = cluster, &cluster.Status.Conditions
+		case errors.IsNotFound(err):
+			standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+			if err := c.Get(ctx, key, standalone); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+			obj, conditions = standalone, &standalone.Status.Conditions
+		default:
+			return err
+		}
+
+		if existing := findCondition(*conditions, ConditionTypeStackReady); existing != nil &&
+			existing.Status == status && existing.Reason == reason && existing.Message == message &&
+			existing.ObservedGeneration == obj.GetGeneration() {
+			return nil
+		}
+		SetNamedCondition(conditions, ConditionTypeStackReady, obj.GetGeneration(), status, reason, message)
+		return c.Status().Update(ctx, obj)
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, update); err != nil {
+		logger.Error(err, "Failed to update StackReady condition", "target", clusterRef)
+	}
+}
+
+// stackReadiness reports whether the target is Ready, every Neo4jDatabase
+// targeting it is online and every credentials Secret they reference
+// exists. Databases with desiredState offline are stopped on purpose and do
+// not hold the stack back. The message lists everything still missing.
+func stackReadiness(ctx context.Context, c client.Client, namespace, clusterRef string, target *workloadTarget) (metav1.ConditionStatus, string, error) {
+	var waiting []string
+	if !target.ready {
+		waiting = append(waiting, fmt.Sprintf("%s is not ready", clusterRef))
+	}
+
+	databaseList := &neo4jv1alpha1.Neo4jDatabaseList{}
+	if err := c.List(ctx, databaseList, client.InNamespace(namespace)); err != nil {
+		return metav1.ConditionUnknown, "", fmt.Errorf("failed to list databases: %w", err)
+	}
+	secrets := map[string]bool{target.adminSecret: true}
+	databases := 0
+	for i := range databaseList.Items {
+		database := &databaseList.Items[i]
+		if database.Spec.ClusterRef != clusterRef || database.DeletionTimestamp != nil {
+			continue
+		}
+		databases++
+		for _, secret := range databaseCredentialSecrets(database) {
+			secrets[secret] = true
+		}
+		if database.Spec.DesiredState == "offline" {
+			continue
+		}
+		if database.Status.Phase != "Ready" {
+			phase := database.Status.Phase
+			if phase == "" {
+				phase = "Pending"
+			}
+			waiting = append(waiting, fmt.Sprintf("database %s is %s", database.Name, phase))
+		}
+	}
+
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &corev1.Secret{})
+		if errors.IsNotFound(err) {
+			waiting = append(waiting, fmt.Sprintf("secret %s is missing", name))
+		} else if err != nil {
+			return metav1.ConditionUnknown, "", fmt.Errorf("failed to get secret %s: %w", name, err)
+		}
+	}
+
+	if len(waiting) > 0 {
+		return metav1.ConditionFalse, "Waiting: " + strings.Join(waiting, ", "), nil
+	}
+	return metav1.ConditionTrue, fmt.Sprintf("%s and %d databases are ready", clusterRef, databases), nil
+}
+
+// databaseCredentialSecrets returns the Secrets a database needs to be
+// created and reached: seed credentials, initial data and the credentials of
+// remote aliases and constituents.
+func databaseCredentialSecrets(database *neo4jv1alpha1.Neo4jDatabase) []string {
+	var secrets []string
+	if database.Spec.SeedCredentials != nil && database.Spec.SeedCredentials.SecretRef != "" {
+		secrets = append(secrets, database.Spec.SeedCredentials.SecretRef)
+	}
+	if database.Spec.InitialData != nil && database.Spec.InitialData.SecretRef != "" {
+		secrets = append(secrets, database.Spec.InitialData.SecretRef)
+	}
+	for _, aliases := range [][]neo4jv1alpha1.DatabaseAlias{database.Spec.Aliases, database.Spec.Constituents} {
+		for _, alias := range aliases {
+			if alias.Remote != nil && alias.Remote.CredentialsSecret != "" {
+				secrets = append(secrets, alias.Remote.CredentialsSecret)
+			}
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func stackTestDatabase(name, phase string) *neo4jv1alpha1.Neo4jDatabase {
+	return &neo4jv1alpha1.Neo4jDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       neo4jv1alpha1.Neo4jDatabaseSpec{ClusterRef: "prod", Name: name},
+		Status:     neo4jv1alpha1.Neo4jDatabaseStatus{Phase: phase},
+	}
+}
+
+func stackTestSecret(name string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+func stackReadyCondition(t *testing.T, c client.Client) *metav1.Condition {
+	t.Helper()
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "prod", Namespace: "default"}, cluster))
+	return findCondition(cluster.Status.Conditions, ConditionTypeStackReady)
+}
+
+func TestRefreshStackReadyCondition(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin"}
+	cluster.Status.Phase = "Ready"
+
+	orders := stackTestDatabase("orders", "Ready")
+	orders.Spec.Aliases = []neo4jv1alpha1.DatabaseAlias{{
+		Name:   "legacy",
+		Remote: &neo4jv1alpha1.RemoteAliasTarget{URL: "neo4j+s://legacy:7687", CredentialsSecret: "legacy-creds"},
+	}}
+	archive := stackTestDatabase("archive", "Offline")
+	archive.Spec.DesiredState = "offline"
+	other := stackTestDatabase("other", "Failed")
+	other.Spec.ClusterRef = "staging"
+
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, orders, archive, other, stackTestSecret("prod-admin")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	ctx := context.Background()
+
+	refreshStackReadyCondition(ctx, c, "default", "prod")
+	cond := stackReadyCondition(t, c)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ConditionReasonStackNotReady, cond.Reason)
+	assert.Equal(t, "Waiting: secret legacy-creds is missing", cond.Message)
+
+	require.NoError(t, c.Create(ctx, stackTestSecret("legacy-creds")))
+	refreshStackReadyCondition(ctx, c, "default", "prod")
+	cond = stackReadyCondition(t, c)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "prod and 2 databases are ready", cond.Message)
+
+	// A database still being created holds the stack back
+	require.NoError(t, c.Create(ctx, stackTestDatabase("events", "Pending")))
+	refreshStackReadyCondition(ctx, c, "default", "prod")
+	cond = stackReadyCondition(t, c)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "Waiting: database events is Pending", cond.Message)
+}
+
+func TestRefreshStackReadyCondition_TargetNotReady(t *testing.T) {
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Status:     neo4jv1alpha1.Neo4jEnterpriseStandaloneStatus{Phase: "Pending"},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(standalone).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseStandalone{}).Build()
+
+	refreshStackReadyCondition(context.Background(), c, "default", "prod")
+
+	updated := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(standalone), updated))
+	cond := findCondition(updated.Status.Conditions, ConditionTypeStackReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "prod is not ready")
+	assert.Contains(t, cond.Message, "secret neo4j-admin-secret is missing")
+}
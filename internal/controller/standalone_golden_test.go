@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"path/filepath"
+	"testing"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/test/testutil"
+)
+
+// TestStandaloneManifestsGolden renders the resources of the standalone
+// fixture and compares them with testdata/golden/standalone.golden.yaml.
+// Run with -update to rewrite the snapshot after an intended change.
+func TestStandaloneManifestsGolden(t *testing.T) {
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+	testutil.LoadFixture(t, filepath.Join("testdata", "golden", "standalone.yaml"), standalone)
+	r := &Neo4jEnterpriseStandaloneReconciler{Scheme: newTestScheme()}
+
+	testutil.AssertGolden(t, filepath.Join("testdata", "golden", "standalone.golden.yaml"),
+		r.createConfigMap(standalone),
+		r.createService(standalone),
+		r.createStatefulSet(standalone),
+		resources.BuildRouteForStandalone(standalone),
+		resources.BuildMCPDeploymentForStandalone(standalone),
+		resources.BuildMCPServiceForStandalone(standalone),
+		resources.BuildMCPIngressForStandalone(standalone),
+		resources.BuildMCPRouteForStandalone(standalone),
+	)
+}
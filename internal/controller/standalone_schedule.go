@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// scheduledStopAnnotation marks a standalone StatefulSet that was scaled to
+// zero by its active hours schedule, so it is only scaled back up by the
+// schedule and not when something else stopped it.
+const scheduledStopAnnotation = "neo4j.neo4j.com/scheduled-stop"
+
+// activeHoursDays maps the spec day names to weekdays.
+var activeHoursDays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// scheduleWindow is a running interval, pre-warm included.
+type scheduleWindow struct {
+	start, end time.Time
+}
+
+// evaluateSchedule reports whether the standalone is scheduled to run at now
+// and when that changes next. It returns nil when no schedule is configured.
+func evaluateSchedule(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone, now time.Time) (*neo4jv1alpha1.StandaloneScheduleStatus, error) {
+	if standalone.Spec.Schedule == nil || standalone.Spec.Schedule.ActiveHours == nil {
+		return nil, nil
+	}
+	windows, err := activeHoursWindows(standalone.Spec.Schedule.ActiveHours, now)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &neo4jv1alpha1.StandaloneScheduleStatus{}
+	for i, window := range windows {
+		if !now.Before(window.start) && now.Before(window.end) {
+			status.Active = true
+			// A window reaching the end of the horizon never closes, e.g.
+			// 00:00-00:00 on every day
+			if i < len(windows)-1 {
+				status.NextTransition = &metav1.Time{Time: window.end}
+			}
+			return status, nil
+		}
+		if window.start.After(now) {
+			status.NextTransition = &metav1.Time{Time: window.start}
+			return status, nil
+		}
+	}
+	return status, nil
+}
+
+// activeHoursWindows lists the running windows from the day before now to a
+// week after it, sorted and with overlapping or touching windows merged, so a
+// window spanning midnight or several days is reported as one.
+func activeHoursWindows(spec *neo4jv1alpha1.ActiveHoursSpec, now time.Time) ([]scheduleWindow, error) {
+	location, err := time.LoadLocation(spec.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule time zone %q: %w", spec.TimeZone, err)
+	}
+	startHour, startMinute, err := parseClock(spec.Start)
+	if err != nil {
+		return nil, err
+	}
+	endHour, endMinute, err := parseClock(spec.End)
+	if err != nil {
+		return nil, err
+	}
+	var preWarm time.Duration
+	if spec.PreWarm != "" {
+		if preWarm, err = time.ParseDuration(spec.PreWarm); err != nil {
+			return nil, fmt.Errorf("invalid schedule preWarm %q: %w", spec.PreWarm, err)
+		}
+	}
+	days := map[time.Weekday]bool{}
+	for _, day := range spec.Days {
+		days[activeHoursDays[day]] = true
+	}
+
+	local := now.In(location)
+	var windows []scheduleWindow
+	for offset := -1; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, location)
+		if len(days) > 0 && !days[day.Weekday()] {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), startHour, startMinute, 0, 0, location)
+		end := time.Date(day.Year(), day.Month(), day.Day(), endHour, endMinute, 0, 0, location)
+		if !end.After(start) {
+			end = end.AddDate(0, 0, 1)
+		}
+		windows = append(windows, scheduleWindow{start: start.Add(-preWarm), end: end})
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start.Before(windows[j].start) })
+
+	var merged []scheduleWindow
+	for _, window := range windows {
+		if n := len(merged); n > 0 && !window.start.After(merged[n-1].end) {
+			if window.end.After(merged[n-1].end) {
+				merged[n-1].end = window.end
+			}
+			continue
+		}
+		merged = append(merged, window)
+	}
+	return merged, nil
+}
+
+// parseClock parses an HH:MM time of day.
+func parseClock(value string) (int, int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid schedule time %q, expected HH:MM", value)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// applyScheduledReplicas scales the standalone StatefulSet to zero outside
+// its active hours and back to one when they start. A StatefulSet stopped by
+// the schedule is also started when the schedule is removed. It returns the
+// event reason of the transition, or "" when nothing changed.
+func applyScheduledReplicas(sts *appsv1.StatefulSet, schedule *neo4jv1alpha1.StandaloneScheduleStatus) string {
+	if schedule != nil && !schedule.Active {
+		if sts.Annotations[scheduledStopAnnotation] == "true" && sts.Spec.Replicas != nil && *sts.Spec.Replicas == 0 {
+			return ""
+		}
+		if sts.Annotations == nil {
+			sts.Annotations = map[string]string{}
+		}
+		sts.Annotations[scheduledStopAnnotation] = "true"
+		sts.Spec.Replicas = ptr.To(int32(0))
+		return EventReasonScheduledStop
+	}
+	if _, stopped := sts.Annotations[scheduledStopAnnotation]; !stopped {
+		return ""
+	}
+	delete(sts.Annotations, scheduledStopAnnotation)
+	sts.Spec.Replicas = ptr.To(int32(1))
+	return EventReasonScheduledStart
+}
+
+// scheduleTransitionMessage describes a scheduled start or stop for its event.
+func scheduleTransitionMessage(reason string, schedule *neo4jv1alpha1.StandaloneScheduleStatus) string {
+	if reason == EventReasonScheduledStart {
+		if schedule == nil {
+			return "Starting standalone, schedule removed"
+		}
+		return "Starting standalone for its active hours"
+	}
+	if schedule != nil && schedule.NextTransition != nil {
+		return fmt.Sprintf("Stopping standalone outside active hours until %s", schedule.NextTransition.UTC().Format(time.RFC3339))
+	}
+	return "Stopping standalone outside active hours"
+}
+
+// scheduledRequeue shortens the requeue interval so the next start or stop
+// happens on time.
+func scheduledRequeue(schedule *neo4jv1alpha1.StandaloneScheduleStatus, requeue time.Duration, now time.Time) time.Duration {
+	if schedule == nil || schedule.NextTransition == nil {
+		return requeue
+	}
+	until := schedule.NextTransition.Sub(now)
+	if until <= 0 {
+		return time.Second
+	}
+	if requeue <= 0 || until < requeue {
+		return until
+	}
+	return requeue
+}
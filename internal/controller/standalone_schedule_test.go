@@ -0,0 +1,156 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func scheduledStandalone(hours *neo4jv1alpha1.ActiveHoursSpec) *neo4jv1alpha1.Neo4jEnterpriseStandalone {
+	return &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default", UID: "dev-uid"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Storage:  neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+			Schedule: &neo4jv1alpha1.StandaloneScheduleSpec{ActiveHours: hours},
+		},
+	}
+}
+
+func TestEvaluateSchedule(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	at := func(day, hour, minute int) time.Time { return time.Date(2026, 10, day, hour, minute, 0, 0, berlin) }
+
+	officeHours := scheduledStandalone(&neo4jv1alpha1.ActiveHoursSpec{
+		Start:    "08:00",
+		End:      "18:00",
+		Days:     []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+		TimeZone: "Europe/Berlin",
+		PreWarm:  "15m",
+	})
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		wantActive bool
+		wantNext   time.Time
+	}{
+		{name: "during the window", now: at(14, 10, 0), wantActive: true, wantNext: at(14, 18, 0)},
+		{name: "pre-warming", now: at(14, 7, 50), wantActive: true, wantNext: at(14, 18, 0)},
+		{name: "before pre-warm", now: at(14, 7, 40), wantActive: false, wantNext: at(14, 7, 45)},
+		{name: "at the end", now: at(14, 18, 0), wantActive: false, wantNext: at(15, 7, 45)},
+		{name: "over the weekend", now: at(16, 19, 0), wantActive: false, wantNext: at(19, 7, 45)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := evaluateSchedule(officeHours, tt.now)
+			require.NoError(t, err)
+			require.NotNil(t, status)
+			assert.Equal(t, tt.wantActive, status.Active)
+			require.NotNil(t, status.NextTransition)
+			assert.True(t, tt.wantNext.Equal(status.NextTransition.Time), "next transition %s, want %s", status.NextTransition.Time, tt.wantNext)
+		})
+	}
+
+	// A window crossing midnight closes on the following day
+	overnight := scheduledStandalone(&neo4jv1alpha1.ActiveHoursSpec{Start: "22:00", End: "06:00"})
+	status, err := evaluateSchedule(overnight, time.Date(2026, 10, 14, 2, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.True(t, time.Date(2026, 10, 14, 6, 0, 0, 0, time.UTC).Equal(status.NextTransition.Time))
+
+	// A window that never closes has no next transition
+	always := scheduledStandalone(&neo4jv1alpha1.ActiveHoursSpec{Start: "00:00", End: "00:00"})
+	status, err = evaluateSchedule(always, time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.Nil(t, status.NextTransition)
+
+	status, err = evaluateSchedule(scheduledStandalone(nil), time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestApplyScheduledReplicas(t *testing.T) {
+	sts := &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(1))}}
+	stopped := &neo4jv1alpha1.StandaloneScheduleStatus{Active: false}
+	running := &neo4jv1alpha1.StandaloneScheduleStatus{Active: true}
+
+	assert.Equal(t, EventReasonScheduledStop, applyScheduledReplicas(sts, stopped))
+	assert.Equal(t, int32(0), *sts.Spec.Replicas)
+	assert.Equal(t, "true", sts.Annotations[scheduledStopAnnotation])
+	assert.Empty(t, applyScheduledReplicas(sts, stopped), "an already stopped StatefulSet is left alone")
+
+	assert.Equal(t, EventReasonScheduledStart, applyScheduledReplicas(sts, running))
+	assert.Equal(t, int32(1), *sts.Spec.Replicas)
+	assert.NotContains(t, sts.Annotations, scheduledStopAnnotation)
+	assert.Empty(t, applyScheduledReplicas(sts, running))
+
+	// Removing the schedule starts an instance the schedule stopped
+	applyScheduledReplicas(sts, stopped)
+	assert.Equal(t, EventReasonScheduledStart, applyScheduledReplicas(sts, nil))
+	assert.Equal(t, int32(1), *sts.Spec.Replicas)
+
+	// A StatefulSet scaled down by something else is not started
+	sts = &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(int32(0))}}
+	assert.Empty(t, applyScheduledReplicas(sts, running))
+	assert.Equal(t, int32(0), *sts.Spec.Replicas)
+}
+
+func TestScheduledRequeue(t *testing.T) {
+	now := time.Date(2026, 10, 14, 17, 58, 0, 0, time.UTC)
+	next := &neo4jv1alpha1.StandaloneScheduleStatus{NextTransition: &metav1.Time{Time: now.Add(2 * time.Minute)}}
+
+	assert.Equal(t, 2*time.Minute, scheduledRequeue(next, 5*time.Minute, now))
+	assert.Equal(t, 5*time.Minute, scheduledRequeue(next, 5*time.Minute, now.Add(-time.Hour)))
+	assert.Equal(t, 2*time.Minute, scheduledRequeue(next, 0, now))
+	assert.Equal(t, time.Second, scheduledRequeue(next, 5*time.Minute, now.Add(time.Hour)))
+	assert.Equal(t, 5*time.Minute, scheduledRequeue(nil, 5*time.Minute, now))
+}
+
+func TestReconcileStatefulSet_ScheduledStop(t *testing.T) {
+	ctx := context.Background()
+	standalone := scheduledStandalone(&neo4jv1alpha1.ActiveHoursSpec{Start: "08:00", End: "18:00"})
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(standalone).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jEnterpriseStandaloneReconciler{Client: c, Scheme: newTestScheme(), Recorder: recorder}
+
+	require.NoError(t, r.reconcileStatefulSet(ctx, standalone, &neo4jv1alpha1.StandaloneScheduleStatus{Active: false}))
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "dev", Namespace: "default"}, sts))
+	assert.Equal(t, int32(0), *sts.Spec.Replicas)
+	assert.Contains(t, <-recorder.Events, EventReasonScheduledStop)
+
+	require.NoError(t, r.reconcileStatefulSet(ctx, standalone, &neo4jv1alpha1.StandaloneScheduleStatus{Active: true}))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sts), sts))
+	assert.Equal(t, int32(1), *sts.Spec.Replicas)
+	assert.Contains(t, <-recorder.Events, EventReasonScheduledStart)
+}
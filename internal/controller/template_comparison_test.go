@@ -197,6 +197,33 @@ func TestIsTemplateChangeSignificant(t *testing.T) {
 		significant := reconciler.isTemplateChangeSignificant(ctx, currentTemplate, desiredTemplate, sts)
 		assert.True(t, significant, "Service account changes should be considered critical")
 	})
+
+	t.Run("should detect AppArmor profile changes as critical", func(t *testing.T) {
+		currentTemplate := createBasicTemplate()
+		desiredTemplate := createBasicTemplate()
+
+		// AppArmor is configured via annotations; unrelated annotations are ignored
+		currentTemplate.Annotations = map[string]string{"kubectl.kubernetes.io/restartedAt": "now"}
+		desiredTemplate.Annotations = map[string]string{
+			"container.apparmor.security.beta.kubernetes.io/neo4j": "runtime/default",
+		}
+
+		sts := &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: int32Ptr(3),
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas: 1, // Not all replicas ready
+			},
+		}
+
+		significant := reconciler.isTemplateChangeSignificant(ctx, currentTemplate, desiredTemplate, sts)
+		assert.True(t, significant, "AppArmor profile changes should be considered critical")
+
+		currentTemplate.Annotations["container.apparmor.security.beta.kubernetes.io/neo4j"] = "runtime/default"
+		significant = reconciler.isTemplateChangeSignificant(ctx, currentTemplate, desiredTemplate, sts)
+		assert.False(t, significant, "Non-AppArmor annotations should not trigger updates")
+	})
 }
 
 func TestHasCriticalTemplateChanges(t *testing.T) {
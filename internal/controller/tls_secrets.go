@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// tlsSecretHash hashes the certificate Secrets users supply for a TLS
+// configuration, empty when there are none. Neo4j only reads its
+// certificates when it starts, so a rotated Secret has to change the pod
+// template to reach the servers. A missing Secret, or one without a
+// certificate and key, is an error rather than pods stuck mounting it.
+func tlsSecretHash(ctx context.Context, c client.Client, namespace string, tls *neo4jv1alpha1.TLSSpec, scopes []string) (string, error) {
+	names := resources.SuppliedTLSSecretNames(tls, scopes)
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	hash := sha256.New()
+	for _, name := range names {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+			return "", fmt.Errorf("failed to get TLS Secret %s: %w", name, err)
+		}
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+			if len(secret.Data[key]) == 0 {
+				return "", fmt.Errorf("TLS Secret %s has no %s", name, key)
+			}
+		}
+		fmt.Fprintf(hash, "%s\n", name)
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, "ca.crt"} {
+			fmt.Fprintf(hash, "%s=%x\n", key, secret.Data[key])
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16], nil
+}
+
+// setTLSSecretHash stamps the hash of the supplied certificates on a pod
+// template
+func setTLSSecretHash(template *corev1.PodTemplateSpec, hash string) {
+	if hash == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[resources.TLSSecretHashAnnotation] = hash
+}
+
+// referencesTLSSecret reports whether a certificate Secret the user supplies
+// for the TLS configuration is the named one
+func referencesTLSSecret(tls *neo4jv1alpha1.TLSSpec, scopes []string, name string) bool {
+	for _, secret := range resources.SuppliedTLSSecretNames(tls, scopes) {
+		if secret == name {
+			return true
+		}
+	}
+	return false
+}
+
+// clustersForTLSSecret maps a Secret to the clusters that mount it as a
+// certificate, so that rotating it rolls or reloads their servers, or that
+// sync their admin credentials from it
+func (r *Neo4jEnterpriseClusterReconciler) clustersForTLSSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	clusters := &neo4jv1alpha1.Neo4jEnterpriseClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list clusters for Secret", "secret", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, cluster := range clusters.Items {
+		if referencesTLSSecret(cluster.Spec.TLS, resources.SSLPolicyScopes, obj.GetName()) || ownsServerCertificateSecret(&cluster, obj) ||
+			mountsCertificateSecret(&cluster, obj.GetName()) || syncsAdminCredentials(&cluster, obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cluster)})
+		}
+	}
+	return requests
+}
+
+// standalonesForTLSSecret maps a Secret to the standalone deployments that
+// mount it as a supplied certificate
+func (r *Neo4jEnterpriseStandaloneReconciler) standalonesForTLSSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	standalones := &neo4jv1alpha1.Neo4jEnterpriseStandaloneList{}
+	if err := r.List(ctx, standalones, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list standalone deployments for Secret", "secret", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, standalone := range standalones.Items {
+		if referencesTLSSecret(standalone.Spec.TLS, standaloneSSLPolicyScopes, obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&standalone)})
+		}
+	}
+	return requests
+}
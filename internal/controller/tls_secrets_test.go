@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func secretTLSCluster(name, namespace string) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := minimalCluster(name, namespace)
+	cluster.Spec.TLS = &neo4jv1alpha1.TLSSpec{Mode: "secret", CertificateSecret: "neo4j-tls"}
+	return cluster
+}
+
+func tlsSecret(name, namespace string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Data: map[string][]byte{}}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return secret
+}
+
+func TestTLSSecretHash(t *testing.T) {
+	ctx := context.Background()
+	tls := secretTLSCluster("prod", "default").Spec.TLS
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+
+	_, err := tlsSecretHash(ctx, c, "default", tls, resources.SSLPolicyScopes)
+	require.Error(t, err, "a missing Secret is an error")
+
+	secret := tlsSecret("neo4j-tls", "default", map[string]string{"tls.crt": "cert-1"})
+	require.NoError(t, c.Create(ctx, secret))
+	_, err = tlsSecretHash(ctx, c, "default", tls, resources.SSLPolicyScopes)
+	require.EqualError(t, err, "TLS Secret neo4j-tls has no tls.key")
+
+	secret.Data["tls.key"] = []byte("key-1")
+	require.NoError(t, c.Update(ctx, secret))
+	first, err := tlsSecretHash(ctx, c, "default", tls, resources.SSLPolicyScopes)
+	require.NoError(t, err)
+	require.NotEmpty(t, first)
+
+	// A rotated certificate changes the hash stamped on the pod template
+	secret.Data["tls.crt"] = []byte("cert-2")
+	require.NoError(t, c.Update(ctx, secret))
+	rotated, err := tlsSecretHash(ctx, c, "default", tls, resources.SSLPolicyScopes)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, rotated)
+
+	template := &corev1.PodTemplateSpec{}
+	setTLSSecretHash(template, rotated)
+	assert.Equal(t, rotated, template.Annotations[resources.TLSSecretHashAnnotation])
+
+	// Certificates issued by cert-manager are not hashed
+	hash, err := tlsSecretHash(ctx, c, "default", &neo4jv1alpha1.TLSSpec{Mode: "cert-manager"}, resources.SSLPolicyScopes)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
+func TestClustersForTLSSecret(t *testing.T) {
+	withBoltSecret := minimalCluster("orders", "team-a")
+	withBoltSecret.Spec.TLS = &neo4jv1alpha1.TLSSpec{
+		Mode:     "cert-manager",
+		Policies: &neo4jv1alpha1.SSLPolicies{Bolt: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "neo4j-tls"}},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		secretTLSCluster("users", "team-a"),
+		withBoltSecret,
+		secretTLSCluster("billing", "team-b"),
+		minimalCluster("legacy", "team-a"),
+	).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c}
+
+	var names []string
+	for _, request := range r.clustersForTLSSecret(context.Background(), tlsSecret("neo4j-tls", "team-a", nil)) {
+		names = append(names, request.String())
+	}
+	assert.ElementsMatch(t, []string{"team-a/orders", "team-a/users"}, names)
+}
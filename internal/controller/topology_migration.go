@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// migrateDeprecatedTopology rewrites a cluster that uses the deprecated
+// primaries/secondaries topology to the equivalent number of servers, so that
+// every controller reading the cluster sees the same topology. It reports
+// whether the cluster was updated. Conflicting topologies are left unchanged
+// for validation to reject.
+func (r *Neo4jEnterpriseClusterReconciler) migrateDeprecatedTopology(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	if !validation.UsesDeprecatedTopology(&cluster.Spec.Topology) {
+		return false, nil
+	}
+	primaries, secondaries := cluster.Spec.Topology.Primaries, cluster.Spec.Topology.Secondaries
+
+	migrated := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		changed, err := validation.ConvertDeprecatedTopology(&latest.Spec.Topology)
+		if err != nil || !changed {
+			migrated = false
+			return nil
+		}
+		if err := r.Update(ctx, latest); err != nil {
+			return err
+		}
+		migrated = true
+		latest.DeepCopyInto(cluster)
+		return nil
+	})
+	if err != nil || !migrated {
+		return false, err
+	}
+
+	log.FromContext(ctx).Info("Converted deprecated topology to servers",
+		"primaries", primaries, "secondaries", secondaries, "servers", cluster.Spec.Topology.Servers)
+	r.Recorder.Eventf(cluster, corev1.EventTypeWarning, EventReasonTopologyDeprecated,
+		"spec.topology.primaries (%d) and secondaries (%d) are deprecated and were converted to servers: %d",
+		primaries, secondaries, cluster.Spec.Topology.Servers)
+	return true, nil
+}
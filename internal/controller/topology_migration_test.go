@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestMigrateDeprecatedTopology(t *testing.T) {
+	ctx := context.Background()
+	legacy := minimalCluster("legacy", "neo4j")
+	legacy.Spec.Topology = neo4jv1alpha1.TopologyConfiguration{Primaries: 3, Secondaries: 2}
+	conflicting := minimalCluster("conflicting", "neo4j")
+	conflicting.Spec.Topology = neo4jv1alpha1.TopologyConfiguration{Servers: 3, Primaries: 3, Secondaries: 2}
+
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(legacy, conflicting).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Recorder: recorder}
+
+	migrated, err := r.migrateDeprecatedTopology(ctx, legacy)
+	require.NoError(t, err)
+	assert.True(t, migrated)
+	assert.Equal(t, int32(5), legacy.Spec.Topology.Servers)
+
+	stored := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(legacy), stored))
+	assert.Equal(t, neo4jv1alpha1.TopologyConfiguration{Servers: 5}, stored.Spec.Topology)
+	assert.Equal(t, "Warning TopologyDeprecated spec.topology.primaries (3) and secondaries (2) are deprecated and were converted to servers: 5",
+		<-recorder.Events)
+
+	// Conflicting topologies are left for validation to reject
+	migrated, err = r.migrateDeprecatedTopology(ctx, conflicting)
+	require.NoError(t, err)
+	assert.False(t, migrated)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(conflicting), stored))
+	assert.Equal(t, int32(3), stored.Spec.Topology.Primaries)
+
+	// Clusters using servers are not touched
+	migrated, err = r.migrateDeprecatedTopology(ctx, minimalCluster("modern", "neo4j"))
+	require.NoError(t, err)
+	assert.False(t, migrated)
+}
@@ -99,7 +99,7 @@ func (ts *TopologyScheduler) ApplyTopologyConstraints(ctx context.Context, sts *
 	// Apply topology spread constraints
 	if placement.UseTopologySpread {
 		tsc := ts.buildTopologySpreadConstraints(cluster, placement)
-		podTemplate.Spec.TopologySpreadConstraints = tsc
+		podTemplate.Spec.TopologySpreadConstraints = append(podTemplate.Spec.TopologySpreadConstraints, tsc...)
 		logger.Info("Applied topology spread constraints", "constraints", len(tsc))
 	}
 
@@ -0,0 +1,374 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+const (
+	// upgradeCheckRequeueInterval is how often a running config check Job
+	// is looked at
+	upgradeCheckRequeueInterval = 10 * time.Second
+
+	// UpgradeCheckImageAnnotation records the image a config check Job
+	// validates the configuration with
+	UpgradeCheckImageAnnotation = "neo4j.com/upgrade-check-image"
+
+	upgradeCheckContainerName = "validate-config"
+)
+
+// upgradePreflightClient is the part of the Neo4j client the upgrade checks
+// use
+type upgradePreflightClient interface {
+	GetDatabaseStores(ctx context.Context) ([]neo4jclient.DatabaseStore, error)
+}
+
+// deprecatedStoreFormats are the record formats that still open on 2025.x
+// but should be migrated to block
+var deprecatedStoreFormats = map[string]bool{"standard": true, "high_limit": true}
+
+// reconcileUpgradeChecks runs the checks that have to pass before the
+// servers are rolled to a new image: the upgrade path from the running
+// version, the state of every database allocation and, unless
+// spec.upgradeStrategy.skipConfigCheck is set, a Job that validates the
+// configuration with the target image. The outcome is the UpgradeReady
+// condition. It returns true once the upgrade can start.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileUpgradeChecks(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	target := resources.ImageReference(cluster.Spec.Image)
+
+	current, err := r.runningVersion(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+	// Tags without a version, such as latest, leave the path unchecked
+	_, currentErr := version.Parse(current)
+	_, targetErr := version.Parse(cluster.Spec.Image.Tag)
+	if currentErr == nil && targetErr == nil {
+		if errs := validation.NewUpgradeValidator().ValidateVersionUpgrade(current, cluster.Spec.Image.Tag); len(errs) > 0 {
+			r.blockUpgrade(ctx, cluster, ConditionReasonUnsupportedUpgradePath,
+				fmt.Sprintf("Upgrade from %s to %s blocked: %s", current, cluster.Spec.Image.Tag, errs[0].Detail))
+			return false, nil
+		}
+	}
+
+	blocking, warnings, err := r.checkDatabaseStores(ctx, cluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to check the databases before the upgrade: %w", err)
+	}
+	if len(blocking) > 0 {
+		r.blockUpgrade(ctx, cluster, ConditionReasonStoreCheckFailed, strings.Join(blocking, "; "))
+		return false, nil
+	}
+
+	if cluster.Spec.UpgradeStrategy == nil || !cluster.Spec.UpgradeStrategy.SkipConfigCheck {
+		passed, err := r.reconcileConfigCheck(ctx, cluster, target)
+		if err != nil || !passed {
+			return false, err
+		}
+	}
+
+	message := fmt.Sprintf("Upgrade to %s passed the checks", cluster.Spec.Image.Tag)
+	if len(warnings) > 0 {
+		message += ". " + strings.Join(warnings, "; ")
+	}
+	r.setUpgradeReadyCondition(ctx, cluster, metav1.ConditionTrue, ConditionReasonUpgradeChecksPassed, message)
+	return true, nil
+}
+
+// runningVersion returns the image tag the server StatefulSet runs, or the
+// version in the status when the StatefulSet does not exist
+func (r *Neo4jEnterpriseClusterReconciler) runningVersion(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (string, error) {
+	sts := &appsv1.StatefulSet{}
+	err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, sts)
+	if errors.IsNotFound(err) {
+		return cluster.Status.Version, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	for _, container := range sts.Spec.Template.Spec.Containers {
+		if container.Name == "neo4j" {
+			parts := strings.Split(container.Image, ":")
+			if len(parts) > 1 {
+				return parts[len(parts)-1], nil
+			}
+		}
+	}
+	return cluster.Status.Version, nil
+}
+
+// checkDatabaseStores returns the findings on the database allocations that
+// block an upgrade, such as quarantined databases, and those that only
+// warn, such as deprecated store formats
+func (r *Neo4jEnterpriseClusterReconciler) checkDatabaseStores(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) ([]string, []string, error) {
+	c, closeClient, err := r.connectForUpgradePreflight(ctx, cluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to Neo4j: %w", err)
+	}
+	defer closeClient()
+
+	stores, err := c.GetDatabaseStores(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blocking, warnings []string
+	deprecated := map[string]bool{}
+	for _, store := range stores {
+		if store.Status == "quarantined" {
+			finding := fmt.Sprintf("database %s on %s is quarantined", store.Name, store.Address)
+			if store.StatusMessage != "" {
+				finding += ": " + store.StatusMessage
+			}
+			blocking = append(blocking, finding)
+		}
+		// The store is <engine>-<format>-<version>, e.g. record-aligned-1.1
+		parts := strings.Split(store.Store, "-")
+		if len(parts) == 3 && deprecatedStoreFormats[parts[1]] && !deprecated[store.Name] {
+			deprecated[store.Name] = true
+			warnings = append(warnings, fmt.Sprintf("database %s uses the deprecated %s store format, migrate it to block", store.Name, parts[1]))
+		}
+	}
+	return blocking, warnings, nil
+}
+
+// reconcileConfigCheck runs neo4j-admin server validate-config of the target
+// image against the configuration of the cluster in a Job, which catches
+// settings the new version removed or renamed before a server fails to
+// start with them. It returns true once the Job for the target image
+// succeeded.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileConfigCheck(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, target string) (bool, error) {
+	name := upgradeCheckName(cluster)
+	job := &batchv1.Job{}
+	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: cluster.Namespace}, job)
+	switch {
+	case err == nil && job.Annotations[UpgradeCheckImageAnnotation] != target:
+		// Job of an earlier target; the next reconcile starts the new check
+		return false, r.deleteUpgradeCheck(ctx, cluster)
+	case err == nil && job.Status.Succeeded > 0:
+		return true, nil
+	case err == nil && job.Status.Failed > 0:
+		message := fmt.Sprintf("Configuration check with %s failed, see the logs of Job %s", target, name)
+		if output, err := jobTerminationMessage(ctx, r.Client, job, upgradeCheckContainerName); err == nil {
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			message += ": " + lines[len(lines)-1]
+		}
+		r.blockUpgrade(ctx, cluster, ConditionReasonConfigCheckFailed, message)
+		return false, nil
+	case err == nil:
+		return false, nil
+	case !errors.IsNotFound(err):
+		return false, err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Namespace,
+			Labels:    upgradeCheckLabels(cluster),
+		},
+		Data: map[string]string{"neo4j.conf": resources.BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]},
+	}
+	if err := r.createOrUpdateResource(ctx, configMap, cluster); err != nil {
+		return false, fmt.Errorf("failed to create ConfigMap %s: %w", name, err)
+	}
+	job = buildUpgradeCheckJob(cluster, target)
+	if err := controllerutil.SetControllerReference(cluster, job, r.Scheme); err != nil {
+		return false, err
+	}
+	if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("failed to create Job %s: %w", name, err)
+	}
+	r.setUpgradeReadyCondition(ctx, cluster, metav1.ConditionUnknown, ConditionReasonUpgradeChecksRunning,
+		fmt.Sprintf("Checking the configuration with %s", target))
+	return false, nil
+}
+
+// buildUpgradeCheckJob returns the Job that validates the configuration of
+// the cluster with the target image
+func buildUpgradeCheckJob(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, target string) *batchv1.Job {
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        upgradeCheckName(cluster),
+			Namespace:   cluster.Namespace,
+			Labels:      upgradeCheckLabels(cluster),
+			Annotations: map[string]string{UpgradeCheckImageAnnotation: target},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: upgradeCheckLabels(cluster)},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: resources.ImagePullSecrets(cluster.Spec.Image),
+					Containers: []corev1.Container{
+						{
+							Name:                     upgradeCheckContainerName,
+							Image:                    target,
+							ImagePullPolicy:          resources.ImagePullPolicy(cluster.Spec.Image),
+							Command:                  []string{"neo4j-admin", "server", "validate-config"},
+							Env:                      []corev1.EnvVar{{Name: "NEO4J_CONF", Value: "/conf"}},
+							VolumeMounts:             []corev1.VolumeMount{{Name: "config", MountPath: "/conf", ReadOnly: true}},
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: upgradeCheckName(cluster)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resetUpgradeChecks removes the UpgradeReady and UpgradeFailed conditions
+// and the config check once no upgrade is pending, after it completed or
+// the image was set back
+func (r *Neo4jEnterpriseClusterReconciler) resetUpgradeChecks(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	if findCondition(cluster.Status.Conditions, ConditionTypeUpgradeReady) == nil &&
+		findCondition(cluster.Status.Conditions, ConditionTypeUpgradeFailed) == nil {
+		return nil
+	}
+	if err := r.deleteUpgradeCheck(ctx, cluster); err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		removedReady := meta.RemoveStatusCondition(&latest.Status.Conditions, ConditionTypeUpgradeReady)
+		removedFailed := meta.RemoveStatusCondition(&latest.Status.Conditions, ConditionTypeUpgradeFailed)
+		if !removedReady && !removedFailed {
+			return nil
+		}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+}
+
+// deleteUpgradeCheck deletes the config check Job and its ConfigMap
+func (r *Neo4jEnterpriseClusterReconciler) deleteUpgradeCheck(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	name := upgradeCheckName(cluster)
+	propagation := metav1.DeletePropagationBackground
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}}
+	if err := r.Delete(ctx, job, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Job %s: %w", name, err)
+	}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}}
+	if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ConfigMap %s: %w", name, err)
+	}
+	return nil
+}
+
+// blockUpgrade records a finding that keeps the upgrade from starting
+func (r *Neo4jEnterpriseClusterReconciler) blockUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, reason, message string) {
+	log.FromContext(ctx).Info("Upgrade blocked", "reason", reason, "message", message)
+	if r.setUpgradeReadyCondition(ctx, cluster, metav1.ConditionFalse, reason, message) {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonUpgradeBlocked, message)
+	}
+}
+
+// setUpgradeReadyCondition records the outcome of the upgrade checks, and
+// returns true if the condition changed
+func (r *Neo4jEnterpriseClusterReconciler) setUpgradeReadyCondition(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, status metav1.ConditionStatus, reason, message string) bool {
+	changed := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		existing := findCondition(latest.Status.Conditions, ConditionTypeUpgradeReady)
+		if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		SetNamedCondition(&latest.Status.Conditions, ConditionTypeUpgradeReady, latest.Generation, status, reason, message)
+		changed = true
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update UpgradeReady condition")
+	}
+	return changed
+}
+
+// connectForUpgradePreflight opens a Neo4j connection for the upgrade
+// checks
+func (r *Neo4jEnterpriseClusterReconciler) connectForUpgradePreflight(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (upgradePreflightClient, func(), error) {
+	if r.newUpgradePreflightClient != nil {
+		c, err := r.newUpgradePreflightClient(ctx, cluster)
+		return c, func() {}, err
+	}
+	neo4jClient, err := r.createNeo4jClient(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neo4jClient, func() { _ = neo4jClient.Close() }, nil
+}
+
+func upgradeCheckName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return cluster.Name + "-upgrade-check"
+}
+
+func upgradeCheckLabels(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "neo4j",
+		"app.kubernetes.io/instance":   cluster.Name,
+		"app.kubernetes.io/component":  "upgrade-check",
+		"app.kubernetes.io/managed-by": "neo4j-operator",
+		"neo4j.com/cluster":            cluster.Name,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+type fakeUpgradePreflightClient struct {
+	stores []neo4jclient.DatabaseStore
+}
+
+func (f *fakeUpgradePreflightClient) GetDatabaseStores(context.Context) ([]neo4jclient.DatabaseStore, error) {
+	return f.stores, nil
+}
+
+func upgradePreflightTestSetup(t *testing.T, runningImage, targetTag string, neo4j *fakeUpgradePreflightClient) (client.Client, *Neo4jEnterpriseClusterReconciler, *record.FakeRecorder, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Image.Tag = targetTag
+	cluster.Status.Phase = "Ready"
+	sts := serverSTS("prod", "default")
+	sts.Spec.Template.Spec.Containers[0].Image = runningImage
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, sts).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}, &batchv1.Job{}).Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &Neo4jEnterpriseClusterReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: recorder,
+		newUpgradePreflightClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (upgradePreflightClient, error) {
+			return neo4j, nil
+		},
+	}
+	return c, r, recorder, cluster
+}
+
+func upgradeReadyCondition(t *testing.T, c client.Client, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *metav1.Condition {
+	t.Helper()
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cluster), latest))
+	return findCondition(latest.Status.Conditions, ConditionTypeUpgradeReady)
+}
+
+func TestReconcileUpgradeChecksBlocksUpgradePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		running string
+		target  string
+		message string
+	}{
+		{"downgrade", "neo4j:2025.06.0-enterprise", "5.26.0-enterprise", "downgrade"},
+		{"skips 5.26", "neo4j:5.20.0-enterprise", "2025.01.0-enterprise", "upgrade to 5.26.x first"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, r, recorder, cluster := upgradePreflightTestSetup(t, tt.running, tt.target, &fakeUpgradePreflightClient{})
+
+			ready, err := r.reconcileUpgradeChecks(context.Background(), cluster)
+			require.NoError(t, err)
+			assert.False(t, ready)
+			condition := upgradeReadyCondition(t, c, cluster)
+			require.NotNil(t, condition)
+			assert.Equal(t, metav1.ConditionFalse, condition.Status)
+			assert.Equal(t, ConditionReasonUnsupportedUpgradePath, condition.Reason)
+			assert.Contains(t, condition.Message, tt.message)
+			assert.Contains(t, <-recorder.Events, EventReasonUpgradeBlocked)
+
+			// The finding is reported once
+			_, err = r.reconcileUpgradeChecks(context.Background(), cluster)
+			require.NoError(t, err)
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
+
+func TestReconcileUpgradeChecksBlocksQuarantinedDatabase(t *testing.T) {
+	neo4j := &fakeUpgradePreflightClient{stores: []neo4jclient.DatabaseStore{
+		{Name: "neo4j", Address: "prod-server-0:7687", Status: "online", Store: "record-aligned-1.1"},
+		{Name: "neo4j", Address: "prod-server-1:7687", Status: "quarantined", StatusMessage: "store corrupted", Store: "record-aligned-1.1"},
+	}}
+	c, r, _, cluster := upgradePreflightTestSetup(t, "neo4j:5.26.0-enterprise", "2025.01.0-enterprise", neo4j)
+
+	ready, err := r.reconcileUpgradeChecks(context.Background(), cluster)
+	require.NoError(t, err)
+	assert.False(t, ready)
+	condition := upgradeReadyCondition(t, c, cluster)
+	require.NotNil(t, condition)
+	assert.Equal(t, ConditionReasonStoreCheckFailed, condition.Reason)
+	assert.Equal(t, "database neo4j on prod-server-1:7687 is quarantined: store corrupted", condition.Message)
+
+	job := &batchv1.Job{}
+	err = c.Get(context.Background(), client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, job)
+	assert.True(t, errors.IsNotFound(err), "the config check waits for the databases")
+}
+
+func TestReconcileUpgradeChecksConfigCheck(t *testing.T) {
+	neo4j := &fakeUpgradePreflightClient{stores: []neo4jclient.DatabaseStore{
+		{Name: "neo4j", Address: "prod-server-0:7687", Status: "online", Store: "record-standard-1.1"},
+		{Name: "neo4j", Address: "prod-server-1:7687", Status: "online", Store: "record-standard-1.1"},
+	}}
+	c, r, _, cluster := upgradePreflightTestSetup(t, "neo4j:5.26.0-enterprise", "2025.01.0-enterprise", neo4j)
+	ctx := context.Background()
+
+	// The first reconcile starts the check with the target image
+	ready, err := r.reconcileUpgradeChecks(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, ready)
+	condition := upgradeReadyCondition(t, c, cluster)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionUnknown, condition.Status)
+	assert.Equal(t, ConditionReasonUpgradeChecksRunning, condition.Reason)
+
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, job))
+	assert.Equal(t, "neo4j:2025.01.0-enterprise", job.Annotations[UpgradeCheckImageAnnotation])
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "neo4j:2025.01.0-enterprise", container.Image)
+	assert.Equal(t, []string{"neo4j-admin", "server", "validate-config"}, container.Command)
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, configMap))
+	assert.Contains(t, configMap.Data["neo4j.conf"], "server.")
+
+	// Once the Job succeeded the upgrade can start, with a warning on the
+	// store format
+	job.Status.Succeeded = 1
+	require.NoError(t, c.Status().Update(ctx, job))
+	ready, err = r.reconcileUpgradeChecks(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, ready)
+	condition = upgradeReadyCondition(t, c, cluster)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ConditionReasonUpgradeChecksPassed, condition.Reason)
+	assert.Equal(t, "Upgrade to 2025.01.0-enterprise passed the checks. "+
+		"database neo4j uses the deprecated standard store format, migrate it to block", condition.Message)
+
+	// A new target replaces the check of the previous one
+	cluster.Spec.Image.Tag = "2025.02.0-enterprise"
+	ready, err = r.reconcileUpgradeChecks(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, ready)
+	err = c.Get(ctx, client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, job)
+	assert.True(t, errors.IsNotFound(err))
+
+	// The condition and the check are removed once no upgrade is pending
+	require.NoError(t, r.resetUpgradeChecks(ctx, cluster))
+	assert.Nil(t, upgradeReadyCondition(t, c, cluster))
+	err = c.Get(ctx, client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, configMap)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestReconcileUpgradeChecksConfigCheckFailed(t *testing.T) {
+	c, r, recorder, cluster := upgradePreflightTestSetup(t, "neo4j:5.26.0-enterprise", "2025.01.0-enterprise", &fakeUpgradePreflightClient{})
+	ctx := context.Background()
+
+	_, err := r.reconcileUpgradeChecks(ctx, cluster)
+	require.NoError(t, err)
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, job))
+	job.Status.Failed = 1
+	require.NoError(t, c.Status().Update(ctx, job))
+
+	ready, err := r.reconcileUpgradeChecks(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, ready)
+	condition := upgradeReadyCondition(t, c, cluster)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ConditionReasonConfigCheckFailed, condition.Reason)
+	assert.Contains(t, condition.Message, "prod-upgrade-check")
+	assert.Contains(t, <-recorder.Events, EventReasonUpgradeBlocked)
+}
+
+func TestReconcileUpgradeChecksSkipConfigCheck(t *testing.T) {
+	c, r, _, cluster := upgradePreflightTestSetup(t, "neo4j:5.26.0-enterprise", "5.26.1-enterprise", &fakeUpgradePreflightClient{})
+	cluster.Spec.UpgradeStrategy = &neo4jv1alpha1.UpgradeStrategySpec{SkipConfigCheck: true}
+
+	ready, err := r.reconcileUpgradeChecks(context.Background(), cluster)
+	require.NoError(t, err)
+	assert.True(t, ready)
+	job := &batchv1.Job{}
+	err = c.Get(context.Background(), client.ObjectKey{Name: "prod-upgrade-check", Namespace: "default"}, job)
+	assert.True(t, errors.IsNotFound(err))
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// configHashAnnotation is the pod template annotation of the server
+// StatefulSet carrying the hash of the configuration the pods run with
+const configHashAnnotation = "neo4j.neo4j.com/config-hash"
+
+// serverTemplate is the part of the server pod template an upgrade changes
+// and a rollback restores
+type serverTemplate struct {
+	image      string
+	configHash string
+}
+
+// upgradeRolledBackFor reports whether the upgrade to the current
+// spec.image.tag was rolled back, which holds it until the tag changes
+func upgradeRolledBackFor(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	upgrade := cluster.Status.UpgradeStatus
+	return upgrade != nil && upgrade.Phase == "Failed" && upgrade.RolledBackTo != "" &&
+		upgrade.TargetVersion == cluster.Spec.Image.Tag
+}
+
+// rolledBackImage returns the image the servers keep after the upgrade to
+// the current spec.image.tag was rolled back, empty if it was not
+func rolledBackImage(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !upgradeRolledBackFor(cluster) {
+		return ""
+	}
+	return cluster.Status.UpgradeStatus.RolledBackTo
+}
+
+// maxUnavailableDuringUpgrade returns
+// spec.upgradeStrategy.maxUnavailableDuringUpgrade, 1 if it is not set
+func maxUnavailableDuringUpgrade(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) int {
+	if cluster.Spec.UpgradeStrategy != nil && cluster.Spec.UpgradeStrategy.MaxUnavailableDuringUpgrade != nil {
+		return int(*cluster.Spec.UpgradeStrategy.MaxUnavailableDuringUpgrade)
+	}
+	return 1
+}
+
+// currentServerTemplate returns the image and configuration hash the server
+// StatefulSet runs
+func (r *Neo4jEnterpriseClusterReconciler) currentServerTemplate(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (serverTemplate, error) {
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, sts); err != nil {
+		return serverTemplate{}, fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	if len(sts.Spec.Template.Spec.Containers) == 0 {
+		return serverTemplate{}, fmt.Errorf("server StatefulSet has no containers defined")
+	}
+	return serverTemplate{
+		image:      sts.Spec.Template.Spec.Containers[0].Image,
+		configHash: sts.Spec.Template.Annotations[configHashAnnotation],
+	}, nil
+}
+
+// serverReadinessFailures describes every server pod that is not ready,
+// with the reason its containers report
+func (r *Neo4jEnterpriseClusterReconciler) serverReadinessFailures(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) ([]string, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list server pods: %w", err)
+	}
+	var failures []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp == nil && isPodReady(pod) {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", pod.Name, podFailureReason(pod)))
+	}
+	slices.Sort(failures)
+	return failures, nil
+}
+
+// podFailureReason explains why a pod is not ready, from the state of its
+// neo4j container or else from its conditions
+func podFailureReason(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "terminating"
+	}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status.Ready {
+			continue
+		}
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" && waiting.Reason != "PodInitializing" {
+			reason := fmt.Sprintf("container %s %s", status.Name, waiting.Reason)
+			if waiting.Message != "" {
+				reason += ": " + waiting.Message
+			}
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				reason += fmt.Sprintf(" (last exit %d, %s)", terminated.ExitCode, terminated.Reason)
+			}
+			return reason
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return fmt.Sprintf("container %s exited with %d, %s", status.Name, terminated.ExitCode, terminated.Reason)
+		}
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue && condition.Message != "" {
+			return fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+		}
+	}
+	if pod.Status.Phase == corev1.PodRunning {
+		return "readiness probe failing"
+	}
+	return string(pod.Status.Phase)
+}
+
+// rollBackServers restores the image and configuration hash of the server
+// pod template and lets the StatefulSet roll every pod back. Pods that are
+// not ready on the failed revision are deleted, as the StatefulSet
+// controller waits for them to become ready before it replaces them.
+func (r *Neo4jEnterpriseClusterReconciler) rollBackServers(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, previous serverTemplate) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sts := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, client.ObjectKey{Name: cluster.Name + "-server", Namespace: cluster.Namespace}, sts); err != nil {
+			return fmt.Errorf("failed to get server StatefulSet: %w", err)
+		}
+		sts.Spec.Template.Spec.Containers[0].Image = previous.image
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = map[string]string{}
+		}
+		if previous.configHash != "" {
+			sts.Spec.Template.Annotations[configHashAnnotation] = previous.configHash
+		}
+		sts.Spec.Template.Annotations["neo4j.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
+		partition := int32(0)
+		sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+		}
+		return r.Update(ctx, sts)
+	})
+	if err != nil {
+		return err
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return fmt.Errorf("failed to list server pods: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || isPodReady(pod) || len(pod.Spec.Containers) == 0 || pod.Spec.Containers[0].Image == previous.image {
+			continue
+		}
+		if err := r.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete pod %s: %w", pod.Name, err)
+		}
+		log.FromContext(ctx).Info("Deleted failed server pod for the rollback", "pod", pod.Name)
+	}
+	return nil
+}
+
+// recordUpgradeRollback marks the upgrade as rolled back to an image in
+// status.upgradeStatus and on the UpgradeFailed condition, with the pod
+// failures that caused it
+func (r *Neo4jEnterpriseClusterReconciler) recordUpgradeRollback(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, image, reason, eventReason, cause string, failures []string) error {
+	message := fmt.Sprintf("Upgrade to %s rolled back to %s: %s", cluster.Status.UpgradeStatus.TargetVersion, image, cause)
+	if len(failures) > 0 {
+		message += "; " + strings.Join(failures, "; ")
+	}
+	now := metav1.Now()
+	if err := r.updateUpgradeStatus(ctx, cluster, func(upgrade *neo4jv1alpha1.UpgradeStatus) {
+		upgrade.Phase = "Failed"
+		upgrade.CompletionTime = &now
+		upgrade.CurrentStep = "Rolled back"
+		upgrade.Message = message
+		upgrade.LastError = cause
+		upgrade.RolledBackTo = image
+	}); err != nil {
+		return err
+	}
+	if err := r.setUpgradeFailedCondition(ctx, cluster, reason, message); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Upgrade rolled back", "image", image, "cause", cause, "failures", failures)
+	r.Recorder.Event(cluster, corev1.EventTypeWarning, eventReason, message)
+	return nil
+}
+
+// setUpgradeFailedCondition sets the UpgradeFailed condition
+func (r *Neo4jEnterpriseClusterReconciler) setUpgradeFailedCondition(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, reason, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		SetNamedCondition(&latest.Status.Conditions, ConditionTypeUpgradeFailed, latest.Generation, metav1.ConditionTrue, reason, message)
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.ResourceVersion = latest.ResourceVersion
+		cluster.Status.Conditions = latest.Status.Conditions
+		return nil
+	})
+}
+
+// rollBackFailedUpgrade reverts the servers to the template they ran before
+// a failed upgrade when more of them than maxUnavailableDuringUpgrade are
+// not ready. It returns true if it rolled back.
+func (r *Neo4jEnterpriseClusterReconciler) rollBackFailedUpgrade(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, previous serverTemplate, cause error) (bool, error) {
+	failures, err := r.serverReadinessFailures(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+	if len(failures) <= maxUnavailableDuringUpgrade(cluster) {
+		return false, nil
+	}
+	if err := r.rollBackServers(ctx, cluster, previous); err != nil {
+		return false, fmt.Errorf("failed to roll back the upgrade: %w", err)
+	}
+	if cluster.Status.UpgradeStatus == nil {
+		cluster.Status.UpgradeStatus = &neo4jv1alpha1.UpgradeStatus{TargetVersion: cluster.Spec.Image.Tag}
+	}
+	if err := r.recordUpgradeRollback(ctx, cluster, previous.image, ConditionReasonUpgradeRolledBack, EventReasonUpgradeRolledBack,
+		cause.Error(), failures); err != nil {
+		return true, err
+	}
+	_ = r.updateClusterStatus(ctx, cluster, "Failed", cluster.Status.UpgradeStatus.Message)
+	return true, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func rollbackTestPod(name, image string, ready bool) *corev1.Pod {
+	pod := restartTestPod(name, "rev")
+	pod.Spec.Containers = []corev1.Container{{Name: "neo4j", Image: image}}
+	if !ready {
+		pod.Status.Conditions[0].Status = corev1.ConditionFalse
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name: "neo4j",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+				Reason: "CrashLoopBackOff", Message: "back-off 5m0s restarting failed container",
+			}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 1, Reason: "Error",
+			}},
+		}}
+	}
+	return pod
+}
+
+func rollbackTestSetup(t *testing.T, pods ...client.Object) (client.Client, *Neo4jEnterpriseClusterReconciler, *record.FakeRecorder, *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	t.Helper()
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Image.Tag = "2025.01.0-enterprise"
+	cluster.Status.UpgradeStatus = &neo4jv1alpha1.UpgradeStatus{Phase: "InProgress", TargetVersion: "2025.01.0-enterprise"}
+	sts := serverSTS("prod", "default")
+	sts.Spec.Replicas = int32PtrCM(3)
+	sts.Spec.Template.Spec.Containers[0].Image = "neo4j:2025.01.0-enterprise"
+	sts.Spec.Template.Annotations = map[string]string{configHashAnnotation: "new"}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(append(pods, cluster, sts)...).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: recorder}
+	return c, r, recorder, cluster
+}
+
+func TestPodFailureReason(t *testing.T) {
+	crashing := rollbackTestPod("prod-server-1", "neo4j:2025.01.0-enterprise", false)
+	assert.Equal(t, "container neo4j CrashLoopBackOff: back-off 5m0s restarting failed container (last exit 1, Error)",
+		podFailureReason(crashing))
+
+	unschedulable := restartTestPod("prod-server-2", "rev")
+	unschedulable.Status.Phase = corev1.PodPending
+	unschedulable.Status.Conditions = []corev1.PodCondition{{
+		Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Message: "0/3 nodes are available",
+	}}
+	assert.Equal(t, "PodScheduled: 0/3 nodes are available", podFailureReason(unschedulable))
+
+	probing := restartTestPod("prod-server-0", "rev")
+	probing.Status.Conditions[0].Status = corev1.ConditionFalse
+	assert.Equal(t, "readiness probe failing", podFailureReason(probing))
+}
+
+func TestRollBackFailedUpgrade(t *testing.T) {
+	c, r, recorder, cluster := rollbackTestSetup(t,
+		rollbackTestPod("prod-server-0", "neo4j:5.26.0-enterprise", true),
+		rollbackTestPod("prod-server-1", "neo4j:2025.01.0-enterprise", false),
+		rollbackTestPod("prod-server-2", "neo4j:2025.01.0-enterprise", false),
+	)
+	ctx := context.Background()
+	previous := serverTemplate{image: "neo4j:5.26.0-enterprise", configHash: "old"}
+
+	rolledBack, err := r.rollBackFailedUpgrade(ctx, cluster, previous, errors.New("server prod-server-1 did not become ready"))
+	require.NoError(t, err)
+	assert.True(t, rolledBack)
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.Equal(t, "neo4j:5.26.0-enterprise", sts.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "old", sts.Spec.Template.Annotations[configHashAnnotation])
+	assert.Equal(t, int32(0), *sts.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	// The failed pods are replaced, the ready one is rolled by the StatefulSet
+	for _, name := range []string{"prod-server-1", "prod-server-2"} {
+		err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &corev1.Pod{})
+		assert.True(t, apierrors.IsNotFound(err), name)
+	}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-0", Namespace: "default"}, &corev1.Pod{}))
+
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	upgrade := latest.Status.UpgradeStatus
+	assert.Equal(t, "Failed", upgrade.Phase)
+	assert.Equal(t, "neo4j:5.26.0-enterprise", upgrade.RolledBackTo)
+	assert.Equal(t, "server prod-server-1 did not become ready", upgrade.LastError)
+	condition := findCondition(latest.Status.Conditions, ConditionTypeUpgradeFailed)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ConditionReasonUpgradeRolledBack, condition.Reason)
+	assert.Contains(t, condition.Message, "Upgrade to 2025.01.0-enterprise rolled back to neo4j:5.26.0-enterprise")
+	assert.Contains(t, condition.Message, "prod-server-1: container neo4j CrashLoopBackOff")
+	assert.Contains(t, condition.Message, "prod-server-2: container neo4j CrashLoopBackOff")
+	assert.Contains(t, <-recorder.Events, EventReasonUpgradeRolledBack)
+
+	// The servers stay on the previous image until spec.image.tag changes
+	assert.True(t, upgradeRolledBackFor(latest))
+	assert.Equal(t, "neo4j:5.26.0-enterprise", rolledBackImage(latest))
+	latest.Spec.Image.Tag = "2025.02.0-enterprise"
+	assert.False(t, upgradeRolledBackFor(latest))
+	assert.Empty(t, rolledBackImage(latest))
+}
+
+func TestRollBackFailedUpgradeWithinMaxUnavailable(t *testing.T) {
+	c, r, recorder, cluster := rollbackTestSetup(t,
+		rollbackTestPod("prod-server-0", "neo4j:5.26.0-enterprise", true),
+		rollbackTestPod("prod-server-1", "neo4j:5.26.0-enterprise", true),
+		rollbackTestPod("prod-server-2", "neo4j:2025.01.0-enterprise", false),
+	)
+	ctx := context.Background()
+
+	rolledBack, err := r.rollBackFailedUpgrade(ctx, cluster, serverTemplate{image: "neo4j:5.26.0-enterprise"}, errors.New("timeout"))
+	require.NoError(t, err)
+	assert.False(t, rolledBack, "one unready server is within the default of 1")
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server", Namespace: "default"}, sts))
+	assert.Equal(t, "neo4j:2025.01.0-enterprise", sts.Spec.Template.Spec.Containers[0].Image)
+	assert.Empty(t, recorder.Events)
+
+	// With maxUnavailableDuringUpgrade 0 the first failed server rolls back
+	cluster.Spec.UpgradeStrategy = &neo4jv1alpha1.UpgradeStrategySpec{MaxUnavailableDuringUpgrade: int32PtrCM(0)}
+	rolledBack, err = r.rollBackFailedUpgrade(ctx, cluster, serverTemplate{image: "neo4j:5.26.0-enterprise"}, errors.New("timeout"))
+	require.NoError(t, err)
+	assert.True(t, rolledBack)
+}
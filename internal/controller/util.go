@@ -17,7 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
 )
 
 // standaloneAsCluster converts a Neo4jEnterpriseStandalone into a synthetic
@@ -33,3 +42,44 @@ func standaloneAsCluster(s *neo4jv1alpha1.Neo4jEnterpriseStandalone) *neo4jv1alp
 	c.Spec.Topology.Servers = 1
 	return c
 }
+
+// jobTerminationMessage returns the termination message left by the named
+// container in one of the Job's pods.
+func jobTerminationMessage(ctx context.Context, c client.Client, job *batchv1.Job, container string) (string, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == container && status.State.Terminated != nil && status.State.Terminated.Message != "" {
+				return status.State.Terminated.Message, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no terminated %s container for job %s", container, job.Name)
+}
+
+// connectClusterRef resolves a clusterRef to a cluster or, failing that, a
+// standalone deployment in the namespace and connects to it once it is
+// ready, with the admin credentials and TLS settings of the deployment.
+func connectClusterRef(ctx context.Context, c client.Client, namespace, clusterRef string) (*neo4jclient.Client, error) {
+	key := types.NamespacedName{Name: clusterRef, Namespace: namespace}
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	if err := c.Get(ctx, key, cluster); err == nil {
+		if cluster.Status.Phase != "Ready" {
+			return nil, fmt.Errorf("target cluster %s is not ready", key.Name)
+		}
+		return neo4jclient.NewClientForEnterprise(cluster, c, getClusterConnectionSecretName(cluster))
+	}
+
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{}
+	if err := c.Get(ctx, key, standalone); err != nil {
+		return nil, fmt.Errorf("target %q not found as Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone in namespace %q", key.Name, key.Namespace)
+	}
+	if standalone.Status.Phase != "Ready" {
+		return nil, fmt.Errorf("target standalone %s is not ready", key.Name)
+	}
+	return neo4jclient.NewClientForEnterpriseStandalone(standalone, c, getStandaloneConnectionSecretName(standalone))
+}
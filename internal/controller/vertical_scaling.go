@@ -0,0 +1,480 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// resizeRequeueInterval is how often an in-place resize checks whether the
+// last resized pod got its new resources
+const resizeRequeueInterval = 10 * time.Second
+
+// Strategies of spec.verticalScaling
+const (
+	verticalScalingStaged  = "Staged"
+	verticalScalingInPlace = "InPlace"
+)
+
+// prepareResize starts the rollout of changed server resources before the
+// server StatefulSet is updated. The StatefulSet is switched to OnDelete,
+// so Kubernetes does not roll the pods itself: a staged resize is handed to
+// reconcileRollingRestart, an in-place resize to reconcileInPlaceResize.
+func (r *Neo4jEnterpriseClusterReconciler) prepareResize(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, desired *appsv1.StatefulSet) error {
+	logger := log.FromContext(ctx)
+
+	existing := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	current, found := serverContainerResources(existing.Spec.Template.Spec)
+	target, _ := serverContainerResources(desired.Spec.Template.Spec)
+	if !found || equality.Semantic.DeepEqual(current, target) {
+		return nil
+	}
+
+	// The pods restarted for a configuration change get the new resources too
+	if cluster.Status.RollingRestart != nil {
+		return nil
+	}
+	desired.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+
+	if resources.InPlaceResizeInProgress(cluster) {
+		if equality.Semantic.DeepEqual(cluster.Status.VerticalScaling.Resize.Resources, target) {
+			return nil
+		}
+		return r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+			status.Resize.Resources = target
+			status.Resize.Message = "Resizing the servers again for changed resources"
+		})
+	}
+
+	strategy := verticalScalingStaged
+	if cluster.Spec.VerticalScaling != nil && cluster.Spec.VerticalScaling.Strategy == verticalScalingInPlace {
+		switch {
+		case !r.Capabilities.AtLeast(1, 33):
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonInPlaceResizeSkipped,
+				"In-place resize needs Kubernetes 1.33 or later, restarting the servers instead")
+		case !r.templateDiffersOnlyInResources(existing.Spec.Template, desired.Spec.Template, target):
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonInPlaceResizeSkipped,
+				"The pod template changes beyond the resources, restarting the servers instead")
+		default:
+			strategy = verticalScalingInPlace
+		}
+	}
+
+	logger.Info("Resizing servers", "strategy", strategy, "resources", describeResources(target))
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonResizeStarted,
+		fmt.Sprintf("Resizing the servers to %s (%s)", describeResources(target), strategy))
+	if strategy == verticalScalingStaged {
+		return recordRollingRestart(ctx, r.Client, cluster, resourcesHash(target), rollingRestartReasonResize)
+	}
+	return r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+		now := metav1.Now()
+		status.Resize = &neo4jv1alpha1.ResizeStatus{
+			Resources: target,
+			StartTime: &now,
+			Message:   "Waiting for the servers to be resized",
+		}
+	})
+}
+
+// reconcileInPlaceResize resizes the server pods that still run an older
+// revision of the StatefulSet through their resize subresource, one at a
+// time and only while every server is available. A resized pod is relabeled
+// with the new revision, so the StatefulSet does not replace it later. A pod
+// whose node cannot fit the new size is restarted instead. It returns true
+// while the resize is in progress.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileInPlaceResize(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	if !resources.InPlaceResizeInProgress(cluster) {
+		return false, nil
+	}
+	resize := cluster.Status.VerticalScaling.Resize
+	logger := log.FromContext(ctx)
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-server", cluster.Name), Namespace: cluster.Namespace}, sts); err != nil {
+		if errors.IsNotFound(err) {
+			return false, r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+				status.Resize = nil
+			})
+		}
+		return true, fmt.Errorf("failed to get server StatefulSet: %w", err)
+	}
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision == "" {
+		return true, r.setResizeMessage(ctx, cluster, "Waiting for the StatefulSet controller to observe the new revision")
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}); err != nil {
+		return true, fmt.Errorf("failed to list server pods: %w", err)
+	}
+
+	var outdated []*corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || !isPodReady(pod) {
+			return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Waiting for %s to become ready", pod.Name))
+		}
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] != sts.Status.UpdateRevision {
+			outdated = append(outdated, pod)
+		}
+	}
+	if int32(len(pods.Items)) < replicas {
+		return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Waiting for %d server pods, found %d", replicas, len(pods.Items)))
+	}
+	if len(outdated) == 0 {
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonResizeCompleted,
+			fmt.Sprintf("All servers resized in place to %s", describeResources(resize.Resources)))
+		return false, r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+			status.Resize = nil
+		})
+	}
+	slices.SortFunc(outdated, func(a, b *corev1.Pod) int { return int(podOrdinal(b.Name) - podOrdinal(a.Name)) })
+	pod := outdated[0]
+	expected := podResources(resize.Resources)
+
+	index := slices.IndexFunc(pod.Spec.Containers, func(c corev1.Container) bool { return c.Name == resources.Neo4jContainer })
+	if index < 0 {
+		return true, fmt.Errorf("pod %s has no %s container", pod.Name, resources.Neo4jContainer)
+	}
+	if !equality.Semantic.DeepEqual(pod.Spec.Containers[index].Resources, expected) {
+		// Resizing the next pod waits until every server is available again
+		neo4jClient, closeClient, err := r.connectForRollingRestart(ctx, cluster)
+		if err != nil {
+			return true, err
+		}
+		defer closeClient()
+		members, err := neo4jClient.GetServerMembers(ctx)
+		if err != nil {
+			return true, err
+		}
+		for i := range pods.Items {
+			name := pods.Items[i].Name
+			if slices.Contains(cluster.Status.MaintenanceServers, name) {
+				continue
+			}
+			if member := memberForPod(members, name); member == nil || member.Health != "Available" {
+				return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Waiting for the server of %s to become available", name))
+			}
+		}
+
+		original := pod.DeepCopy()
+		pod.Spec.Containers[index].Resources = expected
+		if err := r.SubResource("resize").Patch(ctx, pod, client.StrategicMergeFrom(original)); err != nil {
+			return true, fmt.Errorf("failed to resize pod %s: %w", pod.Name, err)
+		}
+		logger.Info("Resizing server pod in place", "pod", pod.Name, "remaining", len(outdated)-1)
+		return true, r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+			status.Resize.LastResizedPod = pod.Name
+			status.Resize.Message = fmt.Sprintf("Resizing %s, %d servers left", pod.Name, len(outdated)-1)
+		})
+	}
+
+	if pending := podCondition(pod, corev1.PodResizePending); pending != nil {
+		if pending.Reason != corev1.PodReasonInfeasible {
+			return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Waiting for the node of %s to make room: %s", pod.Name, pending.Message))
+		}
+		// Its replacement is created from the new revision and scheduled again
+		if err := r.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); err != nil && !errors.IsNotFound(err) {
+			return true, fmt.Errorf("failed to restart pod %s: %w", pod.Name, err)
+		}
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, EventReasonResizeInfeasible,
+			fmt.Sprintf("The node of %s cannot fit %s, restarted it instead: %s", pod.Name, describeResources(resize.Resources), pending.Message))
+		return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Restarting %s, its node cannot fit the new resources", pod.Name))
+	}
+	if podCondition(pod, corev1.PodResizeInProgress) != nil || !containerResized(pod, expected) {
+		return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Waiting for %s to finish resizing", pod.Name))
+	}
+
+	original := pod.DeepCopy()
+	pod.Labels[appsv1.ControllerRevisionHashLabelKey] = sts.Status.UpdateRevision
+	if err := r.Patch(ctx, pod, client.MergeFrom(original)); err != nil {
+		return true, fmt.Errorf("failed to relabel pod %s: %w", pod.Name, err)
+	}
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, EventReasonServerResized,
+		fmt.Sprintf("Resized %s in place to %s", pod.Name, describeResources(resize.Resources)))
+	return true, r.setResizeMessage(ctx, cluster, fmt.Sprintf("Resized %s, %d servers left", pod.Name, len(outdated)-1))
+}
+
+// reconcileResourceRecommendations keeps the recommendation-only
+// VerticalPodAutoscaler of the servers and copies its recommendation for the
+// neo4j container into status.verticalScaling
+func (r *Neo4jEnterpriseClusterReconciler) reconcileResourceRecommendations(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
+	logger := log.FromContext(ctx)
+
+	vpa := resources.BuildVerticalPodAutoscalerForEnterprise(cluster)
+	if vpa == nil {
+		if cluster.Status.VerticalScaling == nil || cluster.Status.VerticalScaling.Recommendation == nil {
+			return nil
+		}
+		stale := &unstructured.Unstructured{}
+		stale.SetGroupVersionKind(resources.VerticalPodAutoscalerGVK)
+		stale.SetName(fmt.Sprintf("%s-server", cluster.Name))
+		stale.SetNamespace(cluster.Namespace)
+		if err := r.Delete(ctx, stale); err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete VerticalPodAutoscaler: %w", err)
+		}
+		return r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+			status.Recommendation = nil
+		})
+	}
+	if !r.Capabilities.Has(capabilities.VerticalPodAutoscaler) {
+		logger.V(1).Info("VerticalPodAutoscaler API not served; skipping resource recommendations")
+		return nil
+	}
+
+	if err := controllerutil.SetControllerReference(cluster, vpa, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference on VerticalPodAutoscaler: %w", err)
+	}
+	desired := vpa.DeepCopy()
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, vpa, func() error {
+		vpa.SetLabels(desired.GetLabels())
+		vpa.Object["spec"] = desired.Object["spec"]
+		return nil
+	}); err != nil {
+		if meta.IsNoMatchError(err) {
+			logger.Info("VerticalPodAutoscaler API not available; skipping resource recommendations")
+			return nil
+		}
+		return fmt.Errorf("failed to create or update VerticalPodAutoscaler: %w", err)
+	}
+
+	recommendation := recommendationFromVPA(vpa)
+	if recommendation == nil {
+		return nil
+	}
+	if status := cluster.Status.VerticalScaling; status != nil && status.Recommendation != nil {
+		previous := status.Recommendation.DeepCopy()
+		previous.LastUpdated = nil
+		if equality.Semantic.DeepEqual(previous, recommendation) {
+			return nil
+		}
+	}
+	now := metav1.Now()
+	recommendation.LastUpdated = &now
+	return r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+		status.Recommendation = recommendation
+	})
+}
+
+// recommendationFromVPA reads the recommendation for the neo4j container
+// from the status of a VerticalPodAutoscaler
+func recommendationFromVPA(vpa *unstructured.Unstructured) *neo4jv1alpha1.ResourceRecommendation {
+	containers, _, _ := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+	for _, item := range containers {
+		container, ok := item.(map[string]interface{})
+		if !ok || container["containerName"] != resources.Neo4jContainer {
+			continue
+		}
+		recommendation := &neo4jv1alpha1.ResourceRecommendation{
+			Target:     resourceListFrom(container, "target"),
+			LowerBound: resourceListFrom(container, "lowerBound"),
+			UpperBound: resourceListFrom(container, "upperBound"),
+		}
+		if recommendation.Target == nil {
+			return nil
+		}
+		return recommendation
+	}
+	return nil
+}
+
+// resourceListFrom parses a map of quantities, skipping invalid ones
+func resourceListFrom(obj map[string]interface{}, field string) corev1.ResourceList {
+	values, found, _ := unstructured.NestedStringMap(obj, field)
+	if !found {
+		return nil
+	}
+	list := corev1.ResourceList{}
+	for name, value := range values {
+		if quantity, err := resource.ParseQuantity(value); err == nil {
+			list[corev1.ResourceName(name)] = quantity
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+// setResizeMessage records what the in-place resize waits for
+func (r *Neo4jEnterpriseClusterReconciler) setResizeMessage(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, message string) error {
+	return r.updateVerticalScalingStatus(ctx, cluster, func(status *neo4jv1alpha1.VerticalScalingStatus) {
+		if status.Resize != nil {
+			status.Resize.Message = message
+		}
+	})
+}
+
+// updateVerticalScalingStatus applies mutate to status.verticalScaling,
+// which is dropped once it holds neither a resize nor a recommendation
+func (r *Neo4jEnterpriseClusterReconciler) updateVerticalScalingStatus(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, mutate func(*neo4jv1alpha1.VerticalScalingStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		desired := &neo4jv1alpha1.VerticalScalingStatus{}
+		if latest.Status.VerticalScaling != nil {
+			desired = latest.Status.VerticalScaling.DeepCopy()
+		}
+		mutate(desired)
+		if desired.Resize == nil && desired.Recommendation == nil {
+			desired = nil
+		}
+		if equality.Semantic.DeepEqual(latest.Status.VerticalScaling, desired) {
+			cluster.Status.VerticalScaling = latest.Status.VerticalScaling
+			return nil
+		}
+		latest.Status.VerticalScaling = desired
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		cluster.Status.VerticalScaling = latest.Status.VerticalScaling
+		cluster.ResourceVersion = latest.ResourceVersion
+		return nil
+	})
+}
+
+// serverContainerResources returns the resources of the neo4j container
+func serverContainerResources(spec corev1.PodSpec) (corev1.ResourceRequirements, bool) {
+	for _, container := range spec.Containers {
+		if container.Name == resources.Neo4jContainer {
+			return container.Resources, true
+		}
+	}
+	return corev1.ResourceRequirements{}, false
+}
+
+// templateDiffersOnlyInResources tells whether the desired pod template
+// changes nothing but the resources of the neo4j container that the
+// reconciler would roll out. Plugin delivery containers and volumes are
+// kept by the update, so they are not compared.
+func (r *Neo4jEnterpriseClusterReconciler) templateDiffersOnlyInResources(current, desired corev1.PodTemplateSpec, target corev1.ResourceRequirements) bool {
+	resized := current.DeepCopy()
+	resized.Spec.InitContainers = slices.DeleteFunc(resized.Spec.InitContainers, isPluginInitContainer)
+	resized.Spec.Volumes = slices.DeleteFunc(resized.Spec.Volumes, isPluginSourceVolume)
+	for i := range resized.Spec.Containers {
+		if resized.Spec.Containers[i].Name == resources.Neo4jContainer {
+			resized.Spec.Containers[i].Resources = target
+		}
+	}
+	return !r.hasSignificantTemplateChanges(*resized, desired)
+}
+
+// podResources returns the resources a pod created from a template with the
+// given container resources gets: requests default to the limits
+func podResources(template corev1.ResourceRequirements) corev1.ResourceRequirements {
+	requirements := *template.DeepCopy()
+	for name, limit := range requirements.Limits {
+		if _, set := requirements.Requests[name]; !set {
+			if requirements.Requests == nil {
+				requirements.Requests = corev1.ResourceList{}
+			}
+			requirements.Requests[name] = limit
+		}
+	}
+	return requirements
+}
+
+// containerResized tells whether the kubelet reports the neo4j container of
+// a pod with the expected resources. Kubelets that do not report resources
+// are trusted once no resize condition is left.
+func containerResized(pod *corev1.Pod, expected corev1.ResourceRequirements) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != resources.Neo4jContainer || status.Resources == nil {
+			continue
+		}
+		for _, pair := range []struct{ want, got corev1.ResourceList }{
+			{expected.Requests, status.Resources.Requests},
+			{expected.Limits, status.Resources.Limits},
+		} {
+			for name, quantity := range pair.want {
+				if got, ok := pair.got[name]; !ok || !got.Equal(quantity) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// podCondition returns a condition of a pod, or nil when it is not set
+func podCondition(pod *corev1.Pod, conditionType corev1.PodConditionType) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == conditionType && pod.Status.Conditions[i].Status == corev1.ConditionTrue {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// describeResources renders the CPU and memory of resources for events
+func describeResources(requirements corev1.ResourceRequirements) string {
+	describe := func(list corev1.ResourceList) string {
+		cpu, memory := "-", "-"
+		if quantity, ok := list[corev1.ResourceCPU]; ok {
+			cpu = quantity.String()
+		}
+		if quantity, ok := list[corev1.ResourceMemory]; ok {
+			memory = quantity.String()
+		}
+		return fmt.Sprintf("cpu=%s memory=%s", cpu, memory)
+	}
+	return fmt.Sprintf("requests %s, limits %s", describe(requirements.Requests), describe(requirements.Limits))
+}
+
+// resourcesHash identifies the resources a staged resize rolls out
+func resourcesHash(requirements corev1.ResourceRequirements) string {
+	data, _ := json.Marshal(requirements)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+	neo4jclient "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+)
+
+func resizeTestResources(memory string) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+	}
+}
+
+func resizeTestPod(name, revision, memory string) *corev1.Pod {
+	pod := restartTestPod(name, revision)
+	pod.Spec.Containers = []corev1.Container{{Name: "neo4j", Resources: podResources(resizeTestResources(memory))}}
+	return pod
+}
+
+func resizeTestReconciler(c client.Client, kubernetesVersion string) *Neo4jEnterpriseClusterReconciler {
+	fakeClient := &fakeRollingRestartClient{
+		members: []neo4jclient.ServerMember{
+			restartTestMember("id-0", "prod-server-0"),
+			restartTestMember("id-1", "prod-server-1"),
+			restartTestMember("id-2", "prod-server-2"),
+		},
+		leaders: map[string][]string{},
+	}
+	return &Neo4jEnterpriseClusterReconciler{
+		Client:       c,
+		Scheme:       c.Scheme(),
+		Recorder:     record.NewFakeRecorder(20),
+		Capabilities: &capabilities.Capabilities{KubernetesVersion: kubernetesVersion},
+		newRollingRestartClient: func(context.Context, *neo4jv1alpha1.Neo4jEnterpriseCluster) (rollingRestartClient, error) {
+			return fakeClient, nil
+		},
+	}
+}
+
+func TestPrepareResize(t *testing.T) {
+	tests := []struct {
+		name              string
+		strategy          string
+		kubernetesVersion string
+		changeImage       bool
+		wantInPlace       bool
+	}{
+		{name: "staged by default", kubernetesVersion: "v1.33.1"},
+		{name: "in place", strategy: verticalScalingInPlace, kubernetesVersion: "v1.33.1", wantInPlace: true},
+		{name: "in place needs 1.33", strategy: verticalScalingInPlace, kubernetesVersion: "v1.32.4"},
+		{name: "in place only for resources", strategy: verticalScalingInPlace, kubernetesVersion: "v1.33.1", changeImage: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := minimalCluster("prod", "default")
+			if tt.strategy != "" {
+				cluster.Spec.VerticalScaling = &neo4jv1alpha1.VerticalScalingSpec{Strategy: tt.strategy}
+			}
+			existing := serverSTS("prod", "default")
+			existing.Spec.Template.Spec.Containers[0].Resources = resizeTestResources("4Gi")
+			c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+				WithObjects(cluster, existing).
+				WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).Build()
+			r := resizeTestReconciler(c, tt.kubernetesVersion)
+
+			desired := existing.DeepCopy()
+			desired.Spec.Template.Spec.Containers[0].Resources = resizeTestResources("8Gi")
+			if tt.changeImage {
+				desired.Spec.Template.Spec.Containers[0].Image = "neo4j:2025.01.0-enterprise"
+			}
+			require.NoError(t, r.prepareResize(context.Background(), cluster, desired))
+
+			assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, desired.Spec.UpdateStrategy.Type)
+			latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cluster), latest))
+			if tt.wantInPlace {
+				require.NotNil(t, latest.Status.VerticalScaling)
+				assert.Equal(t, "8Gi", latest.Status.VerticalScaling.Resize.Resources.Limits.Memory().String())
+				assert.Nil(t, latest.Status.RollingRestart)
+				return
+			}
+			require.NotNil(t, latest.Status.RollingRestart)
+			assert.Equal(t, rollingRestartReasonResize, latest.Status.RollingRestart.Reason)
+			assert.Equal(t, resourcesHash(resizeTestResources("8Gi")), latest.Status.RollingRestart.ConfigHash)
+			assert.Nil(t, latest.Status.VerticalScaling)
+		})
+	}
+}
+
+func TestPrepareResizeUnchanged(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	existing := serverSTS("prod", "default")
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(cluster, existing).Build()
+	r := resizeTestReconciler(c, "v1.33.1")
+
+	desired := existing.DeepCopy()
+	require.NoError(t, r.prepareResize(context.Background(), cluster, desired))
+	assert.Empty(t, desired.Spec.UpdateStrategy.Type)
+	assert.Nil(t, cluster.Status.RollingRestart)
+}
+
+func TestReconcileInPlaceResize(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 3
+	cluster.Status.VerticalScaling = &neo4jv1alpha1.VerticalScalingStatus{
+		Resize: &neo4jv1alpha1.ResizeStatus{Resources: resizeTestResources("8Gi")},
+	}
+	sts := serverSTS("prod", "default")
+	sts.Spec.Replicas = int32PtrCM(3)
+	sts.Status.UpdateRevision = "prod-server-new"
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, sts,
+			resizeTestPod("prod-server-0", "prod-server-old", "4Gi"),
+			resizeTestPod("prod-server-1", "prod-server-old", "4Gi"),
+			resizeTestPod("prod-server-2", "prod-server-old", "4Gi")).
+		WithStatusSubresource(&neo4jv1alpha1.Neo4jEnterpriseCluster{}, &corev1.Pod{}).Build()
+	r := resizeTestReconciler(c, "v1.33.1")
+	ctx := context.Background()
+
+	reconcile := func() bool {
+		t.Helper()
+		resizing, err := r.reconcileInPlaceResize(ctx, cluster)
+		require.NoError(t, err)
+		return resizing
+	}
+	pod := func(name string) *corev1.Pod {
+		t.Helper()
+		pod := &corev1.Pod{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, pod))
+		return pod
+	}
+
+	// The highest ordinal is resized first, and relabeled once it has the
+	// new resources
+	assert.True(t, reconcile())
+	assert.Equal(t, "8Gi", pod("prod-server-2").Spec.Containers[0].Resources.Limits.Memory().String())
+	assert.Equal(t, "8Gi", pod("prod-server-2").Spec.Containers[0].Resources.Requests.Memory().String())
+	assert.Equal(t, "prod-server-2", cluster.Status.VerticalScaling.Resize.LastResizedPod)
+	assert.True(t, reconcile())
+	assert.Equal(t, "prod-server-new", pod("prod-server-2").Labels[appsv1.ControllerRevisionHashLabelKey])
+	assert.Equal(t, "4Gi", pod("prod-server-1").Spec.Containers[0].Resources.Limits.Memory().String())
+
+	// A pod whose node cannot fit the new size is restarted instead
+	assert.True(t, reconcile())
+	infeasible := pod("prod-server-1")
+	infeasible.Status.Conditions = append(infeasible.Status.Conditions, corev1.PodCondition{
+		Type: corev1.PodResizePending, Status: corev1.ConditionTrue, Reason: corev1.PodReasonInfeasible,
+	})
+	require.NoError(t, c.Status().Update(ctx, infeasible))
+	assert.True(t, reconcile())
+	err := c.Get(ctx, client.ObjectKey{Name: "prod-server-1", Namespace: "default"}, &corev1.Pod{})
+	assert.True(t, errors.IsNotFound(err))
+	require.NoError(t, c.Create(ctx, resizeTestPod("prod-server-1", "prod-server-new", "8Gi")))
+
+	assert.True(t, reconcile())
+	assert.True(t, reconcile())
+	assert.Equal(t, "prod-server-new", pod("prod-server-0").Labels[appsv1.ControllerRevisionHashLabelKey])
+
+	assert.False(t, reconcile())
+	assert.Nil(t, cluster.Status.VerticalScaling)
+	latest := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(cluster), latest))
+	assert.Nil(t, latest.Status.VerticalScaling)
+}
+
+func TestRecommendationFromVPA(t *testing.T) {
+	vpa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"recommendation": map[string]interface{}{
+				"containerRecommendations": []interface{}{
+					map[string]interface{}{
+						"containerName": "sidecar",
+						"target":        map[string]interface{}{"cpu": "10m"},
+					},
+					map[string]interface{}{
+						"containerName": "neo4j",
+						"target":        map[string]interface{}{"cpu": "1500m", "memory": "6Gi"},
+						"lowerBound":    map[string]interface{}{"cpu": "1", "memory": "4Gi"},
+						"upperBound":    map[string]interface{}{"cpu": "4", "memory": "12Gi"},
+					},
+				},
+			},
+		},
+	}}
+
+	recommendation := recommendationFromVPA(vpa)
+	require.NotNil(t, recommendation)
+	assert.Equal(t, "1500m", recommendation.Target.Cpu().String())
+	assert.Equal(t, "6Gi", recommendation.Target.Memory().String())
+	assert.Equal(t, "4Gi", recommendation.LowerBound.Memory().String())
+	assert.Equal(t, "4", recommendation.UpperBound.Cpu().String())
+
+	assert.Nil(t, recommendationFromVPA(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// zoneLabelRequeueInterval is how often pods waiting for their zone label
+// are checked; their startup script gives up after five minutes.
+const zoneLabelRequeueInterval = 10 * time.Second
+
+// reconcileZoneLabels labels every scheduled server pod with the zone of its
+// node, which the startup script turns into the server tag. It returns true
+// while a pod is still missing or waiting to be scheduled.
+func (r *Neo4jEnterpriseClusterReconciler) reconcileZoneLabels(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (bool, error) {
+	if !resources.ZoneSpreadEnabled(cluster) {
+		return false, nil
+	}
+
+	pods, err := r.listServerPods(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+
+	topologyKey := resources.ZoneTopologyKey(cluster)
+	pending := int32(len(pods.Items)) < cluster.Spec.Topology.Servers+resources.ServerGroupServers(cluster)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" {
+			pending = true
+			continue
+		}
+		node := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+			return pending, fmt.Errorf("failed to get node %s: %w", pod.Spec.NodeName, err)
+		}
+		zone := node.Labels[topologyKey]
+		if zone == "" || pod.Labels[resources.ZoneLabel] == zone {
+			continue
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[resources.ZoneLabel] = zone
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return pending, fmt.Errorf("failed to label pod %s with its zone: %w", pod.Name, err)
+		}
+		log.FromContext(ctx).Info("Labeled server pod with its zone", "pod", pod.Name, "zone", zone)
+	}
+	return pending, nil
+}
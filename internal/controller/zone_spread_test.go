@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func zoneTestPod(name, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{
+			"neo4j.com/cluster":     "prod",
+			"neo4j.com/server-name": "server",
+		}},
+		Spec: corev1.PodSpec{NodeName: node},
+	}
+}
+
+func TestReconcileZoneLabels(t *testing.T) {
+	cluster := minimalCluster("prod", "default")
+	cluster.Spec.Topology.Servers = 2
+	cluster.Spec.Topology.ZoneSpread = &neo4jv1alpha1.ZoneSpreadSpec{Enabled: true, MinZones: 2}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-a",
+		Labels: map[string]string{"topology.kubernetes.io/zone": "eu-west-1a"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cluster, node, zoneTestPod("prod-server-0", "node-a"), zoneTestPod("prod-server-1", "")).Build()
+	r := &Neo4jEnterpriseClusterReconciler{Client: c, Scheme: c.Scheme(), Recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+
+	pending, err := r.reconcileZoneLabels(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, pending, "prod-server-1 is not scheduled yet")
+
+	pod := &corev1.Pod{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-0", Namespace: "default"}, pod))
+	assert.Equal(t, "eu-west-1a", pod.Labels["neo4j.com/zone"])
+
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "prod-server-1", Namespace: "default"}, pod))
+	pod.Spec.NodeName = "node-a"
+	require.NoError(t, c.Update(ctx, pod))
+	pending, err = r.reconcileZoneLabels(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, pending)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
+)
+
+var (
+	kubernetesInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "kubernetes_info",
+			Help:      "Kubernetes version of the cluster the operator runs in",
+		},
+		[]string{"version"},
+	)
+
+	apiAvailable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "api_available",
+			Help:      "Whether an optional API the operator uses is served (1) or not (0)",
+		},
+		[]string{"api", "group_version"},
+	)
+)
+
+// RecordCapabilities publishes the detected Kubernetes version and optional
+// APIs, replacing what an earlier detection recorded
+func RecordCapabilities(detected *capabilities.Capabilities) {
+	kubernetesInfo.Reset()
+	apiAvailable.Reset()
+	if detected == nil {
+		return
+	}
+	kubernetesInfo.WithLabelValues(detected.KubernetesVersion).Set(1)
+	for _, api := range capabilities.OptionalAPIs {
+		value := 0.0
+		if detected.Has(api.Name) {
+			value = 1
+		}
+		apiAvailable.WithLabelValues(api.Name, api.GroupVersion).Set(value)
+	}
+}
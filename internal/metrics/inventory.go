@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// LabelKind is the label key for the custom resource kind
+const LabelKind = "kind"
+
+// inventoryListTimeout bounds the time a single scrape spends listing resources
+const inventoryListTimeout = 10 * time.Second
+
+// inventoryKinds are the custom resources counted by the inventory collector.
+// Neo4jClusterClass is cluster-scoped and is reported with an empty namespace.
+var inventoryKinds = []struct {
+	kind    string
+	newList func() client.ObjectList
+}{
+	{"Neo4jEnterpriseCluster", func() client.ObjectList { return &neo4jv1alpha1.Neo4jEnterpriseClusterList{} }},
+	{"Neo4jEnterpriseStandalone", func() client.ObjectList { return &neo4jv1alpha1.Neo4jEnterpriseStandaloneList{} }},
+	{"Neo4jDatabase", func() client.ObjectList { return &neo4jv1alpha1.Neo4jDatabaseList{} }},
+	{"Neo4jShardedDatabase", func() client.ObjectList { return &neo4jv1alpha1.Neo4jShardedDatabaseList{} }},
+	{"Neo4jBackup", func() client.ObjectList { return &neo4jv1alpha1.Neo4jBackupList{} }},
+	{"Neo4jRestore", func() client.ObjectList { return &neo4jv1alpha1.Neo4jRestoreList{} }},
+	{"Neo4jPlugin", func() client.ObjectList { return &neo4jv1alpha1.Neo4jPluginList{} }},
+	{"Neo4jWorkload", func() client.ObjectList { return &neo4jv1alpha1.Neo4jWorkloadList{} }},
+	{"Neo4jUserSync", func() client.ObjectList { return &neo4jv1alpha1.Neo4jUserSyncList{} }},
+	{"Neo4jMigration", func() client.ObjectList { return &neo4jv1alpha1.Neo4jMigrationList{} }},
+	{"Neo4jCDC", func() client.ObjectList { return &neo4jv1alpha1.Neo4jCDCList{} }},
+	{"Neo4jClusterClass", func() client.ObjectList { return &neo4jv1alpha1.Neo4jClusterClassList{} }},
+}
+
+var managedResourcesDesc = prometheus.NewDesc(
+	prometheus.BuildFQName("", subsystem, "managed_resources"),
+	"Number of custom resources managed by the operator by kind and namespace",
+	[]string{LabelKind, LabelNamespace},
+	nil,
+)
+
+// InventoryCollector counts the operator's custom resources at scrape time.
+// The counts are read from the manager's client, so no extra state has to be
+// kept in sync with create/delete events.
+type InventoryCollector struct {
+	mu     sync.RWMutex
+	reader client.Reader
+}
+
+// inventoryCollector is registered once; the manager may be restarted (e.g. when
+// the watched namespaces change), so the reader is swapped instead.
+var inventoryCollector = &InventoryCollector{}
+
+// SetInventoryReader sets the reader used to list managed resources.
+// Passing nil disables the inventory metric.
+func SetInventoryReader(reader client.Reader) {
+	inventoryCollector.mu.Lock()
+	defer inventoryCollector.mu.Unlock()
+	inventoryCollector.reader = reader
+}
+
+// Describe implements prometheus.Collector
+func (c *InventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- managedResourcesDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *InventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	reader := c.reader
+	c.mu.RUnlock()
+	if reader == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), inventoryListTimeout)
+	defer cancel()
+
+	for kind, counts := range countManagedResources(ctx, reader) {
+		for namespace, count := range counts {
+			ch <- prometheus.MustNewConstMetric(managedResourcesDesc, prometheus.GaugeValue, float64(count), kind, namespace)
+		}
+	}
+}
+
+// countManagedResources returns the number of resources per kind and namespace.
+// Kinds that cannot be listed (e.g. missing RBAC or cache not started) are
+// skipped rather than reported as zero.
+func countManagedResources(ctx context.Context, reader client.Reader) map[string]map[string]int {
+	logger := logf.Log.WithName("inventory-metrics")
+	result := make(map[string]map[string]int, len(inventoryKinds))
+
+	for _, k := range inventoryKinds {
+		list := k.newList()
+		if err := reader.List(ctx, list); err != nil {
+			logger.V(1).Info("Skipping inventory count", "kind", k.kind, "error", err.Error())
+			continue
+		}
+
+		counts := map[string]int{}
+		err := meta.EachListItem(list, func(obj runtime.Object) error {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			counts[accessor.GetNamespace()]++
+			return nil
+		})
+		if err != nil {
+			continue
+		}
+		result[k.kind] = counts
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestInventoryCollector_CountsByKindAndNamespace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, neo4jv1alpha1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "team-a"}},
+		&neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "team-a"}},
+		&neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "team-b"}},
+		&neo4jv1alpha1.Neo4jEnterpriseStandalone{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-b"}},
+		&neo4jv1alpha1.Neo4jBackup{ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "team-a"}},
+		&neo4jv1alpha1.Neo4jClusterClass{ObjectMeta: metav1.ObjectMeta{Name: "small"}},
+	).Build()
+
+	collector := &InventoryCollector{reader: c}
+
+	expected := `
+# HELP neo4j_operator_managed_resources Number of custom resources managed by the operator by kind and namespace
+# TYPE neo4j_operator_managed_resources gauge
+neo4j_operator_managed_resources{kind="Neo4jBackup",namespace="team-a"} 1
+neo4j_operator_managed_resources{kind="Neo4jClusterClass",namespace=""} 1
+neo4j_operator_managed_resources{kind="Neo4jEnterpriseCluster",namespace="team-a"} 2
+neo4j_operator_managed_resources{kind="Neo4jEnterpriseCluster",namespace="team-b"} 1
+neo4j_operator_managed_resources{kind="Neo4jEnterpriseStandalone",namespace="team-b"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestInventoryCollector_NoReader(t *testing.T) {
+	require.Equal(t, 0, testutil.CollectAndCount(&InventoryCollector{}))
+}
@@ -58,6 +58,19 @@ const (
 	LabelNodeType = "node_type"
 	// LabelStatus is the label key for operation status
 	LabelStatus = "status"
+	// LabelReason is the label key for the reason of a failure
+	LabelReason = "reason"
+
+	// ReconcileErrorValidation is a spec failing validation
+	ReconcileErrorValidation = "validation"
+	// ReconcileErrorNeo4jUnreachable is a failure to connect to Neo4j
+	ReconcileErrorNeo4jUnreachable = "neo4j-unreachable"
+	// ReconcileErrorConflict is a Kubernetes update conflict
+	ReconcileErrorConflict = "k8s-conflict"
+	// ReconcileErrorTimeout is a request or operation timing out
+	ReconcileErrorTimeout = "timeout"
+	// ReconcileErrorOther is any other failure
+	ReconcileErrorOther = "other"
 )
 
 var (
@@ -123,6 +136,25 @@ var (
 		[]string{LabelClusterName, LabelNamespace, LabelOperation},
 	)
 
+	reconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of failed reconciliations by controller and reason",
+		},
+		[]string{LabelController, LabelReason},
+	)
+
+	reconcilePhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "reconcile_phase_duration_seconds",
+			Help:      "Time spent in each phase of a cluster reconciliation",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1.0, 2.0, 5.0, 10.0, 30.0},
+		},
+		[]string{LabelClusterName, LabelNamespace, LabelPhase},
+	)
+
 	// Upgrade metrics
 	upgradeTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -192,6 +224,25 @@ var (
 		[]string{LabelClusterName, LabelNamespace, LabelOperation},
 	)
 
+	adminQueryThrottleWait = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "admin_query_throttle_wait_seconds",
+			Help:      "Time administrative Cypher statements waited for the per-cluster rate limiter",
+			Buckets:   []float64{0, 0.01, 0.05, 0.1, 0.5, 1.0, 5.0, 10.0, 30.0},
+		},
+		[]string{LabelClusterName, LabelNamespace},
+	)
+
+	cypherRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "cypher_retries_total",
+			Help:      "Cypher statements retried after a transient error, such as a leader election",
+		},
+		[]string{LabelClusterName, LabelNamespace},
+	)
+
 	// Security metrics
 	securityOperationTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -242,6 +293,9 @@ func init() {
 		splitBrainDetectedTotal,
 		reconcileTotal,
 		reconcileDuration,
+		reconcilePhaseDuration,
+		reconcileErrorsTotal,
+		workqueueCollector,
 		upgradeTotal,
 		upgradeDuration,
 		backupTotal,
@@ -249,6 +303,8 @@ func init() {
 		backupSize,
 		cypherTotal,
 		cypherDuration,
+		adminQueryThrottleWait,
+		cypherRetries,
 		securityOperationTotal,
 		// Resource version conflict metrics
 		resourceVersionConflicts,
@@ -264,6 +320,16 @@ func init() {
 		secondaryCount,
 		scalingValidationTotal,
 		serverHealth,
+		cdcLag,
+		certificateExpiry,
+		slowQueriesTotal,
+		slowQueryDuration,
+		terminatedQueriesTotal,
+		// Inventory of managed custom resources
+		inventoryCollector,
+		// Capabilities of the Kubernetes cluster
+		kubernetesInfo,
+		apiAvailable,
 	)
 }
 
@@ -306,6 +372,16 @@ func (m *ReconcileMetrics) RecordReconcile(ctx context.Context, operation string
 	}
 }
 
+// RecordReconcileError records a failed reconciliation of a controller
+func RecordReconcileError(controller, reason string) {
+	reconcileErrorsTotal.WithLabelValues(controller, reason).Inc()
+}
+
+// RecordReconcilePhase records the time spent in one phase of a reconciliation
+func (m *ReconcileMetrics) RecordReconcilePhase(phase string, duration time.Duration) {
+	reconcilePhaseDuration.WithLabelValues(m.clusterName, m.namespace, phase).Observe(duration.Seconds())
+}
+
 // StartReconcileSpan starts a new tracing span for reconciliation
 // The caller is responsible for calling span.End()
 func (m *ReconcileMetrics) StartReconcileSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
@@ -350,7 +426,7 @@ func (m *ClusterMetrics) RecordClusterHealth(healthy bool) {
 // RecordClusterPhase records the current cluster phase as a labelled gauge.
 // It sets 1.0 for the active phase label and 0.0 for all others.
 func (m *ClusterMetrics) RecordClusterPhase(phase string) {
-	for _, p := range []string{"Pending", "Forming", "Ready", "Failed", "Degraded", "Upgrading"} {
+	for _, p := range []string{"Pending", "Forming", "Ready", "Failed", "Degraded", "Upgrading", "Hibernated"} {
 		v := 0.0
 		if p == phase {
 			v = 1.0
@@ -507,6 +583,17 @@ func (m *CypherMetrics) RecordCypherExecution(ctx context.Context, operation str
 	}
 }
 
+// RecordThrottleWait records how long an administrative statement waited
+// for the rate limiter of its cluster
+func (m *CypherMetrics) RecordThrottleWait(wait time.Duration) {
+	adminQueryThrottleWait.WithLabelValues(m.clusterName, m.namespace).Observe(wait.Seconds())
+}
+
+// RecordRetry records a statement retried after a transient error
+func (m *CypherMetrics) RecordRetry() {
+	cypherRetries.WithLabelValues(m.clusterName, m.namespace).Inc()
+}
+
 // StartCypherSpan starts a new tracing span for Cypher execution
 func (m *CypherMetrics) StartCypherSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
 	ctx, span := tracer.Start(ctx, "cypher."+operation,
@@ -650,6 +737,54 @@ var (
 		},
 		[]string{LabelClusterName, LabelNamespace, "server_name", "server_address"},
 	)
+
+	// Change data capture metrics
+	cdcLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "cdc_lag_seconds",
+			Help:      "Age of the oldest change a CDC connector has not published yet",
+		},
+		[]string{"cdc", LabelNamespace, "database"},
+	)
+
+	certificateExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "certificate_expiry_seconds",
+			Help:      "Seconds until a certificate the servers of a cluster mount expires",
+		},
+		[]string{LabelClusterName, LabelNamespace, "scope", "secret"},
+	)
+
+	// Query monitoring metrics
+	slowQueriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "slow_queries_total",
+			Help:      "Queries seen running longer than spec.queryMonitoring.slowQueryThreshold",
+		},
+		[]string{LabelClusterName, LabelNamespace, "database"},
+	)
+
+	slowQueryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "slow_query_duration_seconds",
+			Help:      "Longest elapsed time seen of slow queries that finished",
+			Buckets:   []float64{1, 5, 10, 30, 60, 300, 900, 3600},
+		},
+		[]string{LabelClusterName, LabelNamespace, "database"},
+	)
+
+	terminatedQueriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "terminated_queries_total",
+			Help:      "Queries the operator terminated for exceeding spec.queryMonitoring.enforcement",
+		},
+		[]string{LabelClusterName, LabelNamespace, "database", LabelReason},
+	)
 )
 
 // DisasterRecoveryMetrics provides methods for recording disaster recovery metrics
@@ -729,6 +864,90 @@ func (m *ManualScalingMetrics) SetManualScalingEnabled(enabled bool) {
 	manualScalerEnabled.WithLabelValues(m.clusterName, m.namespace).Set(value)
 }
 
+// CDCMetrics provides methods for recording change data capture metrics
+type CDCMetrics struct {
+	name      string
+	namespace string
+}
+
+// NewCDCMetrics creates a new CDCMetrics instance
+func NewCDCMetrics(name, namespace string) *CDCMetrics {
+	return &CDCMetrics{
+		name:      name,
+		namespace: namespace,
+	}
+}
+
+// RecordLag records the lag of the connector of a database
+func (m *CDCMetrics) RecordLag(database string, lag time.Duration) {
+	cdcLag.WithLabelValues(m.name, m.namespace, database).Set(lag.Seconds())
+}
+
+// ForgetLag removes the lag of a database that is no longer published
+func (m *CDCMetrics) ForgetLag(database string) {
+	cdcLag.DeleteLabelValues(m.name, m.namespace, database)
+}
+
+// QueryMetrics provides methods for recording slow query metrics
+type QueryMetrics struct {
+	clusterName string
+	namespace   string
+}
+
+// NewQueryMetrics creates a new QueryMetrics instance
+func NewQueryMetrics(clusterName, namespace string) *QueryMetrics {
+	return &QueryMetrics{
+		clusterName: clusterName,
+		namespace:   namespace,
+	}
+}
+
+// RecordSlowQuery counts a query seen running past the slow query threshold
+func (m *QueryMetrics) RecordSlowQuery(database string) {
+	slowQueriesTotal.WithLabelValues(m.clusterName, m.namespace, database).Inc()
+}
+
+// RecordSlowQueryDuration records the elapsed time of a slow query that
+// finished, as last seen
+func (m *QueryMetrics) RecordSlowQueryDuration(database string, elapsed time.Duration) {
+	slowQueryDuration.WithLabelValues(m.clusterName, m.namespace, database).Observe(elapsed.Seconds())
+}
+
+// RecordTerminatedQuery counts a query terminated for exceeding a limit
+func (m *QueryMetrics) RecordTerminatedQuery(database, reason string) {
+	terminatedQueriesTotal.WithLabelValues(m.clusterName, m.namespace, database, reason).Inc()
+}
+
+// CertificateMetrics provides methods for recording certificate expiry metrics
+type CertificateMetrics struct {
+	clusterName string
+	namespace   string
+}
+
+// NewCertificateMetrics creates a new CertificateMetrics instance
+func NewCertificateMetrics(clusterName, namespace string) *CertificateMetrics {
+	return &CertificateMetrics{
+		clusterName: clusterName,
+		namespace:   namespace,
+	}
+}
+
+// RecordExpiry records the time left until the certificate of a Secret
+// expires, negative once it has
+func (m *CertificateMetrics) RecordExpiry(scope, secret string, remaining time.Duration) {
+	certificateExpiry.WithLabelValues(m.clusterName, m.namespace, scope, secret).Set(remaining.Seconds())
+}
+
+// ForgetExpiry removes the expiry of a certificate the servers no longer mount
+func (m *CertificateMetrics) ForgetExpiry(scope, secret string) {
+	certificateExpiry.DeleteLabelValues(m.clusterName, m.namespace, scope, secret)
+}
+
+// ForgetAll removes the expiry of every certificate of the cluster
+func (m *CertificateMetrics) ForgetAll() {
+	certificateExpiry.DeletePartialMatch(prometheus.Labels{LabelClusterName: m.clusterName, LabelNamespace: m.namespace})
+}
+
 // ConflictMetrics provides methods for recording resource version conflict metrics
 type ConflictMetrics struct{}
 
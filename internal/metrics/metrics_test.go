@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/capabilities"
 )
 
 func TestNewReconcileMetrics(t *testing.T) {
@@ -308,6 +311,33 @@ func TestCypherMetrics_RecordCypherExecution(t *testing.T) {
 	}
 }
 
+func TestCypherMetrics_RecordThrottleWait(t *testing.T) {
+	metrics := NewCypherMetrics("test-cluster", "test-namespace")
+	adminQueryThrottleWait.Reset()
+
+	metrics.RecordThrottleWait(0)
+	metrics.RecordThrottleWait(250 * time.Millisecond)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(adminQueryThrottleWait))
+	expected := `
+# HELP neo4j_operator_admin_query_throttle_wait_seconds Time administrative Cypher statements waited for the per-cluster rate limiter
+# TYPE neo4j_operator_admin_query_throttle_wait_seconds histogram
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="0"} 1
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="0.01"} 1
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="0.05"} 1
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="0.1"} 1
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="0.5"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="1"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="5"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="10"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="30"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_bucket{cluster_name="test-cluster",namespace="test-namespace",le="+Inf"} 2
+neo4j_operator_admin_query_throttle_wait_seconds_sum{cluster_name="test-cluster",namespace="test-namespace"} 0.25
+neo4j_operator_admin_query_throttle_wait_seconds_count{cluster_name="test-cluster",namespace="test-namespace"} 2
+`
+	assert.NoError(t, testutil.CollectAndCompare(adminQueryThrottleWait, strings.NewReader(expected)))
+}
+
 func TestNewSecurityMetrics(t *testing.T) {
 	metrics := NewSecurityMetrics("test-cluster", "test-namespace")
 
@@ -640,6 +670,7 @@ func TestMetricsRegistration(t *testing.T) {
 		backupSize,
 		cypherTotal,
 		cypherDuration,
+		adminQueryThrottleWait,
 		securityOperationTotal,
 		disasterRecoveryStatus,
 		failoverTotal,
@@ -656,3 +687,59 @@ func TestMetricsRegistration(t *testing.T) {
 		assert.NotNil(t, metric)
 	}
 }
+
+func TestRecordCapabilities(t *testing.T) {
+	RecordCapabilities(&capabilities.Capabilities{
+		KubernetesVersion: "v1.30.2",
+		APIs:              map[string]bool{capabilities.Route: false, capabilities.HPAv2: true},
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(kubernetesInfo.WithLabelValues("v1.30.2")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(apiAvailable.WithLabelValues(capabilities.Route, "route.openshift.io/v1")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(apiAvailable.WithLabelValues(capabilities.HPAv2, "autoscaling/v2")))
+
+	RecordCapabilities(nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(apiAvailable))
+}
+
+func TestCertificateMetrics(t *testing.T) {
+	m := NewCertificateMetrics("certs", "default")
+	m.RecordExpiry("default", "certs-tls-secret", 48*time.Hour)
+	m.RecordExpiry("bolt", "bolt-tls", -time.Minute)
+
+	assert.Equal(t, 172800.0, testutil.ToFloat64(certificateExpiry.WithLabelValues("certs", "default", "default", "certs-tls-secret")))
+	assert.Equal(t, -60.0, testutil.ToFloat64(certificateExpiry.WithLabelValues("certs", "default", "bolt", "bolt-tls")))
+
+	m.ForgetExpiry("bolt", "bolt-tls")
+	assert.Equal(t, 1, testutil.CollectAndCount(certificateExpiry))
+
+	m.ForgetAll()
+	assert.Equal(t, 0, testutil.CollectAndCount(certificateExpiry))
+}
+
+func TestQueryMetrics(t *testing.T) {
+	slowQueriesTotal.Reset()
+	slowQueryDuration.Reset()
+
+	m := NewQueryMetrics("slow", "default")
+	m.RecordSlowQuery("neo4j")
+	m.RecordSlowQuery("neo4j")
+	m.RecordSlowQueryDuration("neo4j", 90*time.Second)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(slowQueriesTotal.WithLabelValues("slow", "default", "neo4j")))
+	assert.Equal(t, 1, testutil.CollectAndCount(slowQueryDuration))
+
+	m.RecordTerminatedQuery("neo4j", "duration")
+	assert.Equal(t, 1.0, testutil.ToFloat64(terminatedQueriesTotal.WithLabelValues("slow", "default", "neo4j", "duration")))
+}
+
+func TestRecordReconcileError(t *testing.T) {
+	reconcileErrorsTotal.Reset()
+
+	RecordReconcileError("neo4jbackup", ReconcileErrorConflict)
+	RecordReconcileError("neo4jbackup", ReconcileErrorConflict)
+	RecordReconcileError("neo4jbackup", ReconcileErrorTimeout)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(reconcileErrorsTotal.WithLabelValues("neo4jbackup", ReconcileErrorConflict)))
+	assert.Equal(t, 2, testutil.CollectAndCount(reconcileErrorsTotal))
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// OTLP protocols traces can be exported with
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http/protobuf"
+)
+
+// DefaultTraceServiceName is the service.name of the operator traces
+const DefaultTraceServiceName = "neo4j-operator"
+
+// TracingOptions configures the export of the operator traces over OTLP
+type TracingOptions struct {
+	// Endpoint is the host:port, or URL, of the OTLP receiver. Tracing is
+	// disabled when it is empty.
+	Endpoint string
+	// Protocol is OTLPProtocolGRPC or OTLPProtocolHTTP
+	Protocol string
+	// Insecure sends the traces without TLS
+	Insecure bool
+	// Headers are sent with every export, e.g. for authentication
+	Headers map[string]string
+	// SamplingRatio is the share of root spans sampled; child spans follow
+	// their parent
+	SamplingRatio float64
+	// ServiceName is the service.name resource attribute
+	ServiceName string
+	// ServiceVersion is the service.version resource attribute, if set
+	ServiceVersion string
+}
+
+// SetupTracing installs a TracerProvider exporting the spans of the
+// operator, such as those of StartReconcileSpan and StartBackupSpan, to an
+// OTLP receiver. The function returned flushes the pending spans and
+// shuts the provider down; it does nothing when tracing is disabled.
+func SetupTracing(ctx context.Context, opts TracingOptions) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.SamplingRatio < 0 || opts.SamplingRatio > 1 {
+		return nil, fmt.Errorf("invalid trace sampling ratio %v: must be between 0.0 and 1.0", opts.SamplingRatio)
+	}
+
+	exporter, err := newTraceExporter(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := opts.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultTraceServiceName
+	}
+	// Attributes of OTEL_RESOURCE_ATTRIBUTES are kept, service.name is the
+	// one configured
+	attributes := []attribute.KeyValue{semconv.ServiceName(serviceName)}
+	if opts.ServiceVersion != "" {
+		attributes = append(attributes, semconv.ServiceVersion(opts.ServiceVersion))
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithAttributes(attributes...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// newTraceExporter creates the OTLP exporter of the protocol. An endpoint
+// given as a URL sets the scheme, and the path for OTLP/HTTP, too; a URL
+// without a path gets the default /v1/traces.
+func newTraceExporter(ctx context.Context, opts TracingOptions) (*otlptrace.Exporter, error) {
+	endpointURL := ""
+	if strings.Contains(opts.Endpoint, "://") {
+		parsed, err := url.Parse(opts.Endpoint)
+		if err != nil || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid OTLP endpoint %q: must be host:port or a URL", opts.Endpoint)
+		}
+		if opts.Protocol == OTLPProtocolHTTP && strings.Trim(parsed.Path, "/") == "" {
+			parsed.Path = "/v1/traces"
+		}
+		endpointURL = parsed.String()
+	}
+
+	var client otlptrace.Client
+	switch opts.Protocol {
+	case OTLPProtocolGRPC, "":
+		var grpcOpts []otlptracegrpc.Option
+		if len(opts.Headers) > 0 {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithHeaders(opts.Headers))
+		}
+		if endpointURL != "" {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithEndpointURL(endpointURL))
+		} else {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(grpcOpts...)
+	case OTLPProtocolHTTP:
+		var httpOpts []otlptracehttp.Option
+		if len(opts.Headers) > 0 {
+			httpOpts = append(httpOpts, otlptracehttp.WithHeaders(opts.Headers))
+		}
+		if endpointURL != "" {
+			httpOpts = append(httpOpts, otlptracehttp.WithEndpointURL(endpointURL))
+		} else {
+			httpOpts = append(httpOpts, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(httpOpts...)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q: must be %s or %s", opts.Protocol, OTLPProtocolGRPC, OTLPProtocolHTTP)
+	}
+
+	// The exporter connects lazily, so an unreachable receiver does not
+	// keep the operator from starting
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	return exporter, nil
+}
+
+// ParseTraceHeaders parses headers written as key=value pairs separated by
+// commas, with percent-encoded values, as in OTEL_EXPORTER_OTLP_HEADERS
+func ParseTraceHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, val, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid header %q: must be key=value", pair)
+		}
+		unescaped, err := url.PathUnescape(strings.TrimSpace(val))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of header %s: %w", key, err)
+		}
+		headers[key] = unescaped
+	}
+	return headers, nil
+}
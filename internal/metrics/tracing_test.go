@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSetupTracing(t *testing.T) {
+	ctx := context.Background()
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	shutdown, err := SetupTracing(ctx, TracingOptions{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(ctx))
+	assert.Equal(t, previous, otel.GetTracerProvider())
+
+	shutdown, err = SetupTracing(ctx, TracingOptions{Endpoint: "http://127.0.0.1:4318", Protocol: OTLPProtocolHTTP, SamplingRatio: 0.5})
+	require.NoError(t, err)
+	assert.IsType(t, &sdktrace.TracerProvider{}, otel.GetTracerProvider())
+	require.NoError(t, shutdown(ctx))
+
+	_, err = SetupTracing(ctx, TracingOptions{Endpoint: "127.0.0.1:4317", SamplingRatio: 2})
+	require.Error(t, err)
+	_, err = SetupTracing(ctx, TracingOptions{Endpoint: "127.0.0.1:4317", Protocol: "thrift", SamplingRatio: 1})
+	require.Error(t, err)
+}
+
+func TestParseTraceHeaders(t *testing.T) {
+	headers, err := ParseTraceHeaders("Authorization=Basic%20dXNlcjpwYXNz, X-Scope-OrgID=tenant-a,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Authorization": "Basic dXNlcjpwYXNz", "X-Scope-OrgID": "tenant-a"}, headers)
+
+	_, err = ParseTraceHeaders("missing-value")
+	require.Error(t, err)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// LabelController is the label key for the controller name
+const LabelController = "controller"
+
+var (
+	workqueueDepthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", subsystem, "workqueue_depth"),
+		"Number of requests waiting in the workqueue of a controller",
+		[]string{LabelController},
+		nil,
+	)
+	workqueueOldestAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", subsystem, "workqueue_oldest_item_age_seconds"),
+		"Time the oldest request ready in the workqueue of a controller has waited to be reconciled",
+		[]string{LabelController},
+		nil,
+	)
+)
+
+// queueStats is what the workqueue collector reads from an instrumented
+// queue
+type queueStats interface {
+	Len() int
+	oldestAge(now time.Time) time.Duration
+}
+
+// WorkqueueCollector reports the depth and oldest item age of the
+// instrumented controller queues at scrape time, so a wedged controller
+// shows as a queue that only grows older
+type WorkqueueCollector struct {
+	mu     sync.RWMutex
+	queues map[string]queueStats
+}
+
+var workqueueCollector = &WorkqueueCollector{queues: map[string]queueStats{}}
+
+// Describe implements prometheus.Collector
+func (c *WorkqueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- workqueueDepthDesc
+	ch <- workqueueOldestAgeDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *WorkqueueCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	for controller, queue := range c.queues {
+		ch <- prometheus.MustNewConstMetric(workqueueDepthDesc, prometheus.GaugeValue, float64(queue.Len()), controller)
+		ch <- prometheus.MustNewConstMetric(workqueueOldestAgeDesc, prometheus.GaugeValue, queue.oldestAge(now).Seconds(), controller)
+	}
+}
+
+// instrumentedQueue records when each request in a workqueue became ready,
+// or becomes ready once its delay passes
+type instrumentedQueue[T comparable] struct {
+	workqueue.TypedRateLimitingInterface[T]
+	rateLimiter workqueue.TypedRateLimiter[T]
+
+	mu    sync.Mutex
+	ready map[T]time.Time
+}
+
+// NewInstrumentedQueue creates the rate limiting workqueue of a controller,
+// reporting its depth and oldest item age. It replaces the queue of an
+// earlier controller of the same name.
+func NewInstrumentedQueue[T comparable](controller string, rateLimiter workqueue.TypedRateLimiter[T]) workqueue.TypedRateLimitingInterface[T] {
+	queue := &instrumentedQueue[T]{
+		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[T]{
+			Name: controller,
+		}),
+		rateLimiter: rateLimiter,
+		ready:       map[T]time.Time{},
+	}
+
+	workqueueCollector.mu.Lock()
+	defer workqueueCollector.mu.Unlock()
+	workqueueCollector.queues[controller] = queue
+	return queue
+}
+
+// Add implements workqueue.TypedInterface
+func (q *instrumentedQueue[T]) Add(item T) {
+	q.markReady(item, time.Now())
+	q.TypedRateLimitingInterface.Add(item)
+}
+
+// AddAfter implements workqueue.TypedDelayingInterface
+func (q *instrumentedQueue[T]) AddAfter(item T, duration time.Duration) {
+	q.markReady(item, time.Now().Add(max(duration, 0)))
+	q.TypedRateLimitingInterface.AddAfter(item, duration)
+}
+
+// AddRateLimited implements workqueue.TypedRateLimitingInterface. The delay
+// is taken from the rate limiter here, as the queue it wraps would not
+// tell it.
+func (q *instrumentedQueue[T]) AddRateLimited(item T) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Get implements workqueue.TypedInterface
+func (q *instrumentedQueue[T]) Get() (T, bool) {
+	item, shutdown := q.TypedRateLimitingInterface.Get()
+	q.mu.Lock()
+	delete(q.ready, item)
+	q.mu.Unlock()
+	return item, shutdown
+}
+
+// markReady records when an item is ready, keeping the earlier time of an
+// item already waiting as the queue does
+func (q *instrumentedQueue[T]) markReady(item T, at time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if previous, waiting := q.ready[item]; !waiting || at.Before(previous) {
+		q.ready[item] = at
+	}
+}
+
+// oldestAge returns how long the oldest ready item has waited
+func (q *instrumentedQueue[T]) oldestAge(now time.Time) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var oldest time.Duration
+	for _, at := range q.ready {
+		if age := now.Sub(at); age > oldest {
+			oldest = age
+		}
+	}
+	return oldest
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestInstrumentedQueue(t *testing.T) {
+	queue := NewInstrumentedQueue("test", workqueue.DefaultTypedControllerRateLimiter[string]())
+	defer queue.ShutDown()
+	instrumented := queue.(*instrumentedQueue[string])
+
+	now := time.Now()
+	queue.Add("a")
+	queue.Add("b")
+	queue.AddAfter("later", time.Hour)
+	instrumented.markReady("a", now.Add(-time.Minute))
+
+	assert.Equal(t, 2, queue.Len())
+	assert.InDelta(t, time.Minute.Seconds(), instrumented.oldestAge(now).Seconds(), 1)
+
+	item, shutdown := queue.Get()
+	require.False(t, shutdown)
+	assert.Equal(t, "a", item)
+	queue.Done(item)
+	assert.Less(t, instrumented.oldestAge(now), time.Second)
+
+	// Both gauges are reported for the queue
+	assert.Equal(t, 2, testutil.CollectAndCount(workqueueCollector, "neo4j_operator_workqueue_depth", "neo4j_operator_workqueue_oldest_item_age_seconds"))
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import "github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+
+// GetBackupCommand generates the correct neo4j-admin database backup command.
+// fromAddresses is a comma-separated list of host:port backup endpoints (port 6362).
+// If fromAddresses is empty, the --from flag is omitted (local backup).
+func GetBackupCommand(v *version.Version, databaseName string, backupPath string, allDatabases bool, fromAddresses string) string {
+	cmd := "neo4j-admin database backup"
+
+	if fromAddresses != "" {
+		cmd += " --from=" + fromAddresses
+	}
+	cmd += " --to-path=" + backupPath
+
+	if allDatabases {
+		cmd += ` "*"`
+	} else if databaseName != "" {
+		cmd += " " + databaseName
+	}
+
+	return cmd
+}
+
+// GetRestoreCommand generates the correct restore command based on version
+func GetRestoreCommand(v *version.Version, databaseName string, backupPath string) string {
+	// Base command is the same for both versions
+	cmd := "neo4j-admin database restore"
+
+	// Add source path
+	cmd += " --from-path=" + backupPath
+
+	// Add database name
+	cmd += " " + databaseName
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+// ---------------------------------------------------------------------------
+// TestGetBackupCommand / TestGetRestoreCommand (spot checks)
+// ---------------------------------------------------------------------------
+
+func TestGetBackupCommand(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+
+	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "")
+	if !strings.Contains(cmd, "--to-path=/backups/mydb") {
+		t.Errorf("expected --to-path flag in backup command: %q", cmd)
+	}
+	if !strings.Contains(cmd, "mydb") {
+		t.Errorf("expected database name in backup command: %q", cmd)
+	}
+}
+
+func TestGetBackupCommandArgumentOrder(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "server-0:6362")
+	toPathIdx := strings.Index(cmd, "--to-path")
+	dbIdx := strings.LastIndex(cmd, "mydb")
+	if toPathIdx < 0 || dbIdx < 0 || toPathIdx > dbIdx {
+		t.Errorf("--to-path must appear before database name, got: %q", cmd)
+	}
+}
+
+func TestGetBackupCommandAllDatabases(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+	cmd := GetBackupCommand(v, "", "/backups/all", true, "server-0:6362")
+	if !strings.Contains(cmd, `"*"`) {
+		t.Errorf(`expected wildcard "*" for all-databases backup, got: %q`, cmd)
+	}
+	if strings.Contains(cmd, "--include-metadata") {
+		t.Errorf("--include-metadata should not be in base backup command, got: %q", cmd)
+	}
+}
+
+func TestGetBackupCommandFromFlag(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "host1:6362,host2:6362")
+	if !strings.Contains(cmd, "--from=host1:6362,host2:6362") {
+		t.Errorf("expected --from flag, got: %q", cmd)
+	}
+}
+
+func TestGetBackupCommandNoFromWhenEmpty(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "")
+	if strings.Contains(cmd, "--from") {
+		t.Errorf("should not include --from when fromAddresses is empty, got: %q", cmd)
+	}
+}
+
+func TestGetRestoreCommand(t *testing.T) {
+	v, _ := version.Parse("5.26.0-enterprise")
+
+	cmd := GetRestoreCommand(v, "mydb", "/backups/mydb")
+	if !strings.Contains(cmd, "--from-path=/backups/mydb") {
+		t.Errorf("expected --from-path flag in restore command: %q", cmd)
+	}
+	if !strings.Contains(cmd, "mydb") {
+		t.Errorf("expected database name in restore command: %q", cmd)
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,11 +31,15 @@ import (
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // Client represents a Neo4j cluster client with optimized connection management
@@ -42,16 +48,43 @@ type Client struct {
 	enterpriseCluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 	credentials       *Credentials
 
+	// Cache entry of the driver, nil when the client owns its driver
+	sharedDriver *sharedDriver
+
 	// Circuit breaker state
 	circuitBreaker *CircuitBreaker
 
 	// Connection pool metrics
 	poolMetrics *ConnectionPoolMetrics
 
+	// Called for every user, role and privilege statement
+	securityAudit SecurityAuditFunc
+
+	// Rate limiter shared by the clients of the same cluster, applied to
+	// administrative statements
+	adminLimiter *rate.Limiter
+	adminMetrics *metrics.CypherMetrics
+
+	// Closed by Close to stop the health monitoring
+	done chan struct{}
+
 	// Mutex for thread-safe operations
 	mutex sync.RWMutex
 }
 
+// SecurityAuditFunc receives every user, role and privilege statement the
+// client runs, redacted, with its error or nil when it succeeded.
+type SecurityAuditFunc func(ctx context.Context, statement string, err error)
+
+// passwordLiteral matches the password literals of user statements.
+var passwordLiteral = regexp.MustCompile(`(?i)(PASSWORD\s+)(?:'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*")`)
+
+// RedactStatement replaces the password literals of a statement, so it can
+// be logged. Statements taking the password as a parameter are unchanged.
+func RedactStatement(statement string) string {
+	return passwordLiteral.ReplaceAllString(statement, "${1}'******'")
+}
+
 // CircuitBreaker implements the circuit breaker pattern for connection failures
 type CircuitBreaker struct {
 	failureCount    int
@@ -122,23 +155,120 @@ type ClusterMember struct {
 	Health   string
 }
 
-// DatabaseInfo represents information about a Neo4j database
+// DatabaseInfo represents information about a Neo4j database, as hosted by
+// the server at Address
 type DatabaseInfo struct {
 	Name            string
+	Type            string
+	Address         string
 	Status          string
+	StatusMessage   string
 	Default         bool
 	Home            bool
 	Role            string
+	Writer          bool
 	RequestedStatus string
 }
 
 // ServerInfo represents information about a Neo4j server
 type ServerInfo struct {
+	ID      string
+	Name    string
+	Address string
+	State   string
+	Health  string
+	Hosting []string
+	Version string
+}
+
+// ServerMember is a server as listed by SHOW SERVERS, with the role it has
+// for the system database ("primary" or "secondary")
+type ServerMember struct {
+	ID      string
 	Name    string
 	Address string
 	State   string
 	Health  string
 	Hosting []string
+	Version string
+	Role    string
+}
+
+// UserInfo represents a Neo4j user as listed by SHOW USERS
+type UserInfo struct {
+	Name      string
+	Roles     []string
+	Suspended bool
+}
+
+// AliasInfo represents a database alias as listed by SHOW ALIASES. Driver
+// settings hold bool, int64, string or time.Duration values.
+type AliasInfo struct {
+	Name      string
+	Composite string
+	Database  string
+	Location  string
+	URL       string
+	User      string
+	Driver    map[string]interface{}
+}
+
+// AliasDefinition describes an alias to create or alter. Aliases with a URL
+// are remote and connect as User; the others point to a local database.
+// Driver settings use the Cypher setting names, e.g. connection_timeout.
+type AliasDefinition struct {
+	Composite      string
+	Name           string
+	TargetDatabase string
+	URL            string
+	User           string
+	Password       string
+	Driver         map[string]interface{}
+}
+
+// IndexInfo describes an index as listed by SHOW INDEXES. Config holds the
+// indexConfig of its options.
+type IndexInfo struct {
+	Name             string
+	Type             string
+	EntityType       string
+	LabelsOrTypes    []string
+	Properties       []string
+	OwningConstraint string
+	Config           map[string]interface{}
+}
+
+// ConstraintInfo describes a constraint as listed by SHOW CONSTRAINTS. Type
+// is the type Neo4j reports, e.g. NODE_KEY or RELATIONSHIP_UNIQUENESS.
+type ConstraintInfo struct {
+	Name          string
+	Type          string
+	EntityType    string
+	LabelsOrTypes []string
+	Properties    []string
+	PropertyType  string
+}
+
+// IndexDefinition describes an index to create. Type is RANGE, TEXT, POINT,
+// FULLTEXT or VECTOR; Config is passed as indexConfig.
+type IndexDefinition struct {
+	Name          string
+	Type          string
+	Relationship  bool
+	LabelsOrTypes []string
+	Properties    []string
+	Config        map[string]interface{}
+}
+
+// ConstraintDefinition describes a constraint to create. Type is UNIQUE,
+// KEY, EXISTS or PROPERTY_TYPE, as returned by ConstraintKind.
+type ConstraintDefinition struct {
+	Name         string
+	Type         string
+	Relationship bool
+	LabelOrType  string
+	Properties   []string
+	PropertyType string
 }
 
 // NewClientForPod creates a Neo4j client that connects to a specific pod
@@ -162,18 +292,24 @@ func NewClientForPod(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, k8sClient cl
 	}
 
 	// Create driver with pod-specific URL
-	driver, err := neo4j.NewDriverWithContext(podURL, auth, config)
+	shared, driver, err := acquireDriver(cluster.UID, podURL, credentials, func() (neo4j.DriverWithContext, error) {
+		return neo4j.NewDriverWithContext(podURL, auth, config)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Neo4j driver: %w", err)
 	}
 
-	return &Client{
+	client := &Client{
 		driver:            driver,
+		sharedDriver:      shared,
 		enterpriseCluster: cluster,
 		credentials:       credentials,
 		circuitBreaker:    newCircuitBreaker(),
 		poolMetrics:       newConnectionPoolMetrics(),
-	}, nil
+	}
+	client.setAdminRateLimiter(cluster.Namespace, cluster.Name)
+
+	return client, nil
 }
 
 // NewClientForEnterprise creates a new optimized Neo4j client for enterprise clusters
@@ -203,10 +339,10 @@ func NewClientForEnterpriseStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseS
 		c.MaxTransactionRetryTime = 30 * time.Second
 		c.FetchSize = 1000 // Optimized fetch size for memory efficiency
 
-		// Configure TLS if enabled for cert-manager mode
+		// Configure TLS if enabled
 		// For self-signed certificates in development/demo, skip verification
 		// In production, proper CA certificates should be used
-		if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+		if resources.TLSEnabled(standalone.Spec.TLS) {
 			// Skip TLS verification for self-signed certificates
 			// This is needed for demo environments with cert-manager self-signed issuers
 			tlsConfig := &tls.Config{
@@ -216,20 +352,30 @@ func NewClientForEnterpriseStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseS
 		}
 	}
 
-	// Create driver with retry logic for connection establishment
-	driver, err := neo4j.NewDriverWithContext(uri, auth, config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Neo4j driver: %w", err)
-	}
+	// Create driver with retry logic for connection establishment. A
+	// cached driver was verified within the health check interval.
+	var verifyErr error
+	shared, driver, err := acquireDriver(standalone.UID, uri, credentials, func() (neo4j.DriverWithContext, error) {
+		driver, err := neo4j.NewDriverWithContext(uri, auth, config)
+		if err != nil {
+			return nil, err
+		}
 
-	// Test driver connectivity with proper timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+		// Test driver connectivity with proper timeout
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
 
-	err = driver.VerifyConnectivity(ctx)
+		if verifyErr = driver.VerifyConnectivity(ctx); verifyErr != nil {
+			driver.Close(context.Background())
+			return nil, verifyErr
+		}
+		return driver, nil
+	})
+	if verifyErr != nil {
+		return nil, fmt.Errorf("failed to verify Neo4j connectivity: %w", verifyErr)
+	}
 	if err != nil {
-		driver.Close(context.Background())
-		return nil, fmt.Errorf("failed to verify Neo4j connectivity: %w", err)
+		return nil, fmt.Errorf("failed to create Neo4j driver: %w", err)
 	}
 
 	// Initialize circuit breaker
@@ -247,11 +393,13 @@ func NewClientForEnterpriseStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseS
 
 	client := &Client{
 		driver:            driver,
+		sharedDriver:      shared,
 		enterpriseCluster: nil, // No cluster for standalone
 		credentials:       credentials,
 		circuitBreaker:    circuitBreaker,
 		poolMetrics:       poolMetrics,
 	}
+	client.setAdminRateLimiter(standalone.Namespace, standalone.Name)
 
 	return client, nil
 }
@@ -282,10 +430,10 @@ func NewClientForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, k8sCl
 		c.MaxTransactionRetryTime = 30 * time.Second
 		c.FetchSize = 1000 // Optimized fetch size for memory efficiency
 
-		// Configure TLS if needed for cert-manager enabled clusters
+		// Configure TLS if needed for TLS enabled clusters
 		// For self-signed certificates in development/demo, skip verification
 		// In production, proper CA certificates should be used
-		if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == "cert-manager" {
+		if resources.TLSEnabled(cluster.Spec.TLS) {
 			// Skip TLS verification for self-signed certificates
 			// This is needed for demo environments with cert-manager self-signed issuers
 			tlsConfig := &tls.Config{
@@ -301,7 +449,9 @@ func NewClientForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, k8sCl
 		}
 	}
 
-	driver, err := neo4j.NewDriverWithContext(uri, auth, config)
+	shared, driver, err := acquireDriver(cluster.UID, uri, credentials, func() (neo4j.DriverWithContext, error) {
+		return neo4j.NewDriverWithContext(uri, auth, config)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Neo4j driver: %w", err)
 	}
@@ -321,26 +471,35 @@ func NewClientForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, k8sCl
 
 	client := &Client{
 		driver:            driver,
+		sharedDriver:      shared,
 		enterpriseCluster: cluster,
 		credentials:       credentials,
 		circuitBreaker:    circuitBreaker,
 		poolMetrics:       poolMetrics,
+		done:              make(chan struct{}),
 	}
+	client.setAdminRateLimiter(cluster.Namespace, cluster.Name)
 
 	// Start background health monitoring
-	go client.startHealthMonitoring()
+	go client.startHealthMonitoring(client.done)
 
 	return client, nil
 }
 
 // startHealthMonitoring runs background health checks and pool optimization
-func (c *Client) startHealthMonitoring() {
+// until done is closed. It is passed the channel because Close clears c.done.
+func (c *Client) startHealthMonitoring(done <-chan struct{}) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		c.updatePoolMetrics()
-		c.checkCircuitBreakerState()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.updatePoolMetrics()
+			c.checkCircuitBreakerState()
+		}
 	}
 }
 
@@ -436,11 +595,22 @@ func (c *Client) executeWithCircuitBreaker(ctx context.Context, operation func(c
 	return nil
 }
 
-// Close closes the Neo4j connection with proper cleanup
+// Close closes the Neo4j connection with proper cleanup. A shared driver is
+// only released, and closed once it is idle or evicted.
 func (c *Client) Close() error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	if c.done != nil {
+		close(c.done)
+		c.done = nil
+	}
+	if c.sharedDriver != nil {
+		releaseDriver(c.sharedDriver)
+		c.sharedDriver = nil
+		c.driver = nil
+		return nil
+	}
 	if c.driver != nil {
 		err := c.driver.Close(context.Background())
 		c.driver = nil // Set to nil after closing for proper cleanup
@@ -449,6 +619,28 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// Username returns the user the client authenticates as
+func (c *Client) Username() string {
+	if c.credentials == nil {
+		return ""
+	}
+	return c.credentials.Username
+}
+
+// SetSecurityAudit sets the function called for every user, role and
+// privilege statement the client runs
+func (c *Client) SetSecurityAudit(audit SecurityAuditFunc) {
+	c.securityAudit = audit
+}
+
+// auditSecurityStatement reports a user, role or privilege statement to the
+// security audit function, if any
+func (c *Client) auditSecurityStatement(ctx context.Context, statement string, err error) {
+	if c.securityAudit != nil {
+		c.securityAudit(ctx, RedactStatement(statement), err)
+	}
+}
+
 // formatOptionKey formats option keys for Neo4j CREATE DATABASE OPTIONS syntax
 // Neo4j OPTIONS syntax doesn't support dotted keys - only simple identifiers
 func (c *Client) formatOptionKey(key string) string {
@@ -478,7 +670,7 @@ func (c *Client) closeSession(ctx context.Context, session neo4j.SessionWithCont
 // VerifyConnectivity verifies that the client can connect to the cluster with circuit breaker
 func (c *Client) VerifyConnectivity(ctx context.Context) error {
 	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode: neo4j.AccessModeRead,
 		})
 		defer c.closeSession(ctx, session)
@@ -497,7 +689,7 @@ func (c *Client) GetClusterOverview(ctx context.Context) ([]ClusterMember, error
 	var members []ClusterMember
 
 	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode: neo4j.AccessModeRead,
 		})
 		defer c.closeSession(ctx, session)
@@ -551,55 +743,14 @@ func (c *Client) GetClusterOverview(ctx context.Context) ([]ClusterMember, error
 	return members, err
 }
 
-// GetDatabases returns information about databases in the cluster with caching
+// GetDatabases returns information about databases in the cluster
 func (c *Client) GetDatabases(ctx context.Context) ([]DatabaseInfo, error) {
 	var databases []DatabaseInfo
 
 	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
-			AccessMode:   neo4j.AccessModeRead,
-			DatabaseName: "system",
-		})
-		defer c.closeSession(ctx, session)
-
-		// Optimized query with timeout
-		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-		defer cancel()
-
-		result, err := session.Run(timeoutCtx, `
-			SHOW DATABASES
-			YIELD name, currentStatus, default, home, role, requestedStatus
-			RETURN name, currentStatus, default, home, role, requestedStatus
-		`, nil)
-		if err != nil {
-			return fmt.Errorf("failed to get databases: %w", err)
-		}
-
-		for result.Next(timeoutCtx) {
-			record := result.Record()
-
-			name, _ := record.Get("name")
-			status, _ := record.Get("currentStatus")
-			isDefault, _ := record.Get("default")
-			isHome, _ := record.Get("home")
-			role, _ := record.Get("role")
-			requestedStatus, _ := record.Get("requestedStatus")
-
-			databases = append(databases, DatabaseInfo{
-				Name:            fmt.Sprintf("%v", name),
-				Status:          fmt.Sprintf("%v", status),
-				Default:         fmt.Sprintf("%v", isDefault) == TrueString,
-				Home:            fmt.Sprintf("%v", isHome) == TrueString,
-				Role:            fmt.Sprintf("%v", role),
-				RequestedStatus: fmt.Sprintf("%v", requestedStatus),
-			})
-		}
-
-		if err = result.Err(); err != nil {
-			return fmt.Errorf("error reading databases: %w", err)
-		}
-
-		return nil
+		var err error
+		databases, err = c.ShowDatabases(ctx, "")
+		return err
 	})
 
 	return databases, err
@@ -617,7 +768,11 @@ func (c *Client) GetConnectionPoolMetrics() *ConnectionPoolMetrics {
 
 // CreateDatabase creates a new database with proper Neo4j 5.26+ syntax
 func (c *Client) CreateDatabase(ctx context.Context, databaseName string, options map[string]string, wait bool, ifNotExists bool) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -668,7 +823,11 @@ func (c *Client) CreateDatabase(ctx context.Context, databaseName string, option
 
 // CreateDatabaseWithTopology creates a database with specific topology constraints
 func (c *Client) CreateDatabaseWithTopology(ctx context.Context, databaseName string, primaries, secondaries int32, options map[string]string, wait bool, ifNotExists bool, cypherVersion string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -744,7 +903,11 @@ func (c *Client) CreateDatabaseWithTopology(ctx context.Context, databaseName st
 
 // AlterDatabaseTopology sets database topology using ALTER DATABASE command for Neo4j 5.x
 func (c *Client) AlterDatabaseTopology(ctx context.Context, databaseName string, primaries, secondaries int32) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -781,6 +944,51 @@ func (c *Client) AlterDatabaseTopology(ctx context.Context, databaseName string,
 	return nil
 }
 
+// GetDatabaseTopology returns the number of primaries and secondaries
+// requested for a database, as last set by CREATE or ALTER DATABASE
+func (c *Client) GetDatabaseTopology(ctx context.Context, databaseName string) (int32, int32, error) {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeRead,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := `
+		SHOW DATABASES
+		YIELD name, requestedPrimariesCount, requestedSecondariesCount
+		WHERE name = $databaseName
+		RETURN requestedPrimariesCount, requestedSecondariesCount
+		LIMIT 1
+	`
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, err := session.Run(timeoutCtx, query, map[string]interface{}{
+		"databaseName": databaseName,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get database topology: %w", err)
+	}
+
+	if !result.Next(timeoutCtx) {
+		return 0, 0, fmt.Errorf("database %s not found", databaseName)
+	}
+	record := result.Record()
+	var primaries, secondaries int32
+	if value, found := record.Get("requestedPrimariesCount"); found {
+		if count, ok := value.(int64); ok {
+			primaries = int32(count)
+		}
+	}
+	if value, found := record.Get("requestedSecondariesCount"); found {
+		if count, ok := value.(int64); ok {
+			secondaries = int32(count)
+		}
+	}
+	return primaries, secondaries, nil
+}
+
 // executeWithWaitTimeout executes a Neo4j query with timeout protection for WAIT operations
 func (c *Client) executeWithWaitTimeout(ctx context.Context, session neo4j.SessionWithContext, query string, params map[string]interface{}, wait bool, timeoutSeconds int) error {
 	if wait && strings.Contains(query, " WAIT") {
@@ -788,7 +996,9 @@ func (c *Client) executeWithWaitTimeout(ctx context.Context, session neo4j.Sessi
 		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 		defer cancel()
 
-		_, err := session.Run(waitCtx, query, params)
+		// Not retried: when the connection drops, the callers check
+		// whether the database was created meanwhile
+		_, err := session.Run(withoutRetry(waitCtx), query, params)
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				return fmt.Errorf("database operation timed out after %ds while executing: %s: %w", timeoutSeconds, query, err)
@@ -808,7 +1018,11 @@ func (c *Client) executeWithWaitTimeout(ctx context.Context, session neo4j.Sessi
 
 // StartDatabase starts a stopped database
 func (c *Client) StartDatabase(ctx context.Context, databaseName string, wait bool) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -831,7 +1045,11 @@ func (c *Client) StartDatabase(ctx context.Context, databaseName string, wait bo
 
 // StopDatabase stops a running database
 func (c *Client) StopDatabase(ctx context.Context, databaseName string, wait bool) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -854,7 +1072,11 @@ func (c *Client) StopDatabase(ctx context.Context, databaseName string, wait boo
 
 // AlterDatabase alters database properties
 func (c *Client) AlterDatabase(ctx context.Context, databaseName string, options map[string]string, wait bool) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -888,67 +1110,44 @@ func (c *Client) AlterDatabase(ctx context.Context, databaseName string, options
 
 // GetDatabaseState returns the current state of a database
 func (c *Client) GetDatabaseState(ctx context.Context, databaseName string) (string, error) {
-	databases, err := c.GetDatabases(ctx)
+	databases, err := c.ShowDatabases(ctx, databaseName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get database state: %w", err)
 	}
-
-	for _, db := range databases {
-		if db.Name == databaseName {
-			return db.Status, nil
-		}
+	if len(databases) == 0 {
+		return "", fmt.Errorf("database %s not found", databaseName)
 	}
-
-	return "", fmt.Errorf("database %s not found", databaseName)
+	return databases[0].Status, nil
 }
 
 // GetDatabaseServers returns the servers hosting a specific database
 func (c *Client) GetDatabaseServers(ctx context.Context, databaseName string) ([]string, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
-		AccessMode:   neo4j.AccessModeRead,
-		DatabaseName: "system",
-	})
-	defer session.Close(ctx)
-
-	query := `
-		SHOW DATABASES
-		YIELD name, address
-		WHERE name = $databaseName
-		RETURN collect(address) as servers
-	`
-
-	// Add timeout protection for CI environment compatibility
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-
-	result, err := session.Run(timeoutCtx, query, map[string]interface{}{
-		"databaseName": databaseName,
-	})
+	databases, err := c.ShowDatabases(ctx, databaseName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database servers: %w", err)
 	}
+	return databaseAddresses(databases), nil
+}
 
-	if result.Next(timeoutCtx) {
-		record := result.Record()
-		if servers, found := record.Get("servers"); found {
-			if serverList, ok := servers.([]interface{}); ok {
-				var serverAddresses []string
-				for _, addr := range serverList {
-					if addrStr, ok := addr.(string); ok {
-						serverAddresses = append(serverAddresses, addrStr)
-					}
-				}
-				return serverAddresses, nil
-			}
+// databaseAddresses returns the addresses of the servers hosting the
+// databases listed
+func databaseAddresses(databases []DatabaseInfo) []string {
+	servers := []string{}
+	for _, db := range databases {
+		if db.Address != "" {
+			servers = append(servers, db.Address)
 		}
 	}
-
-	return []string{}, nil
+	return servers
 }
 
 // DropDatabase drops a database
 func (c *Client) DropDatabase(ctx context.Context, databaseName string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -983,127 +1182,652 @@ func isDatabaseNotFoundError(err error) bool {
 		strings.Contains(errMsg, "databasenotfound")
 }
 
-// CreateUser creates a new user
-func (c *Client) CreateUser(ctx context.Context, username, password string, mustChangePassword bool) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+// CreateCompositeDatabase creates a composite database
+func (c *Client) CreateCompositeDatabase(ctx context.Context, databaseName string, wait bool, ifNotExists bool) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	query := fmt.Sprintf("CREATE USER `%s` SET PASSWORD $password", username)
-	if mustChangePassword {
-		query += " CHANGE REQUIRED"
-	} else {
-		query += " CHANGE NOT REQUIRED"
+	query := fmt.Sprintf("CREATE COMPOSITE DATABASE `%s`", databaseName)
+	if ifNotExists {
+		query += " IF NOT EXISTS"
 	}
-
-	params := map[string]interface{}{
-		"password": password,
+	if wait {
+		query += " WAIT"
+	} else {
+		query += " NOWAIT"
 	}
 
-	_, err := session.Run(ctx, query, params)
-	if err != nil {
-		return fmt.Errorf("failed to create user %s: %w", username, err)
+	if err := c.executeWithWaitTimeout(ctx, session, query, nil, wait, 300); err != nil {
+		return fmt.Errorf("failed to create composite database %s: %w", databaseName, err)
 	}
-
 	return nil
 }
 
-// DropUser drops a user
-func (c *Client) DropUser(ctx context.Context, username string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+// DropCompositeDatabase drops a composite database together with its
+// constituent aliases
+func (c *Client) DropCompositeDatabase(ctx context.Context, databaseName string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	query := fmt.Sprintf("DROP USER `%s`", username)
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return fmt.Errorf("failed to drop user %s: %w", username, err)
+	query := fmt.Sprintf("DROP COMPOSITE DATABASE `%s`", databaseName)
+	if _, err := session.Run(ctx, query, nil); err != nil {
+		if isDatabaseNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to drop composite database %s: %w", databaseName, err)
 	}
-
 	return nil
 }
 
-// CreateRole creates a new role
-func (c *Client) CreateRole(ctx context.Context, roleName string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
-		AccessMode:   neo4j.AccessModeWrite,
-		DatabaseName: "system",
-	})
-	defer session.Close(ctx)
+// ListAliases lists the database aliases, including the constituents of
+// composite databases under their qualified <composite>.<name> name
+func (c *Client) ListAliases(ctx context.Context) ([]AliasInfo, error) {
+	var aliases []AliasInfo
 
-	query := fmt.Sprintf("CREATE ROLE `%s`", roleName)
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create role %s: %w", roleName, err)
-	}
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: "system",
+		})
+		defer c.closeSession(ctx, session)
 
-	return nil
+		result, err := session.Run(ctx, "SHOW ALIASES FOR DATABASE YIELD name, composite, database, location, url, user, driver", nil)
+		if err != nil {
+			return fmt.Errorf("failed to list aliases: %w", err)
+		}
+
+		for result.Next(ctx) {
+			record := result.Record()
+			alias := AliasInfo{
+				Name:      recordString(record, "name"),
+				Composite: recordString(record, "composite"),
+				Database:  recordString(record, "database"),
+				Location:  recordString(record, "location"),
+				URL:       recordString(record, "url"),
+				User:      recordString(record, "user"),
+			}
+			if alias.Composite != "" && !strings.HasPrefix(alias.Name, alias.Composite+".") {
+				alias.Name = alias.Composite + "." + alias.Name
+			}
+			if driver, found := record.Get("driver"); found {
+				if settings, ok := driver.(map[string]interface{}); ok {
+					alias.Driver = normalizeDriverSettings(settings)
+				}
+			}
+			aliases = append(aliases, alias)
+		}
+
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("error reading aliases: %w", err)
+		}
+
+		return nil
+	})
+
+	return aliases, err
 }
 
-// DropRole drops a role
-func (c *Client) DropRole(ctx context.Context, roleName string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+// CreateAlias creates a local or remote alias, or a constituent of a
+// composite database
+func (c *Client) CreateAlias(ctx context.Context, alias AliasDefinition) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	query := fmt.Sprintf("DROP ROLE `%s`", roleName)
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return fmt.Errorf("failed to drop role %s: %w", roleName, err)
+	query, params := buildCreateAliasQuery(alias)
+	if _, err := session.Run(ctx, query, params); err != nil {
+		return fmt.Errorf("failed to create alias %s: %w", aliasName(alias.Composite, alias.Name), err)
 	}
-
 	return nil
 }
 
-// GrantRoleToUser grants a role to a user
-func (c *Client) GrantRoleToUser(ctx context.Context, roleName, username string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+// AlterAlias points an existing alias at the target, URL, credentials and
+// driver settings of the definition. An alias cannot be altered between
+// local and remote; drop and recreate it instead.
+func (c *Client) AlterAlias(ctx context.Context, alias AliasDefinition) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	query := fmt.Sprintf("GRANT ROLE `%s` TO `%s`", roleName, username)
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return fmt.Errorf("failed to grant role %s to user %s: %w", roleName, username, err)
+	query, params := buildAlterAliasQuery(alias)
+	if _, err := session.Run(ctx, query, params); err != nil {
+		return fmt.Errorf("failed to alter alias %s: %w", aliasName(alias.Composite, alias.Name), err)
 	}
-
 	return nil
 }
 
-// RevokeRoleFromUser revokes a role from a user
-func (c *Client) RevokeRoleFromUser(ctx context.Context, roleName, username string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+// DropAlias drops an alias or a constituent of a composite database
+func (c *Client) DropAlias(ctx context.Context, composite, name string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	query := fmt.Sprintf("REVOKE ROLE `%s` FROM `%s`", roleName, username)
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return fmt.Errorf("failed to revoke role %s from user %s: %w", roleName, username, err)
-	}
-
+	query := fmt.Sprintf("DROP ALIAS %s IF EXISTS FOR DATABASE", quoteAliasName(composite, name))
+	if _, err := session.Run(ctx, query, nil); err != nil {
+		if isDatabaseNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to drop alias %s: %w", aliasName(composite, name), err)
+	}
+	return nil
+}
+
+// aliasName returns the name SHOW ALIASES lists an alias under
+func aliasName(composite, name string) string {
+	if composite == "" {
+		return name
+	}
+	return composite + "." + name
+}
+
+// quoteAliasName returns the quoted Cypher name of an alias
+func quoteAliasName(composite, name string) string {
+	if composite == "" {
+		return fmt.Sprintf("`%s`", name)
+	}
+	return fmt.Sprintf("`%s`.`%s`", composite, name)
+}
+
+func buildCreateAliasQuery(alias AliasDefinition) (string, map[string]interface{}) {
+	query := fmt.Sprintf("CREATE ALIAS %s IF NOT EXISTS FOR DATABASE `%s`", quoteAliasName(alias.Composite, alias.Name), alias.TargetDatabase)
+	return appendRemoteAliasTarget(query, alias)
+}
+
+func buildAlterAliasQuery(alias AliasDefinition) (string, map[string]interface{}) {
+	query := fmt.Sprintf("ALTER ALIAS %s SET DATABASE TARGET `%s`", quoteAliasName(alias.Composite, alias.Name), alias.TargetDatabase)
+	return appendRemoteAliasTarget(query, alias)
+}
+
+// appendRemoteAliasTarget adds the URL, credentials and driver settings of a
+// remote alias. Values are passed as parameters except for the driver
+// settings, which Cypher only accepts as a map literal.
+func appendRemoteAliasTarget(query string, alias AliasDefinition) (string, map[string]interface{}) {
+	if alias.URL == "" {
+		return query, nil
+	}
+	query += " AT $url USER $user PASSWORD $password"
+	if len(alias.Driver) > 0 {
+		query += " DRIVER " + formatDriverSettings(alias.Driver)
+	}
+	return query, map[string]interface{}{
+		"url":      alias.URL,
+		"user":     alias.User,
+		"password": alias.Password,
+	}
+}
+
+// formatDriverSettings renders driver settings as a Cypher map literal with
+// the keys sorted
+func formatDriverSettings(settings map[string]interface{}) string {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		var value string
+		switch v := settings[key].(type) {
+		case time.Duration:
+			value = fmt.Sprintf("duration('PT%sS')", strconv.FormatFloat(v.Seconds(), 'f', -1, 64))
+		case string:
+			value = fmt.Sprintf("'%s'", v)
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", key, value))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// normalizeDriverSettings converts the driver settings SHOW ALIASES returns
+// to the value types of AliasDefinition.Driver
+func normalizeDriverSettings(settings map[string]interface{}) map[string]interface{} {
+	normalized := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		if value == nil {
+			continue
+		}
+		switch v := value.(type) {
+		case neo4j.Duration:
+			normalized[key] = time.Duration(v.Days)*24*time.Hour + time.Duration(v.Seconds)*time.Second + time.Duration(v.Nanos)
+		default:
+			normalized[key] = v
+		}
+	}
+	return normalized
+}
+
+// recordString returns a string column of a record, empty when it is null
+func recordString(record *neo4j.Record, key string) string {
+	value, found := record.Get(key)
+	if !found || value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// ListIndexes returns the indexes of a database
+func (c *Client) ListIndexes(ctx context.Context, databaseName string) ([]IndexInfo, error) {
+	var indexes []IndexInfo
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: databaseName,
+		})
+		defer c.closeSession(ctx, session)
+
+		result, err := session.Run(ctx, "SHOW INDEXES YIELD *", nil)
+		if err != nil {
+			return fmt.Errorf("failed to list indexes of database %s: %w", databaseName, err)
+		}
+
+		for result.Next(ctx) {
+			record := result.Record()
+			index := IndexInfo{
+				Name:             recordString(record, "name"),
+				Type:             recordString(record, "type"),
+				EntityType:       recordString(record, "entityType"),
+				LabelsOrTypes:    recordStrings(record, "labelsOrTypes"),
+				Properties:       recordStrings(record, "properties"),
+				OwningConstraint: recordString(record, "owningConstraint"),
+			}
+			if options, found := record.Get("options"); found {
+				if options, ok := options.(map[string]interface{}); ok {
+					index.Config, _ = options["indexConfig"].(map[string]interface{})
+				}
+			}
+			indexes = append(indexes, index)
+		}
+
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("error reading indexes: %w", err)
+		}
+		return nil
+	})
+
+	return indexes, err
+}
+
+// ListConstraints returns the constraints of a database
+func (c *Client) ListConstraints(ctx context.Context, databaseName string) ([]ConstraintInfo, error) {
+	var constraints []ConstraintInfo
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: databaseName,
+		})
+		defer c.closeSession(ctx, session)
+
+		// YIELD * also works on versions without the propertyType column
+		result, err := session.Run(ctx, "SHOW CONSTRAINTS YIELD *", nil)
+		if err != nil {
+			return fmt.Errorf("failed to list constraints of database %s: %w", databaseName, err)
+		}
+
+		for result.Next(ctx) {
+			record := result.Record()
+			constraints = append(constraints, ConstraintInfo{
+				Name:          recordString(record, "name"),
+				Type:          recordString(record, "type"),
+				EntityType:    recordString(record, "entityType"),
+				LabelsOrTypes: recordStrings(record, "labelsOrTypes"),
+				Properties:    recordStrings(record, "properties"),
+				PropertyType:  recordString(record, "propertyType"),
+			})
+		}
+
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("error reading constraints: %w", err)
+		}
+		return nil
+	})
+
+	return constraints, err
+}
+
+// CreateIndex creates an index in a database unless one with its name exists
+func (c *Client) CreateIndex(ctx context.Context, databaseName string, index IndexDefinition) error {
+	return c.runSchemaCommand(ctx, databaseName, buildCreateIndexQuery(index))
+}
+
+// CreateConstraint creates a constraint in a database unless one with its
+// name exists
+func (c *Client) CreateConstraint(ctx context.Context, databaseName string, constraint ConstraintDefinition) error {
+	return c.runSchemaCommand(ctx, databaseName, buildCreateConstraintQuery(constraint))
+}
+
+// DropIndex drops an index of a database if it exists
+func (c *Client) DropIndex(ctx context.Context, databaseName, name string) error {
+	return c.runSchemaCommand(ctx, databaseName, fmt.Sprintf("DROP INDEX `%s` IF EXISTS", name))
+}
+
+// DropConstraint drops a constraint of a database if it exists
+func (c *Client) DropConstraint(ctx context.Context, databaseName, name string) error {
+	return c.runSchemaCommand(ctx, databaseName, fmt.Sprintf("DROP CONSTRAINT `%s` IF EXISTS", name))
+}
+
+func (c *Client) runSchemaCommand(ctx context.Context, databaseName, query string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: databaseName,
+	})
+	defer session.Close(ctx)
+
+	// Constraints are validated against all the data before they are created
+	if _, err := session.Run(withoutTimeout(ctx), query, nil); err != nil {
+		return fmt.Errorf("schema command failed on database %s: %s: %w", databaseName, query, err)
+	}
+	return nil
+}
+
+// ConstraintKind maps the constraint type SHOW CONSTRAINTS reports to
+// UNIQUE, KEY, EXISTS or PROPERTY_TYPE, covering the names of Neo4j 5 and
+// 2025.x. It returns the type unchanged if it is not recognised.
+func ConstraintKind(neo4jType string) string {
+	switch {
+	case strings.HasSuffix(neo4jType, "UNIQUENESS"):
+		return "UNIQUE"
+	case strings.HasSuffix(neo4jType, "_KEY"):
+		return "KEY"
+	case strings.HasSuffix(neo4jType, "_EXISTENCE"):
+		return "EXISTS"
+	case strings.HasSuffix(neo4jType, "PROPERTY_TYPE"):
+		return "PROPERTY_TYPE"
+	}
+	return neo4jType
+}
+
+// schemaPattern returns the node or relationship pattern of an index or
+// constraint and the variable bound in it
+func schemaPattern(relationship bool, labelsOrTypes []string) (string, string) {
+	quoted := make([]string, 0, len(labelsOrTypes))
+	for _, token := range labelsOrTypes {
+		quoted = append(quoted, fmt.Sprintf("`%s`", token))
+	}
+	if relationship {
+		return fmt.Sprintf("()-[r:%s]-()", strings.Join(quoted, "|")), "r"
+	}
+	return fmt.Sprintf("(n:%s)", strings.Join(quoted, "|")), "n"
+}
+
+func schemaProperties(variable string, properties []string) string {
+	refs := make([]string, 0, len(properties))
+	for _, property := range properties {
+		refs = append(refs, fmt.Sprintf("%s.`%s`", variable, property))
+	}
+	return strings.Join(refs, ", ")
+}
+
+func buildCreateIndexQuery(index IndexDefinition) string {
+	pattern, variable := schemaPattern(index.Relationship, index.LabelsOrTypes)
+	on := "(" + schemaProperties(variable, index.Properties) + ")"
+	if index.Type == "FULLTEXT" {
+		on = "EACH [" + schemaProperties(variable, index.Properties) + "]"
+	}
+	query := fmt.Sprintf("CREATE %s INDEX `%s` IF NOT EXISTS FOR %s ON %s", index.Type, index.Name, pattern, on)
+	if len(index.Config) > 0 {
+		query += " OPTIONS {indexConfig: " + formatIndexConfig(index.Config) + "}"
+	}
+	return query
+}
+
+func buildCreateConstraintQuery(constraint ConstraintDefinition) string {
+	pattern, variable := schemaPattern(constraint.Relationship, []string{constraint.LabelOrType})
+	properties := schemaProperties(variable, constraint.Properties)
+
+	var require string
+	switch constraint.Type {
+	case "KEY":
+		entity := "NODE"
+		if constraint.Relationship {
+			entity = "RELATIONSHIP"
+		}
+		require = fmt.Sprintf("(%s) IS %s KEY", properties, entity)
+	case "EXISTS":
+		require = properties + " IS NOT NULL"
+	case "PROPERTY_TYPE":
+		require = properties + " IS :: " + constraint.PropertyType
+	default:
+		require = fmt.Sprintf("(%s) IS UNIQUE", properties)
+	}
+	return fmt.Sprintf("CREATE CONSTRAINT `%s` IF NOT EXISTS FOR %s REQUIRE %s", constraint.Name, pattern, require)
+}
+
+// formatIndexConfig renders index settings as a Cypher map literal. Setting
+// names contain dots and are quoted.
+func formatIndexConfig(config map[string]interface{}) string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := fmt.Sprintf("%v", config[key])
+		if v, ok := config[key].(string); ok {
+			value = "'" + strings.ReplaceAll(v, "'", "\\'") + "'"
+		}
+		parts = append(parts, fmt.Sprintf("`%s`: %s", key, value))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// recordStrings returns a list column of a record, nil when it is null
+func recordStrings(record *neo4j.Record, key string) []string {
+	value, found := record.Get(key)
+	if !found || value == nil {
+		return nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, fmt.Sprintf("%v", item))
+	}
+	return values
+}
+
+// CreateUser creates a new user
+func (c *Client) CreateUser(ctx context.Context, username, password string, mustChangePassword bool) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("CREATE USER `%s` SET PASSWORD $password", username)
+	if mustChangePassword {
+		query += " CHANGE REQUIRED"
+	} else {
+		query += " CHANGE NOT REQUIRED"
+	}
+
+	params := map[string]interface{}{
+		"password": password,
+	}
+
+	// Not retried: a second attempt fails when the first one was committed
+	_, err := session.Run(withoutRetry(ctx), query, params)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to create user %s: %w", username, err)
+	}
+
+	return nil
+}
+
+// DropUser drops a user
+func (c *Client) DropUser(ctx context.Context, username string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("DROP USER `%s`", username)
+	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to drop user %s: %w", username, err)
+	}
+
+	return nil
+}
+
+// CreateRole creates a new role
+func (c *Client) CreateRole(ctx context.Context, roleName string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("CREATE ROLE `%s`", roleName)
+	// Not retried: a second attempt fails when the first one was committed
+	_, err := session.Run(withoutRetry(ctx), query, nil)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to create role %s: %w", roleName, err)
+	}
+
+	return nil
+}
+
+// DropRole drops a role
+func (c *Client) DropRole(ctx context.Context, roleName string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("DROP ROLE `%s`", roleName)
+	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to drop role %s: %w", roleName, err)
+	}
+
+	return nil
+}
+
+// GrantRoleToUser grants a role to a user
+func (c *Client) GrantRoleToUser(ctx context.Context, roleName, username string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("GRANT ROLE `%s` TO `%s`", roleName, username)
+	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to grant role %s to user %s: %w", roleName, username, err)
+	}
+
+	return nil
+}
+
+// RevokeRoleFromUser revokes a role from a user
+func (c *Client) RevokeRoleFromUser(ctx context.Context, roleName, username string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("REVOKE ROLE `%s` FROM `%s`", roleName, username)
+	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to revoke role %s from user %s: %w", roleName, username, err)
+	}
+
 	return nil
 }
 
 // ExecutePrivilegeStatement executes a privilege statement
 func (c *Client) ExecutePrivilegeStatement(ctx context.Context, statement string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
 	_, err := session.Run(ctx, statement, nil)
+	c.auditSecurityStatement(ctx, statement, err)
 	if err != nil {
 		return fmt.Errorf("failed to execute privilege statement: %w", err)
 	}
@@ -1114,7 +1838,7 @@ func (c *Client) ExecutePrivilegeStatement(ctx context.Context, statement string
 // CheckUpgradeCompatibility checks if an upgrade to the specified version is compatible
 func (c *Client) CheckUpgradeCompatibility(ctx context.Context, targetVersion string) error {
 	// Get current version
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode: neo4j.AccessModeRead,
 	})
 	defer session.Close(ctx)
@@ -1163,7 +1887,7 @@ func (c *Client) IsClusterHealthy(ctx context.Context) (bool, error) {
 // to roll last during a rolling upgrade. Returns ("", nil) if the primary cannot be
 // determined (caller should fall back to a safe default).
 func (c *Client) FindSystemDatabasePrimaryAddress(ctx context.Context) (string, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
@@ -1287,7 +2011,7 @@ func (c *Client) WaitForClusterReady(ctx context.Context, timeout time.Duration)
 
 // GetMemberRole returns the role of a specific cluster member using CALL dbms.cluster.role()
 func (c *Client) GetMemberRole(ctx context.Context) (string, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode: neo4j.AccessModeRead,
 	})
 	defer session.Close(ctx)
@@ -1345,30 +2069,20 @@ func (c *Client) WaitForRoleTransition(ctx context.Context, expectedRole string,
 
 // GetClusterConsensusState checks if cluster has achieved consensus
 func (c *Client) GetClusterConsensusState(ctx context.Context) (bool, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
-		AccessMode: neo4j.AccessModeRead,
-	})
-	defer session.Close(ctx)
-
-	// Check cluster topology
-	result, err := session.Run(ctx, "SHOW SERVERS YIELD name, address, state, health, hosting", nil)
+	servers, err := c.ShowServers(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get server information: %w", err)
 	}
 
-	var totalServers, enabledServers int
-	for result.Next(ctx) {
-		record := result.Record()
-		totalServers++
-		if state, found := record.Get("state"); found {
-			if stateStr, ok := state.(string); ok && stateStr == "Enabled" {
-				enabledServers++
-			}
+	var enabledServers int
+	for _, server := range servers {
+		if server.State == "Enabled" {
+			enabledServers++
 		}
 	}
 
 	// Require majority to be enabled for consensus
-	return enabledServers > totalServers/2, nil
+	return enabledServers > len(servers)/2, nil
 }
 
 // ValidateUpgradeSafety performs comprehensive upgrade safety checks
@@ -1494,6 +2208,12 @@ func (c *Client) GetSecondaryMembers(ctx context.Context) ([]ClusterMember, erro
 
 // Helper functions
 
+// SecretCredentials reads the credentials the operator connects with from a
+// Secret, in NEO4J_AUTH or username/password form
+func SecretCredentials(ctx context.Context, k8sClient client.Client, namespace, secretName string) (*Credentials, error) {
+	return getCredentials(ctx, k8sClient, namespace, secretName)
+}
+
 func getCredentials(ctx context.Context, k8sClient client.Client, namespace, secretName string) (*Credentials, error) {
 	secret := &corev1.Secret{}
 	err := k8sClient.Get(ctx, types.NamespacedName{
@@ -1551,23 +2271,230 @@ func (c *Client) DatabaseExists(ctx context.Context, databaseName string) (bool,
 
 // ExecuteCypher executes a cypher statement on a specific database
 func (c *Client) ExecuteCypher(ctx context.Context, databaseName, statement string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: databaseName,
 	})
-	defer session.Close(ctx)
-
-	_, err := session.Run(ctx, statement, nil)
-	if err != nil {
-		return fmt.Errorf("failed to execute cypher: %w", err)
-	}
+	defer session.Close(ctx)
+
+	// User statements need not be idempotent, so they are neither retried
+	// nor cut short
+	_, err := session.Run(withoutTimeout(withoutRetry(ctx)), statement, nil)
+	if err != nil {
+		return fmt.Errorf("failed to execute cypher: %w", err)
+	}
+
+	return nil
+}
+
+// MigrationHistoryLabel is the label of the nodes recording the scripts a
+// Neo4jMigration applied to a database
+const MigrationHistoryLabel = "__Neo4jMigration"
+
+// AppliedMigration is a script recorded in the migration history of a
+// database
+type AppliedMigration struct {
+	Version       string
+	Description   string
+	Checksum      string
+	AppliedAt     time.Time
+	ExecutionTime time.Duration
+}
+
+// ListAppliedMigrations returns the scripts recorded for a migration in the
+// history of a database, in the order they were applied
+func (c *Client) ListAppliedMigrations(ctx context.Context, databaseName, migration string) ([]AppliedMigration, error) {
+	var applied []AppliedMigration
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: databaseName,
+		})
+		defer c.closeSession(ctx, session)
+
+		query := "MATCH (m:" + MigrationHistoryLabel + " {migration: $migration}) " +
+			"RETURN m.version AS version, m.description AS description, m.checksum AS checksum, " +
+			"m.appliedAt AS appliedAt, m.executionTimeMillis AS executionTimeMillis ORDER BY m.appliedAt"
+		result, err := session.Run(ctx, query, map[string]interface{}{"migration": migration})
+		if err != nil {
+			return fmt.Errorf("failed to read migration history of database %s: %w", databaseName, err)
+		}
+
+		for result.Next(ctx) {
+			record := result.Record()
+			entry := AppliedMigration{
+				Version:     recordString(record, "version"),
+				Description: recordString(record, "description"),
+				Checksum:    recordString(record, "checksum"),
+			}
+			if value, found := record.Get("appliedAt"); found {
+				if appliedAt, ok := value.(time.Time); ok {
+					entry.AppliedAt = appliedAt
+				}
+			}
+			if value, found := record.Get("executionTimeMillis"); found {
+				if millis, ok := value.(int64); ok {
+					entry.ExecutionTime = time.Duration(millis) * time.Millisecond
+				}
+			}
+			applied = append(applied, entry)
+		}
+
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("error reading migration history: %w", err)
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// ApplyMigration runs the statements of a script one by one and records the
+// script in the migration history once all of them succeeded. Each statement
+// runs in its own auto-commit transaction, so that scripts can mix schema
+// and data changes and use CALL { ... } IN TRANSACTIONS; a script failing
+// part way keeps the changes of the statements before the failing one.
+func (c *Client) ApplyMigration(ctx context.Context, databaseName, migration string, script AppliedMigration, statements []string) (time.Duration, error) {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: databaseName,
+	})
+	defer c.closeSession(ctx, session)
+
+	// Scripts are applied exactly once, however long they run: a statement
+	// is never run again, and only the context of the caller bounds it
+	scriptCtx := withoutTimeout(withoutRetry(ctx))
+	start := time.Now()
+	for i, statement := range statements {
+		result, err := session.Run(scriptCtx, statement, nil)
+		if err == nil {
+			_, err = result.Consume(ctx)
+		}
+		if err != nil {
+			return time.Since(start), fmt.Errorf("statement %d of version %s failed: %w", i+1, script.Version, err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	query := "MERGE (m:" + MigrationHistoryLabel + " {migration: $migration, version: $version}) " +
+		"SET m.description = $description, m.checksum = $checksum, m.appliedAt = datetime(), " +
+		"m.executionTimeMillis = $executionTimeMillis, m.appliedBy = $appliedBy"
+	result, err := session.Run(ctx, query, map[string]interface{}{
+		"migration":           migration,
+		"version":             script.Version,
+		"description":         script.Description,
+		"checksum":            script.Checksum,
+		"executionTimeMillis": elapsed.Milliseconds(),
+		"appliedBy":           c.Username(),
+	})
+	if err == nil {
+		_, err = result.Consume(ctx)
+	}
+	if err != nil {
+		return elapsed, fmt.Errorf("version %s was applied but could not be recorded: %w", script.Version, err)
+	}
+	return elapsed, nil
+}
+
+// GetTxLogEnrichment returns the txLogEnrichment option of a database, which
+// is "OFF" when change data capture was never enabled
+func (c *Client) GetTxLogEnrichment(ctx context.Context, databaseName string) (string, error) {
+	mode := ""
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: "system",
+		})
+		defer c.closeSession(ctx, session)
+
+		result, err := session.Run(ctx, `
+			SHOW DATABASES YIELD name, options
+			WHERE name = $name
+			RETURN options.txLogEnrichment AS txLogEnrichment
+			LIMIT 1
+		`, map[string]interface{}{"name": databaseName})
+		if err != nil {
+			return fmt.Errorf("failed to read options of database %s: %w", databaseName, err)
+		}
+		if !result.Next(ctx) {
+			if err = result.Err(); err != nil {
+				return fmt.Errorf("failed to read options of database %s: %w", databaseName, err)
+			}
+			return fmt.Errorf("database %s does not exist", databaseName)
+		}
+		mode = strings.ToUpper(recordString(result.Record(), "txLogEnrichment"))
+		if mode == "" {
+			mode = "OFF"
+		}
+		return nil
+	})
+
+	return mode, err
+}
+
+// SetTxLogEnrichment sets the txLogEnrichment option of a database, which
+// enables change data capture in the FULL and DIFF modes
+func (c *Client) SetTxLogEnrichment(ctx context.Context, databaseName, mode string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer c.closeSession(ctx, session)
+
+	query := fmt.Sprintf("ALTER DATABASE `%s` SET OPTION txLogEnrichment '%s' WAIT", databaseName, mode)
+	result, err := session.Run(ctx, query, nil)
+	if err == nil {
+		_, err = result.Consume(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set txLogEnrichment of database %s to %s: %w", databaseName, mode, err)
+	}
+	return nil
+}
+
+// OldestChangeAfter returns the commit time of the first change captured
+// after the change identifier, or the zero time when there is none
+func (c *Client) OldestChangeAfter(ctx context.Context, databaseName, changeID string) (time.Time, error) {
+	var commitTime time.Time
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: databaseName,
+		})
+		defer c.closeSession(ctx, session)
+
+		result, err := session.Run(ctx,
+			"CALL db.cdc.query($from) YIELD metadata RETURN metadata.txCommitTime AS commitTime LIMIT 1",
+			map[string]interface{}{"from": changeID})
+		if err != nil {
+			return fmt.Errorf("failed to query changes of database %s: %w", databaseName, err)
+		}
+		if result.Next(ctx) {
+			if value, found := result.Record().Get("commitTime"); found {
+				if t, ok := value.(time.Time); ok {
+					commitTime = t
+				}
+			}
+		}
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("failed to query changes of database %s: %w", databaseName, err)
+		}
+		return nil
+	})
 
-	return nil
+	return commitTime, err
 }
 
 // GetUserRoles returns roles assigned to a user
 func (c *Client) GetUserRoles(ctx context.Context, username string) ([]string, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
@@ -1594,9 +2521,38 @@ func (c *Client) GetUserRoles(ctx context.Context, username string) ([]string, e
 	return roles, nil
 }
 
+// SetUserPassword replaces the password of a user without requiring it to
+// be changed at the next login
+func (c *Client) SetUserPassword(ctx context.Context, username, password string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("ALTER USER `%s` SET PASSWORD $password CHANGE NOT REQUIRED", username)
+	_, err := session.Run(ctx, query, map[string]interface{}{
+		"password": password,
+	})
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to set password of user %s: %w", username, err)
+	}
+
+	return nil
+}
+
 // SetUserProperty sets a property for a user
 func (c *Client) SetUserProperty(ctx context.Context, username, key, value string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -1606,6 +2562,7 @@ func (c *Client) SetUserProperty(ctx context.Context, username, key, value strin
 	_, err := session.Run(ctx, query, map[string]interface{}{
 		"value": value,
 	})
+	c.auditSecurityStatement(ctx, query, err)
 	if err != nil {
 		return fmt.Errorf("failed to set user property: %w", err)
 	}
@@ -1615,7 +2572,7 @@ func (c *Client) SetUserProperty(ctx context.Context, username, key, value strin
 
 // ExecuteQuery executes a query and returns the first result as a string
 func (c *Client) ExecuteQuery(ctx context.Context, query string) (string, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode: neo4j.AccessModeRead,
 	})
 	defer session.Close(ctx)
@@ -1637,49 +2594,239 @@ func (c *Client) ExecuteQuery(ctx context.Context, query string) (string, error)
 
 // GetServerList retrieves the list of servers in the Neo4j cluster
 func (c *Client) GetServerList(ctx context.Context) ([]ServerInfo, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	return c.ShowServers(ctx)
+}
+
+// GetServerMembers lists the servers of the cluster from SHOW SERVERS
+// together with their role for the system database.
+func (c *Client) GetServerMembers(ctx context.Context) ([]ServerMember, error) {
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
 	defer session.Close(ctx)
 
-	result, err := session.Run(ctx, "SHOW SERVERS", nil)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, err := session.Run(timeoutCtx,
+		"SHOW SERVERS YIELD serverId, name, address, state, health, hosting, version RETURN serverId, name, address, state, health, hosting, version",
+		nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute SHOW SERVERS: %w", err)
 	}
 
-	var servers []ServerInfo
-	for result.Next(ctx) {
+	var members []ServerMember
+	for result.Next(timeoutCtx) {
 		record := result.Record()
-
-		// Extract server information from record
-		// SHOW SERVERS returns: name, address, state, health, hosting
-		if len(record.Values) >= 5 {
-			server := ServerInfo{
-				Name:    fmt.Sprintf("%v", record.Values[0]),
-				Address: fmt.Sprintf("%v", record.Values[1]),
-				State:   fmt.Sprintf("%v", record.Values[2]),
-				Health:  fmt.Sprintf("%v", record.Values[3]),
+		member := ServerMember{}
+		for key, target := range map[string]*string{
+			"serverId": &member.ID,
+			"name":     &member.Name,
+			"address":  &member.Address,
+			"state":    &member.State,
+			"health":   &member.Health,
+			"version":  &member.Version,
+		} {
+			if value, ok := record.Get(key); ok && value != nil {
+				*target = fmt.Sprintf("%v", value)
 			}
-
-			// Parse hosting databases (array of strings)
-			if hostingValue := record.Values[4]; hostingValue != nil {
-				if hostingList, ok := hostingValue.([]interface{}); ok {
-					for _, db := range hostingList {
-						server.Hosting = append(server.Hosting, fmt.Sprintf("%v", db))
-					}
+		}
+		if hosting, ok := record.Get("hosting"); ok {
+			if hostingList, ok := hosting.([]interface{}); ok {
+				for _, db := range hostingList {
+					member.Hosting = append(member.Hosting, fmt.Sprintf("%v", db))
 				}
 			}
+		}
+		members = append(members, member)
+	}
+	if err = result.Err(); err != nil {
+		return nil, fmt.Errorf("error reading SHOW SERVERS results: %w", err)
+	}
+
+	// One row per member of the system database, keyed by its Bolt address
+	result, err = session.Run(timeoutCtx, "SHOW DATABASE system YIELD address, role RETURN address, role", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query system database roles: %w", err)
+	}
+	roles := map[string]string{}
+	for result.Next(timeoutCtx) {
+		record := result.Record()
+		address, _ := record.Get("address")
+		role, _ := record.Get("role")
+		roles[fmt.Sprintf("%v", address)] = fmt.Sprintf("%v", role)
+	}
+	if err = result.Err(); err != nil {
+		return nil, fmt.Errorf("error reading system database roles: %w", err)
+	}
 
-			servers = append(servers, server)
+	for i := range members {
+		members[i].Role = roles[members[i].Address]
+	}
+	return members, nil
+}
+
+// GetDatabaseLeaders returns the databases each server leads, keyed by the
+// Bolt address of the server
+func (c *Client) GetDatabaseLeaders(ctx context.Context) (map[string][]string, error) {
+	databases, err := c.ShowDatabases(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query database leaders: %w", err)
+	}
+
+	leaders := map[string][]string{}
+	for _, db := range databases {
+		if db.Writer {
+			leaders[db.Address] = append(leaders[db.Address], db.Name)
 		}
 	}
+	return leaders, nil
+}
+
+// DatabaseStore is one allocation of a database as listed by SHOW DATABASES
+type DatabaseStore struct {
+	Name          string
+	Address       string
+	Status        string
+	StatusMessage string
+	// Store is the storage engine, format and format version of the
+	// allocation, such as "block-block-1.1"
+	Store string
+}
+
+// GetDatabaseStores returns the store and status of every allocation of
+// every database
+func (c *Client) GetDatabaseStores(ctx context.Context) ([]DatabaseStore, error) {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeRead,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, err := session.Run(timeoutCtx,
+		"SHOW DATABASES YIELD name, address, currentStatus, statusMessage, store RETURN name, address, currentStatus, statusMessage, store",
+		nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query database stores: %w", err)
+	}
 
+	var stores []DatabaseStore
+	for result.Next(timeoutCtx) {
+		record := result.Record()
+		store := DatabaseStore{}
+		for key, target := range map[string]*string{
+			"name":          &store.Name,
+			"address":       &store.Address,
+			"currentStatus": &store.Status,
+			"statusMessage": &store.StatusMessage,
+			"store":         &store.Store,
+		} {
+			if value, ok := record.Get(key); ok && value != nil {
+				*target = fmt.Sprintf("%v", value)
+			}
+		}
+		stores = append(stores, store)
+	}
 	if err = result.Err(); err != nil {
-		return nil, fmt.Errorf("error reading SHOW SERVERS results: %w", err)
+		return nil, fmt.Errorf("error reading database stores: %w", err)
+	}
+	return stores, nil
+}
+
+// Checkpoint flushes the transactions of a database to its store files, so
+// that the next start does not have to replay them from the transaction log.
+// Write access routes the call to the server hosting the writer.
+func (c *Client) Checkpoint(ctx context.Context, database string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: database,
+	})
+	defer session.Close(ctx)
+
+	if _, err := session.Run(ctx, "CALL db.checkpoint()", nil); err != nil {
+		return fmt.Errorf("failed to checkpoint database %s: %w", database, err)
+	}
+	return nil
+}
+
+// TransferLeadership asks the raft group of a database to elect the server
+// with the given ID, as listed by SHOW SERVERS, as its leader
+func (c *Client) TransferLeadership(ctx context.Context, database, serverID string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	if _, err := session.Run(ctx, "CALL dbms.cluster.switchLeader($database, $server)",
+		map[string]interface{}{"database": database, "server": serverID}); err != nil {
+		return fmt.Errorf("failed to transfer leadership of %s to server %s: %w", database, serverID, err)
+	}
+	return nil
+}
+
+// DeallocateServer moves every database off a server so that it can be
+// dropped. Neo4j rejects the command when the remaining servers cannot host
+// the database topologies.
+func (c *Client) DeallocateServer(ctx context.Context, server string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	if _, err := session.Run(ctx, "DEALLOCATE DATABASES FROM SERVER $server", map[string]interface{}{"server": server}); err != nil {
+		return fmt.Errorf("failed to deallocate databases from server %s: %w", server, err)
+	}
+	return nil
+}
+
+// DropServer removes a deallocated server that is no longer running from
+// the cluster
+func (c *Client) DropServer(ctx context.Context, server string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	if _, err := session.Run(ctx, "DROP SERVER $server", map[string]interface{}{"server": server}); err != nil {
+		return fmt.Errorf("failed to drop server %s: %w", server, err)
+	}
+	return nil
+}
+
+// CordonServer stops Neo4j from allocating new databases to a server. The
+// databases it hosts stay where they are.
+func (c *Client) CordonServer(ctx context.Context, server string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
+
+	if _, err := session.Run(ctx, "CALL dbms.cluster.cordonServer($server)", map[string]interface{}{"server": server}); err != nil {
+		return fmt.Errorf("failed to cordon server %s: %w", server, err)
 	}
+	return nil
+}
+
+// EnableServer makes a cordoned server available for allocations again
+func (c *Client) EnableServer(ctx context.Context, server string) error {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeWrite,
+		DatabaseName: "system",
+	})
+	defer session.Close(ctx)
 
-	return servers, nil
+	if _, err := session.Run(ctx, "ENABLE SERVER $server", map[string]interface{}{"server": server}); err != nil {
+		return fmt.Errorf("failed to enable server %s: %w", server, err)
+	}
+	return nil
 }
 
 // GetLoadedComponents returns a list of loaded Neo4j components/plugins
@@ -1687,7 +2834,7 @@ func (c *Client) GetLoadedComponents(ctx context.Context) ([]ComponentInfo, erro
 	var components []ComponentInfo
 
 	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode: neo4j.AccessModeRead,
 		})
 		defer session.Close(ctx)
@@ -1716,18 +2863,84 @@ func (c *Client) GetLoadedComponents(ctx context.Context) ([]ComponentInfo, erro
 	return components, err
 }
 
+// GetNamespaceVersion reports whether procedures or functions of the given
+// namespace (e.g. "apoc", "gds") are registered on the connected server and,
+// when the namespace provides a <namespace>.version() function, the version
+// it returns.
+func (c *Client) GetNamespaceVersion(ctx context.Context, namespace string) (bool, string, error) {
+	var loaded bool
+	var version string
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode: neo4j.AccessModeRead,
+		})
+		defer session.Close(ctx)
+
+		params := map[string]interface{}{"prefix": namespace + "."}
+		result, err := session.Run(ctx, "SHOW PROCEDURES YIELD name WHERE name STARTS WITH $prefix RETURN count(name) AS procedures", params)
+		if err != nil {
+			return fmt.Errorf("failed to list procedures: %w", err)
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list procedures: %w", err)
+		}
+		procedures, _ := record.Get("procedures")
+		count, _ := procedures.(int64)
+		loaded = count > 0
+
+		params["function"] = namespace + ".version"
+		result, err = session.Run(ctx, "SHOW FUNCTIONS YIELD name WHERE name STARTS WITH $prefix RETURN count(name) AS functions, sum(CASE name WHEN $function THEN 1 ELSE 0 END) AS versioned", params)
+		if err != nil {
+			return fmt.Errorf("failed to list functions: %w", err)
+		}
+		record, err = result.Single(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list functions: %w", err)
+		}
+		functions, _ := record.Get("functions")
+		versioned, _ := record.Get("versioned")
+		if count, _ := functions.(int64); count > 0 {
+			loaded = true
+		}
+		if count, _ := versioned.(int64); count == 0 {
+			return nil
+		}
+
+		// The namespace comes from the operator's plugin catalog, not user input
+		result, err = session.Run(ctx, fmt.Sprintf("RETURN %s.version() AS version", namespace), nil)
+		if err != nil {
+			return fmt.Errorf("failed to call %s.version(): %w", namespace, err)
+		}
+		record, err = result.Single(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to call %s.version(): %w", namespace, err)
+		}
+		if value, ok := record.Get("version"); ok && value != nil {
+			version = fmt.Sprintf("%v", value)
+		}
+		return nil
+	})
+
+	return loaded, version, err
+}
+
 // SetConfiguration sets a Neo4j configuration parameter
 func (c *Client) SetConfiguration(ctx context.Context, key, value string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
 	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode:   neo4j.AccessModeWrite,
 			DatabaseName: "system",
 		})
 		defer session.Close(ctx)
 
-		// Note: Dynamic configuration changes may require restart
-		query := fmt.Sprintf("CALL dbms.setConfigValue('%s', '%s')", key, value)
-		_, err := session.Run(ctx, query, nil)
+		// Only dynamic settings can be changed, an empty value resets the default
+		_, err := session.Run(ctx, "CALL dbms.setConfigValue($key, $value)", map[string]interface{}{"key": key, "value": value})
 		if err != nil {
 			return fmt.Errorf("failed to set configuration %s=%s: %w", key, value, err)
 		}
@@ -1736,10 +2949,36 @@ func (c *Client) SetConfiguration(ctx context.Context, key, value string) error
 	})
 }
 
+// ReloadTLS makes the server re-read the certificates of its SSL policies.
+// Like dbms.setConfigValue, it only affects the server it runs on.
+func (c *Client) ReloadTLS(ctx context.Context) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeWrite,
+			DatabaseName: "system",
+		})
+		defer session.Close(ctx)
+
+		if _, err := session.Run(ctx, "CALL dbms.security.reloadTLS()", nil); err != nil {
+			return fmt.Errorf("failed to reload TLS certificates: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // SetAllowedProcedures sets the allowed procedures for a plugin
 func (c *Client) SetAllowedProcedures(ctx context.Context, procedures []string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
 	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode:   neo4j.AccessModeWrite,
 			DatabaseName: "system",
 		})
@@ -1759,8 +2998,12 @@ func (c *Client) SetAllowedProcedures(ctx context.Context, procedures []string)
 
 // SetDeniedProcedures sets the denied procedures for a plugin
 func (c *Client) SetDeniedProcedures(ctx context.Context, procedures []string) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
 	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode:   neo4j.AccessModeWrite,
 			DatabaseName: "system",
 		})
@@ -1780,8 +3023,12 @@ func (c *Client) SetDeniedProcedures(ctx context.Context, procedures []string) e
 
 // EnableSandboxMode enables sandbox mode for plugins
 func (c *Client) EnableSandboxMode(ctx context.Context, enabled bool) error {
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
 	return c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
-		session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		session := c.newSession(ctx, neo4j.SessionConfig{
 			AccessMode:   neo4j.AccessModeWrite,
 			DatabaseName: "system",
 		})
@@ -1809,9 +3056,58 @@ type ComponentInfo struct {
 	Edition string
 }
 
+// ListUsers returns every user of the DBMS with its roles and status
+func (c *Client) ListUsers(ctx context.Context) ([]UserInfo, error) {
+	var users []UserInfo
+
+	err := c.executeWithCircuitBreaker(ctx, func(ctx context.Context) error {
+		session := c.newSession(ctx, neo4j.SessionConfig{
+			AccessMode:   neo4j.AccessModeRead,
+			DatabaseName: "system",
+		})
+		defer c.closeSession(ctx, session)
+
+		result, err := session.Run(ctx, "SHOW USERS YIELD user, roles, suspended RETURN user, roles, suspended", nil)
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+
+		for result.Next(ctx) {
+			record := result.Record()
+
+			name, _ := record.Get("user")
+			suspended, _ := record.Get("suspended")
+			user := UserInfo{
+				Name:      fmt.Sprintf("%v", name),
+				Suspended: fmt.Sprintf("%v", suspended) == TrueString,
+			}
+			if roles, found := record.Get("roles"); found {
+				if roleList, ok := roles.([]interface{}); ok {
+					for _, role := range roleList {
+						user.Roles = append(user.Roles, fmt.Sprintf("%v", role))
+					}
+				}
+			}
+			users = append(users, user)
+		}
+
+		if err = result.Err(); err != nil {
+			return fmt.Errorf("error reading users: %w", err)
+		}
+
+		return nil
+	})
+
+	return users, err
+}
+
 // SuspendUser suspends a user account
 func (c *Client) SuspendUser(ctx context.Context, username string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -1819,6 +3115,7 @@ func (c *Client) SuspendUser(ctx context.Context, username string) error {
 
 	query := fmt.Sprintf("ALTER USER `%s` SET STATUS SUSPENDED", username)
 	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
 	if err != nil {
 		return fmt.Errorf("failed to suspend user: %w", err)
 	}
@@ -1828,7 +3125,11 @@ func (c *Client) SuspendUser(ctx context.Context, username string) error {
 
 // ActivateUser activates a user account
 func (c *Client) ActivateUser(ctx context.Context, username string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -1836,6 +3137,7 @@ func (c *Client) ActivateUser(ctx context.Context, username string) error {
 
 	query := fmt.Sprintf("ALTER USER `%s` SET STATUS ACTIVE", username)
 	_, err := session.Run(ctx, query, nil)
+	c.auditSecurityStatement(ctx, query, err)
 	if err != nil {
 		return fmt.Errorf("failed to activate user: %w", err)
 	}
@@ -1845,7 +3147,7 @@ func (c *Client) ActivateUser(ctx context.Context, username string) error {
 
 // ValidateEnterpriseVersion checks if the Neo4j version is Enterprise 5.26 or higher
 func (c *Client) ValidateEnterpriseVersion(ctx context.Context) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
@@ -1888,49 +3190,14 @@ func (c *Client) ValidateEnterpriseVersion(ctx context.Context) error {
 }
 
 // isVersionSupported checks if the version is supported (5.26+ or 2025.1+)
-func isVersionSupported(version string) bool {
-	// Remove any prefix like "v" and suffixes like "-enterprise"
-	version = strings.TrimPrefix(version, "v")
-	if idx := strings.Index(version, "-"); idx != -1 {
-		version = version[:idx]
-	}
-
-	parts := strings.Split(version, ".")
-	if len(parts) < 2 {
-		return false
-	}
-
-	major, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return false
-	}
-
-	minor, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return false
-	}
-
-	// CalVer support (2025.x.x)
-	if major >= 2025 {
-		return true // All CalVer versions are supported
-	}
-
-	// SemVer support: only 5.26.x — the last semver LTS release (no 5.27+ exists)
-	if major == 5 {
-		return minor == 26
-	}
-
-	// Legacy support for 4.x (if needed)
-	if major == 4 {
-		return minor >= 4 // Must be version 4.4 or higher
-	}
-
-	return false
+func isVersionSupported(versionString string) bool {
+	v, err := version.Parse(versionString)
+	return err == nil && v.IsSupported()
 }
 
 func buildConnectionURIForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) string {
 	scheme := "bolt"
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(standalone.Spec.TLS) {
 		scheme = "bolt+s"
 	}
 
@@ -1944,7 +3211,7 @@ func buildConnectionURIForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseSt
 func buildConnectionURIForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
 	// Use bolt+s for TLS-enabled clusters, plain bolt for others
 	scheme := "bolt"
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == "cert-manager" {
+	if resources.TLSEnabled(cluster.Spec.TLS) {
 		scheme = "bolt+s"
 	}
 
@@ -1958,7 +3225,11 @@ func buildConnectionURIForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 
 // CreateDatabaseFromSeedURI creates a database from a seed URI using Neo4j CloudSeedProvider
 func (c *Client) CreateDatabaseFromSeedURI(ctx context.Context, databaseName, seedURI string, seedConfig *neo4jv1alpha1.SeedConfiguration, options map[string]string, wait bool, ifNotExists bool, cypherVersion string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -2003,7 +3274,8 @@ func (c *Client) CreateDatabaseFromSeedURI(ctx context.Context, databaseName, se
 		query += " NOWAIT"
 	}
 
-	_, err := session.Run(ctx, query, nil)
+	// Seeding with WAIT takes as long as copying the seed
+	_, err := session.Run(withoutTimeout(ctx), query, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create database %s from seed URI: %w", databaseName, err)
 	}
@@ -2013,7 +3285,11 @@ func (c *Client) CreateDatabaseFromSeedURI(ctx context.Context, databaseName, se
 
 // CreateDatabaseFromSeedURIWithTopology creates a database with topology from a seed URI
 func (c *Client) CreateDatabaseFromSeedURIWithTopology(ctx context.Context, databaseName, seedURI string, primaries, secondaries int32, seedConfig *neo4jv1alpha1.SeedConfiguration, options map[string]string, wait bool, ifNotExists bool, cypherVersion string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -2080,7 +3356,8 @@ func (c *Client) CreateDatabaseFromSeedURIWithTopology(ctx context.Context, data
 		query += " NOWAIT"
 	}
 
-	_, err := session.Run(ctx, query, nil)
+	// Seeding with WAIT takes as long as copying the seed
+	_, err := session.Run(withoutTimeout(ctx), query, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create database %s with topology from seed URI: %w", databaseName, err)
 	}
@@ -2118,7 +3395,7 @@ func (c *Client) buildSeedOptions(seedConfig *neo4jv1alpha1.SeedConfiguration) s
 }
 
 // PrepareCloudCredentials prepares cloud credentials for seed URI access
-func (c *Client) PrepareCloudCredentials(ctx context.Context, k8sClient client.Client, database *neo4jv1alpha1.Neo4jDatabase) error {
+func (c *Client) PrepareCloudCredentials(ctx context.Context, k8sClient client.Client, database *neo4jv1alpha1.Neo4jDatabase, seedURI string) error {
 	// This method prepares cloud credentials in the cluster for CloudSeedProvider
 	// It doesn't store credentials directly but ensures the cluster environment is configured
 
@@ -2139,7 +3416,6 @@ func (c *Client) PrepareCloudCredentials(ctx context.Context, k8sClient client.C
 	}
 
 	// Validate that the secret contains the expected keys based on URI scheme
-	seedURI := database.Spec.SeedURI
 	if seedURI == "" {
 		return fmt.Errorf("seed URI is required when seed credentials are specified")
 	}
@@ -2255,7 +3531,7 @@ func (c *Client) CreateBackup(ctx context.Context, databaseName, backupName, bac
 	_ = c.buildBackupArgs(databaseName, backupName, backupPath, options)
 
 	// Execute backup using admin commands
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
@@ -2461,7 +3737,11 @@ type BackupMetadata struct {
 // This only needs to be called once per cluster; the plugin handles subsequent re-connections
 // automatically. If auto-rotation is enabled in Aura, the plugin renews the token before expiry.
 func (c *Client) RegisterFleetManagementToken(ctx context.Context, token string) error {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	if err := c.throttleAdminQuery(ctx); err != nil {
+		return err
+	}
+
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: "system",
 	})
@@ -2479,7 +3759,7 @@ func (c *Client) RegisterFleetManagementToken(ctx context.Context, token string)
 // IsFleetManagementInstalled checks whether the fleet management plugin is loaded and responding.
 // Returns true if the plugin is available, false if not (e.g. jar not yet copied to /plugins).
 func (c *Client) IsFleetManagementInstalled(ctx context.Context) (bool, error) {
-	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+	session := c.newSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: "system",
 	})
@@ -0,0 +1,73 @@
+package neo4j
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestBuildCreateAliasQueryLocal(t *testing.T) {
+	query, params := buildCreateAliasQuery(AliasDefinition{Composite: "garden", Name: "flowers", TargetDatabase: "flowerdb"})
+
+	if query != "CREATE ALIAS `garden`.`flowers` IF NOT EXISTS FOR DATABASE `flowerdb`" {
+		t.Fatalf("unexpected query %q", query)
+	}
+	if params != nil {
+		t.Fatalf("expected no parameters for a local alias, got %v", params)
+	}
+}
+
+func TestBuildAliasQueriesRemote(t *testing.T) {
+	alias := AliasDefinition{
+		Name:           "sales",
+		TargetDatabase: "salesdb",
+		URL:            "neo4j+s://remote.example.com:7687",
+		User:           "reader",
+		Password:       "secret",
+		Driver: map[string]interface{}{
+			"ssl_enforced":             true,
+			"connection_timeout":       5 * time.Second,
+			"connection_pool_max_size": int64(10),
+			"logging_level":            "INFO",
+		},
+	}
+
+	query, params := buildCreateAliasQuery(alias)
+	expected := "CREATE ALIAS `sales` IF NOT EXISTS FOR DATABASE `salesdb` AT $url USER $user PASSWORD $password " +
+		"DRIVER {connection_pool_max_size: 10, connection_timeout: duration('PT5S'), logging_level: 'INFO', ssl_enforced: true}"
+	if query != expected {
+		t.Fatalf("unexpected query\n got: %s\nwant: %s", query, expected)
+	}
+	if params["url"] != alias.URL || params["user"] != "reader" || params["password"] != "secret" {
+		t.Fatalf("unexpected parameters %v", params)
+	}
+
+	query, _ = buildAlterAliasQuery(alias)
+	if want := "ALTER ALIAS `sales` SET DATABASE TARGET `salesdb` AT $url"; query[:len(want)] != want {
+		t.Fatalf("unexpected alter query %q", query)
+	}
+}
+
+func TestFormatDriverSettingsFractionalDuration(t *testing.T) {
+	settings := formatDriverSettings(map[string]interface{}{"connection_timeout": 1500 * time.Millisecond})
+
+	if settings != "{connection_timeout: duration('PT1.5S')}" {
+		t.Fatalf("unexpected settings %q", settings)
+	}
+}
+
+func TestNormalizeDriverSettings(t *testing.T) {
+	settings := normalizeDriverSettings(map[string]interface{}{
+		"connection_timeout": neo4j.Duration{Seconds: 5},
+		"ssl_enforced":       true,
+		"logging_level":      nil,
+	})
+
+	if settings["connection_timeout"] != 5*time.Second {
+		t.Fatalf("expected durations as time.Duration, got %#v", settings["connection_timeout"])
+	}
+	if _, found := settings["logging_level"]; found {
+		t.Fatalf("expected null settings to be dropped, got %v", settings)
+	}
+}
@@ -0,0 +1,115 @@
+package neo4j
+
+import "testing"
+
+func TestBuildCreateIndexQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		index IndexDefinition
+		want  string
+	}{
+		{
+			name:  "composite range index",
+			index: IndexDefinition{Name: "person_name", Type: "RANGE", LabelsOrTypes: []string{"Person"}, Properties: []string{"last", "first"}},
+			want:  "CREATE RANGE INDEX `person_name` IF NOT EXISTS FOR (n:`Person`) ON (n.`last`, n.`first`)",
+		},
+		{
+			name:  "relationship text index",
+			index: IndexDefinition{Name: "review_text", Type: "TEXT", Relationship: true, LabelsOrTypes: []string{"REVIEWED"}, Properties: []string{"text"}},
+			want:  "CREATE TEXT INDEX `review_text` IF NOT EXISTS FOR ()-[r:`REVIEWED`]-() ON (r.`text`)",
+		},
+		{
+			name:  "fulltext index over two labels",
+			index: IndexDefinition{Name: "titles", Type: "FULLTEXT", LabelsOrTypes: []string{"Movie", "Book"}, Properties: []string{"title"}},
+			want:  "CREATE FULLTEXT INDEX `titles` IF NOT EXISTS FOR (n:`Movie`|`Book`) ON EACH [n.`title`]",
+		},
+		{
+			name: "vector index with config",
+			index: IndexDefinition{
+				Name: "embeddings", Type: "VECTOR", LabelsOrTypes: []string{"Chunk"}, Properties: []string{"embedding"},
+				Config: map[string]interface{}{"vector.similarity_function": "cosine", "vector.dimensions": int64(1536)},
+			},
+			want: "CREATE VECTOR INDEX `embeddings` IF NOT EXISTS FOR (n:`Chunk`) ON (n.`embedding`) " +
+				"OPTIONS {indexConfig: {`vector.dimensions`: 1536, `vector.similarity_function`: 'cosine'}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildCreateIndexQuery(tt.index); got != tt.want {
+				t.Fatalf("unexpected query\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildCreateConstraintQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint ConstraintDefinition
+		want       string
+	}{
+		{
+			name:       "node uniqueness",
+			constraint: ConstraintDefinition{Name: "person_email", Type: "UNIQUE", LabelOrType: "Person", Properties: []string{"email"}},
+			want:       "CREATE CONSTRAINT `person_email` IF NOT EXISTS FOR (n:`Person`) REQUIRE (n.`email`) IS UNIQUE",
+		},
+		{
+			name:       "node key",
+			constraint: ConstraintDefinition{Name: "order_key", Type: "KEY", LabelOrType: "Order", Properties: []string{"region", "id"}},
+			want:       "CREATE CONSTRAINT `order_key` IF NOT EXISTS FOR (n:`Order`) REQUIRE (n.`region`, n.`id`) IS NODE KEY",
+		},
+		{
+			name:       "relationship key",
+			constraint: ConstraintDefinition{Name: "txn_key", Type: "KEY", Relationship: true, LabelOrType: "PAID", Properties: []string{"txn"}},
+			want:       "CREATE CONSTRAINT `txn_key` IF NOT EXISTS FOR ()-[r:`PAID`]-() REQUIRE (r.`txn`) IS RELATIONSHIP KEY",
+		},
+		{
+			name:       "existence",
+			constraint: ConstraintDefinition{Name: "person_name", Type: "EXISTS", LabelOrType: "Person", Properties: []string{"name"}},
+			want:       "CREATE CONSTRAINT `person_name` IF NOT EXISTS FOR (n:`Person`) REQUIRE n.`name` IS NOT NULL",
+		},
+		{
+			name:       "property type",
+			constraint: ConstraintDefinition{Name: "person_age", Type: "PROPERTY_TYPE", LabelOrType: "Person", Properties: []string{"age"}, PropertyType: "INTEGER"},
+			want:       "CREATE CONSTRAINT `person_age` IF NOT EXISTS FOR (n:`Person`) REQUIRE n.`age` IS :: INTEGER",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildCreateConstraintQuery(tt.constraint); got != tt.want {
+				t.Fatalf("unexpected query\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintKind(t *testing.T) {
+	kinds := map[string]string{
+		"UNIQUENESS":                       "UNIQUE",
+		"NODE_PROPERTY_UNIQUENESS":         "UNIQUE",
+		"RELATIONSHIP_UNIQUENESS":          "UNIQUE",
+		"RELATIONSHIP_PROPERTY_UNIQUENESS": "UNIQUE",
+		"NODE_KEY":                         "KEY",
+		"RELATIONSHIP_KEY":                 "KEY",
+		"NODE_PROPERTY_EXISTENCE":          "EXISTS",
+		"RELATIONSHIP_PROPERTY_EXISTENCE":  "EXISTS",
+		"NODE_PROPERTY_TYPE":               "PROPERTY_TYPE",
+		"RELATIONSHIP_PROPERTY_TYPE":       "PROPERTY_TYPE",
+		"SOMETHING_NEW":                    "SOMETHING_NEW",
+	}
+	for neo4jType, want := range kinds {
+		if got := ConstraintKind(neo4jType); got != want {
+			t.Errorf("ConstraintKind(%q) = %q, want %q", neo4jType, got, want)
+		}
+	}
+}
+
+func TestFormatIndexConfigEscapesQuotes(t *testing.T) {
+	config := formatIndexConfig(map[string]interface{}{"fulltext.analyzer": "it's", "fulltext.eventually_consistent": true})
+
+	if config != "{`fulltext.analyzer`: 'it\\'s', `fulltext.eventually_consistent`: true}" {
+		t.Fatalf("unexpected config %q", config)
+	}
+}
@@ -48,6 +48,10 @@ var _ = Describe("Neo4j Client", func() {
 	)
 
 	BeforeEach(func() {
+		// No Neo4j runs in the suite, so failing statements are not retried
+		neo4j.SetQueryRetry(0, neo4j.DefaultQueryTimeout)
+		DeferCleanup(neo4j.SetQueryRetry, neo4j.DefaultQueryRetries, neo4j.DefaultQueryTimeout)
+
 		ctx = context.Background()
 		scheme = runtime.NewScheme()
 		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
@@ -216,6 +220,37 @@ var _ = Describe("Neo4j Client", func() {
 			Expect(err).To(HaveOccurred()) // Expected to fail without real Neo4j
 		})
 
+		It("Should report security statements to the audit function", func() {
+			var statements []string
+			var failures int
+			neo4jClient.SetSecurityAudit(func(_ context.Context, statement string, err error) {
+				statements = append(statements, statement)
+				if err != nil {
+					failures++
+				}
+			})
+
+			By("Running user and role statements")
+			Expect(neo4jClient.CreateUser(ctx, "testuser", "password", true)).To(HaveOccurred())
+			Expect(neo4jClient.GrantRoleToUser(ctx, "reader", "testuser")).To(HaveOccurred())
+			Expect(neo4jClient.ExecutePrivilegeStatement(ctx, "ALTER USER testuser SET PASSWORD 'secret'")).To(HaveOccurred())
+
+			Expect(statements).To(Equal([]string{
+				"CREATE USER `testuser` SET PASSWORD $password CHANGE REQUIRED",
+				"GRANT ROLE `reader` TO `testuser`",
+				"ALTER USER testuser SET PASSWORD '******'",
+			}))
+			Expect(failures).To(Equal(3)) // Expected to fail without real Neo4j
+		})
+
+		It("Should redact password literals", func() {
+			Expect(neo4j.RedactStatement(`CREATE USER bob SET PLAINTEXT PASSWORD "p'w" CHANGE NOT REQUIRED`)).
+				To(Equal("CREATE USER bob SET PLAINTEXT PASSWORD '******' CHANGE NOT REQUIRED"))
+			Expect(neo4j.RedactStatement(`ALTER USER bob SET ENCRYPTED PASSWORD '1,abc\'d,2'`)).
+				To(Equal("ALTER USER bob SET ENCRYPTED PASSWORD '******'"))
+			Expect(neo4j.RedactStatement("GRANT ROLE reader TO bob")).To(Equal("GRANT ROLE reader TO bob"))
+		})
+
 		It("Should handle database operations", func() {
 			By("Creating database")
 			err := neo4jClient.CreateDatabase(ctx, "testdb", map[string]string{}, true, true)
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SplitCypherStatements splits a script into its semicolon-separated
+// statements. Semicolons in string literals, quoted identifiers and comments
+// do not end a statement, and statements holding nothing but comments are
+// dropped. An unterminated string, identifier or comment is an error.
+func SplitCypherStatements(script string) ([]string, error) {
+	var (
+		statements []string
+		current    strings.Builder
+		// code is set once the current statement holds more than comments
+		code bool
+	)
+	flush := func() {
+		if code {
+			statements = append(statements, strings.TrimSpace(current.String()))
+		}
+		current.Reset()
+		code = false
+	}
+
+	for i := 0; i < len(script); i++ {
+		ch := script[i]
+		switch {
+		case ch == ';':
+			flush()
+			continue
+		case ch == '/' && i+1 < len(script) && script[i+1] == '/':
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script) - i
+			}
+			current.WriteString(script[i : i+end])
+			i += end - 1
+			continue
+		case ch == '/' && i+1 < len(script) && script[i+1] == '*':
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			current.WriteString(script[i : i+2+end+2])
+			i += 2 + end + 1
+			continue
+		case ch == '\'' || ch == '"' || ch == '`':
+			end := quotedEnd(script, i)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated %c quote at offset %d", ch, i)
+			}
+			current.WriteString(script[i : end+1])
+			code = true
+			i = end
+			continue
+		}
+		current.WriteByte(ch)
+		if ch != ' ' && ch != '\t' && ch != '\n' && ch != '\r' {
+			code = true
+		}
+	}
+	flush()
+	return statements, nil
+}
+
+// quotedEnd returns the offset of the quote closing the string literal or
+// identifier opened at start, or -1. Backslashes escape characters in string
+// literals; a doubled backtick is a backtick inside an identifier.
+func quotedEnd(script string, start int) int {
+	quote := script[start]
+	for i := start + 1; i < len(script); i++ {
+		switch {
+		case script[i] == '\\' && quote != '`':
+			i++
+		case script[i] == quote:
+			if quote == '`' && i+1 < len(script) && script[i+1] == '`' {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,50 @@
+package neo4j
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCypherStatements(t *testing.T) {
+	script := `// Schema
+CREATE CONSTRAINT person_id IF NOT EXISTS FOR (p:Person) REQUIRE p.id IS UNIQUE;
+
+/* Data; with a semicolon */
+CREATE (:Person {id: 1, note: 'a;b', quote: "it\"s;"});
+MATCH (p:` + "`Odd;``Label`" + `) SET p.seen = true
+;
+-- not a comment in Cypher, kept as is
+// trailing comment;
+`
+	statements, err := SplitCypherStatements(script)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"// Schema\nCREATE CONSTRAINT person_id IF NOT EXISTS FOR (p:Person) REQUIRE p.id IS UNIQUE",
+		"/* Data; with a semicolon */\nCREATE (:Person {id: 1, note: 'a;b', quote: \"it\\\"s;\"})",
+		"MATCH (p:`Odd;``Label`) SET p.seen = true",
+		"-- not a comment in Cypher, kept as is\n// trailing comment;",
+	}
+	if !reflect.DeepEqual(statements, expected) {
+		t.Fatalf("unexpected statements:\n%q", statements)
+	}
+}
+
+func TestSplitCypherStatementsCommentsOnly(t *testing.T) {
+	statements, err := SplitCypherStatements("// nothing to do\n/* really */ ;\n  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statements) != 0 {
+		t.Fatalf("expected no statements, got %q", statements)
+	}
+}
+
+func TestSplitCypherStatementsUnterminated(t *testing.T) {
+	for _, script := range []string{"RETURN 'open", "RETURN 1 /* open", "MATCH (n:`Open) RETURN n"} {
+		if _, err := SplitCypherStatements(script); err == nil {
+			t.Fatalf("expected an error for %q", script)
+		}
+	}
+}
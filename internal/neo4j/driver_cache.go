@@ -0,0 +1,230 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// DefaultDriverIdleTimeout is how long a driver no client uses stays
+	// open for the next client of its cluster
+	DefaultDriverIdleTimeout = 5 * time.Minute
+
+	// driverHealthCheckInterval is how long a cached driver is handed out
+	// before its connectivity is verified again
+	driverHealthCheckInterval = 30 * time.Second
+
+	// driverHealthCheckTimeout bounds the connectivity check of a cached
+	// driver
+	driverHealthCheckTimeout = 5 * time.Second
+)
+
+// sharedDrivers holds the drivers of the clusters and standalones, keyed by
+// their UID and the URI connected to, so that the clients reconcilers create
+// for a cluster share its connection pool rather than authenticating anew
+// on every reconcile.
+var sharedDrivers = struct {
+	sync.Mutex
+	idleTimeout time.Duration
+	drivers     map[string]*sharedDriver
+}{
+	idleTimeout: DefaultDriverIdleTimeout,
+	drivers:     map[string]*sharedDriver{},
+}
+
+// sharedDriver is a cached driver and the clients using it
+type sharedDriver struct {
+	driver neo4j.DriverWithContext
+	owner  types.UID
+	// credentials is a hash of the credentials the driver authenticates
+	// with, so that a rotated password replaces the driver
+	credentials  string
+	refs         int
+	lastUsed     time.Time
+	lastVerified time.Time
+	// evicted drivers are closed once their last client is closed
+	evicted bool
+}
+
+// SetDriverIdleTimeout sets how long a driver no client uses stays open. A
+// timeout of zero or less disables sharing drivers: every client then opens
+// and closes its own. The drivers cached so far are released.
+func SetDriverIdleTimeout(timeout time.Duration) {
+	sharedDrivers.Lock()
+	sharedDrivers.idleTimeout = timeout
+	var closing []neo4j.DriverWithContext
+	for key, shared := range sharedDrivers.drivers {
+		closing = append(closing, evictDriver(key, shared)...)
+	}
+	sharedDrivers.Unlock()
+	closeDrivers(closing)
+}
+
+// InvalidateDrivers evicts the cached drivers of a cluster or standalone, for
+// instance once it is deleted. Drivers clients still use are closed with
+// their last client.
+func InvalidateDrivers(owner types.UID) {
+	sharedDrivers.Lock()
+	var closing []neo4j.DriverWithContext
+	for key, shared := range sharedDrivers.drivers {
+		if shared.owner == owner {
+			closing = append(closing, evictDriver(key, shared)...)
+		}
+	}
+	sharedDrivers.Unlock()
+	closeDrivers(closing)
+}
+
+// acquireDriver returns the cached driver of a cluster or standalone for a
+// URI and credentials, or one created with newDriver. A cached driver that
+// was not verified within the health check interval is verified first and
+// replaced when it fails. It returns nil, and newDriver's driver is owned by
+// the caller, while sharing is disabled.
+func acquireDriver(owner types.UID, uri string, credentials *Credentials, newDriver func() (neo4j.DriverWithContext, error)) (*sharedDriver, neo4j.DriverWithContext, error) {
+	key := string(owner) + "|" + uri
+	hash := credentialsHash(credentials)
+
+	sharedDrivers.Lock()
+	if sharedDrivers.idleTimeout <= 0 {
+		sharedDrivers.Unlock()
+		driver, err := newDriver()
+		return nil, driver, err
+	}
+	closing := expireIdleDrivers(time.Now())
+	shared, found := sharedDrivers.drivers[key]
+	if found && shared.credentials != hash {
+		closing = append(closing, evictDriver(key, shared)...)
+		found = false
+	}
+	verify := found && time.Since(shared.lastVerified) > driverHealthCheckInterval
+	if found {
+		shared.refs++
+		shared.lastUsed = time.Now()
+	}
+	sharedDrivers.Unlock()
+	closeDrivers(closing)
+
+	if verify {
+		ctx, cancel := context.WithTimeout(context.Background(), driverHealthCheckTimeout)
+		err := shared.driver.VerifyConnectivity(ctx)
+		cancel()
+		sharedDrivers.Lock()
+		if err == nil {
+			shared.lastVerified = time.Now()
+		} else {
+			closing = evictDriver(key, shared)
+			found = false
+		}
+		sharedDrivers.Unlock()
+		if !found {
+			releaseDriver(shared)
+			closeDrivers(closing)
+		}
+	}
+	if found {
+		return shared, shared.driver, nil
+	}
+
+	driver, err := newDriver()
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	shared = &sharedDriver{
+		driver:       driver,
+		owner:        owner,
+		credentials:  hash,
+		refs:         1,
+		lastUsed:     now,
+		lastVerified: now,
+	}
+	sharedDrivers.Lock()
+	if previous, found := sharedDrivers.drivers[key]; found {
+		// Another client created a driver meanwhile; the later one is kept
+		closing = evictDriver(key, previous)
+	}
+	sharedDrivers.drivers[key] = shared
+	sharedDrivers.Unlock()
+	closeDrivers(closing)
+	return shared, driver, nil
+}
+
+// releaseDriver records that a client of a cached driver is closed. Evicted
+// drivers are closed with their last client; the others stay open until
+// they are idle for the idle timeout.
+func releaseDriver(shared *sharedDriver) {
+	sharedDrivers.Lock()
+	shared.refs--
+	shared.lastUsed = time.Now()
+	closing := expireIdleDrivers(time.Now())
+	if shared.evicted && shared.refs == 0 {
+		closing = append(closing, shared.driver)
+	}
+	sharedDrivers.Unlock()
+	closeDrivers(closing)
+}
+
+// expireIdleDrivers evicts the drivers no client used within the idle
+// timeout and returns them. sharedDrivers must be locked.
+func expireIdleDrivers(now time.Time) []neo4j.DriverWithContext {
+	var closing []neo4j.DriverWithContext
+	for key, shared := range sharedDrivers.drivers {
+		if shared.refs == 0 && now.Sub(shared.lastUsed) > sharedDrivers.idleTimeout {
+			closing = append(closing, evictDriver(key, shared)...)
+		}
+	}
+	return closing
+}
+
+// evictDriver removes a driver from the cache and returns it when no client
+// uses it, so that the caller closes it once sharedDrivers is unlocked.
+// sharedDrivers must be locked.
+func evictDriver(key string, shared *sharedDriver) []neo4j.DriverWithContext {
+	if sharedDrivers.drivers[key] == shared {
+		delete(sharedDrivers.drivers, key)
+	}
+	if shared.evicted {
+		return nil
+	}
+	shared.evicted = true
+	if shared.refs > 0 {
+		return nil
+	}
+	return []neo4j.DriverWithContext{shared.driver}
+}
+
+// closeDrivers closes evicted drivers, outside the lock as closing waits for
+// their connections
+func closeDrivers(drivers []neo4j.DriverWithContext) {
+	for _, driver := range drivers {
+		_ = driver.Close(context.Background())
+	}
+}
+
+// credentialsHash identifies credentials without keeping the password
+func credentialsHash(credentials *Credentials) string {
+	sum := sha256.Sum256([]byte(credentials.Username + "\x00" + credentials.Password))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,146 @@
+package neo4j
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func testDriverFactory(uri string, created *int) func() (neo4j.DriverWithContext, error) {
+	return func() (neo4j.DriverWithContext, error) {
+		*created++
+		return neo4j.NewDriverWithContext(uri, neo4j.NoAuth())
+	}
+}
+
+func TestSharedDriverReuse(t *testing.T) {
+	SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+	defer SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+
+	credentials := &Credentials{Username: "neo4j", Password: "secret"}
+	created := 0
+	newDriver := testDriverFactory("bolt://127.0.0.1:1", &created)
+
+	first, _, err := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || created != 1 || first.refs != 2 {
+		t.Fatalf("expected clients of the same cluster to share a driver, created %d", created)
+	}
+
+	other, _, _ := acquireDriver("uid-2", "bolt://prod", credentials, newDriver)
+	if other == first {
+		t.Fatal("expected another cluster to get its own driver")
+	}
+
+	// A rotated password replaces the driver; the old one is closed with
+	// its last client
+	rotated, _, _ := acquireDriver("uid-1", "bolt://prod", &Credentials{Username: "neo4j", Password: "rotated"}, newDriver)
+	if rotated == first || !first.evicted {
+		t.Fatal("expected rotated credentials to replace the driver")
+	}
+	releaseDriver(first)
+	releaseDriver(second)
+	if first.refs != 0 {
+		t.Fatalf("expected the old driver to be released, %d clients left", first.refs)
+	}
+
+	InvalidateDrivers("uid-1")
+	if !rotated.evicted {
+		t.Fatal("expected the drivers of an invalidated cluster to be evicted")
+	}
+	releaseDriver(rotated)
+	releaseDriver(other)
+}
+
+func TestSharedDriverHealthCheck(t *testing.T) {
+	SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+	defer SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+
+	credentials := &Credentials{Username: "neo4j", Password: "secret"}
+	created := 0
+	// Nothing listens on port 1, so connectivity checks fail
+	newDriver := testDriverFactory("bolt://127.0.0.1:1", &created)
+
+	stale, _, _ := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	stale.lastVerified = time.Now().Add(-2 * driverHealthCheckInterval)
+	fresh, _, err := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fresh == stale || !stale.evicted || created != 2 {
+		t.Fatal("expected a driver failing its health check to be replaced")
+	}
+	releaseDriver(stale)
+	releaseDriver(fresh)
+}
+
+func TestSharedDriverIdleExpiry(t *testing.T) {
+	SetDriverIdleTimeout(time.Minute)
+	defer SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+
+	credentials := &Credentials{Username: "neo4j", Password: "secret"}
+	created := 0
+	newDriver := testDriverFactory("bolt://127.0.0.1:1", &created)
+
+	idle, _, _ := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	releaseDriver(idle)
+	if idle.evicted {
+		t.Fatal("expected a released driver to stay cached")
+	}
+	idle.lastUsed = time.Now().Add(-2 * time.Minute)
+	other, _, _ := acquireDriver("uid-2", "bolt://staging", credentials, newDriver)
+	if !idle.evicted {
+		t.Fatal("expected a driver idle past the timeout to be closed")
+	}
+	releaseDriver(other)
+
+	SetDriverIdleTimeout(0)
+	shared, driver, err := acquireDriver("uid-1", "bolt://prod", credentials, newDriver)
+	if err != nil || shared != nil || driver == nil {
+		t.Fatal("expected the caller to own the driver while sharing is disabled")
+	}
+	_ = driver.Close(t.Context())
+}
+
+func TestCloseStopsHealthMonitoring(t *testing.T) {
+	SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+	defer SetDriverIdleTimeout(DefaultDriverIdleTimeout)
+
+	credentials := &Credentials{Username: "neo4j", Password: "secret"}
+	created := 0
+	shared, driver, err := acquireDriver("uid-1", "bolt://prod", credentials, testDriverFactory("bolt://127.0.0.1:1", &created))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{driver: driver, sharedDriver: shared, credentials: credentials, done: make(chan struct{}),
+		circuitBreaker: &CircuitBreaker{}, poolMetrics: &ConnectionPoolMetrics{}}
+	// As in the constructors, the channel is read before the goroutine starts
+	done, stopped := c.done, make(chan struct{})
+	go func() {
+		c.startHealthMonitoring(done)
+		close(stopped)
+	}()
+
+	// Closing twice must neither panic nor race with the monitor
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected Close to stop the health monitoring")
+	}
+	if shared.refs != 0 {
+		t.Fatalf("expected Close to release the shared driver, %d references left", shared.refs)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// IndexCandidate is a label and property a query filters nodes found by a
+// label scan on, which a range index would serve
+type IndexCandidate struct {
+	Label    string
+	Property string
+}
+
+// Statement returns the statement creating the index
+func (c IndexCandidate) Statement() string {
+	return fmt.Sprintf("CREATE INDEX IF NOT EXISTS FOR (n:`%s`) ON (n.`%s`)", c.Label, c.Property)
+}
+
+var (
+	// propertyReference matches variable.property outside of parameters,
+	// as written in the details of plan operators
+	propertyReference = regexp.MustCompile(`(?:^|[^$\w])(\w+)\.(\w+)`)
+	// labelReference matches variable:Label
+	labelReference = regexp.MustCompile(`(?:^|[^$\w])(\w+):(\w+)`)
+)
+
+// MissingIndexes plans query against database with EXPLAIN, without
+// running it, and returns the labels and properties its plan scans a label
+// and filters on instead of seeking an index
+func (c *Client) MissingIndexes(ctx context.Context, database, query string) ([]IndexCandidate, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	session := c.newSession(timeoutCtx, neo4j.SessionConfig{
+		AccessMode:   neo4j.AccessModeRead,
+		DatabaseName: database,
+	})
+	defer c.closeSession(timeoutCtx, session)
+
+	result, err := session.Run(timeoutCtx, "EXPLAIN "+query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+	summary, err := result.Consume(timeoutCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+	if summary.Plan() == nil {
+		return nil, nil
+	}
+	return indexCandidates(summary.Plan()), nil
+}
+
+// indexCandidates walks a plan for filters on the properties of nodes found
+// by NodeByLabelScan, or by AllNodesScan with a label filter
+func indexCandidates(plan neo4j.Plan) []IndexCandidate {
+	var candidates []IndexCandidate
+	seen := map[IndexCandidate]bool{}
+
+	var visit func(plan neo4j.Plan)
+	visit = func(plan neo4j.Plan) {
+		if planOperator(plan) == "Filter" {
+			details := planDetails(plan)
+			labels := scannedLabels(plan, details)
+			for _, match := range propertyReference.FindAllStringSubmatch(details, -1) {
+				label, scanned := labels[match[1]]
+				if !scanned {
+					continue
+				}
+				candidate := IndexCandidate{Label: label, Property: match[2]}
+				if !seen[candidate] {
+					seen[candidate] = true
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+		for _, child := range plan.Children() {
+			visit(child)
+		}
+	}
+	visit(plan)
+	return candidates
+}
+
+// scannedLabels returns the label of each variable scanned below a filter
+// with the given details
+func scannedLabels(filter neo4j.Plan, details string) map[string]string {
+	labels := map[string]string{}
+	allNodes := map[string]bool{}
+
+	var visit func(plan neo4j.Plan)
+	visit = func(plan neo4j.Plan) {
+		switch planOperator(plan) {
+		case "NodeByLabelScan":
+			for _, match := range labelReference.FindAllStringSubmatch(planDetails(plan), -1) {
+				labels[match[1]] = match[2]
+			}
+		case "AllNodesScan":
+			allNodes[strings.TrimSpace(planDetails(plan))] = true
+		}
+		for _, child := range plan.Children() {
+			visit(child)
+		}
+	}
+	for _, child := range filter.Children() {
+		visit(child)
+	}
+
+	// Nodes of all labels scanned for one are filtered on it
+	for _, match := range labelReference.FindAllStringSubmatch(details, -1) {
+		if allNodes[match[1]] {
+			labels[match[1]] = match[2]
+		}
+	}
+	return labels
+}
+
+// planOperator returns the operator of a plan without the database suffix
+// of Neo4j 5
+func planOperator(plan neo4j.Plan) string {
+	operator, _, _ := strings.Cut(plan.Operator(), "@")
+	return operator
+}
+
+// planDetails returns the Details argument of a plan
+func planDetails(plan neo4j.Plan) string {
+	details, _ := plan.Arguments()["Details"].(string)
+	return details
+}
@@ -0,0 +1,67 @@
+package neo4j
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type testPlan struct {
+	operator string
+	details  string
+	children []neo4j.Plan
+}
+
+func (p *testPlan) Operator() string { return p.operator }
+
+func (p *testPlan) Arguments() map[string]any { return map[string]any{"Details": p.details} }
+
+func (p *testPlan) Identifiers() []string { return nil }
+
+func (p *testPlan) Children() []neo4j.Plan { return p.children }
+
+func TestIndexCandidates(t *testing.T) {
+	tests := []struct {
+		name string
+		plan neo4j.Plan
+		want []IndexCandidate
+	}{
+		{
+			name: "label scan",
+			plan: &testPlan{operator: "ProduceResults@neo4j", children: []neo4j.Plan{
+				&testPlan{operator: "Filter@neo4j", details: "p.email = $email AND cache[p.age] > $autoint_0", children: []neo4j.Plan{
+					&testPlan{operator: "NodeByLabelScan@neo4j", details: "p:Person"},
+				}},
+			}},
+			want: []IndexCandidate{{Label: "Person", Property: "email"}, {Label: "Person", Property: "age"}},
+		},
+		{
+			name: "all nodes scan",
+			plan: &testPlan{operator: "Filter", details: "n:Order AND n.status = $status.value", children: []neo4j.Plan{
+				&testPlan{operator: "AllNodesScan", details: "n"},
+			}},
+			want: []IndexCandidate{{Label: "Order", Property: "status"}},
+		},
+		{
+			name: "index seek",
+			plan: &testPlan{operator: "ProduceResults@neo4j", children: []neo4j.Plan{
+				&testPlan{operator: "NodeIndexSeek@neo4j", details: "RANGE INDEX p:Person(email) WHERE email = $email"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := indexCandidates(tt.plan); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("indexCandidates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexCandidateStatement(t *testing.T) {
+	want := "CREATE INDEX IF NOT EXISTS FOR (n:`Person`) ON (n.`email`)"
+	if got := (IndexCandidate{Label: "Person", Property: "email"}).Statement(); got != want {
+		t.Fatalf("Statement() = %q, want %q", got, want)
+	}
+}
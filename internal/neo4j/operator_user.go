@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+)
+
+// OperatorRole is the role of the user of spec.auth.operatorSecret
+const OperatorRole = "neo4j_operator"
+
+// operatorPrivileges are the privileges of OperatorRole: the statements the
+// controllers run against the servers and no more. Admin procedures are
+// needed by checkpoints, cordoning, leadership transfers, TLS reloads and
+// dynamic configuration; other procedures are granted by name. None of them
+// lets the role grant itself privileges.
+var operatorPrivileges = []string{
+	"GRANT ACCESS ON DATABASE * TO `" + OperatorRole + "`",
+	"GRANT MATCH {*} ON GRAPH * TO `" + OperatorRole + "`",
+	"GRANT WRITE ON GRAPH * TO `" + OperatorRole + "`",
+	"GRANT INDEX MANAGEMENT ON DATABASE * TO `" + OperatorRole + "`",
+	"GRANT CONSTRAINT MANAGEMENT ON DATABASE * TO `" + OperatorRole + "`",
+	"GRANT TRANSACTION MANAGEMENT (*) ON DATABASE * TO `" + OperatorRole + "`",
+	"GRANT DATABASE MANAGEMENT ON DBMS TO `" + OperatorRole + "`",
+	"GRANT ALIAS MANAGEMENT ON DBMS TO `" + OperatorRole + "`",
+	"GRANT SERVER MANAGEMENT ON DBMS TO `" + OperatorRole + "`",
+	"GRANT EXECUTE ADMIN PROCEDURES ON DBMS TO `" + OperatorRole + "`",
+	"GRANT EXECUTE PROCEDURE dbms.components, dbms.cluster.*, db.checkpoint, db.cdc.query, fleetManagement.* ON DBMS TO `" + OperatorRole + "`",
+	"GRANT EXECUTE FUNCTION * ON DBMS TO `" + OperatorRole + "`",
+}
+
+// userManagementPrivileges are granted to OperatorRole only while a
+// Neo4jUserSync manages the users of the deployment: creating, altering and
+// dropping users, and assigning and removing their roles. Assigning roles
+// lets the operator user assign itself any role, admin included.
+var userManagementPrivileges = []string{
+	"USER MANAGEMENT ON DBMS",
+	"ASSIGN ROLE ON DBMS",
+	"REMOVE ROLE ON DBMS",
+}
+
+// operatorRoleStatements returns the statements that give OperatorRole its
+// privileges, revoking those of user management when it is not needed
+func operatorRoleStatements(userManagement bool) []string {
+	statements := append([]string{"CREATE ROLE `" + OperatorRole + "` IF NOT EXISTS"}, operatorPrivileges...)
+	for _, privilege := range userManagementPrivileges {
+		if userManagement {
+			statements = append(statements, "GRANT "+privilege+" TO `"+OperatorRole+"`")
+		} else {
+			statements = append(statements, "REVOKE GRANT "+privilege+" FROM `"+OperatorRole+"`")
+		}
+	}
+	return statements
+}
+
+// EnsureOperatorUser creates OperatorRole and the user the operator
+// connects as, or sets the password of the user when it exists. The role
+// holds user management only with userManagement. Every statement is
+// idempotent, so it is run again after a partial failure.
+func (c *Client) EnsureOperatorUser(ctx context.Context, username, password string, userManagement bool) error {
+	if username == "" || password == "" {
+		return fmt.Errorf("the operator user needs a username and a password")
+	}
+
+	for _, statement := range operatorRoleStatements(userManagement) {
+		if err := c.ExecutePrivilegeStatement(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	query := fmt.Sprintf("CREATE USER `%s` IF NOT EXISTS SET PASSWORD $password CHANGE NOT REQUIRED", username)
+	_, err := c.ExecuteWrite(ctx, "system", query, map[string]any{"password": password})
+	c.auditSecurityStatement(ctx, query, err)
+	if err != nil {
+		return fmt.Errorf("failed to create operator user %s: %w", username, err)
+	}
+	// The user may exist from an earlier password
+	if err := c.SetUserPassword(ctx, username, password); err != nil {
+		return err
+	}
+	return c.GrantRoleToUser(ctx, OperatorRole, username)
+}
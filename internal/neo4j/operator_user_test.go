@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOperatorRoleStatements(t *testing.T) {
+	// Privileges that would let the role grant itself anything
+	escalating := []string{"ROLE MANAGEMENT", "PRIVILEGE MANAGEMENT", "ASSIGN ROLE", "USER MANAGEMENT", "EXECUTE PROCEDURE *", "ALL "}
+
+	for _, statement := range operatorRoleStatements(false) {
+		if !strings.HasPrefix(statement, "GRANT ") {
+			continue
+		}
+		for _, privilege := range escalating {
+			if strings.Contains(statement, privilege) {
+				t.Errorf("operator role is granted %q without user management: %q", privilege, statement)
+			}
+		}
+	}
+
+	statements := strings.Join(operatorRoleStatements(false), "\n")
+	for _, want := range []string{
+		"REVOKE GRANT USER MANAGEMENT ON DBMS FROM `neo4j_operator`",
+		"REVOKE GRANT ASSIGN ROLE ON DBMS FROM `neo4j_operator`",
+	} {
+		if !strings.Contains(statements, want) {
+			t.Errorf("expected %q without user management, got:\n%s", want, statements)
+		}
+	}
+
+	statements = strings.Join(operatorRoleStatements(true), "\n")
+	for _, want := range []string{
+		"GRANT USER MANAGEMENT ON DBMS TO `neo4j_operator`",
+		"GRANT ASSIGN ROLE ON DBMS TO `neo4j_operator`",
+		"GRANT REMOVE ROLE ON DBMS TO `neo4j_operator`",
+	} {
+		if !strings.Contains(statements, want) {
+			t.Errorf("expected %q with user management, got:\n%s", want, statements)
+		}
+	}
+	if strings.Contains(statements, "PRIVILEGE MANAGEMENT") || strings.Contains(statements, "GRANT ROLE MANAGEMENT") {
+		t.Errorf("user management must not grant role or privilege management:\n%s", statements)
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// TransactionInfo is a transaction as listed by SHOW TRANSACTIONS
+type TransactionInfo struct {
+	ID          string
+	Database    string
+	Username    string
+	Status      string
+	Query       string
+	ElapsedTime time.Duration
+	// EstimatedUsedHeapMemory is zero unless the server tracks the memory
+	// of transactions
+	EstimatedUsedHeapMemory int64
+}
+
+// ExecuteRead runs a read statement with parameters against a database,
+// routed to any server hosting it, and returns all of its records. The
+// default database is used when database is empty.
+func (c *Client) ExecuteRead(ctx context.Context, database, query string, params map[string]any) ([]*neo4j.Record, error) {
+	return c.collect(ctx, neo4j.AccessModeRead, database, query, params)
+}
+
+// ExecuteWrite runs a write statement with parameters against a database,
+// routed to its leader, and returns all of its records. Statements against
+// the system database are administrative and wait for the rate limiter.
+func (c *Client) ExecuteWrite(ctx context.Context, database, query string, params map[string]any) ([]*neo4j.Record, error) {
+	if database == "system" {
+		if err := c.throttleAdminQuery(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.collect(ctx, neo4j.AccessModeWrite, database, query, params)
+}
+
+// collect runs a statement in an auto-commit transaction, so that it is
+// retried on transient errors like every other statement of the client, and
+// reads its records
+func (c *Client) collect(ctx context.Context, mode neo4j.AccessMode, database, query string, params map[string]any) ([]*neo4j.Record, error) {
+	session := c.newSession(ctx, neo4j.SessionConfig{
+		AccessMode:   mode,
+		DatabaseName: database,
+	})
+	defer c.closeSession(ctx, session)
+
+	result, err := session.Run(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.Collect(ctx)
+}
+
+// MapRecords maps each record with mapper, stopping at the first record it
+// fails to map
+func MapRecords[T any](records []*neo4j.Record, mapper func(*neo4j.Record) (T, error)) ([]T, error) {
+	mapped := make([]T, 0, len(records))
+	for _, record := range records {
+		value, err := mapper(record)
+		if err != nil {
+			return nil, err
+		}
+		mapped = append(mapped, value)
+	}
+	return mapped, nil
+}
+
+// ShowServers lists the servers of the cluster with SHOW SERVERS
+func (c *Client) ShowServers(ctx context.Context) ([]ServerInfo, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	records, err := c.ExecuteRead(timeoutCtx, "system",
+		"SHOW SERVERS YIELD serverId, name, address, state, health, hosting, version "+
+			"RETURN serverId, name, address, state, health, hosting, version", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SHOW SERVERS: %w", err)
+	}
+	return MapRecords(records, mapServer)
+}
+
+// ShowDatabases lists the databases with SHOW DATABASES, one entry for every
+// server hosting a database. Only the entries of the named database are
+// listed unless name is empty.
+func (c *Client) ShowDatabases(ctx context.Context, name string) ([]DatabaseInfo, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	query := "SHOW DATABASES YIELD name, type, address, role, writer, requestedStatus, currentStatus, statusMessage, default, home "
+	var params map[string]any
+	if name != "" {
+		query += "WHERE name = $name "
+		params = map[string]any{"name": name}
+	}
+	query += "RETURN name, type, address, role, writer, requestedStatus, currentStatus, statusMessage, default, home"
+
+	records, err := c.ExecuteRead(timeoutCtx, "system", query, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SHOW DATABASES: %w", err)
+	}
+	return MapRecords(records, mapDatabase)
+}
+
+// ShowTransactions lists the transactions running on the server the session
+// is routed to with SHOW TRANSACTIONS. Only the transactions of the named
+// database are listed unless database is empty.
+func (c *Client) ShowTransactions(ctx context.Context, database string) ([]TransactionInfo, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	query := "SHOW TRANSACTIONS YIELD transactionId, database, username, status, currentQuery, elapsedTime, estimatedUsedHeapMemory "
+	var params map[string]any
+	if database != "" {
+		query += "WHERE database = $database "
+		params = map[string]any{"database": database}
+	}
+	query += "RETURN transactionId, database, username, status, currentQuery, elapsedTime, estimatedUsedHeapMemory"
+
+	records, err := c.ExecuteRead(timeoutCtx, "system", query, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SHOW TRANSACTIONS: %w", err)
+	}
+	return MapRecords(records, mapTransaction)
+}
+
+// TerminateTransactions terminates transactions running on the server the
+// session is routed to with TERMINATE TRANSACTIONS, and returns the ids of
+// those it terminated. Transactions that finished in the meantime are
+// skipped.
+func (c *Client) TerminateTransactions(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Read access, as the transactions of a server are terminated on it
+	// whether or not it leads the system database
+	records, err := c.ExecuteRead(timeoutCtx, "system",
+		"TERMINATE TRANSACTIONS $ids YIELD transactionId, message RETURN transactionId, message",
+		map[string]any{"ids": ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute TERMINATE TRANSACTIONS: %w", err)
+	}
+	var terminated []string
+	for _, record := range records {
+		if recordString(record, "message") == "Transaction terminated." {
+			terminated = append(terminated, recordString(record, "transactionId"))
+		}
+	}
+	return terminated, nil
+}
+
+// mapServer maps a row of SHOW SERVERS
+func mapServer(record *neo4j.Record) (ServerInfo, error) {
+	return ServerInfo{
+		ID:      recordString(record, "serverId"),
+		Name:    recordString(record, "name"),
+		Address: recordString(record, "address"),
+		State:   recordString(record, "state"),
+		Health:  recordString(record, "health"),
+		Hosting: recordStrings(record, "hosting"),
+		Version: recordString(record, "version"),
+	}, nil
+}
+
+// mapDatabase maps a row of SHOW DATABASES
+func mapDatabase(record *neo4j.Record) (DatabaseInfo, error) {
+	return DatabaseInfo{
+		Name:            recordString(record, "name"),
+		Type:            recordString(record, "type"),
+		Address:         recordString(record, "address"),
+		Role:            recordString(record, "role"),
+		Writer:          recordBool(record, "writer"),
+		RequestedStatus: recordString(record, "requestedStatus"),
+		Status:          recordString(record, "currentStatus"),
+		StatusMessage:   recordString(record, "statusMessage"),
+		Default:         recordBool(record, "default"),
+		Home:            recordBool(record, "home"),
+	}, nil
+}
+
+// mapTransaction maps a row of SHOW TRANSACTIONS
+func mapTransaction(record *neo4j.Record) (TransactionInfo, error) {
+	transaction := TransactionInfo{
+		ID:       recordString(record, "transactionId"),
+		Database: recordString(record, "database"),
+		Username: recordString(record, "username"),
+		Status:   recordString(record, "status"),
+		Query:    recordString(record, "currentQuery"),
+	}
+	if memory, ok := record.Get("estimatedUsedHeapMemory"); ok {
+		transaction.EstimatedUsedHeapMemory, _ = memory.(int64)
+	}
+	elapsed, _, err := neo4j.GetRecordValue[neo4j.Duration](record, "elapsedTime")
+	if err != nil {
+		return TransactionInfo{}, fmt.Errorf("invalid elapsed time of transaction %s: %w", transaction.ID, err)
+	}
+	// Transactions run for seconds to hours, so months do not occur
+	transaction.ElapsedTime = time.Duration(elapsed.Days)*24*time.Hour +
+		time.Duration(elapsed.Seconds)*time.Second + time.Duration(elapsed.Nanos)
+	return transaction, nil
+}
+
+// recordBool returns a boolean column of a record, false when it is missing
+// or null
+func recordBool(record *neo4j.Record, key string) bool {
+	value, ok := record.Get(key)
+	if !ok {
+		return false
+	}
+	b, _ := value.(bool)
+	return b
+}
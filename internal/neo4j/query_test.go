@@ -0,0 +1,105 @@
+package neo4j
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestMapRecords(t *testing.T) {
+	records := []*neo4j.Record{
+		{Keys: []string{"name"}, Values: []any{"neo4j"}},
+		{Keys: []string{"name"}, Values: []any{"system"}},
+	}
+	names, err := MapRecords(records, func(record *neo4j.Record) (string, error) {
+		return recordString(record, "name"), nil
+	})
+	if err != nil || !reflect.DeepEqual(names, []string{"neo4j", "system"}) {
+		t.Fatalf("MapRecords() = %v, %v", names, err)
+	}
+
+	failed := errors.New("unexpected record")
+	if _, err := MapRecords(records, func(*neo4j.Record) (string, error) { return "", failed }); !errors.Is(err, failed) {
+		t.Fatalf("MapRecords() error = %v, want %v", err, failed)
+	}
+}
+
+func TestMapServer(t *testing.T) {
+	record := &neo4j.Record{
+		Keys:   []string{"serverId", "name", "address", "state", "health", "hosting", "version"},
+		Values: []any{"8f2c", "prod-server-0", "prod-server-0.prod-headless:7687", "Enabled", "Available", []any{"neo4j", "system"}, "5.26.0"},
+	}
+	server, err := mapServer(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ServerInfo{
+		ID:      "8f2c",
+		Name:    "prod-server-0",
+		Address: "prod-server-0.prod-headless:7687",
+		State:   "Enabled",
+		Health:  "Available",
+		Hosting: []string{"neo4j", "system"},
+		Version: "5.26.0",
+	}
+	if !reflect.DeepEqual(server, want) {
+		t.Fatalf("mapServer() = %+v, want %+v", server, want)
+	}
+}
+
+func TestMapDatabase(t *testing.T) {
+	record := &neo4j.Record{
+		Keys:   []string{"name", "type", "address", "role", "writer", "requestedStatus", "currentStatus", "statusMessage", "default", "home"},
+		Values: []any{"orders", "standard", "prod-server-1:7687", "primary", true, "online", "online", "", false, nil},
+	}
+	database, err := mapDatabase(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DatabaseInfo{
+		Name:            "orders",
+		Type:            "standard",
+		Address:         "prod-server-1:7687",
+		Role:            "primary",
+		Writer:          true,
+		RequestedStatus: "online",
+		Status:          "online",
+	}
+	if !reflect.DeepEqual(database, want) {
+		t.Fatalf("mapDatabase() = %+v, want %+v", database, want)
+	}
+	if servers := databaseAddresses([]DatabaseInfo{database, {Name: "orders"}}); !reflect.DeepEqual(servers, []string{"prod-server-1:7687"}) {
+		t.Fatalf("databaseAddresses() = %v", servers)
+	}
+}
+
+func TestMapTransaction(t *testing.T) {
+	record := &neo4j.Record{
+		Keys:   []string{"transactionId", "database", "username", "status", "currentQuery", "elapsedTime", "estimatedUsedHeapMemory"},
+		Values: []any{"orders-transaction-42", "orders", "app", "Running", "MATCH (n) RETURN count(n)", neo4j.Duration{Seconds: 90, Nanos: 500}, int64(4096)},
+	}
+	transaction, err := mapTransaction(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TransactionInfo{
+		ID:                      "orders-transaction-42",
+		Database:                "orders",
+		Username:                "app",
+		Status:                  "Running",
+		Query:                   "MATCH (n) RETURN count(n)",
+		ElapsedTime:             90*time.Second + 500,
+		EstimatedUsedHeapMemory: 4096,
+	}
+	if !reflect.DeepEqual(transaction, want) {
+		t.Fatalf("mapTransaction() = %+v, want %+v", transaction, want)
+	}
+
+	record.Values[5] = "90s"
+	if _, err := mapTransaction(record); err == nil {
+		t.Fatal("mapTransaction() accepted an elapsed time that is not a duration")
+	}
+}
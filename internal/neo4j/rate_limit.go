@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+)
+
+const (
+	// DefaultAdminQueryQPS is the sustained rate of administrative statements
+	// the operator runs against one cluster
+	DefaultAdminQueryQPS = 20
+
+	// DefaultAdminQueryBurst is the number of administrative statements that
+	// may run back to back before the rate applies
+	DefaultAdminQueryBurst = 40
+)
+
+// adminRateLimiters holds one token bucket per cluster or standalone, shared
+// by every client created for it, so that bursts of resource changes
+// reconciled in parallel do not flood its system database.
+var adminRateLimiters = struct {
+	sync.Mutex
+	qps      float64
+	burst    int
+	limiters map[string]*rate.Limiter
+}{
+	qps:      DefaultAdminQueryQPS,
+	burst:    DefaultAdminQueryBurst,
+	limiters: map[string]*rate.Limiter{},
+}
+
+// SetAdminRateLimit sets the rate and burst of administrative statements per
+// cluster. A qps of zero or less disables the limit. Limiters already handed
+// out keep their settings, so it should be called before clients are created.
+func SetAdminRateLimit(qps float64, burst int) {
+	adminRateLimiters.Lock()
+	defer adminRateLimiters.Unlock()
+
+	if burst < 1 {
+		burst = 1
+	}
+	adminRateLimiters.qps = qps
+	adminRateLimiters.burst = burst
+	adminRateLimiters.limiters = map[string]*rate.Limiter{}
+}
+
+// adminRateLimiterFor returns the limiter of a cluster or standalone, or nil
+// when the limit is disabled.
+func adminRateLimiterFor(namespace, name string) *rate.Limiter {
+	adminRateLimiters.Lock()
+	defer adminRateLimiters.Unlock()
+
+	if adminRateLimiters.qps <= 0 {
+		return nil
+	}
+	key := namespace + "/" + name
+	limiter, found := adminRateLimiters.limiters[key]
+	if !found {
+		limiter = rate.NewLimiter(rate.Limit(adminRateLimiters.qps), adminRateLimiters.burst)
+		adminRateLimiters.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// setAdminRateLimiter attaches the limiter of a cluster or standalone to the
+// client.
+func (c *Client) setAdminRateLimiter(namespace, name string) {
+	c.adminLimiter = adminRateLimiterFor(namespace, name)
+	c.adminMetrics = metrics.NewCypherMetrics(name, namespace)
+}
+
+// throttleAdminQuery waits until the rate limiter of the cluster admits an
+// administrative statement and records the wait.
+func (c *Client) throttleAdminQuery(ctx context.Context) error {
+	if c.adminLimiter == nil {
+		return nil
+	}
+
+	start := time.Now()
+	err := c.adminLimiter.Wait(ctx)
+	if c.adminMetrics != nil {
+		c.adminMetrics.RecordThrottleWait(time.Since(start))
+	}
+	if err != nil {
+		return fmt.Errorf("administrative statement not admitted by rate limiter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package neo4j
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAdminRateLimiterSharedPerCluster(t *testing.T) {
+	SetAdminRateLimit(DefaultAdminQueryQPS, DefaultAdminQueryBurst)
+
+	if adminRateLimiterFor("neo4j", "prod") != adminRateLimiterFor("neo4j", "prod") {
+		t.Fatal("expected clients of the same cluster to share a limiter")
+	}
+	if adminRateLimiterFor("neo4j", "prod") == adminRateLimiterFor("other", "prod") {
+		t.Fatal("expected clusters in different namespaces to have their own limiter")
+	}
+
+	SetAdminRateLimit(0, DefaultAdminQueryBurst)
+	defer SetAdminRateLimit(DefaultAdminQueryQPS, DefaultAdminQueryBurst)
+	if adminRateLimiterFor("neo4j", "prod") != nil {
+		t.Fatal("expected no limiter when the limit is disabled")
+	}
+}
+
+func TestThrottleAdminQuery(t *testing.T) {
+	SetAdminRateLimit(1, 2)
+	defer SetAdminRateLimit(DefaultAdminQueryQPS, DefaultAdminQueryBurst)
+
+	c := &Client{}
+	c.setAdminRateLimiter("neo4j", "throttled")
+
+	// The burst is admitted right away
+	for i := 0; i < 2; i++ {
+		if err := c.throttleAdminQuery(context.Background()); err != nil {
+			t.Fatalf("statement %d of the burst was throttled: %v", i, err)
+		}
+	}
+
+	// The next statement needs a token the deadline does not leave time for
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := c.throttleAdminQuery(ctx); err == nil {
+		t.Fatal("expected statement beyond the burst to be throttled")
+	}
+
+	// Clients without a limiter are never throttled
+	if err := (&Client{}).throttleAdminQuery(context.Background()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
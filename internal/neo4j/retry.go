@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/metrics"
+)
+
+const (
+	// DefaultQueryRetries is how often a statement failing with a transient
+	// error is retried
+	DefaultQueryRetries = 3
+
+	// DefaultQueryTimeout bounds each attempt of a statement whose context
+	// has no deadline. It exceeds the 300 seconds databases are waited for.
+	// Migration, schema and seed statements, which may legitimately run for
+	// longer, are exempt.
+	DefaultQueryTimeout = 6 * time.Minute
+
+	// queryRetryBackoff is the wait before the first retry, doubled for
+	// every further one up to queryRetryMaxBackoff
+	queryRetryBackoff    = 500 * time.Millisecond
+	queryRetryMaxBackoff = 8 * time.Second
+)
+
+// queryRetry holds how statements are retried, shared by every client
+var queryRetry = struct {
+	sync.RWMutex
+	retries int
+	timeout time.Duration
+}{
+	retries: DefaultQueryRetries,
+	timeout: DefaultQueryTimeout,
+}
+
+// SetQueryRetry sets how often a statement failing with a transient error is
+// retried, and the timeout of each attempt whose context has no deadline. A
+// timeout of zero or less leaves such attempts unbounded.
+func SetQueryRetry(retries int, timeout time.Duration) {
+	queryRetry.Lock()
+	defer queryRetry.Unlock()
+
+	if retries < 0 {
+		retries = 0
+	}
+	queryRetry.retries = retries
+	queryRetry.timeout = timeout
+}
+
+// connectionAcquisitionErrors start the messages of the errors the driver
+// fails with before a statement is sent: no routing table, or no connection
+// from the pool. The driver does not export their types.
+var connectionAcquisitionErrors = []string{
+	"Unable to retrieve routing table",
+	"Timeout while waiting for connection",
+	"No idle connections",
+	"Pool could not find any servers",
+}
+
+// IsTransientError reports whether a statement that failed with err may
+// succeed when run again, and is known not to have taken effect: a leader
+// switch or election and other errors Neo4j marks as retriable, a stale
+// routing table, a full connection pool or a refused connection. A
+// connection dropping while the statement ran is not transient, as the
+// server may have committed it. Authentication, authorization, syntax and
+// other client errors are permanent, as are cancelled and timed out
+// contexts.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) {
+		return neo4jErr.IsRetriable()
+	}
+	var connectivityErr *neo4j.ConnectivityError
+	if errors.As(err, &connectivityErr) {
+		if connectivityErr.Inner == nil {
+			return false
+		}
+		err = connectivityErr.Inner
+		for _, prefix := range connectionAcquisitionErrors {
+			if strings.HasPrefix(err.Error(), prefix) {
+				return true
+			}
+		}
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+type noRetryKey struct{}
+
+type noTimeoutKey struct{}
+
+// withoutRetry marks statements run with the context as not to be retried,
+// for statements whose callers check the outcome of a dropped connection
+// themselves
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// withoutTimeout exempts statements run with the context from the timeout
+// of statements without a deadline, for migrations, schema changes and
+// seeds that run as long as the data takes
+func withoutTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTimeoutKey{}, true)
+}
+
+// retryingSession runs the statements of a session again when they fail
+// with a transient error, waiting with exponential backoff in between
+type retryingSession struct {
+	neo4j.SessionWithContext
+	metrics *metrics.CypherMetrics
+	// cancels release the timeouts of the statements run, once the session
+	// is closed: the driver returns the connection of a result with the
+	// context of its statement
+	cancels []context.CancelFunc
+}
+
+// newSession opens a session on the driver of the client whose statements
+// are retried
+func (c *Client) newSession(ctx context.Context, config neo4j.SessionConfig) neo4j.SessionWithContext {
+	return &retryingSession{SessionWithContext: c.driver.NewSession(ctx, config), metrics: c.adminMetrics}
+}
+
+// Run runs a statement in an auto-commit transaction, retrying it while it
+// fails with a transient error
+func (s *retryingSession) Run(ctx context.Context, cypher string, params map[string]any, configurers ...func(*neo4j.TransactionConfig)) (neo4j.ResultWithContext, error) {
+	queryRetry.RLock()
+	retries, timeout := queryRetry.retries, queryRetry.timeout
+	queryRetry.RUnlock()
+	if ctx.Value(noRetryKey{}) != nil {
+		retries = 0
+	}
+	if ctx.Value(noTimeoutKey{}) != nil {
+		timeout = 0
+	}
+
+	backoff := queryRetryBackoff
+	for attempt := 0; ; attempt++ {
+		result, err := s.runAttempt(ctx, timeout, cypher, params, configurers)
+		if err == nil || attempt >= retries || !IsTransientError(err) {
+			return result, err
+		}
+		if s.metrics != nil {
+			s.metrics.RecordRetry()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, queryRetryMaxBackoff)
+	}
+}
+
+// runAttempt runs a statement once, bounded by timeout when the context has
+// no deadline of its own
+func (s *retryingSession) runAttempt(ctx context.Context, timeout time.Duration, cypher string, params map[string]any, configurers []func(*neo4j.TransactionConfig)) (neo4j.ResultWithContext, error) {
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return s.SessionWithContext.Run(ctx, cypher, params, configurers...)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	result, err := s.SessionWithContext.Run(ctx, cypher, params, configurers...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s.cancels = append(s.cancels, cancel)
+	return result, nil
+}
+
+// Close closes the session and releases the timeouts of its statements
+func (s *retryingSession) Close(ctx context.Context) error {
+	err := s.SessionWithContext.Close(ctx)
+	for _, cancel := range s.cancels {
+		cancel()
+	}
+	s.cancels = nil
+	return err
+}
@@ -0,0 +1,114 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{name: "leader switch", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Cluster.NotALeader"}, transient: true},
+		{name: "database unavailable", err: &neo4j.Neo4jError{Code: "Neo.TransientError.General.DatabaseUnavailable"}, transient: true},
+		{name: "wrapped", err: fmt.Errorf("failed to create user: %w", &neo4j.Neo4jError{Code: "Neo.TransientError.General.DatabaseUnavailable"}), transient: true},
+		{name: "stale routing table", err: &neo4j.ConnectivityError{Inner: errors.New("Unable to retrieve routing table from prod-server-0:7687: EOF")}, transient: true},
+		{name: "pool exhausted", err: &neo4j.ConnectivityError{Inner: errors.New("Timeout while waiting for connection to any of [[prod-server-1:7687]]: context deadline exceeded")}, transient: true},
+		{name: "connection refused", err: &neo4j.ConnectivityError{Inner: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, transient: true},
+		// The server may have committed a statement whose connection dropped
+		{name: "connection dropped", err: &neo4j.ConnectivityError{Inner: io.EOF}},
+		{name: "connection reset", err: &neo4j.ConnectivityError{Inner: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}},
+		{name: "broken pipe", err: fmt.Errorf("write: %w", syscall.EPIPE)},
+		{name: "unauthorized", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized"}},
+		{name: "syntax", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"}},
+		{name: "timed out", err: context.DeadlineExceeded},
+		{name: "other", err: errors.New("database name is invalid")},
+		{name: "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientError(tt.err); got != tt.transient {
+				t.Fatalf("IsTransientError(%v) = %v, want %v", tt.err, got, tt.transient)
+			}
+		})
+	}
+}
+
+// failingSession fails the first statements it runs with the given errors
+type failingSession struct {
+	neo4j.SessionWithContext
+	errs     []error
+	runs     int
+	deadline bool
+}
+
+func (s *failingSession) Run(ctx context.Context, _ string, _ map[string]any, _ ...func(*neo4j.TransactionConfig)) (neo4j.ResultWithContext, error) {
+	s.runs++
+	_, s.deadline = ctx.Deadline()
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s *failingSession) Close(context.Context) error {
+	return nil
+}
+
+func TestRetryingSessionRun(t *testing.T) {
+	SetQueryRetry(1, time.Minute)
+	defer SetQueryRetry(DefaultQueryRetries, DefaultQueryTimeout)
+	ctx := context.Background()
+	notALeader := &neo4j.Neo4jError{Code: "Neo.ClientError.Cluster.NotALeader"}
+
+	fake := &failingSession{errs: []error{notALeader}}
+	session := &retryingSession{SessionWithContext: fake}
+	if _, err := session.Run(ctx, "CREATE USER alice", nil); err != nil {
+		t.Fatalf("expected the statement to succeed after a leader switch, got %v", err)
+	}
+	if fake.runs != 2 || !fake.deadline {
+		t.Fatalf("expected 2 attempts with a timeout, got %d", fake.runs)
+	}
+	_ = session.Close(ctx)
+
+	// Retries are bounded
+	fake = &failingSession{errs: []error{notALeader, notALeader}}
+	session = &retryingSession{SessionWithContext: fake}
+	if _, err := session.Run(ctx, "CREATE USER alice", nil); !errors.Is(err, notALeader) {
+		t.Fatalf("expected the last error, got %v", err)
+	}
+
+	// Permanent errors fail at once
+	unauthorized := &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized"}
+	fake = &failingSession{errs: []error{unauthorized}}
+	session = &retryingSession{SessionWithContext: fake}
+	if _, err := session.Run(ctx, "CREATE USER alice", nil); !errors.Is(err, unauthorized) || fake.runs != 1 {
+		t.Fatalf("expected a single attempt, got %d", fake.runs)
+	}
+
+	// as do statements that are not to be retried
+	fake = &failingSession{errs: []error{notALeader}}
+	session = &retryingSession{SessionWithContext: fake}
+	if _, err := session.Run(withoutRetry(ctx), "CREATE DATABASE orders WAIT", nil); err == nil || fake.runs != 1 {
+		t.Fatalf("expected a single attempt, got %d", fake.runs)
+	}
+
+	// Statements exempt from the timeout run without a deadline
+	fake = &failingSession{}
+	session = &retryingSession{SessionWithContext: fake}
+	if _, err := session.Run(withoutTimeout(ctx), "CALL { MATCH (n) SET n.migrated = true } IN TRANSACTIONS", nil); err != nil || fake.deadline {
+		t.Fatalf("expected no deadline, got %v", err)
+	}
+}
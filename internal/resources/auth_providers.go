@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// authSettings collects the neo4j.conf settings of an auth provider. Those
+// also set in spec.config are left to it, as Neo4j does not accept a setting
+// twice.
+type authSettings struct {
+	config map[string]string
+	lines  []string
+}
+
+// set adds a setting unless spec.config sets it
+func (s *authSettings) set(key, value string) {
+	if _, exists := s.config[key]; !exists {
+		s.lines = append(s.lines, key+"="+value)
+	}
+}
+
+// render returns the settings under a comment heading
+func (s *authSettings) render(heading string) string {
+	if len(s.lines) == 0 {
+		return ""
+	}
+	return "\n# " + heading + "\n" + strings.Join(s.lines, "\n") + "\n"
+}
+
+// buildAuthProvidersConfig enables the providers of spec.auth.ldap,
+// spec.auth.kerberos and spec.auth.oidc. Native stays first, so the operator
+// and the admin Secret keep working when the directory or identity provider
+// cannot be reached or its mapping is wrong. Kerberos only authenticates:
+// roles come from native users, or from LDAP when it is configured too.
+func buildAuthProvidersConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	authentication := []string{"native"}
+	authorization := []string{"native"}
+	if KerberosEnabled(cluster.Spec.Auth) {
+		authentication = append(authentication, KerberosAuthenticationProvider)
+	}
+	if LDAPEnabled(cluster.Spec.Auth) {
+		if cluster.Spec.Auth.Provider == "ldap" {
+			authentication = append(authentication, "ldap")
+		}
+		authorization = append(authorization, "ldap")
+	}
+	if OIDCEnabled(cluster.Spec.Auth) {
+		authentication = append(authentication, OIDCProviderName(cluster.Spec.Auth.OIDC))
+		authorization = append(authorization, OIDCProviderName(cluster.Spec.Auth.OIDC))
+	}
+	if len(authentication) == 1 && len(authorization) == 1 {
+		return ""
+	}
+	settings := &authSettings{config: cluster.Spec.Config}
+	settings.set("dbms.security.authentication_providers", strings.Join(authentication, ","))
+	settings.set("dbms.security.authorization_providers", strings.Join(authorization, ","))
+	return settings.render("Auth providers (spec.auth)")
+}
+
+// groupToRoleMapping renders a group to role mapping in the format of the
+// group_to_role_mapping settings of LDAP and OIDC, ordered by group
+func groupToRoleMapping(mapping map[string][]string) string {
+	entries := make([]string, 0, len(mapping))
+	for _, group := range sortedKeys(mapping) {
+		entries = append(entries, fmt.Sprintf("%q=%s", group, strings.Join(mapping[group], ",")))
+	}
+	return strings.Join(entries, ";")
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// BackupJobPodLabels are carried by the pods of Neo4jBackup jobs, so the
+// backup port NetworkPolicy can admit them.
+var BackupJobPodLabels = map[string]string{
+	"app.kubernetes.io/name":      "neo4j-backup",
+	"app.kubernetes.io/component": "backup",
+}
+
+// BackupPortEnabled reports whether the servers of a cluster listen for
+// backups; spec.backupPort.enabled defaults to true.
+func BackupPortEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	spec := cluster.Spec.BackupPort
+	return spec == nil || spec.Enabled == nil || *spec.Enabled
+}
+
+// backupPortRestricted reports whether access to the backup port is limited
+// to spec.backupPort.allowedClients.
+func backupPortRestricted(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return BackupPortEnabled(cluster) && cluster.Spec.BackupPort != nil && len(cluster.Spec.BackupPort.AllowedClients) > 0
+}
+
+// BackupPortName returns the name of the Service and NetworkPolicy of the
+// backup port.
+func BackupPortName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-backup-port", cluster.Name)
+}
+
+// serverPodSelector selects the server pods of a cluster
+func serverPodSelector(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]string {
+	return map[string]string{
+		"neo4j.com/cluster":     cluster.Name,
+		"neo4j.com/server-name": "server",
+	}
+}
+
+// BuildBackupServiceForEnterprise creates the ClusterIP Service through which
+// neo4j-admin tooling reaches the backup port. It returns nil unless
+// spec.backupPort.expose is set.
+func BuildBackupServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Service {
+	if !BackupPortEnabled(cluster) || cluster.Spec.BackupPort == nil || !cluster.Spec.BackupPort.Expose {
+		return nil
+	}
+
+	labels := getLabelsForEnterprise(cluster, "backup")
+	delete(labels, "neo4j.com/clustering")
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupPortName(cluster),
+			Namespace: cluster.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			IPFamilyPolicy: ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:     ServiceIPFamilies(cluster.Spec.Service),
+			Type:           corev1.ServiceTypeClusterIP,
+			Selector:       serverPodSelector(cluster),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "backup",
+					Port:       BackupPort,
+					TargetPort: intstr.FromInt(BackupPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+}
+
+// BuildBackupNetworkPolicyForEnterprise admits backup traffic to the servers
+// from the cluster's own pods, Neo4jBackup jobs in the namespace and
+// spec.backupPort.allowedClients only. Without a client allowlist policy it
+// also keeps every other port open, so the servers are otherwise reached as
+// before. It returns nil when no allowed clients are configured.
+func BuildBackupNetworkPolicyForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *networkingv1.NetworkPolicy {
+	if !backupPortRestricted(cluster) {
+		return nil
+	}
+
+	tcp := corev1.ProtocolTCP
+	backupPort := intstr.FromInt(BackupPort)
+	peers := []networkingv1.NetworkPolicyPeer{
+		{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"neo4j.com/cluster": cluster.Name}}},
+		{PodSelector: &metav1.LabelSelector{MatchLabels: BackupJobPodLabels}},
+	}
+	for _, peer := range cluster.Spec.BackupPort.AllowedClients {
+		peers = append(peers, *peer.DeepCopy())
+	}
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{
+			From:  peers,
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &backupPort}},
+		},
+	}
+	if BuildClientNetworkPolicyForEnterprise(cluster) == nil {
+		// No peers: any source, on every port but the backup port
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{Ports: portsExceptBackup()})
+	}
+
+	labels := getLabelsForEnterprise(cluster, "backup")
+	delete(labels, "neo4j.com/clustering")
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupPortName(cluster),
+			Namespace: cluster.Namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: serverPodSelector(cluster)},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}
+}
+
+// portsExceptBackup covers every TCP port but the backup port
+func portsExceptBackup() []networkingv1.NetworkPolicyPort {
+	tcp := corev1.ProtocolTCP
+	below, belowEnd := intstr.FromInt(1), int32(BackupPort-1)
+	above, aboveEnd := intstr.FromInt(BackupPort+1), int32(65535)
+	return []networkingv1.NetworkPolicyPort{
+		{Protocol: &tcp, Port: &below, EndPort: &belowEnd},
+		{Protocol: &tcp, Port: &above, EndPort: &aboveEnd},
+	}
+}
+
+// BuildClientNetworkPolicyForEnterprise builds the allowlist policy of the
+// cluster client Service. When the backup port is restricted the rule that
+// admits every pod leaves it out, as NetworkPolicies only add up.
+func BuildClientNetworkPolicyForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *networkingv1.NetworkPolicy {
+	policy := BuildClientNetworkPolicy(BuildClientServiceForEnterprise(cluster), cluster.Spec.Service)
+	if policy != nil && backupPortRestricted(cluster) {
+		policy.Spec.Ingress[0].Ports = portsExceptBackup()
+	}
+	return policy
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// CDCConnectorPort is the port of the Kafka Connect REST API
+	CDCConnectorPort = 8083
+
+	cdcConnectorContainerName = "connect"
+	cdcConnectorConfigPath    = "/etc/kafka-connect"
+	cdcConnectorOffsetsPath   = "/var/lib/kafka-connect"
+	cdcWorkerPropertiesKey    = "worker.properties"
+	cdcConnectorKeySuffix     = ".connector.properties"
+	// Kafka Connect images of Confluent and the Apache Kafka project run as uid 1000
+	cdcConnectorUID int64 = 1000
+
+	neo4jSourceConnectorClass = "org.neo4j.connectors.kafka.source.Neo4jConnector"
+)
+
+// CDCConnectorName returns the name of the StatefulSet, Service and
+// ConfigMap of the Kafka Connect worker of a Neo4jCDC
+func CDCConnectorName(cdc *neo4jv1alpha1.Neo4jCDC) string {
+	return cdc.Name + "-connector"
+}
+
+// CDCConnectorInstanceName returns the Kafka Connect name of the connector
+// publishing the changes of a database
+func CDCConnectorInstanceName(cdc *neo4jv1alpha1.Neo4jCDC, database string) string {
+	return cdc.Name + "-" + database
+}
+
+// CDCPublishedDatabases returns the databases of a Neo4jCDC that list topics
+func CDCPublishedDatabases(cdc *neo4jv1alpha1.Neo4jCDC) []neo4jv1alpha1.CDCDatabase {
+	var databases []neo4jv1alpha1.CDCDatabase
+	for _, database := range cdc.Spec.Databases {
+		if len(database.Topics) > 0 {
+			databases = append(databases, database)
+		}
+	}
+	return databases
+}
+
+func cdcConnectorLabels(cdc *neo4jv1alpha1.Neo4jCDC) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "neo4j-cdc-connector",
+		"app.kubernetes.io/instance":   cdc.Name,
+		"app.kubernetes.io/component":  "cdc-connector",
+		"app.kubernetes.io/managed-by": "neo4j-operator",
+	}
+}
+
+// BuildCDCConnectorConfigMap builds the worker and connector properties of
+// the Kafka Connect worker. Credentials are left to the EnvVarConfigProvider,
+// so the ConfigMap holds no secrets.
+func BuildCDCConnectorConfigMap(cdc *neo4jv1alpha1.Neo4jCDC, neo4jURI string) *corev1.ConfigMap {
+	connector := cdc.Spec.Connector
+	data := map[string]string{
+		cdcWorkerPropertiesKey: formatProperties(cdcWorkerProperties(connector)),
+	}
+	for _, database := range CDCPublishedDatabases(cdc) {
+		data[database.Name+cdcConnectorKeySuffix] = formatProperties(cdcSourceProperties(cdc, database, neo4jURI))
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CDCConnectorName(cdc),
+			Namespace: cdc.Namespace,
+			Labels:    cdcConnectorLabels(cdc),
+		},
+		Data: data,
+	}
+}
+
+func cdcWorkerProperties(connector *neo4jv1alpha1.CDCConnectorSpec) map[string]string {
+	props := map[string]string{
+		"bootstrap.servers":              connector.BootstrapServers,
+		"key.converter":                  "org.apache.kafka.connect.json.JsonConverter",
+		"value.converter":                "org.apache.kafka.connect.json.JsonConverter",
+		"key.converter.schemas.enable":   "false",
+		"value.converter.schemas.enable": "false",
+		"offset.storage.file.filename":   cdcConnectorOffsetsPath + "/connect.offsets",
+		"offset.flush.interval.ms":       "10000",
+		"listeners":                      fmt.Sprintf("http://0.0.0.0:%d", CDCConnectorPort),
+		"plugin.path":                    "/usr/share/java,/usr/share/confluent-hub-components,/opt/kafka/plugins",
+		"config.providers":               "env",
+		"config.providers.env.class":     "org.apache.kafka.common.config.provider.EnvVarConfigProvider",
+	}
+
+	protocol := connector.SecurityProtocol
+	if protocol == "" {
+		protocol = "PLAINTEXT"
+		if connector.Auth != nil {
+			protocol = "SASL_SSL"
+		}
+	}
+	// Source connectors produce with the producer.* settings of the worker
+	for _, prefix := range []string{"", "producer."} {
+		props[prefix+"security.protocol"] = protocol
+		if connector.Auth != nil {
+			props[prefix+"sasl.mechanism"] = cdcSASLMechanism(connector.Auth)
+			props[prefix+"sasl.jaas.config"] = cdcJAASConfig(connector.Auth)
+		}
+	}
+
+	for key, value := range connector.WorkerConfig {
+		props[key] = value
+	}
+	return props
+}
+
+func cdcSASLMechanism(auth *neo4jv1alpha1.CDCKafkaAuth) string {
+	if auth.Mechanism == "" {
+		return "SCRAM-SHA-512"
+	}
+	return auth.Mechanism
+}
+
+func cdcJAASConfig(auth *neo4jv1alpha1.CDCKafkaAuth) string {
+	module := "org.apache.kafka.common.security.scram.ScramLoginModule"
+	if cdcSASLMechanism(auth) == "PLAIN" {
+		module = "org.apache.kafka.common.security.plain.PlainLoginModule"
+	}
+	return module + ` required username="${env:KAFKA_USERNAME}" password="${env:KAFKA_PASSWORD}";`
+}
+
+func cdcSourceProperties(cdc *neo4jv1alpha1.Neo4jCDC, database neo4jv1alpha1.CDCDatabase, neo4jURI string) map[string]string {
+	startFrom := database.StartFrom
+	if startFrom == "" {
+		startFrom = "NOW"
+	}
+	props := map[string]string{
+		"name":                                 CDCConnectorInstanceName(cdc, database.Name),
+		"connector.class":                      neo4jSourceConnectorClass,
+		"tasks.max":                            "1",
+		"neo4j.uri":                            neo4jURI,
+		"neo4j.authentication.type":            "BASIC",
+		"neo4j.authentication.basic.username":  "${env:NEO4J_USERNAME}",
+		"neo4j.authentication.basic.password":  "${env:NEO4J_PASSWORD}",
+		"neo4j.database":                       database.Name,
+		"neo4j.source-strategy":                "CDC",
+		"neo4j.start-from":                     startFrom,
+		"neo4j.cdc.poll-interval":              "1s",
+		"neo4j.cdc.poll-duration":              "5s",
+		"neo4j.cdc.key-serialization-strategy": "ELEMENT_ID",
+	}
+	for _, topic := range database.Topics {
+		props[fmt.Sprintf("neo4j.cdc.topic.%s.patterns", topic.Name)] = strings.Join(topic.Patterns, ",")
+	}
+	for key, value := range cdc.Spec.Connector.Config {
+		props[key] = value
+	}
+	return props
+}
+
+// formatProperties renders a Java properties file with sorted keys
+func formatProperties(props map[string]string) string {
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	escaper := strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s=%s\n", key, escaper.Replace(props[key]))
+	}
+	return b.String()
+}
+
+// BuildCDCConnectorService builds the Service of the Kafka Connect REST API,
+// which the operator reads the connector offsets from
+func BuildCDCConnectorService(cdc *neo4jv1alpha1.Neo4jCDC) *corev1.Service {
+	labels := cdcConnectorLabels(cdc)
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CDCConnectorName(cdc),
+			Namespace: cdc.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "rest",
+					Port:       CDCConnectorPort,
+					TargetPort: intstr.FromInt32(CDCConnectorPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+}
+
+// BuildCDCConnectorStatefulSet builds the single-replica Kafka Connect
+// worker. It runs in standalone mode with the offsets on a volume, so a
+// restarted worker resumes after the last change it published. The hash of
+// the ConfigMap restarts the worker when the properties change.
+func BuildCDCConnectorStatefulSet(cdc *neo4jv1alpha1.Neo4jCDC, configMap *corev1.ConfigMap, credentialsSecret string) *appsv1.StatefulSet {
+	connector := cdc.Spec.Connector
+	labels := cdcConnectorLabels(cdc)
+	replicas := int32(1)
+
+	secretEnv := func(name, secret, key string) corev1.EnvVar {
+		return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key,
+			},
+		}}
+	}
+	env := []corev1.EnvVar{
+		secretEnv("NEO4J_USERNAME", credentialsSecret, "username"),
+		secretEnv("NEO4J_PASSWORD", credentialsSecret, "password"),
+	}
+	if connector.Auth != nil {
+		env = append(env,
+			secretEnv("KAFKA_USERNAME", connector.Auth.SecretRef, "username"),
+			secretEnv("KAFKA_PASSWORD", connector.Auth.SecretRef, "password"))
+	}
+
+	container := corev1.Container{
+		Name:            cdcConnectorContainerName,
+		Image:           ImageReference(connector.Image),
+		ImagePullPolicy: ImagePullPolicy(connector.Image),
+		Command: []string{"/bin/sh", "-c", fmt.Sprintf("exec connect-standalone %s/%s %s/*%s",
+			cdcConnectorConfigPath, cdcWorkerPropertiesKey, cdcConnectorConfigPath, cdcConnectorKeySuffix)},
+		Env: env,
+		Ports: []corev1.ContainerPort{
+			{Name: "rest", ContainerPort: CDCConnectorPort, Protocol: corev1.ProtocolTCP},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{Path: "/connectors", Port: intstr.FromInt32(CDCConnectorPort)},
+			},
+			InitialDelaySeconds: 10,
+			PeriodSeconds:       10,
+			FailureThreshold:    6,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "config", MountPath: cdcConnectorConfigPath, ReadOnly: true},
+			{Name: "offsets", MountPath: cdcConnectorOffsetsPath},
+		},
+	}
+	if connector.Resources != nil {
+		container.Resources = *connector.Resources
+	}
+
+	storageSize := connector.OffsetStorageSize
+	if storageSize == "" {
+		storageSize = "1Gi"
+	}
+	fsGroup := cdcConnectorUID
+	onRootMismatch := corev1.FSGroupChangeOnRootMismatch
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CDCConnectorName(cdc),
+			Namespace: cdc.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: CDCConnectorName(cdc),
+			Selector:    &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+					Annotations: map[string]string{
+						"neo4j.neo4j.com/config-hash": cdcConfigHash(configMap),
+					},
+				},
+				Spec: corev1.PodSpec{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup:             &fsGroup,
+						FSGroupChangePolicy: &onRootMismatch,
+					},
+					ImagePullSecrets: ImagePullSecrets(connector.Image),
+					Containers:       []corev1.Container{container},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+								},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "offsets", Labels: labels},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse(storageSize),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func cdcConfigHash(configMap *corev1.ConfigMap) string {
+	keys := make([]string, 0, len(configMap.Data))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := sha256.New()
+	for _, key := range keys {
+		hasher.Write([]byte(key))
+		hasher.Write([]byte(configMap.Data[key]))
+	}
+	return hex.EncodeToString(hasher.Sum(nil))[:16]
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func cdcWithConnector() *neo4jv1alpha1.Neo4jCDC {
+	return &neo4jv1alpha1.Neo4jCDC{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "shop"},
+		Spec: neo4jv1alpha1.Neo4jCDCSpec{
+			ClusterRef: "prod",
+			Databases: []neo4jv1alpha1.CDCDatabase{
+				{
+					Name: "neo4j",
+					Topics: []neo4jv1alpha1.CDCTopic{
+						{Name: "people", Patterns: []string{"(:Person)", "(:Person)-[:KNOWS]->(:Person)"}},
+					},
+				},
+				{Name: "audit"},
+			},
+			Connector: &neo4jv1alpha1.CDCConnectorSpec{
+				Image:            neo4jv1alpha1.ImageSpec{Repo: "confluentinc/cp-kafka-connect", Tag: "7.7.1", Registry: "mirror.example.com"},
+				BootstrapServers: "kafka-0:9092,kafka-1:9092",
+				Auth:             &neo4jv1alpha1.CDCKafkaAuth{SecretRef: "kafka-user"},
+				Config:           map[string]string{"neo4j.cdc.poll-interval": "500ms"},
+			},
+		},
+	}
+}
+
+func TestBuildCDCConnectorConfigMap(t *testing.T) {
+	cdc := cdcWithConnector()
+
+	configMap := resources.BuildCDCConnectorConfigMap(cdc, "neo4j://prod-client.shop.svc.cluster.local:7687")
+
+	assert.Equal(t, "orders-connector", configMap.Name)
+	require.Len(t, configMap.Data, 2, "only databases with topics get a connector")
+
+	worker := configMap.Data["worker.properties"]
+	assert.Contains(t, worker, "bootstrap.servers=kafka-0:9092,kafka-1:9092\n")
+	assert.Contains(t, worker, "security.protocol=SASL_SSL\n")
+	assert.Contains(t, worker, "producer.sasl.mechanism=SCRAM-SHA-512\n")
+	assert.Contains(t, worker, `password="${env:KAFKA_PASSWORD}"`)
+
+	connector := configMap.Data["neo4j.connector.properties"]
+	assert.Contains(t, connector, "name=orders-neo4j\n")
+	assert.Contains(t, connector, "neo4j.uri=neo4j://prod-client.shop.svc.cluster.local:7687\n")
+	assert.Contains(t, connector, "neo4j.cdc.topic.people.patterns=(:Person),(:Person)-[:KNOWS]->(:Person)\n")
+	assert.Contains(t, connector, "neo4j.start-from=NOW\n")
+	assert.Contains(t, connector, "neo4j.cdc.poll-interval=500ms\n", "spec config overrides the defaults")
+	assert.NotContains(t, connector, "1s\n")
+}
+
+func TestBuildCDCConnectorStatefulSet(t *testing.T) {
+	cdc := cdcWithConnector()
+	configMap := resources.BuildCDCConnectorConfigMap(cdc, "neo4j://prod-client.shop.svc.cluster.local:7687")
+
+	statefulSet := resources.BuildCDCConnectorStatefulSet(cdc, configMap, "prod-admin")
+
+	require.Len(t, statefulSet.Spec.Template.Spec.Containers, 1)
+	container := statefulSet.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "mirror.example.com/confluentinc/cp-kafka-connect:7.7.1", container.Image)
+
+	secrets := map[string]string{}
+	for _, env := range container.Env {
+		secrets[env.Name] = env.ValueFrom.SecretKeyRef.Name
+	}
+	assert.Equal(t, map[string]string{
+		"NEO4J_USERNAME": "prod-admin",
+		"NEO4J_PASSWORD": "prod-admin",
+		"KAFKA_USERNAME": "kafka-user",
+		"KAFKA_PASSWORD": "kafka-user",
+	}, secrets)
+
+	require.Len(t, statefulSet.Spec.VolumeClaimTemplates, 1)
+	assert.Equal(t, "1Gi", statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String())
+
+	// Changing the properties restarts the worker
+	hash := statefulSet.Spec.Template.Annotations["neo4j.neo4j.com/config-hash"]
+	assert.NotEmpty(t, hash)
+	cdc.Spec.Databases[0].Topics[0].Patterns = []string{"(:Customer)"}
+	changed := resources.BuildCDCConnectorConfigMap(cdc, "neo4j://prod-client.shop.svc.cluster.local:7687")
+	assert.NotEqual(t, hash, resources.BuildCDCConnectorStatefulSet(cdc, changed, "prod-admin").Spec.Template.Annotations["neo4j.neo4j.com/config-hash"])
+}
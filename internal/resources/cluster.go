@@ -37,7 +37,7 @@ import (
 	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
-	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 const (
@@ -90,6 +90,7 @@ const (
 
 	// TLS modes
 	CertManagerMode = "cert-manager"
+	SecretTLSMode   = "secret"
 
 	// Default non-root UID/GID for Neo4j containers
 	defaultNeo4jUID int64 = 7474
@@ -247,6 +248,13 @@ func buildStatefulSetForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 		}
 	}
 
+	// During a rolling restart the operator deletes the pods itself, in an
+	// order that keeps database leaders running until last. An in-place
+	// resize relabels the resized pods instead.
+	if cluster.Status.RollingRestart != nil || InPlaceResizeInProgress(cluster) {
+		updateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	}
+
 	// Get labels but remove clustering label from StatefulSet
 	// Only pods should have the clustering label, not the StatefulSet itself
 	statefulSetLabels := getLabelsForEnterprise(cluster, serverName)
@@ -255,7 +263,7 @@ func buildStatefulSetForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 	// Add server-specific label
 	statefulSetLabels["neo4j.com/server-name"] = serverName
 
-	return &appsv1.StatefulSet{
+	sts := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-%s", cluster.Name, serverName),
 			Namespace: cluster.Namespace,
@@ -282,6 +290,13 @@ func buildStatefulSetForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 			VolumeClaimTemplates: buildVolumeClaimTemplatesForEnterprise(cluster),
 		},
 	}
+	applyConfigOverrides(sts, cluster, serverName)
+	applyClusterMTLS(sts, cluster, serverName)
+	applyLDAP(sts, cluster)
+	applyOIDC(sts, cluster)
+	applyKerberos(sts, cluster)
+	ApplySecurityProfiles(&sts.Spec.Template, cluster.Spec.SecurityContext)
+	return sts
 }
 
 // BuildHeadlessServiceForEnterprise creates a headless service for StatefulSet pod identity
@@ -296,13 +311,15 @@ func BuildHeadlessServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClu
 	selector := make(map[string]string)
 	selector["neo4j.com/cluster"] = cluster.Name
 
-	return &corev1.Service{
+	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-headless", cluster.Name),
 			Namespace: cluster.Namespace,
 			Labels:    labels,
 		},
 		Spec: corev1.ServiceSpec{
+			IPFamilyPolicy:           ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:               ServiceIPFamilies(cluster.Spec.Service),
 			ClusterIP:                "None",   // Headless service for StatefulSet
 			Selector:                 selector, // Use selector without service-type
 			PublishNotReadyAddresses: true,
@@ -358,6 +375,18 @@ func BuildHeadlessServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClu
 			},
 		},
 	}
+
+	// The ServiceMonitor scrapes every server through the headless service
+	if ServiceMonitorEnabled(cluster) {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name:       "metrics",
+			Port:       MetricsPort,
+			TargetPort: intstr.FromInt(MetricsPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	return svc
 }
 
 // BuildDiscoveryServiceForEnterprise creates a ClusterIP service specifically for Neo4j K8s discovery
@@ -388,6 +417,8 @@ func BuildDiscoveryServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCl
 			Labels:    labels,
 		},
 		Spec: corev1.ServiceSpec{
+			IPFamilyPolicy:           ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:               ServiceIPFamilies(cluster.Spec.Service),
 			Type:                     corev1.ServiceTypeClusterIP, // Regular ClusterIP service
 			Selector:                 selector,
 			PublishNotReadyAddresses: true, // Allow discovery during startup
@@ -421,6 +452,8 @@ func BuildInternalsServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCl
 			Labels:    labels,
 		},
 		Spec: corev1.ServiceSpec{
+			IPFamilyPolicy: ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:     ServiceIPFamilies(cluster.Spec.Service),
 			// Regular ClusterIP service (not headless) for discovery
 			// This follows Neo4j Helm chart pattern to avoid latency issues
 			Type: corev1.ServiceTypeClusterIP,
@@ -505,7 +538,7 @@ func BuildClientServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 	}
 
 	// Add HTTPS port if TLS is enabled
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(cluster.Spec.TLS) {
 		ports = append(ports, corev1.ServicePort{
 			Name:       "https",
 			Port:       HTTPSPort,
@@ -523,8 +556,7 @@ func BuildClientServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 	// Remove clustering label from client service
 	delete(labels, "neo4j.com/clustering")
 
-	selector := make(map[string]string)
-	selector["neo4j.com/cluster"] = cluster.Name
+	selector := clientServiceSelector(cluster)
 
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -534,9 +566,11 @@ func BuildClientServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 			Annotations: annotations,
 		},
 		Spec: corev1.ServiceSpec{
-			Type:     serviceType,
-			Selector: selector,
-			Ports:    ports,
+			IPFamilyPolicy: ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:     ServiceIPFamilies(cluster.Spec.Service),
+			Type:           serviceType,
+			Selector:       selector,
+			Ports:          ports,
 		},
 	}
 
@@ -546,9 +580,7 @@ func BuildClientServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 		if cluster.Spec.Service.LoadBalancerIP != "" {
 			svc.Spec.LoadBalancerIP = cluster.Spec.Service.LoadBalancerIP
 		}
-		if len(cluster.Spec.Service.LoadBalancerSourceRanges) > 0 {
-			svc.Spec.LoadBalancerSourceRanges = cluster.Spec.Service.LoadBalancerSourceRanges
-		}
+		svc.Spec.LoadBalancerSourceRanges = ServiceSourceRanges(cluster.Spec.Service)
 
 		// External traffic policy
 		if cluster.Spec.Service.ExternalTrafficPolicy != "" {
@@ -580,8 +612,10 @@ func BuildMetricsServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClus
 			Labels:    labels,
 		},
 		Spec: corev1.ServiceSpec{
-			Type:     corev1.ServiceTypeClusterIP,
-			Selector: selector,
+			IPFamilyPolicy: ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:     ServiceIPFamilies(cluster.Spec.Service),
+			Type:           corev1.ServiceTypeClusterIP,
+			Selector:       selector,
 			Ports: []corev1.ServicePort{
 				{
 					Name:       "metrics",
@@ -596,9 +630,16 @@ func BuildMetricsServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseClus
 
 // BuildConfigMapForEnterprise creates a ConfigMap with Neo4j configuration
 func BuildConfigMapForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.ConfigMap {
+	return BuildConfigMapWithLifecycleScripts(cluster, LifecycleScripts{})
+}
+
+// BuildConfigMapWithLifecycleScripts creates a ConfigMap with Neo4j
+// configuration, with the spec.lifecycle scripts merged into startup.sh and
+// health.sh
+func BuildConfigMapWithLifecycleScripts(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, scripts LifecycleScripts) *corev1.ConfigMap {
 	config := buildNeo4jConfigForEnterprise(cluster)
 
-	return &corev1.ConfigMap{
+	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-config", cluster.Name),
 			Namespace: cluster.Namespace,
@@ -606,10 +647,26 @@ func BuildConfigMapForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		},
 		Data: map[string]string{
 			"neo4j.conf": config,
-			"startup.sh": buildStartupScriptForEnterprise(cluster),
-			"health.sh":  buildHealthScript(cluster),
+			"startup.sh": buildStartupScriptForEnterprise(cluster, scripts),
+			"health.sh":  buildHealthScript(cluster, scripts),
 		},
 	}
+	for name, content := range buildKerberosConfigFiles(cluster) {
+		configMap.Data[name] = content
+	}
+	for name, content := range BuildLoggingConfigFiles(cluster.Spec.Logging) {
+		configMap.Data[name] = content
+	}
+	return configMap
+}
+
+// sslPolicyBlock renders one SSL policy of the cluster as a neo4j.conf block
+func sslPolicyBlock(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, scope string, trustAll bool) string {
+	lines := BuildSSLPolicyConfig(cluster.Name, cluster.Spec.TLS, scope, trustAll)
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
 }
 
 // BuildCertificateForEnterprise creates an enhanced Certificate for TLS
@@ -735,10 +792,49 @@ func BuildExternalSecretForAuth(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) m
 	return buildExternalSecret(cluster, cluster.Spec.Auth.ExternalSecrets, "auth")
 }
 
+// BuildAdminExternalSecret creates the ExternalSecret syncing the admin
+// credentials of spec.auth.externalSecret into AdminExternalSecretTarget
+func BuildAdminExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]interface{} {
+	if cluster.Spec.Auth == nil || cluster.Spec.Auth.ExternalSecret == nil {
+		return nil
+	}
+	spec := cluster.Spec.Auth.ExternalSecret
+	usernameProperty, passwordProperty := spec.UsernameProperty, spec.PasswordProperty
+	if usernameProperty == "" {
+		usernameProperty = "username"
+	}
+	if passwordProperty == "" {
+		passwordProperty = "password"
+	}
+	storeRef := spec.SecretStoreRef
+	return buildExternalSecret(cluster, &neo4jv1alpha1.ExternalSecretsConfig{
+		Enabled:         true,
+		SecretStoreRef:  &storeRef,
+		RefreshInterval: spec.RefreshInterval,
+		Data: []neo4jv1alpha1.ExternalSecretData{
+			{SecretKey: "username", RemoteRef: &neo4jv1alpha1.ExternalSecretRemoteRef{Key: spec.Key, Property: usernameProperty}},
+			{SecretKey: "password", RemoteRef: &neo4jv1alpha1.ExternalSecretRemoteRef{Key: spec.Key, Property: passwordProperty}},
+		},
+	}, "admin")
+}
+
+// AdminExternalSecretTarget returns the Secret the External Secrets Operator
+// writes the admin credentials of spec.auth.externalSecret to. The servers
+// never read it: the operator copies it into the admin Secret once Neo4j
+// uses the password.
+func AdminExternalSecretTarget(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return externalSecretTargetName(cluster, "admin")
+}
+
+// externalSecretTargetName returns the Secret an ExternalSecret writes to
+func externalSecretTargetName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, secretType string) string {
+	return fmt.Sprintf("%s-%s-secret", cluster.Name, secretType)
+}
+
 // buildExternalSecret is a helper function to create ExternalSecrets for both TLS and Auth
 func buildExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, esConfig *neo4jv1alpha1.ExternalSecretsConfig, secretType string) map[string]interface{} {
-	// Build data array
-	var data []map[string]interface{}
+	// Build data array, with the JSON types unstructured objects hold
+	var data []interface{}
 	for _, item := range esConfig.Data {
 		secretData := map[string]interface{}{
 			"secretKey": item.SecretKey,
@@ -775,7 +871,7 @@ func buildExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, esConfig
 		"metadata": map[string]interface{}{
 			"name":      fmt.Sprintf("%s-%s-external-secret", cluster.Name, secretType),
 			"namespace": cluster.Namespace,
-			"labels":    getLabelsForEnterprise(cluster, "external-secret"),
+			"labels":    stringMapToInterface(getLabelsForEnterprise(cluster, "external-secret")),
 		},
 		"spec": map[string]interface{}{
 			"secretStoreRef": map[string]interface{}{
@@ -783,7 +879,7 @@ func buildExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, esConfig
 				"kind": esConfig.SecretStoreRef.Kind,
 			},
 			"target": map[string]interface{}{
-				"name":           fmt.Sprintf("%s-%s-secret", cluster.Name, secretType),
+				"name":           externalSecretTargetName(cluster, secretType),
 				"creationPolicy": "Owner",
 			},
 			"refreshInterval": refreshInterval,
@@ -792,6 +888,16 @@ func buildExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, esConfig
 	}
 }
 
+// stringMapToInterface converts labels to the map type of unstructured
+// objects
+func stringMapToInterface(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		out[key] = value
+	}
+	return out
+}
+
 // BuildDiscoveryServiceAccountForEnterprise creates a ServiceAccount for Kubernetes discovery
 func BuildDiscoveryServiceAccountForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
@@ -879,8 +985,13 @@ func BuildIngressForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *n
 		return nil
 	}
 
-	ingressSpec := cluster.Spec.Service.Ingress
+	return buildIngressForService(cluster, fmt.Sprintf("%s-ingress", cluster.Name),
+		fmt.Sprintf("%s-client", cluster.Name), cluster.Spec.Service.Ingress)
+}
 
+// buildIngressForService creates an Ingress routing to the HTTP port of a
+// Service of the cluster
+func buildIngressForService(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, name, serviceName string, ingressSpec *neo4jv1alpha1.IngressSpec) *networkingv1.Ingress {
 	// Build TLS configuration
 	var tls []networkingv1.IngressTLS
 	if ingressSpec.TLSSecretName != "" {
@@ -899,7 +1010,7 @@ func BuildIngressForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *n
 			PathType: func() *networkingv1.PathType { pt := networkingv1.PathTypePrefix; return &pt }(),
 			Backend: networkingv1.IngressBackend{
 				Service: &networkingv1.IngressServiceBackend{
-					Name: fmt.Sprintf("%s-client", cluster.Name),
+					Name: serviceName,
 					Port: networkingv1.ServiceBackendPort{
 						Number: HTTPPort,
 					},
@@ -910,7 +1021,7 @@ func BuildIngressForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *n
 
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        fmt.Sprintf("%s-ingress", cluster.Name),
+			Name:        name,
 			Namespace:   cluster.Namespace,
 			Labels:      getLabelsForEnterprise(cluster, "ingress"),
 			Annotations: ingressSpec.Annotations,
@@ -947,6 +1058,7 @@ func getLabelsForEnterpriseServer(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster,
 		"neo4j.com/server-name":        serverName,
 		"neo4j.com/clustering":         "true", // Required for Neo4j discovery
 		"neo4j.com/service-type":       "internals",
+		RoutingLabel:                   RoutingEnabled, // Reset by the operator for servers in maintenance
 	}
 
 	// Note: cluster spec doesn't have Labels field in current API
@@ -997,7 +1109,7 @@ func buildCentralizedBackupStatefulSet(cluster *neo4jv1alpha1.Neo4jEnterpriseClu
 	labels := getLabelsForEnterprise(cluster, "backup")
 	labels["neo4j.com/component"] = "backup"
 
-	return &appsv1.StatefulSet{
+	sts := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-backup", cluster.Name),
 			Namespace: cluster.Namespace,
@@ -1027,6 +1139,8 @@ func buildCentralizedBackupStatefulSet(cluster *neo4jv1alpha1.Neo4jEnterpriseClu
 			VolumeClaimTemplates: buildBackupVolumeClaimTemplates(cluster),
 		},
 	}
+	ApplySecurityProfiles(&sts.Spec.Template, cluster.Spec.SecurityContext)
+	return sts
 }
 
 // buildCentralizedBackupPodSpec creates the pod spec for centralized backup
@@ -1154,8 +1268,8 @@ done`
 		Containers: []corev1.Container{
 			{
 				Name:            "backup",
-				Image:           fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag),
-				ImagePullPolicy: corev1.PullIfNotPresent,
+				Image:           ImageReference(cluster.Spec.Image),
+				ImagePullPolicy: ImagePullPolicy(cluster.Spec.Image),
 				Env:             env,
 				Resources:       resources,
 				Command:         []string{"/bin/bash", "-c"},
@@ -1327,19 +1441,27 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 	}
 
 	// Add TLS volume mount
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(cluster.Spec.TLS) {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      CertsVolume,
 			MountPath: "/ssl",
 			ReadOnly:  true,
 		})
+		_, policyMounts := BuildSSLPolicyVolumes(cluster.Name, cluster.Spec.TLS, SSLPolicyScopes)
+		volumeMounts = append(volumeMounts, policyMounts...)
+	}
+
+	// Pod labels carry the zone the server is tagged with
+	if ZoneSpreadEnabled(cluster) {
+		_, podInfoMount := buildPodInfoVolume()
+		volumeMounts = append(volumeMounts, podInfoMount)
 	}
 
 	// Build container
 	neo4jContainer := corev1.Container{
 		Name:            Neo4jContainer,
-		Image:           fmt.Sprintf("%s:%s", cluster.Spec.Image.Repo, cluster.Spec.Image.Tag),
-		ImagePullPolicy: corev1.PullPolicy(cluster.Spec.Image.PullPolicy),
+		Image:           ImageReference(cluster.Spec.Image),
+		ImagePullPolicy: ImagePullPolicy(cluster.Spec.Image),
 		Env:             env,
 		SecurityContext: containerSecurityContextForCluster(cluster),
 		VolumeMounts:    volumeMounts,
@@ -1400,7 +1522,7 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 		},
 	}
 
-	if cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled {
+	if MetricsEndpointEnabled(cluster) {
 		neo4jContainer.Ports = append(neo4jContainer.Ports, corev1.ContainerPort{
 			Name:          "metrics",
 			ContainerPort: MetricsPort,
@@ -1452,15 +1574,18 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 	}
 
 	// Add TLS volume
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(cluster.Spec.TLS) {
 		volumes = append(volumes, corev1.Volume{
-			Name: CertsVolume,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: fmt.Sprintf("%s-tls-secret", cluster.Name),
-				},
-			},
+			Name:         CertsVolume,
+			VolumeSource: TLSSecretVolumeSource(cluster.Spec.TLS, TLSSecretName(cluster.Name, cluster.Spec.TLS)),
 		})
+		policyVolumes, _ := BuildSSLPolicyVolumes(cluster.Name, cluster.Spec.TLS, SSLPolicyScopes)
+		volumes = append(volumes, policyVolumes...)
+	}
+
+	if ZoneSpreadEnabled(cluster) {
+		podInfo, _ := buildPodInfoVolume()
+		volumes = append(volumes, podInfo)
 	}
 
 	// Build pod spec - backup is now handled by centralized StatefulSet, not sidecars
@@ -1471,6 +1596,12 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 		Volumes:            volumes,
 	}
 
+	// The log forwarder follows the Neo4j container, which stays first
+	if LogForwarderEnabled(cluster.Spec.Logging) {
+		podSpec.Containers = append(podSpec.Containers, BuildLogForwarderContainer(cluster.Name, cluster.Spec.Logging,
+			ConfigVolume, LogsVolume, containerSecurityContextForCluster(cluster)))
+	}
+
 	// Add node selector if specified
 	if cluster.Spec.NodeSelector != nil {
 		podSpec.NodeSelector = cluster.Spec.NodeSelector
@@ -1486,8 +1617,13 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 		podSpec.Affinity = cluster.Spec.Affinity
 	}
 
+	// Spread the servers across availability zones
+	if ZoneSpreadEnabled(cluster) {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, buildZoneSpreadConstraint(cluster))
+	}
+
 	// Wire image pull secrets from cluster spec
-	if refs := clusterImagePullSecrets(cluster); len(refs) > 0 {
+	if refs := ImagePullSecrets(cluster.Spec.Image); len(refs) > 0 {
 		podSpec.ImagePullSecrets = refs
 	}
 
@@ -1498,21 +1634,6 @@ func BuildPodSpecForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, se
 	return podSpec
 }
 
-// clusterImagePullSecrets converts the cluster's image pull secret names to []corev1.LocalObjectReference.
-func clusterImagePullSecrets(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []corev1.LocalObjectReference {
-	if len(cluster.Spec.Image.PullSecrets) == 0 {
-		return nil
-	}
-	refs := make([]corev1.LocalObjectReference, 0, len(cluster.Spec.Image.PullSecrets))
-	for _, name := range cluster.Spec.Image.PullSecrets {
-		if name == "" {
-			continue
-		}
-		refs = append(refs, corev1.LocalObjectReference{Name: name})
-	}
-	return refs
-}
-
 func buildVolumeClaimTemplatesForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []corev1.PersistentVolumeClaim {
 	return []corev1.PersistentVolumeClaim{
 		{
@@ -1555,13 +1676,14 @@ func getServiceAccountNameForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCl
 func buildNeo4jConfigForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
 	// Calculate optimal memory settings for Neo4j 5.26+
 	memoryConfig := GetMemoryConfigForCluster(cluster)
+	svc := cluster.Spec.Service
 
 	config := fmt.Sprintf(`# Neo4j Enterprise Configuration (5.26+ / 2025.x.x)
 
 # Server settings
-server.default_listen_address=0.0.0.0
-server.bolt.listen_address=0.0.0.0:7687
-server.http.listen_address=0.0.0.0:7474
+server.default_listen_address=%s
+server.bolt.listen_address=%s
+server.http.listen_address=%s
 
 # Paths
 server.directories.data=/data
@@ -1591,14 +1713,18 @@ db.format=block
 # Port 5000: V2 discovery protocol (tcp-discovery)
 # Port 6000: Cluster catchup/transaction protocol (tcp-tx)
 # Port 7000: RAFT consensus (raft)
-server.cluster.listen_address=0.0.0.0:6000
-server.routing.listen_address=0.0.0.0:7688
-server.cluster.raft.listen_address=0.0.0.0:7000
-server.backup.enabled=true
-server.backup.listen_address=0.0.0.0:6362
+server.cluster.listen_address=%s
+server.routing.listen_address=%s
+server.cluster.raft.listen_address=%s
+server.backup.enabled=%t
+server.backup.listen_address=%s
 
 # Note: Single RAFT and cluster discovery settings are dynamically added by startup script
-`, memoryConfig.HeapInitialSize, memoryConfig.HeapMaxSize, memoryConfig.PageCacheSize)
+`, ListenHost(svc), ListenAddress(svc, BoltPort), ListenAddress(svc, HTTPPort),
+		memoryConfig.HeapInitialSize, memoryConfig.HeapMaxSize, memoryConfig.PageCacheSize,
+		ListenAddress(svc, DiscoveryPort), ListenAddress(svc, RoutingPort),
+		ListenAddress(svc, RaftPort), BackupPortEnabled(cluster), ListenAddress(svc, BackupPort))
+	config += IPFamilyJVMConfig(svc)
 
 	// NOTE: Property sharding configuration moved to end of config file
 
@@ -1623,11 +1749,11 @@ server.bolt.thread_pool_keep_alive=5m
 		calculatePerDatabaseLimit(memoryConfig.HeapMaxSize, cluster.Spec.Config))
 
 	// Add TLS configuration if enabled
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(cluster.Spec.TLS) {
 		config += `
 # TLS Configuration for Neo4j 5.26+
 server.https.enabled=true
-server.https.listen_address=0.0.0.0:7473
+server.https.listen_address=` + ListenAddress(svc, HTTPSPort) + `
 server.https.advertised_address=${HOSTNAME}:7473
 
 # SSL Policy Configuration
@@ -1635,41 +1761,34 @@ server.https.advertised_address=${HOSTNAME}:7473
 server.directories.certificates=/ssl
 
 # Bolt SSL Policy
-dbms.ssl.policy.bolt.enabled=true
-dbms.ssl.policy.bolt.base_directory=/ssl
-dbms.ssl.policy.bolt.private_key=tls.key
-dbms.ssl.policy.bolt.public_certificate=tls.crt
-dbms.ssl.policy.bolt.client_auth=NONE
-dbms.ssl.policy.bolt.tls_versions=TLSv1.3,TLSv1.2
-
+` + sslPolicyBlock(cluster, SSLPolicyBolt, false) + `
 # HTTPS SSL Policy
-dbms.ssl.policy.https.enabled=true
-dbms.ssl.policy.https.base_directory=/ssl
-dbms.ssl.policy.https.private_key=tls.key
-dbms.ssl.policy.https.public_certificate=tls.crt
-dbms.ssl.policy.https.client_auth=NONE
-dbms.ssl.policy.https.tls_versions=TLSv1.3,TLSv1.2
-
+` + sslPolicyBlock(cluster, SSLPolicyHTTPS, false) + `
 # Cluster SSL Policy (for intra-cluster communication)
 # CRITICAL: trust_all=true is required for reliable TLS cluster formation
 # This allows nodes to trust each other's certificates during initial handshake
-dbms.ssl.policy.cluster.enabled=true
-dbms.ssl.policy.cluster.base_directory=/ssl
-dbms.ssl.policy.cluster.private_key=tls.key
-dbms.ssl.policy.cluster.public_certificate=tls.crt
-dbms.ssl.policy.cluster.trust_all=true
-dbms.ssl.policy.cluster.client_auth=NONE
-dbms.ssl.policy.cluster.tls_versions=TLSv1.3,TLSv1.2
-
-# Enable TLS for connectors
+` + sslPolicyBlock(cluster, SSLPolicyCluster, true) + `
+`
+		if backup := sslPolicyBlock(cluster, SSLPolicyBackup, false); backup != "" {
+			config += `# Backup SSL Policy
+` + backup + `
+`
+		}
+		config += `# Enable TLS for connectors
 server.bolt.tls_level=OPTIONAL
 `
 	}
 
 	if cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled {
 		config += "\n# Query Monitoring and Metrics\n"
-		config += BuildQueryMonitoringConfig(cluster.Spec.QueryMonitoring)
+		config += BuildQueryMonitoringConfig(cluster.Spec.QueryMonitoring, svc)
+	} else if ServiceMonitorEnabled(cluster) {
+		config += "\n# Metrics\n"
+		config += BuildPrometheusMetricsConfig(svc) + "\n"
 	}
+	config += BuildLoggingConfig(cluster.Spec.Logging)
+
+	config += buildAuthProvidersConfig(cluster) + buildLDAPConfig(cluster) + buildOIDCConfig(cluster) + buildKerberosConfig(cluster)
 
 	// Add custom configuration (excluding memory settings already added above)
 	if cluster.Spec.Config != nil {
@@ -1726,7 +1845,8 @@ server.bolt.tls_level=OPTIONAL
 }
 
 // BuildQueryMonitoringConfig generates Neo4j config lines for query monitoring and metrics exposure.
-func BuildQueryMonitoringConfig(queryMonitoring *neo4jv1alpha1.QueryMonitoringSpec) string {
+// The Prometheus endpoint binds to the wildcard address of the service's IP families.
+func BuildQueryMonitoringConfig(queryMonitoring *neo4jv1alpha1.QueryMonitoringSpec, service *neo4jv1alpha1.ServiceSpec) string {
 	slowThreshold := "5s"
 	explainPlan := true
 	indexRecommendations := true
@@ -1739,14 +1859,11 @@ func BuildQueryMonitoringConfig(queryMonitoring *neo4jv1alpha1.QueryMonitoringSp
 	}
 
 	lines := []string{
-		"# Prometheus metrics exposure",
-		"server.metrics.prometheus.enabled=true",
-		fmt.Sprintf("server.metrics.prometheus.endpoint=0.0.0.0:%d", MetricsPort),
+		BuildPrometheusMetricsConfig(service),
 		"",
 		"# Query logging defaults",
 		"db.logs.query.enabled=INFO",
-		"db.logs.query.threshold=1s",
-		fmt.Sprintf("db.logs.query.slow_threshold=%s", slowThreshold),
+		fmt.Sprintf("db.logs.query.threshold=%s", slowThreshold),
 		fmt.Sprintf("db.logs.query.plan_description_enabled=%t", explainPlan),
 		"db.logs.query.parameter_logging_enabled=true",
 		fmt.Sprintf("dbms.index.recommendations.enabled=%t", indexRecommendations),
@@ -1756,31 +1873,22 @@ func BuildQueryMonitoringConfig(queryMonitoring *neo4jv1alpha1.QueryMonitoringSp
 	return strings.Join(lines, "\n")
 }
 
-// isNeo4jVersion526OrHigher checks if the Neo4j image is the 5.26.x semver LTS release.
-// Neo4j moved to CalVer (2025.x.x) after 5.26 — no 5.27+ semver versions exist.
-// CalVer images are handled separately via version.IsCalver checks.
-func isNeo4jVersion526OrHigher(imageTag string) bool {
-	return strings.Contains(imageTag, "5.26")
+// BuildPrometheusMetricsConfig generates the Neo4j config lines exposing the
+// Prometheus endpoint on the wildcard address of the service's IP families.
+func BuildPrometheusMetricsConfig(service *neo4jv1alpha1.ServiceSpec) string {
+	return strings.Join([]string{
+		"# Prometheus metrics exposure",
+		"server.metrics.prometheus.enabled=true",
+		"server.metrics.prometheus.endpoint=" + ListenAddress(service, MetricsPort),
+	}, "\n")
 }
 
 // IsNeo4jVersion202512OrHigher checks if the Neo4j version supports property sharding.
 // Property sharding (Infinigraph) was introduced in 2025.12; calver only — no semver version supports it.
 // See: https://neo4j.com/docs/operations-manual/current/scalability/sharded-property-databases/overview/
 func IsNeo4jVersion202512OrHigher(imageTag string) bool {
-	if imageTag == "" {
-		return false
-	}
-
-	version, err := neo4j.ParseVersion(imageTag)
-	if err != nil || !version.IsCalver {
-		return false
-	}
-
-	if version.Major > 2025 {
-		return true
-	}
-
-	return version.Major == 2025 && version.Minor >= 12
+	v, err := version.Parse(imageTag)
+	return err == nil && v.SupportsPropertySharding()
 }
 
 // IsNeo4jVersion202510OrHigher is a backwards-compat alias kept for callers that have not
@@ -1808,7 +1916,7 @@ func buildPropertyShardingConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 	return config
 }
 
-func buildStartupScriptForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+func buildStartupScriptForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, scripts LifecycleScripts) string {
 	// Unified startup script for all deployments
 	return `#!/bin/bash
 set -e
@@ -1869,7 +1977,8 @@ echo "Multi-server cluster: using LIST discovery with static pod FQDNs"
 # with static pod FQDNs (via the headless service DNS) and minimum_initial_system_primaries_count
 # set to TOTAL_SERVERS ensures all servers discover each other before RAFT election.
 # Server-0 (me) is preferred bootstrapper; all others (other) join when ready.
-if [ "$SERVER_INDEX" = "0" ]; then
+# Servers of a server group always join.
+if [ "$SERVER_INDEX" = "0" ] && [ "${NEO4J_SERVER_NAME}" = "server" ]; then
     echo "Server 0: Using bootstrapping strategy 'me' (preferred cluster bootstrapper)"
     BOOTSTRAP_STRATEGY="me"
 else
@@ -1898,7 +2007,8 @@ else
 fi
 
 # Add server mode constraint if specified
-` + buildServerModeConstraintConfig(cluster) + `
+` + buildServerPoolConfig(cluster) + `
+` + buildServerTagsConfig(cluster) + buildServerTagsWriter(cluster) + buildClusterMTLSLink(cluster) + buildLDAPStartup(cluster) + buildOIDCStartup(cluster) + buildKerberosStartup(cluster) + buildConfigOverridesMerge(cluster) + buildPreStartHook(scripts) + buildPostStartReset(scripts) + `
 
 # Set NEO4J config directory
 export NEO4J_CONF=/tmp/neo4j-config
@@ -1952,15 +2062,14 @@ EOF
 	return config
 }
 
-// isCalverImage returns true when the image tag is a CalVer release (2025.x+).
-// Uses proper version parsing rather than a simple string prefix so it remains
-// correct for future CalVer years (2026.x, 2027.x, …).
-func isCalverImage(tag string) bool {
-	v, err := neo4j.ParseVersion(tag)
+// imageVersion returns the version of the cluster image. Tags that do not
+// parse, like latest, are treated as the 5.26.x LTS release.
+func imageVersion(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *version.Version {
+	v, err := version.Parse(cluster.Spec.Image.Tag)
 	if err != nil {
-		return false
+		return &version.Version{Major: 5, Minor: 26, Raw: cluster.Spec.Image.Tag}
 	}
-	return v.IsCalver
+	return v
 }
 
 // buildVersionSpecificDiscoveryConfig generates the full discovery block for neo4j.conf.
@@ -1984,7 +2093,7 @@ func isCalverImage(tag string) bool {
 //   - dbms.cluster.endpoints=<pod-fqdns>:6000    ← renamed from dbms.cluster.discovery.v2.endpoints
 //   - NO dbms.cluster.discovery.version flag     ← not recognised; V2 is always active
 func buildVersionSpecificDiscoveryConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
-	calver := isCalverImage(cluster.Spec.Image.Tag)
+	v := imageVersion(cluster)
 
 	addrs := make([]string, cluster.Spec.Topology.Servers)
 	for i := int32(0); i < cluster.Spec.Topology.Servers; i++ {
@@ -1993,14 +2102,14 @@ func buildVersionSpecificDiscoveryConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseC
 	}
 	endpointList := strings.Join(addrs, ",")
 
-	if calver {
+	if !v.RequiresDiscoveryVersion() {
 		// CalVer 2025.x+: per the official Neo4j clustering docs, LIST discovery requires
 		// BOTH resolver_type=LIST AND dbms.cluster.endpoints (the renamed v2.endpoints).
 		// V2 is the only supported protocol; dbms.cluster.discovery.version is not needed.
 		// See: https://neo4j.com/docs/operations-manual/current/clustering/setup/discovery/
 		return `# CalVer (2025.x+): LIST discovery — resolver_type + dbms.cluster.endpoints
 dbms.cluster.discovery.resolver_type=LIST
-dbms.cluster.endpoints=` + endpointList + `
+` + v.DiscoveryEndpointsSetting() + `=` + endpointList + `
 dbms.routing.default_router=SERVER
 initial.dbms.automatically_enable_free_servers=true`
 	}
@@ -2011,7 +2120,7 @@ initial.dbms.automatically_enable_free_servers=true`
 	return `# SemVer 5.26.x: LIST discovery with explicit V2_ONLY mode
 dbms.cluster.discovery.resolver_type=LIST
 dbms.cluster.discovery.version=V2_ONLY
-dbms.cluster.discovery.v2.endpoints=` + endpointList + `
+` + v.DiscoveryEndpointsSetting() + `=` + endpointList + `
 
 # Bootstrapping strategy: server-0 (me) bootstraps; all others (other) join.
 internal.dbms.cluster.discovery.system_bootstrapping_strategy=${BOOTSTRAP_STRATEGY}
@@ -2027,14 +2136,10 @@ dbms.routing.default_router=SERVER
 internal.dbms.cluster.discovery.resolution_timeout=1d`
 }
 
-// getMinInitialPrimariesSetting returns the correct config key for the
-// "minimum primaries before bootstrap" guard, which differs between versions.
+// getMinInitialPrimariesSetting returns the config key for the "minimum
+// primaries before bootstrap" guard in the cluster image version.
 func getMinInitialPrimariesSetting(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
-	if isCalverImage(cluster.Spec.Image.Tag) {
-		// 2025.x renamed the setting (same semantics, new namespace)
-		return "dbms.cluster.minimum_initial_system_primaries_count"
-	}
-	return "dbms.cluster.minimum_initial_system_primaries_count"
+	return imageVersion(cluster).SettingName("dbms.cluster.minimum_initial_system_primaries_count")
 }
 
 // ValidateServerRoleHints validates server role hints configuration
@@ -2093,11 +2198,11 @@ func ValidateServerRoleHints(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []st
 	return errors
 }
 
-func buildHealthScript(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+func buildHealthScript(_ *neo4jv1alpha1.Neo4jEnterpriseCluster, scripts LifecycleScripts) string {
 	// Enhanced health check for cluster deployments
 	return `#!/bin/bash
 # Health check script for Neo4j clustering
-
+` + buildPostStartFunction(scripts) + `
 # Check if Neo4j process is running
 if ! (pgrep -f "EnterpriseEntryPoint" > /dev/null || pgrep -f "Neo4jEnterprise" > /dev/null); then
     echo "Neo4j process not running"
@@ -2107,7 +2212,7 @@ fi
 # Try HTTP port check
 if (echo > /dev/tcp/localhost/7474) >/dev/null 2>&1; then
     echo "Neo4j HTTP port responding - healthy"
-    exit 0
+` + buildPostStartHook(scripts) + `    exit 0
 fi
 
 # If HTTP not responding, check if we're in cluster formation process
@@ -2127,8 +2232,8 @@ exit 1
 }
 
 // buildReadinessProbe creates a readiness probe
-func buildReadinessProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
-	return &corev1.Probe{
+func buildReadinessProbe(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
+	probe := &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			Exec: &corev1.ExecAction{
 				Command: []string{
@@ -2138,16 +2243,18 @@ func buildReadinessProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe
 				},
 			},
 		},
-		InitialDelaySeconds: 45, // Allow time for cluster discovery and joining
-		PeriodSeconds:       15, // Less frequent checks during startup
-		TimeoutSeconds:      5,
-		FailureThreshold:    8, // Allow up to 2 minutes after initial delay for cluster rejoin scenarios
 	}
+	var timing *neo4jv1alpha1.ProbeTimingSpec
+	if cluster.Spec.Probes != nil {
+		timing = cluster.Spec.Probes.Readiness
+	}
+	setProbeTimings(probe, probeTimings(timing, readinessProbeDefaults))
+	return probe
 }
 
 // buildLivenessProbe creates a liveness probe
-func buildLivenessProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
-	return &corev1.Probe{
+func buildLivenessProbe(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
+	probe := &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			Exec: &corev1.ExecAction{
 				Command: []string{
@@ -2157,11 +2264,13 @@ func buildLivenessProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
 				},
 			},
 		},
-		InitialDelaySeconds: 120, // Allow sufficient time for joining pods to connect
-		PeriodSeconds:       60,  // Less frequent checks to avoid interrupting cluster operations
-		TimeoutSeconds:      10,
-		FailureThreshold:    3,
 	}
+	var timing *neo4jv1alpha1.ProbeTimingSpec
+	if cluster.Spec.Probes != nil {
+		timing = cluster.Spec.Probes.Liveness
+	}
+	setProbeTimings(probe, probeTimings(timing, livenessProbeDefaults))
+	return probe
 }
 
 // buildJVMSettings builds optimized JVM settings for Neo4j
@@ -2219,8 +2328,8 @@ func buildJVMSettings(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
 }
 
 // buildStartupProbe creates a startup probe for initial cluster formation
-func buildStartupProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
-	return &corev1.Probe{
+func buildStartupProbe(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
+	probe := &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			Exec: &corev1.ExecAction{
 				Command: []string{
@@ -2230,12 +2339,14 @@ func buildStartupProbe(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Probe {
 				},
 			},
 		},
-		InitialDelaySeconds: 30, // Start checking after 30 seconds
-		PeriodSeconds:       10, // Check every 10 seconds during startup
-		TimeoutSeconds:      5,
-		FailureThreshold:    60, // Allow up to 10 minutes for startup (60 * 10s)
-		SuccessThreshold:    1,
+		SuccessThreshold: 1,
+	}
+	var timing *neo4jv1alpha1.ProbeTimingSpec
+	if cluster.Spec.Probes != nil {
+		timing = cluster.Spec.Probes.Startup
 	}
+	setProbeTimings(probe, probeTimings(timing, startupProbeDefaults))
+	return probe
 }
 
 // calculateTransactionMemoryLimit calculates the global transaction memory limit
@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ClusterMTLSRequired is the spec.tls.cluster.mTLS value that gives every
+// server a certificate of its own
+const ClusterMTLSRequired = "required"
+
+// ServerCertificateLabel carries the name of the pod a server certificate,
+// and the Secret cert-manager stores it in, belongs to
+const ServerCertificateLabel = "neo4j.com/server-certificate"
+
+// ServerCertificatesHashAnnotation is the hash of the server certificates of
+// the pods of a StatefulSet, so that renewed certificates roll its pods
+const ServerCertificatesHashAnnotation = "neo4j.neo4j.com/server-certificates-hash"
+
+const (
+	serverCertsVolume    = "server-certs"
+	serverCertsDirectory = "/ssl-server"
+	// clusterMTLSDirectory links to the directory of the pod's own
+	// certificate, which is only known once the pod runs
+	clusterMTLSDirectory = "/tmp/ssl-cluster"
+)
+
+// ClusterMTLSEnabled reports whether the servers authenticate each other
+// with certificates of their own
+func ClusterMTLSEnabled(tls *neo4jv1alpha1.TLSSpec) bool {
+	return tls != nil && tls.Mode == CertManagerMode && tls.Cluster != nil && tls.Cluster.MTLS == ClusterMTLSRequired
+}
+
+// ServerPodNames returns the names of the pods of a server pool
+func ServerPodNames(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) []string {
+	var pods []string
+	for ordinal := int32(0); ordinal < poolServers(cluster, pool); ordinal++ {
+		pods = append(pods, fmt.Sprintf("%s-%s-%d", cluster.Name, pool, ordinal))
+	}
+	return pods
+}
+
+// ServerCertificateName returns the name of the Certificate of a server pod
+func ServerCertificateName(pod string) string {
+	return pod + "-cluster-tls"
+}
+
+// ServerCertificateSecretName returns the Secret holding the certificate of
+// a server pod
+func ServerCertificateSecretName(pod string) string {
+	return pod + "-cluster-tls-secret"
+}
+
+// BuildServerCertificates returns a Certificate for every server pod when
+// spec.tls.cluster.mTLS is required. Each covers the DNS names of its pod
+// only, is issued by the issuer of the cluster SSL policy when it has one,
+// and can authenticate both ends of a connection.
+func BuildServerCertificates(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []*certv1.Certificate {
+	if !ClusterMTLSEnabled(cluster.Spec.TLS) {
+		return nil
+	}
+	base := BuildCertificateForEnterprise(cluster)
+	if base == nil {
+		return nil
+	}
+	if policy := SSLPolicy(cluster.Spec.TLS, SSLPolicyCluster); policy != nil && policy.IssuerRef != nil {
+		base.Spec.IssuerRef = cmmeta.ObjectReference{
+			Name:  policy.IssuerRef.Name,
+			Kind:  policy.IssuerRef.Kind,
+			Group: policy.IssuerRef.Group,
+		}
+	}
+	for _, usage := range []certv1.KeyUsage{certv1.UsageServerAuth, certv1.UsageClientAuth} {
+		if !slices.Contains(base.Spec.Usages, usage) {
+			base.Spec.Usages = append(base.Spec.Usages, usage)
+		}
+	}
+
+	var certificates []*certv1.Certificate
+	for _, pool := range ServerPoolNames(cluster) {
+		for _, pod := range ServerPodNames(cluster, pool) {
+			certificate := base.DeepCopy()
+			certificate.Name = ServerCertificateName(pod)
+			certificate.Labels = getLabelsForEnterprise(cluster, "tls")
+			certificate.Labels[ServerCertificateLabel] = pod
+			certificate.Spec.SecretName = ServerCertificateSecretName(pod)
+			certificate.Spec.SecretTemplate = &certv1.CertificateSecretTemplate{
+				Labels: map[string]string{
+					"neo4j.com/cluster":    cluster.Name,
+					ServerCertificateLabel: pod,
+				},
+			}
+			certificate.Spec.CommonName = fmt.Sprintf("%s.%s-headless.%s.svc.cluster.local", pod, cluster.Name, cluster.Namespace)
+			certificate.Spec.DNSNames = serverPodDNSNames(cluster, pod)
+			certificates = append(certificates, certificate)
+		}
+	}
+	return certificates
+}
+
+// serverPodDNSNames returns the names a server pod is reached by, through
+// the headless and the internals Service
+func serverPodDNSNames(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pod string) []string {
+	names := []string{pod}
+	for _, service := range []string{cluster.Name + "-headless", cluster.Name + "-internals"} {
+		names = append(names,
+			fmt.Sprintf("%s.%s", pod, service),
+			fmt.Sprintf("%s.%s.%s", pod, service, cluster.Namespace),
+			fmt.Sprintf("%s.%s.%s.svc", pod, service, cluster.Namespace),
+			fmt.Sprintf("%s.%s.%s.svc.cluster.local", pod, service, cluster.Namespace),
+		)
+	}
+	return names
+}
+
+// applyClusterMTLS mounts the server certificates of the pods of a
+// StatefulSet, each in a directory named after its pod. A StatefulSet has a
+// single pod template, so every pod mounts the certificates of its siblings
+// as well; the startup script links the cluster SSL policy to its own.
+func applyClusterMTLS(sts *appsv1.StatefulSet, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) {
+	if !ClusterMTLSEnabled(cluster.Spec.TLS) {
+		return
+	}
+
+	var sources []corev1.VolumeProjection
+	for _, pod := range ServerPodNames(cluster, pool) {
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ServerCertificateSecretName(pod)},
+				Items: []corev1.KeyToPath{
+					{Key: corev1.TLSCertKey, Path: pod + "/tls.crt"},
+					{Key: corev1.TLSPrivateKeyKey, Path: pod + "/tls.key"},
+					{Key: "ca.crt", Path: pod + "/trusted/ca.crt"},
+				},
+			},
+		})
+	}
+	if len(sources) == 0 {
+		return
+	}
+
+	podSpec := &sts.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         serverCertsVolume,
+		VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: sources}},
+	})
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == Neo4jContainer {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      serverCertsVolume,
+				MountPath: serverCertsDirectory,
+				ReadOnly:  true,
+			})
+		}
+	}
+}
+
+// buildClusterMTLSLink points the cluster SSL policy at the certificate of
+// the pod
+func buildClusterMTLSLink(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !ClusterMTLSEnabled(cluster.Spec.TLS) {
+		return ""
+	}
+	return `
+# Cluster mTLS: the cluster SSL policy uses the certificate of this pod
+ln -sfn "` + serverCertsDirectory + `/${HOSTNAME_FQDN%%.*}" ` + clusterMTLSDirectory + `
+`
+}
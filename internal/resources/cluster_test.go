@@ -1038,3 +1038,77 @@ func TestBuildPodSpecForEnterprise_WithNoPullSecrets(t *testing.T) {
 
 	assert.Empty(t, podSpec.ImagePullSecrets)
 }
+
+func TestBuildPodSpecForEnterprise_WithRegistryMirror(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image: neo4jv1alpha1.ImageSpec{
+				Repo:        "neo4j",
+				Tag:         "5.26-enterprise",
+				Registry:    "mirror.example.com/dockerhub",
+				PullPolicy:  "Always",
+				PullSecrets: []string{"mirror-secret"},
+			},
+			Topology: neo4jv1alpha1.TopologyConfiguration{
+				Servers: 3,
+			},
+			Storage: neo4jv1alpha1.StorageSpec{
+				ClassName: "fast-ssd",
+				Size:      "10Gi",
+			},
+		},
+	}
+
+	podSpec := resources.BuildPodSpecForEnterprise(cluster, "server", "neo4j-admin-secret")
+
+	require.NotEmpty(t, podSpec.Containers)
+	assert.Equal(t, "mirror.example.com/dockerhub/library/neo4j:5.26-enterprise", podSpec.Containers[0].Image)
+	assert.Equal(t, corev1.PullAlways, podSpec.Containers[0].ImagePullPolicy)
+	require.Len(t, podSpec.ImagePullSecrets, 1)
+	assert.Equal(t, "mirror-secret", podSpec.ImagePullSecrets[0].Name)
+}
+
+func TestBuildServerStatefulSetForEnterprise_ZoneSpread(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{
+				Servers:    3,
+				ZoneSpread: &neo4jv1alpha1.ZoneSpreadSpec{Enabled: true},
+			},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+
+	sts := resources.BuildServerStatefulSetForEnterprise(cluster)
+	podSpec := sts.Spec.Template.Spec
+	require.Len(t, podSpec.TopologySpreadConstraints, 1)
+	constraint := podSpec.TopologySpreadConstraints[0]
+	assert.Equal(t, "topology.kubernetes.io/zone", constraint.TopologyKey)
+	assert.Equal(t, int32(1), constraint.MaxSkew)
+	assert.Equal(t, corev1.DoNotSchedule, constraint.WhenUnsatisfiable)
+	require.NotNil(t, constraint.MinDomains)
+	assert.Equal(t, int32(3), *constraint.MinDomains)
+	assert.Equal(t, "prod", constraint.LabelSelector.MatchLabels["neo4j.com/cluster"])
+
+	var podInfo *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == "podinfo" {
+			podInfo = &podSpec.Volumes[i]
+		}
+	}
+	require.NotNil(t, podInfo, "the startup script reads the zone label from the downward API")
+	assert.Equal(t, "metadata.labels", podInfo.DownwardAPI.Items[0].FieldRef.FieldPath)
+
+	startupScript := resources.BuildConfigMapForEnterprise(cluster).Data["startup.sh"]
+	assert.Contains(t, startupScript, `SERVER_TAGS="${SERVER_TAGS:+${SERVER_TAGS},}${SERVER_ZONE}"`)
+	assert.Contains(t, startupScript, "initial.server.tags=${SERVER_TAGS}")
+	assert.Contains(t, startupScript, "initial.dbms.default_primaries_count=3")
+
+	// Without zone spread nothing changes
+	cluster.Spec.Topology.ZoneSpread = nil
+	sts = resources.BuildServerStatefulSetForEnterprise(cluster)
+	assert.Empty(t, sts.Spec.Template.Spec.TopologySpreadConstraints)
+	assert.NotContains(t, resources.BuildConfigMapForEnterprise(cluster).Data["startup.sh"], "initial.server.tags")
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 )
@@ -111,3 +113,206 @@ func TestBuildStatefulSetForEnterprise_TLSClusterFormation(t *testing.T) {
 	assert.Equal(t, serverSts.Spec.PodManagementPolicy, appsv1.ParallelPodManagement,
 		"TLS clusters must use ParallelPodManagement for reliable formation")
 }
+
+func TestBuildConfigMapForEnterprise_SSLPolicies(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			TLS: &neo4jv1alpha1.TLSSpec{
+				Mode:      "cert-manager",
+				IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "internal-ca", Kind: "ClusterIssuer"},
+				Policies: &neo4jv1alpha1.SSLPolicies{
+					Bolt: &neo4jv1alpha1.SSLPolicySpec{
+						IssuerRef:   &neo4jv1alpha1.IssuerRef{Name: "public-ca", Kind: "ClusterIssuer"},
+						TLSVersions: []string{"TLSv1.3"},
+						Ciphers:     []string{"TLS_AES_256_GCM_SHA384", "TLS_AES_128_GCM_SHA256"},
+					},
+					Backup: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "backup-tls", ClientAuth: "REQUIRE"},
+				},
+			},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+
+	neo4jConf := resources.BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]
+
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.bolt.base_directory=/ssl-bolt\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.bolt.tls_versions=TLSv1.3\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.bolt.ciphers=TLS_AES_256_GCM_SHA384,TLS_AES_128_GCM_SHA256\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.backup.base_directory=/ssl-backup\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.backup.client_auth=REQUIRE\n")
+	// Scopes without a policy keep the shared certificate and defaults
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.cluster.base_directory=/ssl\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.cluster.trust_all=true\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.https.tls_versions=TLSv1.3,TLSv1.2\n")
+
+	podSpec := resources.BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Spec
+	secrets := map[string]string{}
+	for _, volume := range podSpec.Volumes {
+		if volume.Secret != nil {
+			secrets[volume.Name] = volume.Secret.SecretName
+		}
+	}
+	assert.Equal(t, "tls-cluster-bolt-tls-secret", secrets["certs-bolt"])
+	assert.Equal(t, "backup-tls", secrets["certs-backup"])
+
+	certificates := resources.BuildSSLPolicyCertificates(resources.BuildCertificateForEnterprise(cluster), cluster.Name, cluster.Spec.TLS, resources.SSLPolicyScopes)
+	require.Len(t, certificates, 1, "only scopes with their own issuer get a Certificate")
+	assert.Equal(t, "tls-cluster-bolt-tls", certificates[0].Name)
+	assert.Equal(t, "public-ca", certificates[0].Spec.IssuerRef.Name)
+	assert.Contains(t, certificates[0].Spec.DNSNames, "tls-cluster-client.default.svc.cluster.local")
+}
+
+func TestBuildStatefulSetForEnterprise_SecretTLSMode(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			TLS: &neo4jv1alpha1.TLSSpec{
+				Mode:              "secret",
+				CertificateSecret: "neo4j-tls",
+				Policies: &neo4jv1alpha1.SSLPolicies{
+					Bolt: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "bolt-tls", ClientAuth: "REQUIRE"},
+				},
+			},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+
+	// No Certificate is issued, but the servers serve TLS like in cert-manager mode
+	assert.Nil(t, resources.BuildCertificateForEnterprise(cluster))
+	neo4jConf := resources.BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]
+	assert.Contains(t, neo4jConf, "server.https.enabled=true\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.bolt.base_directory=/ssl-bolt\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.https.base_directory=/ssl\n")
+	assert.Equal(t, []string{"bolt-tls", "neo4j-tls"}, resources.SuppliedTLSSecretNames(cluster.Spec.TLS, resources.SSLPolicyScopes))
+
+	podSpec := resources.BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Spec
+	sources := map[string][]corev1.VolumeProjection{}
+	for _, volume := range podSpec.Volumes {
+		if volume.Projected != nil {
+			sources[volume.Name] = volume.Projected.Sources
+		}
+	}
+	require.Contains(t, sources, resources.CertsVolume)
+	require.Contains(t, sources, resources.CertsVolume+"-bolt")
+
+	// The CA of the Secret, if any, becomes the trusted directory of the policy
+	shared := sources[resources.CertsVolume]
+	require.Len(t, shared, 2)
+	assert.Equal(t, "neo4j-tls", shared[0].Secret.Name)
+	assert.Nil(t, shared[0].Secret.Optional)
+	assert.Equal(t, "neo4j-tls", shared[1].Secret.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "ca.crt", Path: "trusted/ca.crt"}}, shared[1].Secret.Items)
+	require.NotNil(t, shared[1].Secret.Optional)
+	assert.True(t, *shared[1].Secret.Optional)
+	assert.Equal(t, "bolt-tls", sources[resources.CertsVolume+"-bolt"][0].Secret.Name)
+}
+
+func TestBuildConfigMapForEnterprise_SSLPolicyTrust(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			TLS: &neo4jv1alpha1.TLSSpec{
+				Mode:      "cert-manager",
+				IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "internal-ca", Kind: "ClusterIssuer"},
+				Policies: &neo4jv1alpha1.SSLPolicies{
+					Cluster: &neo4jv1alpha1.SSLPolicySpec{Trust: "CA", ClientAuth: "REQUIRE"},
+					HTTPS:   &neo4jv1alpha1.SSLPolicySpec{Trust: "TrustAll"},
+				},
+			},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+
+	neo4jConf := resources.BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]
+	assert.NotContains(t, neo4jConf, "dbms.ssl.policy.cluster.trust_all")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.cluster.client_auth=REQUIRE\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.https.trust_all=true\n")
+	assert.NotContains(t, neo4jConf, "dbms.ssl.policy.bolt.trust_all")
+
+	// CA trust needs the CA in the trusted directory of the shared certificate
+	var certs *corev1.Volume
+	for _, volume := range resources.BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Spec.Volumes {
+		if volume.Name == resources.CertsVolume {
+			certs = &volume
+		}
+	}
+	require.NotNil(t, certs)
+	require.NotNil(t, certs.Projected)
+	require.Len(t, certs.Projected.Sources, 2)
+	assert.Equal(t, "tls-cluster-tls-secret", certs.Projected.Sources[1].Secret.Name)
+	assert.Equal(t, "trusted/ca.crt", certs.Projected.Sources[1].Secret.Items[0].Path)
+}
+
+func TestBuildServerCertificates_ClusterMTLS(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{
+				Servers:      2,
+				ServerGroups: []neo4jv1alpha1.ServerGroupSpec{{Name: "analytics", Servers: 1}},
+			},
+			TLS: &neo4jv1alpha1.TLSSpec{
+				Mode:      "cert-manager",
+				IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "ca-issuer", Kind: "ClusterIssuer"},
+				Cluster:   &neo4jv1alpha1.ClusterTLSSpec{MTLS: "required"},
+				Usages:    []string{"digital signature"},
+				Policies: &neo4jv1alpha1.SSLPolicies{
+					Cluster: &neo4jv1alpha1.SSLPolicySpec{IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "cluster-ca", Kind: "Issuer"}},
+				},
+			},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+
+	certificates := resources.BuildServerCertificates(cluster)
+	require.Len(t, certificates, 3)
+	var names []string
+	for _, certificate := range certificates {
+		names = append(names, certificate.Name)
+	}
+	assert.Equal(t, []string{"tls-cluster-server-0-cluster-tls", "tls-cluster-server-1-cluster-tls", "tls-cluster-analytics-0-cluster-tls"}, names)
+
+	// Each certificate covers its own pod, issued by the cluster policy issuer
+	server0 := certificates[0]
+	assert.Equal(t, "tls-cluster-server-0-cluster-tls-secret", server0.Spec.SecretName)
+	assert.Equal(t, "tls-cluster-server-0.tls-cluster-headless.default.svc.cluster.local", server0.Spec.CommonName)
+	assert.Contains(t, server0.Spec.DNSNames, "tls-cluster-server-0.tls-cluster-internals.default.svc")
+	assert.NotContains(t, server0.Spec.DNSNames, "tls-cluster-server-1")
+	assert.Equal(t, "cluster-ca", server0.Spec.IssuerRef.Name)
+	assert.Equal(t, "Issuer", server0.Spec.IssuerRef.Kind)
+	assert.Equal(t, []certv1.KeyUsage{certv1.UsageDigitalSignature, certv1.UsageServerAuth, certv1.UsageClientAuth}, server0.Spec.Usages)
+	assert.Equal(t, "tls-cluster-server-0", server0.Spec.SecretTemplate.Labels[resources.ServerCertificateLabel])
+
+	// The cluster policy issuer is used for the servers instead of a scope certificate
+	assert.Empty(t, resources.BuildSSLPolicyCertificates(resources.BuildCertificateForEnterprise(cluster), cluster.Name, cluster.Spec.TLS, resources.SSLPolicyScopes))
+
+	neo4jConf := resources.BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.cluster.base_directory=/tmp/ssl-cluster\n")
+	assert.Contains(t, neo4jConf, "dbms.ssl.policy.cluster.client_auth=REQUIRE\n")
+	assert.NotContains(t, neo4jConf, "dbms.ssl.policy.cluster.trust_all")
+	assert.Contains(t, resources.BuildConfigMapForEnterprise(cluster).Data["startup.sh"], `ln -sfn "/ssl-server/${HOSTNAME_FQDN%%.*}" /tmp/ssl-cluster`)
+
+	// Every pod of a StatefulSet mounts the certificates of the pods of its pool
+	podSpec := resources.BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Spec
+	var sources []corev1.VolumeProjection
+	for _, volume := range podSpec.Volumes {
+		if volume.Name == "server-certs" {
+			sources = volume.Projected.Sources
+		}
+	}
+	require.Len(t, sources, 2)
+	assert.Equal(t, "tls-cluster-server-1-cluster-tls-secret", sources[1].Secret.Name)
+	assert.Equal(t, []corev1.KeyToPath{
+		{Key: "tls.crt", Path: "tls-cluster-server-1/tls.crt"},
+		{Key: "tls.key", Path: "tls-cluster-server-1/tls.key"},
+		{Key: "ca.crt", Path: "tls-cluster-server-1/trusted/ca.crt"},
+	}, sources[1].Secret.Items)
+}
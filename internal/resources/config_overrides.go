@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ConfigOverrideLabel carries the name of the pod a config override
+// ConfigMap belongs to
+const ConfigOverrideLabel = "neo4j.com/config-override"
+
+// ConfigOverridesHashAnnotation is the hash of the config overrides of the
+// pods of a StatefulSet, so that changing an override rolls its pods
+const ConfigOverridesHashAnnotation = "neo4j.neo4j.com/config-overrides-hash"
+
+const (
+	configOverridesVolume    = "config-overrides"
+	configOverridesDirectory = "/conf-overrides"
+)
+
+// ConfigOverrideConfigMapName returns the name of the config override
+// ConfigMap of a pod
+func ConfigOverrideConfigMapName(pod string) string {
+	return pod + "-config"
+}
+
+// BuildConfigOverrideConfigMaps creates a ConfigMap for every server pod that
+// spec.configOverrides applies to. Its neo4j.conf holds the merged settings
+// of the overrides, which the startup script of the pod merges over the
+// shared neo4j.conf.
+func BuildConfigOverrideConfigMaps(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []*corev1.ConfigMap {
+	var configMaps []*corev1.ConfigMap
+	for _, pool := range ServerPoolNames(cluster) {
+		overrides := configOverridesForPool(cluster, pool)
+		for _, pod := range sortedKeys(overrides) {
+			labels := getLabelsForEnterprise(cluster, "config")
+			labels[ConfigOverrideLabel] = pod
+			configMaps = append(configMaps, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigOverrideConfigMapName(pod),
+					Namespace: cluster.Namespace,
+					Labels:    labels,
+				},
+				Data: map[string]string{
+					"neo4j.conf": renderConfigOverrides(overrides[pod]),
+				},
+			})
+		}
+	}
+	return configMaps
+}
+
+// configOverridesForPool returns the merged override settings of every pod of
+// a server pool that has any, by pod name
+func configOverridesForPool(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) map[string]map[string]string {
+	servers := poolServers(cluster, pool)
+	overrides := map[string]map[string]string{}
+	apply := func(ordinal int32, config map[string]string) {
+		if ordinal < 0 || ordinal >= servers {
+			return
+		}
+		pod := fmt.Sprintf("%s-%s-%d", cluster.Name, pool, ordinal)
+		if overrides[pod] == nil {
+			overrides[pod] = map[string]string{}
+		}
+		for key, value := range config {
+			overrides[pod][key] = value
+		}
+	}
+
+	for _, override := range cluster.Spec.ConfigOverrides {
+		inPool := override.ServerGroup == pool || (override.ServerGroup == "" && pool == "server")
+		if !inPool {
+			continue
+		}
+		if len(override.Servers) == 0 {
+			for ordinal := int32(0); ordinal < servers; ordinal++ {
+				apply(ordinal, override.Config)
+			}
+		}
+		for _, ordinal := range override.Servers {
+			apply(ordinal, override.Config)
+		}
+	}
+	return overrides
+}
+
+// renderConfigOverrides renders override settings as neo4j.conf lines
+func renderConfigOverrides(config map[string]string) string {
+	var conf strings.Builder
+	conf.WriteString("# Config overrides of this server (spec.configOverrides)\n")
+	for _, key := range sortedKeys(config) {
+		fmt.Fprintf(&conf, "%s=%s\n", key, config[key])
+	}
+	return conf.String()
+}
+
+// applyConfigOverrides mounts the config override ConfigMaps of the pods of a
+// StatefulSet and stamps their hash on the pod template. The ConfigMaps are
+// optional, so a scaled up pod starts before the operator created its own.
+func applyConfigOverrides(sts *appsv1.StatefulSet, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) {
+	overrides := configOverridesForPool(cluster, pool)
+	if len(overrides) == 0 {
+		return
+	}
+
+	optional := true
+	hash := sha256.New()
+	var sources []corev1.VolumeProjection
+	for _, pod := range sortedKeys(overrides) {
+		rendered := renderConfigOverrides(overrides[pod])
+		fmt.Fprintf(hash, "%s\n%s", pod, rendered)
+		sources = append(sources, corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ConfigOverrideConfigMapName(pod)},
+				Items:                []corev1.KeyToPath{{Key: "neo4j.conf", Path: pod + ".conf"}},
+				Optional:             &optional,
+			},
+		})
+	}
+
+	podSpec := &sts.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         configOverridesVolume,
+		VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: sources}},
+	})
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == Neo4jContainer {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      configOverridesVolume,
+				MountPath: configOverridesDirectory,
+				ReadOnly:  true,
+			})
+		}
+	}
+
+	if sts.Spec.Template.Annotations == nil {
+		sts.Spec.Template.Annotations = map[string]string{}
+	}
+	sts.Spec.Template.Annotations[ConfigOverridesHashAnnotation] = hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// buildConfigOverridesMerge merges the config overrides of the pod, if any,
+// over neo4j.conf. Settings it overrides are removed first, as Neo4j does not
+// accept a setting twice.
+func buildConfigOverridesMerge(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if len(cluster.Spec.ConfigOverrides) == 0 {
+		return ""
+	}
+	return `
+# Config overrides: merge the settings of spec.configOverrides for this pod
+OVERRIDES_FILE="` + configOverridesDirectory + `/${HOSTNAME_FQDN%%.*}.conf"
+if [ -f "${OVERRIDES_FILE}" ]; then
+    echo "Applying config overrides from ${OVERRIDES_FILE}"
+    grep -v '^#' "${OVERRIDES_FILE}" | while IFS='=' read -r key value; do
+        if [ -n "${key}" ]; then
+            sed -i "/^$(echo "${key}" | sed 's/\./\\./g')=/d" /tmp/neo4j-config/neo4j.conf
+        fi
+    done
+    cat "${OVERRIDES_FILE}" >> /tmp/neo4j-config/neo4j.conf
+fi
+`
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func configOverridesTestCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("prod", "default")}
+	cluster.Spec.Image = neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"}
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{{Name: "analytics", Servers: 2}}
+	cluster.Spec.Storage = neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"}
+	return cluster
+}
+
+func TestBuildConfigOverrideConfigMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	g.Expect(BuildConfigOverrideConfigMaps(cluster)).To(BeEmpty())
+
+	cluster.Spec.ConfigOverrides = []neo4jv1alpha1.ConfigOverrideSpec{
+		{Name: "debug", Servers: []int32{0}, Config: map[string]string{
+			"db.logs.query.enabled":   "VERBOSE",
+			"db.track_query_cpu_time": "true",
+		}},
+		{Name: "analytics", ServerGroup: "analytics", Config: map[string]string{"server.memory.pagecache.size": "16g"}},
+		{Name: "analytics-0", ServerGroup: "analytics", Servers: []int32{0}, Config: map[string]string{"server.memory.pagecache.size": "24g"}},
+		// Later overrides win, ordinals beyond the pool are skipped
+		{Name: "quiet", Servers: []int32{0, 7}, Config: map[string]string{"db.logs.query.enabled": "INFO"}},
+	}
+
+	configMaps := BuildConfigOverrideConfigMaps(cluster)
+	data := map[string]string{}
+	for _, configMap := range configMaps {
+		g.Expect(configMap.Labels).To(HaveKeyWithValue(ConfigOverrideLabel, configMap.Name[:len(configMap.Name)-len("-config")]))
+		data[configMap.Name] = configMap.Data["neo4j.conf"]
+	}
+	g.Expect(data).To(Equal(map[string]string{
+		"prod-server-0-config": "# Config overrides of this server (spec.configOverrides)\n" +
+			"db.logs.query.enabled=INFO\ndb.track_query_cpu_time=true\n",
+		"prod-analytics-0-config": "# Config overrides of this server (spec.configOverrides)\n" +
+			"server.memory.pagecache.size=24g\n",
+		"prod-analytics-1-config": "# Config overrides of this server (spec.configOverrides)\n" +
+			"server.memory.pagecache.size=16g\n",
+	}))
+}
+
+func TestConfigOverridesStatefulSets(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	server := BuildServerStatefulSetForEnterprise(cluster)
+	g.Expect(server.Spec.Template.Annotations).ToNot(HaveKey(ConfigOverridesHashAnnotation))
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["startup.sh"]).ToNot(ContainSubstring("OVERRIDES_FILE"))
+
+	cluster.Spec.ConfigOverrides = []neo4jv1alpha1.ConfigOverrideSpec{
+		{Name: "debug", Servers: []int32{1}, Config: map[string]string{"db.logs.query.enabled": "VERBOSE"}},
+	}
+	server = BuildServerStatefulSetForEnterprise(cluster)
+	hash := server.Spec.Template.Annotations[ConfigOverridesHashAnnotation]
+	g.Expect(hash).ToNot(BeEmpty())
+
+	var projected *corev1.ProjectedVolumeSource
+	for _, volume := range server.Spec.Template.Spec.Volumes {
+		if volume.Name == configOverridesVolume {
+			projected = volume.Projected
+		}
+	}
+	g.Expect(projected).ToNot(BeNil())
+	g.Expect(projected.Sources).To(HaveLen(1))
+	g.Expect(projected.Sources[0].ConfigMap.Name).To(Equal("prod-server-1-config"))
+	g.Expect(projected.Sources[0].ConfigMap.Items).To(Equal([]corev1.KeyToPath{{Key: "neo4j.conf", Path: "prod-server-1.conf"}}))
+	g.Expect(*projected.Sources[0].ConfigMap.Optional).To(BeTrue())
+	g.Expect(server.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+		Name: configOverridesVolume, MountPath: configOverridesDirectory, ReadOnly: true,
+	}))
+
+	// The group has no override
+	analytics := BuildServerGroupStatefulSetsForEnterprise(cluster)[0]
+	g.Expect(analytics.Spec.Template.Annotations).ToNot(HaveKey(ConfigOverridesHashAnnotation))
+
+	// A changed value changes the hash of the StatefulSet it applies to
+	cluster.Spec.ConfigOverrides[0].Config["db.logs.query.enabled"] = "INFO"
+	g.Expect(BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Annotations[ConfigOverridesHashAnnotation]).ToNot(Equal(hash))
+
+	startupScript := BuildConfigMapForEnterprise(cluster).Data["startup.sh"]
+	g.Expect(startupScript).To(ContainSubstring(`OVERRIDES_FILE="/conf-overrides/${HOSTNAME_FQDN%%.*}.conf"`))
+}
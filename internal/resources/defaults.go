@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// The builders have no defaults for the image repository, the storage size
+// and the number of servers: the CRD requires them and the validators reject
+// them when empty. The defaulting webhook fills them in with these values, so
+// they are optional where it runs. The image tag is not defaulted, as the
+// operator version names no Neo4j release to derive it from.
+const (
+	// DefaultImageRepo is the Neo4j image of deployments that do not name one
+	DefaultImageRepo = "neo4j"
+	// DefaultStorageSize is the data volume size of deployments that do not
+	// set one, the neo4j.defaultStorageSize of the Helm chart
+	DefaultStorageSize = "10Gi"
+	// DefaultClusterServers is the number of servers of clusters that do not
+	// set a topology
+	DefaultClusterServers int32 = 3
+)
+
+var (
+	// Allow time for cluster discovery and joining, then up to 2 minutes for
+	// cluster rejoin scenarios
+	readinessProbeDefaults = neo4jv1alpha1.ProbeTimingSpec{
+		InitialDelaySeconds: ptr.To[int32](45),
+		PeriodSeconds:       ptr.To[int32](15),
+		TimeoutSeconds:      ptr.To[int32](5),
+		FailureThreshold:    ptr.To[int32](8),
+	}
+	// Allow sufficient time for joining pods to connect, and check rarely to
+	// avoid interrupting cluster operations
+	livenessProbeDefaults = neo4jv1alpha1.ProbeTimingSpec{
+		InitialDelaySeconds: ptr.To[int32](120),
+		PeriodSeconds:       ptr.To[int32](60),
+		TimeoutSeconds:      ptr.To[int32](10),
+		FailureThreshold:    ptr.To[int32](3),
+	}
+	// Allow up to 10 minutes for startup (60 * 10s)
+	startupProbeDefaults = neo4jv1alpha1.ProbeTimingSpec{
+		InitialDelaySeconds: ptr.To[int32](30),
+		PeriodSeconds:       ptr.To[int32](10),
+		TimeoutSeconds:      ptr.To[int32](5),
+		FailureThreshold:    ptr.To[int32](60),
+	}
+)
+
+// ApplyClusterDefaults fills in the probe timings and MCP fields of a cluster
+// spec the builders would otherwise default, so that the stored spec shows
+// what is deployed, and the required image repository, storage size and
+// servers. Set fields are left alone, as are the deprecated primaries and
+// secondaries, which the reconciler converts.
+func ApplyClusterDefaults(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	spec := &cluster.Spec
+	if spec.Image.Repo == "" {
+		spec.Image.Repo = DefaultImageRepo
+	}
+	if spec.Storage.Size == "" {
+		spec.Storage.Size = DefaultStorageSize
+	}
+	if spec.Topology.Servers == 0 && spec.Topology.Primaries == 0 && spec.Topology.Secondaries == 0 {
+		spec.Topology.Servers = DefaultClusterServers
+	}
+
+	if spec.Probes == nil {
+		spec.Probes = &neo4jv1alpha1.ProbesSpec{}
+	}
+	spec.Probes.Readiness = probeTimings(spec.Probes.Readiness, readinessProbeDefaults)
+	spec.Probes.Liveness = probeTimings(spec.Probes.Liveness, livenessProbeDefaults)
+	spec.Probes.Startup = probeTimings(spec.Probes.Startup, startupProbeDefaults)
+
+	applyMCPDefaults(spec.MCP)
+}
+
+// ApplyStandaloneDefaults fills in the MCP fields of a standalone spec the
+// builders would otherwise default, and the required image repository and
+// storage size
+func ApplyStandaloneDefaults(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) {
+	spec := &standalone.Spec
+	if spec.Image.Repo == "" {
+		spec.Image.Repo = DefaultImageRepo
+	}
+	if spec.Storage.Size == "" {
+		spec.Storage.Size = DefaultStorageSize
+	}
+	applyMCPDefaults(spec.MCP)
+}
+
+// applyMCPDefaults fills in the image, transport, replicas and HTTP listener
+// of an enabled MCP server
+func applyMCPDefaults(mcp *neo4jv1alpha1.MCPServerSpec) {
+	if mcp == nil || !mcp.Enabled {
+		return
+	}
+	repo, tag := mcpImageRepo(mcp), mcpImageTag(mcp)
+	if mcp.Image == nil {
+		mcp.Image = &neo4jv1alpha1.ImageSpec{}
+	}
+	mcp.Image.Repo, mcp.Image.Tag = repo, tag
+	mcp.Transport = mcpTransport(mcp)
+	if mcp.Replicas == nil {
+		mcp.Replicas = ptr.To[int32](1)
+	}
+	if mcp.Transport == "http" {
+		if mcp.HTTP == nil {
+			mcp.HTTP = &neo4jv1alpha1.MCPHTTPConfig{}
+		}
+		mcp.HTTP.Host = mcpHTTPHost(mcp)
+		mcp.HTTP.Port = mcpHTTPPort(mcp)
+	}
+}
+
+// probeTimings returns the timings of a probe with the ones left empty taken
+// from defaults
+func probeTimings(timing *neo4jv1alpha1.ProbeTimingSpec, defaults neo4jv1alpha1.ProbeTimingSpec) *neo4jv1alpha1.ProbeTimingSpec {
+	merged := defaults.DeepCopy()
+	if timing == nil {
+		return merged
+	}
+	if timing.InitialDelaySeconds != nil {
+		merged.InitialDelaySeconds = ptr.To(*timing.InitialDelaySeconds)
+	}
+	if timing.PeriodSeconds != nil {
+		merged.PeriodSeconds = ptr.To(*timing.PeriodSeconds)
+	}
+	if timing.TimeoutSeconds != nil {
+		merged.TimeoutSeconds = ptr.To(*timing.TimeoutSeconds)
+	}
+	if timing.FailureThreshold != nil {
+		merged.FailureThreshold = ptr.To(*timing.FailureThreshold)
+	}
+	return merged
+}
+
+// setProbeTimings sets the timings of a probe
+func setProbeTimings(probe *corev1.Probe, timing *neo4jv1alpha1.ProbeTimingSpec) {
+	probe.InitialDelaySeconds = *timing.InitialDelaySeconds
+	probe.PeriodSeconds = *timing.PeriodSeconds
+	probe.TimeoutSeconds = *timing.TimeoutSeconds
+	probe.FailureThreshold = *timing.FailureThreshold
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestApplyClusterDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	cluster.Spec.Image.Repo = ""
+	cluster.Spec.Storage.Size = ""
+	cluster.Spec.Topology.Servers = 0
+	cluster.Spec.Probes = &neo4jv1alpha1.ProbesSpec{Liveness: &neo4jv1alpha1.ProbeTimingSpec{PeriodSeconds: ptr.To[int32](30)}}
+	cluster.Spec.MCP = &neo4jv1alpha1.MCPServerSpec{Enabled: true, HTTP: &neo4jv1alpha1.MCPHTTPConfig{TLS: &neo4jv1alpha1.MCPTLSSpec{SecretName: "mcp-tls"}}}
+	ApplyClusterDefaults(cluster)
+
+	g.Expect(cluster.Spec.Image.Repo).To(Equal(DefaultImageRepo))
+	g.Expect(cluster.Spec.Storage.Size).To(Equal(DefaultStorageSize))
+	g.Expect(cluster.Spec.Topology.Servers).To(Equal(DefaultClusterServers))
+	g.Expect(cluster.Spec.Probes.Readiness).To(Equal(&readinessProbeDefaults))
+	g.Expect(cluster.Spec.Probes.Startup).To(Equal(&startupProbeDefaults))
+	g.Expect(cluster.Spec.Probes.Liveness).To(Equal(&neo4jv1alpha1.ProbeTimingSpec{
+		InitialDelaySeconds: ptr.To[int32](120),
+		PeriodSeconds:       ptr.To[int32](30),
+		TimeoutSeconds:      ptr.To[int32](10),
+		FailureThreshold:    ptr.To[int32](3),
+	}))
+	g.Expect(cluster.Spec.MCP.Image).To(Equal(&neo4jv1alpha1.ImageSpec{Repo: mcpImageRepoDefault, Tag: mcpImageTagDefault}))
+	g.Expect(cluster.Spec.MCP.Transport).To(Equal("http"))
+	g.Expect(cluster.Spec.MCP.Replicas).To(Equal(ptr.To[int32](1)))
+	g.Expect(cluster.Spec.MCP.HTTP.Host).To(Equal("0.0.0.0"))
+	g.Expect(cluster.Spec.MCP.HTTP.Port).To(Equal(int32(mcpHTTPSPortDefault)))
+
+	// The deprecated topology is left to the reconciler
+	cluster = configOverridesTestCluster()
+	cluster.Spec.Topology = neo4jv1alpha1.TopologyConfiguration{Primaries: 3}
+	ApplyClusterDefaults(cluster)
+	g.Expect(cluster.Spec.Topology.Servers).To(BeZero())
+}
+
+func TestApplyClusterDefaultsKeepsStatefulSets(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	cluster.Spec.MCP = &neo4jv1alpha1.MCPServerSpec{Enabled: true}
+	defaulted := cluster.DeepCopy()
+	ApplyClusterDefaults(defaulted)
+
+	g.Expect(BuildServerStatefulSetsForEnterprise(defaulted)).To(Equal(BuildServerStatefulSetsForEnterprise(cluster)))
+	g.Expect(BuildMCPDeploymentForCluster(defaulted)).To(Equal(BuildMCPDeploymentForCluster(cluster)))
+
+	// Set timings replace the defaults
+	defaulted.Spec.Probes.Readiness.FailureThreshold = ptr.To[int32](20)
+	container := BuildServerStatefulSetsForEnterprise(defaulted)[0].Spec.Template.Spec.Containers[0]
+	g.Expect(container.ReadinessProbe.FailureThreshold).To(Equal(int32(20)))
+	g.Expect(container.ReadinessProbe.PeriodSeconds).To(Equal(int32(15)))
+}
+
+func TestApplyStandaloneDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{ObjectMeta: testObjectMeta("dev", "default")}
+	standalone.Spec.Image.Tag = "5.26.0-enterprise"
+	standalone.Spec.Storage.Size = "50Gi"
+	ApplyStandaloneDefaults(standalone)
+
+	g.Expect(standalone.Spec.Image.Repo).To(Equal(DefaultImageRepo))
+	g.Expect(standalone.Spec.Storage.Size).To(Equal("50Gi"))
+	g.Expect(standalone.Spec.MCP).To(BeNil())
+}
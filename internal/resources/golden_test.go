@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/test/testutil"
+)
+
+// TestClusterManifestsGolden renders every resource of the cluster fixtures
+// in testdata/golden and compares them with the snapshots next to them.
+// Run with -update to rewrite the snapshots after an intended change.
+func TestClusterManifestsGolden(t *testing.T) {
+	for _, fixture := range []string{"small-cluster", "tls-cluster", "mcp-http-cluster"} {
+		t.Run(fixture, func(t *testing.T) {
+			cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			testutil.LoadFixture(t, filepath.Join("testdata", "golden", fixture+".yaml"), cluster)
+
+			testutil.AssertGolden(t, filepath.Join("testdata", "golden", fixture+".golden.yaml"),
+				resources.BuildConfigMapForEnterprise(cluster),
+				resources.BuildServerStatefulSetForEnterprise(cluster),
+				resources.BuildHeadlessServiceForEnterprise(cluster),
+				resources.BuildDiscoveryServiceForEnterprise(cluster),
+				resources.BuildInternalsServiceForEnterprise(cluster),
+				resources.BuildClientServiceForEnterprise(cluster),
+				resources.BuildMetricsServiceForEnterprise(cluster),
+				resources.BuildBackupServiceForEnterprise(cluster),
+				resources.BuildBackupNetworkPolicyForEnterprise(cluster),
+				resources.BuildClientNetworkPolicyForEnterprise(cluster),
+				resources.BuildServiceAccountForEnterprise(cluster),
+				resources.BuildDiscoveryServiceAccountForEnterprise(cluster),
+				resources.BuildDiscoveryRoleForEnterprise(cluster),
+				resources.BuildDiscoveryRoleBindingForEnterprise(cluster),
+				resources.BuildCertificateForEnterprise(cluster),
+				resources.BuildIngressForEnterprise(cluster),
+				resources.BuildRouteForEnterprise(cluster),
+				resources.BuildMCPDeploymentForCluster(cluster),
+				resources.BuildMCPServiceForCluster(cluster),
+				resources.BuildMCPIngressForCluster(cluster),
+				resources.BuildMCPRouteForCluster(cluster),
+			)
+		})
+	}
+}
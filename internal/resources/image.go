@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ImageReference returns the image reference of an ImageSpec, pulled from
+// spec.registry when a registry mirror is set
+func ImageReference(image neo4jv1alpha1.ImageSpec) string {
+	return fmt.Sprintf("%s:%s", mirroredRepository(image.Repo, image.Registry), image.Tag)
+}
+
+// ImagePullPolicy returns the pull policy of an ImageSpec, IfNotPresent
+// unless one is set
+func ImagePullPolicy(image neo4jv1alpha1.ImageSpec) corev1.PullPolicy {
+	if image.PullPolicy != "" {
+		return corev1.PullPolicy(image.PullPolicy)
+	}
+	return corev1.PullIfNotPresent
+}
+
+// ImagePullSecrets converts the pull secret names of an ImageSpec to
+// []corev1.LocalObjectReference, skipping empty names
+func ImagePullSecrets(image neo4jv1alpha1.ImageSpec) []corev1.LocalObjectReference {
+	if len(image.PullSecrets) == 0 {
+		return nil
+	}
+	refs := make([]corev1.LocalObjectReference, 0, len(image.PullSecrets))
+	for _, name := range image.PullSecrets {
+		if name == "" {
+			continue
+		}
+		refs = append(refs, corev1.LocalObjectReference{Name: name})
+	}
+	return refs
+}
+
+// mirroredRepository replaces the registry host of repo with registry. A repo
+// without a registry host is a Docker Hub repository, whose official images
+// live under "library/" on mirrors of Docker Hub.
+func mirroredRepository(repo, registry string) string {
+	registry = strings.TrimSuffix(registry, "/")
+	if registry == "" {
+		return repo
+	}
+
+	path := repo
+	host, rest, found := strings.Cut(repo, "/")
+	if found && isRegistryHost(host) {
+		path = rest
+		if !isDockerHub(host) {
+			return registry + "/" + path
+		}
+	}
+	if !strings.Contains(path, "/") {
+		path = "library/" + path
+	}
+	return registry + "/" + path
+}
+
+// isRegistryHost reports whether the first path component of a repository
+// names a registry, the way the container runtimes tell them apart
+func isRegistryHost(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost"
+}
+
+func isDockerHub(host string) bool {
+	switch host {
+	case "docker.io", "index.docker.io", "registry-1.docker.io":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func TestImageReference(t *testing.T) {
+	tests := []struct {
+		name     string
+		repo     string
+		registry string
+		want     string
+	}{
+		{"no mirror", "neo4j", "", "neo4j:5.26.0-enterprise"},
+		{"official image", "neo4j", "mirror.example.com", "mirror.example.com/library/neo4j:5.26.0-enterprise"},
+		{"mirror path", "neo4j", "harbor.example.com/dockerhub/", "harbor.example.com/dockerhub/library/neo4j:5.26.0-enterprise"},
+		{"docker hub organisation", "neo4j-partners/neo4j", "mirror.example.com", "mirror.example.com/neo4j-partners/neo4j:5.26.0-enterprise"},
+		{"explicit docker hub", "docker.io/neo4j", "mirror.example.com:5000", "mirror.example.com:5000/library/neo4j:5.26.0-enterprise"},
+		{"other registry", "registry.example.com/db/neo4j", "mirror.internal", "mirror.internal/db/neo4j:5.26.0-enterprise"},
+		{"localhost registry", "localhost/neo4j", "mirror.internal", "mirror.internal/neo4j:5.26.0-enterprise"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image := neo4jv1alpha1.ImageSpec{Repo: tt.repo, Tag: "5.26.0-enterprise", Registry: tt.registry}
+			assert.Equal(t, tt.want, resources.ImageReference(image))
+		})
+	}
+}
+
+func TestImagePullPolicyAndSecrets(t *testing.T) {
+	image := neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"}
+	assert.Equal(t, corev1.PullIfNotPresent, resources.ImagePullPolicy(image))
+	assert.Nil(t, resources.ImagePullSecrets(image))
+
+	image.PullPolicy = "Always"
+	image.PullSecrets = []string{"registry-creds", "", "mirror-creds"}
+	assert.Equal(t, corev1.PullAlways, resources.ImagePullPolicy(image))
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "mirror-creds"}}, resources.ImagePullSecrets(image))
+}
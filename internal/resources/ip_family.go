@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"net"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// ipv4Wildcard is the listen address of IPv4-only deployments
+	ipv4Wildcard = "0.0.0.0"
+
+	// ipv6Wildcard is the listen address when IPv6 is requested. Linux
+	// accepts IPv4-mapped connections on it, so it also serves dual-stack pods.
+	ipv6Wildcard = "::"
+)
+
+// ServiceIPFamilyPolicy returns the IP family policy of the generated
+// Services, or nil to leave it to the Kubernetes cluster default.
+func ServiceIPFamilyPolicy(spec *neo4jv1alpha1.ServiceSpec) *corev1.IPFamilyPolicy {
+	if spec == nil || spec.IPFamilyPolicy == "" {
+		return nil
+	}
+	policy := corev1.IPFamilyPolicy(spec.IPFamilyPolicy)
+	return &policy
+}
+
+// ServiceIPFamilies returns the IP families of the generated Services.
+func ServiceIPFamilies(spec *neo4jv1alpha1.ServiceSpec) []corev1.IPFamily {
+	if spec == nil {
+		return nil
+	}
+	var families []corev1.IPFamily
+	for _, family := range spec.IPFamilies {
+		families = append(families, corev1.IPFamily(family))
+	}
+	return families
+}
+
+// ListensOnIPv6 reports whether the service spec requests IPv6, alone or as
+// one of two families.
+func ListensOnIPv6(spec *neo4jv1alpha1.ServiceSpec) bool {
+	for _, family := range ServiceIPFamilies(spec) {
+		if family == corev1.IPv6Protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// PrefersIPv6 reports whether IPv6 is the primary family of the service spec.
+func PrefersIPv6(spec *neo4jv1alpha1.ServiceSpec) bool {
+	families := ServiceIPFamilies(spec)
+	return len(families) > 0 && families[0] == corev1.IPv6Protocol
+}
+
+// ListenHost returns the wildcard address Neo4j binds its connectors to.
+func ListenHost(spec *neo4jv1alpha1.ServiceSpec) string {
+	if ListensOnIPv6(spec) {
+		return ipv6Wildcard
+	}
+	return ipv4Wildcard
+}
+
+// ListenAddress returns the host:port a connector binds to, with IPv6
+// literals in brackets as Neo4j expects them.
+func ListenAddress(spec *neo4jv1alpha1.ServiceSpec, port int) string {
+	return net.JoinHostPort(ListenHost(spec), strconv.Itoa(port))
+}
+
+// IPFamilyJVMConfig returns the neo4j.conf lines that make the JVM resolve
+// peers to IPv6 addresses first when IPv6 is the primary family. It is empty
+// otherwise, leaving the JVM default of IPv4 first.
+func IPFamilyJVMConfig(spec *neo4jv1alpha1.ServiceSpec) string {
+	if !PrefersIPv6(spec) {
+		return ""
+	}
+	return `
+# IPv6 is the primary IP family: resolve peer FQDNs to IPv6 addresses first
+server.jvm.additional=-Djava.net.preferIPv6Addresses=true
+`
+}
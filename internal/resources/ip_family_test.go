@@ -0,0 +1,88 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func TestListenAddress(t *testing.T) {
+	assert.Equal(t, "0.0.0.0:7687", resources.ListenAddress(nil, resources.BoltPort))
+
+	ipv4 := &neo4jv1alpha1.ServiceSpec{IPFamilies: []string{"IPv4"}}
+	assert.Equal(t, "0.0.0.0", resources.ListenHost(ipv4))
+
+	ipv6 := &neo4jv1alpha1.ServiceSpec{IPFamilies: []string{"IPv6"}}
+	assert.Equal(t, "::", resources.ListenHost(ipv6))
+	assert.Equal(t, "[::]:7687", resources.ListenAddress(ipv6, resources.BoltPort))
+
+	// Dual-stack pods listen on the IPv6 wildcard, which also accepts IPv4
+	dualStack := &neo4jv1alpha1.ServiceSpec{IPFamilyPolicy: "PreferDualStack", IPFamilies: []string{"IPv4", "IPv6"}}
+	assert.Equal(t, "[::]:6000", resources.ListenAddress(dualStack, resources.DiscoveryPort))
+	assert.False(t, resources.PrefersIPv6(dualStack))
+	assert.True(t, resources.PrefersIPv6(ipv6))
+}
+
+func ipv6Cluster(service *neo4jv1alpha1.ServiceSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "ipv6", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26-enterprise"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			Storage:  neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+			Service:  service,
+		},
+	}
+}
+
+func TestClusterConfigForIPv6(t *testing.T) {
+	neo4jConf := resources.BuildConfigMapForEnterprise(ipv6Cluster(nil)).Data["neo4j.conf"]
+	assert.Contains(t, neo4jConf, "server.default_listen_address=0.0.0.0\n")
+	assert.NotContains(t, neo4jConf, "preferIPv6Addresses")
+
+	neo4jConf = resources.BuildConfigMapForEnterprise(ipv6Cluster(&neo4jv1alpha1.ServiceSpec{
+		IPFamilies: []string{"IPv6"},
+	})).Data["neo4j.conf"]
+	assert.Contains(t, neo4jConf, "server.default_listen_address=::\n")
+	for _, line := range []string{
+		"server.bolt.listen_address=[::]:7687",
+		"server.http.listen_address=[::]:7474",
+		"server.cluster.listen_address=[::]:6000",
+		"server.routing.listen_address=[::]:7688",
+		"server.cluster.raft.listen_address=[::]:7000",
+		"server.backup.listen_address=[::]:6362",
+		"server.jvm.additional=-Djava.net.preferIPv6Addresses=true",
+	} {
+		assert.Contains(t, neo4jConf, line)
+	}
+	assert.NotContains(t, neo4jConf, "0.0.0.0")
+}
+
+func TestClusterServicesIPFamilies(t *testing.T) {
+	cluster := ipv6Cluster(&neo4jv1alpha1.ServiceSpec{
+		IPFamilyPolicy: "RequireDualStack",
+		IPFamilies:     []string{"IPv6", "IPv4"},
+	})
+
+	for _, svc := range []*corev1.Service{
+		resources.BuildHeadlessServiceForEnterprise(cluster),
+		resources.BuildDiscoveryServiceForEnterprise(cluster),
+		resources.BuildInternalsServiceForEnterprise(cluster),
+		resources.BuildClientServiceForEnterprise(cluster),
+	} {
+		require.NotNil(t, svc.Spec.IPFamilyPolicy, svc.Name)
+		assert.Equal(t, corev1.IPFamilyPolicyRequireDualStack, *svc.Spec.IPFamilyPolicy, svc.Name)
+		assert.Equal(t, []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}, svc.Spec.IPFamilies, svc.Name)
+	}
+
+	// Without IP family settings the Kubernetes cluster defaults apply
+	svc := resources.BuildClientServiceForEnterprise(ipv6Cluster(nil))
+	assert.Nil(t, svc.Spec.IPFamilyPolicy)
+	assert.Empty(t, svc.Spec.IPFamilies)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// KerberosAuthenticationProvider is the auth provider of the Neo4j Kerberos
+// add-on
+const KerberosAuthenticationProvider = "plugin-com.neo4j.plugin.kerberos.KerberosAuthenticationPlugin"
+
+const (
+	kerberosKeytabVolume    = "kerberos-keytab"
+	kerberosKeytabDirectory = "/kerberos"
+	kerberosKeytabFile      = "neo4j.keytab"
+)
+
+// KerberosEnabled reports whether the servers authenticate users with
+// Kerberos through spec.auth.kerberos
+func KerberosEnabled(auth *neo4jv1alpha1.AuthSpec) bool {
+	return auth != nil && auth.Provider == "kerberos" && auth.Kerberos != nil
+}
+
+// buildKerberosConfigFiles returns krb5.conf and the kerberos.conf of the
+// add-on, which the startup script copies next to neo4j.conf
+func buildKerberosConfigFiles(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]string {
+	if !KerberosEnabled(cluster.Spec.Auth) {
+		return nil
+	}
+	kerberos := cluster.Spec.Auth.Kerberos
+
+	var krb5 strings.Builder
+	krb5.WriteString("# Generated from spec.auth.kerberos\n")
+	fmt.Fprintf(&krb5, "[libdefaults]\n    default_realm = %s\n    dns_lookup_kdc = %t\n\n", kerberos.Realm, len(kerberos.KDCs) == 0)
+	fmt.Fprintf(&krb5, "[realms]\n    %s = {\n", kerberos.Realm)
+	for _, kdc := range kerberos.KDCs {
+		fmt.Fprintf(&krb5, "        kdc = %s\n", kdc)
+	}
+	if kerberos.AdminServer != "" {
+		fmt.Fprintf(&krb5, "        admin_server = %s\n", kerberos.AdminServer)
+	}
+	krb5.WriteString("    }\n")
+	if len(kerberos.Domains) > 0 {
+		krb5.WriteString("\n[domain_realm]\n")
+		for _, domain := range kerberos.Domains {
+			fmt.Fprintf(&krb5, "    %s = %s\n", domain, kerberos.Realm)
+		}
+	}
+
+	addOn := fmt.Sprintf("# Neo4j Kerberos add-on, generated from spec.auth.kerberos\n"+
+		"keytab=%s/%s\nservice.principal=%s\nkrb5.conf.path=/tmp/neo4j-config/krb5.conf\n",
+		kerberosKeytabDirectory, kerberosKeytabFile, kerberos.ServicePrincipal)
+
+	return map[string]string{
+		"krb5.conf":     krb5.String(),
+		"kerberos.conf": addOn,
+	}
+}
+
+// buildKerberosConfig points the JVM at the generated krb5.conf
+func buildKerberosConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !KerberosEnabled(cluster.Spec.Auth) {
+		return ""
+	}
+	return `
+# Kerberos authentication (spec.auth.kerberos)
+server.jvm.additional=-Djava.security.krb5.conf=/tmp/neo4j-config/krb5.conf
+`
+}
+
+// applyKerberos mounts the keytab of spec.auth.kerberos
+func applyKerberos(sts *appsv1.StatefulSet, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	if !KerberosEnabled(cluster.Spec.Auth) || cluster.Spec.Auth.Kerberos.Keytab == nil {
+		return
+	}
+	keytab := cluster.Spec.Auth.Kerberos.Keytab
+	key := keytab.Key
+	if key == "" {
+		key = "keytab"
+	}
+
+	readOnly := int32(0o400)
+	podSpec := &sts.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: kerberosKeytabVolume,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName:  keytab.SecretRef,
+			Items:       []corev1.KeyToPath{{Key: key, Path: kerberosKeytabFile}},
+			DefaultMode: &readOnly,
+		}},
+	})
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == Neo4jContainer {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      kerberosKeytabVolume,
+				MountPath: kerberosKeytabDirectory,
+				ReadOnly:  true,
+			})
+		}
+	}
+}
+
+// buildKerberosStartup copies krb5.conf and kerberos.conf next to
+// neo4j.conf, where the add-on reads them
+func buildKerberosStartup(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !KerberosEnabled(cluster.Spec.Auth) {
+		return ""
+	}
+	return `
+# Kerberos: the realm and the add-on configuration
+cp /conf/krb5.conf /conf/kerberos.conf /tmp/neo4j-config/
+`
+}
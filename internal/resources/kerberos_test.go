@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func kerberosTestCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := configOverridesTestCluster()
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{
+		Provider: "kerberos",
+		Kerberos: &neo4jv1alpha1.KerberosAuthSpec{
+			Realm:            "EXAMPLE.COM",
+			ServicePrincipal: "neo4j/neo4j.example.com@EXAMPLE.COM",
+			Keytab:           &neo4jv1alpha1.KerberosKeytabSpec{SecretRef: "neo4j-keytab"},
+			KDCs:             []string{"kdc-0.example.com:88", "kdc-1.example.com:88"},
+			AdminServer:      "kdc-0.example.com",
+			Domains:          []string{".example.com"},
+		},
+	}
+	return cluster
+}
+
+func TestBuildKerberosConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := kerberosTestCluster()
+	configMap := BuildConfigMapForEnterprise(cluster)
+	g.Expect(configMap.Data["krb5.conf"]).To(Equal(`# Generated from spec.auth.kerberos
+[libdefaults]
+    default_realm = EXAMPLE.COM
+    dns_lookup_kdc = false
+
+[realms]
+    EXAMPLE.COM = {
+        kdc = kdc-0.example.com:88
+        kdc = kdc-1.example.com:88
+        admin_server = kdc-0.example.com
+    }
+
+[domain_realm]
+    .example.com = EXAMPLE.COM
+`))
+	g.Expect(configMap.Data["kerberos.conf"]).To(ContainSubstring("keytab=/kerberos/neo4j.keytab\nservice.principal=neo4j/neo4j.example.com@EXAMPLE.COM\n"))
+	g.Expect(configMap.Data["neo4j.conf"]).To(ContainSubstring("server.jvm.additional=-Djava.security.krb5.conf=/tmp/neo4j-config/krb5.conf\n"))
+	g.Expect(configMap.Data["startup.sh"]).To(ContainSubstring("cp /conf/krb5.conf /conf/kerberos.conf /tmp/neo4j-config/"))
+
+	// Without LDAP, roles come from native users
+	g.Expect(buildAuthProvidersConfig(cluster)).To(Equal(`
+# Auth providers (spec.auth)
+dbms.security.authentication_providers=native,` + KerberosAuthenticationProvider + `
+dbms.security.authorization_providers=native
+`))
+
+	// With LDAP, it only looks up the groups of the users
+	cluster.Spec.Auth.LDAP = &neo4jv1alpha1.LDAPAuthSpec{
+		Server:          &neo4jv1alpha1.LDAPServerSpec{URLs: []string{"ldaps://ldap.example.com"}},
+		BindCredentials: &neo4jv1alpha1.LDAPBindCredentialsSpec{SecretRef: "ldap-bind"},
+		UserSearch:      &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=users,dc=example,dc=com"},
+	}
+	g.Expect(buildAuthProvidersConfig(cluster)).To(ContainSubstring("dbms.security.authorization_providers=native,ldap\n"))
+	g.Expect(buildLDAPConfig(cluster)).ToNot(ContainSubstring("dbms.security.ldap.authentication"))
+
+	cluster.Spec.Auth.Kerberos.KDCs = nil
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["krb5.conf"]).To(ContainSubstring("dns_lookup_kdc = true\n"))
+
+	cluster.Spec.Auth.Provider = "native"
+	configMap = BuildConfigMapForEnterprise(cluster)
+	g.Expect(configMap.Data).ToNot(HaveKey("krb5.conf"))
+	g.Expect(configMap.Data["startup.sh"]).ToNot(ContainSubstring("krb5.conf"))
+}
+
+func TestKerberosStatefulSet(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := kerberosTestCluster()
+	readOnly := int32(0o400)
+	for _, sts := range append(BuildServerStatefulSetsForEnterprise(cluster), BuildServerGroupStatefulSetsForEnterprise(cluster)...) {
+		g.Expect(sts.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name: kerberosKeytabVolume, MountPath: kerberosKeytabDirectory, ReadOnly: true,
+		}))
+		g.Expect(sts.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: kerberosKeytabVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName:  "neo4j-keytab",
+				Items:       []corev1.KeyToPath{{Key: "keytab", Path: kerberosKeytabFile}},
+				DefaultMode: &readOnly,
+			}},
+		}))
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	ldapCAVolume    = "ldap-ca"
+	ldapCADirectory = "/ldap-ca"
+	// ldapTrustStore holds the CAs of the JVM and the LDAP CA. It is built
+	// by the startup script, as keytool needs the image's JVM.
+	ldapTrustStore         = "/tmp/ldap-truststore"
+	ldapTrustStorePassword = "changeit"
+
+	ldapBindDNEnv       = "LDAP_BIND_DN"
+	ldapBindPasswordEnv = "LDAP_BIND_PASSWORD"
+)
+
+// LDAPEnabled reports whether the servers use LDAP through spec.auth.ldap:
+// to authenticate users and look up their groups, or only to look up the
+// groups of the users Kerberos authenticated
+func LDAPEnabled(auth *neo4jv1alpha1.AuthSpec) bool {
+	return auth != nil && (auth.Provider == "ldap" || auth.Provider == "kerberos") && auth.LDAP != nil
+}
+
+// buildLDAPConfig renders spec.auth.ldap as neo4j.conf settings. The bind
+// credentials are added by the startup script, so the password stays out of
+// the ConfigMap.
+func buildLDAPConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !LDAPEnabled(cluster.Spec.Auth) {
+		return ""
+	}
+	ldap := cluster.Spec.Auth.LDAP
+
+	settings := &authSettings{config: cluster.Spec.Config}
+	if ldap.Server != nil {
+		settings.set("dbms.security.ldap.host", strings.Join(ldap.Server.URLs, " "))
+		if ldap.Server.TLS && ldapUsesPlainURL(ldap.Server.URLs) {
+			settings.set("dbms.security.ldap.use_starttls", "true")
+		}
+	}
+
+	// With Kerberos, LDAP only looks up the groups of users
+	if cluster.Spec.Auth.Provider == "ldap" {
+		if ldap.UserDNTemplate != "" {
+			settings.set("dbms.security.ldap.authentication.user_dn_template", ldap.UserDNTemplate)
+		} else {
+			attribute := ldap.LoginAttribute
+			if attribute == "" {
+				attribute = "samaccountname"
+			}
+			settings.set("dbms.security.ldap.authentication.search_for_attribute", "true")
+			settings.set("dbms.security.ldap.authentication.attribute", attribute)
+		}
+	}
+	if ldap.BindCredentials != nil {
+		settings.set("dbms.security.ldap.authorization.use_system_account", "true")
+	}
+	if ldap.UserSearch != nil {
+		if ldap.UserSearch.BaseDN != "" {
+			settings.set("dbms.security.ldap.authorization.user_search_base", ldap.UserSearch.BaseDN)
+		}
+		if ldap.UserSearch.Filter != "" {
+			settings.set("dbms.security.ldap.authorization.user_search_filter", ldap.UserSearch.Filter)
+		}
+	}
+	if ldap.GroupSearch != nil && ldap.GroupSearch.Filter != "" {
+		settings.set("dbms.security.ldap.authorization.nested_groups_enabled", "true")
+		settings.set("dbms.security.ldap.authorization.nested_groups_search_filter", ldap.GroupSearch.Filter)
+	}
+	if len(ldap.GroupToRoleMapping) > 0 {
+		settings.set("dbms.security.ldap.authorization.group_to_role_mapping", groupToRoleMapping(ldap.GroupToRoleMapping))
+	}
+
+	if ldapCASecret(cluster) != "" {
+		// server.jvm.additional may be repeated
+		settings.lines = append(settings.lines,
+			"server.jvm.additional=-Djavax.net.ssl.trustStore="+ldapTrustStore,
+			"server.jvm.additional=-Djavax.net.ssl.trustStorePassword="+ldapTrustStorePassword)
+	}
+	return settings.render("LDAP authentication (spec.auth.ldap)")
+}
+
+// ldapUsesPlainURL reports whether any of the URLs connects without TLS, so
+// that the connection has to be upgraded with StartTLS
+func ldapUsesPlainURL(urls []string) bool {
+	for _, url := range urls {
+		if !strings.HasPrefix(strings.ToLower(url), "ldaps://") {
+			return true
+		}
+	}
+	return false
+}
+
+// ldapCASecret returns the Secret holding the CA of the LDAP servers, if any
+func ldapCASecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !LDAPEnabled(cluster.Spec.Auth) || cluster.Spec.Auth.LDAP.Server == nil {
+		return ""
+	}
+	return cluster.Spec.Auth.LDAP.Server.CASecret
+}
+
+// applyLDAP passes the bind credentials of spec.auth.ldap to the Neo4j
+// container and mounts the CA of the LDAP servers
+func applyLDAP(sts *appsv1.StatefulSet, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	if !LDAPEnabled(cluster.Spec.Auth) {
+		return
+	}
+	podSpec := &sts.Spec.Template.Spec
+
+	var env []corev1.EnvVar
+	if bind := cluster.Spec.Auth.LDAP.BindCredentials; bind != nil {
+		dnKey, passwordKey := bind.DNKey, bind.PasswordKey
+		if dnKey == "" {
+			dnKey = "bindDN"
+		}
+		if passwordKey == "" {
+			passwordKey = "bindPassword"
+		}
+		secretEnv := func(name, key string) corev1.EnvVar {
+			return corev1.EnvVar{
+				Name: name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: bind.SecretRef},
+						Key:                  key,
+					},
+				},
+			}
+		}
+		env = append(env, secretEnv(ldapBindDNEnv, dnKey), secretEnv(ldapBindPasswordEnv, passwordKey))
+	}
+
+	caSecret := ldapCASecret(cluster)
+	if caSecret != "" {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: ldapCAVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: caSecret,
+				Items:      []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+			}},
+		})
+	}
+
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name != Neo4jContainer {
+			continue
+		}
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env...)
+		if caSecret != "" {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      ldapCAVolume,
+				MountPath: ldapCADirectory,
+				ReadOnly:  true,
+			})
+		}
+	}
+}
+
+// buildLDAPStartup adds the bind credentials of spec.auth.ldap to
+// neo4j.conf and builds the trust store holding the CA of the LDAP servers
+func buildLDAPStartup(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !LDAPEnabled(cluster.Spec.Auth) {
+		return ""
+	}
+	var script strings.Builder
+	if cluster.Spec.Auth.LDAP.BindCredentials != nil {
+		script.WriteString(`
+# LDAP: the system account searches the directory
+cat >> /tmp/neo4j-config/neo4j.conf << EOF
+dbms.security.ldap.authorization.system_username=${` + ldapBindDNEnv + `}
+dbms.security.ldap.authorization.system_password=${` + ldapBindPasswordEnv + `}
+EOF
+`)
+	}
+	if ldapCASecret(cluster) != "" {
+		script.WriteString(`
+# LDAP: trust the CA of the LDAP servers along with the CAs of the JVM
+rm -f ` + ldapTrustStore + `
+cp "${JAVA_HOME}/lib/security/cacerts" ` + ldapTrustStore + `
+chmod u+w ` + ldapTrustStore + `
+keytool -importcert -noprompt -alias ldap-ca -file ` + ldapCADirectory + `/ca.crt -keystore ` + ldapTrustStore + ` -storepass ` + ldapTrustStorePassword + `
+`)
+	}
+	return script.String()
+}
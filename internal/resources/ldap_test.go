@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func ldapTestCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := configOverridesTestCluster()
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{
+		Provider: "ldap",
+		LDAP: &neo4jv1alpha1.LDAPAuthSpec{
+			Server: &neo4jv1alpha1.LDAPServerSpec{
+				URLs:     []string{"ldap://ldap-0.example.com:389", "ldap://ldap-1.example.com:389"},
+				TLS:      true,
+				CASecret: "ldap-ca",
+			},
+			BindCredentials: &neo4jv1alpha1.LDAPBindCredentialsSpec{SecretRef: "ldap-bind"},
+			UserSearch:      &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=users,dc=example,dc=com", Filter: "(&(objectClass=person)(sAMAccountName={0}))"},
+			GroupSearch:     &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=groups,dc=example,dc=com", Filter: "(&(objectClass=group)(member:1.2.840.113556.1.4.1941:={0}))"},
+			GroupToRoleMapping: map[string][]string{
+				"cn=Readers,ou=groups,dc=example,dc=com":      {"reader"},
+				"cn=Neo4j Admins,ou=groups,dc=example,dc=com": {"admin", "publisher"},
+			},
+		},
+	}
+	return cluster
+}
+
+func TestBuildLDAPConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := ldapTestCluster()
+	g.Expect(buildLDAPConfig(cluster)).To(Equal(`
+# LDAP authentication (spec.auth.ldap)
+dbms.security.ldap.host=ldap://ldap-0.example.com:389 ldap://ldap-1.example.com:389
+dbms.security.ldap.use_starttls=true
+dbms.security.ldap.authentication.search_for_attribute=true
+dbms.security.ldap.authentication.attribute=samaccountname
+dbms.security.ldap.authorization.use_system_account=true
+dbms.security.ldap.authorization.user_search_base=ou=users,dc=example,dc=com
+dbms.security.ldap.authorization.user_search_filter=(&(objectClass=person)(sAMAccountName={0}))
+dbms.security.ldap.authorization.nested_groups_enabled=true
+dbms.security.ldap.authorization.nested_groups_search_filter=(&(objectClass=group)(member:1.2.840.113556.1.4.1941:={0}))
+dbms.security.ldap.authorization.group_to_role_mapping="cn=Neo4j Admins,ou=groups,dc=example,dc=com"=admin,publisher;"cn=Readers,ou=groups,dc=example,dc=com"=reader
+server.jvm.additional=-Djavax.net.ssl.trustStore=/tmp/ldap-truststore
+server.jvm.additional=-Djavax.net.ssl.trustStorePassword=changeit
+`))
+
+	g.Expect(buildAuthProvidersConfig(cluster)).To(Equal(`
+# Auth providers (spec.auth)
+dbms.security.authentication_providers=native,ldap
+dbms.security.authorization_providers=native,ldap
+`))
+
+	// spec.config wins over the rendered settings
+	cluster.Spec.Config = map[string]string{"dbms.security.ldap.use_starttls": "false"}
+	cluster.Spec.Auth.LDAP.UserDNTemplate = "uid={0},ou=users,dc=example,dc=com"
+	conf := buildLDAPConfig(cluster)
+	g.Expect(conf).ToNot(ContainSubstring("dbms.security.ldap.use_starttls"))
+	g.Expect(conf).To(ContainSubstring("dbms.security.ldap.authentication.user_dn_template=uid={0},ou=users,dc=example,dc=com\n"))
+	g.Expect(conf).ToNot(ContainSubstring("search_for_attribute"))
+	g.Expect(buildNeo4jConfigForEnterprise(cluster)).To(ContainSubstring(conf))
+
+	cluster.Spec.Auth.Provider = "native"
+	g.Expect(buildLDAPConfig(cluster)).To(BeEmpty())
+	g.Expect(buildAuthProvidersConfig(cluster)).To(BeEmpty())
+}
+
+func TestLDAPStatefulSet(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := ldapTestCluster()
+	for _, sts := range append(BuildServerStatefulSetsForEnterprise(cluster), BuildServerGroupStatefulSetsForEnterprise(cluster)...) {
+		container := sts.Spec.Template.Spec.Containers[0]
+		g.Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: ldapBindDNEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ldap-bind"}, Key: "bindDN",
+			}}},
+			corev1.EnvVar{Name: ldapBindPasswordEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ldap-bind"}, Key: "bindPassword",
+			}}},
+		))
+		g.Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: ldapCAVolume, MountPath: ldapCADirectory, ReadOnly: true}))
+		g.Expect(sts.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: ldapCAVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: "ldap-ca",
+				Items:      []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+			}},
+		}))
+	}
+
+	startup := BuildConfigMapForEnterprise(cluster).Data["startup.sh"]
+	g.Expect(startup).To(ContainSubstring("dbms.security.ldap.authorization.system_password=${LDAP_BIND_PASSWORD}"))
+	g.Expect(startup).To(ContainSubstring("keytool -importcert -noprompt -alias ldap-ca -file /ldap-ca/ca.crt"))
+
+	cluster.Spec.Auth.LDAP.BindCredentials = nil
+	cluster.Spec.Auth.LDAP.Server.CASecret = ""
+	sts := BuildServerStatefulSetForEnterprise(cluster)
+	for _, env := range sts.Spec.Template.Spec.Containers[0].Env {
+		g.Expect(env.Name).ToNot(HavePrefix("LDAP_"))
+	}
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["startup.sh"]).ToNot(ContainSubstring("LDAP"))
+}
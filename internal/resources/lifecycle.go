@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+)
+
+// postStartMarker is written once the post-start script succeeded, so that it
+// only runs once per container start
+const postStartMarker = "/tmp/neo4j-post-start.done"
+
+// LifecycleScripts are the snippets of spec.lifecycle, read from their
+// ConfigMaps by the operator
+type LifecycleScripts struct {
+	// PreStart runs in startup.sh before Neo4j starts
+	PreStart string
+	// PostStart runs in health.sh once Neo4j responds
+	PostStart string
+}
+
+// buildPreStartHook runs the pre-start script in the startup script, after
+// neo4j.conf is complete and before Neo4j starts. It runs in the shell of the
+// startup script, so it can edit /tmp/neo4j-config/neo4j.conf and export
+// environment variables for Neo4j.
+func buildPreStartHook(scripts LifecycleScripts) string {
+	if strings.TrimSpace(scripts.PreStart) == "" {
+		return ""
+	}
+	return `
+# Pre-start script (spec.lifecycle.preStartScript)
+pre_start() {
+` + strings.TrimRight(scripts.PreStart, "\n") + `
+}
+echo "Running pre-start script"
+pre_start
+`
+}
+
+// buildPostStartReset clears the post-start marker of a previous start of the
+// container
+func buildPostStartReset(scripts LifecycleScripts) string {
+	if strings.TrimSpace(scripts.PostStart) == "" {
+		return ""
+	}
+	return `
+# Post-start script (spec.lifecycle.postStartScript) runs again for this start
+rm -f ` + postStartMarker + `
+`
+}
+
+// buildPostStartFunction defines the post-start script in the health script
+func buildPostStartFunction(scripts LifecycleScripts) string {
+	if strings.TrimSpace(scripts.PostStart) == "" {
+		return ""
+	}
+	return `
+# Post-start script (spec.lifecycle.postStartScript)
+post_start() {
+` + strings.TrimRight(scripts.PostStart, "\n") + `
+}
+`
+}
+
+// buildPostStartHook runs the post-start script from the health script the
+// first time Neo4j responds. The server is not healthy until it succeeds.
+func buildPostStartHook(scripts LifecycleScripts) string {
+	if strings.TrimSpace(scripts.PostStart) == "" {
+		return ""
+	}
+	return `    if [ ! -f ` + postStartMarker + ` ]; then
+        echo "Running post-start script"
+        ( set -e; post_start )
+        if [ $? -ne 0 ]; then
+            echo "Post-start script failed"
+            exit 1
+        fi
+        touch ` + postStartMarker + `
+    fi
+`
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBuildConfigMapWithLifecycleScripts(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	plain := BuildConfigMapForEnterprise(cluster)
+	g.Expect(BuildConfigMapWithLifecycleScripts(cluster, LifecycleScripts{PreStart: " \n"}).Data).To(Equal(plain.Data))
+	g.Expect(plain.Data["startup.sh"]).ToNot(ContainSubstring("pre_start"))
+	g.Expect(plain.Data["health.sh"]).ToNot(ContainSubstring("post_start"))
+
+	configMap := BuildConfigMapWithLifecycleScripts(cluster, LifecycleScripts{
+		PreStart:  "echo 'dbms.usage_report.enabled=false' >> /tmp/neo4j-config/neo4j.conf\n",
+		PostStart: "touch /tmp/site-ready",
+	})
+	g.Expect(configMap.Data["neo4j.conf"]).To(Equal(plain.Data["neo4j.conf"]))
+
+	// The pre-start script runs once neo4j.conf is complete, before Neo4j
+	startup := configMap.Data["startup.sh"]
+	g.Expect(startup).To(ContainSubstring("pre_start() {\necho 'dbms.usage_report.enabled=false' >> /tmp/neo4j-config/neo4j.conf\n}\n"))
+	g.Expect(startup).To(MatchRegexp(`(?s)\npre_start\n.*export NEO4J_CONF=/tmp/neo4j-config\n`))
+	g.Expect(startup).To(ContainSubstring("rm -f " + postStartMarker))
+
+	// The post-start script runs once, in the healthy branch
+	health := configMap.Data["health.sh"]
+	g.Expect(health).To(ContainSubstring("post_start() {\ntouch /tmp/site-ready\n}\n"))
+	g.Expect(health).To(MatchRegexp(`(?s)HTTP port responding - healthy"\n    if \[ ! -f ` + postStartMarker + ` \].*\( set -e; post_start \).*touch ` + postStartMarker + `\n    fi\n    exit 0\n`))
+}
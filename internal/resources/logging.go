@@ -0,0 +1,331 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// LogFormatJSON is the spec.logging.format writing one JSON object per
+	// line
+	LogFormatJSON = "json"
+
+	// LogForwarderContainer is the name of the Fluent Bit sidecar
+	LogForwarderContainer = "log-forwarder"
+
+	logForwarderImageRepoDefault = "fluent/fluent-bit"
+	logForwarderImageTagDefault  = "3.2"
+
+	// logForwarderConfigPath is where the sidecar mounts the config
+	// ConfigMap of the server
+	logForwarderConfigPath = "/fluent-bit/etc/neo4j"
+	// logsPath is the log directory of the servers
+	logsPath = "/logs"
+
+	serverLogsConfigFile = "server-logs.xml"
+	userLogsConfigFile   = "user-logs.xml"
+	fluentBitConfigFile  = "fluent-bit.conf"
+	fluentBitParsersFile = "parsers.conf"
+)
+
+// LogNames are the Neo4j logs the forwarder can ship
+var LogNames = []string{"neo4j", "debug", "security", "query", "http"}
+
+// JSONLogsEnabled reports whether the servers write their logs as JSON
+func JSONLogsEnabled(logging *neo4jv1alpha1.LoggingSpec) bool {
+	return logging != nil && logging.Format == LogFormatJSON
+}
+
+// LogForwarderEnabled reports whether the Fluent Bit sidecar runs next to
+// the servers
+func LogForwarderEnabled(logging *neo4jv1alpha1.LoggingSpec) bool {
+	return logging != nil && logging.Forwarder != nil && logging.Forwarder.Enabled
+}
+
+// BuildLoggingConfig returns the neo4j.conf lines pointing the servers at
+// the generated JSON log configurations, or nothing for the text format
+func BuildLoggingConfig(logging *neo4jv1alpha1.LoggingSpec) string {
+	if !JSONLogsEnabled(logging) {
+		return ""
+	}
+	return "\n# Logging (spec.logging.format: json)\n" +
+		"server.logs.config=/conf/" + serverLogsConfigFile + "\n" +
+		"server.logs.user.config=/conf/" + userLogsConfigFile + "\n"
+}
+
+// BuildLoggingConfigFiles returns the files spec.logging adds to the config
+// ConfigMap of a cluster or standalone: the Log4j configurations of the
+// JSON format and the Fluent Bit configuration of the forwarder
+func BuildLoggingConfigFiles(logging *neo4jv1alpha1.LoggingSpec) map[string]string {
+	files := map[string]string{}
+	if JSONLogsEnabled(logging) {
+		files[serverLogsConfigFile] = serverLogsJSONConfig
+		files[userLogsConfigFile] = userLogsJSONConfig
+	}
+	if LogForwarderEnabled(logging) {
+		files[fluentBitConfigFile] = buildFluentBitConfig(logging)
+		files[fluentBitParsersFile] = fluentBitParsers
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return files
+}
+
+// BuildLogForwarderContainer returns the Fluent Bit sidecar tailing the
+// logs volume of a server of the named cluster or standalone
+func BuildLogForwarderContainer(name string, logging *neo4jv1alpha1.LoggingSpec, configVolume, logsVolume string,
+	securityContext *corev1.SecurityContext) corev1.Container {
+	forwarder := logging.Forwarder
+
+	image := neo4jv1alpha1.ImageSpec{Repo: logForwarderImageRepoDefault, Tag: logForwarderImageTagDefault}
+	if forwarder.Image != nil {
+		image = *forwarder.Image
+		if image.Repo == "" {
+			image.Repo = logForwarderImageRepoDefault
+		}
+		if image.Tag == "" {
+			image.Tag = logForwarderImageTagDefault
+		}
+	}
+
+	container := corev1.Container{
+		Name:            LogForwarderContainer,
+		Image:           ImageReference(image),
+		ImagePullPolicy: ImagePullPolicy(image),
+		Args:            []string{"-c", logForwarderConfigPath + "/" + fluentBitConfigFile},
+		Env: []corev1.EnvVar{
+			{Name: "NEO4J_CLUSTER", Value: name},
+			{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			{Name: "POD_NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+		},
+		SecurityContext: securityContext,
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: configVolume, MountPath: logForwarderConfigPath, ReadOnly: true},
+			// Writable for the tail offsets, which survive restarts of the sidecar
+			{Name: logsVolume, MountPath: logsPath},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		},
+	}
+	if forwarder.CredentialsSecret != "" {
+		container.EnvFrom = []corev1.EnvFromSource{{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: forwarder.CredentialsSecret}},
+		}}
+	}
+	if forwarder.Resources != nil {
+		container.Resources = *forwarder.Resources
+	}
+	return container
+}
+
+// forwardedLogs returns the logs the forwarder ships, in LogNames order
+func forwardedLogs(forwarder *neo4jv1alpha1.LogForwarderSpec) []string {
+	if len(forwarder.Logs) == 0 {
+		return LogNames
+	}
+	var logs []string
+	for _, name := range LogNames {
+		for _, selected := range forwarder.Logs {
+			if selected == name {
+				logs = append(logs, name)
+				break
+			}
+		}
+	}
+	return logs
+}
+
+// buildFluentBitConfig renders the Fluent Bit configuration tailing the
+// selected logs. Records get the cluster, namespace, pod and log file, and
+// the database when the message names one as [database/id].
+func buildFluentBitConfig(logging *neo4jv1alpha1.LoggingSpec) string {
+	forwarder := logging.Forwarder
+	messageKey := "log"
+	if JSONLogsEnabled(logging) {
+		messageKey = "message"
+	}
+
+	var config strings.Builder
+	config.WriteString("# Generated from spec.logging.forwarder\n")
+	fmt.Fprintf(&config, "[SERVICE]\n    Flush        5\n    Log_Level    info\n    Parsers_File %s/%s\n",
+		logForwarderConfigPath, fluentBitParsersFile)
+
+	for _, name := range forwardedLogs(forwarder) {
+		fmt.Fprintf(&config, "\n[INPUT]\n    Name             tail\n    Tag              neo4j.%s\n    Path             %s/%s.log\n",
+			name, logsPath, name)
+		fmt.Fprintf(&config, "    Path_Key         log_file\n    DB               %s/.fluent-bit-%s.db\n", logsPath, name)
+		if JSONLogsEnabled(logging) {
+			config.WriteString("    Parser           json\n")
+		} else {
+			config.WriteString("    multiline.parser neo4j_multiline\n")
+		}
+		config.WriteString("    Mem_Buf_Limit    16MB\n    Skip_Long_Lines  On\n")
+	}
+
+	fmt.Fprintf(&config, "\n[FILTER]\n    Name         parser\n    Match        neo4j.*\n    Key_Name     %s\n"+
+		"    Parser       neo4j_database\n    Reserve_Data On\n    Preserve_Key On\n", messageKey)
+	config.WriteString("\n[FILTER]\n    Name   modify\n    Match  neo4j.*\n" +
+		"    Add    cluster ${NEO4J_CLUSTER}\n    Add    namespace ${POD_NAMESPACE}\n    Add    pod ${POD_NAME}\n")
+
+	outputs := forwarder.Outputs
+	if len(outputs) == 0 {
+		outputs = []neo4jv1alpha1.LogOutputSpec{{Name: "stdout", Properties: map[string]string{"Format": "json_lines"}}}
+	}
+	for _, output := range outputs {
+		match := output.Match
+		if match == "" {
+			match = "neo4j.*"
+		}
+		fmt.Fprintf(&config, "\n[OUTPUT]\n    Name  %s\n    Match %s\n", output.Name, match)
+		keys := make([]string, 0, len(output.Properties))
+		for key := range output.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&config, "    %s %s\n", key, output.Properties[key])
+		}
+	}
+	return config.String()
+}
+
+// fluentBitParsers parses the JSON logs, joins the continuation lines of
+// the text logs, such as stack traces, to their record, and extracts the
+// database of a message
+const fluentBitParsers = `# Generated from spec.logging.forwarder
+[PARSER]
+    Name   json
+    Format json
+
+[PARSER]
+    Name   neo4j_database
+    Format regex
+    Regex  \[(?<database>[^/\]\s]+)/[0-9a-f]{8}\]
+
+[MULTILINE_PARSER]
+    Name          neo4j_multiline
+    Type          regex
+    Flush_Timeout 1000
+    Rule          "start_state" "/^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}/" "cont"
+    Rule          "cont"        "/^(?!\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2})/" "cont"
+`
+
+// serverLogsJSONConfig is the Log4j configuration of debug.log, http.log,
+// query.log and security.log in the JSON layouts of Neo4j, rolled as by
+// default
+const serverLogsJSONConfig = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- Generated from spec.logging.format -->
+<Configuration status="ERROR" monitorInterval="30" packages="org.neo4j.logging.log4j">
+    <Appenders>
+        <RollingRandomAccessFile name="DebugLog" fileName="${config:server.directories.logs}/debug.log"
+                                 filePattern="$${config:server.directories.logs}/debug.log.%02i">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/StructuredLayoutWithMessage.json"/>
+            <Policies>
+                <SizeBasedTriggeringPolicy size="20 MB"/>
+            </Policies>
+            <DefaultRolloverStrategy fileIndex="min" max="7"/>
+        </RollingRandomAccessFile>
+
+        <RollingRandomAccessFile name="HttpLog" fileName="${config:server.directories.logs}/http.log"
+                                 filePattern="$${config:server.directories.logs}/http.log.%02i">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/StructuredLayoutWithMessage.json"/>
+            <Policies>
+                <SizeBasedTriggeringPolicy size="20 MB"/>
+            </Policies>
+            <DefaultRolloverStrategy fileIndex="min" max="5"/>
+        </RollingRandomAccessFile>
+
+        <RollingRandomAccessFile name="QueryLog" fileName="${config:server.directories.logs}/query.log"
+                                 filePattern="$${config:server.directories.logs}/query.log.%02i">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/QueryLogJsonLayout.json"/>
+            <Policies>
+                <SizeBasedTriggeringPolicy size="20 MB"/>
+            </Policies>
+            <DefaultRolloverStrategy fileIndex="min" max="7"/>
+        </RollingRandomAccessFile>
+
+        <RollingRandomAccessFile name="SecurityLog" fileName="${config:server.directories.logs}/security.log"
+                                 filePattern="$${config:server.directories.logs}/security.log.%02i">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/StructuredLayoutWithMessage.json"/>
+            <Policies>
+                <SizeBasedTriggeringPolicy size="20 MB"/>
+            </Policies>
+            <DefaultRolloverStrategy fileIndex="min" max="7"/>
+        </RollingRandomAccessFile>
+    </Appenders>
+
+    <Loggers>
+        <Root level="INFO">
+            <AppenderRef ref="DebugLog"/>
+        </Root>
+        <Logger name="QueryLogger" level="INFO" additivity="false">
+            <AppenderRef ref="QueryLog"/>
+        </Logger>
+        <Logger name="HttpLogger" level="INFO" additivity="false">
+            <AppenderRef ref="HttpLog"/>
+        </Logger>
+        <Logger name="SecurityLogger" level="INFO" additivity="false">
+            <AppenderRef ref="SecurityLog"/>
+        </Logger>
+    </Loggers>
+</Configuration>
+`
+
+// userLogsJSONConfig is the Log4j configuration of neo4j.log and the
+// console in the JSON layout of Neo4j
+const userLogsJSONConfig = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- Generated from spec.logging.format -->
+<Configuration status="ERROR" monitorInterval="30" packages="org.neo4j.logging.log4j">
+    <Appenders>
+        <RollingRandomAccessFile name="Neo4jLog" fileName="${config:server.directories.logs}/neo4j.log"
+                                 filePattern="$${config:server.directories.logs}/neo4j.log.%02i">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/StructuredLayoutWithMessage.json"/>
+            <Policies>
+                <SizeBasedTriggeringPolicy size="20 MB"/>
+            </Policies>
+            <DefaultRolloverStrategy fileIndex="min" max="7"/>
+        </RollingRandomAccessFile>
+
+        <Console name="ConsoleAppender" target="SYSTEM_OUT">
+            <JsonTemplateLayout eventTemplateUri="classpath:org/neo4j/logging/StructuredLayoutWithMessage.json"/>
+        </Console>
+    </Appenders>
+
+    <Loggers>
+        <Root level="INFO">
+            <AppenderRef ref="Neo4jLog"/>
+            <AppenderRef ref="ConsoleAppender"/>
+        </Root>
+    </Loggers>
+</Configuration>
+`
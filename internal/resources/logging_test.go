@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func loggingTestCluster(logging *neo4jv1alpha1.LoggingSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	cluster.Spec.Image.Repo = "neo4j"
+	cluster.Spec.Image.Tag = "5.26.0-enterprise"
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Storage.Size = "10Gi"
+	cluster.Spec.Logging = logging
+	return cluster
+}
+
+func TestBuildLoggingConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(BuildLoggingConfig(nil)).To(BeEmpty())
+	g.Expect(BuildLoggingConfigFiles(&neo4jv1alpha1.LoggingSpec{Format: "text"})).To(BeNil())
+
+	logging := &neo4jv1alpha1.LoggingSpec{Format: LogFormatJSON}
+	g.Expect(BuildLoggingConfig(logging)).To(ContainSubstring("server.logs.config=/conf/server-logs.xml"))
+	g.Expect(BuildLoggingConfig(logging)).To(ContainSubstring("server.logs.user.config=/conf/user-logs.xml"))
+
+	files := BuildLoggingConfigFiles(logging)
+	g.Expect(files).To(HaveLen(2))
+	g.Expect(files["server-logs.xml"]).To(ContainSubstring("JsonTemplateLayout"))
+	g.Expect(files["user-logs.xml"]).To(ContainSubstring("JsonTemplateLayout"))
+
+	configMap := BuildConfigMapForEnterprise(loggingTestCluster(logging))
+	g.Expect(configMap.Data).To(HaveKey("server-logs.xml"))
+	g.Expect(configMap.Data["neo4j.conf"]).To(ContainSubstring("server.logs.config=/conf/server-logs.xml"))
+}
+
+func TestBuildFluentBitConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	logging := &neo4jv1alpha1.LoggingSpec{Forwarder: &neo4jv1alpha1.LogForwarderSpec{
+		Enabled: true,
+		Logs:    []string{"query", "neo4j"},
+	}}
+	config := BuildLoggingConfigFiles(logging)["fluent-bit.conf"]
+	g.Expect(config).To(ContainSubstring("Path             /logs/neo4j.log"))
+	g.Expect(config).To(ContainSubstring("Path             /logs/query.log"))
+	g.Expect(config).ToNot(ContainSubstring("/logs/debug.log"))
+	g.Expect(config).To(ContainSubstring("multiline.parser neo4j_multiline"))
+	g.Expect(config).To(ContainSubstring("Key_Name     log"))
+	g.Expect(config).To(ContainSubstring("Add    cluster ${NEO4J_CLUSTER}"))
+	g.Expect(config).To(ContainSubstring("Name  stdout\n    Match neo4j.*\n    Format json_lines"))
+
+	logging.Format = LogFormatJSON
+	logging.Forwarder.Outputs = []neo4jv1alpha1.LogOutputSpec{{
+		Name:       "loki",
+		Match:      "neo4j.query",
+		Properties: map[string]string{"Port": "3100", "Host": "loki.monitoring"},
+	}}
+	config = BuildLoggingConfigFiles(logging)["fluent-bit.conf"]
+	g.Expect(config).To(ContainSubstring("Parser           json"))
+	g.Expect(config).To(ContainSubstring("Key_Name     message"))
+	g.Expect(config).To(ContainSubstring("Name  loki\n    Match neo4j.query\n    Host loki.monitoring\n    Port 3100\n"))
+	g.Expect(config).ToNot(ContainSubstring("Name  stdout"))
+}
+
+func TestLogForwarderContainer(t *testing.T) {
+	g := NewWithT(t)
+
+	podSpec := BuildPodSpecForEnterprise(loggingTestCluster(nil), "server", "neo4j-admin-secret")
+	g.Expect(podSpec.Containers).To(HaveLen(1))
+
+	cluster := loggingTestCluster(&neo4jv1alpha1.LoggingSpec{Forwarder: &neo4jv1alpha1.LogForwarderSpec{
+		Enabled:           true,
+		CredentialsSecret: "log-credentials",
+	}})
+	podSpec = BuildPodSpecForEnterprise(cluster, "server", "neo4j-admin-secret")
+	g.Expect(podSpec.Containers).To(HaveLen(2))
+	g.Expect(podSpec.Containers[0].Name).To(Equal("neo4j"))
+
+	forwarder := podSpec.Containers[1]
+	g.Expect(forwarder.Name).To(Equal(LogForwarderContainer))
+	g.Expect(forwarder.Image).To(Equal("fluent/fluent-bit:3.2"))
+	g.Expect(forwarder.Args).To(Equal([]string{"-c", "/fluent-bit/etc/neo4j/fluent-bit.conf"}))
+	g.Expect(forwarder.Env[0].Value).To(Equal("test-cluster"))
+	g.Expect(forwarder.EnvFrom).To(HaveLen(1))
+	g.Expect(forwarder.EnvFrom[0].SecretRef.Name).To(Equal("log-credentials"))
+	var mounts []string
+	for _, mount := range forwarder.VolumeMounts {
+		mounts = append(mounts, mount.Name)
+	}
+	g.Expect(mounts).To(Equal([]string{ConfigVolume, LogsVolume}))
+
+	configMap := BuildConfigMapForEnterprise(cluster)
+	g.Expect(configMap.Data).To(HaveKey("fluent-bit.conf"))
+	g.Expect(configMap.Data).To(HaveKey("parsers.conf"))
+}
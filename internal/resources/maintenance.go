@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// MaintenanceAnnotation puts a single server pod into maintenance when set
+// to "true"
+const MaintenanceAnnotation = "neo4j.com/maintenance"
+
+// RoutingLabel tells whether the client Service may send connections to a
+// server pod
+const RoutingLabel = "neo4j.com/routing"
+
+// Values of RoutingLabel
+const (
+	RoutingEnabled = "enabled"
+	RoutingDrained = "drained"
+)
+
+// ClusterInMaintenance reports whether spec.maintenance pauses the cluster
+func ClusterInMaintenance(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Maintenance != nil && cluster.Spec.Maintenance.Enabled
+}
+
+// PodInMaintenance reports whether a server pod carries the maintenance
+// annotation
+func PodInMaintenance(pod *corev1.Pod) bool {
+	return pod.Annotations[MaintenanceAnnotation] == "true"
+}
+
+// clientServiceSelector selects the server pods behind the client Service.
+// While a server is in maintenance only pods with routing enabled are
+// selected; otherwise every server is, so a restarted pod is never left out
+// before the operator has labeled it.
+func clientServiceSelector(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]string {
+	selector := map[string]string{"neo4j.com/cluster": cluster.Name}
+	if len(cluster.Status.MaintenanceServers) > 0 {
+		selector[RoutingLabel] = RoutingEnabled
+	}
+	return selector
+}
@@ -113,7 +113,7 @@ func BuildMCPDeploymentForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		Volumes:          volumes,
 	}
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-mcp", cluster.Name),
 			Namespace: cluster.Namespace,
@@ -132,6 +132,8 @@ func BuildMCPDeploymentForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 			},
 		},
 	}
+	ApplySecurityProfiles(&deployment.Spec.Template, mcpSecurityProfiles(mcp, cluster.Spec.SecurityContext))
+	return deployment
 }
 
 // BuildMCPDeploymentForStandalone builds the MCP Deployment for a standalone deployment.
@@ -199,7 +201,7 @@ func BuildMCPDeploymentForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseSt
 		Volumes:          volumes,
 	}
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-mcp", standalone.Name),
 			Namespace: standalone.Namespace,
@@ -218,6 +220,8 @@ func BuildMCPDeploymentForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseSt
 			},
 		},
 	}
+	ApplySecurityProfiles(&deployment.Spec.Template, mcpSecurityProfiles(mcp, standalone.Spec.SecurityContext))
+	return deployment
 }
 
 // BuildMCPServiceForCluster builds the MCP Service for a cluster.
@@ -496,6 +500,9 @@ func mcpImage(spec *neo4jv1alpha1.MCPServerSpec) string {
 	if repo == "" || tag == "" {
 		return ""
 	}
+	if spec != nil && spec.Image != nil {
+		repo = mirroredRepository(repo, spec.Image.Registry)
+	}
 	return fmt.Sprintf("%s:%s", repo, tag)
 }
 
@@ -544,7 +551,7 @@ func mcpHTTPHost(spec *neo4jv1alpha1.MCPServerSpec) string {
 
 func mcpNeo4jURIForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
 	serviceName := fmt.Sprintf("%s-client", cluster.Name)
-	if cluster.Spec.TLS != nil && cluster.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(cluster.Spec.TLS) {
 		return fmt.Sprintf("neo4j+ssc://%s.%s.svc.cluster.local:7687", serviceName, cluster.Namespace)
 	}
 	return fmt.Sprintf("neo4j://%s.%s.svc.cluster.local:7687", serviceName, cluster.Namespace)
@@ -552,7 +559,7 @@ func mcpNeo4jURIForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string
 
 func mcpNeo4jURIForStandalone(standalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) string {
 	serviceName := fmt.Sprintf("%s-service", standalone.Name)
-	if standalone.Spec.TLS != nil && standalone.Spec.TLS.Mode == CertManagerMode {
+	if TLSEnabled(standalone.Spec.TLS) {
 		return fmt.Sprintf("bolt+ssc://%s.%s.svc.cluster.local:7687", serviceName, standalone.Namespace)
 	}
 	return fmt.Sprintf("bolt://%s.%s.svc.cluster.local:7687", serviceName, standalone.Namespace)
@@ -711,24 +718,17 @@ func mcpTLSVolumeMount() corev1.VolumeMount {
 }
 
 func imagePullPolicy(spec *neo4jv1alpha1.MCPServerSpec) corev1.PullPolicy {
-	if spec != nil && spec.Image != nil && spec.Image.PullPolicy != "" {
-		return corev1.PullPolicy(spec.Image.PullPolicy)
+	if spec != nil && spec.Image != nil {
+		return ImagePullPolicy(*spec.Image)
 	}
 	return corev1.PullIfNotPresent
 }
 
 func imagePullSecrets(spec *neo4jv1alpha1.MCPServerSpec) []corev1.LocalObjectReference {
-	if spec == nil || spec.Image == nil || len(spec.Image.PullSecrets) == 0 {
+	if spec == nil || spec.Image == nil {
 		return nil
 	}
-	secrets := make([]corev1.LocalObjectReference, 0, len(spec.Image.PullSecrets))
-	for _, secret := range spec.Image.PullSecrets {
-		if secret == "" {
-			continue
-		}
-		secrets = append(secrets, corev1.LocalObjectReference{Name: secret})
-	}
-	return secrets
+	return ImagePullSecrets(*spec.Image)
 }
 
 func resourceRequirements(spec *neo4jv1alpha1.MCPServerSpec) corev1.ResourceRequirements {
@@ -762,6 +762,16 @@ func mcpAuthSecretName(auth *neo4jv1alpha1.AuthSpec, mcp *neo4jv1alpha1.MCPServe
 	return name, usernameKey, passwordKey
 }
 
+// mcpSecurityProfiles returns the spec carrying seccomp/AppArmor settings for
+// MCP pods. MCP pods are scheduled onto the same nodes as Neo4j, so they
+// inherit the parent's profiles unless the MCP spec overrides its security context.
+func mcpSecurityProfiles(spec *neo4jv1alpha1.MCPServerSpec, parent *neo4jv1alpha1.SecurityContextSpec) *neo4jv1alpha1.SecurityContextSpec {
+	if spec != nil && spec.SecurityContext != nil {
+		return spec.SecurityContext
+	}
+	return parent
+}
+
 func mcpSecurityContext(spec *neo4jv1alpha1.MCPServerSpec) (*corev1.PodSecurityContext, *corev1.SecurityContext) {
 	podContext := &corev1.PodSecurityContext{
 		RunAsUser:    ptr.To(defaultMCPUID),
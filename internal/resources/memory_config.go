@@ -44,6 +44,28 @@ const (
 	MinSystemMemoryReserved = 256 * 1024 * 1024 // 256MB in bytes
 )
 
+// memoryBracket maps an amount of memory to the share of it recommended for
+// one use, both in GiB
+type memoryBracket struct {
+	memory      float64
+	recommended float64
+}
+
+var (
+	// osMemoryBrackets is the memory neo4j-admin server memory-recommendation
+	// leaves to the operating system, by total memory
+	osMemoryBrackets = []memoryBracket{
+		{0.01, 0.007}, {1, 0.65}, {2, 1}, {4, 1.5}, {8, 2}, {16, 3},
+		{32, 4}, {64, 5}, {128, 7}, {256, 12}, {512, 16}, {1024, 32},
+	}
+	// heapMemoryBrackets is the heap neo4j-admin server memory-recommendation
+	// recommends, by the memory left after the operating system's share
+	heapMemoryBrackets = []memoryBracket{
+		{0.01, 0.007}, {0.8, 0.65}, {1, 0.8}, {2, 1.5}, {3, 2}, {6, 3},
+		{10, 6}, {14, 8}, {20, 12}, {32, 16}, {96, 24}, {256, 31},
+	}
+)
+
 // MemoryConfig represents Neo4j memory configuration
 type MemoryConfig struct {
 	HeapInitialSize string
@@ -60,8 +82,9 @@ func calculateSystemMemoryReserved(memoryBytes int64) int64 {
 	return SystemMemoryReserved // 512MB for larger containers
 }
 
-// CalculateOptimalMemorySettings calculates optimal memory settings based on container resources
-func CalculateOptimalMemorySettings(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) MemoryConfig {
+// containerMemoryLimit returns the memory limit of the Neo4j container in
+// bytes, the default limit when the cluster sets none
+func containerMemoryLimit(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) int64 {
 	var memoryLimit resource.Quantity
 
 	// Get memory limit from cluster spec or use default
@@ -76,8 +99,12 @@ func CalculateOptimalMemorySettings(cluster *neo4jv1alpha1.Neo4jEnterpriseCluste
 		memoryLimit = resource.MustParse(DefaultMemoryLimit)
 	}
 
-	// Convert to bytes
-	memoryBytes := memoryLimit.Value()
+	return memoryLimit.Value()
+}
+
+// CalculateOptimalMemorySettings calculates optimal memory settings based on container resources
+func CalculateOptimalMemorySettings(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) MemoryConfig {
+	memoryBytes := containerMemoryLimit(cluster)
 
 	// Calculate heap size (50% of container memory, with min/max constraints)
 	heapBytes := int64(float64(memoryBytes) * DefaultHeapPercentage)
@@ -152,18 +179,7 @@ func CalculateOptimalMemoryForNeo4j526Plus(cluster *neo4jv1alpha1.Neo4jEnterpris
 	baseConfig := CalculateOptimalMemorySettings(cluster)
 
 	// Neo4j 5.26+ optimizations
-	var memoryLimit resource.Quantity
-	if cluster.Spec.Resources != nil && cluster.Spec.Resources.Limits != nil {
-		if limit, exists := cluster.Spec.Resources.Limits[corev1.ResourceMemory]; exists {
-			memoryLimit = limit
-		}
-	}
-
-	if memoryLimit.IsZero() {
-		memoryLimit = resource.MustParse(DefaultMemoryLimit)
-	}
-
-	memoryBytes := memoryLimit.Value()
+	memoryBytes := containerMemoryLimit(cluster)
 
 	// For Neo4j 5.26+, we can be more aggressive with memory allocation
 	// due to improved memory management and garbage collection
@@ -205,6 +221,63 @@ func CalculateOptimalMemoryForNeo4j526Plus(cluster *neo4jv1alpha1.Neo4jEnterpris
 	return baseConfig
 }
 
+// CalculateAutoTunedMemory sizes heap and page cache for a container memory
+// limit the way neo4j-admin server memory-recommendation does: the operating
+// system's share comes off first, the heap is taken from what is left and
+// the page cache gets the rest. The transaction memory limits follow from
+// the heap.
+func CalculateAutoTunedMemory(memoryBytes int64) MemoryConfig {
+	osBytes := recommendMemory(osMemoryBrackets, memoryBytes)
+
+	heapBytes := recommendMemory(heapMemoryBrackets, memoryBytes-osBytes)
+	if heapBytes < MinHeapSize {
+		heapBytes = MinHeapSize
+	}
+	if heapBytes > MaxHeapSize {
+		heapBytes = MaxHeapSize
+	}
+
+	// neo4j-admin never recommends less than 8MB of page cache
+	pageCacheBytes := memoryBytes - osBytes - heapBytes
+	if pageCacheBytes < 8*1024*1024 {
+		pageCacheBytes = 8 * 1024 * 1024
+	}
+
+	return MemoryConfig{
+		HeapInitialSize: formatMebibytes(heapBytes),
+		HeapMaxSize:     formatMebibytes(heapBytes),
+		PageCacheSize:   formatMebibytes(pageCacheBytes),
+	}
+}
+
+// recommendMemory interpolates linearly between the brackets around bytes.
+// Memory beyond the last bracket gets the last recommendation.
+func recommendMemory(brackets []memoryBracket, bytes int64) int64 {
+	const GiB = 1024 * 1024 * 1024
+	memory := float64(bytes) / GiB
+
+	lower := memoryBracket{}
+	for _, upper := range brackets {
+		if memory <= upper.memory {
+			share := (memory - lower.memory) / (upper.memory - lower.memory)
+			return int64((lower.recommended + share*(upper.recommended-lower.recommended)) * GiB)
+		}
+		lower = upper
+	}
+	return int64(lower.recommended * GiB)
+}
+
+// formatMebibytes rounds bytes down to whole megabytes, and states them in
+// gigabytes when that is exact, so the size never exceeds what was computed
+func formatMebibytes(bytes int64) string {
+	const MB = 1024 * 1024
+	megabytes := bytes / MB
+	if megabytes > 0 && megabytes%1024 == 0 {
+		return fmt.Sprintf("%dG", megabytes/1024)
+	}
+	return fmt.Sprintf("%dM", megabytes)
+}
+
 // formatMemorySize converts bytes to human-readable format with appropriate units
 func formatMemorySize(bytes int64) string {
 	// Ensure non-negative values
@@ -273,6 +346,10 @@ func parseMemorySize(size string) (int64, error) {
 
 // GetMemoryConfigForCluster returns memory configuration for the cluster
 func GetMemoryConfigForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) MemoryConfig {
+	if cluster.Spec.Memory != nil && cluster.Spec.Memory.AutoTune {
+		return autoTunedMemoryConfig(cluster)
+	}
+
 	// Check if custom memory configuration is provided
 	if cluster.Spec.Config != nil {
 		// Check for custom heap settings
@@ -311,3 +388,22 @@ func GetMemoryConfigForCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) Me
 	// Use optimized settings for Neo4j 5.26+
 	return CalculateOptimalMemoryForNeo4j526Plus(cluster)
 }
+
+// autoTunedMemoryConfig returns the auto-tuned memory configuration of the
+// cluster, with the sizes set in spec.config taking precedence
+func autoTunedMemoryConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) MemoryConfig {
+	config := CalculateAutoTunedMemory(containerMemoryLimit(cluster))
+
+	if heapMax, exists := cluster.Spec.Config["server.memory.heap.max_size"]; exists {
+		config.HeapMaxSize = heapMax
+		config.HeapInitialSize = heapMax
+	}
+	if heapInitial, exists := cluster.Spec.Config["server.memory.heap.initial_size"]; exists {
+		config.HeapInitialSize = heapInitial
+	}
+	if pageCache, exists := cluster.Spec.Config["server.memory.pagecache.size"]; exists {
+		config.PageCacheSize = pageCache
+	}
+
+	return config
+}
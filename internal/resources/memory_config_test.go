@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -402,3 +403,64 @@ func TestMemoryConfigForDifferentTopologies(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateAutoTunedMemory(t *testing.T) {
+	tests := []struct {
+		memoryLimit  string
+		expectedHeap string
+		expectedPage string
+	}{
+		{memoryLimit: "2Gi", expectedHeap: "819M", expectedPage: "204M"},
+		{memoryLimit: "8Gi", expectedHeap: "3G", expectedPage: "3G"},
+		{memoryLimit: "16Gi", expectedHeap: "7680M", expectedPage: "5632M"},
+		{memoryLimit: "512Gi", expectedHeap: "31G", expectedPage: "465G"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.memoryLimit, func(t *testing.T) {
+			limit := resource.MustParse(tt.memoryLimit)
+			memoryConfig := CalculateAutoTunedMemory(limit.Value())
+
+			if memoryConfig.HeapMaxSize != tt.expectedHeap || memoryConfig.HeapInitialSize != tt.expectedHeap {
+				t.Errorf("expected heap %s, got initial %s and max %s", tt.expectedHeap, memoryConfig.HeapInitialSize, memoryConfig.HeapMaxSize)
+			}
+			if memoryConfig.PageCacheSize != tt.expectedPage {
+				t.Errorf("expected page cache %s, got %s", tt.expectedPage, memoryConfig.PageCacheSize)
+			}
+		})
+	}
+}
+
+func TestGetMemoryConfigForClusterAutoTune(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-cluster",
+		},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Memory: &neo4jv1alpha1.MemorySpec{AutoTune: true},
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+			},
+		},
+	}
+
+	memoryConfig := GetMemoryConfigForCluster(cluster)
+	if memoryConfig.HeapMaxSize != "7680M" || memoryConfig.PageCacheSize != "5632M" {
+		t.Errorf("expected auto-tuned heap 7680M and page cache 5632M, got %s and %s", memoryConfig.HeapMaxSize, memoryConfig.PageCacheSize)
+	}
+
+	// Transaction memory follows the auto-tuned heap
+	conf := buildNeo4jConfigForEnterprise(cluster)
+	if !strings.Contains(conf, "server.memory.heap.max_size=7680M") || !strings.Contains(conf, "dbms.memory.transaction.total.max=5.2g") {
+		t.Errorf("expected auto-tuned memory in neo4j.conf, got:\n%s", conf)
+	}
+
+	// Sizes set in spec.config win over the auto-tuned ones
+	cluster.Spec.Config = map[string]string{"server.memory.pagecache.size": "4G"}
+	memoryConfig = GetMemoryConfigForCluster(cluster)
+	if memoryConfig.HeapMaxSize != "7680M" || memoryConfig.PageCacheSize != "4G" {
+		t.Errorf("expected heap 7680M and page cache 4G, got %s and %s", memoryConfig.HeapMaxSize, memoryConfig.PageCacheSize)
+	}
+}
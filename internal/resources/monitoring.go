@@ -0,0 +1,366 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// Kinds of the monitors built for spec.monitoring.serviceMonitor
+var (
+	ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+	PodMonitorGVK     = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+	PrometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+)
+
+const (
+	// replicationLagAlertThreshold is how many Raft log entries a member
+	// may apply behind the most advanced one before Neo4jReplicationLag fires
+	replicationLagAlertThreshold = 1000
+	// pageCacheHitRatioAlertThreshold is the page cache hit ratio below
+	// which Neo4jLowPageCacheHitRatio fires
+	pageCacheHitRatioAlertThreshold = 0.9
+)
+
+// ServiceMonitorEnabled reports whether spec.monitoring.serviceMonitor is
+// enabled
+func ServiceMonitorEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Monitoring != nil && cluster.Spec.Monitoring.ServiceMonitor != nil &&
+		cluster.Spec.Monitoring.ServiceMonitor.Enabled
+}
+
+// MetricsEndpointEnabled reports whether the servers expose the Prometheus
+// endpoint, for query monitoring or a ServiceMonitor
+func MetricsEndpointEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return (cluster.Spec.QueryMonitoring != nil && cluster.Spec.QueryMonitoring.Enabled) || ServiceMonitorEnabled(cluster)
+}
+
+// AlertsEnabled reports whether spec.monitoring.alerts is enabled
+func AlertsEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Monitoring != nil && cluster.Spec.Monitoring.Alerts != nil && cluster.Spec.Monitoring.Alerts.Enabled
+}
+
+// DashboardsEnabled reports whether spec.monitoring.dashboards is enabled
+func DashboardsEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Monitoring != nil && cluster.Spec.Monitoring.Dashboards != nil && cluster.Spec.Monitoring.Dashboards.Enabled
+}
+
+// MonitorName returns the name of the ServiceMonitor or PodMonitor of a
+// cluster
+func MonitorName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-metrics", cluster.Name)
+}
+
+// BuildMonitorForEnterprise creates the ServiceMonitor scraping every server
+// through the headless Service, or with kind PodMonitor the PodMonitor
+// scraping the server pods. The samples are relabeled with the cluster and
+// server they come from. It returns nil unless
+// spec.monitoring.serviceMonitor is enabled.
+func BuildMonitorForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *unstructured.Unstructured {
+	if !ServiceMonitorEnabled(cluster) {
+		return nil
+	}
+	spec := cluster.Spec.Monitoring.ServiceMonitor
+
+	labels := map[string]interface{}{}
+	for k, v := range monitoringLabels(cluster, spec.Labels) {
+		labels[k] = v
+	}
+
+	interval := spec.Interval
+	if interval == "" {
+		interval = "30s"
+	}
+	endpoint := map[string]interface{}{
+		"port":     "metrics",
+		"path":     "/metrics",
+		"interval": interval,
+		"relabelings": []interface{}{
+			map[string]interface{}{
+				"sourceLabels": []interface{}{"__meta_kubernetes_pod_label_neo4j_com_cluster"},
+				"targetLabel":  "neo4j_cluster",
+			},
+			map[string]interface{}{
+				"sourceLabels": []interface{}{"__meta_kubernetes_pod_label_neo4j_com_server_name"},
+				"targetLabel":  "neo4j_server",
+			},
+			map[string]interface{}{
+				"sourceLabels": []interface{}{"__meta_kubernetes_pod_name"},
+				"targetLabel":  "pod",
+			},
+		},
+	}
+	if spec.ScrapeTimeout != "" {
+		endpoint["scrapeTimeout"] = spec.ScrapeTimeout
+	}
+
+	gvk := ServiceMonitorGVK
+	// The headless Service is the only one of the cluster without a
+	// service type
+	selector := map[string]interface{}{
+		"matchLabels": map[string]interface{}{"neo4j.com/cluster": cluster.Name},
+		"matchExpressions": []interface{}{
+			map[string]interface{}{"key": "neo4j.com/service-type", "operator": "DoesNotExist"},
+		},
+	}
+	endpointsField := "endpoints"
+	if spec.Kind == PodMonitorGVK.Kind {
+		gvk = PodMonitorGVK
+		selector = map[string]interface{}{
+			"matchLabels": map[string]interface{}{"neo4j.com/cluster": cluster.Name},
+			"matchExpressions": []interface{}{
+				map[string]interface{}{"key": "neo4j.com/server-name", "operator": "Exists"},
+			},
+		}
+		endpointsField = "podMetricsEndpoints"
+	}
+
+	monitor := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      MonitorName(cluster),
+				"namespace": cluster.Namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]interface{}{
+				"selector":     selector,
+				endpointsField: []interface{}{endpoint},
+			},
+		},
+	}
+	monitor.SetGroupVersionKind(gvk)
+	return monitor
+}
+
+// PrometheusRuleName returns the name of the PrometheusRule of a cluster
+func PrometheusRuleName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-alerts", cluster.Name)
+}
+
+// DashboardConfigMapName returns the name of the Grafana dashboard
+// ConfigMap of a cluster
+func DashboardConfigMapName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-dashboard", cluster.Name)
+}
+
+// BuildPrometheusRuleForEnterprise creates the PrometheusRule alerting on
+// replication lag, a low page cache hit ratio, failed backups and an
+// unhealthy cluster. The server alerts select the samples by the labels the
+// monitor relabels them with, the others the metrics of the operator. The
+// backup alert covers the given Neo4jBackups, as the operator counts
+// backups by the name of the backup, and is left out without any. It
+// returns nil unless spec.monitoring.alerts is enabled.
+func BuildPrometheusRuleForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, backups []string) *unstructured.Unstructured {
+	if !AlertsEnabled(cluster) {
+		return nil
+	}
+
+	labels := map[string]interface{}{}
+	for k, v := range monitoringLabels(cluster, cluster.Spec.Monitoring.Alerts.Labels) {
+		labels[k] = v
+	}
+
+	rules := []interface{}{
+		alertRule("Neo4jReplicationLag", fmt.Sprintf("%s > %d", replicationLagExpr(cluster), replicationLagAlertThreshold), "5m", "warning",
+			"Neo4j server {{ $labels.neo4j_server }} lags behind on {{ $labels.database }}",
+			"Server {{ $labels.neo4j_server }} of cluster {{ $labels.neo4j_cluster }} has applied {{ $value }} fewer Raft log entries of database {{ $labels.database }} than the most advanced member for 5 minutes."),
+		alertRule("Neo4jLowPageCacheHitRatio", fmt.Sprintf("neo4j_dbms_page_cache_hit_ratio{%s} < %g", serverMatchers(cluster), pageCacheHitRatioAlertThreshold), "15m", "warning",
+			"Neo4j server {{ $labels.neo4j_server }} misses the page cache",
+			"Server {{ $labels.neo4j_server }} of cluster {{ $labels.neo4j_cluster }} has found only {{ $value | humanizePercentage }} of the pages it read in the page cache for 15 minutes. Consider a larger server.memory.pagecache.size."),
+		alertRule("Neo4jClusterUnhealthy", operatorMetric("neo4j_operator_cluster_healthy{%s}", fmt.Sprintf("cluster_name=%q", cluster.Name), cluster.Namespace)+" == 0", "5m", "critical",
+			"Neo4j cluster "+cluster.Name+" is unhealthy",
+			"The operator has found cluster "+cluster.Namespace+"/"+cluster.Name+" unhealthy for 5 minutes."),
+	}
+	if len(backups) > 0 {
+		names := make([]string, len(backups))
+		for i, backup := range backups {
+			names[i] = regexp.QuoteMeta(backup)
+		}
+		matchers := fmt.Sprintf("cluster_name=~%q, result=%q", strings.Join(names, "|"), "failure")
+		rules = append(rules, alertRule("Neo4jBackupFailed", operatorMetric("increase(neo4j_operator_backup_total{%s}[1h])", matchers, cluster.Namespace)+" > 0", "", "critical",
+			"Neo4j backup {{ $labels.cluster_name }} failed",
+			"Backup {{ $labels.cluster_name }} of cluster "+cluster.Name+" failed in the last hour."))
+	}
+
+	rule := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      PrometheusRuleName(cluster),
+				"namespace": cluster.Namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name":  fmt.Sprintf("neo4j-%s", cluster.Name),
+						"rules": rules,
+					},
+				},
+			},
+		},
+	}
+	rule.SetGroupVersionKind(PrometheusRuleGVK)
+	return rule
+}
+
+// BuildDashboardConfigMapForEnterprise creates the ConfigMap with the
+// Grafana dashboard of a cluster, labelled for discovery by the Grafana
+// sidecar. It returns nil unless spec.monitoring.dashboards is enabled.
+func BuildDashboardConfigMapForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*corev1.ConfigMap, error) {
+	if !DashboardsEnabled(cluster) {
+		return nil, nil
+	}
+
+	labels := monitoringLabels(cluster, cluster.Spec.Monitoring.Dashboards.Labels)
+	labels["grafana_dashboard"] = "1"
+
+	dashboard, err := json.MarshalIndent(buildDashboard(cluster), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode dashboard: %w", err)
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DashboardConfigMapName(cluster),
+			Namespace: cluster.Namespace,
+			Labels:    labels,
+		},
+		Data: map[string]string{
+			// The sidecar writes the dashboards of every namespace to one
+			// folder
+			fmt.Sprintf("neo4j-%s-%s.json", cluster.Namespace, cluster.Name): string(dashboard),
+		},
+	}, nil
+}
+
+// buildDashboard returns the Grafana dashboard of a cluster
+func buildDashboard(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) map[string]interface{} {
+	servers := serverMatchers(cluster)
+	clusterName := fmt.Sprintf("cluster_name=%q", cluster.Name)
+	// The uid is at most 40 characters, and stable for the cluster
+	uid := fmt.Sprintf("neo4j-%x", sha256.Sum256([]byte(cluster.Namespace+"/"+cluster.Name)))[:30]
+
+	panels := []interface{}{
+		dashboardPanel(1, "Cluster healthy", "stat", operatorMetric("neo4j_operator_cluster_healthy{%s}", clusterName, cluster.Namespace), "", 0, 0),
+		dashboardPanel(2, "Servers scraped", "stat", fmt.Sprintf("sum(up{%s})", servers), "", 12, 0),
+		dashboardPanel(3, "Page cache hit ratio", "timeseries", fmt.Sprintf("neo4j_dbms_page_cache_hit_ratio{%s}", servers), "{{neo4j_server}}", 0, 8),
+		dashboardPanel(4, "Heap used", "timeseries", fmt.Sprintf("neo4j_dbms_vm_heap_used{%s}", servers), "{{neo4j_server}}", 12, 8),
+		dashboardPanel(5, "Committed transactions per second", "timeseries",
+			fmt.Sprintf(`sum by (neo4j_server) (rate({__name__=~"neo4j_database_.+_transaction_committed_total", %s}[5m]))`, servers), "{{neo4j_server}}", 0, 16),
+		dashboardPanel(6, "Replication lag", "timeseries", replicationLagExpr(cluster), "{{neo4j_server}} {{database}}", 12, 16),
+		dashboardPanel(7, "Slow queries per minute", "timeseries",
+			fmt.Sprintf("sum(%s) * 60", operatorMetric("rate(neo4j_operator_slow_queries_total{%s}[5m])", clusterName, cluster.Namespace)), "", 0, 24),
+		dashboardPanel(8, "Terminated queries per minute", "timeseries",
+			fmt.Sprintf("sum by (reason) (%s) * 60", operatorMetric("rate(neo4j_operator_terminated_queries_total{%s}[5m])", clusterName, cluster.Namespace)), "{{reason}}", 12, 24),
+	}
+
+	return map[string]interface{}{
+		"uid":           uid,
+		"title":         fmt.Sprintf("Neo4j / %s / %s", cluster.Namespace, cluster.Name),
+		"tags":          []interface{}{"neo4j"},
+		"schemaVersion": 39,
+		"refresh":       "30s",
+		"time":          map[string]interface{}{"from": "now-6h", "to": "now"},
+		"templating": map[string]interface{}{
+			"list": []interface{}{
+				map[string]interface{}{"name": "datasource", "label": "Data source", "type": "datasource", "query": "prometheus"},
+			},
+		},
+		"panels": panels,
+	}
+}
+
+// dashboardPanel returns a half-width Grafana panel plotting expr
+func dashboardPanel(id int, title, panelType, expr, legend string, x, y int) map[string]interface{} {
+	target := map[string]interface{}{"refId": "A", "expr": expr}
+	if legend != "" {
+		target["legendFormat"] = legend
+	}
+	return map[string]interface{}{
+		"id":         id,
+		"title":      title,
+		"type":       panelType,
+		"datasource": map[string]interface{}{"type": "prometheus", "uid": "${datasource}"},
+		"gridPos":    map[string]interface{}{"x": x, "y": y, "w": 12, "h": 8},
+		"targets":    []interface{}{target},
+	}
+}
+
+// alertRule returns a Prometheus alerting rule; the rule fires at once
+// without forDuration
+func alertRule(name, expr, forDuration, severity, summary, description string) map[string]interface{} {
+	rule := map[string]interface{}{
+		"alert":  name,
+		"expr":   expr,
+		"labels": map[string]interface{}{"severity": severity},
+		"annotations": map[string]interface{}{
+			"summary":     summary,
+			"description": description,
+		},
+	}
+	if forDuration != "" {
+		rule["for"] = forDuration
+	}
+	return rule
+}
+
+// serverMatchers returns the label matchers of the samples scraped from the
+// servers of a cluster by its monitor
+func serverMatchers(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("neo4j_cluster=%q, namespace=%q", cluster.Name, cluster.Namespace)
+}
+
+// replicationLagExpr returns the PromQL of the Raft log entries each member
+// has applied behind the most advanced member, per database. Neo4j names
+// the metric after the database, which the expression moves into a label.
+func replicationLagExpr(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	applied := fmt.Sprintf(`label_replace({__name__=~"neo4j_database_.+_cluster_raft_applied_index", %s}, "database", "$1", "__name__", "neo4j_database_(.+)_cluster_raft_applied_index")`,
+		serverMatchers(cluster))
+	return fmt.Sprintf("max by (neo4j_cluster, namespace, database) (%s) - on (neo4j_cluster, namespace, database) group_right %s", applied, applied)
+}
+
+// operatorMetric returns query, with %s in place of its label matchers, on
+// the metrics of the operator for a namespace. A Prometheus scraping the
+// operator without honorLabels keeps their namespace label as
+// exported_namespace.
+func operatorMetric(query, matchers, namespace string) string {
+	return fmt.Sprintf("(%s or %s)",
+		fmt.Sprintf(query, fmt.Sprintf("%s, namespace=%q", matchers, namespace)),
+		fmt.Sprintf(query, fmt.Sprintf("%s, exported_namespace=%q", matchers, namespace)))
+}
+
+// monitoringLabels returns the labels of the monitoring resources of a
+// cluster, with the labels configured for them
+func monitoringLabels(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, configured map[string]string) map[string]string {
+	labels := getLabelsForEnterprise(cluster, "metrics")
+	delete(labels, "neo4j.com/clustering")
+	delete(labels, "neo4j.com/service-type")
+	for k, v := range configured {
+		labels[k] = v
+	}
+	return labels
+}
@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestBuildMonitorForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	g.Expect(BuildMonitorForEnterprise(cluster)).To(BeNil())
+
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{
+		Enabled:       true,
+		ScrapeTimeout: "10s",
+		Labels:        map[string]string{"release": "prometheus"},
+	}}
+	monitor := BuildMonitorForEnterprise(cluster)
+	g.Expect(monitor).ToNot(BeNil())
+	g.Expect(monitor.GroupVersionKind()).To(Equal(ServiceMonitorGVK))
+	g.Expect(monitor.GetName()).To(Equal("test-cluster-metrics"))
+	g.Expect(monitor.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+	g.Expect(monitor.GetLabels()).ToNot(HaveKey("neo4j.com/service-type"))
+
+	endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+	g.Expect(endpoints).To(HaveLen(1))
+	endpoint := endpoints[0].(map[string]interface{})
+	g.Expect(endpoint).To(HaveKeyWithValue("port", "metrics"))
+	g.Expect(endpoint).To(HaveKeyWithValue("interval", "30s"))
+	g.Expect(endpoint).To(HaveKeyWithValue("scrapeTimeout", "10s"))
+	relabelings, _, _ := unstructured.NestedSlice(endpoint, "relabelings")
+	var targets []string
+	for _, relabeling := range relabelings {
+		targets = append(targets, relabeling.(map[string]interface{})["targetLabel"].(string))
+	}
+	g.Expect(targets).To(Equal([]string{"neo4j_cluster", "neo4j_server", "pod"}))
+
+	cluster.Spec.Monitoring.ServiceMonitor.Kind = "PodMonitor"
+	monitor = BuildMonitorForEnterprise(cluster)
+	g.Expect(monitor.GroupVersionKind()).To(Equal(PodMonitorGVK))
+	podEndpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+	g.Expect(podEndpoints).To(HaveLen(1))
+}
+
+func TestServiceMonitorExposesMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	cluster.Spec.Image.Tag = "5.26.0-enterprise"
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Storage.Size = "10Gi"
+	hasMetricsPort := func() bool {
+		for _, port := range BuildHeadlessServiceForEnterprise(cluster).Spec.Ports {
+			if port.Name == "metrics" && port.Port == MetricsPort {
+				return true
+			}
+		}
+		return false
+	}
+	g.Expect(hasMetricsPort()).To(BeFalse())
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]).ToNot(ContainSubstring("server.metrics.prometheus.enabled"))
+
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true}}
+	g.Expect(hasMetricsPort()).To(BeTrue())
+	config := BuildConfigMapForEnterprise(cluster).Data["neo4j.conf"]
+	g.Expect(config).To(ContainSubstring("server.metrics.prometheus.enabled=true"))
+	g.Expect(config).ToNot(ContainSubstring("db.logs.query.threshold"))
+	g.Expect(strings.Count(config, "server.metrics.prometheus.endpoint=")).To(Equal(1))
+
+	container := BuildServerStatefulSetForEnterprise(cluster).Spec.Template.Spec.Containers[0]
+	g.Expect(container.Ports).To(ContainElement(HaveField("Name", "metrics")))
+}
+
+func TestBuildPrometheusRuleForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	g.Expect(BuildPrometheusRuleForEnterprise(cluster, nil)).To(BeNil())
+
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{Alerts: &neo4jv1alpha1.MonitoringAlertsSpec{
+		Enabled: true,
+		Labels:  map[string]string{"release": "prometheus"},
+	}}
+	alertNames := func(backups []string) []string {
+		rule := BuildPrometheusRuleForEnterprise(cluster, backups)
+		g.Expect(rule.GroupVersionKind()).To(Equal(PrometheusRuleGVK))
+		g.Expect(rule.GetName()).To(Equal("test-cluster-alerts"))
+		g.Expect(rule.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+		groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+		g.Expect(groups).To(HaveLen(1))
+		var names []string
+		for _, rule := range groups[0].(map[string]interface{})["rules"].([]interface{}) {
+			alert := rule.(map[string]interface{})
+			g.Expect(alert["expr"]).To(ContainSubstring(`"default"`))
+			names = append(names, alert["alert"].(string))
+		}
+		return names
+	}
+	g.Expect(alertNames(nil)).To(Equal([]string{"Neo4jReplicationLag", "Neo4jLowPageCacheHitRatio", "Neo4jClusterUnhealthy"}))
+	g.Expect(alertNames([]string{"nightly"})).To(ContainElement("Neo4jBackupFailed"))
+}
+
+func TestBuildDashboardConfigMapForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	configMap, err := BuildDashboardConfigMapForEnterprise(cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(configMap).To(BeNil())
+
+	cluster.Spec.Monitoring = &neo4jv1alpha1.MonitoringSpec{Dashboards: &neo4jv1alpha1.MonitoringDashboardsSpec{Enabled: true}}
+	configMap, err = BuildDashboardConfigMapForEnterprise(cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(configMap.Name).To(Equal("test-cluster-dashboard"))
+	g.Expect(configMap.Labels).To(HaveKeyWithValue("grafana_dashboard", "1"))
+	g.Expect(configMap.Data).To(HaveKey("neo4j-default-test-cluster.json"))
+
+	var dashboard struct {
+		UID    string `json:"uid"`
+		Panels []struct {
+			Targets []struct {
+				Expr string `json:"expr"`
+			} `json:"targets"`
+		} `json:"panels"`
+	}
+	g.Expect(json.Unmarshal([]byte(configMap.Data["neo4j-default-test-cluster.json"]), &dashboard)).To(Succeed())
+	g.Expect(len(dashboard.UID)).To(BeNumerically("<=", 40))
+	g.Expect(dashboard.Panels).ToNot(BeEmpty())
+	for _, panel := range dashboard.Panels {
+		g.Expect(panel.Targets[0].Expr).To(ContainSubstring(`"test-cluster"`))
+	}
+}
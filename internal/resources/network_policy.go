@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ServiceSourceRanges returns the source ranges for a client Service. For
+// LoadBalancer services these are the explicit loadBalancerSourceRanges
+// followed by allowedCIDRs; other types keep loadBalancerSourceRanges as
+// given, their allowedCIDRs are enforced by BuildClientNetworkPolicy.
+func ServiceSourceRanges(spec *neo4jv1alpha1.ServiceSpec) []string {
+	if spec == nil {
+		return nil
+	}
+	if spec.Type != string(corev1.ServiceTypeLoadBalancer) {
+		return spec.LoadBalancerSourceRanges
+	}
+	var ranges []string
+	seen := map[string]bool{}
+	for _, cidr := range append(append([]string{}, spec.LoadBalancerSourceRanges...), spec.AllowedCIDRs...) {
+		if !seen[cidr] {
+			seen[cidr] = true
+			ranges = append(ranges, cidr)
+		}
+	}
+	return ranges
+}
+
+// ClientNetworkPolicyName returns the name of the allowlist policy generated
+// for a client Service.
+func ClientNetworkPolicyName(serviceName string) string {
+	return serviceName + "-allowlist"
+}
+
+// BuildClientNetworkPolicy enforces spec.service.allowedCIDRs where the
+// Service cannot: it admits the allowed CIDRs on the Service's ports and any
+// pod in the Kubernetes cluster, so cluster members, the operator and backup
+// jobs keep working. It returns nil for LoadBalancer services, which use
+// source ranges instead, and when no CIDRs are configured.
+func BuildClientNetworkPolicy(svc *corev1.Service, spec *neo4jv1alpha1.ServiceSpec) *networkingv1.NetworkPolicy {
+	if spec == nil || len(spec.AllowedCIDRs) == 0 || svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	for _, port := range svc.Spec.Ports {
+		protocol := port.Protocol
+		targetPort := port.TargetPort
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &targetPort})
+	}
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, cidr := range spec.AllowedCIDRs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+
+	labels := make(map[string]string, len(svc.Labels))
+	for k, v := range svc.Labels {
+		labels[k] = v
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClientNetworkPolicyName(svc.Name),
+			Namespace: svc.Namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: svc.Spec.Selector},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					// Any pod in any namespace; clients outside the Kubernetes
+					// cluster are matched by the ipBlock rule below only
+					From: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+				},
+				{
+					From:  peers,
+					Ports: ports,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func TestServiceSourceRanges(t *testing.T) {
+	assert.Nil(t, resources.ServiceSourceRanges(nil))
+
+	lb := &neo4jv1alpha1.ServiceSpec{
+		Type:                     "LoadBalancer",
+		LoadBalancerSourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		AllowedCIDRs:             []string{"192.168.0.0/16", "203.0.113.0/24"},
+	}
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.0.0/16", "203.0.113.0/24"}, resources.ServiceSourceRanges(lb))
+
+	// Other service types enforce allowedCIDRs with a NetworkPolicy instead
+	nodePort := &neo4jv1alpha1.ServiceSpec{
+		Type:         "NodePort",
+		AllowedCIDRs: []string{"203.0.113.0/24"},
+	}
+	assert.Empty(t, resources.ServiceSourceRanges(nodePort))
+}
+
+func TestBuildClientNetworkPolicy(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prod-client",
+			Namespace: "default",
+			Labels:    map[string]string{"app.kubernetes.io/instance": "prod"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeNodePort,
+			Selector: map[string]string{"neo4j.com/cluster": "prod"},
+			Ports: []corev1.ServicePort{
+				{Name: "bolt", Port: 7687, TargetPort: intstr.FromInt(7687), Protocol: corev1.ProtocolTCP},
+				{Name: "http", Port: 7474, TargetPort: intstr.FromInt(7474), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	spec := &neo4jv1alpha1.ServiceSpec{Type: "NodePort", AllowedCIDRs: []string{"203.0.113.0/24"}}
+
+	policy := resources.BuildClientNetworkPolicy(svc, spec)
+	require.NotNil(t, policy)
+	assert.Equal(t, "prod-client-allowlist", policy.Name)
+	assert.Equal(t, "default", policy.Namespace)
+	assert.Equal(t, svc.Labels, policy.Labels)
+	assert.Equal(t, svc.Spec.Selector, policy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+
+	require.Len(t, policy.Spec.Ingress, 2)
+	inCluster := policy.Spec.Ingress[0]
+	require.Len(t, inCluster.From, 1)
+	assert.NotNil(t, inCluster.From[0].NamespaceSelector)
+	assert.Empty(t, inCluster.Ports, "pods in the cluster are admitted on every port")
+
+	external := policy.Spec.Ingress[1]
+	require.Len(t, external.From, 1)
+	require.NotNil(t, external.From[0].IPBlock)
+	assert.Equal(t, "203.0.113.0/24", external.From[0].IPBlock.CIDR)
+	require.Len(t, external.Ports, 2)
+	assert.Equal(t, intstr.FromInt(7687), *external.Ports[0].Port)
+	assert.Equal(t, corev1.ProtocolTCP, *external.Ports[0].Protocol)
+
+	// LoadBalancer services use source ranges, and no CIDRs means no policy
+	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+	assert.Nil(t, resources.BuildClientNetworkPolicy(svc, spec))
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	assert.Nil(t, resources.BuildClientNetworkPolicy(svc, &neo4jv1alpha1.ServiceSpec{}))
+	assert.Nil(t, resources.BuildClientNetworkPolicy(svc, nil))
+}
+
+func TestBuildBackupNetworkPolicyForEnterprise(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			BackupPort: &neo4jv1alpha1.BackupPortSpec{
+				Expose: true,
+				AllowedClients: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.20.0.0/16"}},
+				},
+			},
+		},
+	}
+
+	svc := resources.BuildBackupServiceForEnterprise(cluster)
+	require.NotNil(t, svc)
+	assert.Equal(t, "prod-backup-port", svc.Name)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, svc.Spec.Type)
+	assert.Equal(t, "server", svc.Spec.Selector["neo4j.com/server-name"])
+	require.Len(t, svc.Spec.Ports, 1)
+	assert.Equal(t, int32(6362), svc.Spec.Ports[0].Port)
+
+	policy := resources.BuildBackupNetworkPolicyForEnterprise(cluster)
+	require.NotNil(t, policy)
+	assert.Equal(t, "prod-backup-port", policy.Name)
+	require.Len(t, policy.Spec.Ingress, 2)
+	backup := policy.Spec.Ingress[0]
+	require.Len(t, backup.Ports, 1)
+	assert.Equal(t, intstr.FromInt(6362), *backup.Ports[0].Port)
+	require.Len(t, backup.From, 3)
+	assert.Equal(t, "prod", backup.From[0].PodSelector.MatchLabels["neo4j.com/cluster"])
+	assert.Equal(t, resources.BackupJobPodLabels, backup.From[1].PodSelector.MatchLabels)
+	assert.Equal(t, "10.20.0.0/16", backup.From[2].IPBlock.CIDR)
+	others := policy.Spec.Ingress[1]
+	assert.Empty(t, others.From, "every source may reach the other ports")
+	require.Len(t, others.Ports, 2)
+	assert.Equal(t, int32(6361), *others.Ports[0].EndPort)
+	assert.Equal(t, intstr.FromInt(6363), *others.Ports[1].Port)
+
+	// With an allowlist in place the other ports are left to it, and its
+	// in-cluster rule no longer covers the backup port
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{AllowedCIDRs: []string{"203.0.113.0/24"}}
+	policy = resources.BuildBackupNetworkPolicyForEnterprise(cluster)
+	require.NotNil(t, policy)
+	assert.Len(t, policy.Spec.Ingress, 1)
+	allowlist := resources.BuildClientNetworkPolicyForEnterprise(cluster)
+	require.NotNil(t, allowlist)
+	require.Len(t, allowlist.Spec.Ingress[0].Ports, 2)
+	assert.Equal(t, int32(6361), *allowlist.Spec.Ingress[0].Ports[0].EndPort)
+
+	// Disabling the port removes both
+	disabled := false
+	cluster.Spec.BackupPort.Enabled = &disabled
+	assert.Nil(t, resources.BuildBackupServiceForEnterprise(cluster))
+	assert.Nil(t, resources.BuildBackupNetworkPolicyForEnterprise(cluster))
+	assert.Empty(t, resources.BuildClientNetworkPolicyForEnterprise(cluster).Spec.Ingress[0].Ports)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const oidcClientSecretEnv = "OIDC_CLIENT_SECRET"
+
+// OIDCEnabled reports whether the servers accept logins through
+// spec.auth.oidc
+func OIDCEnabled(auth *neo4jv1alpha1.AuthSpec) bool {
+	return auth != nil && auth.OIDC != nil
+}
+
+// OIDCProviderName returns the auth provider of spec.auth.oidc, oidc-<name>
+func OIDCProviderName(oidc *neo4jv1alpha1.OIDCAuthSpec) string {
+	return "oidc-" + oidcName(oidc)
+}
+
+// OIDCDiscoveryURL returns the URL of the discovery document of an issuer
+func OIDCDiscoveryURL(issuer string) string {
+	return strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+}
+
+// oidcName returns the name of the provider in the Neo4j settings
+func oidcName(oidc *neo4jv1alpha1.OIDCAuthSpec) string {
+	if oidc.Name == "" {
+		return "sso"
+	}
+	return oidc.Name
+}
+
+// buildOIDCConfig renders spec.auth.oidc as dbms.security.oidc.<name>.*
+// settings. The client secret is added by the startup script.
+func buildOIDCConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !OIDCEnabled(cluster.Spec.Auth) {
+		return ""
+	}
+	oidc := cluster.Spec.Auth.OIDC
+	prefix := "dbms.security.oidc." + oidcName(oidc) + "."
+
+	displayName := oidc.DisplayName
+	if displayName == "" {
+		displayName = oidcName(oidc)
+	}
+	authFlow := oidc.AuthFlow
+	if authFlow == "" {
+		authFlow = "pkce"
+	}
+	audience := oidc.Audience
+	if len(audience) == 0 {
+		audience = []string{oidc.ClientID}
+	}
+	responseType := "code"
+	if authFlow == "implicit" {
+		responseType = "token"
+	}
+
+	settings := &authSettings{config: cluster.Spec.Config}
+	settings.set(prefix+"display_name", displayName)
+	settings.set(prefix+"auth_flow", authFlow)
+	settings.set(prefix+"well_known_discovery_uri", OIDCDiscoveryURL(oidc.Issuer))
+	settings.set(prefix+"issuer", oidc.Issuer)
+	settings.set(prefix+"audience", strings.Join(audience, ","))
+	settings.set(prefix+"params", "client_id="+oidc.ClientID+";response_type="+responseType+";scope="+strings.Join(OIDCScopes(oidc), " "))
+
+	username, groups := "sub", "groups"
+	if oidc.ClaimsMapping != nil {
+		if oidc.ClaimsMapping.Username != "" {
+			username = oidc.ClaimsMapping.Username
+		}
+		if oidc.ClaimsMapping.Groups != "" {
+			groups = oidc.ClaimsMapping.Groups
+		}
+	}
+	settings.set(prefix+"claims.username", username)
+	settings.set(prefix+"claims.groups", groups)
+	if len(oidc.GroupToRoleMapping) > 0 {
+		settings.set(prefix+"authorization.group_to_role_mapping", groupToRoleMapping(oidc.GroupToRoleMapping))
+	}
+	return settings.render("OIDC single sign-on (spec.auth.oidc)")
+}
+
+// OIDCScopes returns the scopes requested from the provider
+func OIDCScopes(oidc *neo4jv1alpha1.OIDCAuthSpec) []string {
+	if len(oidc.Scopes) == 0 {
+		return []string{"openid", "profile", "email"}
+	}
+	return oidc.Scopes
+}
+
+// applyOIDC passes the client secret of spec.auth.oidc to the Neo4j
+// container
+func applyOIDC(sts *appsv1.StatefulSet, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+	if !OIDCEnabled(cluster.Spec.Auth) || cluster.Spec.Auth.OIDC.ClientSecret == nil {
+		return
+	}
+	secret := cluster.Spec.Auth.OIDC.ClientSecret
+	key := secret.Key
+	if key == "" {
+		key = "clientSecret"
+	}
+	podSpec := &sts.Spec.Template.Spec
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == Neo4jContainer {
+			podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, corev1.EnvVar{
+				Name: oidcClientSecretEnv,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret.SecretRef},
+						Key:                  key,
+					},
+				},
+			})
+		}
+	}
+}
+
+// buildOIDCStartup adds the client secret of spec.auth.oidc to neo4j.conf
+func buildOIDCStartup(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !OIDCEnabled(cluster.Spec.Auth) || cluster.Spec.Auth.OIDC.ClientSecret == nil {
+		return ""
+	}
+	return `
+# OIDC: the client secret of the identity provider
+cat >> /tmp/neo4j-config/neo4j.conf << EOF
+dbms.security.oidc.` + oidcName(cluster.Spec.Auth.OIDC) + `.client_secret=${` + oidcClientSecretEnv + `}
+EOF
+`
+}
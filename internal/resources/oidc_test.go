@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestBuildOIDCConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := configOverridesTestCluster()
+	g.Expect(buildOIDCConfig(cluster)).To(BeEmpty())
+
+	cluster.Spec.Auth = &neo4jv1alpha1.AuthSpec{
+		Provider: "native",
+		OIDC: &neo4jv1alpha1.OIDCAuthSpec{
+			Name:          "keycloak",
+			DisplayName:   "Corporate SSO",
+			Issuer:        "https://login.example.com/realms/corp/",
+			ClientID:      "neo4j",
+			ClientSecret:  &neo4jv1alpha1.OIDCClientSecretSpec{SecretRef: "neo4j-oidc"},
+			ClaimsMapping: &neo4jv1alpha1.OIDCClaimsMappingSpec{Username: "preferred_username"},
+			GroupToRoleMapping: map[string][]string{
+				"neo4j-readers": {"reader"},
+				"neo4j-admins":  {"admin", "publisher"},
+			},
+		},
+	}
+	g.Expect(buildOIDCConfig(cluster)).To(Equal(`
+# OIDC single sign-on (spec.auth.oidc)
+dbms.security.oidc.keycloak.display_name=Corporate SSO
+dbms.security.oidc.keycloak.auth_flow=pkce
+dbms.security.oidc.keycloak.well_known_discovery_uri=https://login.example.com/realms/corp/.well-known/openid-configuration
+dbms.security.oidc.keycloak.issuer=https://login.example.com/realms/corp/
+dbms.security.oidc.keycloak.audience=neo4j
+dbms.security.oidc.keycloak.params=client_id=neo4j;response_type=code;scope=openid profile email
+dbms.security.oidc.keycloak.claims.username=preferred_username
+dbms.security.oidc.keycloak.claims.groups=groups
+dbms.security.oidc.keycloak.authorization.group_to_role_mapping="neo4j-admins"=admin,publisher;"neo4j-readers"=reader
+`))
+	g.Expect(buildAuthProvidersConfig(cluster)).To(ContainSubstring("dbms.security.authentication_providers=native,oidc-keycloak\n"))
+
+	// LDAP and OIDC combine
+	cluster.Spec.Auth.Provider = "ldap"
+	cluster.Spec.Auth.LDAP = &neo4jv1alpha1.LDAPAuthSpec{UserDNTemplate: "uid={0},dc=example,dc=com"}
+	g.Expect(buildAuthProvidersConfig(cluster)).To(ContainSubstring("dbms.security.authorization_providers=native,ldap,oidc-keycloak\n"))
+
+	sts := BuildServerStatefulSetForEnterprise(cluster)
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+		Name: oidcClientSecretEnv,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "neo4j-oidc"}, Key: "clientSecret",
+		}},
+	}))
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["startup.sh"]).To(ContainSubstring(
+		"dbms.security.oidc.keycloak.client_secret=${OIDC_CLIENT_SECRET}"))
+
+	// The implicit flow asks for tokens
+	cluster.Spec.Auth.OIDC.AuthFlow = "implicit"
+	cluster.Spec.Auth.OIDC.Audience = []string{"api://neo4j", "neo4j"}
+	conf := buildOIDCConfig(cluster)
+	g.Expect(conf).To(ContainSubstring("dbms.security.oidc.keycloak.params=client_id=neo4j;response_type=token;"))
+	g.Expect(conf).To(ContainSubstring("dbms.security.oidc.keycloak.audience=api://neo4j,neo4j\n"))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ServerRoleLabel carries the role of a server pod for the system database,
+// as reported by SHOW DATABASE system. The read-only Service selects on it.
+const ServerRoleLabel = "neo4j.com/server-role"
+
+// ServerRoleSecondary is the ServerRoleLabel value of secondary servers
+const ServerRoleSecondary = "secondary"
+
+// ReadOnlyServiceEnabled reports whether spec.service.readOnly asks for the
+// read-only Service
+func ReadOnlyServiceEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Service != nil && cluster.Spec.Service.ReadOnly != nil && cluster.Spec.Service.ReadOnly.Enabled
+}
+
+// ReadOnlyServiceName returns the name of the read-only Service
+func ReadOnlyServiceName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-ro", cluster.Name)
+}
+
+// ReadOnlyIngressName returns the name of the Ingress of the read-only
+// Service
+func ReadOnlyIngressName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	return fmt.Sprintf("%s-ro-ingress", cluster.Name)
+}
+
+// BuildReadOnlyServiceForEnterprise creates the Service that selects the
+// secondary servers. Pods only join it once the operator has labeled them
+// with their role, so a new secondary is reached after it joined the
+// cluster. It returns nil unless spec.service.readOnly is enabled.
+func BuildReadOnlyServiceForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *corev1.Service {
+	if !ReadOnlyServiceEnabled(cluster) {
+		return nil
+	}
+	spec := cluster.Spec.Service.ReadOnly
+
+	serviceType := corev1.ServiceTypeClusterIP
+	if spec.Type != "" {
+		serviceType = corev1.ServiceType(spec.Type)
+	}
+
+	ports := []corev1.ServicePort{
+		{
+			Name:       "bolt",
+			Port:       BoltPort,
+			TargetPort: intstr.FromInt(BoltPort),
+			Protocol:   corev1.ProtocolTCP,
+		},
+		{
+			Name:       "http",
+			Port:       HTTPPort,
+			TargetPort: intstr.FromInt(HTTPPort),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+	if TLSEnabled(cluster.Spec.TLS) {
+		ports = append(ports, corev1.ServicePort{
+			Name:       "https",
+			Port:       HTTPSPort,
+			TargetPort: intstr.FromInt(HTTPSPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	labels := getLabelsForEnterprise(cluster, "read-only")
+	delete(labels, "neo4j.com/clustering")
+
+	// Servers in maintenance are left out as they are from the client Service
+	selector := clientServiceSelector(cluster)
+	selector[ServerRoleLabel] = ServerRoleSecondary
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ReadOnlyServiceName(cluster),
+			Namespace:   cluster.Namespace,
+			Labels:      labels,
+			Annotations: spec.Annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			IPFamilyPolicy: ServiceIPFamilyPolicy(cluster.Spec.Service),
+			IPFamilies:     ServiceIPFamilies(cluster.Spec.Service),
+			Type:           serviceType,
+			Selector:       selector,
+			Ports:          ports,
+		},
+	}
+}
+
+// BuildReadOnlyIngressForEnterprise creates the Ingress of the read-only
+// Service. It returns nil unless the Service and its Ingress are enabled.
+func BuildReadOnlyIngressForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *networkingv1.Ingress {
+	if !ReadOnlyServiceEnabled(cluster) {
+		return nil
+	}
+	ingressSpec := cluster.Spec.Service.ReadOnly.Ingress
+	if ingressSpec == nil || !ingressSpec.Enabled {
+		return nil
+	}
+	return buildIngressForService(cluster, ReadOnlyIngressName(cluster), ReadOnlyServiceName(cluster), ingressSpec)
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestBuildReadOnlyServiceForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	g.Expect(BuildReadOnlyServiceForEnterprise(cluster)).To(BeNil())
+	g.Expect(BuildReadOnlyIngressForEnterprise(cluster)).To(BeNil())
+
+	cluster.Spec.Service = &neo4jv1alpha1.ServiceSpec{ReadOnly: &neo4jv1alpha1.ReadOnlyServiceSpec{
+		Enabled:     true,
+		Annotations: map[string]string{"team": "analytics"},
+	}}
+	svc := BuildReadOnlyServiceForEnterprise(cluster)
+	g.Expect(svc).ToNot(BeNil())
+	g.Expect(svc.Name).To(Equal("test-cluster-ro"))
+	g.Expect(string(svc.Spec.Type)).To(Equal("ClusterIP"))
+	g.Expect(svc.Annotations).To(HaveKeyWithValue("team", "analytics"))
+	g.Expect(svc.Spec.Selector).To(Equal(map[string]string{
+		"neo4j.com/cluster": "test-cluster",
+		ServerRoleLabel:     ServerRoleSecondary,
+	}))
+	g.Expect(svc.Spec.Ports).To(HaveLen(2))
+	g.Expect(BuildReadOnlyIngressForEnterprise(cluster)).To(BeNil())
+
+	// Servers in maintenance leave the read-only Service as well
+	cluster.Status.MaintenanceServers = []string{"test-cluster-server-2"}
+	g.Expect(BuildReadOnlyServiceForEnterprise(cluster).Spec.Selector).To(HaveKeyWithValue(RoutingLabel, RoutingEnabled))
+
+	cluster.Spec.Service.ReadOnly.Ingress = &neo4jv1alpha1.IngressSpec{Enabled: true, Host: "analytics.example.com"}
+	ingress := BuildReadOnlyIngressForEnterprise(cluster)
+	g.Expect(ingress).ToNot(BeNil())
+	g.Expect(ingress.Name).To(Equal("test-cluster-ro-ingress"))
+	g.Expect(ingress.Spec.Rules[0].Host).To(Equal("analytics.example.com"))
+	g.Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name).To(Equal("test-cluster-ro"))
+	g.Expect(BuildIngressForEnterprise(cluster)).To(BeNil())
+}
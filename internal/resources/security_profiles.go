@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// Supported values for SecurityContextSpec.Distro
+	DistroGeneric      = "generic"
+	DistroBottlerocket = "bottlerocket"
+	DistroCOS          = "cos"
+	DistroUbuntu       = "ubuntu"
+
+	// AppArmorAnnotationPrefix is the per-container pod annotation read by the
+	// kubelet before Kubernetes 1.30, see SetAppArmorAnnotations
+	AppArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+
+	// AppArmorRuntimeDefault selects the container runtime's default AppArmor profile
+	AppArmorRuntimeDefault = "runtime/default"
+)
+
+// appArmorAnnotations is set when the cluster predates the
+// securityContext.appArmorProfile field
+var appArmorAnnotations atomic.Bool
+
+// SetAppArmorAnnotations selects how AppArmor profiles are applied. By
+// default they are set as the pod's securityContext.appArmorProfile, which
+// Kubernetes 1.30 introduced; older API servers drop that field, so for them
+// the deprecated per-container annotations are written instead.
+func SetAppArmorAnnotations(enabled bool) {
+	appArmorAnnotations.Store(enabled)
+}
+
+// defaultAppArmorProfile returns the AppArmor profile for nodes of the given
+// distro. Only distros that ship AppArmor get one: the kubelet refuses to
+// start pods that request a profile on nodes without AppArmor support.
+func defaultAppArmorProfile(distro string) string {
+	switch distro {
+	case DistroCOS, DistroUbuntu:
+		return AppArmorRuntimeDefault
+	default:
+		return ""
+	}
+}
+
+// AppArmorProfileFor resolves the AppArmor profile configured by sc, falling
+// back to the distro default. An empty result means no annotation is set.
+func AppArmorProfileFor(sc *neo4jv1alpha1.SecurityContextSpec) string {
+	if sc == nil {
+		return ""
+	}
+	if sc.AppArmorProfile != "" {
+		return sc.AppArmorProfile
+	}
+	return defaultAppArmorProfile(sc.Distro)
+}
+
+// appArmorProfileField converts a profile of the annotation form
+// (runtime/default, unconfined or localhost/<profile>) to the
+// securityContext field.
+func appArmorProfileField(profile string) *corev1.AppArmorProfile {
+	if name, ok := strings.CutPrefix(profile, "localhost/"); ok {
+		return &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeLocalhost, LocalhostProfile: &name}
+	}
+	if profile == "unconfined" {
+		return &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeUnconfined}
+	}
+	return &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault}
+}
+
+// ApplySecurityProfiles applies the seccomp and AppArmor settings of sc to a
+// generated pod template. An explicit seccompProfile overrides whatever the
+// pod security context carries; otherwise RuntimeDefault is filled in when the
+// pod security context does not set a profile. The AppArmor profile is set on
+// the pod security context, so it covers every container, or annotated on
+// every container and init container present in the template on clusters
+// older than Kubernetes 1.30.
+func ApplySecurityProfiles(template *corev1.PodTemplateSpec, sc *neo4jv1alpha1.SecurityContextSpec) {
+	if sc == nil {
+		return
+	}
+
+	profile := AppArmorProfileFor(sc)
+	annotate := appArmorAnnotations.Load()
+	if sc.SeccompProfile != nil || sc.Distro != "" || (profile != "" && !annotate) {
+		// Pod security contexts may be shared package defaults, never mutate them in place.
+		podSC := &corev1.PodSecurityContext{}
+		if template.Spec.SecurityContext != nil {
+			podSC = template.Spec.SecurityContext.DeepCopy()
+		}
+		switch {
+		case sc.SeccompProfile != nil:
+			podSC.SeccompProfile = sc.SeccompProfile.DeepCopy()
+		case sc.Distro != "" && podSC.SeccompProfile == nil:
+			podSC.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+		}
+		if profile != "" && !annotate {
+			podSC.AppArmorProfile = appArmorProfileField(profile)
+		}
+		template.Spec.SecurityContext = podSC
+	}
+
+	if profile == "" || !annotate {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	for _, c := range template.Spec.InitContainers {
+		template.Annotations[AppArmorAnnotationPrefix+c.Name] = profile
+	}
+	for _, c := range template.Spec.Containers {
+		template.Annotations[AppArmorAnnotationPrefix+c.Name] = profile
+	}
+}
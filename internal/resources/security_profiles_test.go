@@ -0,0 +1,118 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+func securityProfileCluster(sc *neo4jv1alpha1.SecurityContextSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "profiles", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:           neo4jv1alpha1.ImageSpec{Repo: "neo4j/neo4j", Tag: "5.26-enterprise"},
+			Topology:        neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			Storage:         neo4jv1alpha1.StorageSpec{ClassName: "fast-ssd", Size: "10Gi"},
+			SecurityContext: sc,
+		},
+	}
+}
+
+func TestBuildServerStatefulSet_DistroDefaults(t *testing.T) {
+	runtimeDefault := &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault}
+	tests := []struct {
+		name     string
+		distro   string
+		apparmor *corev1.AppArmorProfile
+	}{
+		{name: "cos gets runtime/default AppArmor", distro: resources.DistroCOS, apparmor: runtimeDefault},
+		{name: "ubuntu gets runtime/default AppArmor", distro: resources.DistroUbuntu, apparmor: runtimeDefault},
+		{name: "bottlerocket has no AppArmor", distro: resources.DistroBottlerocket},
+		{name: "generic has no AppArmor", distro: resources.DistroGeneric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts := resources.BuildServerStatefulSetForEnterprise(securityProfileCluster(&neo4jv1alpha1.SecurityContextSpec{Distro: tt.distro}))
+			podSpec := sts.Spec.Template.Spec
+
+			require.NotNil(t, podSpec.SecurityContext)
+			require.NotNil(t, podSpec.SecurityContext.SeccompProfile)
+			assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, podSpec.SecurityContext.SeccompProfile.Type)
+			// The default UID settings are kept.
+			assert.Equal(t, int64(7474), *podSpec.SecurityContext.RunAsUser)
+
+			assert.Equal(t, tt.apparmor, podSpec.SecurityContext.AppArmorProfile)
+			// The deprecated annotations are only written for clusters older than 1.30.
+			assert.Empty(t, sts.Spec.Template.Annotations)
+		})
+	}
+}
+
+func TestBuildServerStatefulSet_ExplicitProfilesOverrideDefaults(t *testing.T) {
+	sc := &neo4jv1alpha1.SecurityContextSpec{
+		Distro: resources.DistroCOS,
+		SeccompProfile: &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: ptr.To("profiles/neo4j.json"),
+		},
+		AppArmorProfile: "localhost/neo4j",
+	}
+
+	sts := resources.BuildServerStatefulSetForEnterprise(securityProfileCluster(sc))
+	seccomp := sts.Spec.Template.Spec.SecurityContext.SeccompProfile
+	assert.Equal(t, corev1.SeccompProfileTypeLocalhost, seccomp.Type)
+	assert.Equal(t, "profiles/neo4j.json", *seccomp.LocalhostProfile)
+	apparmor := sts.Spec.Template.Spec.SecurityContext.AppArmorProfile
+	assert.Equal(t, corev1.AppArmorProfileTypeLocalhost, apparmor.Type)
+	assert.Equal(t, "neo4j", *apparmor.LocalhostProfile)
+
+	// The shared default pod security context must not have been mutated.
+	other := resources.BuildServerStatefulSetForEnterprise(securityProfileCluster(nil))
+	assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, other.Spec.Template.Spec.SecurityContext.SeccompProfile.Type)
+	assert.Empty(t, other.Spec.Template.Annotations)
+}
+
+func TestApplySecurityProfiles_KeepsPodSecurityContextSeccomp(t *testing.T) {
+	template := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			SecurityContext: &corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+			},
+			Containers: []corev1.Container{{Name: "backup"}},
+		},
+	}
+
+	resources.ApplySecurityProfiles(template, &neo4jv1alpha1.SecurityContextSpec{Distro: resources.DistroUbuntu})
+
+	assert.Equal(t, corev1.SeccompProfileTypeUnconfined, template.Spec.SecurityContext.SeccompProfile.Type)
+	assert.Equal(t, corev1.AppArmorProfileTypeRuntimeDefault, template.Spec.SecurityContext.AppArmorProfile.Type)
+}
+
+func TestApplySecurityProfiles_AnnotationsBeforeKubernetes130(t *testing.T) {
+	resources.SetAppArmorAnnotations(true)
+	t.Cleanup(func() { resources.SetAppArmorAnnotations(false) })
+
+	template := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "backup"}},
+		},
+	}
+
+	resources.ApplySecurityProfiles(template, &neo4jv1alpha1.SecurityContextSpec{AppArmorProfile: "localhost/neo4j"})
+
+	// Older API servers drop the field, so only the annotations are set.
+	assert.Nil(t, template.Spec.SecurityContext)
+	assert.Equal(t, map[string]string{
+		resources.AppArmorAnnotationPrefix + "init":   "localhost/neo4j",
+		resources.AppArmorAnnotationPrefix + "backup": "localhost/neo4j",
+	}, template.Annotations)
+}
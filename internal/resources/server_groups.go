@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ServerGroupLabel carries the name of the server group of a StatefulSet and
+// its pods. The servers of spec.topology.servers do not have it.
+const ServerGroupLabel = "neo4j.com/server-group"
+
+// ServerPoolNames returns the server-name label values of all server pods:
+// "server" for spec.topology.servers followed by the server groups
+func ServerPoolNames(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []string {
+	names := []string{"server"}
+	for _, group := range cluster.Spec.Topology.ServerGroups {
+		names = append(names, group.Name)
+	}
+	return names
+}
+
+// poolServers returns the number of servers of a server pool
+func poolServers(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, pool string) int32 {
+	if pool == "server" {
+		return cluster.Spec.Topology.Servers
+	}
+	for _, group := range cluster.Spec.Topology.ServerGroups {
+		if group.Name == pool {
+			return group.Servers
+		}
+	}
+	return 0
+}
+
+// ServerGroupServers returns the number of servers of all server groups
+func ServerGroupServers(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) int32 {
+	var servers int32
+	for _, group := range cluster.Spec.Topology.ServerGroups {
+		servers += group.Servers
+	}
+	return servers
+}
+
+// ServerGroupStatefulSetName returns the name of the StatefulSet of a server
+// group
+func ServerGroupStatefulSetName(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, group string) string {
+	return fmt.Sprintf("%s-%s", cluster.Name, group)
+}
+
+// BuildServerGroupStatefulSetsForEnterprise creates a StatefulSet for every
+// server group. The pods run the startup script of the cluster and join it
+// through the servers of spec.topology.servers. Rolling restarts and
+// resizes only cover those servers, so the group StatefulSets keep the
+// rolling update strategy.
+func BuildServerGroupStatefulSetsForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []*appsv1.StatefulSet {
+	var statefulSets []*appsv1.StatefulSet
+	for i := range cluster.Spec.Topology.ServerGroups {
+		group := &cluster.Spec.Topology.ServerGroups[i]
+		sts := buildStatefulSetForEnterprise(serverGroupCluster(cluster, group), group.Name, group.Servers)
+		sts.Labels[ServerGroupLabel] = group.Name
+		sts.Spec.Template.Labels[ServerGroupLabel] = group.Name
+		statefulSets = append(statefulSets, sts)
+	}
+	return statefulSets
+}
+
+// serverGroupCluster returns a copy of the cluster with the resources,
+// storage class and scheduling of the server group in place of its own
+func serverGroupCluster(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, group *neo4jv1alpha1.ServerGroupSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	groupCluster := cluster.DeepCopy()
+	if group.Resources != nil {
+		groupCluster.Spec.Resources = group.Resources.DeepCopy()
+	}
+	if group.StorageClassName != "" {
+		groupCluster.Spec.Storage.ClassName = group.StorageClassName
+	}
+	if group.NodeSelector != nil {
+		groupCluster.Spec.NodeSelector = group.NodeSelector
+	}
+	if group.Tolerations != nil {
+		groupCluster.Spec.Tolerations = group.Tolerations
+	}
+	groupCluster.Status.RollingRestart = nil
+	groupCluster.Status.VerticalScaling = nil
+	return groupCluster
+}
+
+// buildServerPoolConfig generates the mode constraint configuration of the
+// servers. With server groups every group gets its own branch, which tags
+// its servers with the group name and sizes their memory from the resources
+// of the group.
+func buildServerPoolConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if len(cluster.Spec.Topology.ServerGroups) == 0 {
+		return buildServerModeConstraintConfig(cluster)
+	}
+
+	var config strings.Builder
+	config.WriteString(`
+# Server groups: the servers of a group are tagged with its name
+SERVER_TAGS=""
+case "${NEO4J_SERVER_NAME}" in
+server)
+` + buildServerModeConstraintConfig(cluster) + `
+    ;;
+`)
+	for i := range cluster.Spec.Topology.ServerGroups {
+		group := &cluster.Spec.Topology.ServerGroups[i]
+		fmt.Fprintf(&config, `%s)
+    echo "Server group: %s"
+    SERVER_TAGS="%s"
+`, group.Name, group.Name, group.Name)
+		if group.ModeConstraint != "" && group.ModeConstraint != "NONE" {
+			fmt.Fprintf(&config, `cat >> /tmp/neo4j-config/neo4j.conf << EOF
+# Constrain the servers of group %s to %s mode
+initial.server.mode_constraint=%s
+EOF
+`, group.Name, group.ModeConstraint, group.ModeConstraint)
+		}
+		if group.Resources != nil {
+			groupCluster := serverGroupCluster(cluster, group)
+			memoryConfig := GetMemoryConfigForCluster(groupCluster)
+			fmt.Fprintf(&config, `    sed -i -e '/^server\.memory\.heap\.initial_size=/d' -e '/^server\.memory\.heap\.max_size=/d' \
+        -e '/^server\.memory\.pagecache\.size=/d' -e '/^dbms\.memory\.transaction\.total\.max=/d' \
+        -e '/^db\.memory\.transaction\.max=/d' /tmp/neo4j-config/neo4j.conf
+cat >> /tmp/neo4j-config/neo4j.conf << EOF
+# Memory settings of group %s
+server.memory.heap.initial_size=%s
+server.memory.heap.max_size=%s
+server.memory.pagecache.size=%s
+dbms.memory.transaction.total.max=%s
+db.memory.transaction.max=%s
+EOF
+`, group.Name, memoryConfig.HeapInitialSize, memoryConfig.HeapMaxSize, memoryConfig.PageCacheSize,
+				calculateTransactionMemoryLimit(memoryConfig.HeapMaxSize, groupCluster.Spec.Config),
+				calculatePerTransactionLimit(memoryConfig.HeapMaxSize, groupCluster.Spec.Config))
+		}
+		config.WriteString("    ;;\n")
+	}
+	config.WriteString("esac\n")
+	return config.String()
+}
+
+// buildServerTagsWriter writes the server tags collected by the server group
+// and zone spread configuration
+func buildServerTagsWriter(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if len(cluster.Spec.Topology.ServerGroups) == 0 && !ZoneSpreadEnabled(cluster) {
+		return ""
+	}
+	return `
+if [ -n "${SERVER_TAGS}" ]; then
+    echo "Server tags: ${SERVER_TAGS}"
+    echo "initial.server.tags=${SERVER_TAGS}" >> /tmp/neo4j-config/neo4j.conf
+fi
+`
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestBuildServerGroupStatefulSetsForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	cluster.Spec.Image = neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"}
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Storage = neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"}
+	cluster.Spec.NodeSelector = map[string]string{"pool": "oltp"}
+	g.Expect(BuildServerGroupStatefulSetsForEnterprise(cluster)).To(BeEmpty())
+	g.Expect(BuildConfigMapForEnterprise(cluster).Data["startup.sh"]).ToNot(ContainSubstring("SERVER_TAGS"))
+
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{{
+		Name:           "analytics",
+		Servers:        2,
+		ModeConstraint: "SECONDARY",
+		Resources: &corev1.ResourceRequirements{Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("32Gi"),
+		}},
+		StorageClassName: "fast-ssd",
+		NodeSelector:     map[string]string{"pool": "gds"},
+	}}
+	// Rolling restarts only cover the servers of spec.topology.servers
+	cluster.Status.RollingRestart = &neo4jv1alpha1.RollingRestartStatus{}
+
+	statefulSets := BuildServerGroupStatefulSetsForEnterprise(cluster)
+	g.Expect(statefulSets).To(HaveLen(1))
+	sts := statefulSets[0]
+	g.Expect(sts.Name).To(Equal("test-cluster-analytics"))
+	g.Expect(*sts.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(sts.Labels).To(HaveKeyWithValue(ServerGroupLabel, "analytics"))
+	g.Expect(sts.Spec.Selector.MatchLabels).To(HaveKeyWithValue("neo4j.com/server-name", "analytics"))
+	g.Expect(sts.Spec.Template.Labels).To(HaveKeyWithValue(ServerGroupLabel, "analytics"))
+	g.Expect(sts.Spec.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateStatefulSetStrategyType))
+	g.Expect(sts.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "gds"}))
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("32Gi"))
+	g.Expect(*sts.Spec.VolumeClaimTemplates[0].Spec.StorageClassName).To(Equal("fast-ssd"))
+
+	// The servers of spec.topology.servers keep the settings of the cluster
+	server := BuildServerStatefulSetForEnterprise(cluster)
+	g.Expect(server.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "oltp"}))
+	g.Expect(*server.Spec.VolumeClaimTemplates[0].Spec.StorageClassName).To(Equal("standard"))
+
+	startupScript := BuildConfigMapForEnterprise(cluster).Data["startup.sh"]
+	g.Expect(startupScript).To(ContainSubstring(`case "${NEO4J_SERVER_NAME}" in`))
+	g.Expect(startupScript).To(ContainSubstring(`SERVER_TAGS="analytics"`))
+	g.Expect(startupScript).To(ContainSubstring("initial.server.mode_constraint=SECONDARY"))
+	g.Expect(startupScript).To(ContainSubstring("# Memory settings of group analytics"))
+	g.Expect(startupScript).To(ContainSubstring("initial.server.tags=${SERVER_TAGS}"))
+	g.Expect(startupScript).To(ContainSubstring(`[ "${NEO4J_SERVER_NAME}" = "server" ]`))
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// SSL policy scopes, named after the dbms.ssl.policy.<scope> settings
+const (
+	SSLPolicyBolt    = "bolt"
+	SSLPolicyHTTPS   = "https"
+	SSLPolicyCluster = "cluster"
+	SSLPolicyBackup  = "backup"
+)
+
+// SSLPolicyScopes lists every scope a policy can be declared for
+var SSLPolicyScopes = []string{SSLPolicyBolt, SSLPolicyHTTPS, SSLPolicyCluster, SSLPolicyBackup}
+
+// Trust settings of a policy
+const (
+	SSLTrustAll = "TrustAll"
+	SSLTrustCA  = "CA"
+)
+
+const (
+	defaultSSLClientAuth  = "NONE"
+	defaultSSLTLSVersions = "TLSv1.3,TLSv1.2"
+	sharedCertsDirectory  = "/ssl"
+)
+
+// TLSSecretHashAnnotation is the hash of the user-supplied certificate
+// Secrets, so that rotating a certificate restarts the servers
+const TLSSecretHashAnnotation = "neo4j.neo4j.com/tls-secret-hash"
+
+// CertificatesRestartAnnotation is the hash of the renewed certificates the
+// servers could not reload, so that they restart to read them
+const CertificatesRestartAnnotation = "neo4j.neo4j.com/certificates-restart"
+
+// TLSEnabled reports whether the servers serve TLS, with certificates
+// issued by cert-manager or read from an existing Secret
+func TLSEnabled(tls *neo4jv1alpha1.TLSSpec) bool {
+	return tls != nil && (tls.Mode == CertManagerMode || tls.Mode == SecretTLSMode)
+}
+
+// TLSSecretName returns the Secret holding the shared certificate: the one
+// cert-manager issues, or spec.tls.certificateSecret in secret mode.
+func TLSSecretName(owner string, tls *neo4jv1alpha1.TLSSpec) string {
+	if tls != nil && tls.Mode == SecretTLSMode {
+		return tls.CertificateSecret
+	}
+	return owner + "-tls-secret"
+}
+
+// SuppliedTLSSecretNames returns the certificate Secrets of the scopes that
+// users supply rather than cert-manager, sorted and without duplicates.
+func SuppliedTLSSecretNames(tls *neo4jv1alpha1.TLSSpec, scopes []string) []string {
+	if !TLSEnabled(tls) {
+		return nil
+	}
+	names := map[string]bool{}
+	if tls.Mode == SecretTLSMode && tls.CertificateSecret != "" {
+		names[tls.CertificateSecret] = true
+	}
+	for _, scope := range scopes {
+		if policy := SSLPolicy(tls, scope); policy != nil && policy.CertificateSecret != "" {
+			names[policy.CertificateSecret] = true
+		}
+	}
+	return sortedKeys(names)
+}
+
+// TLSSecretVolumeSource mounts a certificate Secret. In secret mode, or
+// once a policy asks for CA trust, the ca.crt of the Secret, when it has
+// one, is projected into the trusted directory of the policy, against which
+// Neo4j checks peer certificates.
+func TLSSecretVolumeSource(tls *neo4jv1alpha1.TLSSpec, secretName string) corev1.VolumeSource {
+	if tls == nil || (tls.Mode != SecretTLSMode && !trustsCA(tls)) {
+		return corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+		}
+	}
+	optional := true
+	secret := corev1.LocalObjectReference{Name: secretName}
+	return corev1.VolumeSource{
+		Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{
+				{Secret: &corev1.SecretProjection{
+					LocalObjectReference: secret,
+					Items: []corev1.KeyToPath{
+						{Key: "tls.crt", Path: "tls.crt"},
+						{Key: "tls.key", Path: "tls.key"},
+					},
+				}},
+				{Secret: &corev1.SecretProjection{
+					LocalObjectReference: secret,
+					Items:                []corev1.KeyToPath{{Key: "ca.crt", Path: "trusted/ca.crt"}},
+					Optional:             &optional,
+				}},
+			},
+		},
+	}
+}
+
+// trustsCA reports whether a policy explicitly verifies peers against its CA
+func trustsCA(tls *neo4jv1alpha1.TLSSpec) bool {
+	for _, scope := range SSLPolicyScopes {
+		if policy := SSLPolicy(tls, scope); policy != nil && policy.Trust == SSLTrustCA {
+			return true
+		}
+	}
+	return false
+}
+
+// SSLPolicy returns the policy declared for a scope, or nil when the scope
+// uses the defaults.
+func SSLPolicy(tls *neo4jv1alpha1.TLSSpec, scope string) *neo4jv1alpha1.SSLPolicySpec {
+	if tls == nil || tls.Policies == nil {
+		return nil
+	}
+	switch scope {
+	case SSLPolicyBolt:
+		return tls.Policies.Bolt
+	case SSLPolicyHTTPS:
+		return tls.Policies.HTTPS
+	case SSLPolicyCluster:
+		return tls.Policies.Cluster
+	case SSLPolicyBackup:
+		return tls.Policies.Backup
+	}
+	return nil
+}
+
+// SSLPolicyCertificateName returns the name of the Certificate issued for a
+// scope with its own issuer.
+func SSLPolicyCertificateName(owner, scope string) string {
+	return fmt.Sprintf("%s-%s-tls", owner, scope)
+}
+
+// SSLPolicySecretName returns the Secret holding the certificate of a scope,
+// or an empty string when the scope shares the <owner>-tls-secret. With
+// cluster mTLS the cluster scope uses the certificates of the servers.
+func SSLPolicySecretName(owner string, tls *neo4jv1alpha1.TLSSpec, scope string) string {
+	policy := SSLPolicy(tls, scope)
+	switch {
+	case policy == nil, scope == SSLPolicyCluster && ClusterMTLSEnabled(tls):
+		return ""
+	case policy.CertificateSecret != "":
+		return policy.CertificateSecret
+	case policy.IssuerRef != nil:
+		return fmt.Sprintf("%s-%s-tls-secret", owner, scope)
+	}
+	return ""
+}
+
+// sslPolicyDirectory returns where the certificate of a scope is mounted.
+// Scopes with their own certificate get a sibling of /ssl, because the
+// shared secret volume is read-only and cannot hold nested mount points.
+func sslPolicyDirectory(owner string, tls *neo4jv1alpha1.TLSSpec, scope string) string {
+	if scope == SSLPolicyCluster && ClusterMTLSEnabled(tls) {
+		return clusterMTLSDirectory
+	}
+	if SSLPolicySecretName(owner, tls, scope) == "" {
+		return sharedCertsDirectory
+	}
+	return sharedCertsDirectory + "-" + scope
+}
+
+// BuildSSLPolicyConfig renders the dbms.ssl.policy.<scope> settings. The
+// backup policy is only rendered when declared; trustAll is the default for
+// the scope, used for the cluster scope, where servers have to trust each
+// other's certificates, unless the policy sets its own trust. Cluster mTLS
+// requires client certificates and checks them against the CA.
+func BuildSSLPolicyConfig(owner string, tls *neo4jv1alpha1.TLSSpec, scope string, trustAll bool) []string {
+	policy := SSLPolicy(tls, scope)
+	if policy == nil && scope == SSLPolicyBackup {
+		return nil
+	}
+
+	clientAuth := defaultSSLClientAuth
+	tlsVersions := defaultSSLTLSVersions
+	var ciphers string
+	if policy != nil {
+		if policy.ClientAuth != "" {
+			clientAuth = policy.ClientAuth
+		}
+		if len(policy.TLSVersions) > 0 {
+			tlsVersions = strings.Join(policy.TLSVersions, ",")
+		}
+		ciphers = strings.Join(policy.Ciphers, ",")
+		if policy.Trust != "" {
+			trustAll = policy.Trust == SSLTrustAll
+		}
+	}
+	if scope == SSLPolicyCluster && ClusterMTLSEnabled(tls) {
+		clientAuth = "REQUIRE"
+		trustAll = false
+	}
+
+	prefix := "dbms.ssl.policy." + scope + "."
+	lines := []string{
+		prefix + "enabled=true",
+		prefix + "base_directory=" + sslPolicyDirectory(owner, tls, scope),
+		prefix + "private_key=tls.key",
+		prefix + "public_certificate=tls.crt",
+	}
+	if trustAll {
+		lines = append(lines, prefix+"trust_all=true")
+	}
+	lines = append(lines,
+		prefix+"client_auth="+clientAuth,
+		prefix+"tls_versions="+tlsVersions,
+	)
+	if ciphers != "" {
+		lines = append(lines, prefix+"ciphers="+ciphers)
+	}
+	return lines
+}
+
+// BuildSSLPolicyVolumes returns the volumes and mounts for the scopes that
+// have their own certificate.
+func BuildSSLPolicyVolumes(owner string, tls *neo4jv1alpha1.TLSSpec, scopes []string) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	for _, scope := range scopes {
+		secretName := SSLPolicySecretName(owner, tls, scope)
+		if secretName == "" {
+			continue
+		}
+		name := fmt.Sprintf("%s-%s", CertsVolume, scope)
+		volumes = append(volumes, corev1.Volume{
+			Name:         name,
+			VolumeSource: TLSSecretVolumeSource(tls, secretName),
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: sslPolicyDirectory(owner, tls, scope),
+			ReadOnly:  true,
+		})
+	}
+	return volumes, mounts
+}
+
+// BuildSSLPolicyCertificates returns a Certificate for every scope with its
+// own issuer. They cover the same DNS names as the shared certificate base.
+func BuildSSLPolicyCertificates(base *certv1.Certificate, owner string, tls *neo4jv1alpha1.TLSSpec, scopes []string) []*certv1.Certificate {
+	if base == nil {
+		return nil
+	}
+	var certificates []*certv1.Certificate
+	for _, scope := range scopes {
+		policy := SSLPolicy(tls, scope)
+		if policy == nil || policy.IssuerRef == nil || policy.CertificateSecret != "" {
+			continue
+		}
+		if scope == SSLPolicyCluster && ClusterMTLSEnabled(tls) {
+			continue
+		}
+		certificate := base.DeepCopy()
+		certificate.Name = SSLPolicyCertificateName(owner, scope)
+		certificate.Spec.SecretName = SSLPolicySecretName(owner, tls, scope)
+		certificate.Spec.IssuerRef = cmmeta.ObjectReference{
+			Name:  policy.IssuerRef.Name,
+			Kind:  policy.IssuerRef.Kind,
+			Group: policy.IssuerRef.Group,
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates
+}
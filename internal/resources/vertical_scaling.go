@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// VerticalPodAutoscalerGVK is the kind of the autoscaler built for resource
+// recommendations
+var VerticalPodAutoscalerGVK = schema.GroupVersionKind{
+	Group:   "autoscaling.k8s.io",
+	Version: "v1",
+	Kind:    "VerticalPodAutoscaler",
+}
+
+// BuildVerticalPodAutoscalerForEnterprise creates a VerticalPodAutoscaler
+// for the server StatefulSet in the Off update mode, so it only records
+// recommendations for the neo4j container and never evicts a server. It
+// returns nil unless spec.verticalScaling.recommendations is set.
+func BuildVerticalPodAutoscalerForEnterprise(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) *unstructured.Unstructured {
+	if cluster.Spec.VerticalScaling == nil || !cluster.Spec.VerticalScaling.Recommendations {
+		return nil
+	}
+
+	labels := map[string]interface{}{}
+	for k, v := range getLabelsForEnterprise(cluster, "server") {
+		if k != "neo4j.com/clustering" {
+			labels[k] = v
+		}
+	}
+
+	vpa := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      fmt.Sprintf("%s-server", cluster.Name),
+				"namespace": cluster.Namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]interface{}{
+				"targetRef": map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "StatefulSet",
+					"name":       fmt.Sprintf("%s-server", cluster.Name),
+				},
+				"updatePolicy": map[string]interface{}{
+					"updateMode": "Off",
+				},
+				"resourcePolicy": map[string]interface{}{
+					"containerPolicies": []interface{}{
+						map[string]interface{}{
+							"containerName":       Neo4jContainer,
+							"controlledResources": []interface{}{"cpu", "memory"},
+						},
+						map[string]interface{}{
+							"containerName": "*",
+							"mode":          "Off",
+						},
+					},
+				},
+			},
+		},
+	}
+	vpa.SetGroupVersionKind(VerticalPodAutoscalerGVK)
+	return vpa
+}
+
+// InPlaceResizeInProgress tells whether the server pods are being resized
+// in place
+func InPlaceResizeInProgress(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Status.VerticalScaling != nil && cluster.Status.VerticalScaling.Resize != nil
+}
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestBuildVerticalPodAutoscalerForEnterprise(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	g.Expect(BuildVerticalPodAutoscalerForEnterprise(cluster)).To(BeNil())
+
+	cluster.Spec.VerticalScaling = &neo4jv1alpha1.VerticalScalingSpec{Recommendations: true}
+	vpa := BuildVerticalPodAutoscalerForEnterprise(cluster)
+	g.Expect(vpa).ToNot(BeNil())
+	g.Expect(vpa.GetName()).To(Equal("test-cluster-server"))
+	g.Expect(vpa.GroupVersionKind()).To(Equal(VerticalPodAutoscalerGVK))
+	g.Expect(vpa.GetLabels()).ToNot(HaveKey("neo4j.com/clustering"))
+
+	target, _, _ := unstructured.NestedStringMap(vpa.Object, "spec", "targetRef")
+	g.Expect(target).To(Equal(map[string]string{"apiVersion": "apps/v1", "kind": "StatefulSet", "name": "test-cluster-server"}))
+	mode, _, _ := unstructured.NestedString(vpa.Object, "spec", "updatePolicy", "updateMode")
+	g.Expect(mode).To(Equal("Off"))
+}
+
+func TestInPlaceResizeUsesOnDelete(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{ObjectMeta: testObjectMeta("test-cluster", "default")}
+	cluster.Spec.Topology.Servers = 3
+	cluster.Spec.Storage.Size = "10Gi"
+	g.Expect(BuildServerStatefulSetForEnterprise(cluster).Spec.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateStatefulSetStrategyType))
+
+	cluster.Status.VerticalScaling = &neo4jv1alpha1.VerticalScalingStatus{Resize: &neo4jv1alpha1.ResizeStatus{}}
+	g.Expect(BuildServerStatefulSetForEnterprise(cluster).Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteStatefulSetStrategyType))
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// ZoneLabel is set by the operator on server pods to the zone of their node
+const ZoneLabel = "neo4j.com/zone"
+
+const (
+	defaultZoneTopologyKey = "topology.kubernetes.io/zone"
+	defaultMinZones        = int32(3)
+	podInfoVolume          = "podinfo"
+	podInfoDirectory       = "/etc/podinfo"
+)
+
+// ZoneSpreadEnabled reports whether spec.topology.zoneSpread is on
+func ZoneSpreadEnabled(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) bool {
+	return cluster.Spec.Topology.ZoneSpread != nil && cluster.Spec.Topology.ZoneSpread.Enabled
+}
+
+// ZoneTopologyKey returns the node label holding the zone of a server
+func ZoneTopologyKey(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if spread := cluster.Spec.Topology.ZoneSpread; spread != nil && spread.TopologyKey != "" {
+		return spread.TopologyKey
+	}
+	return defaultZoneTopologyKey
+}
+
+// ZoneSpreadMinZones returns the number of zones the servers have to span
+func ZoneSpreadMinZones(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) int32 {
+	if spread := cluster.Spec.Topology.ZoneSpread; spread != nil && spread.MinZones > 0 {
+		return spread.MinZones
+	}
+	return defaultMinZones
+}
+
+// buildZoneSpreadConstraint keeps the servers within one pod of each other
+// across at least minZones zones. Pods that cannot be placed that way stay
+// pending rather than doubling up in a zone.
+func buildZoneSpreadConstraint(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) corev1.TopologySpreadConstraint {
+	minZones := ZoneSpreadMinZones(cluster)
+	return corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       ZoneTopologyKey(cluster),
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		MinDomains:        &minZones,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: serverPodSelector(cluster)},
+	}
+}
+
+// buildPodInfoVolume exposes the pod labels to the startup script, which
+// reads the zone label from it.
+func buildPodInfoVolume() (corev1.Volume, corev1.VolumeMount) {
+	volume := corev1.Volume{
+		Name: podInfoVolume,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{Path: "labels", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+				},
+			},
+		},
+	}
+	mount := corev1.VolumeMount{Name: podInfoVolume, MountPath: podInfoDirectory, ReadOnly: true}
+	return volume, mount
+}
+
+// buildServerTagsConfig waits for the zone label of the pod and adds it to
+// the server tags. The label only appears once the operator has seen the pod
+// scheduled, so a server that starts without it is left untagged after a
+// few minutes instead of never starting.
+func buildServerTagsConfig(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) string {
+	if !ZoneSpreadEnabled(cluster) {
+		return ""
+	}
+	return fmt.Sprintf(`
+# Zone spread: tag the server with its availability zone
+SERVER_ZONE=""
+for attempt in $(seq 1 60); do
+    SERVER_ZONE=$(sed -n 's/^neo4j\.com\/zone="\(.*\)"$/\1/p' %s/labels)
+    [ -n "$SERVER_ZONE" ] && break
+    echo "Waiting for the zone label of ${HOSTNAME} (attempt ${attempt})"
+    sleep 5
+done
+if [ -n "$SERVER_ZONE" ]; then
+    echo "Server zone: ${SERVER_ZONE}"
+    SERVER_TAGS="${SERVER_TAGS:+${SERVER_TAGS},}${SERVER_ZONE}"
+else
+    echo "No zone label on ${HOSTNAME}, starting without a zone tag"
+fi
+cat >> /tmp/neo4j-config/neo4j.conf << EOF
+# New databases get a primary for every zone the servers span
+initial.dbms.default_primaries_count=%d
+EOF
+`, podInfoDirectory, ZoneSpreadMinZones(cluster))
+}
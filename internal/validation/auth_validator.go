@@ -18,10 +18,16 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
 )
 
 // AuthValidator validates Neo4j authentication configuration
@@ -60,8 +66,10 @@ func (v *AuthValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		}
 	}
 
-	// Validate that external auth providers have secretRef
-	if cluster.Spec.Auth.Provider != "" && cluster.Spec.Auth.Provider != "native" {
+	// Validate that external auth providers have secretRef, unless LDAP or
+	// Kerberos are configured through spec.auth.ldap and spec.auth.kerberos
+	if cluster.Spec.Auth.Provider != "" && cluster.Spec.Auth.Provider != "native" &&
+		!resources.LDAPEnabled(cluster.Spec.Auth) && !resources.KerberosEnabled(cluster.Spec.Auth) {
 		if cluster.Spec.Auth.SecretRef == "" {
 			allErrs = append(allErrs, field.Required(
 				authPath.Child("secretRef"),
@@ -70,5 +78,376 @@ func (v *AuthValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		}
 	}
 
+	// The operator user replaces the admin for the operator, so it is a user
+	// of its own
+	if operatorSecret := cluster.Spec.Auth.OperatorSecret; operatorSecret != "" && operatorSecret == adminSecretName(cluster.Spec.Auth) {
+		allErrs = append(allErrs, field.Invalid(authPath.Child("operatorSecret"), operatorSecret,
+			"the operator user needs a Secret of its own, not that of the admin"))
+	}
+
+	allErrs = append(allErrs, v.validateExternalSecret(cluster, authPath.Child("externalSecret"))...)
+	allErrs = append(allErrs, v.validateLDAP(cluster.Spec.Auth, authPath)...)
+	allErrs = append(allErrs, v.validateKerberos(cluster.Spec.Auth, authPath)...)
+	allErrs = append(allErrs, v.validateOIDC(cluster.Spec.Auth, authPath.Child("oidc"))...)
+
+	return allErrs
+}
+
+// validateExternalSecret validates the admin credentials synced by the
+// External Secrets Operator
+func (v *AuthValidator) validateExternalSecret(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	spec := cluster.Spec.Auth.ExternalSecret
+	if spec == nil {
+		return allErrs
+	}
+
+	if spec.SecretStoreRef.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("secretStoreRef", "name"), "secret store name is required"))
+	}
+	if spec.SecretStoreRef.Kind != "" && spec.SecretStoreRef.Kind != "SecretStore" && spec.SecretStoreRef.Kind != "ClusterSecretStore" {
+		allErrs = append(allErrs, field.NotSupported(path.Child("secretStoreRef", "kind"), spec.SecretStoreRef.Kind,
+			[]string{"SecretStore", "ClusterSecretStore"}))
+	}
+	if spec.Key == "" {
+		allErrs = append(allErrs, field.Required(path.Child("key"), "key of the credentials in the store is required"))
+	}
+	if spec.RefreshInterval != "" {
+		if _, err := time.ParseDuration(spec.RefreshInterval); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("refreshInterval"), spec.RefreshInterval,
+				fmt.Sprintf("invalid duration: %v", err)))
+		}
+	}
+	if target := resources.AdminExternalSecretTarget(cluster); cluster.Spec.Auth.AdminSecret == target {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "auth", "adminSecret"), target,
+			"the External Secrets Operator writes the synced credentials to this Secret, use another name"))
+	}
+
+	return allErrs
+}
+
+var (
+	// ldapAttributePattern matches an attribute type of a DN, by name or OID
+	ldapAttributePattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9-]*|[0-9]+(\.[0-9]+)*)$`)
+	// rolePattern matches the names Neo4j accepts for roles
+	rolePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	// oidcNamePattern matches the names of OIDC providers in the settings
+	oidcNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	// kerberosRealmPattern matches the realm names that krb5.conf accepts
+	kerberosRealmPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	// kerberosHostPattern matches a host name, optionally with a port
+	kerberosHostPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?(:[0-9]{1,5})?$`)
+)
+
+// validateLDAP validates spec.auth.ldap. A mistake there does not lock out
+// the admin, as the native provider stays enabled, but it does lock out
+// every LDAP user, so the settings Neo4j only checks at login are checked
+// here.
+func (v *AuthValidator) validateLDAP(auth *neo4jv1alpha1.AuthSpec, authPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ldap := auth.LDAP
+	if ldap == nil {
+		return allErrs
+	}
+	path := authPath.Child("ldap")
+	if auth.Provider != "ldap" && auth.Provider != "kerberos" {
+		return append(allErrs, field.Invalid(authPath.Child("provider"), auth.Provider,
+			"spec.auth.ldap is only used by the ldap and kerberos providers"))
+	}
+
+	serverPath := path.Child("server")
+	if ldap.Server == nil || len(ldap.Server.URLs) == 0 {
+		allErrs = append(allErrs, field.Required(serverPath.Child("urls"), "at least one LDAP server URL is required"))
+	} else {
+		for i, raw := range ldap.Server.URLs {
+			u, err := url.Parse(raw)
+			if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Hostname() == "" {
+				allErrs = append(allErrs, field.Invalid(serverPath.Child("urls").Index(i), raw,
+					"must be an ldap:// or ldaps:// URL with a host"))
+			}
+		}
+		if ldap.Server.InsecureSkipVerify {
+			allErrs = append(allErrs, field.Invalid(serverPath.Child("insecureSkipVerify"), true,
+				"Neo4j always verifies the certificates of LDAP servers; trust their CA with caSecret instead"))
+		}
+	}
+
+	if ldap.BindCredentials != nil && ldap.BindCredentials.SecretRef == "" {
+		allErrs = append(allErrs, field.Required(path.Child("bindCredentials", "secretRef"), "secret holding the bind credentials is required"))
+	}
+	switch {
+	case auth.Provider == "kerberos":
+		// Kerberos authenticates the users, LDAP searches for their groups
+		if ldap.BindCredentials == nil {
+			allErrs = append(allErrs, field.Required(path.Child("bindCredentials"),
+				"bindCredentials are required to search for the groups of Kerberos users"))
+		}
+		if ldap.UserSearch == nil || ldap.UserSearch.BaseDN == "" {
+			allErrs = append(allErrs, field.Required(path.Child("userSearch", "baseDN"),
+				"a user search base is required to search for the groups of Kerberos users"))
+		}
+	case ldap.UserDNTemplate != "":
+		if !strings.Contains(ldap.UserDNTemplate, "{0}") {
+			allErrs = append(allErrs, field.Invalid(path.Child("userDNTemplate"), ldap.UserDNTemplate,
+				"must contain {0}, which is replaced by the username"))
+		} else if err := checkLDAPDN(strings.ReplaceAll(ldap.UserDNTemplate, "{0}", "user")); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("userDNTemplate"), ldap.UserDNTemplate, err.Error()))
+		}
+	default:
+		if ldap.BindCredentials == nil {
+			allErrs = append(allErrs, field.Required(path.Child("bindCredentials"),
+				"bindCredentials are required to search for users without a userDNTemplate"))
+		}
+		if ldap.UserSearch == nil || ldap.UserSearch.BaseDN == "" {
+			allErrs = append(allErrs, field.Required(path.Child("userSearch", "baseDN"),
+				"a user search base is required to search for users without a userDNTemplate"))
+		}
+	}
+
+	for _, search := range []struct {
+		name string
+		spec *neo4jv1alpha1.LDAPSearchSpec
+	}{{"userSearch", ldap.UserSearch}, {"groupSearch", ldap.GroupSearch}} {
+		if search.spec == nil {
+			continue
+		}
+		if search.spec.BaseDN != "" {
+			if err := checkLDAPDN(search.spec.BaseDN); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child(search.name, "baseDN"), search.spec.BaseDN, err.Error()))
+			}
+		}
+		if search.spec.Filter != "" {
+			if err := checkLDAPFilter(search.spec.Filter); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child(search.name, "filter"), search.spec.Filter, err.Error()))
+			} else if !strings.Contains(search.spec.Filter, "{0}") {
+				allErrs = append(allErrs, field.Invalid(path.Child(search.name, "filter"), search.spec.Filter,
+					"must contain {0}, which is replaced by the user"))
+			}
+		}
+	}
+
+	mappingPath := path.Child("groupToRoleMapping")
+	for group, roles := range ldap.GroupToRoleMapping {
+		if strings.ContainsAny(group, "\";") {
+			allErrs = append(allErrs, field.Invalid(mappingPath.Key(group), group, "group DNs cannot contain quotes or semicolons"))
+		} else if err := checkLDAPDN(group); err != nil {
+			allErrs = append(allErrs, field.Invalid(mappingPath.Key(group), group, err.Error()))
+		} else if ldap.GroupSearch != nil && ldap.GroupSearch.BaseDN != "" && !ldapDNUnder(group, ldap.GroupSearch.BaseDN) {
+			allErrs = append(allErrs, field.Invalid(mappingPath.Key(group), group,
+				fmt.Sprintf("group is not under the group search base %s", ldap.GroupSearch.BaseDN)))
+		}
+		allErrs = append(allErrs, validateMappedRoles(roles, mappingPath.Key(group))...)
+	}
+
+	return allErrs
+}
+
+// validateKerberos validates spec.auth.kerberos. The realm and KDCs end up
+// in krb5.conf, which is parsed by the JVM only when the first user logs in.
+func (v *AuthValidator) validateKerberos(auth *neo4jv1alpha1.AuthSpec, authPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	kerberos := auth.Kerberos
+	if kerberos == nil {
+		return allErrs
+	}
+	path := authPath.Child("kerberos")
+	if auth.Provider != "kerberos" {
+		return append(allErrs, field.Invalid(authPath.Child("provider"), auth.Provider,
+			"spec.auth.kerberos is only used by the kerberos provider"))
+	}
+
+	if kerberos.Realm == "" {
+		allErrs = append(allErrs, field.Required(path.Child("realm"), "realm is required"))
+	} else if !kerberosRealmPattern.MatchString(kerberos.Realm) {
+		allErrs = append(allErrs, field.Invalid(path.Child("realm"), kerberos.Realm, "invalid realm name"))
+	}
+
+	if kerberos.ServicePrincipal == "" {
+		allErrs = append(allErrs, field.Required(path.Child("servicePrincipal"), "service principal is required"))
+	} else if strings.ContainsAny(kerberos.ServicePrincipal, " \t\n") {
+		allErrs = append(allErrs, field.Invalid(path.Child("servicePrincipal"), kerberos.ServicePrincipal,
+			"service principal cannot contain whitespace"))
+	} else if _, realm, found := strings.Cut(kerberos.ServicePrincipal, "@"); found && kerberos.Realm != "" && realm != kerberos.Realm {
+		allErrs = append(allErrs, field.Invalid(path.Child("servicePrincipal"), kerberos.ServicePrincipal,
+			fmt.Sprintf("service principal is not in the realm %s", kerberos.Realm)))
+	}
+
+	if kerberos.Keytab == nil || kerberos.Keytab.SecretRef == "" {
+		allErrs = append(allErrs, field.Required(path.Child("keytab", "secretRef"), "secret holding the keytab is required"))
+	}
+
+	for i, kdc := range kerberos.KDCs {
+		if !kerberosHostPattern.MatchString(kdc) {
+			allErrs = append(allErrs, field.Invalid(path.Child("kdcs").Index(i), kdc, "must be a host or host:port"))
+		}
+	}
+	if kerberos.AdminServer != "" && !kerberosHostPattern.MatchString(kerberos.AdminServer) {
+		allErrs = append(allErrs, field.Invalid(path.Child("adminServer"), kerberos.AdminServer, "must be a host or host:port"))
+	}
+	for i, domain := range kerberos.Domains {
+		if !kerberosHostPattern.MatchString(strings.TrimPrefix(domain, ".")) || strings.Contains(domain, ":") {
+			allErrs = append(allErrs, field.Invalid(path.Child("domains").Index(i), domain, "must be a host or a domain such as .example.com"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMappedRoles validates the roles a group is mapped to
+func validateMappedRoles(roles []string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(roles) == 0 {
+		allErrs = append(allErrs, field.Required(path, "at least one role is required"))
+	}
+	for _, role := range roles {
+		if !rolePattern.MatchString(role) {
+			allErrs = append(allErrs, field.Invalid(path, role, "invalid role name"))
+		}
+	}
 	return allErrs
 }
+
+// validateOIDC validates spec.auth.oidc, and checks the discovery document
+// of the issuer when the operator runs with --oidc-discovery-check
+func (v *AuthValidator) validateOIDC(auth *neo4jv1alpha1.AuthSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	oidc := auth.OIDC
+	if oidc == nil {
+		return allErrs
+	}
+
+	if oidc.Name != "" && !oidcNamePattern.MatchString(oidc.Name) {
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), oidc.Name,
+			"must start with a letter or digit and contain only letters, digits, '_' and '-'"))
+	}
+	issuerValid := false
+	if oidc.Issuer == "" {
+		allErrs = append(allErrs, field.Required(path.Child("issuer"), "issuer URL is required"))
+	} else if u, err := url.Parse(oidc.Issuer); err != nil || u.Scheme != "https" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		allErrs = append(allErrs, field.Invalid(path.Child("issuer"), oidc.Issuer,
+			"must be an https URL without query or fragment"))
+	} else {
+		issuerValid = true
+	}
+	if oidc.ClientID == "" {
+		allErrs = append(allErrs, field.Required(path.Child("clientID"), "client ID is required"))
+	} else if strings.ContainsAny(oidc.ClientID, ";=") {
+		allErrs = append(allErrs, field.Invalid(path.Child("clientID"), oidc.ClientID, "client ID cannot contain ';' or '='"))
+	}
+	if oidc.AuthFlow != "" && oidc.AuthFlow != "pkce" && oidc.AuthFlow != "implicit" {
+		allErrs = append(allErrs, field.NotSupported(path.Child("authFlow"), oidc.AuthFlow, []string{"pkce", "implicit"}))
+	}
+	if oidc.ClientSecret != nil && oidc.ClientSecret.SecretRef == "" {
+		allErrs = append(allErrs, field.Required(path.Child("clientSecret", "secretRef"), "secret holding the client secret is required"))
+	}
+	if len(oidc.Scopes) > 0 && !slices.Contains(oidc.Scopes, "openid") {
+		allErrs = append(allErrs, field.Invalid(path.Child("scopes"), oidc.Scopes, "scopes must include openid"))
+	}
+
+	mappingPath := path.Child("groupToRoleMapping")
+	for group, roles := range oidc.GroupToRoleMapping {
+		if group == "" || strings.ContainsAny(group, "\";") {
+			allErrs = append(allErrs, field.Invalid(mappingPath.Key(group), group, "group names cannot be empty or contain quotes or semicolons"))
+		}
+		allErrs = append(allErrs, validateMappedRoles(roles, mappingPath.Key(group))...)
+	}
+
+	if issuerValid && len(allErrs) == 0 {
+		if err := checkOIDCDiscovery(oidc); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("issuer"), oidc.Issuer, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// checkLDAPDN checks that a DN is a comma separated list of attribute=value
+// pairs
+func checkLDAPDN(dn string) error {
+	for _, rdn := range splitLDAPDN(dn) {
+		attribute, value, found := strings.Cut(rdn, "=")
+		attribute = strings.TrimSpace(attribute)
+		if !found || strings.TrimSpace(value) == "" || !ldapAttributePattern.MatchString(attribute) || ldapUnescaped(value, "=;") {
+			return fmt.Errorf("invalid DN component %q, expected attribute=value", strings.TrimSpace(rdn))
+		}
+	}
+	return nil
+}
+
+// ldapUnescaped reports whether a DN value holds any of chars without
+// escaping them
+func ldapUnescaped(value, chars string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+		} else if strings.IndexByte(chars, value[i]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// splitLDAPDN splits a DN at the commas that are not escaped
+func splitLDAPDN(dn string) []string {
+	var rdns []string
+	start := 0
+	for i := 0; i < len(dn); i++ {
+		switch dn[i] {
+		case '\\':
+			i++
+		case ',':
+			rdns = append(rdns, dn[start:i])
+			start = i + 1
+		}
+	}
+	return append(rdns, dn[start:])
+}
+
+// ldapDNUnder reports whether a DN is the base DN or below it. Attribute
+// types and values are compared without case and surrounding spaces.
+func ldapDNUnder(dn, base string) bool {
+	normalize := func(dn string) []string {
+		rdns := splitLDAPDN(dn)
+		for i, rdn := range rdns {
+			attribute, value, _ := strings.Cut(rdn, "=")
+			rdns[i] = strings.ToLower(strings.TrimSpace(attribute)) + "=" + strings.ToLower(strings.TrimSpace(value))
+		}
+		return rdns
+	}
+	rdns, baseRDNs := normalize(dn), normalize(base)
+	if len(rdns) < len(baseRDNs) {
+		return false
+	}
+	offset := len(rdns) - len(baseRDNs)
+	for i, rdn := range baseRDNs {
+		if rdns[offset+i] != rdn {
+			return false
+		}
+	}
+	return true
+}
+
+// checkLDAPFilter checks that a search filter is a parenthesized expression
+// with balanced parentheses
+func checkLDAPFilter(filter string) error {
+	if !strings.HasPrefix(filter, "(") || !strings.HasSuffix(filter, ")") {
+		return fmt.Errorf("filter must be enclosed in parentheses")
+	}
+	depth := 0
+	for i := 0; i < len(filter); i++ {
+		switch filter[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unbalanced parentheses")
+			}
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("unbalanced parentheses")
+	}
+	return nil
+}
@@ -17,9 +17,14 @@ limitations under the License.
 package validation
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 )
@@ -121,3 +126,453 @@ func TestAuthValidator_Validate_NilAuth(t *testing.T) {
 		t.Errorf("expected no errors for nil auth, got: %v", errs)
 	}
 }
+
+func TestAuthValidator_ExternalSecret(t *testing.T) {
+	v := NewAuthValidator()
+
+	cases := []struct {
+		name     string
+		spec     neo4jv1alpha1.AdminExternalSecretSpec
+		admin    string
+		errField string
+	}{
+		{
+			name: "valid",
+			spec: neo4jv1alpha1.AdminExternalSecretSpec{
+				SecretStoreRef: neo4jv1alpha1.SecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"},
+				Key:            "neo4j/admin",
+			},
+		},
+		{
+			name:     "missing store",
+			spec:     neo4jv1alpha1.AdminExternalSecretSpec{Key: "neo4j/admin"},
+			errField: "spec.auth.externalSecret.secretStoreRef.name",
+		},
+		{
+			name:     "missing key",
+			spec:     neo4jv1alpha1.AdminExternalSecretSpec{SecretStoreRef: neo4jv1alpha1.SecretStoreRef{Name: "vault"}},
+			errField: "spec.auth.externalSecret.key",
+		},
+		{
+			name: "invalid refresh interval",
+			spec: neo4jv1alpha1.AdminExternalSecretSpec{
+				SecretStoreRef:  neo4jv1alpha1.SecretStoreRef{Name: "vault"},
+				Key:             "neo4j/admin",
+				RefreshInterval: "hourly",
+			},
+			errField: "spec.auth.externalSecret.refreshInterval",
+		},
+		{
+			name: "admin secret is the sync target",
+			spec: neo4jv1alpha1.AdminExternalSecretSpec{
+				SecretStoreRef: neo4jv1alpha1.SecretStoreRef{Name: "vault"},
+				Key:            "neo4j/admin",
+			},
+			admin:    "test-admin-secret",
+			errField: "spec.auth.adminSecret",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := clusterWithAuth("native", "")
+			spec := tc.spec
+			cluster.Spec.Auth.ExternalSecret = &spec
+			cluster.Spec.Auth.AdminSecret = tc.admin
+			errs := v.Validate(cluster)
+
+			if tc.errField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tc.errField {
+				t.Errorf("expected one error on field %q, got: %v", tc.errField, errs)
+			}
+		})
+	}
+}
+
+func TestAuthValidator_OperatorSecret(t *testing.T) {
+	v := NewAuthValidator()
+
+	cluster := clusterWithAuth("native", "")
+	cluster.Spec.Auth.OperatorSecret = "neo4j-operator"
+	if errs := v.Validate(cluster); len(errs) != 0 {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+
+	// The admin Secret defaults to neo4j-admin-secret
+	cluster.Spec.Auth.OperatorSecret = "neo4j-admin-secret"
+	if errs := v.Validate(cluster); len(errs) != 1 || errs[0].Field != "spec.auth.operatorSecret" {
+		t.Errorf("expected one error on field spec.auth.operatorSecret, got: %v", errs)
+	}
+}
+
+func TestAuthValidator_LDAP(t *testing.T) {
+	v := NewAuthValidator()
+	valid := func() *neo4jv1alpha1.LDAPAuthSpec {
+		return &neo4jv1alpha1.LDAPAuthSpec{
+			Server:          &neo4jv1alpha1.LDAPServerSpec{URLs: []string{"ldaps://ldap.example.com:636"}, TLS: true},
+			BindCredentials: &neo4jv1alpha1.LDAPBindCredentialsSpec{SecretRef: "ldap-bind"},
+			UserSearch:      &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=users,dc=example,dc=com", Filter: "(&(objectClass=person)(uid={0}))"},
+			GroupSearch:     &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=groups,dc=example,dc=com"},
+			GroupToRoleMapping: map[string][]string{
+				"cn=Neo4j Admins,ou=groups,dc=example,dc=com": {"admin"},
+				"CN=Readers, OU=Groups,DC=example,DC=com":     {"reader", "publisher"},
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		provider string
+		edit     func(*neo4jv1alpha1.LDAPAuthSpec)
+		errField string
+	}{
+		{name: "valid", edit: func(*neo4jv1alpha1.LDAPAuthSpec) {}},
+		{
+			name: "valid with user DN template",
+			edit: func(l *neo4jv1alpha1.LDAPAuthSpec) {
+				l.BindCredentials = nil
+				l.UserSearch = nil
+				l.UserDNTemplate = "uid={0},ou=users,dc=example,dc=com"
+			},
+		},
+		{
+			name:     "other provider",
+			provider: "native",
+			edit:     func(*neo4jv1alpha1.LDAPAuthSpec) {},
+			errField: "spec.auth.provider",
+		},
+		{
+			name:     "missing server",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.Server = nil },
+			errField: "spec.auth.ldap.server.urls",
+		},
+		{
+			name:     "invalid URL",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.Server.URLs = []string{"ldap.example.com:636"} },
+			errField: "spec.auth.ldap.server.urls[0]",
+		},
+		{
+			name:     "insecure skip verify",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.Server.InsecureSkipVerify = true },
+			errField: "spec.auth.ldap.server.insecureSkipVerify",
+		},
+		{
+			name:     "template without placeholder",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.UserDNTemplate = "uid=user,ou=users,dc=example,dc=com" },
+			errField: "spec.auth.ldap.userDNTemplate",
+		},
+		{
+			name:     "search without bind credentials",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.BindCredentials = nil },
+			errField: "spec.auth.ldap.bindCredentials",
+		},
+		{
+			name:     "invalid base DN",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.UserSearch.BaseDN = "ou=users;dc=example" },
+			errField: "spec.auth.ldap.userSearch.baseDN",
+		},
+		{
+			name:     "unbalanced filter",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.UserSearch.Filter = "(&(objectClass=person)(uid={0})" },
+			errField: "spec.auth.ldap.userSearch.filter",
+		},
+		{
+			name:     "filter without placeholder",
+			edit:     func(l *neo4jv1alpha1.LDAPAuthSpec) { l.UserSearch.Filter = "(objectClass=person)" },
+			errField: "spec.auth.ldap.userSearch.filter",
+		},
+		{
+			name: "group outside the group search base",
+			edit: func(l *neo4jv1alpha1.LDAPAuthSpec) {
+				l.GroupToRoleMapping = map[string][]string{"cn=admins,ou=grups,dc=example,dc=com": {"admin"}}
+			},
+			errField: "spec.auth.ldap.groupToRoleMapping[cn=admins,ou=grups,dc=example,dc=com]",
+		},
+		{
+			name: "invalid role",
+			edit: func(l *neo4jv1alpha1.LDAPAuthSpec) {
+				l.GroupToRoleMapping = map[string][]string{"cn=admins,ou=groups,dc=example,dc=com": {"admin;reader"}}
+			},
+			errField: "spec.auth.ldap.groupToRoleMapping[cn=admins,ou=groups,dc=example,dc=com]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := tc.provider
+			if provider == "" {
+				provider = "ldap"
+			}
+			cluster := clusterWithAuth(provider, "")
+			cluster.Spec.Auth.LDAP = valid()
+			tc.edit(cluster.Spec.Auth.LDAP)
+			errs := v.Validate(cluster)
+
+			if tc.errField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tc.errField {
+				t.Errorf("expected one error on field %q, got: %v", tc.errField, errs)
+			}
+		})
+	}
+}
+
+func TestAuthValidator_Kerberos(t *testing.T) {
+	v := NewAuthValidator()
+	valid := func() *neo4jv1alpha1.KerberosAuthSpec {
+		return &neo4jv1alpha1.KerberosAuthSpec{
+			Realm:            "EXAMPLE.COM",
+			ServicePrincipal: "neo4j/neo4j.example.com@EXAMPLE.COM",
+			Keytab:           &neo4jv1alpha1.KerberosKeytabSpec{SecretRef: "neo4j-keytab"},
+			KDCs:             []string{"kdc-0.example.com:88", "kdc-1.example.com"},
+			AdminServer:      "kdc-0.example.com",
+			Domains:          []string{".example.com", "example.com"},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		provider string
+		edit     func(*neo4jv1alpha1.AuthSpec)
+		errField string
+	}{
+		{name: "valid", edit: func(*neo4jv1alpha1.AuthSpec) {}},
+		{
+			name: "valid with DNS lookup of the KDCs",
+			edit: func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.KDCs = nil },
+		},
+		{
+			name: "valid with LDAP groups",
+			edit: func(a *neo4jv1alpha1.AuthSpec) {
+				a.LDAP = &neo4jv1alpha1.LDAPAuthSpec{
+					Server:          &neo4jv1alpha1.LDAPServerSpec{URLs: []string{"ldaps://ldap.example.com"}},
+					BindCredentials: &neo4jv1alpha1.LDAPBindCredentialsSpec{SecretRef: "ldap-bind"},
+					UserSearch:      &neo4jv1alpha1.LDAPSearchSpec{BaseDN: "ou=users,dc=example,dc=com"},
+				}
+			},
+		},
+		{
+			name: "LDAP groups without a user search base",
+			edit: func(a *neo4jv1alpha1.AuthSpec) {
+				a.LDAP = &neo4jv1alpha1.LDAPAuthSpec{
+					Server:          &neo4jv1alpha1.LDAPServerSpec{URLs: []string{"ldaps://ldap.example.com"}},
+					BindCredentials: &neo4jv1alpha1.LDAPBindCredentialsSpec{SecretRef: "ldap-bind"},
+				}
+			},
+			errField: "spec.auth.ldap.userSearch.baseDN",
+		},
+		{
+			name:     "other provider",
+			provider: "native",
+			edit:     func(*neo4jv1alpha1.AuthSpec) {},
+			errField: "spec.auth.provider",
+		},
+		{
+			name:     "missing realm",
+			edit:     func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.Realm = "" },
+			errField: "spec.auth.kerberos.realm",
+		},
+		{
+			name:     "principal in another realm",
+			edit:     func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.ServicePrincipal = "neo4j/neo4j.example.com@OTHER.COM" },
+			errField: "spec.auth.kerberos.servicePrincipal",
+		},
+		{
+			name:     "missing keytab",
+			edit:     func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.Keytab = nil },
+			errField: "spec.auth.kerberos.keytab.secretRef",
+		},
+		{
+			name:     "invalid KDC",
+			edit:     func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.KDCs = []string{"kdc.example.com:88 }"} },
+			errField: "spec.auth.kerberos.kdcs[0]",
+		},
+		{
+			name:     "invalid domain",
+			edit:     func(a *neo4jv1alpha1.AuthSpec) { a.Kerberos.Domains = []string{".example.com:88"} },
+			errField: "spec.auth.kerberos.domains[0]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := tc.provider
+			if provider == "" {
+				provider = "kerberos"
+			}
+			cluster := clusterWithAuth(provider, "")
+			cluster.Spec.Auth.Kerberos = valid()
+			tc.edit(cluster.Spec.Auth)
+			errs := v.Validate(cluster)
+
+			if tc.errField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tc.errField {
+				t.Errorf("expected one error on field %q, got: %v", tc.errField, errs)
+			}
+		})
+	}
+}
+
+func TestAuthValidator_OIDC(t *testing.T) {
+	v := NewAuthValidator()
+	valid := func() *neo4jv1alpha1.OIDCAuthSpec {
+		return &neo4jv1alpha1.OIDCAuthSpec{
+			Issuer:             "https://login.example.com/realms/corp",
+			ClientID:           "neo4j",
+			GroupToRoleMapping: map[string][]string{"neo4j-admins": {"admin"}},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		edit     func(*neo4jv1alpha1.OIDCAuthSpec)
+		errField string
+	}{
+		{name: "valid", edit: func(*neo4jv1alpha1.OIDCAuthSpec) {}},
+		{
+			name:     "invalid name",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.Name = "corp.sso" },
+			errField: "spec.auth.oidc.name",
+		},
+		{
+			name:     "plain http issuer",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.Issuer = "http://login.example.com" },
+			errField: "spec.auth.oidc.issuer",
+		},
+		{
+			name:     "missing client ID",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.ClientID = "" },
+			errField: "spec.auth.oidc.clientID",
+		},
+		{
+			name:     "unknown flow",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.AuthFlow = "device" },
+			errField: "spec.auth.oidc.authFlow",
+		},
+		{
+			name:     "scopes without openid",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.Scopes = []string{"profile"} },
+			errField: "spec.auth.oidc.scopes",
+		},
+		{
+			name:     "invalid role",
+			edit:     func(o *neo4jv1alpha1.OIDCAuthSpec) { o.GroupToRoleMapping["neo4j-admins"] = []string{"admin,reader"} },
+			errField: "spec.auth.oidc.groupToRoleMapping[neo4j-admins]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := clusterWithAuth("native", "")
+			cluster.Spec.Auth.OIDC = valid()
+			tc.edit(cluster.Spec.Auth.OIDC)
+			errs := v.Validate(cluster)
+
+			if tc.errField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tc.errField {
+				t.Errorf("expected one error on field %q, got: %v", tc.errField, errs)
+			}
+		})
+	}
+}
+
+func TestAuthValidator_OIDCDiscovery(t *testing.T) {
+	v := NewAuthValidator()
+	var document map[string]interface{}
+	fetches := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		if r.URL.Path != "/realms/corp/.well-known/openid-configuration" || document == nil {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(document)
+	}))
+	defer server.Close()
+	setOIDCDiscoveryClient(server.Client())
+	defer setOIDCDiscoveryClient(nil)
+
+	issuer := server.URL + "/realms/corp"
+	validate := func(edit func(*neo4jv1alpha1.OIDCAuthSpec)) field.ErrorList {
+		setOIDCDiscoveryClient(server.Client())
+		cluster := clusterWithAuth("native", "")
+		cluster.Spec.Auth.OIDC = &neo4jv1alpha1.OIDCAuthSpec{Issuer: issuer, ClientID: "neo4j"}
+		if edit != nil {
+			edit(cluster.Spec.Auth.OIDC)
+		}
+		return v.Validate(cluster)
+	}
+
+	// A missing document fails validation
+	errs := validate(nil)
+	if len(errs) != 1 || errs[0].Field != "spec.auth.oidc.issuer" || !strings.Contains(errs[0].Detail, "404") {
+		t.Fatalf("expected the missing document to be reported, got: %v", errs)
+	}
+
+	document = map[string]interface{}{
+		"issuer":                           issuer,
+		"authorization_endpoint":           issuer + "/auth",
+		"token_endpoint":                   issuer + "/token",
+		"jwks_uri":                         issuer + "/certs",
+		"scopes_supported":                 []string{"openid", "profile", "email"},
+		"response_types_supported":         []string{"code", "id_token"},
+		"code_challenge_methods_supported": []string{"plain", "S256"},
+	}
+	if errs := validate(nil); len(errs) != 0 {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+
+	// Documents are cached between validations
+	fetches = 0
+	_ = v.Validate(func() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+		cluster := clusterWithAuth("native", "")
+		cluster.Spec.Auth.OIDC = &neo4jv1alpha1.OIDCAuthSpec{Issuer: issuer, ClientID: "neo4j"}
+		return cluster
+	}())
+	if fetches != 0 {
+		t.Errorf("expected the cached document to be used, got %d fetches", fetches)
+	}
+
+	cases := map[string]func(*neo4jv1alpha1.OIDCAuthSpec){
+		"issuer mismatch":      func(o *neo4jv1alpha1.OIDCAuthSpec) { document["issuer"] = "https://other.example.com" },
+		"unsupported scope":    func(o *neo4jv1alpha1.OIDCAuthSpec) { o.Scopes = []string{"openid", "groups"} },
+		"implicit unsupported": func(o *neo4jv1alpha1.OIDCAuthSpec) { o.AuthFlow = "implicit" },
+	}
+	for name, edit := range cases {
+		t.Run(name, func(t *testing.T) {
+			saved := document["issuer"]
+			defer func() { document["issuer"] = saved }()
+			errs := validate(edit)
+			if len(errs) != 1 || errs[0].Field != "spec.auth.oidc.issuer" {
+				t.Errorf("expected one error on the issuer, got: %v", errs)
+			}
+		})
+	}
+
+	// Without a client the check is skipped
+	setOIDCDiscoveryClient(nil)
+	cluster := clusterWithAuth("native", "")
+	cluster.Spec.Auth.OIDC = &neo4jv1alpha1.OIDCAuthSpec{Issuer: "https://unreachable.example.com", ClientID: "neo4j"}
+	if errs := v.Validate(cluster); len(errs) != 0 {
+		t.Errorf("expected no errors with the check disabled, got: %v", errs)
+	}
+}
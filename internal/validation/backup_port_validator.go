@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// validateBackupPort validates the backupPort spec of a cluster.
+func validateBackupPort(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := cluster.Spec.BackupPort
+	if spec == nil {
+		return allErrs
+	}
+
+	if spec.Enabled != nil && !*spec.Enabled {
+		if spec.Expose {
+			allErrs = append(allErrs, field.Forbidden(path.Child("expose"),
+				"cannot expose a disabled backup port"))
+		}
+		if len(spec.AllowedClients) > 0 {
+			allErrs = append(allErrs, field.Forbidden(path.Child("allowedClients"),
+				"cannot restrict a disabled backup port"))
+		}
+		if cluster.Spec.Backups != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("enabled"),
+				"spec.backups requires the backup port"))
+		}
+	}
+
+	for i, peer := range spec.AllowedClients {
+		peerPath := path.Child("allowedClients").Index(i)
+		switch {
+		case peer.IPBlock == nil && peer.PodSelector == nil && peer.NamespaceSelector == nil:
+			allErrs = append(allErrs, field.Required(peerPath,
+				"one of ipBlock, podSelector or namespaceSelector is required"))
+		case peer.IPBlock != nil && (peer.PodSelector != nil || peer.NamespaceSelector != nil):
+			allErrs = append(allErrs, field.Forbidden(peerPath.Child("ipBlock"),
+				"ipBlock cannot be combined with podSelector or namespaceSelector"))
+		case peer.IPBlock != nil:
+			if _, _, err := net.ParseCIDR(peer.IPBlock.CIDR); err != nil {
+				allErrs = append(allErrs, field.Invalid(peerPath.Child("ipBlock", "cidr"), peer.IPBlock.CIDR,
+					"must be a CIDR such as 10.0.0.0/8 or 2001:db8::/32"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateBackupPort(t *testing.T) {
+	path := field.NewPath("spec", "backupPort")
+	disabled := false
+
+	tests := []struct {
+		name      string
+		spec      *neo4jv1alpha1.BackupPortSpec
+		backups   *neo4jv1alpha1.BackupsSpec
+		wantErrs  int
+		errDetail string
+	}{
+		{
+			name:     "nil spec — no errors",
+			wantErrs: 0,
+		},
+		{
+			name: "exposed with CIDR and pod peers — valid",
+			spec: &neo4jv1alpha1.BackupPortSpec{Expose: true, AllowedClients: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}},
+				{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "neo4j-admin"}}},
+			}},
+			wantErrs: 0,
+		},
+		{
+			name:      "exposed while disabled — invalid",
+			spec:      &neo4jv1alpha1.BackupPortSpec{Enabled: &disabled, Expose: true},
+			wantErrs:  1,
+			errDetail: "cannot expose",
+		},
+		{
+			name:      "disabled with spec.backups — invalid",
+			spec:      &neo4jv1alpha1.BackupPortSpec{Enabled: &disabled},
+			backups:   &neo4jv1alpha1.BackupsSpec{},
+			wantErrs:  1,
+			errDetail: "requires the backup port",
+		},
+		{
+			name:      "empty peer — invalid",
+			spec:      &neo4jv1alpha1.BackupPortSpec{AllowedClients: []networkingv1.NetworkPolicyPeer{{}}},
+			wantErrs:  1,
+			errDetail: "is required",
+		},
+		{
+			name: "ipBlock with podSelector — invalid",
+			spec: &neo4jv1alpha1.BackupPortSpec{AllowedClients: []networkingv1.NetworkPolicyPeer{{
+				IPBlock:     &networkingv1.IPBlock{CIDR: "10.0.0.0/8"},
+				PodSelector: &metav1.LabelSelector{},
+			}}},
+			wantErrs:  1,
+			errDetail: "cannot be combined",
+		},
+		{
+			name:      "bad CIDR — invalid",
+			spec:      &neo4jv1alpha1.BackupPortSpec{AllowedClients: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1"}}}},
+			wantErrs:  1,
+			errDetail: "must be a CIDR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{BackupPort: tt.spec, Backups: tt.backups},
+			}
+			errs := validateBackupPort(cluster, path)
+			if len(errs) != tt.wantErrs {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+			if tt.errDetail != "" && !strings.Contains(errs[0].Detail, tt.errDetail) {
+				t.Errorf("expected error detail to contain %q, got %q", tt.errDetail, errs[0].Detail)
+			}
+		})
+	}
+}
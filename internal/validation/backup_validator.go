@@ -18,11 +18,13 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
-	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
@@ -44,7 +46,7 @@ func (v *BackupValidator) Validate(backup *neo4jv1alpha1.Neo4jBackup) field.Erro
 	allErrs = append(allErrs, v.validateBackupTarget(&backup.Spec.Target)...)
 
 	// Validate storage configuration
-	allErrs = append(allErrs, v.validateStorageConfiguration(&backup.Spec.Storage)...)
+	allErrs = append(allErrs, v.validateStorageConfiguration(&backup.Spec.Storage, backup.Spec.Cloud, field.NewPath("spec", "storage"))...)
 
 	// Validate schedule if specified
 	if backup.Spec.Schedule != "" {
@@ -122,10 +124,11 @@ func (v *BackupValidator) validateBackupTarget(target *neo4jv1alpha1.BackupTarge
 	return allErrs
 }
 
-// validateStorageConfiguration validates storage configuration for Neo4j 5.26+
-func (v *BackupValidator) validateStorageConfiguration(storage *neo4jv1alpha1.StorageLocation) field.ErrorList {
+// validateStorageConfiguration validates storage configuration for Neo4j 5.26+.
+// The cloud block of the storage takes the place of defaultCloud, the
+// cloud block of the resource, when both are set.
+func (v *BackupValidator) validateStorageConfiguration(storage *neo4jv1alpha1.StorageLocation, defaultCloud *neo4jv1alpha1.CloudBlock, storagePath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
-	storagePath := field.NewPath("spec", "storage")
 
 	// Validate storage type
 	if storage.Type == "" {
@@ -144,7 +147,11 @@ func (v *BackupValidator) validateStorageConfiguration(storage *neo4jv1alpha1.St
 	}
 
 	// Validate storage provider specific configurations
-	if err := v.validateStorageProvider(storage); err != nil {
+	cloud := storage.Cloud
+	if cloud == nil {
+		cloud = defaultCloud
+	}
+	if err := v.validateStorageProvider(storage, cloud); err != nil {
 		allErrs = append(allErrs, field.Invalid(
 			storagePath,
 			storage,
@@ -152,6 +159,20 @@ func (v *BackupValidator) validateStorageConfiguration(storage *neo4jv1alpha1.St
 		))
 	}
 
+	if storage.PVC != nil && storage.PVC.Size != "" {
+		if _, err := resource.ParseQuantity(storage.PVC.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				storagePath.Child("pvc", "size"),
+				storage.PVC.Size,
+				"invalid PVC size. Use a quantity like '100Gi'",
+			))
+		}
+	}
+
+	if storage.Cloud != nil {
+		allErrs = append(allErrs, v.validateCloudEgress(storage.Cloud, storagePath.Child("cloud"))...)
+	}
+
 	return allErrs
 }
 
@@ -218,7 +239,8 @@ func (v *BackupValidator) validateSchemeSpecificURI(uri, scheme string) error {
 }
 
 // validateStorageProvider validates provider-specific storage configurations
-func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.StorageLocation) error {
+// against the cloud block that applies to the storage
+func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.StorageLocation, cloud *neo4jv1alpha1.CloudBlock) error {
 	storageType := strings.ToLower(storage.Type)
 
 	// For cloud storage providers, validate additional configuration
@@ -228,7 +250,7 @@ func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.Storage
 		if storage.Bucket == "" {
 			return fmt.Errorf("S3 storage requires bucket name for Neo4j 5.26+")
 		}
-		if storage.Cloud == nil || storage.Cloud.Provider != "aws" {
+		if cloud == nil || cloud.Provider != "aws" {
 			return fmt.Errorf("S3 storage requires cloud provider to be set to 'aws'")
 		}
 	case "gcs":
@@ -236,7 +258,7 @@ func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.Storage
 		if storage.Bucket == "" {
 			return fmt.Errorf("Google Cloud Storage requires bucket name for Neo4j 5.26+")
 		}
-		if storage.Cloud == nil || storage.Cloud.Provider != "gcp" {
+		if cloud == nil || cloud.Provider != "gcp" {
 			return fmt.Errorf("GCS storage requires cloud provider to be set to 'gcp'")
 		}
 	case "azure":
@@ -244,7 +266,7 @@ func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.Storage
 		if storage.Bucket == "" {
 			return fmt.Errorf("Azure Blob Storage requires container name for Neo4j 5.26+")
 		}
-		if storage.Cloud == nil || storage.Cloud.Provider != "azure" {
+		if cloud == nil || cloud.Provider != "azure" {
 			return fmt.Errorf("Azure storage requires cloud provider to be set to 'azure'")
 		}
 	case "pvc":
@@ -257,116 +279,84 @@ func (v *BackupValidator) validateStorageProvider(storage *neo4jv1alpha1.Storage
 	return nil
 }
 
-// validateSchedule validates cron schedule format
+// validateSchedule validates the schedule as the CronJob controller parses
+// it: five fields or one of the predefined schedules
 func (v *BackupValidator) validateSchedule(schedule string) error {
-	// Basic cron validation (5 or 6 fields)
-	fields := strings.Fields(schedule)
-	if len(fields) < 5 || len(fields) > 6 {
-		return fmt.Errorf("invalid cron schedule format. Expected 5 or 6 fields, got %d", len(fields))
+	if strings.HasPrefix(schedule, "@") {
+		if !slices.Contains(cronMacros, schedule) {
+			return fmt.Errorf("unsupported predefined schedule %s. Supported: %s", schedule, strings.Join(cronMacros, ", "))
+		}
+		return nil
 	}
 
-	// Validate each field in the cron expression
-
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("invalid cron schedule format. Expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
 	for i, field := range fields {
-		switch i {
-		case 0: // Second (if 6 fields) or Minute (if 5 fields)
-			if len(fields) == 6 {
-				// Second field (0-59)
-				if !v.validateCronField(field, 0, 59) {
-					return fmt.Errorf("invalid second field in cron schedule: %s", field)
-				}
-			} else {
-				// Minute field (0-59)
-				if !v.validateCronField(field, 0, 59) {
-					return fmt.Errorf("invalid minute field in cron schedule: %s", field)
-				}
-			}
-		case 1: // Minute (if 6 fields) or Hour (if 5 fields)
-			if len(fields) == 6 {
-				// Minute field (0-59)
-				if !v.validateCronField(field, 0, 59) {
-					return fmt.Errorf("invalid minute field in cron schedule: %s", field)
-				}
-			} else {
-				// Hour field (0-23)
-				if !v.validateCronField(field, 0, 23) {
-					return fmt.Errorf("invalid hour field in cron schedule: %s", field)
-				}
-			}
-		case 2: // Hour (if 6 fields) or Day (if 5 fields)
-			if len(fields) == 6 {
-				// Hour field (0-23)
-				if !v.validateCronField(field, 0, 23) {
-					return fmt.Errorf("invalid hour field in cron schedule: %s", field)
-				}
-			} else {
-				// Day field (1-31)
-				if !v.validateCronField(field, 1, 31) {
-					return fmt.Errorf("invalid day field in cron schedule: %s", field)
-				}
-			}
-		case 3: // Day (if 6 fields) or Month (if 5 fields)
-			if len(fields) == 6 {
-				// Day field (1-31)
-				if !v.validateCronField(field, 1, 31) {
-					return fmt.Errorf("invalid day field in cron schedule: %s", field)
-				}
-			} else {
-				// Month field (1-12)
-				if !v.validateCronField(field, 1, 12) {
-					return fmt.Errorf("invalid month field in cron schedule: %s", field)
-				}
-			}
-		case 4: // Month (if 6 fields) or Day of week (if 5 fields)
-			if len(fields) == 6 {
-				// Month field (1-12)
-				if !v.validateCronField(field, 1, 12) {
-					return fmt.Errorf("invalid month field in cron schedule: %s", field)
-				}
-			} else {
-				// Day of week field (0-7, where 0 and 7 are Sunday)
-				if !v.validateCronField(field, 0, 7) {
-					return fmt.Errorf("invalid day of week field in cron schedule: %s", field)
-				}
-			}
-		case 5: // Day of week (only if 6 fields)
-			// Day of week field (0-7, where 0 and 7 are Sunday)
-			if !v.validateCronField(field, 0, 7) {
-				return fmt.Errorf("invalid day of week field in cron schedule: %s", field)
-			}
+		if !v.validateCronField(field, cronFields[i].min, cronFields[i].max, cronFields[i].names) {
+			return fmt.Errorf("invalid %s field in cron schedule: %s", cronFields[i].name, field)
 		}
 	}
 
 	return nil
 }
 
-// validateCronField validates individual cron field
-func (v *BackupValidator) validateCronField(field string, min, max int) bool {
-	if field == "*" {
-		return true
-	}
+// cronMacros are the predefined schedules of a CronJob
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// cronFields are the fields of a CronJob schedule with their ranges. Months
+// and days of the week may also be given by name, names[i] standing for
+// min+i.
+var cronFields = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
 
-	// Handle step values (*/n)
-	if strings.HasPrefix(field, "*/") {
-		stepStr := field[2:]
-		step, err := strconv.Atoi(stepStr)
-		return err == nil && step > 0 && step <= max
-	}
+// validateCronField validates individual cron field: a list of values,
+// ranges or *, each optionally with a step
+func (v *BackupValidator) validateCronField(field string, min, max int, names []string) bool {
+	for _, item := range strings.Split(field, ",") {
+		values, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n <= 0 || n > max {
+				return false
+			}
+		}
+		if values == "*" {
+			continue
+		}
 
-	// Handle ranges (n-m)
-	if strings.Contains(field, "-") {
-		parts := strings.Split(field, "-")
-		if len(parts) != 2 {
+		first, last, isRange := strings.Cut(values, "-")
+		start, ok := cronValue(first, min, max, names)
+		if !ok {
 			return false
 		}
-		start, err1 := strconv.Atoi(parts[0])
-		end, err2 := strconv.Atoi(parts[1])
-		return err1 == nil && err2 == nil && start >= min && start <= max && end >= min && end <= max && start <= end
+		if isRange {
+			end, ok := cronValue(last, min, max, names)
+			if !ok || end < start {
+				return false
+			}
+		}
 	}
+	return true
+}
 
-	// Handle single number
-	num, err := strconv.Atoi(field)
-	return err == nil && num >= min && num <= max
+// cronValue parses a number or name of a cron field
+func cronValue(value string, min, max int, names []string) (int, bool) {
+	if i := slices.Index(names, strings.ToLower(value)); i >= 0 {
+		return min + i, true
+	}
+	n, err := strconv.Atoi(value)
+	return n, err == nil && n >= min && n <= max
 }
 
 // validateCloudConfiguration validates cloud-specific backup configuration
@@ -403,6 +393,51 @@ func (v *BackupValidator) validateCloudConfiguration(cloud *neo4jv1alpha1.CloudB
 		}
 	}
 
+	allErrs = append(allErrs, v.validateCloudEgress(cloud, cloudPath)...)
+
+	return allErrs
+}
+
+// validateCloudEgress validates proxy and custom CA settings used by backup jobs
+func (v *BackupValidator) validateCloudEgress(cloud *neo4jv1alpha1.CloudBlock, cloudPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if cloud.Proxy != nil {
+		proxyPath := cloudPath.Child("proxy")
+		for _, p := range []struct{ name, value string }{
+			{"httpProxy", cloud.Proxy.HTTPProxy},
+			{"httpsProxy", cloud.Proxy.HTTPSProxy},
+		} {
+			if p.value == "" {
+				continue
+			}
+			u, err := url.Parse(p.value)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+				allErrs = append(allErrs, field.Invalid(
+					proxyPath.Child(p.name),
+					p.value,
+					"proxy must be an absolute http:// or https:// URL",
+				))
+			}
+		}
+	}
+
+	if cloud.CABundle != nil {
+		caPath := cloudPath.Child("caBundle")
+		if cloud.CABundle.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				caPath.Child("name"),
+				"CA bundle secret name must be specified",
+			))
+		}
+		if cloud.CABundle.Key == "" {
+			allErrs = append(allErrs, field.Required(
+				caPath.Child("key"),
+				"CA bundle secret key must be specified",
+			))
+		}
+	}
+
 	return allErrs
 }
 
@@ -413,11 +448,11 @@ func (v *BackupValidator) validateRetentionPolicy(retention *neo4jv1alpha1.Reten
 
 	// Validate max age format if specified
 	if retention.MaxAge != "" {
-		if _, err := time.ParseDuration(retention.MaxAge); err != nil {
+		if err := validateRetentionAge(retention.MaxAge); err != nil {
 			allErrs = append(allErrs, field.Invalid(
 				retentionPath.Child("maxAge"),
 				retention.MaxAge,
-				"invalid duration format. Use format like '24h', '7d', '30d'",
+				err.Error(),
 			))
 		}
 	}
@@ -462,6 +497,19 @@ func (v *BackupValidator) validateRetentionPolicy(retention *neo4jv1alpha1.Reten
 	return allErrs
 }
 
+// retentionAgePattern matches the ages the retention job can enforce: a
+// whole number of days or hours
+var retentionAgePattern = regexp.MustCompile(`^[1-9][0-9]*[dh]$`)
+
+// validateRetentionAge validates an age backups or transaction logs are
+// kept for
+func validateRetentionAge(age string) error {
+	if !retentionAgePattern.MatchString(age) {
+		return fmt.Errorf("invalid duration format. Use a whole number of days or hours, like '24h', '7d', '30d'")
+	}
+	return nil
+}
+
 // validateBackupOptions validates backup options for Neo4j 5.26+
 func (v *BackupValidator) validateBackupOptions(options *neo4jv1alpha1.BackupOptions) field.ErrorList {
 	var allErrs field.ErrorList
@@ -242,6 +242,66 @@ func TestBackupValidator_ValidateFixed(t *testing.T) {
 			expectError: false,
 			errorCount:  0,
 		},
+		{
+			name: "valid S3 backup through proxy with custom CA",
+			backup: &neo4jv1alpha1.Neo4jBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-backup",
+				},
+				Spec: neo4jv1alpha1.Neo4jBackupSpec{
+					Target: neo4jv1alpha1.BackupTarget{
+						Kind: "Cluster",
+						Name: "test-cluster",
+					},
+					Storage: neo4jv1alpha1.StorageLocation{
+						Type:   "s3",
+						Bucket: "backup-bucket",
+						Cloud: &neo4jv1alpha1.CloudBlock{
+							Provider: "aws",
+							Proxy: &neo4jv1alpha1.ProxySpec{
+								HTTPSProxy: "http://proxy.corp.example:3128",
+								NoProxy:    ".svc,.cluster.local",
+							},
+							CABundle: &neo4jv1alpha1.SecretKeySelector{
+								Name: "corp-ca",
+								Key:  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			expectError: false,
+			errorCount:  0,
+		},
+		{
+			name: "invalid proxy URL and incomplete CA bundle",
+			backup: &neo4jv1alpha1.Neo4jBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-backup",
+				},
+				Spec: neo4jv1alpha1.Neo4jBackupSpec{
+					Target: neo4jv1alpha1.BackupTarget{
+						Kind: "Cluster",
+						Name: "test-cluster",
+					},
+					Storage: neo4jv1alpha1.StorageLocation{
+						Type:   "s3",
+						Bucket: "backup-bucket",
+						Cloud: &neo4jv1alpha1.CloudBlock{
+							Provider: "aws",
+							Proxy: &neo4jv1alpha1.ProxySpec{
+								HTTPSProxy: "proxy.corp.example:3128",
+							},
+							CABundle: &neo4jv1alpha1.SecretKeySelector{
+								Name: "corp-ca",
+							},
+						},
+					},
+				},
+			},
+			expectError: true,
+			errorCount:  2,
+		},
 	}
 
 	for _, tt := range tests {
@@ -318,3 +378,86 @@ func TestBackupValidator_validateStorageType(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupValidator_validateSchedule(t *testing.T) {
+	validator := NewBackupValidator()
+
+	tests := []struct {
+		schedule    string
+		expectError bool
+	}{
+		{schedule: "0 2 * * *"},
+		{schedule: "*/15 0-6,22-23 * * mon-fri"},
+		{schedule: "30 3 1,15 JAN-JUN/2 *"},
+		{schedule: "@daily"},
+		{schedule: "@fortnightly", expectError: true},
+		{schedule: "0 0 2 * * *", expectError: true}, // CronJobs have no seconds field
+		{schedule: "0 25 * * *", expectError: true},
+		{schedule: "0 2 * * 7", expectError: true},
+		{schedule: "0 2 * * fri-mon", expectError: true},
+		{schedule: "0 2 1,,15 * *", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			err := validator.validateSchedule(tt.schedule)
+			if tt.expectError && err == nil {
+				t.Errorf("expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestBackupValidator_ValidateRetentionAndPVC(t *testing.T) {
+	validator := NewBackupValidator()
+	backup := func(maxAge, size string) *neo4jv1alpha1.Neo4jBackup {
+		return &neo4jv1alpha1.Neo4jBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-backup"},
+			Spec: neo4jv1alpha1.Neo4jBackupSpec{
+				Target:    neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "test-cluster"},
+				Storage:   neo4jv1alpha1.StorageLocation{Type: "pvc", PVC: &neo4jv1alpha1.PVCSpec{Size: size}},
+				Retention: &neo4jv1alpha1.RetentionPolicy{MaxAge: maxAge},
+			},
+		}
+	}
+
+	if errs := validator.Validate(backup("30d", "100Gi")); len(errs) != 0 {
+		t.Errorf("expected no errors but got: %v", errs)
+	}
+	if errs := validator.Validate(backup("36h", "1Ti")); len(errs) != 0 {
+		t.Errorf("expected no errors but got: %v", errs)
+	}
+	errs := validator.Validate(backup("30dd", "100GB!"))
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	if len(errs) != 2 || fields[0] != "spec.storage.pvc.size" || fields[1] != "spec.retention.maxAge" {
+		t.Errorf("expected errors on spec.storage.pvc.size and spec.retention.maxAge but got: %v", errs)
+	}
+}
+
+func TestBackupValidator_ValidateBackupCloud(t *testing.T) {
+	validator := NewBackupValidator()
+	backup := &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-backup"},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target:  neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "test-cluster"},
+			Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "backup-bucket"},
+			Cloud:   &neo4jv1alpha1.CloudBlock{Provider: "aws"},
+		},
+	}
+
+	// The cloud block of the backup applies to storage without its own
+	if errs := validator.Validate(backup); len(errs) != 0 {
+		t.Errorf("expected no errors but got: %v", errs)
+	}
+
+	backup.Spec.Storage.Cloud = &neo4jv1alpha1.CloudBlock{Provider: "gcp"}
+	if errs := validator.Validate(backup); len(errs) != 1 || errs[0].Field != "spec.storage" {
+		t.Errorf("expected an error on spec.storage for S3 storage in GCP but got: %v", errs)
+	}
+}
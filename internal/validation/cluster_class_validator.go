@@ -0,0 +1,269 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
+)
+
+// ClusterClassValidator applies Neo4jClusterClass templates to clusters and
+// enforces the policies of the class
+type ClusterClassValidator struct{}
+
+// NewClusterClassValidator creates a new cluster class validator
+func NewClusterClassValidator() *ClusterClassValidator {
+	return &ClusterClassValidator{}
+}
+
+// ApplyClass fills the fields of the cluster spec that are left empty from
+// the class template, and rejects fields set to a different value unless the
+// class allows overriding them. applied is the template returned by the
+// previous call for this cluster: values that still match it were inherited
+// rather than chosen, so they follow the class when its template changes.
+// It returns the template to pass as applied next time.
+func (v *ClusterClassValidator) ApplyClass(class *neo4jv1alpha1.Neo4jClusterClass, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, applied []byte) ([]byte, field.ErrorList) {
+	var allErrs field.ErrorList
+	templatePath := field.NewPath("spec", "template")
+
+	template, normalized, err := clusterClassTemplate(class)
+	if err != nil {
+		return nil, append(allErrs, field.Invalid(templatePath, class.Name, err.Error()))
+	}
+	if len(template) == 0 {
+		return normalized, nil
+	}
+
+	previous := map[string]interface{}{}
+	if len(applied) > 0 {
+		// A corrupt record only means that nothing counts as inherited
+		_ = json.Unmarshal(applied, &previous)
+	}
+	spec, err := toJSONMap(cluster.Spec)
+	if err != nil {
+		return nil, append(allErrs, field.InternalError(field.NewPath("spec"), err))
+	}
+
+	merger := classMerger{class: class}
+	merger.merge(field.NewPath("spec"), "", spec, template, previous)
+	if len(merger.errs) > 0 {
+		return nil, merger.errs
+	}
+
+	merged, err := json.Marshal(spec)
+	if err != nil {
+		return nil, append(allErrs, field.InternalError(field.NewPath("spec"), err))
+	}
+	mergedSpec := neo4jv1alpha1.Neo4jEnterpriseClusterSpec{}
+	if err := json.Unmarshal(merged, &mergedSpec); err != nil {
+		return nil, append(allErrs, field.InternalError(field.NewPath("spec"), err))
+	}
+	cluster.Spec = mergedSpec
+	return normalized, nil
+}
+
+// Validate checks the cluster spec, with the class template applied, against
+// the image, TLS and backup policies of the class
+func (v *ClusterClassValidator) Validate(class *neo4jv1alpha1.Neo4jClusterClass, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) field.ErrorList {
+	var allErrs field.ErrorList
+	spec := &cluster.Spec
+
+	if policy := class.Spec.ImagePolicy; policy != nil {
+		imagePath := field.NewPath("spec", "image")
+		if len(policy.AllowedRepositories) > 0 && !containsStringItem(policy.AllowedRepositories, spec.Image.Repo) {
+			allErrs = append(allErrs, field.NotSupported(imagePath.Child("repo"), spec.Image.Repo, policy.AllowedRepositories))
+		}
+		if policy.MinVersion != "" {
+			minVersion, err := version.Parse(policy.MinVersion)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(imagePath.Child("tag"), spec.Image.Tag,
+					fmt.Sprintf("cluster class %s has an invalid minVersion %q", class.Name, policy.MinVersion)))
+			} else if imageVersion, err := version.Parse(spec.Image.Tag); err == nil && imageVersion.Compare(minVersion) < 0 {
+				allErrs = append(allErrs, field.Invalid(imagePath.Child("tag"), spec.Image.Tag,
+					fmt.Sprintf("cluster class %s requires Neo4j %s or later", class.Name, policy.MinVersion)))
+			}
+		}
+	}
+
+	if class.Spec.RequireTLS && (spec.TLS == nil || spec.TLS.Mode == "disabled") {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "tls", "mode"),
+			fmt.Sprintf("cluster class %s requires TLS", class.Name)))
+	}
+
+	if class.Spec.RequireBackups && (spec.Backups == nil || spec.Backups.DefaultStorage == nil) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "backups", "defaultStorage"),
+			fmt.Sprintf("cluster class %s requires a backup storage location", class.Name)))
+	}
+
+	return allErrs
+}
+
+// clusterClassTemplate decodes the template of a class as a cluster spec,
+// rejecting unknown fields, and returns the fields it sets along with their
+// canonical JSON encoding
+func clusterClassTemplate(class *neo4jv1alpha1.Neo4jClusterClass) (map[string]interface{}, []byte, error) {
+	if len(class.Spec.Template.Raw) == 0 {
+		return nil, nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(class.Spec.Template.Raw))
+	decoder.DisallowUnknownFields()
+	templateSpec := neo4jv1alpha1.Neo4jEnterpriseClusterSpec{}
+	if err := decoder.Decode(&templateSpec); err != nil {
+		return nil, nil, fmt.Errorf("template of cluster class %s is not a valid cluster spec: %w", class.Name, err)
+	}
+	if templateSpec.ClusterClassName != "" {
+		return nil, nil, fmt.Errorf("template of cluster class %s cannot set clusterClassName", class.Name)
+	}
+
+	// Round trip through the spec type, so that quantities and other values
+	// compare equal to the ones read back from clusters
+	template, err := toJSONMap(templateSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	pruneEmpty(template)
+	normalized, err := json.Marshal(template)
+	if err != nil {
+		return nil, nil, err
+	}
+	return template, normalized, nil
+}
+
+// classMerger merges a class template into a cluster spec and collects the
+// fields a cluster overrides without the class allowing it
+type classMerger struct {
+	class *neo4jv1alpha1.Neo4jClusterClass
+	errs  field.ErrorList
+}
+
+func (m *classMerger) merge(path *field.Path, dotted string, spec, template, previous map[string]interface{}) {
+	for key, value := range template {
+		childPath := path.Child(key)
+		childDotted := key
+		if dotted != "" {
+			childDotted = dotted + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			current, ok := spec[key].(map[string]interface{})
+			if !ok {
+				if !isEmptyJSONValue(spec[key]) {
+					m.forbid(childPath, childDotted, value)
+					continue
+				}
+				current = map[string]interface{}{}
+			}
+			previousNested, _ := previous[key].(map[string]interface{})
+			m.merge(childPath, childDotted, current, nested, previousNested)
+			spec[key] = current
+			continue
+		}
+
+		current := spec[key]
+		switch {
+		case isEmptyJSONValue(current), reflect.DeepEqual(current, value):
+			spec[key] = value
+		case reflect.DeepEqual(current, previous[key]):
+			// Inherited from an earlier version of the template
+			spec[key] = value
+		default:
+			m.forbid(childPath, childDotted, value)
+		}
+	}
+}
+
+// forbid records an override of a template field unless the class allows it
+func (m *classMerger) forbid(path *field.Path, dotted string, value interface{}) {
+	if overrideAllowed(m.class.Spec.AllowedOverrides, dotted) {
+		return
+	}
+	m.errs = append(m.errs, field.Forbidden(path,
+		fmt.Sprintf("cluster class %s sets this field to %s and does not allow overriding it", m.class.Name, jsonString(value))))
+}
+
+// overrideAllowed reports whether a dotted field path is one of the allowed
+// overrides or below one of them
+func overrideAllowed(allowed []string, dotted string) bool {
+	for _, prefix := range allowed {
+		if dotted == prefix || strings.HasPrefix(dotted, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func toJSONMap(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// pruneEmpty removes the empty values the spec type serialises for fields
+// the template did not set
+func pruneEmpty(values map[string]interface{}) {
+	for key, value := range values {
+		if nested, ok := value.(map[string]interface{}); ok {
+			pruneEmpty(nested)
+		}
+		if isEmptyJSONValue(values[key]) {
+			delete(values, key)
+		}
+	}
+}
+
+// isEmptyJSONValue reports whether a decoded JSON value is absent, zero,
+// false or empty, which a cluster spec uses for fields it leaves unset
+func isEmptyJSONValue(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typed == ""
+	case float64:
+		return typed == 0
+	case bool:
+		return !typed
+	case []interface{}:
+		return len(typed) == 0
+	case map[string]interface{}:
+		return len(typed) == 0
+	}
+	return false
+}
+
+func jsonString(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func testClusterClass(template string) *neo4jv1alpha1.Neo4jClusterClass {
+	return &neo4jv1alpha1.Neo4jClusterClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: neo4jv1alpha1.Neo4jClusterClassSpec{
+			Template:         runtime.RawExtension{Raw: []byte(template)},
+			AllowedOverrides: []string{"topology.servers", "config"},
+		},
+	}
+}
+
+func TestClusterClassValidator_ApplyClass(t *testing.T) {
+	validator := NewClusterClassValidator()
+	template := `{"image":{"repo":"neo4j","tag":"5.26.0-enterprise"},"topology":{"servers":3},` +
+		`"storage":{"className":"standard","size":"10Gi"},"config":{"db.logs.query.enabled":"INFO"}}`
+
+	tests := []struct {
+		name         string
+		spec         neo4jv1alpha1.Neo4jEnterpriseClusterSpec
+		wantErrorMsg string
+		check        func(t *testing.T, spec neo4jv1alpha1.Neo4jEnterpriseClusterSpec)
+	}{
+		{
+			name: "empty fields are inherited",
+			spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{ClusterClassName: "production"},
+			check: func(t *testing.T, spec neo4jv1alpha1.Neo4jEnterpriseClusterSpec) {
+				if spec.Image.Tag != "5.26.0-enterprise" || spec.Topology.Servers != 3 || spec.Storage.Size != "10Gi" {
+					t.Errorf("Expected the template to be inherited, got %+v", spec)
+				}
+				if spec.ClusterClassName != "production" {
+					t.Errorf("Expected clusterClassName to be kept, got %q", spec.ClusterClassName)
+				}
+			},
+		},
+		{
+			name: "allowed overrides are kept",
+			spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+				Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 5},
+				Config:   map[string]string{"db.logs.query.enabled": "VERBOSE", "server.memory.heap.max_size": "4g"},
+			},
+			check: func(t *testing.T, spec neo4jv1alpha1.Neo4jEnterpriseClusterSpec) {
+				if spec.Topology.Servers != 5 || spec.Config["db.logs.query.enabled"] != "VERBOSE" {
+					t.Errorf("Expected the overrides to be kept, got %+v", spec)
+				}
+				if spec.Config["server.memory.heap.max_size"] != "4g" {
+					t.Errorf("Expected fields outside the template to be kept, got %+v", spec.Config)
+				}
+			},
+		},
+		{
+			name: "locked fields cannot be overridden",
+			spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+				Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "2025.01.0-enterprise"},
+			},
+			wantErrorMsg: "spec.image.tag: Forbidden: cluster class production sets this field to \"5.26.0-enterprise\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{Spec: tt.spec}
+			applied, errs := validator.ApplyClass(testClusterClass(template), cluster, nil)
+			if tt.wantErrorMsg != "" {
+				if len(errs) != 1 || !contains(errs[0].Error(), tt.wantErrorMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErrorMsg, errs)
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			if len(applied) == 0 {
+				t.Error("Expected the applied template to be returned")
+			}
+			tt.check(t, cluster.Spec)
+		})
+	}
+
+	// A class update moves inherited values along and still rejects overrides
+	updated := `{"image":{"repo":"neo4j","tag":"5.26.1-enterprise"}}`
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+		Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+	}}
+	if _, errs := validator.ApplyClass(testClusterClass(updated), cluster, []byte(`{"image":{"repo":"neo4j","tag":"5.26.0-enterprise"}}`)); len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+	if cluster.Spec.Image.Tag != "5.26.1-enterprise" {
+		t.Errorf("Expected the inherited tag to follow the class, got %q", cluster.Spec.Image.Tag)
+	}
+}
+
+func TestClusterClassValidator_ApplyClassInvalidTemplate(t *testing.T) {
+	validator := NewClusterClassValidator()
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+
+	if _, errs := validator.ApplyClass(testClusterClass(`{"imagee":{"repo":"neo4j"}}`), cluster, nil); len(errs) != 1 || !contains(errs[0].Error(), "unknown field") {
+		t.Errorf("Expected an unknown field error, got %v", errs)
+	}
+	if _, errs := validator.ApplyClass(testClusterClass(`{"clusterClassName":"other"}`), cluster, nil); len(errs) != 1 {
+		t.Errorf("Expected a template setting clusterClassName to be rejected, got %v", errs)
+	}
+}
+
+func TestClusterClassValidator_Validate(t *testing.T) {
+	validator := NewClusterClassValidator()
+	class := &neo4jv1alpha1.Neo4jClusterClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: neo4jv1alpha1.Neo4jClusterClassSpec{
+			ImagePolicy: &neo4jv1alpha1.ClusterClassImagePolicy{
+				AllowedRepositories: []string{"registry.example.com/neo4j"},
+				MinVersion:          "2025.01.0",
+			},
+			RequireTLS:     true,
+			RequireBackups: true,
+		},
+	}
+	compliant := neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+		Image:   neo4jv1alpha1.ImageSpec{Repo: "registry.example.com/neo4j", Tag: "2025.06.0-enterprise"},
+		TLS:     &neo4jv1alpha1.TLSSpec{Mode: "cert-manager"},
+		Backups: &neo4jv1alpha1.BackupsSpec{DefaultStorage: &neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "backups"}},
+	}
+
+	tests := []struct {
+		name          string
+		mutate        func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec)
+		wantErrorsLen int
+		wantErrorMsg  string
+	}{
+		{
+			name:   "compliant cluster",
+			mutate: func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec) {},
+		},
+		{
+			name:          "repository not allowed",
+			mutate:        func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec) { spec.Image.Repo = "neo4j" },
+			wantErrorsLen: 1,
+			wantErrorMsg:  "spec.image.repo: Unsupported value",
+		},
+		{
+			name:          "version below minimum",
+			mutate:        func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec) { spec.Image.Tag = "5.26.0-enterprise" },
+			wantErrorsLen: 1,
+			wantErrorMsg:  "requires Neo4j 2025.01.0 or later",
+		},
+		{
+			name:          "TLS disabled",
+			mutate:        func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec) { spec.TLS.Mode = "disabled" },
+			wantErrorsLen: 1,
+			wantErrorMsg:  "cluster class production requires TLS",
+		},
+		{
+			name:          "no backup storage",
+			mutate:        func(spec *neo4jv1alpha1.Neo4jEnterpriseClusterSpec) { spec.Backups = nil },
+			wantErrorsLen: 1,
+			wantErrorMsg:  "requires a backup storage location",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{Spec: *compliant.DeepCopy()}
+			tt.mutate(&cluster.Spec)
+			errs := validator.Validate(class, cluster)
+			if len(errs) != tt.wantErrorsLen {
+				t.Fatalf("Expected %d errors, got %d: %v", tt.wantErrorsLen, len(errs), errs)
+			}
+			if tt.wantErrorMsg != "" && !contains(errs[0].Error(), tt.wantErrorMsg) {
+				t.Errorf("Expected error containing %q, got %q", tt.wantErrorMsg, errs[0].Error())
+			}
+		})
+	}
+}
@@ -46,6 +46,8 @@ type ClusterValidator struct {
 	upgradeValidator  *UpgradeValidator
 	memoryValidator   *MemoryValidator
 	resourceValidator *ResourceValidator
+	unsafeValidator   *UnsafeChangeValidator
+	licenseValidator  *LicenseValidator
 }
 
 // NewClusterValidator creates a new cluster validator
@@ -62,12 +64,15 @@ func NewClusterValidator(client client.Client) *ClusterValidator {
 		upgradeValidator:  NewUpgradeValidator(),
 		memoryValidator:   NewMemoryValidator(),
 		resourceValidator: NewResourceValidator(client),
+		unsafeValidator:   NewUnsafeChangeValidator(),
+		licenseValidator:  NewLicenseValidator(client),
 	}
 }
 
 // ValidateCreate validates a Neo4jEnterpriseCluster for creation
 func (v *ClusterValidator) ValidateCreate(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) error {
 	allErrs := v.validateCluster(ctx, cluster)
+	allErrs = append(allErrs, v.licenseValidator.Validate(ctx, cluster, 0)...)
 	if len(allErrs) > 0 {
 		return fmt.Errorf("validation failed: %s", allErrs.ToAggregate().Error())
 	}
@@ -131,6 +136,10 @@ func (v *ClusterValidator) ApplyDefaults(ctx context.Context, cluster *neo4jv1al
 		cluster.Spec.Storage.RetentionPolicy = "Delete"
 	}
 
+	// Convert the deprecated primaries/secondaries model to servers. A
+	// conflicting servers value is left for validation to report.
+	_, _ = ConvertDeprecatedTopology(&cluster.Spec.Topology)
+
 	// Note: We no longer auto-adjust topology values
 	// Topology warnings will be generated during validation instead
 }
@@ -168,12 +177,30 @@ func (v *ClusterValidator) validateCluster(ctx context.Context, cluster *neo4jv1
 	// Cloud identity validation (least critical, do last)
 	allErrs = append(allErrs, v.cloudValidator.Validate(cluster)...)
 
+	// Seccomp/AppArmor profile validation
+	allErrs = append(allErrs, validateSecurityProfiles(cluster.Spec.SecurityContext, field.NewPath("spec", "securityContext"))...)
+
 	// MCP server validation
 	allErrs = append(allErrs, validateMCPConfig(cluster.Spec.MCP, field.NewPath("spec", "mcp"))...)
 
 	// Aura Fleet Management validation
 	allErrs = append(allErrs, validateAuraFleetManagement(cluster.Spec.AuraFleetManagement, field.NewPath("spec", "auraFleetManagement"))...)
 
+	// Client service allowlist validation
+	allErrs = append(allErrs, validateServiceSpec(cluster.Spec.Service, field.NewPath("spec", "service"))...)
+
+	// Backup port exposure validation
+	allErrs = append(allErrs, validateBackupPort(cluster, field.NewPath("spec", "backupPort"))...)
+
+	// Slow query polling and enforcement validation
+	allErrs = append(allErrs, validateQueryMonitoring(cluster.Spec.QueryMonitoring, field.NewPath("spec", "queryMonitoring"))...)
+
+	// Bundled alerts and dashboard validation
+	allErrs = append(allErrs, validateMonitoring(cluster.Spec.Monitoring, field.NewPath("spec", "monitoring"))...)
+
+	// Log forwarder validation
+	allErrs = append(allErrs, validateLogging(cluster.Spec.Logging, field.NewPath("spec", "logging"))...)
+
 	return allErrs
 }
 
@@ -209,6 +236,14 @@ func (v *ClusterValidator) validateClusterUpdate(ctx context.Context, oldCluster
 		allErrs = append(allErrs, v.upgradeValidator.ValidateUpgradeStrategy(newCluster)...)
 	}
 
+	// Reject changes to storage, the admin secret and the edition that
+	// cannot be applied safely
+	allErrs = append(allErrs, v.unsafeValidator.ValidateClusterUpdate(oldCluster, newCluster)...)
+
+	// Reject growing past the license entitlement
+	deployedCores, _ := RequestedCores(oldCluster)
+	allErrs = append(allErrs, v.licenseValidator.Validate(ctx, newCluster, deployedCores)...)
+
 	return allErrs
 }
 
@@ -223,6 +258,7 @@ func (v *ClusterValidator) ValidateCreateWithWarnings(ctx context.Context, clust
 		Errors:   v.validateCluster(ctx, cluster),
 		Warnings: []string{},
 	}
+	result.Errors = append(result.Errors, v.licenseValidator.Validate(ctx, cluster, 0)...)
 
 	// Get topology warnings
 	topologyResult := v.topologyValidator.ValidateWithWarnings(cluster)
@@ -245,6 +281,19 @@ func (v *ClusterValidator) ValidateUpdateWithWarnings(ctx context.Context, oldCl
 	return result
 }
 
+// DeprecationWarnings returns a warning for every deprecated field the cluster
+// sets. They are checked before defaults are applied, which convert them.
+func DeprecationWarnings(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) []string {
+	var warnings []string
+	if UsesDeprecatedTopology(&cluster.Spec.Topology) {
+		warnings = append(warnings,
+			fmt.Sprintf("spec.topology.primaries and spec.topology.secondaries are deprecated and are converted to servers: %d. "+
+				"Set servers instead, and primaries and secondaries in the topology of each Neo4jDatabase.",
+				cluster.Spec.Topology.Primaries+cluster.Spec.Topology.Secondaries))
+	}
+	return warnings
+}
+
 // validatePropertySharding validates property sharding configuration and version requirements
 func (v *ClusterValidator) validatePropertySharding(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) field.ErrorList {
 	var allErrs field.ErrorList
@@ -23,8 +23,27 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
+// unsupportedDiscoverySettings lists the discovery settings users cannot set.
+// The operator injects all discovery settings (resolver_type, endpoints, …) through
+// the startup script into /tmp/neo4j-config/neo4j.conf. User-supplied values in
+// Spec.Config would conflict with or override that managed configuration.
+//
+// Discovery mechanism used by this operator:
+//
+//	5.26.x  — LIST resolver, dbms.cluster.discovery.v2.endpoints, V2_ONLY
+//	2025.x+ — LIST resolver, dbms.cluster.endpoints (renamed), no version flag
+//	Both    — port 6000 (tcp-tx), pod FQDNs via headless service
+var unsupportedDiscoverySettings = map[string]string{
+	"dbms.cluster.discovery.resolver_type":        "discovery resolver is managed by the operator (LIST with static pod FQDNs) — do not override",
+	"dbms.cluster.discovery.v2.endpoints":         "discovery endpoints are managed by the operator — do not override (5.26.x setting)",
+	"dbms.cluster.endpoints":                      "discovery endpoints are managed by the operator — do not override (2025.x+ setting)",
+	"dbms.kubernetes.label_selector":              "Kubernetes service-list discovery is not used; operator uses LIST discovery with pod FQDNs",
+	"dbms.kubernetes.discovery.service_port_name": "Kubernetes service-list discovery is not used; operator uses LIST discovery with pod FQDNs",
+}
+
 // ConfigValidator validates Neo4j configuration settings
 type ConfigValidator struct{}
 
@@ -38,6 +57,8 @@ func (v *ConfigValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 	var allErrs field.ErrorList
 	configPath := field.NewPath("spec", "config")
 
+	allErrs = append(allErrs, v.validateConfigOverrides(cluster)...)
+
 	if cluster.Spec.Config == nil {
 		return allErrs
 	}
@@ -49,22 +70,8 @@ func (v *ConfigValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 		"dbms.integrations.cloud_storage.s3.region": "replaced by new cloud storage integration settings",
 	}
 
-	// Check for unsupported manual discovery configuration.
-	// The operator injects all discovery settings (resolver_type, endpoints, …) through
-	// the startup script into /tmp/neo4j-config/neo4j.conf. User-supplied values in
-	// Spec.Config would conflict with or override that managed configuration.
-	//
-	// Discovery mechanism used by this operator:
-	//   5.26.x  — LIST resolver, dbms.cluster.discovery.v2.endpoints, V2_ONLY
-	//   2025.x+ — LIST resolver, dbms.cluster.endpoints (renamed), no version flag
-	//   Both    — port 6000 (tcp-tx), pod FQDNs via headless service
-	unsupportedDiscoverySettings := map[string]string{
-		"dbms.cluster.discovery.resolver_type":        "discovery resolver is managed by the operator (LIST with static pod FQDNs) — do not override",
-		"dbms.cluster.discovery.v2.endpoints":         "discovery endpoints are managed by the operator — do not override (5.26.x setting)",
-		"dbms.cluster.endpoints":                      "discovery endpoints are managed by the operator — do not override (2025.x+ setting)",
-		"dbms.kubernetes.label_selector":              "Kubernetes service-list discovery is not used; operator uses LIST discovery with pod FQDNs",
-		"dbms.kubernetes.discovery.service_port_name": "Kubernetes service-list discovery is not used; operator uses LIST discovery with pod FQDNs",
-	}
+	// Tags that do not parse are checked by the image validator
+	imageVersion, _ := version.Parse(cluster.Spec.Image.Tag)
 
 	for configKey, configValue := range cluster.Spec.Config {
 		// Special handling for dbms.cluster.discovery.version.
@@ -98,6 +105,15 @@ func (v *ConfigValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 				configPath.Child(configKey),
 				"unsupported configuration: "+unsupportedMsg,
 			))
+		} else if imageVersion != nil {
+			// Check for 5.26.x settings the CalVer image no longer accepts
+			if reason, removed := imageVersion.RemovedSetting(configKey); removed {
+				allErrs = append(allErrs, field.Invalid(
+					configPath.Child(configKey),
+					configValue,
+					fmt.Sprintf("not supported by Neo4j %s: %s", cluster.Spec.Image.Tag, reason),
+				))
+			}
 		}
 
 		// Validate database format settings
@@ -126,6 +142,54 @@ func (v *ConfigValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster
 	return allErrs
 }
 
+// validateConfigOverrides validates the servers spec.configOverrides select
+// and the settings they set, which the startup script merges into neo4j.conf
+func (v *ConfigValidator) validateConfigOverrides(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	groupServers := map[string]int32{}
+	for _, group := range cluster.Spec.Topology.ServerGroups {
+		groupServers[group.Name] = group.Servers
+	}
+
+	for i, override := range cluster.Spec.ConfigOverrides {
+		overridePath := field.NewPath("spec", "configOverrides").Index(i)
+
+		servers := cluster.Spec.Topology.Servers
+		pool := "spec.topology.servers"
+		if override.ServerGroup != "" {
+			count, ok := groupServers[override.ServerGroup]
+			if !ok {
+				allErrs = append(allErrs, field.NotFound(overridePath.Child("serverGroup"), override.ServerGroup))
+				continue
+			}
+			servers = count
+			pool = "server group " + override.ServerGroup
+		} else if len(override.Servers) == 0 {
+			allErrs = append(allErrs, field.Required(overridePath.Child("servers"),
+				"select servers by ordinal, a server group, or both; use spec.config for all servers"))
+		}
+		for j, ordinal := range override.Servers {
+			if ordinal < 0 || ordinal >= servers {
+				allErrs = append(allErrs, field.Invalid(overridePath.Child("servers").Index(j), ordinal,
+					fmt.Sprintf("%s has %d servers, ordinals start at 0", pool, servers)))
+			}
+		}
+
+		for key, value := range override.Config {
+			configPath := overridePath.Child("config").Key(key)
+			if unsupportedMsg, isUnsupported := unsupportedDiscoverySettings[key]; isUnsupported {
+				allErrs = append(allErrs, field.Forbidden(configPath, "unsupported configuration: "+unsupportedMsg))
+			}
+			if strings.ContainsAny(key, "=\n\r") || strings.ContainsAny(value, "\n\r") {
+				allErrs = append(allErrs, field.Invalid(configPath, value, "settings must be a single key=value line"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // isValidDiscoveryVersion checks if the discovery version is valid.
 // Only V2_ONLY is accepted; in 2025.x+ the setting is not used at all
 // (V2 is the only supported protocol), but V2_ONLY is harmless if set.
@@ -176,3 +176,66 @@ func TestConfigValidator_ValidDiscoveryVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidator_RenamedSettings(t *testing.T) {
+	validator := NewConfigValidator()
+	config := map[string]string{"dbms.kubernetes.discovery.v2.service_port_name": "tcp-discovery"}
+
+	semver := &neo4jv1alpha1.Neo4jEnterpriseCluster{Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+		Image:  neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+		Config: config,
+	}}
+	assert.Empty(t, validator.Validate(semver))
+
+	calver := semver.DeepCopy()
+	calver.Spec.Image.Tag = "2025.01.0-enterprise"
+	errors := validator.Validate(calver)
+	if assert.Len(t, errors, 1) {
+		assert.Contains(t, errors[0].Detail, "renamed to dbms.kubernetes.discovery.service_port_name")
+	}
+}
+
+func TestConfigValidator_ConfigOverrides(t *testing.T) {
+	validator := NewConfigValidator()
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+		Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+		Topology: neo4jv1alpha1.TopologyConfiguration{
+			Servers:      3,
+			ServerGroups: []neo4jv1alpha1.ServerGroupSpec{{Name: "analytics", Servers: 2}},
+		},
+		ConfigOverrides: []neo4jv1alpha1.ConfigOverrideSpec{
+			{Name: "debug", Servers: []int32{0, 2}, Config: map[string]string{"db.logs.query.enabled": "VERBOSE"}},
+			{Name: "analytics", ServerGroup: "analytics", Config: map[string]string{"server.memory.pagecache.size": "16g"}},
+		},
+	}}
+	assert.Empty(t, validator.Validate(cluster))
+
+	tests := []struct {
+		name     string
+		override neo4jv1alpha1.ConfigOverrideSpec
+		field    string
+	}{
+		{"no servers selected", neo4jv1alpha1.ConfigOverrideSpec{Name: "all", Config: map[string]string{"db.track_query_cpu_time": "true"}},
+			"spec.configOverrides[2].servers"},
+		{"ordinal out of range", neo4jv1alpha1.ConfigOverrideSpec{Name: "missing", Servers: []int32{3}, Config: map[string]string{"db.track_query_cpu_time": "true"}},
+			"spec.configOverrides[2].servers[0]"},
+		{"group ordinal out of range", neo4jv1alpha1.ConfigOverrideSpec{Name: "missing", ServerGroup: "analytics", Servers: []int32{2}, Config: map[string]string{"db.track_query_cpu_time": "true"}},
+			"spec.configOverrides[2].servers[0]"},
+		{"unknown server group", neo4jv1alpha1.ConfigOverrideSpec{Name: "reporting", ServerGroup: "reporting", Config: map[string]string{"db.track_query_cpu_time": "true"}},
+			"spec.configOverrides[2].serverGroup"},
+		{"discovery setting", neo4jv1alpha1.ConfigOverrideSpec{Name: "discovery", Servers: []int32{0}, Config: map[string]string{"dbms.cluster.endpoints": "a:6000"}},
+			"spec.configOverrides[2].config[dbms.cluster.endpoints]"},
+		{"multi-line value", neo4jv1alpha1.ConfigOverrideSpec{Name: "inject", Servers: []int32{0}, Config: map[string]string{"db.track_query_cpu_time": "true\ndbms.security.auth_enabled=false"}},
+			"spec.configOverrides[2].config[db.track_query_cpu_time]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := cluster.DeepCopy()
+			invalid.Spec.ConfigOverrides = append(invalid.Spec.ConfigOverrides, tt.override)
+			errors := validator.Validate(invalid)
+			if assert.Len(t, errors, 1) {
+				assert.Equal(t, tt.field, errors[0].Field)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -103,7 +104,8 @@ func (v *DatabaseValidator) Validate(ctx context.Context, database *neo4jv1alpha
 	// Validate Cypher language version
 	v.validateCypherLanguage(database, result)
 
-	// Validate seed URI configuration
+	// Validate seed configuration
+	v.validateSeed(ctx, database, result)
 	v.validateSeedURI(ctx, database, result)
 
 	// Validate database options syntax
@@ -112,6 +114,13 @@ func (v *DatabaseValidator) Validate(ctx context.Context, database *neo4jv1alpha
 	// Validate conflicting configurations
 	v.validateConfigurationConflicts(database, result)
 
+	// Validate composite database constituents and aliases
+	v.validateAliases(database, result)
+
+	// Validate desired state and drop policy
+	v.validateLifecycle(database, result)
+	v.validateSchema(database, result)
+
 	return result
 }
 
@@ -278,16 +287,78 @@ func (v *DatabaseValidator) validateCypherLanguage(database *neo4jv1alpha1.Neo4j
 	}
 }
 
+// databaseSeedURI returns the seed URI given in the spec and its path: the
+// URI of spec.seed or the legacy spec.seedURI. Backups referenced by
+// spec.seed are resolved by the controller.
+func databaseSeedURI(database *neo4jv1alpha1.Neo4jDatabase) (string, *field.Path) {
+	if database.Spec.Seed != nil {
+		return database.Spec.Seed.URI, field.NewPath("spec", "seed", "uri")
+	}
+	return database.Spec.SeedURI, field.NewPath("spec", "seedURI")
+}
+
+func (v *DatabaseValidator) validateSeed(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, result *DatabaseValidationResult) {
+	seed := database.Spec.Seed
+	if seed == nil {
+		return
+	}
+	seedPath := field.NewPath("spec", "seed")
+
+	if (seed.URI == "") == (seed.BackupRef == "") {
+		result.Errors = append(result.Errors, field.Invalid(
+			seedPath, fmt.Sprintf("uri=%q backupRef=%q", seed.URI, seed.BackupRef),
+			"exactly one of uri and backupRef must be specified"))
+	}
+	if database.Spec.SeedURI != "" {
+		result.Errors = append(result.Errors, field.Forbidden(
+			field.NewPath("spec", "seedURI"), "seedURI cannot be specified together with seed, which replaces it"))
+	}
+	if database.Spec.InitialData != nil {
+		result.Errors = append(result.Errors, field.Forbidden(
+			field.NewPath("spec", "initialData"), "initialData cannot be specified together with seed - the seed provides the initial data"))
+	}
+	if database.Spec.Type == "composite" {
+		result.Errors = append(result.Errors, field.Forbidden(
+			seedPath, "seed cannot be specified for a composite database"))
+	}
+
+	if seed.BackupRef == "" {
+		return
+	}
+	backupRefPath := seedPath.Child("backupRef")
+	backup := &neo4jv1alpha1.Neo4jBackup{}
+	backupKey := types.NamespacedName{Name: seed.BackupRef, Namespace: database.Namespace}
+	if err := v.client.Get(ctx, backupKey, backup); err != nil {
+		if errors.IsNotFound(err) {
+			result.Errors = append(result.Errors, field.NotFound(backupRefPath, seed.BackupRef))
+		} else {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("Cannot validate seed backup %s: %v", seed.BackupRef, err))
+		}
+		return
+	}
+	if backup.Spec.Storage.Type == "pvc" {
+		result.Errors = append(result.Errors, field.Invalid(
+			backupRefPath, seed.BackupRef,
+			"backups stored on a PVC cannot seed a database; use a backup in s3, gcs or azure storage"))
+	}
+	if backup.Spec.Target.Kind == "Database" && backup.Spec.Target.Name != database.Spec.Name {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("Backup %s is of database %s; seeding from a backup location picks the backup of a database named %s. "+
+				"Use seed.uri with the backup artifact to seed from a database with another name.",
+				seed.BackupRef, backup.Spec.Target.Name, database.Spec.Name))
+	}
+}
+
 func (v *DatabaseValidator) validateSeedURI(ctx context.Context, database *neo4jv1alpha1.Neo4jDatabase, result *DatabaseValidationResult) {
-	seedURIPath := field.NewPath("spec", "seedURI")
+	seedURI, seedURIPath := databaseSeedURI(database)
 
 	// If no seed URI is specified, skip validation
-	if database.Spec.SeedURI == "" {
+	if seedURI == "" {
 		return
 	}
 
 	// Validate seed URI format
-	seedURI := database.Spec.SeedURI
 	parsedURI, err := url.Parse(seedURI)
 	if err != nil {
 		result.Errors = append(result.Errors, field.Invalid(
@@ -437,8 +508,8 @@ func (v *DatabaseValidator) validateSeedCredentials(ctx context.Context, databas
 	}
 
 	// Validate secret contains expected keys based on seed URI scheme
-	if database.Spec.SeedURI != "" {
-		parsedURI, err := url.Parse(database.Spec.SeedURI)
+	if seedURI, _ := databaseSeedURI(database); seedURI != "" {
+		parsedURI, err := url.Parse(seedURI)
 		if err == nil {
 			v.validateSecretKeysForScheme(parsedURI.Scheme, secret, credentials, result)
 		}
@@ -525,7 +596,7 @@ func (v *DatabaseValidator) addSeedURIWarnings(database *neo4jv1alpha1.Neo4jData
 	}
 
 	// Warn about backup file format recommendations
-	seedURI := database.Spec.SeedURI
+	seedURI, _ := databaseSeedURI(database)
 	if strings.HasSuffix(seedURI, ".dump") {
 		result.Warnings = append(result.Warnings,
 			"Using dump file format. For better performance with large databases, "+
@@ -668,6 +739,203 @@ func (v *DatabaseValidator) validateConfigurationConflicts(database *neo4jv1alph
 	}
 }
 
+func (v *DatabaseValidator) validateAliases(database *neo4jv1alpha1.Neo4jDatabase, result *DatabaseValidationResult) {
+	specPath := field.NewPath("spec")
+
+	if database.Spec.Type == "composite" {
+		// Composite databases hold no data of their own
+		if database.Spec.Topology != nil {
+			result.Errors = append(result.Errors, field.Forbidden(
+				specPath.Child("topology"), "topology cannot be specified for a composite database"))
+		}
+		if database.Spec.SeedURI != "" {
+			result.Errors = append(result.Errors, field.Forbidden(
+				specPath.Child("seedURI"), "seedURI cannot be specified for a composite database"))
+		}
+		if database.Spec.InitialData != nil {
+			result.Errors = append(result.Errors, field.Forbidden(
+				specPath.Child("initialData"), "initialData cannot be specified for a composite database"))
+		}
+	} else if len(database.Spec.Constituents) > 0 {
+		result.Errors = append(result.Errors, field.Forbidden(
+			specPath.Child("constituents"), "constituents can only be specified when type is composite"))
+	}
+
+	names := map[string]bool{}
+	for i, constituent := range database.Spec.Constituents {
+		path := specPath.Child("constituents").Index(i)
+		if constituent.TargetDatabase == "" {
+			result.Errors = append(result.Errors, field.Required(
+				path.Child("targetDatabase"), "constituents must name the database they point to"))
+		}
+		v.validateAlias(constituent, path, names, result)
+	}
+
+	names = map[string]bool{}
+	for i, alias := range database.Spec.Aliases {
+		path := specPath.Child("aliases").Index(i)
+		if alias.Remote != nil && alias.TargetDatabase == "" {
+			result.Errors = append(result.Errors, field.Required(
+				path.Child("targetDatabase"), "remote aliases must name the database they point to"))
+		}
+		if alias.Name == database.Spec.Name {
+			result.Errors = append(result.Errors, field.Invalid(
+				path.Child("name"), alias.Name, "alias cannot have the name of the database"))
+		}
+		v.validateAlias(alias, path, names, result)
+	}
+}
+
+func (v *DatabaseValidator) validateLifecycle(database *neo4jv1alpha1.Neo4jDatabase, result *DatabaseValidationResult) {
+	specPath := field.NewPath("spec")
+	composite := database.Spec.Type == "composite"
+
+	if composite && database.Spec.DesiredState == "offline" {
+		result.Errors = append(result.Errors, field.Forbidden(
+			specPath.Child("desiredState"), "composite databases cannot be taken offline"))
+	}
+
+	policy := database.Spec.DropPolicy
+	if policy == nil || policy.FinalDump == nil {
+		return
+	}
+	dumpPath := specPath.Child("dropPolicy", "finalDump")
+	if composite {
+		result.Errors = append(result.Errors, field.Forbidden(
+			dumpPath, "composite databases hold no data to dump"))
+		return
+	}
+	if policy.Action == "Retain" {
+		result.Warnings = append(result.Warnings,
+			"dropPolicy.finalDump is ignored because the database is retained on deletion")
+	}
+	storage := policy.FinalDump.Storage
+	switch storage.Type {
+	case "s3", "gcs", "azure":
+		if storage.Bucket == "" {
+			result.Errors = append(result.Errors, field.Required(
+				dumpPath.Child("storage", "bucket"), "bucket is required for the final dump"))
+		}
+	default:
+		result.Errors = append(result.Errors, field.NotSupported(
+			dumpPath.Child("storage", "type"), storage.Type, []string{"s3", "gcs", "azure"}))
+	}
+}
+
+// validateSchema checks the shape of the declared indexes and constraints
+// that the CRD schema cannot express
+func (v *DatabaseValidator) validateSchema(database *neo4jv1alpha1.Neo4jDatabase, result *DatabaseValidationResult) {
+	schema := database.Spec.Schema
+	if schema == nil {
+		return
+	}
+	schemaPath := field.NewPath("spec", "schema")
+	if database.Spec.Type == "composite" {
+		result.Errors = append(result.Errors, field.Forbidden(
+			schemaPath, "composite databases have no indexes or constraints"))
+		return
+	}
+
+	// Indexes and constraints share one namespace in Neo4j
+	names := map[string]bool{}
+	for i, index := range schema.Indexes {
+		path := schemaPath.Child("indexes").Index(i)
+		if names[index.Name] {
+			result.Errors = append(result.Errors, field.Duplicate(path.Child("name"), index.Name))
+		}
+		names[index.Name] = true
+
+		tokens := index.Labels
+		if len(index.Labels) > 0 == (len(index.RelationshipTypes) > 0) {
+			result.Errors = append(result.Errors, field.Invalid(path, index.Name,
+				"exactly one of labels or relationshipTypes must be set"))
+		} else if len(index.RelationshipTypes) > 0 {
+			tokens = index.RelationshipTypes
+		}
+		indexType := index.Type
+		if indexType == "" {
+			indexType = "RANGE"
+		}
+		if indexType != "FULLTEXT" && len(tokens) > 1 {
+			result.Errors = append(result.Errors, field.Invalid(path, index.Name,
+				fmt.Sprintf("%s indexes apply to a single label or relationship type", indexType)))
+		}
+		switch indexType {
+		case "TEXT", "POINT", "VECTOR":
+			if len(index.Properties) > 1 {
+				result.Errors = append(result.Errors, field.TooMany(path.Child("properties"), len(index.Properties), 1))
+			}
+		}
+	}
+
+	for i, constraint := range schema.Constraints {
+		path := schemaPath.Child("constraints").Index(i)
+		if names[constraint.Name] {
+			result.Errors = append(result.Errors, field.Duplicate(path.Child("name"), constraint.Name))
+		}
+		names[constraint.Name] = true
+
+		if (constraint.Label != "") == (constraint.RelationshipType != "") {
+			result.Errors = append(result.Errors, field.Invalid(path, constraint.Name,
+				"exactly one of label or relationshipType must be set"))
+		}
+		switch constraint.Type {
+		case "EXISTS", "PROPERTY_TYPE":
+			if len(constraint.Properties) > 1 {
+				result.Errors = append(result.Errors, field.TooMany(path.Child("properties"), len(constraint.Properties), 1))
+			}
+		}
+		if constraint.Type == "PROPERTY_TYPE" && constraint.PropertyType == "" {
+			result.Errors = append(result.Errors, field.Required(path.Child("propertyType"),
+				"propertyType is required for PROPERTY_TYPE constraints"))
+		} else if constraint.Type != "PROPERTY_TYPE" && constraint.PropertyType != "" {
+			result.Errors = append(result.Errors, field.Forbidden(path.Child("propertyType"),
+				"propertyType is only used by PROPERTY_TYPE constraints"))
+		}
+	}
+}
+
+func (v *DatabaseValidator) validateAlias(alias neo4jv1alpha1.DatabaseAlias, path *field.Path, names map[string]bool, result *DatabaseValidationResult) {
+	if names[alias.Name] {
+		result.Errors = append(result.Errors, field.Duplicate(path.Child("name"), alias.Name))
+	}
+	names[alias.Name] = true
+
+	if alias.Remote == nil {
+		return
+	}
+	remotePath := path.Child("remote")
+	if parsedURL, err := url.Parse(alias.Remote.URL); err != nil || parsedURL.Host == "" {
+		result.Errors = append(result.Errors, field.Invalid(
+			remotePath.Child("url"), alias.Remote.URL, "url must be a Bolt URL such as neo4j+s://host:7687"))
+	}
+	if alias.Remote.CredentialsSecret == "" {
+		result.Errors = append(result.Errors, field.Required(
+			remotePath.Child("credentialsSecret"), "remote aliases need a Secret with username and password"))
+	}
+
+	driver := alias.Remote.Driver
+	if driver == nil {
+		return
+	}
+	driverPath := remotePath.Child("driver")
+	durations := []struct{ name, value string }{
+		{"connectionTimeout", driver.ConnectionTimeout},
+		{"connectionMaxLifetime", driver.ConnectionMaxLifetime},
+		{"connectionPoolAcquisitionTimeout", driver.ConnectionPoolAcquisitionTimeout},
+		{"connectionPoolIdleTest", driver.ConnectionPoolIdleTest},
+	}
+	for _, duration := range durations {
+		if duration.value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(duration.value); err != nil {
+			result.Errors = append(result.Errors, field.Invalid(
+				driverPath.Child(duration.name), duration.value, "must be a duration such as 5s or 1h"))
+		}
+	}
+}
+
 // Helper functions
 func containsSlice(slice []string, item string) bool {
 	for _, s := range slice {
@@ -943,3 +943,529 @@ func TestDatabaseValidator_ValidateClusterAndStandaloneFallback(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseValidator_ValidateAliases(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	validator := NewDatabaseValidator(client)
+	ctx := context.Background()
+
+	remote := &neo4jv1alpha1.RemoteAliasTarget{
+		URL:               "neo4j+s://remote.example.com:7687",
+		CredentialsSecret: "remote-creds",
+	}
+
+	tests := []struct {
+		name               string
+		spec               neo4jv1alpha1.Neo4jDatabaseSpec
+		expectedErrors     int
+		shouldContainError string
+	}{
+		{
+			name: "valid composite database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type: "composite",
+				Constituents: []neo4jv1alpha1.DatabaseAlias{
+					{Name: "local", TargetDatabase: "localdb"},
+					{Name: "remote", TargetDatabase: "remotedb", Remote: remote},
+				},
+				Aliases: []neo4jv1alpha1.DatabaseAlias{{Name: "everything"}},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "composite database with topology",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type:     "composite",
+				Topology: &neo4jv1alpha1.DatabaseTopology{Primaries: 1},
+			},
+			expectedErrors:     1,
+			shouldContainError: "topology cannot be specified for a composite database",
+		},
+		{
+			name: "constituents on a standard database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Constituents: []neo4jv1alpha1.DatabaseAlias{{Name: "local", TargetDatabase: "localdb"}},
+			},
+			expectedErrors:     1,
+			shouldContainError: "constituents can only be specified when type is composite",
+		},
+		{
+			name: "constituent without target",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type:         "composite",
+				Constituents: []neo4jv1alpha1.DatabaseAlias{{Name: "local"}},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.constituents[0].targetDatabase",
+		},
+		{
+			name: "duplicate alias names",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Aliases: []neo4jv1alpha1.DatabaseAlias{{Name: "a"}, {Name: "a"}},
+			},
+			expectedErrors:     1,
+			shouldContainError: "Duplicate value",
+		},
+		{
+			name: "remote alias with invalid driver duration",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Aliases: []neo4jv1alpha1.DatabaseAlias{{
+					Name:           "remote",
+					TargetDatabase: "remotedb",
+					Remote: &neo4jv1alpha1.RemoteAliasTarget{
+						URL:               remote.URL,
+						CredentialsSecret: remote.CredentialsSecret,
+						Driver:            &neo4jv1alpha1.AliasDriverSettings{ConnectionTimeout: "five seconds"},
+					},
+				}},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.aliases[0].remote.driver.connectionTimeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.ClusterRef = "test-cluster"
+			tt.spec.Name = "testdb"
+			database := &neo4jv1alpha1.Neo4jDatabase{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-db", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+
+			result := validator.Validate(ctx, database)
+
+			assert.Equal(t, tt.expectedErrors, len(result.Errors),
+				"Expected %d errors, got %d: %v", tt.expectedErrors, len(result.Errors), result.Errors)
+
+			if tt.shouldContainError != "" {
+				found := false
+				for _, err := range result.Errors {
+					if containsString(err.Error(), tt.shouldContainError) {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "Expected error containing '%s' but got: %v", tt.shouldContainError, result.Errors)
+			}
+		})
+	}
+}
+
+func TestDatabaseValidator_ValidateLifecycle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	validator := NewDatabaseValidator(client)
+	ctx := context.Background()
+
+	s3Dump := &neo4jv1alpha1.DatabaseFinalDump{
+		Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "dumps"},
+	}
+
+	tests := []struct {
+		name               string
+		spec               neo4jv1alpha1.Neo4jDatabaseSpec
+		expectedErrors     int
+		expectedWarnings   int
+		shouldContainError string
+	}{
+		{
+			name: "offline database with final dump",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				DesiredState: "offline",
+				DropPolicy: &neo4jv1alpha1.DatabaseDropPolicy{
+					RequireConfirmation: true,
+					FinalDump:           s3Dump,
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "offline composite database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type:         "composite",
+				DesiredState: "offline",
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.desiredState",
+		},
+		{
+			name: "final dump of a composite database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type:       "composite",
+				DropPolicy: &neo4jv1alpha1.DatabaseDropPolicy{FinalDump: s3Dump},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.dropPolicy.finalDump",
+		},
+		{
+			name: "final dump to a PVC",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				DropPolicy: &neo4jv1alpha1.DatabaseDropPolicy{
+					FinalDump: &neo4jv1alpha1.DatabaseFinalDump{
+						Storage: neo4jv1alpha1.StorageLocation{Type: "pvc"},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.dropPolicy.finalDump.storage.type",
+		},
+		{
+			name: "final dump without bucket",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				DropPolicy: &neo4jv1alpha1.DatabaseDropPolicy{
+					FinalDump: &neo4jv1alpha1.DatabaseFinalDump{
+						Storage: neo4jv1alpha1.StorageLocation{Type: "gcs"},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.dropPolicy.finalDump.storage.bucket",
+		},
+		{
+			name: "final dump of a retained database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				DropPolicy: &neo4jv1alpha1.DatabaseDropPolicy{Action: "Retain", FinalDump: s3Dump},
+			},
+			expectedErrors:   0,
+			expectedWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.ClusterRef = "test-cluster"
+			tt.spec.Name = "testdb"
+			database := &neo4jv1alpha1.Neo4jDatabase{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-db", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+
+			result := validator.Validate(ctx, database)
+
+			assert.Equal(t, tt.expectedErrors, len(result.Errors),
+				"Expected %d errors, got %d: %v", tt.expectedErrors, len(result.Errors), result.Errors)
+			assert.Equal(t, tt.expectedWarnings, len(result.Warnings),
+				"Expected %d warnings, got %d: %v", tt.expectedWarnings, len(result.Warnings), result.Warnings)
+
+			if tt.shouldContainError != "" {
+				found := false
+				for _, err := range result.Errors {
+					if containsString(err.Error(), tt.shouldContainError) {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "Expected error containing '%s' but got: %v", tt.shouldContainError, result.Errors)
+			}
+		})
+	}
+}
+
+func TestDatabaseValidator_ValidateSchema(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	validator := NewDatabaseValidator(client)
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		spec               neo4jv1alpha1.Neo4jDatabaseSpec
+		expectedErrors     int
+		shouldContainError string
+	}{
+		{
+			name: "valid indexes and constraints",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes: []neo4jv1alpha1.SchemaIndex{
+						{Name: "person_name", Labels: []string{"Person"}, Properties: []string{"name"}},
+						{Name: "search", Type: "FULLTEXT", Labels: []string{"Movie", "Book"}, Properties: []string{"title", "summary"}},
+						{Name: "since", RelationshipTypes: []string{"KNOWS"}, Properties: []string{"since"}},
+					},
+					Constraints: []neo4jv1alpha1.SchemaConstraint{
+						{Name: "person_id", Type: "UNIQUE", Label: "Person", Properties: []string{"id"}},
+						{Name: "person_age", Type: "PROPERTY_TYPE", Label: "Person", Properties: []string{"age"}, PropertyType: "INTEGER"},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "schema of a composite database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type: "composite",
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes: []neo4jv1alpha1.SchemaIndex{{Name: "person_name", Labels: []string{"Person"}, Properties: []string{"name"}}},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.schema",
+		},
+		{
+			name: "index and constraint with the same name",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes:     []neo4jv1alpha1.SchemaIndex{{Name: "person", Labels: []string{"Person"}, Properties: []string{"name"}}},
+					Constraints: []neo4jv1alpha1.SchemaConstraint{{Name: "person", Type: "UNIQUE", Label: "Person", Properties: []string{"id"}}},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.schema.constraints[0].name",
+		},
+		{
+			name: "index on labels and relationship types",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes: []neo4jv1alpha1.SchemaIndex{
+						{Name: "mixed", Labels: []string{"Person"}, RelationshipTypes: []string{"KNOWS"}, Properties: []string{"name"}},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "exactly one of labels or relationshipTypes",
+		},
+		{
+			name: "range index on several labels",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes: []neo4jv1alpha1.SchemaIndex{
+						{Name: "names", Labels: []string{"Person", "Company"}, Properties: []string{"name"}},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "single label",
+		},
+		{
+			name: "text index on several properties",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Indexes: []neo4jv1alpha1.SchemaIndex{
+						{Name: "names", Type: "TEXT", Labels: []string{"Person"}, Properties: []string{"first", "last"}},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.schema.indexes[0].properties",
+		},
+		{
+			name: "constraint without label",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Constraints: []neo4jv1alpha1.SchemaConstraint{{Name: "id", Type: "KEY", Properties: []string{"id"}}},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "exactly one of label or relationshipType",
+		},
+		{
+			name: "property type constraint without type",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Constraints: []neo4jv1alpha1.SchemaConstraint{
+						{Name: "age", Type: "PROPERTY_TYPE", Label: "Person", Properties: []string{"age"}},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.schema.constraints[0].propertyType",
+		},
+		{
+			name: "existence constraint on several properties",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Schema: &neo4jv1alpha1.DatabaseSchema{
+					Constraints: []neo4jv1alpha1.SchemaConstraint{
+						{Name: "named", Type: "EXISTS", Label: "Person", Properties: []string{"first", "last"}},
+					},
+				},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.schema.constraints[0].properties",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.ClusterRef = "test-cluster"
+			tt.spec.Name = "testdb"
+			database := &neo4jv1alpha1.Neo4jDatabase{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-db", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+
+			result := validator.Validate(ctx, database)
+
+			assert.Equal(t, tt.expectedErrors, len(result.Errors),
+				"Expected %d errors, got %d: %v", tt.expectedErrors, len(result.Errors), result.Errors)
+
+			if tt.shouldContainError != "" {
+				found := false
+				for _, err := range result.Errors {
+					if containsString(err.Error(), tt.shouldContainError) {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "Expected error containing '%s' but got: %v", tt.shouldContainError, result.Errors)
+			}
+		})
+	}
+}
+
+func TestDatabaseValidator_ValidateSeed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+		},
+	}
+	s3Backup := &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target:  neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "testdb"},
+			Storage: neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "backups", Path: "prod"},
+		},
+	}
+	pvcBackup := &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target:  neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "test-cluster"},
+			Storage: neo4jv1alpha1.StorageLocation{Type: "pvc"},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, s3Backup, pvcBackup).Build()
+	validator := NewDatabaseValidator(client)
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		spec               neo4jv1alpha1.Neo4jDatabaseSpec
+		expectedErrors     int
+		shouldContainError string
+	}{
+		{
+			name: "seed from URI",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{URI: "s3://backups/prod/testdb.backup"},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "seed from cloud backup",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{BackupRef: "nightly"},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "seed without uri or backupRef",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{},
+			},
+			expectedErrors:     1,
+			shouldContainError: "exactly one of uri and backupRef",
+		},
+		{
+			name: "seed with invalid URI",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{URI: "file:///backups/prod/testdb.backup"},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.seed.uri",
+		},
+		{
+			name: "seed with seedURI",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed:    &neo4jv1alpha1.DatabaseSeed{BackupRef: "nightly"},
+				SeedURI: "s3://backups/prod/testdb.backup",
+			},
+			expectedErrors:     1,
+			shouldContainError: "seedURI cannot be specified together with seed",
+		},
+		{
+			name: "seed from missing backup",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{BackupRef: "missing"},
+			},
+			expectedErrors:     1,
+			shouldContainError: "spec.seed.backupRef",
+		},
+		{
+			name: "seed from PVC backup",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Seed: &neo4jv1alpha1.DatabaseSeed{BackupRef: "local"},
+			},
+			expectedErrors:     1,
+			shouldContainError: "backups stored on a PVC cannot seed a database",
+		},
+		{
+			name: "seed of composite database",
+			spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+				Type: "composite",
+				Seed: &neo4jv1alpha1.DatabaseSeed{BackupRef: "nightly"},
+			},
+			expectedErrors:     1,
+			shouldContainError: "seed cannot be specified for a composite database",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.ClusterRef = "test-cluster"
+			tt.spec.Name = "testdb"
+			database := &neo4jv1alpha1.Neo4jDatabase{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-db", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+
+			result := validator.Validate(ctx, database)
+
+			assert.Equal(t, tt.expectedErrors, len(result.Errors),
+				"Expected %d errors, got %d: %v", tt.expectedErrors, len(result.Errors), result.Errors)
+
+			if tt.shouldContainError != "" {
+				found := false
+				for _, err := range result.Errors {
+					if containsString(err.Error(), tt.shouldContainError) {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "Expected error containing '%s' but got: %v", tt.shouldContainError, result.Errors)
+			}
+		})
+	}
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package validation
 
 import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
-	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // ImageValidator validates Neo4j image configuration
@@ -52,8 +55,8 @@ func (v *ImageValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 
 	// Validate Neo4j version (must be 5.26.x last semver LTS, or 2025.x.x+ CalVer)
 	if cluster.Spec.Image.Tag != "" {
-		version, err := neo4j.ParseVersion(cluster.Spec.Image.Tag)
-		if err != nil || !version.IsSupported() {
+		imageVersion, err := version.Parse(cluster.Spec.Image.Tag)
+		if err != nil || !imageVersion.IsSupported() {
 			allErrs = append(allErrs, field.Invalid(
 				imagePath.Child("tag"),
 				cluster.Spec.Image.Tag,
@@ -62,12 +65,25 @@ func (v *ImageValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		}
 	}
 
-	// Validate pull policy
+	allErrs = append(allErrs, validateImagePull(cluster.Spec.Image, imagePath)...)
+
+	return allErrs
+}
+
+// registryPattern matches a registry host with an optional port and path,
+// without a scheme
+var registryPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9.-]*(:[0-9]+)?(/[a-z0-9._-]+)*$`)
+
+// validateImagePull validates the pull policy, registry mirror and pull
+// secrets of an image, which clusters and standalones share
+func validateImagePull(image neo4jv1alpha1.ImageSpec, imagePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
 	validPullPolicies := []string{"Always", "Never", "IfNotPresent"}
-	if cluster.Spec.Image.PullPolicy != "" {
+	if image.PullPolicy != "" {
 		valid := false
 		for _, policy := range validPullPolicies {
-			if cluster.Spec.Image.PullPolicy == policy {
+			if image.PullPolicy == policy {
 				valid = true
 				break
 			}
@@ -75,17 +91,31 @@ func (v *ImageValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster)
 		if !valid {
 			allErrs = append(allErrs, field.NotSupported(
 				imagePath.Child("pullPolicy"),
-				cluster.Spec.Image.PullPolicy,
+				image.PullPolicy,
 				validPullPolicies,
 			))
 		}
 	}
 
+	if image.Registry != "" && !registryPattern.MatchString(image.Registry) {
+		allErrs = append(allErrs, field.Invalid(
+			imagePath.Child("registry"),
+			image.Registry,
+			"registry must be a host with an optional port and path, without a scheme, e.g. mirror.example.com:5000/dockerhub",
+		))
+	}
+
+	for i, name := range image.PullSecrets {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(imagePath.Child("pullSecrets").Index(i), name, msg))
+		}
+	}
+
 	return allErrs
 }
 
-func (v *ImageValidator) isVersionSupported(version string) bool {
-	parsed, err := neo4j.ParseVersion(version)
+func (v *ImageValidator) isVersionSupported(tag string) bool {
+	parsed, err := version.Parse(tag)
 	if err != nil {
 		return false
 	}
@@ -153,6 +153,47 @@ func TestImageValidator_Validate(t *testing.T) {
 			},
 			expectedErrs: 1,
 		},
+		{
+			name: "registry mirror with pull secrets",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Image: neo4jv1alpha1.ImageSpec{
+						Repo:        "neo4j",
+						Tag:         "5.26.0",
+						Registry:    "mirror.example.com:5000/dockerhub",
+						PullSecrets: []string{"mirror-credentials"},
+					},
+				},
+			},
+			expectedErrs: 0,
+		},
+		{
+			name: "registry with scheme",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Image: neo4jv1alpha1.ImageSpec{
+						Repo:     "neo4j",
+						Tag:      "5.26.0",
+						Registry: "https://mirror.example.com",
+					},
+				},
+			},
+			expectedErrs:  1,
+			expectedError: "without a scheme",
+		},
+		{
+			name: "invalid pull secret name",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Image: neo4jv1alpha1.ImageSpec{
+						Repo:        "neo4j",
+						Tag:         "5.26.0",
+						PullSecrets: []string{"Registry_Secret"},
+					},
+				},
+			},
+			expectedErrs: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// LicenseMaxCoresKey is the key of the operator-wide license ConfigMap that
+// holds the licensed number of cores
+const LicenseMaxCoresKey = "maxCores"
+
+// licenseConfigMap is the ConfigMap holding the operator-wide entitlement,
+// empty while there is none
+var licenseConfigMap = struct {
+	sync.Mutex
+	name types.NamespacedName
+}{}
+
+// SetLicenseConfigMap sets the ConfigMap whose maxCores key is the license
+// entitlement of clusters without spec.license. An empty name removes it.
+func SetLicenseConfigMap(name types.NamespacedName) {
+	licenseConfigMap.Lock()
+	defer licenseConfigMap.Unlock()
+	licenseConfigMap.name = name
+}
+
+// LicenseValidator checks the cores of a cluster against its Neo4j license
+// entitlement
+type LicenseValidator struct {
+	client client.Client
+}
+
+// NewLicenseValidator creates a new license validator
+func NewLicenseValidator(c client.Client) *LicenseValidator {
+	return &LicenseValidator{client: c}
+}
+
+// Entitlement returns the licensed cores of a cluster and where they are
+// set, or 0 when no entitlement applies
+func (v *LicenseValidator) Entitlement(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (int64, string, error) {
+	if cluster.Spec.License != nil {
+		return int64(cluster.Spec.License.MaxCores), "spec.license.maxCores", nil
+	}
+
+	licenseConfigMap.Lock()
+	name := licenseConfigMap.name
+	licenseConfigMap.Unlock()
+	if name.Name == "" || v.client == nil {
+		return 0, "", nil
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := v.client.Get(ctx, name, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return 0, "", fmt.Errorf("license ConfigMap %s not found", name)
+		}
+		return 0, "", fmt.Errorf("failed to get license ConfigMap %s: %w", name, err)
+	}
+	maxCores, err := strconv.ParseInt(configMap.Data[LicenseMaxCoresKey], 10, 64)
+	if err != nil || maxCores < 1 {
+		return 0, "", fmt.Errorf("license ConfigMap %s needs a positive %s, got %q", name, LicenseMaxCoresKey, configMap.Data[LicenseMaxCoresKey])
+	}
+	return maxCores, fmt.Sprintf("ConfigMap %s", name), nil
+}
+
+// Validate rejects a cluster that needs more cores than its entitlement,
+// unless it needs no more than deployedCores, the cores its servers use
+// already. A cluster past an entitlement that was lowered can so still be
+// changed, as long as it does not grow.
+func (v *LicenseValidator) Validate(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster, deployedCores int64) field.ErrorList {
+	maxCores, source, err := v.Entitlement(ctx, cluster)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "license"), err)}
+	}
+	if maxCores == 0 {
+		return nil
+	}
+	cores, errs := RequestedCores(cluster)
+	if len(errs) > 0 {
+		return errs
+	}
+	if cores <= maxCores || cores <= deployedCores {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "topology", "servers"),
+		fmt.Sprintf("the servers need %d cores, more than the %d licensed in %s", cores, maxCores, source))}
+}
+
+// RequestedCores returns the cores the servers of a cluster need: the CPU
+// limit of every server, rounded up to whole cores. Servers without a CPU
+// limit cannot be counted and are returned as errors.
+func RequestedCores(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (int64, field.ErrorList) {
+	if cluster.Spec.Hibernate {
+		return 0, nil
+	}
+	var allErrs field.ErrorList
+	serverCores := func(requirements *corev1.ResourceRequirements, path *field.Path) int64 {
+		if requirements == nil {
+			limit := resource.MustParse(resources.DefaultCPULimit)
+			return limit.Value()
+		}
+		limit, ok := requirements.Limits[corev1.ResourceCPU]
+		if !ok {
+			allErrs = append(allErrs, field.Required(path.Child("limits", "cpu"),
+				"a CPU limit is needed to count the cores of the Neo4j license"))
+		}
+		return limit.Value()
+	}
+
+	clusterCores := serverCores(cluster.Spec.Resources, field.NewPath("spec", "resources"))
+	cores := int64(cluster.Spec.Topology.Servers) * clusterCores
+	for i, group := range cluster.Spec.Topology.ServerGroups {
+		groupCores := clusterCores
+		if group.Resources != nil {
+			groupCores = serverCores(group.Resources, field.NewPath("spec", "topology", "serverGroups").Index(i).Child("resources"))
+		}
+		cores += int64(group.Servers) * groupCores
+	}
+	return cores, allErrs
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func licenseTestCluster(servers int32, cpu string) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: servers},
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			},
+		},
+	}
+}
+
+func TestRequestedCores(t *testing.T) {
+	cluster := licenseTestCluster(3, "1500m")
+	cluster.Spec.Topology.ServerGroups = []neo4jv1alpha1.ServerGroupSpec{
+		{Name: "analytics", Servers: 2, Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")},
+		}},
+		{Name: "inherit", Servers: 1},
+	}
+	// 1500m rounds up to 2 cores per server
+	if cores, errs := RequestedCores(cluster); len(errs) > 0 || cores != 3*2+2*8+2 {
+		t.Errorf("RequestedCores() = %d, %v; want 24 cores", cores, errs)
+	}
+
+	cluster.Spec.Hibernate = true
+	if cores, _ := RequestedCores(cluster); cores != 0 {
+		t.Errorf("RequestedCores() of a hibernating cluster = %d, want 0", cores)
+	}
+
+	cluster = licenseTestCluster(3, "1")
+	cluster.Spec.Resources.Limits = nil
+	if _, errs := RequestedCores(cluster); len(errs) != 1 || errs[0].Field != "spec.resources.limits.cpu" {
+		t.Errorf("RequestedCores() without a CPU limit = %v, want an error on spec.resources.limits.cpu", errs)
+	}
+}
+
+func TestLicenseValidator_Validate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "neo4j-license", Namespace: "neo4j-operator"},
+		Data:       map[string]string{LicenseMaxCoresKey: "8"},
+	}
+	validator := NewLicenseValidator(fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build())
+	ctx := context.Background()
+
+	// Without an entitlement nothing is checked
+	if errs := validator.Validate(ctx, licenseTestCluster(100, "4"), 0); len(errs) > 0 {
+		t.Errorf("Validate() without an entitlement = %v, want no errors", errs)
+	}
+
+	SetLicenseConfigMap(types.NamespacedName{Namespace: "neo4j-operator", Name: "neo4j-license"})
+	defer SetLicenseConfigMap(types.NamespacedName{})
+
+	if errs := validator.Validate(ctx, licenseTestCluster(4, "2"), 0); len(errs) > 0 {
+		t.Errorf("Validate() within the ConfigMap entitlement = %v, want no errors", errs)
+	}
+	errs := validator.Validate(ctx, licenseTestCluster(5, "2"), 0)
+	if len(errs) != 1 || errs[0].Field != "spec.topology.servers" || !strings.Contains(errs[0].Detail, "ConfigMap neo4j-operator/neo4j-license") {
+		t.Errorf("Validate() past the ConfigMap entitlement = %v, want an error naming the ConfigMap", errs)
+	}
+	// A cluster past its entitlement may shrink, but not grow
+	if errs := validator.Validate(ctx, licenseTestCluster(5, "2"), 12); len(errs) > 0 {
+		t.Errorf("Validate() for a cluster shrinking past its entitlement = %v, want no errors", errs)
+	}
+	if errs := validator.Validate(ctx, licenseTestCluster(7, "2"), 12); len(errs) != 1 {
+		t.Errorf("Validate() for a cluster growing past its entitlement = %v, want an error", errs)
+	}
+
+	// spec.license takes the place of the ConfigMap
+	cluster := licenseTestCluster(5, "2")
+	cluster.Spec.License = &neo4jv1alpha1.LicenseSpec{MaxCores: 16}
+	if errs := validator.Validate(ctx, cluster, 0); len(errs) > 0 {
+		t.Errorf("Validate() within spec.license = %v, want no errors", errs)
+	}
+
+	SetLicenseConfigMap(types.NamespacedName{Namespace: "neo4j-operator", Name: "missing"})
+	if errs := validator.Validate(ctx, licenseTestCluster(1, "1"), 0); len(errs) != 1 || errs[0].Field != "spec.license" {
+		t.Errorf("Validate() with a missing ConfigMap = %v, want an error on spec.license", errs)
+	}
+}
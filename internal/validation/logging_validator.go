@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// validateLogging checks the outputs of the log forwarder, which are
+// written into its configuration line by line
+func validateLogging(spec *neo4jv1alpha1.LoggingSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil || spec.Forwarder == nil {
+		return allErrs
+	}
+	for i, output := range spec.Forwarder.Outputs {
+		outputPath := path.Child("forwarder", "outputs").Index(i)
+		if strings.TrimSpace(output.Name) == "" || strings.ContainsAny(output.Name, " \t\r\n") {
+			allErrs = append(allErrs, field.Invalid(outputPath.Child("name"), output.Name,
+				"must be the name of a Fluent Bit output plugin"))
+		}
+		if strings.ContainsAny(output.Match, "\r\n") {
+			allErrs = append(allErrs, field.Invalid(outputPath.Child("match"), output.Match,
+				"must not contain line breaks"))
+		}
+		for key, value := range output.Properties {
+			if key == "" || strings.ContainsAny(key, " \t\r\n") {
+				allErrs = append(allErrs, field.Invalid(outputPath.Child("properties").Key(key), key,
+					"property names must not be empty or contain whitespace"))
+			}
+			if strings.ContainsAny(value, "\r\n") {
+				allErrs = append(allErrs, field.Invalid(outputPath.Child("properties").Key(key), value,
+					"must not contain line breaks"))
+			}
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateLogging(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           *neo4jv1alpha1.LoggingSpec
+		expectedErrors int
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "json without forwarder",
+			spec: &neo4jv1alpha1.LoggingSpec{Format: "json"},
+		},
+		{
+			name: "valid outputs",
+			spec: &neo4jv1alpha1.LoggingSpec{
+				Forwarder: &neo4jv1alpha1.LogForwarderSpec{
+					Enabled: true,
+					Outputs: []neo4jv1alpha1.LogOutputSpec{
+						{Name: "loki", Match: "neo4j.query", Properties: map[string]string{"Host": "loki.monitoring", "Labels": "job=neo4j"}},
+						{Name: "stdout"},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid output name",
+			spec: &neo4jv1alpha1.LoggingSpec{
+				Forwarder: &neo4jv1alpha1.LogForwarderSpec{
+					Enabled: true,
+					Outputs: []neo4jv1alpha1.LogOutputSpec{{Name: "stdout\n[OUTPUT]"}},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "line breaks in match and properties",
+			spec: &neo4jv1alpha1.LoggingSpec{
+				Forwarder: &neo4jv1alpha1.LogForwarderSpec{
+					Enabled: true,
+					Outputs: []neo4jv1alpha1.LogOutputSpec{{
+						Name:       "http",
+						Match:      "neo4j.*\n",
+						Properties: map[string]string{"Host": "collector\n    Port 80", "Uri Path": "/logs"},
+					}},
+				},
+			},
+			expectedErrors: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateLogging(tt.spec, field.NewPath("spec", "logging"))
+			assert.Len(t, errs, tt.expectedErrors, "errors: %v", errs)
+		})
+	}
+}
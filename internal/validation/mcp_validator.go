@@ -83,5 +83,7 @@ func validateMCPConfig(spec *neo4jv1alpha1.MCPServerSpec, path *field.Path) fiel
 		))
 	}
 
+	allErrs = append(allErrs, validateSecurityProfiles(spec.SecurityContext, path.Child("securityContext"))...)
+
 	return allErrs
 }
@@ -0,0 +1,43 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// validateMonitoring checks that the bundled alerts and dashboard, which
+// select the servers by the labels their monitor relabels them with, come
+// with spec.monitoring.serviceMonitor
+func validateMonitoring(spec *neo4jv1alpha1.MonitoringSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil || (spec.ServiceMonitor != nil && spec.ServiceMonitor.Enabled) {
+		return allErrs
+	}
+	if spec.Alerts != nil && spec.Alerts.Enabled {
+		allErrs = append(allErrs, field.Invalid(path.Child("alerts", "enabled"), true,
+			"requires spec.monitoring.serviceMonitor.enabled"))
+	}
+	if spec.Dashboards != nil && spec.Dashboards.Enabled {
+		allErrs = append(allErrs, field.Invalid(path.Child("dashboards", "enabled"), true,
+			"requires spec.monitoring.serviceMonitor.enabled"))
+	}
+	return allErrs
+}
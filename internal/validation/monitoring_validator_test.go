@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateMonitoring(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           *neo4jv1alpha1.MonitoringSpec
+		expectedErrors int
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "with service monitor",
+			spec: &neo4jv1alpha1.MonitoringSpec{
+				ServiceMonitor: &neo4jv1alpha1.ServiceMonitorSpec{Enabled: true},
+				Alerts:         &neo4jv1alpha1.MonitoringAlertsSpec{Enabled: true},
+				Dashboards:     &neo4jv1alpha1.MonitoringDashboardsSpec{Enabled: true},
+			},
+		},
+		{
+			name: "without service monitor",
+			spec: &neo4jv1alpha1.MonitoringSpec{
+				Alerts:     &neo4jv1alpha1.MonitoringAlertsSpec{Enabled: true},
+				Dashboards: &neo4jv1alpha1.MonitoringDashboardsSpec{Enabled: true},
+			},
+			expectedErrors: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateMonitoring(tt.spec, field.NewPath("spec", "monitoring"))
+			assert.Len(t, errs, tt.expectedErrors, "errors: %v", errs)
+		})
+	}
+}
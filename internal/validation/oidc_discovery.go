@@ -0,0 +1,174 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+const (
+	// oidcDiscoveryTimeout bounds the fetch of a discovery document
+	oidcDiscoveryTimeout = 5 * time.Second
+	// oidcDiscoveryCacheTTL is how long a fetched document is reused, as
+	// clusters are validated on every reconcile
+	oidcDiscoveryCacheTTL = 10 * time.Minute
+	// oidcDiscoveryMaxSize bounds the size of a discovery document
+	oidcDiscoveryMaxSize = 1 << 20
+)
+
+// oidcDiscovery holds the client discovery documents are fetched with, nil
+// while the check is disabled, and the documents fetched by URL
+var oidcDiscovery = struct {
+	sync.Mutex
+	client    *http.Client
+	documents map[string]oidcDiscoveryResult
+}{
+	documents: map[string]oidcDiscoveryResult{},
+}
+
+// oidcDiscoveryResult is a fetched discovery document, or why it could not
+// be fetched
+type oidcDiscoveryResult struct {
+	document *oidcDiscoveryDocument
+	err      error
+	fetched  time.Time
+}
+
+// oidcDiscoveryDocument holds the fields of an OpenID provider configuration
+// that Neo4j relies on
+type oidcDiscoveryDocument struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	JWKSURI                       string   `json:"jwks_uri"`
+	ScopesSupported               []string `json:"scopes_supported"`
+	ResponseTypesSupported        []string `json:"response_types_supported"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+}
+
+// SetOIDCDiscoveryCheck turns fetching the discovery document of the issuer
+// of spec.auth.oidc during validation on or off. It is off by default, as
+// the operator may not be allowed to reach the identity provider.
+func SetOIDCDiscoveryCheck(enabled bool) {
+	var client *http.Client
+	if enabled {
+		client = &http.Client{Timeout: oidcDiscoveryTimeout}
+	}
+	setOIDCDiscoveryClient(client)
+}
+
+// setOIDCDiscoveryClient sets the client discovery documents are fetched
+// with and forgets the documents fetched so far
+func setOIDCDiscoveryClient(client *http.Client) {
+	oidcDiscovery.Lock()
+	defer oidcDiscovery.Unlock()
+	oidcDiscovery.client = client
+	oidcDiscovery.documents = map[string]oidcDiscoveryResult{}
+}
+
+// checkOIDCDiscovery checks spec.auth.oidc against the discovery document
+// of its issuer. It passes while the check is disabled.
+func checkOIDCDiscovery(oidc *neo4jv1alpha1.OIDCAuthSpec) error {
+	document, err := fetchOIDCDiscovery(resources.OIDCDiscoveryURL(oidc.Issuer))
+	if err != nil || document == nil {
+		return err
+	}
+
+	if strings.TrimSuffix(document.Issuer, "/") != strings.TrimSuffix(oidc.Issuer, "/") {
+		return fmt.Errorf("the discovery document names the issuer %q", document.Issuer)
+	}
+	if document.JWKSURI == "" {
+		return fmt.Errorf("the discovery document has no jwks_uri to verify tokens with")
+	}
+	if document.AuthorizationEndpoint == "" {
+		return fmt.Errorf("the discovery document has no authorization_endpoint")
+	}
+	if oidc.AuthFlow == "implicit" {
+		if len(document.ResponseTypesSupported) > 0 && !slices.Contains(document.ResponseTypesSupported, "token") {
+			return fmt.Errorf("the provider does not support the implicit flow, response types: %s",
+				strings.Join(document.ResponseTypesSupported, ", "))
+		}
+	} else {
+		if document.TokenEndpoint == "" {
+			return fmt.Errorf("the discovery document has no token_endpoint, which the pkce flow needs")
+		}
+		if len(document.CodeChallengeMethodsSupported) > 0 && !slices.Contains(document.CodeChallengeMethodsSupported, "S256") {
+			return fmt.Errorf("the provider does not support S256 code challenges, which the pkce flow needs")
+		}
+	}
+	if len(document.ScopesSupported) > 0 {
+		for _, scope := range resources.OIDCScopes(oidc) {
+			if !slices.Contains(document.ScopesSupported, scope) {
+				return fmt.Errorf("the provider does not support the scope %q", scope)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchOIDCDiscovery returns the discovery document at a URL, nil while the
+// check is disabled. Documents, and errors fetching them, are cached.
+func fetchOIDCDiscovery(discoveryURL string) (*oidcDiscoveryDocument, error) {
+	oidcDiscovery.Lock()
+	client := oidcDiscovery.client
+	cached, found := oidcDiscovery.documents[discoveryURL]
+	oidcDiscovery.Unlock()
+	if client == nil {
+		return nil, nil
+	}
+	if found && time.Since(cached.fetched) < oidcDiscoveryCacheTTL {
+		return cached.document, cached.err
+	}
+
+	result := oidcDiscoveryResult{fetched: time.Now()}
+	result.document, result.err = getOIDCDiscovery(client, discoveryURL)
+
+	oidcDiscovery.Lock()
+	if oidcDiscovery.client == client {
+		oidcDiscovery.documents[discoveryURL] = result
+	}
+	oidcDiscovery.Unlock()
+	return result.document, result.err
+}
+
+// getOIDCDiscovery fetches and parses a discovery document
+func getOIDCDiscovery(client *http.Client, discoveryURL string) (*oidcDiscoveryDocument, error) {
+	response, err := client.Get(discoveryURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the discovery document: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch the discovery document %s: %s", discoveryURL, response.Status)
+	}
+
+	document := &oidcDiscoveryDocument{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, oidcDiscoveryMaxSize)).Decode(document); err != nil {
+		return nil, fmt.Errorf("invalid discovery document %s: %w", discoveryURL, err)
+	}
+	return document, nil
+}
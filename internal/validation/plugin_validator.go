@@ -18,6 +18,8 @@ package validation
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -25,6 +27,9 @@ import (
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
 )
 
+// pluginChecksumPattern matches the checksum formats the plugin init container can verify
+var pluginChecksumPattern = regexp.MustCompile(`^(?i)(sha256:[0-9a-f]{64}|sha512:[0-9a-f]{128}|[0-9a-f]{64}|[0-9a-f]{128})$`)
+
 // PluginValidator validates Neo4j plugin configuration for Neo4j 5.26+ compatibility
 type PluginValidator struct{}
 
@@ -79,6 +84,17 @@ func (v *PluginValidator) Validate(plugin *neo4jv1alpha1.Neo4jPlugin) field.Erro
 		allErrs = append(allErrs, v.validatePluginSecurity(plugin.Spec.Security)...)
 	}
 
+	// Validate plugin license
+	if plugin.Spec.License != nil && plugin.Spec.License.SecretRef != nil {
+		secretRefPath := field.NewPath("spec", "license", "secretRef")
+		if plugin.Spec.License.SecretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(secretRefPath.Child("name"), "license secret name must be specified"))
+		}
+		if plugin.Spec.License.SecretRef.Key == "" {
+			allErrs = append(allErrs, field.Required(secretRefPath.Child("key"), "key of the license file in the secret must be specified"))
+		}
+	}
+
 	// Validate plugin resources
 	if plugin.Spec.Resources != nil {
 		allErrs = append(allErrs, v.validatePluginResources(plugin.Spec.Resources)...)
@@ -133,7 +149,7 @@ func (v *PluginValidator) validatePluginSource(source *neo4jv1alpha1.PluginSourc
 	var allErrs field.ErrorList
 	sourcePath := field.NewPath("spec", "source")
 
-	validSourceTypes := []string{"official", "community", "custom", "url"}
+	validSourceTypes := []string{"official", "community", "custom", "url", "oci", "pvc"}
 	if source.Type != "" {
 		valid := false
 		for _, validType := range validSourceTypes {
@@ -159,6 +175,48 @@ func (v *PluginValidator) validatePluginSource(source *neo4jv1alpha1.PluginSourc
 		))
 	}
 
+	// Validate air-gapped sources: the JAR is copied from an image or a volume
+	switch source.Type {
+	case "oci":
+		if source.Image == "" {
+			allErrs = append(allErrs, field.Required(
+				sourcePath.Child("image"),
+				"image must be specified for oci source type",
+			))
+		}
+		if source.Path == "" {
+			allErrs = append(allErrs, field.Required(
+				sourcePath.Child("path"),
+				"path of the plugin JAR inside the image must be specified for oci source type",
+			))
+		} else if !path.IsAbs(source.Path) {
+			allErrs = append(allErrs, field.Invalid(
+				sourcePath.Child("path"),
+				source.Path,
+				"path must be absolute for oci source type",
+			))
+		}
+	case "pvc":
+		if source.ClaimName == "" {
+			allErrs = append(allErrs, field.Required(
+				sourcePath.Child("claimName"),
+				"claimName must be specified for pvc source type",
+			))
+		}
+		if source.Path == "" {
+			allErrs = append(allErrs, field.Required(
+				sourcePath.Child("path"),
+				"path of the plugin JAR inside the volume must be specified for pvc source type",
+			))
+		} else if clean := path.Clean(source.Path); path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+			allErrs = append(allErrs, field.Invalid(
+				sourcePath.Child("path"),
+				source.Path,
+				"path must be relative to the volume root for pvc source type",
+			))
+		}
+	}
+
 	// Validate checksum for security
 	if source.Type == "url" && source.Checksum == "" {
 		allErrs = append(allErrs, field.Required(
@@ -166,6 +224,13 @@ func (v *PluginValidator) validatePluginSource(source *neo4jv1alpha1.PluginSourc
 			"checksum must be specified for url source type for security",
 		))
 	}
+	if source.Checksum != "" && !pluginChecksumPattern.MatchString(source.Checksum) {
+		allErrs = append(allErrs, field.Invalid(
+			sourcePath.Child("checksum"),
+			source.Checksum,
+			"checksum must be sha256:<hex>, sha512:<hex> or a bare sha256/sha512 hex digest",
+		))
+	}
 
 	return allErrs
 }
@@ -157,7 +157,7 @@ func TestPluginValidator_Validate(t *testing.T) {
 					Source: &neo4jv1alpha1.PluginSource{
 						Type:     "url",
 						URL:      "https://example.com/plugin.jar",
-						Checksum: "sha256:abc123",
+						Checksum: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
 					},
 				},
 			},
@@ -184,6 +184,108 @@ func TestPluginValidator_Validate(t *testing.T) {
 			expectError: true,
 			errorCount:  2, // Missing URL and checksum
 		},
+		{
+			name: "valid plugin with oci source",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "air-gapped",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "apoc",
+					Version:    "5.26.0",
+					Enabled:    true,
+					Source: &neo4jv1alpha1.PluginSource{
+						Type:  "oci",
+						Image: "registry.internal/plugins/apoc:5.26.0",
+						Path:  "/plugins/apoc-5.26.0-core.jar",
+					},
+				},
+			},
+			expectError: false,
+			errorCount:  0,
+		},
+		{
+			name: "invalid oci source - missing image and relative path",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "air-gapped",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "apoc",
+					Version:    "5.26.0",
+					Enabled:    true,
+					Source: &neo4jv1alpha1.PluginSource{
+						Type: "oci",
+						Path: "apoc.jar",
+					},
+				},
+			},
+			expectError: true,
+			errorCount:  2, // Missing image and relative path
+		},
+		{
+			name: "valid plugin with pvc source",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "air-gapped",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "apoc",
+					Version:    "5.26.0",
+					Enabled:    true,
+					Source: &neo4jv1alpha1.PluginSource{
+						Type:      "pvc",
+						ClaimName: "plugin-artifacts",
+						Path:      "apoc/apoc-5.26.0-core.jar",
+					},
+				},
+			},
+			expectError: false,
+			errorCount:  0,
+		},
+		{
+			name: "invalid pvc source - path escapes the volume",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "air-gapped",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "apoc",
+					Version:    "5.26.0",
+					Enabled:    true,
+					Source: &neo4jv1alpha1.PluginSource{
+						Type:      "pvc",
+						ClaimName: "plugin-artifacts",
+						Path:      "../secrets/apoc.jar",
+					},
+				},
+			},
+			expectError: true,
+			errorCount:  1,
+		},
+		{
+			name: "invalid pvc source - missing claim and path",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "air-gapped",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "apoc",
+					Version:    "5.26.0",
+					Enabled:    true,
+					Source: &neo4jv1alpha1.PluginSource{
+						Type: "pvc",
+					},
+				},
+			},
+			expectError: true,
+			errorCount:  2, // Missing claimName and path
+		},
 		{
 			name: "valid plugin with dependencies",
 			plugin: &neo4jv1alpha1.Neo4jPlugin{
@@ -312,6 +414,44 @@ func TestPluginValidator_Validate(t *testing.T) {
 			expectError: true,
 			errorCount:  1,
 		},
+		{
+			name: "valid GDS plugin with license secret",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gds-licensed",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "graph-data-science",
+					Version:    "2.9.0",
+					Enabled:    true,
+					License: &neo4jv1alpha1.PluginLicense{
+						SecretRef: &neo4jv1alpha1.SecretKeySelector{Name: "gds-license", Key: "gds.license"},
+					},
+				},
+			},
+			expectError: false,
+			errorCount:  0,
+		},
+		{
+			name: "invalid license secret - missing name and key",
+			plugin: &neo4jv1alpha1.Neo4jPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gds-unlicensed",
+				},
+				Spec: neo4jv1alpha1.Neo4jPluginSpec{
+					ClusterRef: "test-cluster",
+					Name:       "graph-data-science",
+					Version:    "2.9.0",
+					Enabled:    true,
+					License: &neo4jv1alpha1.PluginLicense{
+						SecretRef: &neo4jv1alpha1.SecretKeySelector{},
+					},
+				},
+			},
+			expectError: true,
+			errorCount:  2,
+		},
 	}
 
 	for _, tt := range tests {
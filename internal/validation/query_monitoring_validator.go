@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// validateQueryMonitoring checks the values the operator parses when it
+// polls the servers for slow and runaway queries
+func validateQueryMonitoring(spec *neo4jv1alpha1.QueryMonitoringSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil || !spec.Enabled {
+		return allErrs
+	}
+
+	if spec.SlowQueryThreshold != "" {
+		if _, err := time.ParseDuration(spec.SlowQueryThreshold); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("slowQueryThreshold"), spec.SlowQueryThreshold,
+				"must be a duration such as 5s or 500ms"))
+		}
+	}
+
+	if spec.Sampling != nil && spec.Sampling.Rate != "" {
+		if rate, err := strconv.ParseFloat(spec.Sampling.Rate, 64); err != nil || rate < 0 || rate > 1 {
+			allErrs = append(allErrs, field.Invalid(path.Child("sampling", "rate"), spec.Sampling.Rate,
+				"must be a number between 0.0 and 1.0"))
+		}
+	}
+
+	if enforcement := spec.Enforcement; enforcement != nil {
+		enforcementPath := path.Child("enforcement")
+		if enforcement.MaxQueryDuration != "" {
+			if duration, err := time.ParseDuration(enforcement.MaxQueryDuration); err != nil || duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(enforcementPath.Child("maxQueryDuration"), enforcement.MaxQueryDuration,
+					"must be a positive duration such as 10m"))
+			}
+		}
+		if enforcement.MaxAllocatedMemory != "" {
+			if quantity, err := resource.ParseQuantity(enforcement.MaxAllocatedMemory); err != nil || quantity.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(enforcementPath.Child("maxAllocatedMemory"), enforcement.MaxAllocatedMemory,
+					"must be a positive quantity such as 2Gi"))
+			}
+		}
+		for i, allowlist := range enforcement.Allowlists {
+			allowlistPath := enforcementPath.Child("allowlists").Index(i)
+			if allowlist.Database == "" {
+				allErrs = append(allErrs, field.Required(allowlistPath.Child("database"), "database is required"))
+			}
+			for j, pattern := range allowlist.QueryPatterns {
+				if _, err := regexp.Compile(pattern); err != nil {
+					allErrs = append(allErrs, field.Invalid(allowlistPath.Child("queryPatterns").Index(j), pattern,
+						"must be a regular expression: "+err.Error()))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
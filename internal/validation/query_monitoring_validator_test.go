@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateQueryMonitoring(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           *neo4jv1alpha1.QueryMonitoringSpec
+		expectedErrors int
+	}{
+		{
+			name: "disabled",
+			spec: &neo4jv1alpha1.QueryMonitoringSpec{SlowQueryThreshold: "soon"},
+		},
+		{
+			name: "valid",
+			spec: &neo4jv1alpha1.QueryMonitoringSpec{
+				Enabled:            true,
+				SlowQueryThreshold: "500ms",
+				Sampling:           &neo4jv1alpha1.QuerySamplingConfig{Rate: "0.25"},
+				Enforcement: &neo4jv1alpha1.QueryEnforcementSpec{
+					MaxQueryDuration:   "10m",
+					MaxAllocatedMemory: "2Gi",
+					Allowlists:         []neo4jv1alpha1.QueryAllowlist{{Database: "reports", QueryPatterns: []string{`^CALL gds\.`}}},
+				},
+			},
+		},
+		{
+			name: "invalid values",
+			spec: &neo4jv1alpha1.QueryMonitoringSpec{
+				Enabled:            true,
+				SlowQueryThreshold: "soon",
+				Sampling:           &neo4jv1alpha1.QuerySamplingConfig{Rate: "2"},
+				Enforcement: &neo4jv1alpha1.QueryEnforcementSpec{
+					MaxQueryDuration:   "-1m",
+					MaxAllocatedMemory: "lots",
+					Allowlists:         []neo4jv1alpha1.QueryAllowlist{{QueryPatterns: []string{"("}}},
+				},
+			},
+			expectedErrors: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateQueryMonitoring(tt.spec, field.NewPath("spec", "queryMonitoring"))
+			assert.Len(t, errs, tt.expectedErrors, "errors: %v", errs)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// RestoreValidator validates Neo4j restore configuration
+type RestoreValidator struct {
+	backupValidator *BackupValidator
+}
+
+// NewRestoreValidator creates a new restore validator
+func NewRestoreValidator() *RestoreValidator {
+	return &RestoreValidator{backupValidator: NewBackupValidator()}
+}
+
+// Validate validates the restore configuration. The storage locations are
+// checked like those of a backup.
+func (v *RestoreValidator) Validate(restore *neo4jv1alpha1.Neo4jRestore) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if restore.Spec.TargetCluster == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("targetCluster"), "restore target cluster must be specified"))
+	}
+	if restore.Spec.DatabaseName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("databaseName"), "database to restore to must be specified"))
+	}
+
+	allErrs = append(allErrs, v.validateSource(&restore.Spec.Source)...)
+
+	if restore.Spec.Options != nil && restore.Spec.Options.RestoreSecurity && restore.Spec.StopCluster {
+		allErrs = append(allErrs, field.Forbidden(
+			specPath.Child("options", "restoreSecurity"),
+			"restoreSecurity requires an online restore: the cluster is stopped while the metadata would be replayed",
+		))
+	}
+
+	return allErrs
+}
+
+// validateSource validates the backup a restore reads
+func (v *RestoreValidator) validateSource(source *neo4jv1alpha1.RestoreSource) field.ErrorList {
+	var allErrs field.ErrorList
+	sourcePath := field.NewPath("spec", "source")
+
+	switch source.Type {
+	case "backup":
+		if source.BackupRef == "" {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("backupRef"), "backupRef is required when source type is 'backup'"))
+		}
+	case "storage":
+		if source.Storage == nil {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("storage"), "storage is required when source type is 'storage'"))
+		}
+		if source.BackupPath == "" {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("backupPath"), "backupPath is required when source type is 'storage'"))
+		}
+	case "pitr":
+		if source.PITR == nil {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("pitr"), "pitr configuration is required when source type is 'pitr'"))
+		} else if source.PITR.BaseBackup == nil && source.PointInTime == nil {
+			allErrs = append(allErrs, field.Required(sourcePath.Child("pitr", "baseBackup"), "pitr requires baseBackup configuration or pointInTime (or both)"))
+		}
+	}
+
+	if source.Storage != nil {
+		allErrs = append(allErrs, v.backupValidator.validateStorageConfiguration(source.Storage, nil, sourcePath.Child("storage"))...)
+	}
+	if source.PITR != nil {
+		allErrs = append(allErrs, v.validatePITR(source.PITR, sourcePath.Child("pitr"))...)
+	}
+
+	return allErrs
+}
+
+// validatePITR validates the transaction logs and base backup of a
+// point-in-time recovery
+func (v *RestoreValidator) validatePITR(pitr *neo4jv1alpha1.PITRConfig, pitrPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if pitr.LogStorage != nil {
+		allErrs = append(allErrs, v.backupValidator.validateStorageConfiguration(pitr.LogStorage, nil, pitrPath.Child("logStorage"))...)
+	}
+	if pitr.LogRetention != "" {
+		if err := validateRetentionAge(pitr.LogRetention); err != nil {
+			allErrs = append(allErrs, field.Invalid(pitrPath.Child("logRetention"), pitr.LogRetention, err.Error()))
+		}
+	}
+
+	if base := pitr.BaseBackup; base != nil {
+		basePath := pitrPath.Child("baseBackup")
+		if base.Type == "backup" && base.BackupRef == "" {
+			allErrs = append(allErrs, field.Required(basePath.Child("backupRef"), "backupRef is required when base backup type is 'backup'"))
+		}
+		if base.Type == "storage" && base.Storage == nil {
+			allErrs = append(allErrs, field.Required(basePath.Child("storage"), "storage is required when base backup type is 'storage'"))
+		}
+		if base.Storage != nil {
+			allErrs = append(allErrs, v.backupValidator.validateStorageConfiguration(base.Storage, nil, basePath.Child("storage"))...)
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestRestoreValidator_Validate(t *testing.T) {
+	validator := NewRestoreValidator()
+
+	tests := []struct {
+		name       string
+		source     neo4jv1alpha1.RestoreSource
+		wantFields []string
+	}{
+		{
+			name:   "valid restore from a backup",
+			source: neo4jv1alpha1.RestoreSource{Type: "backup", BackupRef: "daily"},
+		},
+		{
+			name:       "backup source without backupRef",
+			source:     neo4jv1alpha1.RestoreSource{Type: "backup"},
+			wantFields: []string{"spec.source.backupRef"},
+		},
+		{
+			name: "valid restore from storage",
+			source: neo4jv1alpha1.RestoreSource{
+				Type:       "storage",
+				BackupPath: "/backups/neo4j",
+				Storage: &neo4jv1alpha1.StorageLocation{
+					Type:   "gcs",
+					Bucket: "backups",
+					Cloud:  &neo4jv1alpha1.CloudBlock{Provider: "gcp"},
+				},
+			},
+		},
+		{
+			name: "storage source whose cloud does not match",
+			source: neo4jv1alpha1.RestoreSource{
+				Type:    "storage",
+				Storage: &neo4jv1alpha1.StorageLocation{Type: "s3", Bucket: "backups"},
+			},
+			wantFields: []string{"spec.source.backupPath", "spec.source.storage"},
+		},
+		{
+			name: "pitr with malformed retention and PVC size",
+			source: neo4jv1alpha1.RestoreSource{
+				Type: "pitr",
+				PITR: &neo4jv1alpha1.PITRConfig{
+					LogRetention: "7 days",
+					LogStorage:   &neo4jv1alpha1.StorageLocation{Type: "pvc", PVC: &neo4jv1alpha1.PVCSpec{Size: "lots"}},
+					BaseBackup:   &neo4jv1alpha1.BaseBackupSource{Type: "backup"},
+				},
+			},
+			wantFields: []string{"spec.source.pitr.logStorage.pvc.size", "spec.source.pitr.logRetention", "spec.source.pitr.baseBackup.backupRef"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := &neo4jv1alpha1.Neo4jRestore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-restore"},
+				Spec: neo4jv1alpha1.Neo4jRestoreSpec{
+					TargetCluster: "test-cluster",
+					DatabaseName:  "neo4j",
+					Source:        tt.source,
+				},
+			}
+			errs := validator.Validate(restore)
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if len(fields) != len(tt.wantFields) {
+				t.Fatalf("expected errors on %v but got: %v", tt.wantFields, errs)
+			}
+			for i := range fields {
+				if fields[i] != tt.wantFields[i] {
+					t.Errorf("expected errors on %v but got: %v", tt.wantFields, errs)
+				}
+			}
+		})
+	}
+}
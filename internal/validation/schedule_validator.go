@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+var scheduleDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// validateStandaloneSchedule validates the active hours schedule of a standalone.
+func validateStandaloneSchedule(spec *neo4jv1alpha1.StandaloneScheduleSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil || spec.ActiveHours == nil {
+		return allErrs
+	}
+	hours := spec.ActiveHours
+	hoursPath := path.Child("activeHours")
+
+	for _, clock := range []struct{ name, value string }{{"start", hours.Start}, {"end", hours.End}} {
+		if _, err := time.Parse("15:04", clock.value); err != nil || len(clock.value) != len("15:04") {
+			allErrs = append(allErrs, field.Invalid(hoursPath.Child(clock.name), clock.value, "must be a time of day as HH:MM"))
+		}
+	}
+
+	for i, day := range hours.Days {
+		valid := false
+		for _, d := range scheduleDays {
+			if day == d {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			allErrs = append(allErrs, field.NotSupported(hoursPath.Child("days").Index(i), day, scheduleDays))
+		}
+	}
+
+	if _, err := time.LoadLocation(hours.TimeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(hoursPath.Child("timeZone"), hours.TimeZone, "must be an IANA time zone such as Europe/Berlin"))
+	}
+
+	if hours.PreWarm != "" {
+		preWarm, err := time.ParseDuration(hours.PreWarm)
+		if err != nil || preWarm < 0 || preWarm >= 24*time.Hour {
+			allErrs = append(allErrs, field.Invalid(hoursPath.Child("preWarm"), hours.PreWarm, "must be a duration between 0 and 24h, e.g. 15m"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateStandaloneSchedule(t *testing.T) {
+	path := field.NewPath("spec", "schedule")
+	officeHours := func() *neo4jv1alpha1.ActiveHoursSpec {
+		return &neo4jv1alpha1.ActiveHoursSpec{
+			Start:    "08:00",
+			End:      "18:00",
+			Days:     []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+			TimeZone: "Europe/Berlin",
+			PreWarm:  "15m",
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(*neo4jv1alpha1.ActiveHoursSpec)
+		wantErrs  int
+		wantField string
+	}{
+		{
+			name:     "office hours — valid",
+			mutate:   func(*neo4jv1alpha1.ActiveHoursSpec) {},
+			wantErrs: 0,
+		},
+		{
+			name:     "overnight window in UTC — valid",
+			mutate:   func(h *neo4jv1alpha1.ActiveHoursSpec) { h.Start, h.End, h.TimeZone = "22:00", "06:00", "" },
+			wantErrs: 0,
+		},
+		{
+			name:      "single digit hour — invalid",
+			mutate:    func(h *neo4jv1alpha1.ActiveHoursSpec) { h.Start = "8:00" },
+			wantErrs:  1,
+			wantField: "spec.schedule.activeHours.start",
+		},
+		{
+			name:      "unknown day — invalid",
+			mutate:    func(h *neo4jv1alpha1.ActiveHoursSpec) { h.Days = []string{"Monday"} },
+			wantErrs:  1,
+			wantField: "spec.schedule.activeHours.days[0]",
+		},
+		{
+			name:      "unknown time zone — invalid",
+			mutate:    func(h *neo4jv1alpha1.ActiveHoursSpec) { h.TimeZone = "Mars/Olympus" },
+			wantErrs:  1,
+			wantField: "spec.schedule.activeHours.timeZone",
+		},
+		{
+			name:      "pre-warm of a day — invalid",
+			mutate:    func(h *neo4jv1alpha1.ActiveHoursSpec) { h.PreWarm = "24h" },
+			wantErrs:  1,
+			wantField: "spec.schedule.activeHours.preWarm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hours := officeHours()
+			tt.mutate(hours)
+			errs := validateStandaloneSchedule(&neo4jv1alpha1.StandaloneScheduleSpec{ActiveHours: hours}, path)
+			if len(errs) != tt.wantErrs {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), tt.wantErrs, errs)
+			}
+			if tt.wantField != "" && errs[0].Field != tt.wantField {
+				t.Errorf("expected error on %s, got %s", tt.wantField, errs[0].Field)
+			}
+		})
+	}
+
+	if errs := validateStandaloneSchedule(nil, path); len(errs) != 0 {
+		t.Errorf("nil schedule should be valid, got %v", errs)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// validateSecurityProfiles validates the seccomp/AppArmor settings of a securityContext block
+func validateSecurityProfiles(sc *neo4jv1alpha1.SecurityContextSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if sc == nil {
+		return allErrs
+	}
+
+	validDistros := []string{resources.DistroGeneric, resources.DistroBottlerocket, resources.DistroCOS, resources.DistroUbuntu}
+	if sc.Distro != "" && !containsSlice(validDistros, sc.Distro) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("distro"), sc.Distro, validDistros))
+	}
+
+	if sc.SeccompProfile != nil {
+		seccompPath := path.Child("seccompProfile")
+		switch sc.SeccompProfile.Type {
+		case corev1.SeccompProfileTypeLocalhost:
+			if sc.SeccompProfile.LocalhostProfile == nil || *sc.SeccompProfile.LocalhostProfile == "" {
+				allErrs = append(allErrs, field.Required(
+					seccompPath.Child("localhostProfile"),
+					"localhostProfile is required when type is Localhost",
+				))
+			}
+		case corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined:
+			if sc.SeccompProfile.LocalhostProfile != nil {
+				allErrs = append(allErrs, field.Forbidden(
+					seccompPath.Child("localhostProfile"),
+					"localhostProfile may only be set when type is Localhost",
+				))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				seccompPath.Child("type"),
+				sc.SeccompProfile.Type,
+				[]string{string(corev1.SeccompProfileTypeRuntimeDefault), string(corev1.SeccompProfileTypeLocalhost), string(corev1.SeccompProfileTypeUnconfined)},
+			))
+		}
+	}
+
+	if profile := sc.AppArmorProfile; profile != "" {
+		appArmorPath := path.Child("appArmorProfile")
+		localhost := strings.HasPrefix(profile, "localhost/") && len(profile) > len("localhost/")
+		if profile != resources.AppArmorRuntimeDefault && profile != "unconfined" && !localhost {
+			allErrs = append(allErrs, field.Invalid(appArmorPath, profile,
+				"must be runtime/default, unconfined or localhost/<profile>"))
+		}
+		if sc.Distro == resources.DistroBottlerocket && profile != "unconfined" {
+			allErrs = append(allErrs, field.Invalid(appArmorPath, profile,
+				"Bottlerocket nodes do not support AppArmor; pods requesting a profile will not start"))
+		}
+	}
+
+	return allErrs
+}
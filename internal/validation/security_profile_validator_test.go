@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateSecurityProfiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       *neo4jv1alpha1.SecurityContextSpec
+		errorTypes []field.ErrorType
+	}{
+		{
+			name: "nil spec",
+		},
+		{
+			name: "distro defaults only",
+			spec: &neo4jv1alpha1.SecurityContextSpec{Distro: "cos"},
+		},
+		{
+			name: "localhost profiles",
+			spec: &neo4jv1alpha1.SecurityContextSpec{
+				SeccompProfile: &corev1.SeccompProfile{
+					Type:             corev1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("profiles/neo4j.json"),
+				},
+				AppArmorProfile: "localhost/neo4j",
+			},
+		},
+		{
+			name:       "unknown distro",
+			spec:       &neo4jv1alpha1.SecurityContextSpec{Distro: "flatcar"},
+			errorTypes: []field.ErrorType{field.ErrorTypeNotSupported},
+		},
+		{
+			name: "localhost seccomp without profile",
+			spec: &neo4jv1alpha1.SecurityContextSpec{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+			},
+			errorTypes: []field.ErrorType{field.ErrorTypeRequired},
+		},
+		{
+			name: "localhostProfile with RuntimeDefault",
+			spec: &neo4jv1alpha1.SecurityContextSpec{
+				SeccompProfile: &corev1.SeccompProfile{
+					Type:             corev1.SeccompProfileTypeRuntimeDefault,
+					LocalhostProfile: ptr.To("profiles/neo4j.json"),
+				},
+			},
+			errorTypes: []field.ErrorType{field.ErrorTypeForbidden},
+		},
+		{
+			name:       "malformed AppArmor profile",
+			spec:       &neo4jv1alpha1.SecurityContextSpec{AppArmorProfile: "localhost/"},
+			errorTypes: []field.ErrorType{field.ErrorTypeInvalid},
+		},
+		{
+			name:       "AppArmor on bottlerocket",
+			spec:       &neo4jv1alpha1.SecurityContextSpec{Distro: "bottlerocket", AppArmorProfile: "runtime/default"},
+			errorTypes: []field.ErrorType{field.ErrorTypeInvalid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateSecurityProfiles(tt.spec, field.NewPath("spec", "securityContext"))
+			assert.Len(t, errs, len(tt.errorTypes), "errors: %v", errs)
+			for i, errType := range tt.errorTypes {
+				if i < len(errs) {
+					assert.Equal(t, errType, errs[i].Type)
+				}
+			}
+		})
+	}
+}
@@ -371,7 +371,7 @@ func (v *SecurityValidator) validateTLSConfig(tls *neo4jv1alpha1.TLSSpec) field.
 	tlsPath := field.NewPath("spec", "tls")
 
 	// Validate TLS mode
-	validModes := []string{"cert-manager", "disabled"}
+	validModes := []string{"cert-manager", "secret", "disabled"}
 	if tls.Mode != "" {
 		valid := false
 		for _, mode := range validModes {
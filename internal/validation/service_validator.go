@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// validateServiceSpec validates the client service spec of a cluster or standalone.
+func validateServiceSpec(spec *neo4jv1alpha1.ServiceSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil {
+		return allErrs
+	}
+
+	for i, cidr := range spec.AllowedCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("allowedCIDRs").Index(i), cidr,
+				"must be a CIDR such as 10.0.0.0/8 or 2001:db8::/32"))
+			continue
+		}
+		// NetworkPolicy ipBlocks and cloud load balancers expect the network address
+		if network.String() != cidr {
+			allErrs = append(allErrs, field.Invalid(path.Child("allowedCIDRs").Index(i), cidr,
+				fmt.Sprintf("host bits must be zero, use %s", network.String())))
+		}
+	}
+
+	familiesPath := path.Child("ipFamilies")
+	if len(spec.IPFamilies) == 2 && spec.IPFamilies[0] == spec.IPFamilies[1] {
+		allErrs = append(allErrs, field.Duplicate(familiesPath.Index(1), spec.IPFamilies[1]))
+	}
+	switch {
+	case spec.IPFamilyPolicy == "SingleStack" && len(spec.IPFamilies) > 1:
+		allErrs = append(allErrs, field.Invalid(familiesPath, spec.IPFamilies,
+			"SingleStack services take a single IP family"))
+	case spec.IPFamilyPolicy == "" && len(spec.IPFamilies) > 1:
+		allErrs = append(allErrs, field.Required(path.Child("ipFamilyPolicy"),
+			"PreferDualStack or RequireDualStack is required for two IP families"))
+	}
+
+	return allErrs
+}
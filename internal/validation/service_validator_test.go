@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func TestValidateServiceSpec(t *testing.T) {
+	path := field.NewPath("spec", "service")
+
+	tests := []struct {
+		name      string
+		spec      *neo4jv1alpha1.ServiceSpec
+		wantErrs  int
+		errDetail string
+	}{
+		{
+			name:     "nil spec — no errors",
+			spec:     nil,
+			wantErrs: 0,
+		},
+		{
+			name:     "IPv4 and IPv6 networks — valid",
+			spec:     &neo4jv1alpha1.ServiceSpec{AllowedCIDRs: []string{"10.0.0.0/8", "203.0.113.7/32", "2001:db8::/32"}},
+			wantErrs: 0,
+		},
+		{
+			name:      "bare IP address — invalid",
+			spec:      &neo4jv1alpha1.ServiceSpec{AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.10"}},
+			wantErrs:  1,
+			errDetail: "must be a CIDR",
+		},
+		{
+			name:      "host bits set — invalid",
+			spec:      &neo4jv1alpha1.ServiceSpec{AllowedCIDRs: []string{"192.168.1.10/24"}},
+			wantErrs:  1,
+			errDetail: "use 192.168.1.0/24",
+		},
+		{
+			name:     "IPv6 first dual-stack — valid",
+			spec:     &neo4jv1alpha1.ServiceSpec{IPFamilyPolicy: "RequireDualStack", IPFamilies: []string{"IPv6", "IPv4"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "same IP family twice — invalid",
+			spec:     &neo4jv1alpha1.ServiceSpec{IPFamilyPolicy: "PreferDualStack", IPFamilies: []string{"IPv6", "IPv6"}},
+			wantErrs: 1,
+		},
+		{
+			name:      "single stack with two IP families — invalid",
+			spec:      &neo4jv1alpha1.ServiceSpec{IPFamilyPolicy: "SingleStack", IPFamilies: []string{"IPv4", "IPv6"}},
+			wantErrs:  1,
+			errDetail: "single IP family",
+		},
+		{
+			name:      "two IP families without policy — invalid",
+			spec:      &neo4jv1alpha1.ServiceSpec{IPFamilies: []string{"IPv4", "IPv6"}},
+			wantErrs:  1,
+			errDetail: "PreferDualStack or RequireDualStack",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateServiceSpec(tt.spec, path)
+			if len(errs) != tt.wantErrs {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+			if tt.errDetail != "" && !strings.Contains(errs[0].Detail, tt.errDetail) {
+				t.Errorf("expected error detail to contain %q, got %q", tt.errDetail, errs[0].Detail)
+			}
+		})
+	}
+}
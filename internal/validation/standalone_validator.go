@@ -23,7 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
-	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // StandaloneValidator validates Neo4j standalone configuration
@@ -33,6 +33,7 @@ type StandaloneValidator struct {
 	storageValidator *StorageValidator
 	tlsValidator     *TLSValidator
 	authValidator    *AuthValidator
+	unsafeValidator  *UnsafeChangeValidator
 }
 
 // NewStandaloneValidator creates a new standalone validator
@@ -43,6 +44,7 @@ func NewStandaloneValidator() *StandaloneValidator {
 		storageValidator: NewStorageValidator(),
 		tlsValidator:     NewTLSValidator(),
 		authValidator:    NewAuthValidator(),
+		unsafeValidator:  NewUnsafeChangeValidator(),
 	}
 }
 
@@ -77,12 +79,30 @@ func (v *StandaloneValidator) ValidateCreate(standalone *neo4jv1alpha1.Neo4jEnte
 		allErrs = append(allErrs, errs...)
 	}
 
+	// Validate seccomp/AppArmor profiles
+	allErrs = append(allErrs, validateSecurityProfiles(standalone.Spec.SecurityContext, field.NewPath("spec", "securityContext"))...)
+
 	// Validate MCP configuration
 	allErrs = append(allErrs, validateMCPConfig(standalone.Spec.MCP, field.NewPath("spec", "mcp"))...)
 
 	// Aura Fleet Management validation
 	allErrs = append(allErrs, validateAuraFleetManagement(standalone.Spec.AuraFleetManagement, field.NewPath("spec", "auraFleetManagement"))...)
 
+	// Client service allowlist validation
+	allErrs = append(allErrs, validateServiceSpec(standalone.Spec.Service, field.NewPath("spec", "service"))...)
+
+	// Active hours schedule validation
+	allErrs = append(allErrs, validateStandaloneSchedule(standalone.Spec.Schedule, field.NewPath("spec", "schedule"))...)
+
+	// Log forwarder validation
+	allErrs = append(allErrs, validateLogging(standalone.Spec.Logging, field.NewPath("spec", "logging"))...)
+
+	// Only the cluster controller polls and terminates transactions
+	if standalone.Spec.QueryMonitoring != nil && standalone.Spec.QueryMonitoring.Enforcement != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "queryMonitoring", "enforcement"),
+			"query limit enforcement is only supported on Neo4jEnterpriseCluster"))
+	}
+
 	return allErrs
 }
 
@@ -100,8 +120,9 @@ func (v *StandaloneValidator) ValidateUpdate(oldStandalone, newStandalone *neo4j
 		allErrs = append(allErrs, errs...)
 	}
 
-	// Validate storage changes (should be immutable)
-	if errs := v.validateStorageChanges(oldStandalone, newStandalone); len(errs) > 0 {
+	// Reject changes to storage, the admin secret and the edition that
+	// cannot be applied safely
+	if errs := v.unsafeValidator.ValidateStandaloneUpdate(oldStandalone, newStandalone); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
 
@@ -145,6 +166,8 @@ func (v *StandaloneValidator) validateImage(standalone *neo4jv1alpha1.Neo4jEnter
 		}
 	}
 
+	allErrs = append(allErrs, validateImagePull(standalone.Spec.Image, imagePath)...)
+
 	return allErrs
 }
 
@@ -181,11 +204,11 @@ func (v *StandaloneValidator) validateTLS(standalone *neo4jv1alpha1.Neo4jEnterpr
 	tlsPath := field.NewPath("spec", "tls")
 
 	// Validate TLS mode
-	if standalone.Spec.TLS.Mode != "" && standalone.Spec.TLS.Mode != "cert-manager" && standalone.Spec.TLS.Mode != "disabled" {
+	if standalone.Spec.TLS.Mode != "" && standalone.Spec.TLS.Mode != "cert-manager" && standalone.Spec.TLS.Mode != "secret" && standalone.Spec.TLS.Mode != "disabled" {
 		allErrs = append(allErrs, field.Invalid(
 			tlsPath.Child("mode"),
 			standalone.Spec.TLS.Mode,
-			"TLS mode must be 'cert-manager' or 'disabled'",
+			"TLS mode must be 'cert-manager', 'secret' or 'disabled'",
 		))
 	}
 
@@ -204,6 +227,9 @@ func (v *StandaloneValidator) validateTLS(standalone *neo4jv1alpha1.Neo4jEnterpr
 		}
 	}
 
+	allErrs = append(allErrs, validateSecretTLSMode(standalone.Spec.TLS, tlsPath)...)
+	allErrs = append(allErrs, validateSSLPolicies(standalone.Spec.TLS, tlsPath)...)
+
 	return allErrs
 }
 
@@ -236,6 +262,12 @@ func (v *StandaloneValidator) validateAuth(standalone *neo4jv1alpha1.Neo4jEnterp
 		}
 	}
 
+	// The standalone controller does not sync or rotate the admin credentials
+	if standalone.Spec.Auth.ExternalSecret != nil {
+		allErrs = append(allErrs, field.Forbidden(authPath.Child("externalSecret"),
+			"syncing the admin credentials from an external secret is only supported on Neo4jEnterpriseCluster"))
+	}
+
 	return allErrs
 }
 
@@ -297,35 +329,12 @@ func (v *StandaloneValidator) validateImageUpgrade(oldStandalone, newStandalone
 	return allErrs
 }
 
-// validateStorageChanges validates storage changes (should be immutable)
-func (v *StandaloneValidator) validateStorageChanges(oldStandalone, newStandalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) field.ErrorList {
-	var allErrs field.ErrorList
-	storagePath := field.NewPath("spec", "storage")
-
-	// Storage class should not change
-	if oldStandalone.Spec.Storage.ClassName != newStandalone.Spec.Storage.ClassName {
-		allErrs = append(allErrs, field.Invalid(
-			storagePath.Child("className"),
-			newStandalone.Spec.Storage.ClassName,
-			"storage class name cannot be changed after creation",
-		))
-	}
-
-	// Storage size can only increase
-	if oldStandalone.Spec.Storage.Size != newStandalone.Spec.Storage.Size {
-		// Note: More sophisticated size comparison logic would be needed here
-		// For now, we'll allow any size change
-	}
-
-	return allErrs
-}
-
 // validateNeo4jVersion validates Neo4j version requirements
 func (v *StandaloneValidator) validateNeo4jVersion(tag string) []error {
 	var errs []error
 
-	version, err := neo4j.ParseVersion(tag)
-	if err != nil || !version.IsSupported() {
+	imageVersion, err := version.Parse(tag)
+	if err != nil || !imageVersion.IsSupported() {
 		errs = append(errs, fmt.Errorf("Neo4j version must be 5.26.x (last semver LTS) or 2025.01+ (CalVer), got: %s", tag))
 	}
 
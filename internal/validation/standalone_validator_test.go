@@ -134,6 +134,25 @@ func TestStandaloneValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErrs: 1,
 		},
+		{
+			name: "admin credentials from an external secret are rejected",
+			mutate: func(s *neo4jv1alpha1.Neo4jEnterpriseStandalone) {
+				s.Spec.Auth = &neo4jv1alpha1.AuthSpec{ExternalSecret: &neo4jv1alpha1.AdminExternalSecretSpec{}}
+			},
+			wantErrs: 1,
+			errField: "spec.auth.externalSecret",
+		},
+		{
+			name: "query limit enforcement is rejected",
+			mutate: func(s *neo4jv1alpha1.Neo4jEnterpriseStandalone) {
+				s.Spec.QueryMonitoring = &neo4jv1alpha1.QueryMonitoringSpec{
+					Enabled:     true,
+					Enforcement: &neo4jv1alpha1.QueryEnforcementSpec{MaxQueryDuration: "10m"},
+				}
+			},
+			wantErrs: 1,
+			errField: "spec.queryMonitoring.enforcement",
+		},
 	}
 
 	for _, tc := range cases {
@@ -27,6 +27,8 @@ import (
 const (
 	// CertManagerMode represents cert-manager TLS mode
 	CertManagerMode = "cert-manager"
+	// SecretTLSMode represents TLS with certificates from an existing Secret
+	SecretTLSMode = "secret"
 )
 
 // TLSValidator validates Neo4j TLS configuration
@@ -46,7 +48,7 @@ func (v *TLSValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) f
 	}
 
 	tlsPath := field.NewPath("spec", "tls")
-	validModes := []string{"cert-manager", "disabled"}
+	validModes := []string{"cert-manager", "secret", "disabled"}
 
 	if cluster.Spec.TLS.Mode != "" {
 		valid := false
@@ -128,6 +130,10 @@ func (v *TLSValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) f
 		}
 	}
 
+	allErrs = append(allErrs, validateSecretTLSMode(cluster.Spec.TLS, tlsPath)...)
+	allErrs = append(allErrs, validateSSLPolicies(cluster.Spec.TLS, tlsPath)...)
+	allErrs = append(allErrs, validateClusterMTLS(cluster.Spec.TLS, tlsPath)...)
+
 	// Validate External Secrets configuration
 	if cluster.Spec.TLS.ExternalSecrets != nil && cluster.Spec.TLS.ExternalSecrets.Enabled {
 		esPath := tlsPath.Child("externalSecrets")
@@ -202,3 +208,162 @@ func (v *TLSValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) f
 
 	return allErrs
 }
+
+// validateSecretTLSMode validates the certificate Secret of secret mode
+func validateSecretTLSMode(tls *neo4jv1alpha1.TLSSpec, tlsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if tls.Mode != SecretTLSMode {
+		return allErrs
+	}
+	if tls.CertificateSecret == "" {
+		allErrs = append(allErrs, field.Required(
+			tlsPath.Child("certificateSecret"),
+			"certificateSecret is required when TLS mode is 'secret'",
+		))
+	}
+	if tls.IssuerRef != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			tlsPath.Child("issuerRef"),
+			"issuerRef requires TLS mode cert-manager",
+		))
+	}
+
+	return allErrs
+}
+
+// validateClusterMTLS validates spec.tls.cluster. Mutual TLS needs
+// cert-manager to issue the server certificates, and the cluster SSL policy
+// must not loosen the client authentication it enforces.
+func validateClusterMTLS(tls *neo4jv1alpha1.TLSSpec, tlsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if tls.Cluster == nil || tls.Cluster.MTLS == "" {
+		return allErrs
+	}
+	mtlsPath := tlsPath.Child("cluster", "mTLS")
+	validMTLS := []string{"required", "disabled"}
+	if !containsStringItem(validMTLS, tls.Cluster.MTLS) {
+		allErrs = append(allErrs, field.NotSupported(mtlsPath, tls.Cluster.MTLS, validMTLS))
+		return allErrs
+	}
+	if tls.Cluster.MTLS != "required" {
+		return allErrs
+	}
+	if tls.Mode != "" && tls.Mode != CertManagerMode {
+		allErrs = append(allErrs, field.Forbidden(
+			mtlsPath,
+			"mTLS requires TLS mode cert-manager, which issues the server certificates",
+		))
+	}
+
+	if tls.Policies == nil || tls.Policies.Cluster == nil {
+		return allErrs
+	}
+	policy := tls.Policies.Cluster
+	policyPath := tlsPath.Child("policies", "cluster")
+	if policy.CertificateSecret != "" {
+		allErrs = append(allErrs, field.Forbidden(
+			policyPath.Child("certificateSecret"),
+			"the servers use certificates of their own with mTLS",
+		))
+	}
+	if policy.ClientAuth != "" && policy.ClientAuth != "REQUIRE" {
+		allErrs = append(allErrs, field.Invalid(
+			policyPath.Child("clientAuth"),
+			policy.ClientAuth,
+			"mTLS requires client_auth REQUIRE",
+		))
+	}
+	if policy.Trust == "TrustAll" {
+		allErrs = append(allErrs, field.Invalid(
+			policyPath.Child("trust"),
+			policy.Trust,
+			"mTLS verifies the servers against the CA of their issuer",
+		))
+	}
+
+	return allErrs
+}
+
+// validateSSLPolicies validates the per-connector SSL policies
+func validateSSLPolicies(tls *neo4jv1alpha1.TLSSpec, tlsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if tls.Policies == nil {
+		return allErrs
+	}
+
+	policiesPath := tlsPath.Child("policies")
+	if tls.Mode == "disabled" {
+		allErrs = append(allErrs, field.Forbidden(
+			policiesPath,
+			"SSL policies require TLS mode cert-manager or secret",
+		))
+		return allErrs
+	}
+
+	validClientAuth := []string{"NONE", "OPTIONAL", "REQUIRE"}
+	validTrust := []string{"TrustAll", "CA"}
+	validVersions := []string{"TLSv1.2", "TLSv1.3"}
+	scopes := []struct {
+		name   string
+		policy *neo4jv1alpha1.SSLPolicySpec
+	}{
+		{"bolt", tls.Policies.Bolt},
+		{"https", tls.Policies.HTTPS},
+		{"cluster", tls.Policies.Cluster},
+		{"backup", tls.Policies.Backup},
+	}
+	for _, scope := range scopes {
+		if scope.policy == nil {
+			continue
+		}
+		policyPath := policiesPath.Child(scope.name)
+
+		if scope.policy.IssuerRef != nil && scope.policy.CertificateSecret != "" {
+			allErrs = append(allErrs, field.Invalid(
+				policyPath,
+				scope.name,
+				"issuerRef and certificateSecret are mutually exclusive",
+			))
+		}
+		if scope.policy.IssuerRef != nil && tls.Mode == SecretTLSMode {
+			allErrs = append(allErrs, field.Forbidden(
+				policyPath.Child("issuerRef"),
+				"issuerRef requires TLS mode cert-manager",
+			))
+		}
+		if scope.policy.IssuerRef != nil && scope.policy.IssuerRef.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				policyPath.Child("issuerRef", "name"),
+				"issuer name must be specified",
+			))
+		}
+		if scope.policy.ClientAuth != "" && !containsStringItem(validClientAuth, scope.policy.ClientAuth) {
+			allErrs = append(allErrs, field.NotSupported(
+				policyPath.Child("clientAuth"),
+				scope.policy.ClientAuth,
+				validClientAuth,
+			))
+		}
+		if scope.policy.Trust != "" && !containsStringItem(validTrust, scope.policy.Trust) {
+			allErrs = append(allErrs, field.NotSupported(
+				policyPath.Child("trust"),
+				scope.policy.Trust,
+				validTrust,
+			))
+		}
+		for i, version := range scope.policy.TLSVersions {
+			if !containsStringItem(validVersions, version) {
+				allErrs = append(allErrs, field.NotSupported(
+					policyPath.Child("tlsVersions").Index(i),
+					version,
+					validVersions,
+				))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
@@ -526,6 +527,121 @@ func TestTLSValidator_ValidSecretStoreKinds(t *testing.T) {
 	}
 }
 
+func TestTLSValidator_SSLPolicies(t *testing.T) {
+	validator := NewTLSValidator()
+	cluster := func(mode string, policies *neo4jv1alpha1.SSLPolicies) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+		return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+			Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+				TLS: &neo4jv1alpha1.TLSSpec{
+					Mode:      mode,
+					IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "internal-ca", Kind: "ClusterIssuer"},
+					Policies:  policies,
+				},
+			},
+		}
+	}
+
+	valid := &neo4jv1alpha1.SSLPolicies{
+		Bolt:   &neo4jv1alpha1.SSLPolicySpec{IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "public-ca"}, TLSVersions: []string{"TLSv1.3"}},
+		Backup: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "backup-tls", ClientAuth: "REQUIRE"},
+	}
+	assert.Empty(t, validator.Validate(cluster("cert-manager", valid)))
+	assert.NotEmpty(t, validator.Validate(cluster("disabled", valid)), "policies need TLS enabled")
+
+	errs := validator.Validate(cluster("cert-manager", &neo4jv1alpha1.SSLPolicies{
+		HTTPS: &neo4jv1alpha1.SSLPolicySpec{
+			IssuerRef:         &neo4jv1alpha1.IssuerRef{Name: "public-ca"},
+			CertificateSecret: "https-tls",
+			ClientAuth:        "ALWAYS",
+			Trust:             "TRUST_ALL",
+			TLSVersions:       []string{"TLSv1.1"},
+		},
+	}))
+	assert.Len(t, errs, 4)
+}
+
+func TestTLSValidator_SecretMode(t *testing.T) {
+	validator := NewTLSValidator()
+	cluster := func(tls *neo4jv1alpha1.TLSSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+		return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+			Spec:       neo4jv1alpha1.Neo4jEnterpriseClusterSpec{TLS: tls},
+		}
+	}
+
+	assert.Empty(t, validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{
+		Mode:              "secret",
+		CertificateSecret: "neo4j-tls",
+		Policies: &neo4jv1alpha1.SSLPolicies{
+			Bolt: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "bolt-tls", ClientAuth: "REQUIRE"},
+		},
+	})))
+
+	errs := validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{Mode: "secret"}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.tls.certificateSecret", errs[0].Field)
+
+	// cert-manager issuers have nothing to issue for in secret mode
+	errs = validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{
+		Mode:              "secret",
+		CertificateSecret: "neo4j-tls",
+		IssuerRef:         &neo4jv1alpha1.IssuerRef{Name: "internal-ca"},
+		Policies: &neo4jv1alpha1.SSLPolicies{
+			HTTPS: &neo4jv1alpha1.SSLPolicySpec{IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "public-ca"}},
+		},
+	}))
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.tls.issuerRef", errs[0].Field)
+	assert.Equal(t, "spec.tls.policies.https.issuerRef", errs[1].Field)
+}
+
+func TestTLSValidator_ClusterMTLS(t *testing.T) {
+	validator := NewTLSValidator()
+	cluster := func(tls *neo4jv1alpha1.TLSSpec) *neo4jv1alpha1.Neo4jEnterpriseCluster {
+		return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+			Spec:       neo4jv1alpha1.Neo4jEnterpriseClusterSpec{TLS: tls},
+		}
+	}
+	required := &neo4jv1alpha1.ClusterTLSSpec{MTLS: "required"}
+
+	assert.Empty(t, validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{
+		Mode:      "cert-manager",
+		IssuerRef: &neo4jv1alpha1.IssuerRef{Name: "internal-ca"},
+		Cluster:   required,
+		Policies: &neo4jv1alpha1.SSLPolicies{
+			Cluster: &neo4jv1alpha1.SSLPolicySpec{ClientAuth: "REQUIRE", Trust: "CA"},
+		},
+	})))
+
+	// Secret mode has no issuer for the server certificates
+	errs := validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{
+		Mode:              "secret",
+		CertificateSecret: "neo4j-tls",
+		Cluster:           required,
+	}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.tls.cluster.mTLS", errs[0].Field)
+
+	// The cluster policy cannot loosen what mTLS enforces
+	errs = validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{
+		Mode:    "cert-manager",
+		Cluster: required,
+		Policies: &neo4jv1alpha1.SSLPolicies{
+			Cluster: &neo4jv1alpha1.SSLPolicySpec{CertificateSecret: "cluster-tls", ClientAuth: "OPTIONAL", Trust: "TrustAll"},
+		},
+	}))
+	require.Len(t, errs, 3)
+	assert.Equal(t, "spec.tls.policies.cluster.certificateSecret", errs[0].Field)
+	assert.Equal(t, "spec.tls.policies.cluster.clientAuth", errs[1].Field)
+	assert.Equal(t, "spec.tls.policies.cluster.trust", errs[2].Field)
+
+	errs = validator.Validate(cluster(&neo4jv1alpha1.TLSSpec{Mode: "cert-manager", Cluster: &neo4jv1alpha1.ClusterTLSSpec{MTLS: "optional"}}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.tls.cluster.mTLS", errs[0].Field)
+}
+
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
@@ -38,17 +38,54 @@ type ValidationResult struct {
 	Warnings []string
 }
 
+// UsesDeprecatedTopology reports whether a topology sets the deprecated
+// primaries or secondaries.
+func UsesDeprecatedTopology(topology *neo4jv1alpha1.TopologyConfiguration) bool {
+	return topology.Primaries != 0 || topology.Secondaries != 0
+}
+
+// ConvertDeprecatedTopology moves the deprecated primaries and secondaries of
+// a topology into servers and clears them. It reports whether the topology
+// changed, and returns an error without changing it when servers is set to a
+// different number.
+func ConvertDeprecatedTopology(topology *neo4jv1alpha1.TopologyConfiguration) (bool, error) {
+	if !UsesDeprecatedTopology(topology) {
+		return false, nil
+	}
+	servers := topology.Primaries + topology.Secondaries
+	if topology.Servers != 0 && topology.Servers != servers {
+		return false, fmt.Errorf("servers (%d) conflicts with the deprecated primaries (%d) + secondaries (%d)",
+			topology.Servers, topology.Primaries, topology.Secondaries)
+	}
+	topology.Servers = servers
+	topology.Primaries = 0
+	topology.Secondaries = 0
+	return true, nil
+}
+
 // Validate validates the topology configuration
 func (v *TopologyValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) field.ErrorList {
 	var allErrs field.ErrorList
 	topologyPath := field.NewPath("spec", "topology")
 
+	// Validate the deprecated model against servers, then validate the
+	// number of servers it converts to
+	topology := cluster.Spec.Topology
+	if _, err := ConvertDeprecatedTopology(&topology); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			topologyPath.Child("servers"),
+			cluster.Spec.Topology.Servers,
+			err.Error()+". Remove primaries and secondaries, which are deprecated",
+		))
+		topology = cluster.Spec.Topology
+	}
+
 	// Validate servers - enforce minimum clustering requirements
 	// Neo4j clusters require at least 2 servers
-	if cluster.Spec.Topology.Servers < 2 {
+	if topology.Servers < 2 {
 		allErrs = append(allErrs, field.Invalid(
 			topologyPath.Child("servers"),
-			cluster.Spec.Topology.Servers,
+			topology.Servers,
 			"servers must be at least 2 for clustering. For single-node deployments, use Neo4jEnterpriseStandalone instead",
 		))
 	}
@@ -65,6 +102,50 @@ func (v *TopologyValidator) Validate(cluster *neo4jv1alpha1.Neo4jEnterpriseClust
 		}
 	}
 
+	// Zone spread needs a server in every zone it spans
+	if spread := cluster.Spec.Topology.ZoneSpread; spread != nil && spread.Enabled {
+		minZones := spread.MinZones
+		if minZones == 0 {
+			minZones = 3
+		}
+		if topology.Servers < minZones {
+			allErrs = append(allErrs, field.Invalid(
+				topologyPath.Child("zoneSpread", "minZones"),
+				minZones,
+				fmt.Sprintf("spreading across %d zones requires at least %d servers, got %d", minZones, minZones, topology.Servers),
+			))
+		}
+		if zones := len(cluster.Spec.Topology.AvailabilityZones); zones > 0 && int32(zones) < minZones {
+			allErrs = append(allErrs, field.Invalid(
+				topologyPath.Child("availabilityZones"),
+				cluster.Spec.Topology.AvailabilityZones,
+				fmt.Sprintf("zoneSpread.minZones is %d but only %d availability zones are listed", minZones, zones),
+			))
+		}
+	}
+
+	// Server groups name their StatefulSet, which must not clash with the
+	// server and backup StatefulSets or another group
+	groupNames := map[string]bool{}
+	for i, group := range cluster.Spec.Topology.ServerGroups {
+		namePath := topologyPath.Child("serverGroups").Index(i).Child("name")
+		switch {
+		case group.Name == "server" || group.Name == "backup":
+			allErrs = append(allErrs, field.Invalid(namePath, group.Name,
+				"server group names \"server\" and \"backup\" are reserved"))
+		case groupNames[group.Name]:
+			allErrs = append(allErrs, field.Duplicate(namePath, group.Name))
+		}
+		groupNames[group.Name] = true
+		if group.Servers < 1 {
+			allErrs = append(allErrs, field.Invalid(
+				topologyPath.Child("serverGroups").Index(i).Child("servers"),
+				group.Servers,
+				"a server group needs at least 1 server",
+			))
+		}
+	}
+
 	return allErrs
 }
 
@@ -75,6 +156,8 @@ func (v *TopologyValidator) ValidateWithWarnings(cluster *neo4jv1alpha1.Neo4jEnt
 		Warnings: []string{},
 	}
 
+	result.Warnings = append(result.Warnings, DeprecationWarnings(cluster)...)
+
 	// Check for even number of servers (generate warning for cluster consensus)
 	if cluster.Spec.Topology.Servers > 0 && cluster.Spec.Topology.Servers%2 == 0 {
 		result.Warnings = append(result.Warnings,
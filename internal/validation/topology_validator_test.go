@@ -88,6 +88,127 @@ func TestTopologyValidator_Validate(t *testing.T) {
 			wantErrorsLen: 1,
 			wantErrorMsg:  "servers must be at least 2",
 		},
+		{
+			name: "valid deprecated primaries and secondaries",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Primaries:   3,
+						Secondaries: 2,
+					},
+				},
+			},
+			wantErrorsLen: 0,
+		},
+		{
+			name: "deprecated topology below minimum",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Primaries: 1,
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "servers must be at least 2",
+		},
+		{
+			name: "servers conflicting with deprecated topology",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers:     3,
+						Primaries:   3,
+						Secondaries: 2,
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "conflicts with the deprecated primaries (3) + secondaries (2)",
+		},
+		{
+			name: "zone spread across three zones",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers:    3,
+						ZoneSpread: &neo4jv1alpha1.ZoneSpreadSpec{Enabled: true},
+					},
+				},
+			},
+			wantErrorsLen: 0,
+		},
+		{
+			name: "zone spread with fewer servers than zones",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers:    2,
+						ZoneSpread: &neo4jv1alpha1.ZoneSpreadSpec{Enabled: true, MinZones: 3},
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "requires at least 3 servers",
+		},
+		{
+			name: "zone spread with too few availability zones listed",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers:           3,
+						AvailabilityZones: []string{"zone-a", "zone-b"},
+						ZoneSpread:        &neo4jv1alpha1.ZoneSpreadSpec{Enabled: true},
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "only 2 availability zones are listed",
+		},
+		{
+			name: "valid server groups",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers: 3,
+						ServerGroups: []neo4jv1alpha1.ServerGroupSpec{
+							{Name: "analytics", Servers: 2, ModeConstraint: "SECONDARY"},
+							{Name: "reporting", Servers: 1},
+						},
+					},
+				},
+			},
+			wantErrorsLen: 0,
+		},
+		{
+			name: "server group with a reserved name",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers:      3,
+						ServerGroups: []neo4jv1alpha1.ServerGroupSpec{{Name: "backup", Servers: 1}},
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "are reserved",
+		},
+		{
+			name: "duplicate server group names",
+			cluster: &neo4jv1alpha1.Neo4jEnterpriseCluster{
+				Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+					Topology: neo4jv1alpha1.TopologyConfiguration{
+						Servers: 3,
+						ServerGroups: []neo4jv1alpha1.ServerGroupSpec{
+							{Name: "analytics", Servers: 1},
+							{Name: "analytics", Servers: 2},
+						},
+					},
+				},
+			},
+			wantErrorsLen: 1,
+			wantErrorMsg:  "Duplicate value",
+		},
 	}
 
 	for _, tt := range tests {
@@ -135,6 +256,51 @@ func TestTopologyValidator_ValidateDefaults(t *testing.T) {
 	}
 }
 
+func TestConvertDeprecatedTopology(t *testing.T) {
+	topology := neo4jv1alpha1.TopologyConfiguration{Primaries: 3, Secondaries: 2}
+	changed, err := ConvertDeprecatedTopology(&topology)
+	if err != nil || !changed {
+		t.Fatalf("ConvertDeprecatedTopology() = %v, %v, want true, nil", changed, err)
+	}
+	if topology.Servers != 5 || topology.Primaries != 0 || topology.Secondaries != 0 {
+		t.Errorf("Expected servers 5 and cleared deprecated fields, got %+v", topology)
+	}
+
+	// Matching servers are accepted, conflicting ones leave the topology alone
+	topology = neo4jv1alpha1.TopologyConfiguration{Servers: 4, Primaries: 3, Secondaries: 1}
+	if changed, err := ConvertDeprecatedTopology(&topology); err != nil || !changed {
+		t.Errorf("ConvertDeprecatedTopology() = %v, %v, want true, nil", changed, err)
+	}
+	topology = neo4jv1alpha1.TopologyConfiguration{Servers: 3, Primaries: 3, Secondaries: 1}
+	if _, err := ConvertDeprecatedTopology(&topology); err == nil || topology.Primaries != 3 {
+		t.Errorf("Expected a conflict error and unchanged topology, got %v, %+v", err, topology)
+	}
+
+	topology = neo4jv1alpha1.TopologyConfiguration{Servers: 3}
+	if changed, _ := ConvertDeprecatedTopology(&topology); changed {
+		t.Error("Expected a servers topology to be left unchanged")
+	}
+}
+
+func TestTopologyValidator_DeprecationWarning(t *testing.T) {
+	cluster := &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Topology: neo4jv1alpha1.TopologyConfiguration{Primaries: 2, Secondaries: 1},
+		},
+	}
+
+	result := NewTopologyValidator().ValidateWithWarnings(cluster)
+	found := false
+	for _, warning := range result.Warnings {
+		if contains(warning, "converted to servers: 3") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a deprecation warning, got %v", result.Warnings)
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || (len(s) > len(substr) &&
 		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+)
+
+// AllowUnsafeChangesAnnotation, set to "true", admits the changes the
+// UnsafeChangeValidator rejects
+const AllowUnsafeChangesAnnotation = "neo4j.com/allow-unsafe-changes"
+
+// UnsafeChangeValidator rejects spec changes the operator cannot reconcile
+// safely: existing volumes and the data on them are not changed by them
+type UnsafeChangeValidator struct{}
+
+// NewUnsafeChangeValidator creates a new unsafe change validator
+func NewUnsafeChangeValidator() *UnsafeChangeValidator {
+	return &UnsafeChangeValidator{}
+}
+
+// unsafeChangeSpec holds the fields clusters and standalones share that are
+// checked for unsafe changes
+type unsafeChangeSpec struct {
+	image   neo4jv1alpha1.ImageSpec
+	storage neo4jv1alpha1.StorageSpec
+	auth    *neo4jv1alpha1.AuthSpec
+	// started reports whether the servers have been started, and so have
+	// initialized their data
+	started bool
+}
+
+// ValidateClusterUpdate validates a change to a cluster
+func (v *UnsafeChangeValidator) ValidateClusterUpdate(oldCluster, newCluster *neo4jv1alpha1.Neo4jEnterpriseCluster) field.ErrorList {
+	if AllowsUnsafeChanges(newCluster.Annotations) {
+		return nil
+	}
+	return v.validate(
+		unsafeChangeSpec{oldCluster.Spec.Image, oldCluster.Spec.Storage, oldCluster.Spec.Auth, hasStarted(oldCluster.Status.Phase)},
+		unsafeChangeSpec{image: newCluster.Spec.Image, storage: newCluster.Spec.Storage, auth: newCluster.Spec.Auth})
+}
+
+// ValidateStandaloneUpdate validates a change to a standalone
+func (v *UnsafeChangeValidator) ValidateStandaloneUpdate(oldStandalone, newStandalone *neo4jv1alpha1.Neo4jEnterpriseStandalone) field.ErrorList {
+	if AllowsUnsafeChanges(newStandalone.Annotations) {
+		return nil
+	}
+	return v.validate(
+		unsafeChangeSpec{oldStandalone.Spec.Image, oldStandalone.Spec.Storage, oldStandalone.Spec.Auth, hasStarted(oldStandalone.Status.Phase)},
+		unsafeChangeSpec{image: newStandalone.Spec.Image, storage: newStandalone.Spec.Storage, auth: newStandalone.Spec.Auth})
+}
+
+// AllowsUnsafeChanges reports whether the annotations admit unsafe changes
+func AllowsUnsafeChanges(annotations map[string]string) bool {
+	return annotations[AllowUnsafeChangesAnnotation] == "true"
+}
+
+func (v *UnsafeChangeValidator) validate(oldSpec, newSpec unsafeChangeSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	hint := fmt.Sprintf("; set the annotation %s=true to apply it anyway", AllowUnsafeChangesAnnotation)
+
+	// The class of the volumes of existing servers is not changed, only
+	// the volumes of new servers get the new one
+	if oldSpec.storage.ClassName != "" && newSpec.storage.ClassName != oldSpec.storage.ClassName {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("storage", "className"),
+			fmt.Sprintf("changing the storage class from %s to %s does not move existing volumes%s",
+				oldSpec.storage.ClassName, newSpec.storage.ClassName, hint)))
+	}
+
+	oldSize, oldErr := resource.ParseQuantity(oldSpec.storage.Size)
+	newSize, newErr := resource.ParseQuantity(newSpec.storage.Size)
+	if oldErr == nil && newErr == nil && newSize.Cmp(oldSize) < 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("storage", "size"),
+			fmt.Sprintf("volumes cannot shrink from %s to %s%s", oldSpec.storage.Size, newSpec.storage.Size, hint)))
+	}
+
+	// The admin password is set from the admin Secret when the servers
+	// first start; another Secret does not change it
+	if oldSpec.started && adminSecretName(oldSpec.auth) != adminSecretName(newSpec.auth) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("auth", "adminSecret"),
+			fmt.Sprintf("the servers keep the admin password of %s, which they were started with; change the password in that Secret instead%s",
+				adminSecretName(oldSpec.auth), hint)))
+	}
+
+	if isEnterpriseTag(oldSpec.image.Tag) && newSpec.image.Tag != "" && !isEnterpriseTag(newSpec.image.Tag) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("image", "tag"),
+			fmt.Sprintf("%s is not an enterprise image: the community edition cannot read the data of %s%s",
+				newSpec.image.Tag, oldSpec.image.Tag, hint)))
+	}
+
+	return allErrs
+}
+
+// hasStarted reports whether the operator has started the servers of a
+// deployment in the given phase
+func hasStarted(phase string) bool {
+	return phase != "" && phase != "Pending"
+}
+
+// adminSecretName returns the admin Secret of a deployment
+func adminSecretName(auth *neo4jv1alpha1.AuthSpec) string {
+	if auth != nil && auth.AdminSecret != "" {
+		return auth.AdminSecret
+	}
+	return resources.DefaultAdminSecret
+}
+
+// isEnterpriseTag reports whether an image tag names the enterprise edition
+func isEnterpriseTag(tag string) bool {
+	return strings.Contains(tag, "enterprise")
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func unsafeChangeTestCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Storage:  neo4jv1alpha1.StorageSpec{ClassName: "fast-ssd", Size: "100Gi"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			Auth:     &neo4jv1alpha1.AuthSpec{AdminSecret: "prod-admin"},
+		},
+		Status: neo4jv1alpha1.Neo4jEnterpriseClusterStatus{Phase: "Ready"},
+	}
+}
+
+func TestUnsafeChangeValidator_ValidateClusterUpdate(t *testing.T) {
+	v := NewUnsafeChangeValidator()
+
+	cases := []struct {
+		name     string
+		mutate   func(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+		errField string
+	}{
+		{
+			name:   "unchanged",
+			mutate: func(_ *neo4jv1alpha1.Neo4jEnterpriseCluster) {},
+		},
+		{
+			name:   "growing storage",
+			mutate: func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Storage.Size = "0.2Ti" },
+		},
+		{
+			name:     "shrinking storage",
+			mutate:   func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Storage.Size = "50Gi" },
+			errField: "spec.storage.size",
+		},
+		{
+			name:     "storage class",
+			mutate:   func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Storage.ClassName = "standard" },
+			errField: "spec.storage.className",
+		},
+		{
+			name:     "admin secret",
+			mutate:   func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Auth.AdminSecret = "" },
+			errField: "spec.auth.adminSecret",
+		},
+		{
+			name:     "community edition",
+			mutate:   func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Image.Tag = "5.26.1" },
+			errField: "spec.image.tag",
+		},
+		{
+			name:   "enterprise upgrade",
+			mutate: func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) { c.Spec.Image.Tag = "2025.01.0-enterprise" },
+		},
+		{
+			name: "allowed by annotation",
+			mutate: func(c *neo4jv1alpha1.Neo4jEnterpriseCluster) {
+				c.Annotations = map[string]string{AllowUnsafeChangesAnnotation: "true"}
+				c.Spec.Storage = neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCluster := unsafeChangeTestCluster()
+			newCluster := oldCluster.DeepCopy()
+			tc.mutate(newCluster)
+
+			errs := v.ValidateClusterUpdate(oldCluster, newCluster)
+			if tc.errField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tc.errField {
+				t.Errorf("expected one error on %s, got: %v", tc.errField, errs)
+			}
+		})
+	}
+
+	t.Run("admin secret before the servers start", func(t *testing.T) {
+		oldCluster := unsafeChangeTestCluster()
+		oldCluster.Status.Phase = "Pending"
+		newCluster := oldCluster.DeepCopy()
+		newCluster.Spec.Auth.AdminSecret = "other-admin"
+		if errs := v.ValidateClusterUpdate(oldCluster, newCluster); len(errs) != 0 {
+			t.Errorf("expected no errors, got: %v", errs)
+		}
+	})
+}
+
+func TestUnsafeChangeValidator_ValidateStandaloneUpdate(t *testing.T) {
+	v := NewUnsafeChangeValidator()
+
+	oldStandalone := validStandalone()
+	oldStandalone.Status.Phase = "Ready"
+	newStandalone := oldStandalone.DeepCopy()
+	newStandalone.Spec.Storage.Size = "512Mi"
+	newStandalone.Spec.Auth = &neo4jv1alpha1.AuthSpec{AdminSecret: "dev-admin"}
+	if errs := v.ValidateStandaloneUpdate(oldStandalone, newStandalone); len(errs) != 2 {
+		t.Errorf("expected errors on storage size and admin secret, got: %v", errs)
+	}
+
+	newStandalone.Annotations = map[string]string{AllowUnsafeChangesAnnotation: "true"}
+	if errs := v.ValidateStandaloneUpdate(oldStandalone, newStandalone); len(errs) != 0 {
+		t.Errorf("expected no errors with %s, got: %v", AllowUnsafeChangesAnnotation, errs)
+	}
+}
@@ -98,6 +98,22 @@ func TestValidateUpgradeStrategy(t *testing.T) {
 			name:     "valid Recreate strategy",
 			strategy: &neo4jv1alpha1.UpgradeStrategySpec{Strategy: "Recreate"}, wantErrs: 0,
 		},
+		{
+			name: "valid Canary strategy",
+			strategy: &neo4jv1alpha1.UpgradeStrategySpec{
+				Strategy: "Canary",
+				Canary:   &neo4jv1alpha1.CanaryUpgradeSpec{SoakDuration: "30m"},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "invalid canary soakDuration",
+			strategy: &neo4jv1alpha1.UpgradeStrategySpec{
+				Strategy: "Canary",
+				Canary:   &neo4jv1alpha1.CanaryUpgradeSpec{SoakDuration: "a while"},
+			},
+			wantErrs: 1,
+		},
 		{
 			name:     "unknown strategy Blue-Green",
 			strategy: &neo4jv1alpha1.UpgradeStrategySpec{Strategy: "Blue-Green"}, wantErrs: 1,
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+// SupportsMetadataOption checks if version supports --include-metadata option
+func (v *Version) SupportsMetadataOption() bool {
+	// All supported versions (5.26+ and 2025.x) support metadata option
+	return v.IsSupported()
+}
+
+// SupportsCypherLanguageVersion checks if version supports DEFAULT LANGUAGE CYPHER
+func (v *Version) SupportsCypherLanguageVersion() bool {
+	// Only CalVer versions (2025.x) support Cypher language version
+	return v.IsCalver
+}
+
+// SupportsRemoteAddressResolution checks if version supports --remote-address-resolution flag (2025.09+).
+func (v *Version) SupportsRemoteAddressResolution() bool {
+	return v.IsCalver && v.AtLeast(2025, 9)
+}
+
+// SupportsPreferDiffAsParent checks if version supports --prefer-diff-as-parent flag (2025.04+).
+func (v *Version) SupportsPreferDiffAsParent() bool {
+	return v.IsCalver && v.AtLeast(2025, 4)
+}
+
+// SupportsParallelDownload checks if version supports --parallel-download flag (2025.11+).
+func (v *Version) SupportsParallelDownload() bool {
+	return v.IsCalver && v.AtLeast(2025, 11)
+}
+
+// SupportsSkipRecovery checks if version supports --skip-recovery flag (2025.11+).
+func (v *Version) SupportsSkipRecovery() bool {
+	return v.SupportsParallelDownload()
+}
+
+// SupportsAdvancedBackupFlags checks if version supports flags like --parallel-download and --skip-recovery.
+// Deprecated: use SupportsParallelDownload instead.
+func (v *Version) SupportsAdvancedBackupFlags() bool {
+	return v.SupportsParallelDownload()
+}
+
+// SupportsSourceDatabaseFilter checks if version supports --source-database in restore
+func (v *Version) SupportsSourceDatabaseFilter() bool {
+	// Available from 2025.02+
+	return v.IsCalver && v.AtLeast(2025, 2)
+}
+
+// SupportsPropertySharding checks if version supports property sharded
+// databases (2025.12+)
+func (v *Version) SupportsPropertySharding() bool {
+	return v.IsCalver && v.AtLeast(2025, 12)
+}
+
+// DiscoveryEndpointsSetting returns the name of the setting listing the
+// cluster discovery endpoints, renamed from dbms.cluster.discovery.v2.endpoints
+// in CalVer releases
+func (v *Version) DiscoveryEndpointsSetting() string {
+	return v.SettingName("dbms.cluster.discovery.v2.endpoints")
+}
+
+// RequiresDiscoveryVersion reports whether dbms.cluster.discovery.version has
+// to be set to V2_ONLY. V2 is opt-in on 5.26.x and the only protocol, without
+// the setting, on CalVer releases.
+func (v *Version) RequiresDiscoveryVersion() bool {
+	return !v.IsCalver
+}
+
+// KubernetesDiscoveryPortSetting returns the name of the Kubernetes discovery
+// service port setting
+func (v *Version) KubernetesDiscoveryPortSetting() string {
+	if v.IsCalver {
+		// CalVer versions (2025.x) use the new parameter without v2
+		return "dbms.kubernetes.discovery.service_port_name"
+	}
+	// SemVer versions (5.x) use the v2 parameter
+	return "dbms.kubernetes.discovery.v2.service_port_name"
+}
+
+// renamedSettings maps 5.26.x settings to the names CalVer releases use
+var renamedSettings = map[string]string{
+	"dbms.cluster.discovery.v2.endpoints":            "dbms.cluster.endpoints",
+	"dbms.kubernetes.discovery.v2.service_port_name": "dbms.kubernetes.discovery.service_port_name",
+}
+
+// removedSettings lists 5.26.x settings CalVer releases no longer accept,
+// with the reason
+var removedSettings = map[string]string{
+	"dbms.cluster.discovery.version": "V2 is the only discovery protocol",
+}
+
+// SettingName returns the name a 5.26.x setting has in this version
+func (v *Version) SettingName(name string) string {
+	if renamed, ok := renamedSettings[name]; ok && v.IsCalver {
+		return renamed
+	}
+	return name
+}
+
+// RemovedSetting reports whether this version no longer accepts a setting,
+// with the reason and the setting replacing it, if any
+func (v *Version) RemovedSetting(name string) (string, bool) {
+	if !v.IsCalver {
+		return "", false
+	}
+	if renamed, ok := renamedSettings[name]; ok {
+		return "renamed to " + renamed, true
+	}
+	reason, ok := removedSettings[name]
+	return reason, ok
+}
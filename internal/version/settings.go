@@ -0,0 +1,264 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// settingKind is the type of value a setting accepts
+type settingKind int
+
+const (
+	kindBool settingKind = iota
+	kindInt
+	kindDuration
+	kindByteSize
+	kindEnum
+)
+
+// setting describes the values one neo4j.conf setting accepts
+type setting struct {
+	kind settingKind
+	// min is the smallest value an int setting accepts
+	min int
+	// max is the largest value an int setting accepts, zero for no limit
+	max int
+	// values lists the values an enum setting accepts
+	values []string
+	// supported reports whether a version has the setting, nil for all
+	supported func(*Version) bool
+}
+
+// settingCatalog lists the settings whose values are checked before they
+// reach neo4j.conf. It is not exhaustive: settings missing from it are only
+// checked for their namespace.
+var settingCatalog = map[string]setting{
+	// Memory
+	"db.memory.pagecache.warmup.enable": {kind: kindBool},
+	"db.memory.transaction.max":         {kind: kindByteSize},
+	"db.memory.transaction.total.max":   {kind: kindByteSize},
+	"dbms.memory.transaction.total.max": {kind: kindByteSize},
+	"server.memory.heap.initial_size":   {kind: kindByteSize},
+	"server.memory.heap.max_size":       {kind: kindByteSize},
+	"server.memory.pagecache.size":      {kind: kindByteSize},
+
+	// Transactions and checkpoints
+	"db.checkpoint.interval.time":                   {kind: kindDuration},
+	"db.checkpoint.iops.limit":                      {kind: kindInt, min: -1},
+	"db.lock.acquisition.timeout":                   {kind: kindDuration},
+	"db.shutdown_transaction_end_timeout":           {kind: kindDuration},
+	"db.transaction.bookmark_ready_timeout":         {kind: kindDuration},
+	"db.transaction.concurrent.maximum":             {kind: kindInt},
+	"db.transaction.sampling.percentage":            {kind: kindInt, min: 1, max: 100},
+	"db.transaction.timeout":                        {kind: kindDuration},
+	"db.transaction.tracing.level":                  {kind: kindEnum, values: []string{"DISABLED", "SAMPLE", "ALL"}},
+	"db.tx_log.preallocate":                         {kind: kindBool},
+	"db.tx_log.rotation.size":                       {kind: kindByteSize},
+	"dbms.security.allow_csv_import_from_file_urls": {kind: kindBool},
+
+	// Query logging
+	"db.logs.query.early_raw_logging_enabled": {kind: kindBool},
+	"db.logs.query.enabled":                   {kind: kindEnum, values: []string{"OFF", "INFO", "VERBOSE"}},
+	"db.logs.query.max_parameter_length":      {kind: kindInt},
+	"db.logs.query.obfuscate_literals":        {kind: kindBool},
+	"db.logs.query.parameter_logging_enabled": {kind: kindBool},
+	"db.logs.query.plan_description_enabled":  {kind: kindBool},
+	"db.logs.query.threshold":                 {kind: kindDuration},
+	"db.logs.query.transaction.threshold":     {kind: kindDuration},
+	"db.track_query_cpu_time":                 {kind: kindBool},
+
+	// Cypher and storage
+	"db.format": {kind: kindEnum, values: []string{"aligned", "standard", "high_limit", "block"}},
+	"db.query.default_language": {
+		kind:      kindEnum,
+		values:    []string{"CYPHER_5", "CYPHER_25"},
+		supported: (*Version).SupportsCypherLanguageVersion,
+	},
+
+	// Connectors
+	"server.bolt.connection_keep_alive":              {kind: kindDuration},
+	"server.bolt.enabled":                            {kind: kindBool},
+	"server.bolt.thread_pool_keep_alive":             {kind: kindDuration},
+	"server.bolt.thread_pool_max_size":               {kind: kindInt, min: 1},
+	"server.bolt.thread_pool_min_size":               {kind: kindInt, min: 1},
+	"server.http.enabled":                            {kind: kindBool},
+	"server.https.enabled":                           {kind: kindBool},
+	"dbms.routing.enabled":                           {kind: kindBool},
+	"dbms.routing.driver.connection.connect_timeout": {kind: kindDuration},
+
+	// Security
+	"dbms.security.auth_cache_ttl":               {kind: kindDuration},
+	"dbms.security.auth_enabled":                 {kind: kindBool},
+	"dbms.security.auth_max_failed_attempts":     {kind: kindInt},
+	"dbms.security.auth_minimum_password_length": {kind: kindInt, min: 1},
+
+	// Clustering
+	"initial.server.mode_constraint":      {kind: kindEnum, values: []string{"NONE", "PRIMARY", "SECONDARY"}},
+	"server.cluster.system_database_mode": {kind: kindEnum, values: []string{"PRIMARY", "SECONDARY"}},
+
+	// Metrics and server behaviour
+	"server.config.strict_validation.enabled": {kind: kindBool},
+	"server.metrics.csv.enabled":              {kind: kindBool},
+	"server.metrics.enabled":                  {kind: kindBool},
+	"server.metrics.jmx.enabled":              {kind: kindBool},
+	"server.metrics.prometheus.enabled":       {kind: kindBool},
+	"server.panic.shutdown_on_panic":          {kind: kindBool},
+}
+
+// legacySettings maps 4.x settings to the names Neo4j 5 and later use
+var legacySettings = map[string]string{
+	"dbms.connector.bolt.advertised_address": "server.bolt.advertised_address",
+	"dbms.connector.bolt.enabled":            "server.bolt.enabled",
+	"dbms.connector.bolt.listen_address":     "server.bolt.listen_address",
+	"dbms.connector.http.enabled":            "server.http.enabled",
+	"dbms.connector.https.enabled":           "server.https.enabled",
+	"dbms.lock.acquisition.timeout":          "db.lock.acquisition.timeout",
+	"dbms.logs.query.enabled":                "db.logs.query.enabled",
+	"dbms.logs.query.threshold":              "db.logs.query.threshold",
+	"dbms.memory.heap.initial_size":          "server.memory.heap.initial_size",
+	"dbms.memory.heap.max_size":              "server.memory.heap.max_size",
+	"dbms.memory.pagecache.size":             "server.memory.pagecache.size",
+	"dbms.transaction.timeout":               "db.transaction.timeout",
+	"dbms.tx_log.rotation.retention_policy":  "db.tx_log.rotation.retention_policy",
+	"dbms.tx_log.rotation.size":              "db.tx_log.rotation.size",
+	"metrics.enabled":                        "server.metrics.enabled",
+}
+
+// settingNamespaces are the prefixes of the settings Neo4j and its bundled
+// plugins declare
+var settingNamespaces = []string{
+	"apoc.", "bloom.", "browser.", "db.", "dbms.", "gds.", "genai.", "initial.", "internal.", "server.",
+}
+
+var (
+	byteSizePattern = regexp.MustCompile(`^\d+(\.\d+)?\s*([kKmMgGtT]i?[bB]?|[bB])?$`)
+	durationPattern = regexp.MustCompile(`^(\d+\s*(ns|us|μs|ms|s|m|h|d)\s*)+$|^\d+$`)
+)
+
+// SettingProblem is a setting Neo4j would refuse or warn about
+type SettingProblem struct {
+	Setting string
+	Message string
+	// Invalid is set when Neo4j would refuse to start with the setting
+	Invalid bool
+}
+
+// String formats the problem as "setting: message"
+func (p SettingProblem) String() string {
+	return p.Setting + ": " + p.Message
+}
+
+// CheckSettings checks user settings against the settings catalog for a
+// version, sorted by setting name. A nil version skips the checks that
+// depend on it.
+func CheckSettings(config map[string]string, v *Version) []SettingProblem {
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []SettingProblem
+	for _, name := range names {
+		if problem := checkSetting(name, config[name], v); problem != nil {
+			problems = append(problems, *problem)
+		}
+	}
+	return problems
+}
+
+// checkSetting returns the problem with one setting, if any
+func checkSetting(name, value string, v *Version) *SettingProblem {
+	invalid := func(message string) *SettingProblem {
+		return &SettingProblem{Setting: name, Message: message, Invalid: true}
+	}
+	warning := func(message string) *SettingProblem {
+		return &SettingProblem{Setting: name, Message: message}
+	}
+
+	if v != nil {
+		if reason, removed := v.RemovedSetting(name); removed {
+			return invalid(fmt.Sprintf("not supported by Neo4j %s: %s", v.Raw, reason))
+		}
+	}
+	if renamed, ok := legacySettings[name]; ok {
+		return warning("Neo4j 4.x setting, renamed to " + renamed)
+	}
+	if strings.HasPrefix(name, "causal_clustering.") {
+		return warning("Neo4j 4.x setting, replaced by the dbms.cluster.* and server.cluster.* settings")
+	}
+
+	s, known := settingCatalog[name]
+	if !known {
+		for _, namespace := range settingNamespaces {
+			if strings.HasPrefix(name, namespace) {
+				return nil
+			}
+		}
+		return warning("unknown setting")
+	}
+	if v != nil && s.supported != nil && !s.supported(v) {
+		return invalid(fmt.Sprintf("not supported by Neo4j %s", v.Raw))
+	}
+	if err := s.check(value); err != nil {
+		return invalid(err.Error())
+	}
+	return nil
+}
+
+// check reports whether the setting accepts a value
+func (s setting) check(value string) error {
+	value = strings.TrimSpace(value)
+	switch s.kind {
+	case kindBool:
+		if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+	case kindInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		if n < s.min || (s.max != 0 && n > s.max) {
+			if s.max != 0 {
+				return fmt.Errorf("%d is outside %d-%d", n, s.min, s.max)
+			}
+			return fmt.Errorf("%d is below the minimum of %d", n, s.min)
+		}
+	case kindDuration:
+		if !durationPattern.MatchString(value) {
+			return fmt.Errorf("invalid duration %q, use a number with a unit such as 500ms, 30s or 5m", value)
+		}
+	case kindByteSize:
+		if !byteSizePattern.MatchString(value) {
+			return fmt.Errorf("invalid byte size %q, use a number with a unit such as 512m or 4g", value)
+		}
+	case kindEnum:
+		for _, allowed := range s.values {
+			if strings.EqualFold(value, allowed) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q, must be one of %s", value, strings.Join(s.values, ", "))
+	}
+	return nil
+}
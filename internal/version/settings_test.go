@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"testing"
+)
+
+func TestCheckSettings(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		setting string
+		value   string
+		message string
+		invalid bool
+	}{
+		// Valid values
+		{"duration", "5.26.0", "db.transaction.timeout", "30s", "", false},
+		{"duration without unit", "5.26.0", "db.lock.acquisition.timeout", "10", "", false},
+		{"byte size", "5.26.0", "server.memory.heap.max_size", "4g", "", false},
+		{"byte size with suffix", "5.26.0", "server.memory.pagecache.size", "512MiB", "", false},
+		{"bool", "5.26.0", "server.metrics.enabled", "TRUE", "", false},
+		{"enum", "5.26.0", "db.logs.query.enabled", "info", "", false},
+		{"int in range", "5.26.0", "db.transaction.sampling.percentage", "50", "", false},
+		{"setting outside the catalog", "5.26.0", "dbms.security.procedures.unrestricted", "apoc.*", "", false},
+		{"plugin setting", "5.26.0", "apoc.export.file.enabled", "true", "", false},
+		{"cypher 25 on calver", "2025.06.0", "db.query.default_language", "CYPHER_25", "", false},
+
+		// Values Neo4j refuses
+		{"malformed duration", "5.26.0", "db.transaction.timeout", "ten seconds",
+			`invalid duration "ten seconds", use a number with a unit such as 500ms, 30s or 5m`, true},
+		{"malformed byte size", "5.26.0", "server.memory.heap.max_size", "4 gigs",
+			`invalid byte size "4 gigs", use a number with a unit such as 512m or 4g`, true},
+		{"malformed bool", "5.26.0", "server.bolt.enabled", "yes", `invalid boolean "yes"`, true},
+		{"int out of range", "5.26.0", "db.transaction.sampling.percentage", "150", "150 is outside 1-100", true},
+		{"int below minimum", "5.26.0", "dbms.security.auth_minimum_password_length", "0", "0 is below the minimum of 1", true},
+		{"unknown enum value", "5.26.0", "db.logs.query.enabled", "ON",
+			`invalid value "ON", must be one of OFF, INFO, VERBOSE`, true},
+		{"setting missing in 5.26", "5.26.0", "db.query.default_language", "CYPHER_5", "not supported by Neo4j 5.26.0", true},
+		{"setting removed in calver", "2025.01.0", "dbms.cluster.discovery.version", "V2_ONLY",
+			"not supported by Neo4j 2025.01.0: V2 is the only discovery protocol", true},
+
+		// Settings Neo4j may ignore
+		{"legacy setting", "5.26.0", "dbms.memory.heap.max_size", "4g", "Neo4j 4.x setting, renamed to server.memory.heap.max_size", false},
+		{"legacy prefix", "5.26.0", "causal_clustering.minimum_core_cluster_size_at_formation", "3",
+			"Neo4j 4.x setting, replaced by the dbms.cluster.* and server.cluster.* settings", false},
+		{"unknown namespace", "5.26.0", "custom.setting", "value", "unknown setting", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := Parse(tc.version)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tc.version, err)
+			}
+			problems := CheckSettings(map[string]string{tc.setting: tc.value}, v)
+			if tc.message == "" {
+				if len(problems) != 0 {
+					t.Errorf("expected no problems, got %v", problems)
+				}
+				return
+			}
+			if len(problems) != 1 {
+				t.Fatalf("expected one problem, got %v", problems)
+			}
+			if problems[0].Message != tc.message || problems[0].Invalid != tc.invalid {
+				t.Errorf("got %q (invalid=%v), want %q (invalid=%v)", problems[0].Message, problems[0].Invalid, tc.message, tc.invalid)
+			}
+		})
+	}
+}
+
+func TestCheckSettingsWithoutVersion(t *testing.T) {
+	// Checks that depend on the version are skipped when it is unknown
+	problems := CheckSettings(map[string]string{
+		"db.query.default_language": "CYPHER_25",
+		"server.bolt.enabled":       "maybe",
+	}, nil)
+	if len(problems) != 1 || problems[0].Setting != "server.bolt.enabled" {
+		t.Errorf("expected only the server.bolt.enabled problem, got %v", problems)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import "fmt"
+
+// CheckUpgrade returns an error unless Neo4j supports upgrading a cluster
+// from current to target: patches within 5.26.x, 5.26.x to CalVer, and any
+// CalVer release to a newer one
+func CheckUpgrade(current, target *Version) error {
+	if isDowngrade(current, target) {
+		return fmt.Errorf("downgrades are not supported (current: %s, target: %s)", current.Raw, target.Raw)
+	}
+
+	switch {
+	case current.IsCalver && target.IsCalver:
+		// Same year or any later year (2025.x.x -> 2025.y.y or 2026.x.x)
+		return nil
+	case isSemver(current) && isSemver(target):
+		return checkSemverUpgrade(current, target)
+	case isSemver(current) && target.IsCalver:
+		// Only 5.26.x (last semver LTS) may upgrade to CalVer 2025.x.x
+		if current.Major == 5 && current.Minor == 26 {
+			return nil
+		}
+		return fmt.Errorf("upgrade from %s to CalVer %s requires Neo4j 5.26.x (last semver LTS), upgrade to 5.26.x first", current.Raw, target.Raw)
+	default:
+		return fmt.Errorf("downgrade from CalVer to SemVer is not supported")
+	}
+}
+
+// isSemver reports whether the version is a 4.x or 5.x SemVer release
+func isSemver(v *Version) bool {
+	return v.Major >= 4 && v.Major <= 10
+}
+
+// isDowngrade reports whether target is older than current. Any CalVer to
+// SemVer change is a downgrade.
+func isDowngrade(current, target *Version) bool {
+	if target.Compare(current) < 0 {
+		return true
+	}
+	return current.IsCalver && isSemver(target)
+}
+
+func checkSemverUpgrade(current, target *Version) error {
+	// Only allow upgrades within same major version
+	if target.Major != current.Major {
+		return fmt.Errorf("major version upgrades are not supported")
+	}
+
+	// Only patch upgrades within 5.26.x are supported (last semver LTS; no 5.27+ exists)
+	if current.Major == 5 {
+		if current.Minor == 26 && target.Minor == 26 {
+			return nil
+		}
+		return fmt.Errorf("only Neo4j 5.26.x (last semver LTS) or 2025.x.x (CalVer) versions are supported")
+	}
+
+	// Neo4j 4.x is no longer supported
+	if current.Major == 4 {
+		return fmt.Errorf("Neo4j 4.x versions are not supported - only 5.26.x (last semver LTS) or 2025.x.x (CalVer) versions are supported")
+	}
+
+	return fmt.Errorf("unsupported SemVer upgrade path from %s to %s", current.Raw, target.Raw)
+}
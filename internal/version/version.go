@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version parses Neo4j SemVer (5.26.x) and CalVer (2025.01+) versions
+// and answers which settings, flags and features a version has, so that
+// configuration, startup scripts, backups and upgrades do not each inspect
+// image tags themselves.
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version represents a parsed Neo4j version
+type Version struct {
+	Major    int
+	Minor    int
+	Patch    int
+	IsCalver bool
+	Raw      string
+}
+
+// Parse parses a Neo4j version string into a structured Version
+func Parse(versionString string) (*Version, error) {
+	if versionString == "" {
+		return nil, fmt.Errorf("empty version string")
+	}
+
+	// Store raw version
+	v := &Version{Raw: versionString}
+
+	// Remove common prefixes and suffixes
+	cleaned := versionString
+	cleaned = strings.TrimPrefix(cleaned, "v")
+	cleaned = strings.TrimPrefix(cleaned, "neo4j-")
+	cleaned = strings.TrimPrefix(cleaned, "neo4j:")
+
+	// Remove any suffix after a dash (like -enterprise, -community)
+	if idx := strings.Index(cleaned, "-"); idx != -1 {
+		cleaned = cleaned[:idx]
+	}
+
+	// Split by dots
+	parts := strings.Split(cleaned, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid version format: %s", versionString)
+	}
+
+	// Parse major version
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid major version: %s", parts[0])
+	}
+	v.Major = major
+
+	// Parse minor version
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid minor version: %s", parts[1])
+	}
+	v.Minor = minor
+
+	// Parse patch version if present
+	if len(parts) >= 3 {
+		patch, err := strconv.Atoi(parts[2])
+		if err != nil {
+			// Some versions might have non-numeric patch like "5.26.0-beta1"
+			// In this case, we'll use 0 as the patch
+			v.Patch = 0
+		} else {
+			v.Patch = patch
+		}
+	}
+
+	// Determine if this is a CalVer version (2025.x.x format)
+	v.IsCalver = major >= 2025
+
+	return v, nil
+}
+
+// IsSupported checks if the version meets minimum requirements.
+// Neo4j 5.26.x is the final semver LTS release; the project moved to CalVer
+// (2025.x.x+) after that — no 5.27+ semver versions exist or will exist.
+func (v *Version) IsSupported() bool {
+	// CalVer versions (2025.x.x and later) are all supported
+	if v.IsCalver {
+		return true
+	}
+
+	// SemVer: only 5.26.x is supported — the last LTS semver release
+	return v.Major == 5 && v.Minor == 26
+}
+
+// Compare compares two versions
+// Returns -1 if v < other, 0 if v == other, 1 if v > other
+func (v *Version) Compare(other *Version) int {
+	// Compare major
+	if v.Major < other.Major {
+		return -1
+	}
+	if v.Major > other.Major {
+		return 1
+	}
+
+	// Compare minor
+	if v.Minor < other.Minor {
+		return -1
+	}
+	if v.Minor > other.Minor {
+		return 1
+	}
+
+	// Compare patch
+	if v.Patch < other.Patch {
+		return -1
+	}
+	if v.Patch > other.Patch {
+		return 1
+	}
+
+	return 0
+}
+
+// AtLeast reports whether the version is major.minor or newer
+func (v *Version) AtLeast(major, minor int) bool {
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+// String returns the version as a string
+func (v *Version) String() string {
+	if v.Patch > 0 {
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// FromImage extracts the version from a Neo4j image reference
+func FromImage(image string) (*Version, error) {
+	// Extract tag from image
+	// Format: neo4j:5.26.0-enterprise or neo4j:2025.01.0-enterprise
+	parts := strings.Split(image, ":")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid image format: %s", image)
+	}
+
+	tag := parts[len(parts)-1]
+	return Parse(tag)
+}
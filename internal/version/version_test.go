@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package neo4j
+package version
 
 import (
 	"strings"
@@ -22,10 +22,10 @@ import (
 )
 
 // ---------------------------------------------------------------------------
-// TestParseVersion
+// TestParse
 // ---------------------------------------------------------------------------
 
-func TestParseVersion(t *testing.T) {
+func TestParse(t *testing.T) {
 	cases := []struct {
 		input    string
 		wantErr  bool
@@ -55,7 +55,7 @@ func TestParseVersion(t *testing.T) {
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.input, func(t *testing.T) {
-			v, err := ParseVersion(tc.input)
+			v, err := Parse(tc.input)
 			if tc.wantErr {
 				if err == nil {
 					t.Errorf("expected error for input %q, got nil", tc.input)
@@ -101,9 +101,9 @@ func TestVersion_IsSupported(t *testing.T) {
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.input, func(t *testing.T) {
-			v, err := ParseVersion(tc.input)
+			v, err := Parse(tc.input)
 			if err != nil {
-				t.Fatalf("ParseVersion(%q): %v", tc.input, err)
+				t.Fatalf("Parse(%q): %v", tc.input, err)
 			}
 			if got := v.IsSupported(); got != tc.want {
 				t.Errorf("IsSupported: expected %v, got %v", tc.want, got)
@@ -118,9 +118,9 @@ func TestVersion_IsSupported(t *testing.T) {
 
 func TestVersion_Compare(t *testing.T) {
 	parse := func(s string) *Version {
-		v, err := ParseVersion(s)
+		v, err := Parse(s)
 		if err != nil {
-			t.Fatalf("ParseVersion(%q): %v", s, err)
+			t.Fatalf("Parse(%q): %v", s, err)
 		}
 		return v
 	}
@@ -156,12 +156,12 @@ func TestVersion_Compare(t *testing.T) {
 }
 
 // ---------------------------------------------------------------------------
-// TestGetImageVersion
+// TestFromImage
 // ---------------------------------------------------------------------------
 
-func TestGetImageVersion(t *testing.T) {
+func TestFromImage(t *testing.T) {
 	t.Run("valid enterprise image", func(t *testing.T) {
-		v, err := GetImageVersion("neo4j:5.26.0-enterprise")
+		v, err := FromImage("neo4j:5.26.0-enterprise")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -171,7 +171,7 @@ func TestGetImageVersion(t *testing.T) {
 	})
 
 	t.Run("invalid image format", func(t *testing.T) {
-		_, err := GetImageVersion("no-colon-here")
+		_, err := FromImage("no-colon-here")
 		if err == nil {
 			t.Error("expected error for image without colon")
 		}
@@ -179,74 +179,21 @@ func TestGetImageVersion(t *testing.T) {
 }
 
 // ---------------------------------------------------------------------------
-// TestGetKubernetesDiscoveryParameter
+// TestKubernetesDiscoveryPortSetting
 // ---------------------------------------------------------------------------
 
-func TestGetKubernetesDiscoveryParameter(t *testing.T) {
-	semver, _ := ParseVersion("5.26.0-enterprise")
-	calver, _ := ParseVersion("2025.01.0-enterprise")
+func TestKubernetesDiscoveryPortSetting(t *testing.T) {
+	semver, _ := Parse("5.26.0-enterprise")
+	calver, _ := Parse("2025.01.0-enterprise")
 
-	if got := GetKubernetesDiscoveryParameter(semver); got != "dbms.kubernetes.discovery.v2.service_port_name" {
+	if got := semver.KubernetesDiscoveryPortSetting(); got != "dbms.kubernetes.discovery.v2.service_port_name" {
 		t.Errorf("SemVer: expected v2 parameter, got %q", got)
 	}
-	if got := GetKubernetesDiscoveryParameter(calver); got != "dbms.kubernetes.discovery.service_port_name" {
+	if got := calver.KubernetesDiscoveryPortSetting(); got != "dbms.kubernetes.discovery.service_port_name" {
 		t.Errorf("CalVer: expected non-v2 parameter, got %q", got)
 	}
 }
 
-// ---------------------------------------------------------------------------
-// TestGetBackupCommand / TestGetRestoreCommand (spot checks)
-// ---------------------------------------------------------------------------
-
-func TestGetBackupCommand(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-
-	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "")
-	if !containsStr(cmd, "--to-path=/backups/mydb") {
-		t.Errorf("expected --to-path flag in backup command: %q", cmd)
-	}
-	if !containsStr(cmd, "mydb") {
-		t.Errorf("expected database name in backup command: %q", cmd)
-	}
-}
-
-func TestGetBackupCommandArgumentOrder(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "server-0:6362")
-	toPathIdx := strings.Index(cmd, "--to-path")
-	dbIdx := strings.LastIndex(cmd, "mydb")
-	if toPathIdx < 0 || dbIdx < 0 || toPathIdx > dbIdx {
-		t.Errorf("--to-path must appear before database name, got: %q", cmd)
-	}
-}
-
-func TestGetBackupCommandAllDatabases(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-	cmd := GetBackupCommand(v, "", "/backups/all", true, "server-0:6362")
-	if !strings.Contains(cmd, `"*"`) {
-		t.Errorf(`expected wildcard "*" for all-databases backup, got: %q`, cmd)
-	}
-	if strings.Contains(cmd, "--include-metadata") {
-		t.Errorf("--include-metadata should not be in base backup command, got: %q", cmd)
-	}
-}
-
-func TestGetBackupCommandFromFlag(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "host1:6362,host2:6362")
-	if !strings.Contains(cmd, "--from=host1:6362,host2:6362") {
-		t.Errorf("expected --from flag, got: %q", cmd)
-	}
-}
-
-func TestGetBackupCommandNoFromWhenEmpty(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-	cmd := GetBackupCommand(v, "mydb", "/backups/mydb", false, "")
-	if strings.Contains(cmd, "--from") {
-		t.Errorf("should not include --from when fromAddresses is empty, got: %q", cmd)
-	}
-}
-
 func TestSupportsRemoteAddressResolution(t *testing.T) {
 	cases := []struct {
 		version string
@@ -259,7 +206,7 @@ func TestSupportsRemoteAddressResolution(t *testing.T) {
 		{"5.26.0-enterprise", false},
 	}
 	for _, tc := range cases {
-		v, _ := ParseVersion(tc.version)
+		v, _ := Parse(tc.version)
 		if v.SupportsRemoteAddressResolution() != tc.want {
 			t.Errorf("SupportsRemoteAddressResolution(%s) = %v, want %v", tc.version, !tc.want, tc.want)
 		}
@@ -278,7 +225,7 @@ func TestSupportsPreferDiffAsParent(t *testing.T) {
 		{"5.26.0-enterprise", false},
 	}
 	for _, tc := range cases {
-		v, _ := ParseVersion(tc.version)
+		v, _ := Parse(tc.version)
 		if v.SupportsPreferDiffAsParent() != tc.want {
 			t.Errorf("SupportsPreferDiffAsParent(%s) = %v, want %v", tc.version, !tc.want, tc.want)
 		}
@@ -296,32 +243,20 @@ func TestSupportsParallelDownload(t *testing.T) {
 		{"5.26.0-enterprise", false},
 	}
 	for _, tc := range cases {
-		v, _ := ParseVersion(tc.version)
+		v, _ := Parse(tc.version)
 		if v.SupportsParallelDownload() != tc.want {
 			t.Errorf("SupportsParallelDownload(%s) = %v, want %v", tc.version, !tc.want, tc.want)
 		}
 	}
 }
 
-func TestGetRestoreCommand(t *testing.T) {
-	v, _ := ParseVersion("5.26.0-enterprise")
-
-	cmd := GetRestoreCommand(v, "mydb", "/backups/mydb")
-	if !containsStr(cmd, "--from-path=/backups/mydb") {
-		t.Errorf("expected --from-path flag in restore command: %q", cmd)
-	}
-	if !containsStr(cmd, "mydb") {
-		t.Errorf("expected database name in restore command: %q", cmd)
-	}
-}
-
 // ---------------------------------------------------------------------------
 // TestSupports* feature flags
 // ---------------------------------------------------------------------------
 
 func TestSupportsCypherLanguageVersion(t *testing.T) {
-	semver, _ := ParseVersion("5.26.0-enterprise")
-	calver, _ := ParseVersion("2025.01.0-enterprise")
+	semver, _ := Parse("5.26.0-enterprise")
+	calver, _ := Parse("2025.01.0-enterprise")
 
 	if semver.SupportsCypherLanguageVersion() {
 		t.Error("SemVer should not support Cypher language version")
@@ -332,10 +267,10 @@ func TestSupportsCypherLanguageVersion(t *testing.T) {
 }
 
 func TestSupportsAdvancedBackupFlags(t *testing.T) {
-	semver, _ := ParseVersion("5.26.0-enterprise")
-	earlyCalver, _ := ParseVersion("2025.01.0-enterprise")
-	latestCalver, _ := ParseVersion("2025.11.0-enterprise")
-	futureCalver, _ := ParseVersion("2026.01.0-enterprise")
+	semver, _ := Parse("5.26.0-enterprise")
+	earlyCalver, _ := Parse("2025.01.0-enterprise")
+	latestCalver, _ := Parse("2025.11.0-enterprise")
+	futureCalver, _ := Parse("2026.01.0-enterprise")
 
 	if semver.SupportsAdvancedBackupFlags() {
 		t.Error("SemVer should not support advanced backup flags")
@@ -352,9 +287,9 @@ func TestSupportsAdvancedBackupFlags(t *testing.T) {
 }
 
 func TestSupportsSourceDatabaseFilter(t *testing.T) {
-	calverEarly, _ := ParseVersion("2025.01.0-enterprise")
-	calverSupported, _ := ParseVersion("2025.02.0-enterprise")
-	semver, _ := ParseVersion("5.26.0-enterprise")
+	calverEarly, _ := Parse("2025.01.0-enterprise")
+	calverSupported, _ := Parse("2025.02.0-enterprise")
+	semver, _ := Parse("5.26.0-enterprise")
 
 	if calverEarly.SupportsSourceDatabaseFilter() {
 		t.Error("2025.01 should not support source database filter")
@@ -365,19 +300,96 @@ func TestSupportsSourceDatabaseFilter(t *testing.T) {
 	if semver.SupportsSourceDatabaseFilter() {
 		t.Error("SemVer should not support source database filter")
 	}
+	if future, _ := Parse("2026.01.0-enterprise"); !future.SupportsSourceDatabaseFilter() {
+		t.Error("2026.01 should support source database filter")
+	}
+}
+
+func TestSupportsPropertySharding(t *testing.T) {
+	cases := []struct {
+		version string
+		want    bool
+	}{
+		{"5.26.0-enterprise", false},
+		{"2025.11.0-enterprise", false},
+		{"2025.12.0-enterprise", true},
+		{"2026.01.0-enterprise", true},
+	}
+	for _, tc := range cases {
+		v, _ := Parse(tc.version)
+		if v.SupportsPropertySharding() != tc.want {
+			t.Errorf("SupportsPropertySharding(%s) = %v, want %v", tc.version, !tc.want, tc.want)
+		}
+	}
 }
 
 // ---------------------------------------------------------------------------
-// local helper
+// Setting names
 // ---------------------------------------------------------------------------
 
-func containsStr(s, sub string) bool {
-	return len(s) >= len(sub) && func() bool {
-		for i := 0; i <= len(s)-len(sub); i++ {
-			if s[i:i+len(sub)] == sub {
-				return true
-			}
+func TestDiscoverySettings(t *testing.T) {
+	semver, _ := Parse("5.26.0-enterprise")
+	calver, _ := Parse("2026.01.0-enterprise")
+
+	if got := semver.DiscoveryEndpointsSetting(); got != "dbms.cluster.discovery.v2.endpoints" {
+		t.Errorf("SemVer: expected v2 endpoints setting, got %q", got)
+	}
+	if got := calver.DiscoveryEndpointsSetting(); got != "dbms.cluster.endpoints" {
+		t.Errorf("CalVer: expected renamed endpoints setting, got %q", got)
+	}
+	if !semver.RequiresDiscoveryVersion() || calver.RequiresDiscoveryVersion() {
+		t.Error("only SemVer should require dbms.cluster.discovery.version")
+	}
+}
+
+func TestRemovedSetting(t *testing.T) {
+	semver, _ := Parse("5.26.0-enterprise")
+	calver, _ := Parse("2025.01.0-enterprise")
+
+	if _, removed := semver.RemovedSetting("dbms.kubernetes.discovery.v2.service_port_name"); removed {
+		t.Error("SemVer should accept its own settings")
+	}
+	if reason, removed := calver.RemovedSetting("dbms.kubernetes.discovery.v2.service_port_name"); !removed ||
+		reason != "renamed to dbms.kubernetes.discovery.service_port_name" {
+		t.Errorf("CalVer: expected renamed setting, got %q, %v", reason, removed)
+	}
+	if _, removed := calver.RemovedSetting("dbms.cluster.discovery.version"); !removed {
+		t.Error("CalVer should not accept dbms.cluster.discovery.version")
+	}
+	if _, removed := calver.RemovedSetting("server.memory.heap.max_size"); removed {
+		t.Error("CalVer should accept unchanged settings")
+	}
+}
+
+// ---------------------------------------------------------------------------
+// TestCheckUpgrade
+// ---------------------------------------------------------------------------
+
+func TestCheckUpgrade(t *testing.T) {
+	cases := []struct {
+		current string
+		target  string
+		wantErr string
+	}{
+		{"5.26.0", "5.26.1-enterprise", ""},
+		{"5.26.0", "2025.01.0-enterprise", ""},
+		{"2025.01.0", "2025.06.0-enterprise", ""},
+		{"2025.12.0", "2026.01.0-enterprise", ""},
+		{"2025.06.0", "2025.01.0-enterprise", "downgrades are not supported"},
+		{"2025.01.0", "5.26.0-enterprise", "downgrades are not supported"},
+		{"5.20.0", "2025.01.0-enterprise", "upgrade to 5.26.x first"},
+		{"5.20.0", "5.26.0-enterprise", "only Neo4j 5.26.x"},
+		{"4.4.0", "5.26.0-enterprise", "major version upgrades are not supported"},
+	}
+	for _, tc := range cases {
+		current, _ := Parse(tc.current)
+		target, _ := Parse(tc.target)
+		err := CheckUpgrade(current, target)
+		switch {
+		case tc.wantErr == "" && err != nil:
+			t.Errorf("CheckUpgrade(%s, %s): unexpected error %v", tc.current, tc.target, err)
+		case tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)):
+			t.Errorf("CheckUpgrade(%s, %s) = %v, want error containing %q", tc.current, tc.target, err, tc.wantErr)
 		}
-		return false
-	}()
+	}
 }
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// SetupNeo4jBackupWebhookWithManager registers the validating webhook for
+// Neo4jBackup. Backups whose labels do not match selector are left alone; a
+// nil selector matches every backup.
+func SetupNeo4jBackupWebhookWithManager(mgr ctrl.Manager, selector labels.Selector) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&neo4jv1alpha1.Neo4jBackup{}).
+		WithValidator(NewNeo4jBackupCustomValidator(mgr.GetClient(), selector)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-neo4j-neo4j-com-v1alpha1-neo4jbackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jbackups,verbs=create;update,versions=v1alpha1,name=vneo4jbackup-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jBackupCustomValidator validates a backup at admission, together with
+// the cluster it backs up, so that a mistake is rejected by kubectl rather
+// than found when the first backup Job fails
+type Neo4jBackupCustomValidator struct {
+	client    client.Client
+	validator *validation.BackupValidator
+	selector  labels.Selector
+}
+
+var _ admission.CustomValidator = &Neo4jBackupCustomValidator{}
+
+// NewNeo4jBackupCustomValidator creates a new backup admission validator
+func NewNeo4jBackupCustomValidator(c client.Client, selector labels.Selector) *Neo4jBackupCustomValidator {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jBackupCustomValidator{
+		client:    c,
+		validator: validation.NewBackupValidator(),
+		selector:  selector,
+	}
+}
+
+// ValidateCreate validates a new backup
+func (v *Neo4jBackupCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	backup, ok := obj.(*neo4jv1alpha1.Neo4jBackup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jBackup object but got %T", obj)
+	}
+	if !v.selector.Matches(labels.Set(backup.Labels)) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, backup)
+}
+
+// ValidateUpdate validates a change to a backup. Changes that leave the
+// spec alone, such as removing the finalizer, are always admitted.
+func (v *Neo4jBackupCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldBackup, ok := oldObj.(*neo4jv1alpha1.Neo4jBackup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jBackup object but got %T", oldObj)
+	}
+	backup, ok := newObj.(*neo4jv1alpha1.Neo4jBackup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jBackup object but got %T", newObj)
+	}
+	if !v.selector.Matches(labels.Set(backup.Labels)) || !backup.DeletionTimestamp.IsZero() ||
+		equality.Semantic.DeepEqual(oldBackup.Spec, backup.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, backup)
+}
+
+// ValidateDelete admits every deletion
+func (v *Neo4jBackupCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the spec of a backup and that the cluster it backs up
+// exists
+func (v *Neo4jBackupCustomValidator) validate(ctx context.Context, backup *neo4jv1alpha1.Neo4jBackup) error {
+	errs := v.validator.Validate(backup)
+
+	target := backup.Spec.Target
+	namespace := target.Namespace
+	if namespace == "" {
+		namespace = backup.Namespace
+	}
+	targetPath := field.NewPath("spec", "target")
+	switch {
+	case target.Kind == "Database" && target.ClusterRef == "":
+		errs = append(errs, field.Required(targetPath.Child("clusterRef"),
+			"the cluster that owns the database must be set when the target kind is Database"))
+	case target.Kind == "Database":
+		errs = append(errs, deploymentExists(ctx, v.client, types.NamespacedName{Namespace: namespace, Name: target.ClusterRef}, targetPath.Child("clusterRef"))...)
+	case target.Kind == "Cluster" && target.Name != "":
+		errs = append(errs, deploymentExists(ctx, v.client, types.NamespacedName{Namespace: namespace, Name: target.Name}, targetPath.Child("name"))...)
+	}
+	return invalid("Neo4jBackup", backup.Name, errs)
+}
+
+// deploymentExists returns an error unless a Neo4jEnterpriseCluster or
+// Neo4jEnterpriseStandalone of the name exists, the deployments a backup or
+// restore may target
+func deploymentExists(ctx context.Context, c client.Client, key types.NamespacedName, path *field.Path) field.ErrorList {
+	for _, obj := range []client.Object{&neo4jv1alpha1.Neo4jEnterpriseCluster{}, &neo4jv1alpha1.Neo4jEnterpriseStandalone{}} {
+		err := c.Get(ctx, key, obj)
+		if err == nil {
+			return nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return field.ErrorList{field.InternalError(path, err)}
+		}
+	}
+	return field.ErrorList{field.Invalid(path, key.Name,
+		fmt.Sprintf("no Neo4jEnterpriseCluster or Neo4jEnterpriseStandalone %s in namespace %s", key.Name, key.Namespace))}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+func webhookTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func causeFields(err error) []string {
+	var fields []string
+	for _, cause := range err.(*apierrors.StatusError).ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	return fields
+}
+
+func TestValidateBackup(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := NewNeo4jBackupCustomValidator(webhookTestClient(webhookTestCluster()), nil)
+
+	backup := &neo4jv1alpha1.Neo4jBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target:    neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: "prod"},
+			Storage:   neo4jv1alpha1.StorageLocation{Type: "pvc", PVC: &neo4jv1alpha1.PVCSpec{Size: "100Gi"}},
+			Schedule:  "0 2 * * *",
+			Retention: &neo4jv1alpha1.RetentionPolicy{MaxAge: "30d"},
+		},
+	}
+	_, err := v.ValidateCreate(ctx, backup)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Typos are rejected at admission rather than by the first backup Job
+	invalidBackup := backup.DeepCopy()
+	invalidBackup.Spec.Target.Name = "prdo"
+	invalidBackup.Spec.Retention.MaxAge = "30dd"
+	invalidBackup.Spec.Schedule = "0 2 * *"
+	_, err = v.ValidateCreate(ctx, invalidBackup)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(causeFields(err)).To(ConsistOf("spec.schedule", "spec.retention.maxAge", "spec.target.name"))
+
+	// Database backups name the cluster that owns the database
+	databaseBackup := backup.DeepCopy()
+	databaseBackup.Spec.Target = neo4jv1alpha1.BackupTarget{Kind: "Database", Name: "orders"}
+	_, err = v.ValidateCreate(ctx, databaseBackup)
+	g.Expect(causeFields(err)).To(ConsistOf("spec.target.clusterRef"))
+	databaseBackup.Spec.Target.ClusterRef = "prod"
+	_, err = v.ValidateCreate(ctx, databaseBackup)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Changes that leave the spec alone are admitted
+	finalized := invalidBackup.DeepCopy()
+	finalized.Finalizers = []string{"neo4j.neo4j.com/backup-finalizer"}
+	_, err = v.ValidateUpdate(ctx, invalidBackup, finalized)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestValidateRestore(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	nightly := &neo4jv1alpha1.Neo4jBackup{ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"}}
+	v := NewNeo4jRestoreCustomValidator(webhookTestClient(webhookTestCluster(), nightly), nil)
+
+	restore := &neo4jv1alpha1.Neo4jRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jRestoreSpec{
+			TargetCluster: "prod",
+			DatabaseName:  "orders",
+			Source:        neo4jv1alpha1.RestoreSource{Type: "backup", BackupRef: "nightly"},
+		},
+	}
+	_, err := v.ValidateCreate(ctx, restore)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	restore.Spec.TargetCluster = "staging"
+	restore.Spec.Source.BackupRef = "weekly"
+	_, err = v.ValidateCreate(ctx, restore)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(causeFields(err)).To(ConsistOf("spec.targetCluster", "spec.source.backupRef"))
+}
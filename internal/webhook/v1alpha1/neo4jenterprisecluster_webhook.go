@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/controller"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// SetupNeo4jEnterpriseClusterWebhookWithManager registers the defaulting
+// and validating webhooks for Neo4jEnterpriseCluster. Clusters whose labels
+// do not match selector are left to the operator that manages them; a nil
+// selector matches every cluster.
+func SetupNeo4jEnterpriseClusterWebhookWithManager(mgr ctrl.Manager, selector labels.Selector) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&neo4jv1alpha1.Neo4jEnterpriseCluster{}).
+		WithDefaulter(NewNeo4jEnterpriseClusterCustomDefaulter(selector)).
+		WithValidator(NewNeo4jEnterpriseClusterCustomValidator(mgr.GetClient(), selector)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-neo4j-neo4j-com-v1alpha1-neo4jenterprisecluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=create;update,versions=v1alpha1,name=mneo4jenterprisecluster-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jEnterpriseClusterCustomDefaulter stores the probe timings and MCP
+// listener defaults of the builders in the cluster spec, and fills in the
+// image repository, storage size and topology the CRD otherwise requires
+type Neo4jEnterpriseClusterCustomDefaulter struct {
+	selector labels.Selector
+}
+
+var _ admission.CustomDefaulter = &Neo4jEnterpriseClusterCustomDefaulter{}
+
+// NewNeo4jEnterpriseClusterCustomDefaulter creates a new cluster admission
+// defaulter
+func NewNeo4jEnterpriseClusterCustomDefaulter(selector labels.Selector) *Neo4jEnterpriseClusterCustomDefaulter {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jEnterpriseClusterCustomDefaulter{selector: selector}
+}
+
+// Default fills in the defaults of a cluster. Clusters of a Neo4jClusterClass
+// are defaulted once the reconciler has applied the class, as defaults would
+// otherwise take the place of the fields the class sets.
+func (d *Neo4jEnterpriseClusterCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	cluster, ok := obj.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return fmt.Errorf("expected a Neo4jEnterpriseCluster object but got %T", obj)
+	}
+	if !d.selector.Matches(labels.Set(cluster.Labels)) || !cluster.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if cluster.Spec.ClusterClassName != "" && cluster.Annotations[controller.ClusterClassAppliedAnnotation] == "" {
+		return nil
+	}
+	resources.ApplyClusterDefaults(cluster)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-neo4j-neo4j-com-v1alpha1-neo4jenterprisecluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jenterpriseclusters,verbs=create;update,versions=v1alpha1,name=vneo4jenterprisecluster-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jEnterpriseClusterCustomValidator runs the validation of the cluster
+// reconciler at admission, so that a cluster it would fail is rejected by
+// kubectl rather than reported later as phase Failed
+type Neo4jEnterpriseClusterCustomValidator struct {
+	client    client.Client
+	validator *validation.ClusterValidator
+	selector  labels.Selector
+}
+
+var _ admission.CustomValidator = &Neo4jEnterpriseClusterCustomValidator{}
+
+// NewNeo4jEnterpriseClusterCustomValidator creates a new cluster admission
+// validator
+func NewNeo4jEnterpriseClusterCustomValidator(c client.Client, selector labels.Selector) *Neo4jEnterpriseClusterCustomValidator {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jEnterpriseClusterCustomValidator{
+		client:    c,
+		validator: validation.NewClusterValidator(c),
+		selector:  selector,
+	}
+}
+
+// ValidateCreate validates a new cluster
+func (v *Neo4jEnterpriseClusterCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cluster, ok := obj.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseCluster object but got %T", obj)
+	}
+	if !v.selector.Matches(labels.Set(cluster.Labels)) {
+		return nil, nil
+	}
+
+	warnings := validation.DeprecationWarnings(cluster)
+	desired, classWarning, errs := v.desiredCluster(ctx, cluster)
+	if classWarning != "" {
+		return append(warnings, classWarning), nil
+	}
+	if len(errs) == 0 {
+		result := v.validator.ValidateCreateWithWarnings(ctx, desired)
+		warnings = appendUnique(warnings, result.Warnings...)
+		errs = result.Errors
+	}
+	return warnings, invalid("Neo4jEnterpriseCluster", cluster.Name, errs)
+}
+
+// ValidateUpdate validates a change to a cluster. Changes that leave the
+// spec alone, such as the finalizer or cluster class annotation the
+// reconciler writes, are always admitted, so a cluster created before the
+// webhook can still be deleted. Filling in the defaults leaves the spec alone
+// too.
+func (v *Neo4jEnterpriseClusterCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCluster, ok := oldObj.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseCluster object but got %T", oldObj)
+	}
+	cluster, ok := newObj.(*neo4jv1alpha1.Neo4jEnterpriseCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseCluster object but got %T", newObj)
+	}
+	if !v.selector.Matches(labels.Set(cluster.Labels)) || !cluster.DeletionTimestamp.IsZero() ||
+		equality.Semantic.DeepEqual(oldCluster.Spec, cluster.Spec) {
+		return nil, nil
+	}
+	defaulted := oldCluster.DeepCopy()
+	resources.ApplyClusterDefaults(defaulted)
+	if equality.Semantic.DeepEqual(defaulted.Spec, cluster.Spec) {
+		return nil, nil
+	}
+
+	warnings := validation.DeprecationWarnings(cluster)
+	desired, classWarning, errs := v.desiredCluster(ctx, cluster)
+	if classWarning != "" {
+		return append(warnings, classWarning), nil
+	}
+	if len(errs) == 0 {
+		current := oldCluster.DeepCopy()
+		v.validator.ApplyDefaults(ctx, current)
+		result := v.validator.ValidateUpdateWithWarnings(ctx, current, desired)
+		warnings = appendUnique(warnings, result.Warnings...)
+		errs = result.Errors
+	}
+	return warnings, invalid("Neo4jEnterpriseCluster", cluster.Name, errs)
+}
+
+// ValidateDelete admits every deletion
+func (v *Neo4jEnterpriseClusterCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// desiredCluster returns the cluster the reconciler would validate: with the
+// template of its Neo4jClusterClass applied and the defaults filled in. When
+// the class does not exist yet it returns a warning instead, as the spec
+// cannot be validated without it.
+func (v *Neo4jEnterpriseClusterCustomValidator) desiredCluster(ctx context.Context, cluster *neo4jv1alpha1.Neo4jEnterpriseCluster) (*neo4jv1alpha1.Neo4jEnterpriseCluster, string, field.ErrorList) {
+	desired := cluster.DeepCopy()
+	if className := desired.Spec.ClusterClassName; className != "" {
+		class := &neo4jv1alpha1.Neo4jClusterClass{}
+		if err := v.client.Get(ctx, types.NamespacedName{Name: className}, class); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Sprintf("cluster class %s not found: the cluster is validated once the class exists", className), nil
+			}
+			return nil, "", field.ErrorList{field.InternalError(field.NewPath("spec", "clusterClassName"), err)}
+		}
+		classValidator := validation.NewClusterClassValidator()
+		if _, errs := classValidator.ApplyClass(class, desired, []byte(desired.Annotations[controller.ClusterClassAppliedAnnotation])); len(errs) > 0 {
+			return nil, "", errs
+		}
+		if errs := classValidator.Validate(class, desired); len(errs) > 0 {
+			return nil, "", errs
+		}
+	}
+	v.validator.ApplyDefaults(ctx, desired)
+	return desired, "", nil
+}
+
+// invalid returns the field errors as an Invalid status error, which kubectl
+// prints one field per line, or nil without errors
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(neo4jv1alpha1.GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
+
+// appendUnique appends the warnings not in warnings yet
+func appendUnique(warnings admission.Warnings, more ...string) admission.Warnings {
+	for _, warning := range more {
+		if !slices.Contains(warnings, warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
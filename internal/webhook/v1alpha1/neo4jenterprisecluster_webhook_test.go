@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/controller"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+func webhookTestValidator(selector labels.Selector) *Neo4jEnterpriseClusterCustomValidator {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = neo4jv1alpha1.AddToScheme(scheme)
+	return NewNeo4jEnterpriseClusterCustomValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), selector)
+}
+
+func webhookTestCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0"},
+			Storage:  neo4jv1alpha1.StorageSpec{ClassName: "fast-ssd", Size: "100Gi"},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+		},
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := webhookTestValidator(nil)
+
+	warnings, err := v.ValidateCreate(ctx, webhookTestCluster())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+
+	// Errors are returned per field
+	cluster := webhookTestCluster()
+	cluster.Spec.Topology.Servers = 1
+	cluster.Spec.Storage.Size = ""
+	_, err = v.ValidateCreate(ctx, cluster)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	var fields []string
+	for _, cause := range err.(*apierrors.StatusError).ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	g.Expect(fields).To(ContainElements("spec.topology.servers", "spec.storage.size"))
+
+	// Deprecated fields are admitted with a warning
+	cluster = webhookTestCluster()
+	cluster.Spec.Topology = neo4jv1alpha1.TopologyConfiguration{Primaries: 3, Secondaries: 1}
+	warnings, err = v.ValidateCreate(ctx, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ContainElement(ContainSubstring("spec.topology.primaries and spec.topology.secondaries are deprecated")))
+	g.Expect(warnings).To(ContainElement(ContainSubstring("Even number of servers (4)")))
+
+	// Clusters of a class that does not exist yet are not validated
+	cluster = webhookTestCluster()
+	cluster.Spec.ClusterClassName = "production"
+	cluster.Spec.Topology.Servers = 1
+	warnings, err = v.ValidateCreate(ctx, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(ContainSubstring("cluster class production not found")))
+
+	// Clusters another operator manages are left alone
+	cluster = webhookTestCluster()
+	cluster.Spec.Topology.Servers = 1
+	_, err = webhookTestValidator(labels.SelectorFromSet(labels.Set{"team": "payments"})).ValidateCreate(ctx, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	v := webhookTestValidator(nil)
+
+	oldCluster := webhookTestCluster()
+	cluster := webhookTestCluster()
+	cluster.Spec.Image.Tag = "2025.01.0"
+	_, err := v.ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	cluster.Spec.Image.Tag = "5.25.0"
+	_, err = v.ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+	// Changes that leave the spec alone are admitted, so that the finalizer
+	// of a cluster that is invalid already can be removed
+	oldCluster.Spec.Topology.Servers = 1
+	cluster = oldCluster.DeepCopy()
+	cluster.Finalizers = nil
+	_, err = v.ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// as are the defaults the defaulting webhook fills in
+	resources.ApplyClusterDefaults(cluster)
+	_, err = v.ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestDefault(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	d := NewNeo4jEnterpriseClusterCustomDefaulter(nil)
+
+	cluster := webhookTestCluster()
+	cluster.Spec.Topology.Servers = 0
+	g.Expect(d.Default(ctx, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Topology.Servers).To(Equal(resources.DefaultClusterServers))
+	g.Expect(cluster.Spec.Probes.Startup.FailureThreshold).To(HaveValue(Equal(int32(60))))
+
+	// Clusters of a class are defaulted once the class is applied
+	cluster = webhookTestCluster()
+	cluster.Spec.ClusterClassName = "production"
+	cluster.Spec.Storage.Size = ""
+	g.Expect(d.Default(ctx, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Storage.Size).To(BeEmpty())
+	cluster.Annotations = map[string]string{controller.ClusterClassAppliedAnnotation: `{"storage":{"className":"fast-ssd"}}`}
+	g.Expect(d.Default(ctx, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Storage.Size).To(Equal(resources.DefaultStorageSize))
+
+	// Clusters another operator manages are left alone
+	cluster = webhookTestCluster()
+	g.Expect(NewNeo4jEnterpriseClusterCustomDefaulter(labels.SelectorFromSet(labels.Set{"team": "payments"})).Default(ctx, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Probes).To(BeNil())
+
+	standalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image: neo4jv1alpha1.ImageSpec{Tag: "5.26.0"},
+			MCP:   &neo4jv1alpha1.MCPServerSpec{Enabled: true},
+		},
+	}
+	g.Expect(NewNeo4jEnterpriseStandaloneCustomDefaulter(nil).Default(ctx, standalone)).To(Succeed())
+	g.Expect(standalone.Spec.Image.Repo).To(Equal(resources.DefaultImageRepo))
+	g.Expect(standalone.Spec.Storage.Size).To(Equal(resources.DefaultStorageSize))
+	g.Expect(standalone.Spec.MCP.HTTP.Port).To(Equal(int32(8080)))
+}
+
+func TestValidateUnsafeChanges(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	oldCluster := webhookTestCluster()
+	oldCluster.Status.Phase = "Ready"
+	cluster := oldCluster.DeepCopy()
+	cluster.Spec.Storage.Size = "50Gi"
+	_, err := webhookTestValidator(nil).ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("spec.storage.size"))
+
+	cluster.Annotations = map[string]string{validation.AllowUnsafeChangesAnnotation: "true"}
+	_, err = webhookTestValidator(nil).ValidateUpdate(ctx, oldCluster, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	v := NewNeo4jEnterpriseStandaloneCustomValidator(nil)
+	oldStandalone := &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image:   neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: "5.26.0-enterprise"},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+		},
+	}
+	_, err = v.ValidateCreate(ctx, oldStandalone)
+	g.Expect(err).ToNot(HaveOccurred())
+	standalone := oldStandalone.DeepCopy()
+	standalone.Spec.Storage.ClassName = "premium"
+	_, err = v.ValidateUpdate(ctx, oldStandalone, standalone)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("spec.storage.className"))
+}
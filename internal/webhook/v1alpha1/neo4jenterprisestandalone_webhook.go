@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/resources"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// SetupNeo4jEnterpriseStandaloneWebhookWithManager registers the defaulting
+// and validating webhooks for Neo4jEnterpriseStandalone. Standalones whose
+// labels do not match selector are left alone; a nil selector matches every
+// standalone.
+func SetupNeo4jEnterpriseStandaloneWebhookWithManager(mgr ctrl.Manager, selector labels.Selector) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&neo4jv1alpha1.Neo4jEnterpriseStandalone{}).
+		WithDefaulter(NewNeo4jEnterpriseStandaloneCustomDefaulter(selector)).
+		WithValidator(NewNeo4jEnterpriseStandaloneCustomValidator(selector)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-neo4j-neo4j-com-v1alpha1-neo4jenterprisestandalone,mutating=true,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=create;update,versions=v1alpha1,name=mneo4jenterprisestandalone-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jEnterpriseStandaloneCustomDefaulter stores the defaults of the
+// builders in the standalone spec
+type Neo4jEnterpriseStandaloneCustomDefaulter struct {
+	selector labels.Selector
+}
+
+var _ admission.CustomDefaulter = &Neo4jEnterpriseStandaloneCustomDefaulter{}
+
+// NewNeo4jEnterpriseStandaloneCustomDefaulter creates a new standalone
+// admission defaulter
+func NewNeo4jEnterpriseStandaloneCustomDefaulter(selector labels.Selector) *Neo4jEnterpriseStandaloneCustomDefaulter {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jEnterpriseStandaloneCustomDefaulter{selector: selector}
+}
+
+// Default fills in the defaults of a standalone
+func (d *Neo4jEnterpriseStandaloneCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	standalone, ok := obj.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+	if !ok {
+		return fmt.Errorf("expected a Neo4jEnterpriseStandalone object but got %T", obj)
+	}
+	if !d.selector.Matches(labels.Set(standalone.Labels)) || !standalone.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	resources.ApplyStandaloneDefaults(standalone)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-neo4j-neo4j-com-v1alpha1-neo4jenterprisestandalone,mutating=false,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jenterprisestandalones,verbs=create;update,versions=v1alpha1,name=vneo4jenterprisestandalone-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jEnterpriseStandaloneCustomValidator runs the validation of the
+// standalone reconciler at admission, and rejects the changes to a
+// standalone that cannot be applied safely
+type Neo4jEnterpriseStandaloneCustomValidator struct {
+	validator *validation.StandaloneValidator
+	selector  labels.Selector
+}
+
+var _ admission.CustomValidator = &Neo4jEnterpriseStandaloneCustomValidator{}
+
+// NewNeo4jEnterpriseStandaloneCustomValidator creates a new standalone
+// admission validator
+func NewNeo4jEnterpriseStandaloneCustomValidator(selector labels.Selector) *Neo4jEnterpriseStandaloneCustomValidator {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jEnterpriseStandaloneCustomValidator{
+		validator: validation.NewStandaloneValidator(),
+		selector:  selector,
+	}
+}
+
+// ValidateCreate validates a new standalone
+func (v *Neo4jEnterpriseStandaloneCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	standalone, ok := obj.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseStandalone object but got %T", obj)
+	}
+	if !v.selector.Matches(labels.Set(standalone.Labels)) {
+		return nil, nil
+	}
+	return nil, invalid("Neo4jEnterpriseStandalone", standalone.Name, v.validator.ValidateCreate(standalone))
+}
+
+// ValidateUpdate validates a change to a standalone. Like for clusters,
+// changes that leave the spec alone are always admitted.
+func (v *Neo4jEnterpriseStandaloneCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldStandalone, ok := oldObj.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseStandalone object but got %T", oldObj)
+	}
+	standalone, ok := newObj.(*neo4jv1alpha1.Neo4jEnterpriseStandalone)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jEnterpriseStandalone object but got %T", newObj)
+	}
+	if !v.selector.Matches(labels.Set(standalone.Labels)) || !standalone.DeletionTimestamp.IsZero() ||
+		equality.Semantic.DeepEqual(oldStandalone.Spec, standalone.Spec) {
+		return nil, nil
+	}
+	defaulted := oldStandalone.DeepCopy()
+	resources.ApplyStandaloneDefaults(defaulted)
+	if equality.Semantic.DeepEqual(defaulted.Spec, standalone.Spec) {
+		return nil, nil
+	}
+	return nil, invalid("Neo4jEnterpriseStandalone", standalone.Name, v.validator.ValidateUpdate(oldStandalone, standalone))
+}
+
+// ValidateDelete admits every deletion
+func (v *Neo4jEnterpriseStandaloneCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
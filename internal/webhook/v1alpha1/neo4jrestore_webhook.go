@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/validation"
+)
+
+// SetupNeo4jRestoreWebhookWithManager registers the validating webhook for
+// Neo4jRestore. Restores whose labels do not match selector are left alone;
+// a nil selector matches every restore.
+func SetupNeo4jRestoreWebhookWithManager(mgr ctrl.Manager, selector labels.Selector) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&neo4jv1alpha1.Neo4jRestore{}).
+		WithValidator(NewNeo4jRestoreCustomValidator(mgr.GetClient(), selector)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-neo4j-neo4j-com-v1alpha1-neo4jrestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=neo4j.neo4j.com,resources=neo4jrestores,verbs=create;update,versions=v1alpha1,name=vneo4jrestore-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Neo4jRestoreCustomValidator validates a restore at admission, together
+// with the cluster it restores to and the backups it reads
+type Neo4jRestoreCustomValidator struct {
+	client    client.Client
+	validator *validation.RestoreValidator
+	selector  labels.Selector
+}
+
+var _ admission.CustomValidator = &Neo4jRestoreCustomValidator{}
+
+// NewNeo4jRestoreCustomValidator creates a new restore admission validator
+func NewNeo4jRestoreCustomValidator(c client.Client, selector labels.Selector) *Neo4jRestoreCustomValidator {
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	return &Neo4jRestoreCustomValidator{
+		client:    c,
+		validator: validation.NewRestoreValidator(),
+		selector:  selector,
+	}
+}
+
+// ValidateCreate validates a new restore
+func (v *Neo4jRestoreCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	restore, ok := obj.(*neo4jv1alpha1.Neo4jRestore)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jRestore object but got %T", obj)
+	}
+	if !v.selector.Matches(labels.Set(restore.Labels)) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, restore)
+}
+
+// ValidateUpdate validates a change to a restore. Like for backups, changes
+// that leave the spec alone are always admitted.
+func (v *Neo4jRestoreCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRestore, ok := oldObj.(*neo4jv1alpha1.Neo4jRestore)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jRestore object but got %T", oldObj)
+	}
+	restore, ok := newObj.(*neo4jv1alpha1.Neo4jRestore)
+	if !ok {
+		return nil, fmt.Errorf("expected a Neo4jRestore object but got %T", newObj)
+	}
+	if !v.selector.Matches(labels.Set(restore.Labels)) || !restore.DeletionTimestamp.IsZero() ||
+		equality.Semantic.DeepEqual(oldRestore.Spec, restore.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, restore)
+}
+
+// ValidateDelete admits every deletion
+func (v *Neo4jRestoreCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the spec of a restore, that the cluster it restores to
+// exists and that so do the Neo4jBackups it reads
+func (v *Neo4jRestoreCustomValidator) validate(ctx context.Context, restore *neo4jv1alpha1.Neo4jRestore) error {
+	errs := v.validator.Validate(restore)
+
+	if restore.Spec.TargetCluster != "" {
+		errs = append(errs, deploymentExists(ctx, v.client,
+			types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.TargetCluster},
+			field.NewPath("spec", "targetCluster"))...)
+	}
+
+	sourcePath := field.NewPath("spec", "source")
+	source := restore.Spec.Source
+	if source.Type == "backup" && source.BackupRef != "" {
+		errs = append(errs, v.backupExists(ctx, restore.Namespace, source.BackupRef, sourcePath.Child("backupRef"))...)
+	}
+	if source.PITR != nil && source.PITR.BaseBackup != nil {
+		if base := source.PITR.BaseBackup; base.Type == "backup" && base.BackupRef != "" {
+			errs = append(errs, v.backupExists(ctx, restore.Namespace, base.BackupRef, sourcePath.Child("pitr", "baseBackup", "backupRef"))...)
+		}
+	}
+	return invalid("Neo4jRestore", restore.Name, errs)
+}
+
+// backupExists returns an error unless the Neo4jBackup exists
+func (v *Neo4jRestoreCustomValidator) backupExists(ctx context.Context, namespace, name string, path *field.Path) field.ErrorList {
+	err := v.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &neo4jv1alpha1.Neo4jBackup{})
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsNotFound(err):
+		return field.ErrorList{field.Invalid(path, name, fmt.Sprintf("no Neo4jBackup %s in namespace %s", name, namespace))}
+	default:
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+}
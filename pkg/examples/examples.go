@@ -0,0 +1,344 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package examples provides the sample custom resources of config/samples as
+// typed objects, so tooling and tests can build valid examples without
+// parsing the YAML files. Every constructor returns a new object that callers
+// may modify freely.
+package examples
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+const (
+	// ClusterName is the name of the sample Neo4jEnterpriseCluster that the
+	// database, backup, restore, sharded database and workload samples target.
+	ClusterName = "sample-cluster"
+
+	// StandaloneName is the name of the sample Neo4jEnterpriseStandalone.
+	StandaloneName = "sample-standalone"
+
+	// AdminSecretName is the admin credentials Secret the samples expect.
+	AdminSecretName = "neo4j-admin-secret"
+
+	// Neo4jImageTag is the Neo4j Enterprise image used by the samples.
+	Neo4jImageTag = "5.26.0-enterprise"
+)
+
+// typeMeta returns the TypeMeta of a kind in the operator's API group.
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: neo4jv1alpha1.GroupVersion.String(), Kind: kind}
+}
+
+// pluginLabels are the kustomize labels carried by the plugin samples.
+func pluginLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "neo4j-kubernetes-operator",
+		"app.kubernetes.io/managed-by": "kustomize",
+	}
+}
+
+// All returns every sample in the order of their files in config/samples.
+func All() []client.Object {
+	return []client.Object{
+		Backup(),
+		CDC(),
+		ClusterClass(),
+		Database(),
+		EnterpriseCluster(),
+		EnterpriseStandalone(),
+		Migration(),
+		ClusterPlugin(),
+		StandalonePlugin(),
+		Restore(),
+		ShardedDatabase(),
+		UserSync(),
+		Workload(),
+	}
+}
+
+// EnterpriseCluster returns a three server cluster.
+func EnterpriseCluster() *neo4jv1alpha1.Neo4jEnterpriseCluster {
+	return &neo4jv1alpha1.Neo4jEnterpriseCluster{
+		TypeMeta:   typeMeta("Neo4jEnterpriseCluster"),
+		ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+			Image:    neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: Neo4jImageTag},
+			Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 3},
+			Storage:  neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+			Auth:     &neo4jv1alpha1.AuthSpec{AdminSecret: AdminSecretName},
+		},
+	}
+}
+
+// ClusterClass returns a production cluster class that locks TLS, backups
+// and the image, leaving the server count, storage size, resources and
+// configuration to the clusters created from it.
+func ClusterClass() *neo4jv1alpha1.Neo4jClusterClass {
+	return &neo4jv1alpha1.Neo4jClusterClass{
+		TypeMeta:   typeMeta("Neo4jClusterClass"),
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: neo4jv1alpha1.Neo4jClusterClassSpec{
+			Description: "Three server cluster with TLS and backups to S3",
+			Template: runtime.RawExtension{Raw: []byte(`{` +
+				`"backups":{"defaultStorage":{"bucket":"neo4j-backups","type":"s3"}},` +
+				`"image":{"repo":"neo4j","tag":"` + Neo4jImageTag + `"},` +
+				`"storage":{"className":"standard","size":"10Gi"},` +
+				`"tls":{"issuerRef":{"kind":"ClusterIssuer","name":"ca-cluster-issuer"},"mode":"cert-manager"},` +
+				`"topology":{"servers":3}}`)},
+			AllowedOverrides: []string{"topology.servers", "storage.size", "resources", "config"},
+			ImagePolicy: &neo4jv1alpha1.ClusterClassImagePolicy{
+				AllowedRepositories: []string{"neo4j"},
+				MinVersion:          "5.26.0",
+			},
+			RequireTLS:     true,
+			RequireBackups: true,
+		},
+	}
+}
+
+// EnterpriseStandalone returns a single server deployment.
+func EnterpriseStandalone() *neo4jv1alpha1.Neo4jEnterpriseStandalone {
+	return &neo4jv1alpha1.Neo4jEnterpriseStandalone{
+		TypeMeta:   typeMeta("Neo4jEnterpriseStandalone"),
+		ObjectMeta: metav1.ObjectMeta{Name: StandaloneName},
+		Spec: neo4jv1alpha1.Neo4jEnterpriseStandaloneSpec{
+			Image:   neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: Neo4jImageTag},
+			Storage: neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "10Gi"},
+			Auth:    &neo4jv1alpha1.AuthSpec{AdminSecret: AdminSecretName},
+		},
+	}
+}
+
+// ClusterPlugin returns APOC with file import and export enabled.
+func ClusterPlugin() *neo4jv1alpha1.Neo4jPlugin {
+	return &neo4jv1alpha1.Neo4jPlugin{
+		TypeMeta: typeMeta("Neo4jPlugin"),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "neo4jplugin-cluster-sample",
+			Namespace: "default",
+			Labels:    pluginLabels(),
+		},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "neo4jenterprisecluster-sample",
+			Name:       "apoc",
+			Version:    "5.26.0",
+			Enabled:    true,
+			Source:     &neo4jv1alpha1.PluginSource{Type: "official"},
+			Config: map[string]string{
+				"apoc.export.file.enabled":          "true",
+				"apoc.import.file.enabled":          "true",
+				"apoc.import.file.use_neo4j_config": "true",
+			},
+		},
+	}
+}
+
+// StandalonePlugin returns Graph Data Science with its APOC dependency,
+// procedure allowlist and resource limits.
+func StandalonePlugin() *neo4jv1alpha1.Neo4jPlugin {
+	return &neo4jv1alpha1.Neo4jPlugin{
+		TypeMeta: typeMeta("Neo4jPlugin"),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "neo4jplugin-standalone-sample",
+			Namespace: "default",
+			Labels:    pluginLabels(),
+		},
+		Spec: neo4jv1alpha1.Neo4jPluginSpec{
+			ClusterRef: "neo4jenterprisestandalone-sample",
+			Name:       "graph-data-science",
+			Version:    "2.10.0",
+			Enabled:    true,
+			Source:     &neo4jv1alpha1.PluginSource{Type: "community"},
+			Dependencies: []neo4jv1alpha1.PluginDependency{
+				{Name: "apoc", VersionConstraint: ">=5.26.0"},
+			},
+			Config: map[string]string{
+				"gds.enterprise.license_file": "/licenses/gds.license",
+			},
+			Security: &neo4jv1alpha1.PluginSecurity{
+				AllowedProcedures: []string{"gds.*", "apoc.load.*"},
+				Sandbox:           true,
+			},
+			Resources: &neo4jv1alpha1.PluginResourceRequirements{
+				MemoryLimit:    "1Gi",
+				CPULimit:       "500m",
+				ThreadPoolSize: 4,
+			},
+		},
+	}
+}
+
+// Database returns a database on the sample cluster.
+func Database() *neo4jv1alpha1.Neo4jDatabase {
+	return &neo4jv1alpha1.Neo4jDatabase{
+		TypeMeta:   typeMeta("Neo4jDatabase"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-database"},
+		Spec: neo4jv1alpha1.Neo4jDatabaseSpec{
+			Name:       "exampledb",
+			ClusterRef: ClusterName,
+		},
+	}
+}
+
+// Backup returns a compressed full backup of all databases of the sample
+// cluster to a PVC.
+func Backup() *neo4jv1alpha1.Neo4jBackup {
+	return &neo4jv1alpha1.Neo4jBackup{
+		TypeMeta:   typeMeta("Neo4jBackup"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-backup"},
+		Spec: neo4jv1alpha1.Neo4jBackupSpec{
+			Target: neo4jv1alpha1.BackupTarget{Kind: "Cluster", Name: ClusterName},
+			Storage: neo4jv1alpha1.StorageLocation{
+				Type: "pvc",
+				PVC: &neo4jv1alpha1.PVCSpec{
+					Name:             "neo4j-backup-pvc",
+					StorageClassName: "standard",
+					Size:             "20Gi",
+				},
+			},
+			Options: &neo4jv1alpha1.BackupOptions{Compress: true, BackupType: "FULL"},
+		},
+	}
+}
+
+// Restore returns a restore of the sample database from the sample backup.
+func Restore() *neo4jv1alpha1.Neo4jRestore {
+	return &neo4jv1alpha1.Neo4jRestore{
+		TypeMeta:   typeMeta("Neo4jRestore"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-restore"},
+		Spec: neo4jv1alpha1.Neo4jRestoreSpec{
+			TargetCluster: ClusterName,
+			DatabaseName:  "exampledb",
+			Source:        neo4jv1alpha1.RestoreSource{Type: "backup", BackupRef: "example-backup"},
+		},
+	}
+}
+
+// ShardedDatabase returns a property sharded database with two property
+// shards.
+func ShardedDatabase() *neo4jv1alpha1.Neo4jShardedDatabase {
+	return &neo4jv1alpha1.Neo4jShardedDatabase{
+		TypeMeta:   typeMeta("Neo4jShardedDatabase"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-sharded"},
+		Spec: neo4jv1alpha1.Neo4jShardedDatabaseSpec{
+			ClusterRef:            ClusterName,
+			Name:                  "shardeddb",
+			DefaultCypherLanguage: "25",
+			PropertySharding: neo4jv1alpha1.PropertyShardingConfiguration{
+				PropertyShards:        2,
+				GraphShard:            neo4jv1alpha1.DatabaseTopology{Primaries: 1},
+				PropertyShardTopology: neo4jv1alpha1.PropertyShardTopology{Replicas: 1},
+			},
+		},
+	}
+}
+
+// Migration returns two schema and data migration scripts for the sample
+// cluster's default database, one inline and one read from a ConfigMap.
+func Migration() *neo4jv1alpha1.Neo4jMigration {
+	return &neo4jv1alpha1.Neo4jMigration{
+		TypeMeta:   typeMeta("Neo4jMigration"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-migration"},
+		Spec: neo4jv1alpha1.Neo4jMigrationSpec{
+			ClusterRef: ClusterName,
+			Database:   "neo4j",
+			Scripts: []neo4jv1alpha1.MigrationScript{
+				{
+					Version:     "1",
+					Description: "Person constraints",
+					Cypher:      "CREATE CONSTRAINT person_id IF NOT EXISTS FOR (p:Person) REQUIRE p.id IS UNIQUE;\n",
+				},
+				{
+					Version:      "2",
+					Description:  "Backfill person names",
+					ConfigMapRef: &neo4jv1alpha1.MigrationScriptRef{Name: "neo4j-migrations", Key: "V2__backfill_names.cypher"},
+				},
+			},
+		},
+	}
+}
+
+// CDC returns change data capture on the sample cluster's default database,
+// publishing person changes to a Kafka topic.
+func CDC() *neo4jv1alpha1.Neo4jCDC {
+	return &neo4jv1alpha1.Neo4jCDC{
+		TypeMeta:   typeMeta("Neo4jCDC"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-cdc"},
+		Spec: neo4jv1alpha1.Neo4jCDCSpec{
+			ClusterRef: ClusterName,
+			Databases: []neo4jv1alpha1.CDCDatabase{
+				{
+					Name:           "neo4j",
+					EnrichmentMode: "FULL",
+					StartFrom:      "NOW",
+					Topics: []neo4jv1alpha1.CDCTopic{
+						{Name: "people", Patterns: []string{"(:Person)", "(:Person)-[:KNOWS]->(:Person)"}},
+					},
+				},
+			},
+			Connector: &neo4jv1alpha1.CDCConnectorSpec{
+				Image:            neo4jv1alpha1.ImageSpec{Repo: "confluentinc/cp-kafka-connect", Tag: "7.7.1"},
+				BootstrapServers: "kafka-bootstrap.kafka.svc:9093",
+				SecurityProtocol: "SASL_SSL",
+				Auth:             &neo4jv1alpha1.CDCKafkaAuth{Mechanism: "SCRAM-SHA-512", SecretRef: "kafka-cdc-user"},
+			},
+		},
+	}
+}
+
+// UserSync returns a sync of the sample cluster's users from a group
+// membership snapshot, granting roles per group.
+func UserSync() *neo4jv1alpha1.Neo4jUserSync {
+	return &neo4jv1alpha1.Neo4jUserSync{
+		TypeMeta:   typeMeta("Neo4jUserSync"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-user-sync"},
+		Spec: neo4jv1alpha1.Neo4jUserSyncSpec{
+			ClusterRef: ClusterName,
+			Source: neo4jv1alpha1.UserSyncSource{
+				Format:       "groups",
+				ConfigMapRef: &neo4jv1alpha1.UserSyncSourceRef{Name: "neo4j-groups", Key: "groups.yaml"},
+			},
+			GroupRoles: map[string][]string{
+				"analysts":  {"reader"},
+				"engineers": {"editor"},
+			},
+			RemovalPolicy: "Suspend",
+		},
+	}
+}
+
+// Workload returns a ten minute read-heavy soak test against the sample
+// cluster.
+func Workload() *neo4jv1alpha1.Neo4jWorkload {
+	return &neo4jv1alpha1.Neo4jWorkload{
+		TypeMeta:   typeMeta("Neo4jWorkload"),
+		ObjectMeta: metav1.ObjectMeta{Name: "example-soak"},
+		Spec: neo4jv1alpha1.Neo4jWorkloadSpec{
+			ClusterRef:  ClusterName,
+			Database:    "neo4j",
+			Duration:    "10m",
+			Concurrency: 8,
+			ReadPercent: ptr.To[int32](80),
+		},
+	}
+}
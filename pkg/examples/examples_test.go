@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package examples_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/yaml"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/pkg/examples"
+)
+
+const samplesDir = "../../config/samples"
+
+func sampleFiles(t *testing.T) []string {
+	t.Helper()
+	entries, err := os.ReadDir(samplesDir)
+	require.NoError(t, err)
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".yaml") || name == "kustomization.yaml" {
+			continue
+		}
+		files = append(files, filepath.Join(samplesDir, name))
+	}
+	sort.Strings(files)
+	return files
+}
+
+// TestAllMatchesSampleFiles keeps the constructors and config/samples in step:
+// every sample file must decode to the object built for it.
+func TestAllMatchesSampleFiles(t *testing.T) {
+	files := sampleFiles(t)
+	objects := examples.All()
+	require.Len(t, objects, len(files), "every file in config/samples needs a constructor")
+
+	for i, file := range files {
+		want := objects[i]
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			got := reflect.New(reflect.TypeOf(want).Elem()).Interface()
+			require.NoError(t, yaml.UnmarshalStrict(data, got))
+			assert.True(t, equality.Semantic.DeepEqual(want, got), "%s differs from its constructor:\nwant %+v\ngot  %+v", file, want, got)
+		})
+	}
+}
+
+func TestConstructorsReturnFreshObjects(t *testing.T) {
+	cluster := examples.EnterpriseCluster()
+	cluster.Spec.Topology.Servers = 5
+	cluster.Spec.Auth.AdminSecret = "changed"
+
+	assert.Equal(t, int32(3), examples.EnterpriseCluster().Spec.Topology.Servers)
+	assert.Equal(t, examples.AdminSecretName, examples.EnterpriseCluster().Spec.Auth.AdminSecret)
+	assert.Equal(t, "neo4j.neo4j.com/v1alpha1", cluster.APIVersion)
+}
@@ -20,9 +20,20 @@ cluster() {
         kind delete cluster --name "${CLUSTER_NAME}" 2>/dev/null || true
     fi
 
-    # Create new cluster
-    log "Creating cluster: ${CLUSTER_NAME}"
-    kind create cluster --name "${CLUSTER_NAME}" --wait 10m
+    # Create new cluster. KIND_IP_FAMILY=ipv6 or dual creates an IPv6-only
+    # or dual-stack cluster to test cluster formation over IPv6.
+    local ip_family="${KIND_IP_FAMILY:-ipv4}"
+    log "Creating cluster: ${CLUSTER_NAME} (ipFamily: ${ip_family})"
+    if [[ "${ip_family}" == "ipv4" ]]; then
+        kind create cluster --name "${CLUSTER_NAME}" --wait 10m
+    else
+        kind create cluster --name "${CLUSTER_NAME}" --wait 10m --config - <<EOF
+kind: Cluster
+apiVersion: kind.x-k8s.io/v1alpha4
+networking:
+  ipFamily: ${ip_family}
+EOF
+    fi
 
     # Export kubeconfig
     kind export kubeconfig --name "${CLUSTER_NAME}"
@@ -11,7 +11,10 @@ import (
 	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/pkg/examples"
 )
 
 func loadSamples(samplesDir string) ([]map[string]any, error) {
@@ -58,6 +61,24 @@ func loadSamples(samplesDir string) ([]map[string]any, error) {
 	return samples, nil
 }
 
+// loadExamples converts the typed samples of pkg/examples, dropping the empty
+// status and creation timestamp every typed object serializes.
+func loadExamples() ([]map[string]any, error) {
+	var samples []map[string]any
+	for _, obj := range examples.All() {
+		doc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("convert %s: %w", obj.GetName(), err)
+		}
+		delete(doc, "status")
+		if metadata, ok := doc["metadata"].(map[string]any); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		samples = append(samples, doc)
+	}
+	return samples, nil
+}
+
 func updateCSV(path string, almValue string) (bool, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -119,23 +140,29 @@ func setMinKubeVersion(lines []string, version string) []string {
 
 func main() {
 	samplesDir := flag.String("samples-dir", "config/samples", "Directory with sample CRs")
+	fromExamples := flag.Bool("from-examples", false, "Build the samples from pkg/examples instead of reading --samples-dir")
 	flag.Parse()
 	csvPaths := flag.Args()
 	if len(csvPaths) == 0 {
-		fmt.Fprintln(os.Stderr, "usage: update-alm-examples [--samples-dir <dir>] <csv path> [<csv path>...]")
+		fmt.Fprintln(os.Stderr, "usage: update-alm-examples [--samples-dir <dir> | --from-examples] <csv path> [<csv path>...]")
 		os.Exit(2)
 	}
 
-	if _, err := os.Stat(*samplesDir); err != nil {
-		if os.IsNotExist(err) {
-			fmt.Fprintf(os.Stderr, "samples directory not found (%s); skipping alm-examples update\n", *samplesDir)
-			os.Exit(0)
+	var samples []map[string]any
+	var err error
+	if *fromExamples {
+		samples, err = loadExamples()
+	} else {
+		if _, statErr := os.Stat(*samplesDir); statErr != nil {
+			if os.IsNotExist(statErr) {
+				fmt.Fprintf(os.Stderr, "samples directory not found (%s); skipping alm-examples update\n", *samplesDir)
+				os.Exit(0)
+			}
+			fmt.Fprintf(os.Stderr, "stat samples dir: %v\n", statErr)
+			os.Exit(1)
 		}
-		fmt.Fprintf(os.Stderr, "stat samples dir: %v\n", err)
-		os.Exit(1)
+		samples, err = loadSamples(*samplesDir)
 	}
-
-	samples, err := loadSamples(*samplesDir)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "load samples: %v\n", err)
 		os.Exit(1)
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
+)
+
+// kubernetesServiceIsIPv6 reports whether the primary service IP family of
+// the test cluster is IPv6, as on kind clusters created with
+// KIND_IP_FAMILY=ipv6 or dual.
+func kubernetesServiceIsIPv6(ctx context.Context) bool {
+	svc := &corev1.Service{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: "kubernetes", Namespace: "default"}, svc); err != nil {
+		return false
+	}
+	return strings.Contains(svc.Spec.ClusterIP, ":")
+}
+
+var _ = Describe("IPv6 Cluster Formation", func() {
+	var (
+		ctx       context.Context
+		namespace string
+		cluster   *neo4jv1alpha1.Neo4jEnterpriseCluster
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		if !kubernetesServiceIsIPv6(ctx) {
+			Skip("IPv6 cluster formation requires an IPv6-only or IPv6-primary kind cluster (KIND_IP_FAMILY=ipv6)")
+		}
+		namespace = createTestNamespace("ipv6")
+
+		adminSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "neo4j-admin-secret", Namespace: namespace},
+			Data: map[string][]byte{
+				"username": []byte("neo4j"),
+				"password": []byte("password123"),
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+		Expect(k8sClient.Create(ctx, adminSecret)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if cluster != nil {
+			if err := k8sClient.Delete(ctx, cluster); err != nil && !errors.IsNotFound(err) {
+				By(fmt.Sprintf("Failed to delete cluster: %v", err))
+			}
+			cluster = nil
+		}
+		if namespace != "" {
+			cleanupCustomResourcesInNamespace(namespace)
+		}
+	})
+
+	It("should form a cluster listening on IPv6", func() {
+		cluster = &neo4jv1alpha1.Neo4jEnterpriseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "ipv6-cluster", Namespace: namespace},
+			Spec: neo4jv1alpha1.Neo4jEnterpriseClusterSpec{
+				Image: neo4jv1alpha1.ImageSpec{Repo: "neo4j", Tag: getNeo4jImageTag()},
+				Auth: &neo4jv1alpha1.AuthSpec{
+					Provider:    "native",
+					AdminSecret: "neo4j-admin-secret",
+				},
+				Topology: neo4jv1alpha1.TopologyConfiguration{Servers: 2},
+				Storage:  neo4jv1alpha1.StorageSpec{ClassName: "standard", Size: "2Gi"},
+				Service: &neo4jv1alpha1.ServiceSpec{
+					IPFamilyPolicy: "SingleStack",
+					IPFamilies:     []string{"IPv6"},
+				},
+				Env: []corev1.EnvVar{{Name: "NEO4J_ACCEPT_LICENSE_AGREEMENT", Value: "eval"}},
+			},
+		}
+		applyCIOptimizations(cluster)
+		Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+
+		By("Checking the connectors listen on the IPv6 wildcard address")
+		Eventually(func() error {
+			configMap := &corev1.ConfigMap{}
+			key := types.NamespacedName{Name: cluster.Name + "-config", Namespace: namespace}
+			if err := k8sClient.Get(ctx, key, configMap); err != nil {
+				return err
+			}
+			for _, line := range []string{
+				"server.default_listen_address=::",
+				"server.cluster.listen_address=[::]:6000",
+				"server.cluster.raft.listen_address=[::]:7000",
+			} {
+				if !containsString(configMap.Data["neo4j.conf"], line) {
+					return fmt.Errorf("neo4j.conf does not contain %s", line)
+				}
+			}
+			return nil
+		}, timeout, interval).Should(Succeed())
+
+		By("Checking the client service is IPv6")
+		Eventually(func() error {
+			svc := &corev1.Service{}
+			key := types.NamespacedName{Name: cluster.Name + "-client", Namespace: namespace}
+			if err := k8sClient.Get(ctx, key, svc); err != nil {
+				return err
+			}
+			if len(svc.Spec.IPFamilies) != 1 || svc.Spec.IPFamilies[0] != corev1.IPv6Protocol {
+				return fmt.Errorf("client service has IP families %v", svc.Spec.IPFamilies)
+			}
+			return nil
+		}, timeout, interval).Should(Succeed())
+
+		By("Waiting for the cluster to form")
+		Eventually(func() error {
+			current := &neo4jv1alpha1.Neo4jEnterpriseCluster{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: namespace}, current); err != nil {
+				return err
+			}
+			if current.Status.Phase != "Ready" {
+				return fmt.Errorf("cluster phase is %s: %s", current.Status.Phase, current.Status.Message)
+			}
+			return nil
+		}, clusterTimeout, interval).Should(Succeed())
+	})
+})
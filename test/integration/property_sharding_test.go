@@ -30,7 +30,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	neo4jv1alpha1 "github.com/neo4j-partners/neo4j-kubernetes-operator/api/v1alpha1"
-	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 // isRunningInCI checks if tests are running in CI environment
@@ -43,7 +43,7 @@ func isRunningInCI() bool {
 // See: https://neo4j.com/docs/operations-manual/current/scalability/sharded-property-databases/overview/
 func isPropertyShardingCompatible() bool {
 	tag := getNeo4jImageTag()
-	v, err := neo4j.ParseVersion(tag)
+	v, err := version.Parse(tag)
 	if err != nil {
 		return false
 	}
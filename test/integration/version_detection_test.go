@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/neo4j"
+	"github.com/neo4j-partners/neo4j-kubernetes-operator/internal/version"
 )
 
 var _ = Describe("Version Detection Integration Tests", func() {
@@ -43,13 +44,13 @@ var _ = Describe("Version Detection Integration Tests", func() {
 
 			for _, tc := range testCases {
 				By(fmt.Sprintf("Parsing version from image %s", tc.image))
-				version, err := neo4j.GetImageVersion(tc.image)
+				parsed, err := version.FromImage(tc.image)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(version).NotTo(BeNil())
-				Expect(version.IsCalver).To(Equal(tc.isCalver))
-				Expect(version.Major).To(Equal(tc.expectedMajor))
-				Expect(version.Minor).To(Equal(tc.expectedMinor))
-				Expect(version.Patch).To(Equal(tc.expectedPatch))
+				Expect(parsed).NotTo(BeNil())
+				Expect(parsed.IsCalver).To(Equal(tc.isCalver))
+				Expect(parsed.Major).To(Equal(tc.expectedMajor))
+				Expect(parsed.Minor).To(Equal(tc.expectedMinor))
+				Expect(parsed.Patch).To(Equal(tc.expectedPatch))
 			}
 		})
 
@@ -69,26 +70,26 @@ var _ = Describe("Version Detection Integration Tests", func() {
 
 			for _, tc := range testCases {
 				By(fmt.Sprintf("Parsing version from image %s", tc.image))
-				version, err := neo4j.GetImageVersion(tc.image)
+				parsed, err := version.FromImage(tc.image)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(version).NotTo(BeNil())
-				Expect(version.IsCalver).To(Equal(tc.isCalver))
-				Expect(version.Major).To(Equal(tc.expectedMajor))
-				Expect(version.Minor).To(Equal(tc.expectedMinor))
-				Expect(version.Patch).To(Equal(tc.expectedPatch))
+				Expect(parsed).NotTo(BeNil())
+				Expect(parsed.IsCalver).To(Equal(tc.isCalver))
+				Expect(parsed.Major).To(Equal(tc.expectedMajor))
+				Expect(parsed.Minor).To(Equal(tc.expectedMinor))
+				Expect(parsed.Patch).To(Equal(tc.expectedPatch))
 			}
 		})
 
 		It("Should generate correct backup commands for different versions", func() {
 			By("Testing Neo4j 5.26.x backup command")
-			version526, err := neo4j.GetImageVersion("neo4j:5.26.0")
+			version526, err := version.FromImage("neo4j:5.26.0")
 			Expect(err).NotTo(HaveOccurred())
 
 			backupCmd := neo4j.GetBackupCommand(version526, "mydb", "/backups/mydb", false, "")
 			Expect(backupCmd).To(Equal("neo4j-admin database backup --to-path=/backups/mydb mydb"))
 
 			By("Testing Neo4j 2025.x backup command")
-			version2025, err := neo4j.GetImageVersion("neo4j:2025.01.0")
+			version2025, err := version.FromImage("neo4j:2025.01.0")
 			Expect(err).NotTo(HaveOccurred())
 
 			backupCmd2025 := neo4j.GetBackupCommand(version2025, "mydb", "/backups/mydb", false, "")
@@ -101,14 +102,14 @@ var _ = Describe("Version Detection Integration Tests", func() {
 
 		It("Should generate correct restore commands for different versions", func() {
 			By("Testing Neo4j 5.26.x restore command")
-			version526, err := neo4j.GetImageVersion("neo4j:5.26.0")
+			version526, err := version.FromImage("neo4j:5.26.0")
 			Expect(err).NotTo(HaveOccurred())
 
 			restoreCmd := neo4j.GetRestoreCommand(version526, "mydb", "/backups/mydb")
 			Expect(restoreCmd).To(Equal("neo4j-admin database restore --from-path=/backups/mydb mydb"))
 
 			By("Testing Neo4j 2025.x restore command")
-			version2025, err := neo4j.GetImageVersion("neo4j:2025.01.0")
+			version2025, err := version.FromImage("neo4j:2025.01.0")
 			Expect(err).NotTo(HaveOccurred())
 
 			restoreCmd2025 := neo4j.GetRestoreCommand(version2025, "mydb", "/backups/mydb")
@@ -117,35 +118,35 @@ var _ = Describe("Version Detection Integration Tests", func() {
 
 		It("Should identify version support correctly", func() {
 			By("Testing supported versions")
-			version526, _ := neo4j.GetImageVersion("neo4j:5.26.0")
+			version526, _ := version.FromImage("neo4j:5.26.0")
 			Expect(version526.IsSupported()).To(BeTrue())
 
 			// 5.27 is not supported (does not exist)
-			version527, _ := neo4j.GetImageVersion("neo4j:5.27.0")
+			version527, _ := version.FromImage("neo4j:5.27.0")
 			Expect(version527.IsSupported()).To(BeFalse())
 
-			version2025, _ := neo4j.GetImageVersion("neo4j:2025.01.0")
+			version2025, _ := version.FromImage("neo4j:2025.01.0")
 			Expect(version2025.IsSupported()).To(BeTrue())
 
 			By("Testing unsupported versions")
-			version525, _ := neo4j.GetImageVersion("neo4j:5.25.0")
+			version525, _ := version.FromImage("neo4j:5.25.0")
 			Expect(version525.IsSupported()).To(BeFalse())
 
-			version4, _ := neo4j.GetImageVersion("neo4j:4.4.0")
+			version4, _ := version.FromImage("neo4j:4.4.0")
 			Expect(version4.IsSupported()).To(BeFalse())
 		})
 
 		It("Should handle version comparison correctly", func() {
 			By("Comparing SemVer versions")
-			v526, _ := neo4j.GetImageVersion("neo4j:5.26.0")
-			v527, _ := neo4j.GetImageVersion("neo4j:5.27.0")
+			v526, _ := version.FromImage("neo4j:5.26.0")
+			v527, _ := version.FromImage("neo4j:5.27.0")
 			Expect(v526.Compare(v527)).To(Equal(-1))
 			Expect(v527.Compare(v526)).To(Equal(1))
 			Expect(v526.Compare(v526)).To(Equal(0))
 
 			By("Comparing CalVer versions")
-			v2025_01, _ := neo4j.GetImageVersion("neo4j:2025.01.0")
-			v2025_02, _ := neo4j.GetImageVersion("neo4j:2025.02.0")
+			v2025_01, _ := version.FromImage("neo4j:2025.01.0")
+			v2025_02, _ := version.FromImage("neo4j:2025.02.0")
 			Expect(v2025_01.Compare(v2025_02)).To(Equal(-1))
 			Expect(v2025_02.Compare(v2025_01)).To(Equal(1))
 
@@ -157,36 +158,36 @@ var _ = Describe("Version Detection Integration Tests", func() {
 
 		It("Should detect Cypher language version support", func() {
 			By("Testing Neo4j 5.26.x - no Cypher language version support")
-			version526, _ := neo4j.GetImageVersion("neo4j:5.26.0")
+			version526, _ := version.FromImage("neo4j:5.26.0")
 			Expect(version526.SupportsCypherLanguageVersion()).To(BeFalse())
 
 			By("Testing Neo4j 2025.x - supports Cypher language version")
-			version2025, _ := neo4j.GetImageVersion("neo4j:2025.01.0")
+			version2025, _ := version.FromImage("neo4j:2025.01.0")
 			Expect(version2025.SupportsCypherLanguageVersion()).To(BeTrue())
 		})
 
 		It("Should handle edge cases in version parsing", func() {
 			By("Testing invalid image formats")
-			_, err := neo4j.GetImageVersion("neo4j")
+			_, err := version.FromImage("neo4j")
 			Expect(err).To(HaveOccurred())
 
-			_, err = neo4j.GetImageVersion("neo4j:latest")
+			_, err = version.FromImage("neo4j:latest")
 			Expect(err).To(HaveOccurred())
 
-			_, err = neo4j.GetImageVersion("neo4j:invalid-version")
+			_, err = version.FromImage("neo4j:invalid-version")
 			Expect(err).To(HaveOccurred())
 
 			By("Testing custom registries")
-			version, err := neo4j.GetImageVersion("my-registry.com/neo4j:5.26.0-enterprise")
+			parsed, err := version.FromImage("my-registry.com/neo4j:5.26.0-enterprise")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(version.Major).To(Equal(5))
-			Expect(version.Minor).To(Equal(26))
+			Expect(parsed.Major).To(Equal(5))
+			Expect(parsed.Minor).To(Equal(26))
 
 			By("Testing version with multiple hyphens")
-			version, err = neo4j.GetImageVersion("neo4j:5.26.0-enterprise-aura")
+			parsed, err = version.FromImage("neo4j:5.26.0-enterprise-aura")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(version.Major).To(Equal(5))
-			Expect(version.Minor).To(Equal(26))
+			Expect(parsed.Major).To(Equal(5))
+			Expect(parsed.Minor).To(Equal(26))
 		})
 	})
 })
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+// updateGolden rewrites golden files instead of comparing against them
+var updateGolden = flag.Bool("update", false, "Rewrite golden files with the rendered manifests")
+
+// LoadFixture reads a YAML fixture into obj
+func LoadFixture(t *testing.T, path string, obj any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	if err := yaml.UnmarshalStrict(data, obj); err != nil {
+		t.Fatalf("failed to parse fixture %s: %v", path, err)
+	}
+}
+
+// AssertGolden renders objects as YAML documents and compares them with the
+// golden file at path. Nil objects, which builders return for disabled
+// features, are left out. Run the test with -update to rewrite the file
+// after an intended manifest change.
+func AssertGolden(t *testing.T, path string, objects ...any) {
+	t.Helper()
+	var rendered bytes.Buffer
+	for _, obj := range objects {
+		if value := reflect.ValueOf(obj); obj == nil || (value.Kind() == reflect.Pointer && value.IsNil()) {
+			continue
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			t.Fatalf("failed to render %T: %v", obj, err)
+		}
+		fmt.Fprintf(&rendered, "---\n# %s\n%s", describeObject(obj), data)
+	}
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, rendered.Bytes(), 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file, run the test with -update to create it: %v", err)
+	}
+	if !bytes.Equal(golden, rendered.Bytes()) {
+		t.Errorf("rendered manifests differ from %s, run the test with -update if the change is intended:\n%s",
+			path, lineDiff(string(golden), rendered.String()))
+	}
+}
+
+// describeObject names the kind and name of a rendered object
+func describeObject(obj any) string {
+	kind := reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	if typed, ok := obj.(interface{ GetKind() string }); ok && typed.GetKind() != "" {
+		kind = typed.GetKind()
+	}
+	if named, ok := obj.(interface{ GetName() string }); ok {
+		return kind + " " + named.GetName()
+	}
+	return kind
+}
+
+// lineDiff lists the lines around the first difference of two renderings
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	first := 0
+	for first < len(wantLines) && first < len(gotLines) && wantLines[first] == gotLines[first] {
+		first++
+	}
+
+	var diff strings.Builder
+	for i := max(first-3, 0); i < first; i++ {
+		fmt.Fprintf(&diff, "  %s\n", wantLines[i])
+	}
+	for i := first; i < min(first+10, len(wantLines)); i++ {
+		fmt.Fprintf(&diff, "- %s\n", wantLines[i])
+	}
+	for i := first; i < min(first+10, len(gotLines)); i++ {
+		fmt.Fprintf(&diff, "+ %s\n", gotLines[i])
+	}
+	return diff.String()
+}